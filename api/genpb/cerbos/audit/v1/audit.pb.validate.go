@@ -167,6 +167,64 @@ func (m *AccessLogEntry) validate(all bool) error {
 
 	// no validation rules for StatusCode
 
+	if all {
+		switch v := interface{}(m.GetSystemInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessLogEntryValidationError{
+					field:  "SystemInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessLogEntryValidationError{
+					field:  "SystemInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSystemInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessLogEntryValidationError{
+				field:  "SystemInfo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAdminChange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessLogEntryValidationError{
+					field:  "AdminChange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessLogEntryValidationError{
+					field:  "AdminChange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAdminChange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessLogEntryValidationError{
+				field:  "AdminChange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return AccessLogEntryMultiError(errors)
 	}
@@ -245,6 +303,141 @@ var _ interface {
 	ErrorName() string
 } = AccessLogEntryValidationError{}
 
+// Validate checks the field values on AdminChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminChangeMultiError, or
+// nil if none found.
+func (m *AdminChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for User
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminChangeValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminChangeValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminChangeValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminChangeMultiError is an error wrapping multiple validation errors
+// returned by AdminChange.ValidateAll() if the designated constraints aren't met.
+type AdminChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminChangeMultiError) AllErrors() []error { return m }
+
+// AdminChangeValidationError is the validation error returned by
+// AdminChange.Validate if the designated constraints aren't met.
+type AdminChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminChangeValidationError) ErrorName() string { return "AdminChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminChangeValidationError{}
+
 // Validate checks the field values on DecisionLogEntry with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -443,6 +636,35 @@ func (m *DecisionLogEntry) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetSystemInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DecisionLogEntryValidationError{
+					field:  "SystemInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DecisionLogEntryValidationError{
+					field:  "SystemInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSystemInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DecisionLogEntryValidationError{
+				field:  "SystemInfo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	switch v := m.Method.(type) {
 	case *DecisionLogEntry_CheckResources_:
 		if v == nil {
@@ -813,6 +1035,221 @@ var _ interface {
 	ErrorName() string
 } = PeerValidationError{}
 
+// Validate checks the field values on SystemInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SystemInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SystemInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SystemInfoMultiError, or
+// nil if none found.
+func (m *SystemInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SystemInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for Commit
+
+	// no validation rules for ConfigHash
+
+	// no validation rules for StoreRevision
+
+	if len(errors) > 0 {
+		return SystemInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// SystemInfoMultiError is an error wrapping multiple validation errors
+// returned by SystemInfo.ValidateAll() if the designated constraints aren't met.
+type SystemInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SystemInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SystemInfoMultiError) AllErrors() []error { return m }
+
+// SystemInfoValidationError is the validation error returned by
+// SystemInfo.Validate if the designated constraints aren't met.
+type SystemInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SystemInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SystemInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SystemInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SystemInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SystemInfoValidationError) ErrorName() string { return "SystemInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SystemInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSystemInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SystemInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SystemInfoValidationError{}
+
+// Validate checks the field values on AdminChange_PolicyChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminChange_PolicyChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminChange_PolicyChange with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminChange_PolicyChangeMultiError, or nil if none found.
+func (m *AdminChange_PolicyChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminChange_PolicyChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PolicyId
+
+	// no validation rules for BeforeHash
+
+	// no validation rules for AfterHash
+
+	if len(errors) > 0 {
+		return AdminChange_PolicyChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminChange_PolicyChangeMultiError is an error wrapping multiple validation
+// errors returned by AdminChange_PolicyChange.ValidateAll() if the designated
+// constraints aren't met.
+type AdminChange_PolicyChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminChange_PolicyChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminChange_PolicyChangeMultiError) AllErrors() []error { return m }
+
+// AdminChange_PolicyChangeValidationError is the validation error returned by
+// AdminChange_PolicyChange.Validate if the designated constraints aren't met.
+type AdminChange_PolicyChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminChange_PolicyChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminChange_PolicyChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminChange_PolicyChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminChange_PolicyChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminChange_PolicyChangeValidationError) ErrorName() string {
+	return "AdminChange_PolicyChangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminChange_PolicyChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminChange_PolicyChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminChange_PolicyChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminChange_PolicyChangeValidationError{}
+
 // Validate checks the field values on DecisionLogEntry_CheckResources with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
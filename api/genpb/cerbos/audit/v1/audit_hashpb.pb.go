@@ -16,6 +16,22 @@ func (m *AccessLogEntry) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
 	}
 }
 
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *AdminChange) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_audit_v1_AdminChange_hashpb_sum(m, hasher, ignore)
+	}
+}
+
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *AdminChange_PolicyChange) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_audit_v1_AdminChange_PolicyChange_hashpb_sum(m, hasher, ignore)
+	}
+}
+
 // HashPB computes a hash of the message using the given hash function
 // The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
 func (m *DecisionLogEntry) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
@@ -55,3 +71,11 @@ func (m *Peer) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
 		cerbos_audit_v1_Peer_hashpb_sum(m, hasher, ignore)
 	}
 }
+
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *SystemInfo) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_audit_v1_SystemInfo_hashpb_sum(m, hasher, ignore)
+	}
+}
@@ -58,6 +58,50 @@ func cerbos_audit_v1_AccessLogEntry_hashpb_sum(m *AccessLogEntry, hasher hash.Ha
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.StatusCode)))
 
 	}
+	if _, ok := ignore["cerbos.audit.v1.AccessLogEntry.system_info"]; !ok {
+		if m.SystemInfo != nil {
+			cerbos_audit_v1_SystemInfo_hashpb_sum(m.SystemInfo, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.AccessLogEntry.admin_change"]; !ok {
+		if m.AdminChange != nil {
+			cerbos_audit_v1_AdminChange_hashpb_sum(m.AdminChange, hasher, ignore)
+		}
+
+	}
+}
+
+func cerbos_audit_v1_AdminChange_PolicyChange_hashpb_sum(m *AdminChange_PolicyChange, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.audit.v1.AdminChange.PolicyChange.policy_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.PolicyId))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.AdminChange.PolicyChange.before_hash"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.BeforeHash))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.AdminChange.PolicyChange.after_hash"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.AfterHash))
+
+	}
+}
+
+func cerbos_audit_v1_AdminChange_hashpb_sum(m *AdminChange, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.audit.v1.AdminChange.user"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.User))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.AdminChange.policies"]; !ok {
+		if len(m.Policies) > 0 {
+			for _, v := range m.Policies {
+				if v != nil {
+					cerbos_audit_v1_AdminChange_PolicyChange_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_audit_v1_DecisionLogEntry_CheckResources_hashpb_sum(m *DecisionLogEntry_CheckResources, hasher hash.Hash, ignore map[string]struct{}) {
@@ -182,6 +226,12 @@ func cerbos_audit_v1_DecisionLogEntry_hashpb_sum(m *DecisionLogEntry, hasher has
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.system_info"]; !ok {
+		if m.SystemInfo != nil {
+			cerbos_audit_v1_SystemInfo_hashpb_sum(m.SystemInfo, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_audit_v1_MetaValues_hashpb_sum(m *MetaValues, hasher hash.Hash, ignore map[string]struct{}) {
@@ -214,6 +264,25 @@ func cerbos_audit_v1_Peer_hashpb_sum(m *Peer, hasher hash.Hash, ignore map[strin
 	}
 }
 
+func cerbos_audit_v1_SystemInfo_hashpb_sum(m *SystemInfo, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.audit.v1.SystemInfo.version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Version))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.SystemInfo.commit"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Commit))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.SystemInfo.config_hash"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.ConfigHash))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.SystemInfo.store_revision"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.StoreRevision))
+
+	}
+}
+
 func cerbos_engine_v1_AuxData_hashpb_sum(m *v1.AuxData, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.AuxData.jwt"]; !ok {
 		if len(m.Jwt) > 0 {
@@ -472,6 +541,47 @@ func cerbos_engine_v1_PlanResourcesInput_hashpb_sum(m *v1.PlanResourcesInput, ha
 		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeMeta)))
 
 	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.expand_conditionals"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.ExpandConditionals)))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.include_rule_residuals"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeRuleResiduals)))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.simplify_filters"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.SimplifyFilters)))
+
+	}
+}
+
+func cerbos_engine_v1_PlanResourcesOutput_RuleResidual_hashpb_sum(m *v1.PlanResourcesOutput_RuleResidual, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.policy"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Policy))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.rule"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Rule))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Effect)))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.filter"]; !ok {
+		if m.Filter != nil {
+			cerbos_engine_v1_PlanResourcesFilter_hashpb_sum(m.Filter, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.filter_debug"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.FilterDebug))
+
+	}
 }
 
 func cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(m *v1.PlanResourcesOutput, hasher hash.Hash, ignore map[string]struct{}) {
@@ -515,6 +625,16 @@ func cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(m *v1.PlanResourcesOutput,
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.rule_residuals"]; !ok {
+		if len(m.RuleResiduals) > 0 {
+			for _, v := range m.RuleResiduals {
+				if v != nil {
+					cerbos_engine_v1_PlanResourcesOutput_RuleResidual_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_engine_v1_Principal_hashpb_sum(m *v1.Principal, hasher hash.Hash, ignore map[string]struct{}) {
@@ -154,6 +154,12 @@ func (m *PlanResourcesInput) validate(all bool) error {
 
 	// no validation rules for IncludeMeta
 
+	// no validation rules for ExpandConditionals
+
+	// no validation rules for IncludeRuleResiduals
+
+	// no validation rules for SimplifyFilters
+
 	if len(errors) > 0 {
 		return PlanResourcesInputMultiError(errors)
 	}
@@ -593,6 +599,40 @@ func (m *PlanResourcesOutput) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetRuleResiduals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlanResourcesOutputValidationError{
+						field:  fmt.Sprintf("RuleResiduals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlanResourcesOutputValidationError{
+						field:  fmt.Sprintf("RuleResiduals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlanResourcesOutputValidationError{
+					field:  fmt.Sprintf("RuleResiduals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return PlanResourcesOutputMultiError(errors)
 	}
@@ -2904,6 +2944,150 @@ var _ interface {
 	ErrorName() string
 } = PlanResourcesFilter_Expression_OperandValidationError{}
 
+// Validate checks the field values on PlanResourcesOutput_RuleResidual with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PlanResourcesOutput_RuleResidual) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanResourcesOutput_RuleResidual with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PlanResourcesOutput_RuleResidualMultiError, or nil if none found.
+func (m *PlanResourcesOutput_RuleResidual) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanResourcesOutput_RuleResidual) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Policy
+
+	// no validation rules for Scope
+
+	// no validation rules for Rule
+
+	// no validation rules for Effect
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlanResourcesOutput_RuleResidualValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlanResourcesOutput_RuleResidualValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlanResourcesOutput_RuleResidualValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FilterDebug
+
+	if len(errors) > 0 {
+		return PlanResourcesOutput_RuleResidualMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanResourcesOutput_RuleResidualMultiError is an error wrapping multiple
+// validation errors returned by
+// PlanResourcesOutput_RuleResidual.ValidateAll() if the designated
+// constraints aren't met.
+type PlanResourcesOutput_RuleResidualMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanResourcesOutput_RuleResidualMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanResourcesOutput_RuleResidualMultiError) AllErrors() []error { return m }
+
+// PlanResourcesOutput_RuleResidualValidationError is the validation error
+// returned by PlanResourcesOutput_RuleResidual.Validate if the designated
+// constraints aren't met.
+type PlanResourcesOutput_RuleResidualValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanResourcesOutput_RuleResidualValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanResourcesOutput_RuleResidualValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanResourcesOutput_RuleResidualValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanResourcesOutput_RuleResidualValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanResourcesOutput_RuleResidualValidationError) ErrorName() string {
+	return "PlanResourcesOutput_RuleResidualValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanResourcesOutput_RuleResidualValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanResourcesOutput_RuleResidual.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanResourcesOutput_RuleResidualValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanResourcesOutput_RuleResidualValidationError{}
+
 // Validate checks the field values on CheckOutput_ActionEffect with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
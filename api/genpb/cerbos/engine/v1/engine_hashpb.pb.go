@@ -80,6 +80,14 @@ func (m *PlanResourcesOutput) HashPB(hasher hash.Hash, ignore map[string]struct{
 	}
 }
 
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *PlanResourcesOutput_RuleResidual) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_engine_v1_PlanResourcesOutput_RuleResidual_hashpb_sum(m, hasher, ignore)
+	}
+}
+
 // HashPB computes a hash of the message using the given hash function
 // The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
 func (m *CheckInput) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
@@ -319,6 +319,47 @@ func cerbos_engine_v1_PlanResourcesInput_hashpb_sum(m *PlanResourcesInput, hashe
 		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeMeta)))
 
 	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.expand_conditionals"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.ExpandConditionals)))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.include_rule_residuals"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeRuleResiduals)))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.simplify_filters"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.SimplifyFilters)))
+
+	}
+}
+
+func cerbos_engine_v1_PlanResourcesOutput_RuleResidual_hashpb_sum(m *PlanResourcesOutput_RuleResidual, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.policy"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Policy))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.rule"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Rule))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Effect)))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.filter"]; !ok {
+		if m.Filter != nil {
+			cerbos_engine_v1_PlanResourcesFilter_hashpb_sum(m.Filter, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.filter_debug"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.FilterDebug))
+
+	}
 }
 
 func cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(m *PlanResourcesOutput, hasher hash.Hash, ignore map[string]struct{}) {
@@ -362,6 +403,16 @@ func cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(m *PlanResourcesOutput, has
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.rule_residuals"]; !ok {
+		if len(m.RuleResiduals) > 0 {
+			for _, v := range m.RuleResiduals {
+				if v != nil {
+					cerbos_engine_v1_PlanResourcesOutput_RuleResidual_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_engine_v1_Principal_hashpb_sum(m *Principal, hasher hash.Hash, ignore map[string]struct{}) {
@@ -6,6 +6,7 @@ package policyv1
 import (
 	v1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
 	protowire "google.golang.org/protobuf/encoding/protowire"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
@@ -326,6 +327,19 @@ func cerbos_policy_v1_ExportVariables_hashpb_sum(m *ExportVariables, hasher hash
 	}
 }
 
+func cerbos_policy_v1_Limit_hashpb_sum(m *Limit, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.Limit.max_allowed"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.MaxAllowed)))
+
+	}
+	if _, ok := ignore["cerbos.policy.v1.Limit.window"]; !ok {
+		if m.Window != nil {
+			google_protobuf_Duration_hashpb_sum(m.Window, hasher, ignore)
+		}
+
+	}
+}
+
 func cerbos_policy_v1_Match_ExprList_hashpb_sum(m *Match_ExprList, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.policy.v1.Match.ExprList.of"]; !ok {
 		if len(m.Of) > 0 {
@@ -402,6 +416,18 @@ func cerbos_policy_v1_Metadata_hashpb_sum(m *Metadata, hasher hash.Hash, ignore
 		_, _ = hasher.Write(protowire.AppendString(nil, m.StoreIdentifier))
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.Metadata.active_from"]; !ok {
+		if m.ActiveFrom != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.ActiveFrom, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.policy.v1.Metadata.active_until"]; !ok {
+		if m.ActiveUntil != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.ActiveUntil, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_policy_v1_Output_hashpb_sum(m *Output, hasher hash.Hash, ignore map[string]struct{}) {
@@ -597,6 +623,16 @@ func cerbos_policy_v1_ResourcePolicy_hashpb_sum(m *ResourcePolicy, hasher hash.H
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.ResourcePolicy.local_derived_roles"]; !ok {
+		if len(m.LocalDerivedRoles) > 0 {
+			for _, v := range m.LocalDerivedRoles {
+				if v != nil {
+					cerbos_policy_v1_RoleDef_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_policy_v1_ResourceRule_hashpb_sum(m *ResourceRule, hasher hash.Hash, ignore map[string]struct{}) {
@@ -644,6 +680,12 @@ func cerbos_policy_v1_ResourceRule_hashpb_sum(m *ResourceRule, hasher hash.Hash,
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.ResourceRule.limit"]; !ok {
+		if m.Limit != nil {
+			cerbos_policy_v1_Limit_hashpb_sum(m.Limit, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_policy_v1_RoleDef_hashpb_sum(m *RoleDef, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1334,6 +1376,17 @@ func cerbos_policy_v1_Variables_hashpb_sum(m *Variables, hasher hash.Hash, ignor
 	}
 }
 
+func google_protobuf_Duration_hashpb_sum(m *durationpb.Duration, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["google.protobuf.Duration.seconds"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Seconds)))
+
+	}
+	if _, ok := ignore["google.protobuf.Duration.nanos"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Nanos)))
+
+	}
+}
+
 func google_protobuf_ListValue_hashpb_sum(m *structpb.ListValue, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["google.protobuf.ListValue.values"]; !ok {
 		if len(m.Values) > 0 {
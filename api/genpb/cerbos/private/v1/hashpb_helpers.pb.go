@@ -11,6 +11,7 @@ import (
 	v14 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
 	v15 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
 	protowire "google.golang.org/protobuf/encoding/protowire"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -278,6 +279,47 @@ func cerbos_engine_v1_PlanResourcesInput_hashpb_sum(m *v1.PlanResourcesInput, ha
 		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeMeta)))
 
 	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.expand_conditionals"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.ExpandConditionals)))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.include_rule_residuals"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeRuleResiduals)))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.simplify_filters"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.SimplifyFilters)))
+
+	}
+}
+
+func cerbos_engine_v1_PlanResourcesOutput_RuleResidual_hashpb_sum(m *v1.PlanResourcesOutput_RuleResidual, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.policy"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Policy))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.rule"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Rule))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Effect)))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.filter"]; !ok {
+		if m.Filter != nil {
+			cerbos_engine_v1_PlanResourcesFilter_hashpb_sum(m.Filter, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.filter_debug"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.FilterDebug))
+
+	}
 }
 
 func cerbos_engine_v1_Principal_hashpb_sum(m *v1.Principal, hasher hash.Hash, ignore map[string]struct{}) {
@@ -524,6 +566,19 @@ func cerbos_policy_v1_ExportVariables_hashpb_sum(m *v11.ExportVariables, hasher
 	}
 }
 
+func cerbos_policy_v1_Limit_hashpb_sum(m *v11.Limit, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.Limit.max_allowed"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.MaxAllowed)))
+
+	}
+	if _, ok := ignore["cerbos.policy.v1.Limit.window"]; !ok {
+		if m.Window != nil {
+			google_protobuf_Duration_hashpb_sum(m.Window, hasher, ignore)
+		}
+
+	}
+}
+
 func cerbos_policy_v1_Match_ExprList_hashpb_sum(m *v11.Match_ExprList, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.policy.v1.Match.ExprList.of"]; !ok {
 		if len(m.Of) > 0 {
@@ -600,6 +655,18 @@ func cerbos_policy_v1_Metadata_hashpb_sum(m *v11.Metadata, hasher hash.Hash, ign
 		_, _ = hasher.Write(protowire.AppendString(nil, m.StoreIdentifier))
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.Metadata.active_from"]; !ok {
+		if m.ActiveFrom != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.ActiveFrom, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.policy.v1.Metadata.active_until"]; !ok {
+		if m.ActiveUntil != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.ActiveUntil, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_policy_v1_Output_hashpb_sum(m *v11.Output, hasher hash.Hash, ignore map[string]struct{}) {
@@ -795,6 +862,16 @@ func cerbos_policy_v1_ResourcePolicy_hashpb_sum(m *v11.ResourcePolicy, hasher ha
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.ResourcePolicy.local_derived_roles"]; !ok {
+		if len(m.LocalDerivedRoles) > 0 {
+			for _, v := range m.LocalDerivedRoles {
+				if v != nil {
+					cerbos_policy_v1_RoleDef_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_policy_v1_ResourceRule_hashpb_sum(m *v11.ResourceRule, hasher hash.Hash, ignore map[string]struct{}) {
@@ -842,6 +919,12 @@ func cerbos_policy_v1_ResourceRule_hashpb_sum(m *v11.ResourceRule, hasher hash.H
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.ResourceRule.limit"]; !ok {
+		if m.Limit != nil {
+			cerbos_policy_v1_Limit_hashpb_sum(m.Limit, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_policy_v1_RoleDef_hashpb_sum(m *v11.RoleDef, hasher hash.Hash, ignore map[string]struct{}) {
@@ -2246,6 +2329,16 @@ func cerbos_request_v1_CheckResourcesRequest_hashpb_sum(m *v12.CheckResourcesReq
 		}
 
 	}
+	if _, ok := ignore["cerbos.request.v1.CheckResourcesRequest.policies"]; !ok {
+		if len(m.Policies) > 0 {
+			for _, v := range m.Policies {
+				if v != nil {
+					cerbos_policy_v1_Policy_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_request_v1_File_hashpb_sum(m *v12.File, hasher hash.Hash, ignore map[string]struct{}) {
@@ -2290,6 +2383,18 @@ func cerbos_request_v1_PlanResourcesRequest_hashpb_sum(m *v12.PlanResourcesReque
 		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeMeta)))
 
 	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.expand_conditionals"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.ExpandConditionals)))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.include_rule_residuals"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeRuleResiduals)))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.simplify_filters"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.SimplifyFilters)))
+
+	}
 }
 
 func cerbos_request_v1_PlaygroundEvaluateRequest_hashpb_sum(m *v12.PlaygroundEvaluateRequest, hasher hash.Hash, ignore map[string]struct{}) {
@@ -2813,6 +2918,16 @@ func cerbos_response_v1_PlanResourcesResponse_hashpb_sum(m *v13.PlanResourcesRes
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.rule_residuals"]; !ok {
+		if len(m.RuleResiduals) > 0 {
+			for _, v := range m.RuleResiduals {
+				if v != nil {
+					cerbos_engine_v1_PlanResourcesOutput_RuleResidual_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_response_v1_PlaygroundEvaluateResponse_EvalResultList_hashpb_sum(m *v13.PlaygroundEvaluateResponse_EvalResultList, hasher hash.Hash, ignore map[string]struct{}) {
@@ -3137,6 +3252,17 @@ func cerbos_schema_v1_ValidationError_hashpb_sum(m *v15.ValidationError, hasher
 	}
 }
 
+func google_protobuf_Duration_hashpb_sum(m *durationpb.Duration, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["google.protobuf.Duration.seconds"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Seconds)))
+
+	}
+	if _, ok := ignore["google.protobuf.Duration.nanos"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Nanos)))
+
+	}
+}
+
 func google_protobuf_Empty_hashpb_sum(m *emptypb.Empty, hasher hash.Hash, ignore map[string]struct{}) {
 }
 
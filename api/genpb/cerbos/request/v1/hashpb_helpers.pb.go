@@ -195,6 +195,19 @@ func cerbos_policy_v1_ExportVariables_hashpb_sum(m *v11.ExportVariables, hasher
 	}
 }
 
+func cerbos_policy_v1_Limit_hashpb_sum(m *v11.Limit, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.Limit.max_allowed"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.MaxAllowed)))
+
+	}
+	if _, ok := ignore["cerbos.policy.v1.Limit.window"]; !ok {
+		if m.Window != nil {
+			google_protobuf_Duration_hashpb_sum(m.Window, hasher, ignore)
+		}
+
+	}
+}
+
 func cerbos_policy_v1_Match_ExprList_hashpb_sum(m *v11.Match_ExprList, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.policy.v1.Match.ExprList.of"]; !ok {
 		if len(m.Of) > 0 {
@@ -271,6 +284,18 @@ func cerbos_policy_v1_Metadata_hashpb_sum(m *v11.Metadata, hasher hash.Hash, ign
 		_, _ = hasher.Write(protowire.AppendString(nil, m.StoreIdentifier))
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.Metadata.active_from"]; !ok {
+		if m.ActiveFrom != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.ActiveFrom, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.policy.v1.Metadata.active_until"]; !ok {
+		if m.ActiveUntil != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.ActiveUntil, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_policy_v1_Output_hashpb_sum(m *v11.Output, hasher hash.Hash, ignore map[string]struct{}) {
@@ -466,6 +491,16 @@ func cerbos_policy_v1_ResourcePolicy_hashpb_sum(m *v11.ResourcePolicy, hasher ha
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.ResourcePolicy.local_derived_roles"]; !ok {
+		if len(m.LocalDerivedRoles) > 0 {
+			for _, v := range m.LocalDerivedRoles {
+				if v != nil {
+					cerbos_policy_v1_RoleDef_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_policy_v1_ResourceRule_hashpb_sum(m *v11.ResourceRule, hasher hash.Hash, ignore map[string]struct{}) {
@@ -513,6 +548,12 @@ func cerbos_policy_v1_ResourceRule_hashpb_sum(m *v11.ResourceRule, hasher hash.H
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.ResourceRule.limit"]; !ok {
+		if m.Limit != nil {
+			cerbos_policy_v1_Limit_hashpb_sum(m.Limit, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_policy_v1_RoleDef_hashpb_sum(m *v11.RoleDef, hasher hash.Hash, ignore map[string]struct{}) {
@@ -754,6 +795,29 @@ func cerbos_request_v1_CheckResourceSetRequest_hashpb_sum(m *CheckResourceSetReq
 	}
 }
 
+func cerbos_request_v1_CheckResourcesAsOfRequest_hashpb_sum(m *CheckResourcesAsOfRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if m.AsOf != nil {
+		if _, ok := ignore["cerbos.request.v1.CheckResourcesAsOfRequest.as_of"]; !ok {
+			switch t := m.AsOf.(type) {
+			case *CheckResourcesAsOfRequest_Revision:
+				_, _ = hasher.Write(protowire.AppendString(nil, t.Revision))
+
+			case *CheckResourcesAsOfRequest_Time:
+				if t.Time != nil {
+					google_protobuf_Timestamp_hashpb_sum(t.Time, hasher, ignore)
+				}
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.CheckResourcesAsOfRequest.check"]; !ok {
+		if m.Check != nil {
+			cerbos_request_v1_CheckResourcesRequest_hashpb_sum(m.Check, hasher, ignore)
+		}
+
+	}
+}
+
 func cerbos_request_v1_CheckResourcesRequest_ResourceEntry_hashpb_sum(m *CheckResourcesRequest_ResourceEntry, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.request.v1.CheckResourcesRequest.ResourceEntry.actions"]; !ok {
 		if len(m.Actions) > 0 {
@@ -802,6 +866,40 @@ func cerbos_request_v1_CheckResourcesRequest_hashpb_sum(m *CheckResourcesRequest
 		}
 
 	}
+	if _, ok := ignore["cerbos.request.v1.CheckResourcesRequest.policies"]; !ok {
+		if len(m.Policies) > 0 {
+			for _, v := range m.Policies {
+				if v != nil {
+					cerbos_policy_v1_Policy_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
+func cerbos_request_v1_CheckResourcesStreamRequest_hashpb_sum(m *CheckResourcesStreamRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.CheckResourcesStreamRequest.id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.CheckResourcesStreamRequest.request"]; !ok {
+		if m.Request != nil {
+			cerbos_request_v1_CheckResourcesRequest_hashpb_sum(m.Request, hasher, ignore)
+		}
+
+	}
+}
+
+func cerbos_request_v1_DeletePolicyRequest_hashpb_sum(m *DeletePolicyRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.DeletePolicyRequest.id"]; !ok {
+		if len(m.Id) > 0 {
+			for _, v := range m.Id {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
 }
 
 func cerbos_request_v1_DeleteSchemaRequest_hashpb_sum(m *DeleteSchemaRequest, hasher hash.Hash, ignore map[string]struct{}) {
@@ -837,6 +935,9 @@ func cerbos_request_v1_EnablePolicyRequest_hashpb_sum(m *EnablePolicyRequest, ha
 	}
 }
 
+func cerbos_request_v1_ExportPolicySnapshotRequest_hashpb_sum(m *ExportPolicySnapshotRequest, hasher hash.Hash, ignore map[string]struct{}) {
+}
+
 func cerbos_request_v1_File_hashpb_sum(m *File, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.request.v1.File.file_name"]; !ok {
 		_, _ = hasher.Write(protowire.AppendString(nil, m.FileName))
@@ -859,6 +960,17 @@ func cerbos_request_v1_GetPolicyRequest_hashpb_sum(m *GetPolicyRequest, hasher h
 	}
 }
 
+func cerbos_request_v1_GetPolicyVersionRequest_hashpb_sum(m *GetPolicyVersionRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.GetPolicyVersionRequest.id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.GetPolicyVersionRequest.version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, m.Version))
+
+	}
+}
+
 func cerbos_request_v1_GetSchemaRequest_hashpb_sum(m *GetSchemaRequest, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.request.v1.GetSchemaRequest.id"]; !ok {
 		if len(m.Id) > 0 {
@@ -870,6 +982,25 @@ func cerbos_request_v1_GetSchemaRequest_hashpb_sum(m *GetSchemaRequest, hasher h
 	}
 }
 
+func cerbos_request_v1_InspectEffectiveRulesRequest_hashpb_sum(m *InspectEffectiveRulesRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.InspectEffectiveRulesRequest.resource"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Resource))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.InspectEffectiveRulesRequest.policy_version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.PolicyVersion))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.InspectEffectiveRulesRequest.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.InspectEffectiveRulesRequest.lenient_scope_search"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.LenientScopeSearch)))
+
+	}
+}
+
 func cerbos_request_v1_ListAuditLogEntriesRequest_TimeRange_hashpb_sum(m *ListAuditLogEntriesRequest_TimeRange, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.request.v1.ListAuditLogEntriesRequest.TimeRange.start"]; !ok {
 		if m.Start != nil {
@@ -914,6 +1045,9 @@ func cerbos_request_v1_ListAuditLogEntriesRequest_hashpb_sum(m *ListAuditLogEntr
 	}
 }
 
+func cerbos_request_v1_ListDeprecationsRequest_hashpb_sum(m *ListDeprecationsRequest, hasher hash.Hash, ignore map[string]struct{}) {
+}
+
 func cerbos_request_v1_ListPoliciesRequest_hashpb_sum(m *ListPoliciesRequest, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.request.v1.ListPoliciesRequest.include_disabled"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeDisabled)))
@@ -933,9 +1067,30 @@ func cerbos_request_v1_ListPoliciesRequest_hashpb_sum(m *ListPoliciesRequest, ha
 	}
 }
 
+func cerbos_request_v1_ListPolicyVersionsRequest_hashpb_sum(m *ListPolicyVersionsRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.ListPolicyVersionsRequest.id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
+
+	}
+}
+
+func cerbos_request_v1_ListResourceKindsRequest_hashpb_sum(m *ListResourceKindsRequest, hasher hash.Hash, ignore map[string]struct{}) {
+}
+
 func cerbos_request_v1_ListSchemasRequest_hashpb_sum(m *ListSchemasRequest, hasher hash.Hash, ignore map[string]struct{}) {
 }
 
+func cerbos_request_v1_ListStoreEventsRequest_hashpb_sum(m *ListStoreEventsRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.ListStoreEventsRequest.id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.ListStoreEventsRequest.limit"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Limit)))
+
+	}
+}
+
 func cerbos_request_v1_PlanResourcesRequest_hashpb_sum(m *PlanResourcesRequest, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.request_id"]; !ok {
 		_, _ = hasher.Write(protowire.AppendString(nil, m.RequestId))
@@ -967,6 +1122,80 @@ func cerbos_request_v1_PlanResourcesRequest_hashpb_sum(m *PlanResourcesRequest,
 		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeMeta)))
 
 	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.expand_conditionals"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.ExpandConditionals)))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.include_rule_residuals"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeRuleResiduals)))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.simplify_filters"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.SimplifyFilters)))
+
+	}
+}
+
+func cerbos_request_v1_PlanResourcesStreamRequest_Entry_hashpb_sum(m *PlanResourcesStreamRequest_Entry, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesStreamRequest.Entry.id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesStreamRequest.Entry.action"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Action))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesStreamRequest.Entry.principal"]; !ok {
+		if m.Principal != nil {
+			cerbos_engine_v1_Principal_hashpb_sum(m.Principal, hasher, ignore)
+		}
+
+	}
+}
+
+func cerbos_request_v1_PlanResourcesStreamRequest_hashpb_sum(m *PlanResourcesStreamRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesStreamRequest.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.RequestId))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesStreamRequest.resource"]; !ok {
+		if m.Resource != nil {
+			cerbos_engine_v1_PlanResourcesInput_Resource_hashpb_sum(m.Resource, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesStreamRequest.entries"]; !ok {
+		if len(m.Entries) > 0 {
+			for _, v := range m.Entries {
+				if v != nil {
+					cerbos_request_v1_PlanResourcesStreamRequest_Entry_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesStreamRequest.aux_data"]; !ok {
+		if m.AuxData != nil {
+			cerbos_request_v1_AuxData_hashpb_sum(m.AuxData, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesStreamRequest.include_meta"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeMeta)))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesStreamRequest.expand_conditionals"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.ExpandConditionals)))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesStreamRequest.include_rule_residuals"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeRuleResiduals)))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesStreamRequest.simplify_filters"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.SimplifyFilters)))
+
+	}
 }
 
 func cerbos_request_v1_PlaygroundEvaluateRequest_hashpb_sum(m *PlaygroundEvaluateRequest, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1130,9 +1359,78 @@ func cerbos_request_v1_ResourceSet_hashpb_sum(m *ResourceSet, hasher hash.Hash,
 	}
 }
 
+func cerbos_request_v1_RestorePolicyRequest_hashpb_sum(m *RestorePolicyRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.RestorePolicyRequest.id"]; !ok {
+		if len(m.Id) > 0 {
+			for _, v := range m.Id {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+}
+
+func cerbos_request_v1_RestoreStoreRequest_hashpb_sum(m *RestoreStoreRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.RestoreStoreRequest.key"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Key))
+
+	}
+}
+
+func cerbos_request_v1_RollbackPolicyRequest_hashpb_sum(m *RollbackPolicyRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.RollbackPolicyRequest.id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.RollbackPolicyRequest.version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, m.Version))
+
+	}
+}
+
 func cerbos_request_v1_ServerInfoRequest_hashpb_sum(m *ServerInfoRequest, hasher hash.Hash, ignore map[string]struct{}) {
 }
 
+func cerbos_request_v1_SnapshotStoreRequest_hashpb_sum(m *SnapshotStoreRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.SnapshotStoreRequest.key"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Key))
+
+	}
+}
+
+func cerbos_request_v1_WatchDecisionsRequest_hashpb_sum(m *WatchDecisionsRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.WatchDecisionsRequest.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.RequestId))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.WatchDecisionsRequest.include_meta"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeMeta)))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.WatchDecisionsRequest.principal"]; !ok {
+		if m.Principal != nil {
+			cerbos_engine_v1_Principal_hashpb_sum(m.Principal, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.request.v1.WatchDecisionsRequest.resources"]; !ok {
+		if len(m.Resources) > 0 {
+			for _, v := range m.Resources {
+				if v != nil {
+					cerbos_request_v1_CheckResourcesRequest_ResourceEntry_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.WatchDecisionsRequest.aux_data"]; !ok {
+		if m.AuxData != nil {
+			cerbos_request_v1_AuxData_hashpb_sum(m.AuxData, hasher, ignore)
+		}
+
+	}
+}
+
 func cerbos_schema_v1_Schema_hashpb_sum(m *v12.Schema, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.schema.v1.Schema.id"]; !ok {
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
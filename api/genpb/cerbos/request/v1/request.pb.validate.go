@@ -181,6 +181,12 @@ func (m *PlanResourcesRequest) validate(all bool) error {
 
 	// no validation rules for IncludeMeta
 
+	// no validation rules for ExpandConditionals
+
+	// no validation rules for IncludeRuleResiduals
+
+	// no validation rules for SimplifyFilters
+
 	if len(errors) > 0 {
 		return PlanResourcesRequestMultiError(errors)
 	}
@@ -261,6 +267,232 @@ var _ interface {
 	ErrorName() string
 } = PlanResourcesRequestValidationError{}
 
+// Validate checks the field values on PlanResourcesStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlanResourcesStreamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanResourcesStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanResourcesStreamRequestMultiError, or nil if none found.
+func (m *PlanResourcesStreamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanResourcesStreamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestId
+
+	if m.GetResource() == nil {
+		err := PlanResourcesStreamRequestValidationError{
+			field:  "Resource",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlanResourcesStreamRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlanResourcesStreamRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlanResourcesStreamRequestValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetEntries()) < 1 {
+		err := PlanResourcesStreamRequestValidationError{
+			field:  "Entries",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlanResourcesStreamRequestValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlanResourcesStreamRequestValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlanResourcesStreamRequestValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAuxData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlanResourcesStreamRequestValidationError{
+					field:  "AuxData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlanResourcesStreamRequestValidationError{
+					field:  "AuxData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuxData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlanResourcesStreamRequestValidationError{
+				field:  "AuxData",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IncludeMeta
+
+	// no validation rules for ExpandConditionals
+
+	// no validation rules for IncludeRuleResiduals
+
+	// no validation rules for SimplifyFilters
+
+	if len(errors) > 0 {
+		return PlanResourcesStreamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanResourcesStreamRequestMultiError is an error wrapping multiple
+// validation errors returned by PlanResourcesStreamRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PlanResourcesStreamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanResourcesStreamRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanResourcesStreamRequestMultiError) AllErrors() []error { return m }
+
+// PlanResourcesStreamRequestValidationError is the validation error returned
+// by PlanResourcesStreamRequest.Validate if the designated constraints aren't met.
+type PlanResourcesStreamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanResourcesStreamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanResourcesStreamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanResourcesStreamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanResourcesStreamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanResourcesStreamRequestValidationError) ErrorName() string {
+	return "PlanResourcesStreamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanResourcesStreamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanResourcesStreamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanResourcesStreamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanResourcesStreamRequestValidationError{}
+
 // Validate checks the field values on CheckResourceSetRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1250,6 +1482,40 @@ func (m *CheckResourcesRequest) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckResourcesRequestValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckResourcesRequestValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckResourcesRequestValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CheckResourcesRequestMultiError(errors)
 	}
@@ -1330,50 +1596,64 @@ var _ interface {
 	ErrorName() string
 } = CheckResourcesRequestValidationError{}
 
-// Validate checks the field values on AuxData with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AuxData) Validate() error {
+// Validate checks the field values on CheckResourcesStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckResourcesStreamRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuxData with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in AuxDataMultiError, or nil if none found.
-func (m *AuxData) ValidateAll() error {
+// ValidateAll checks the field values on CheckResourcesStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckResourcesStreamRequestMultiError, or nil if none found.
+func (m *CheckResourcesStreamRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuxData) validate(all bool) error {
+func (m *CheckResourcesStreamRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	if m.GetRequest() == nil {
+		err := CheckResourcesStreamRequestValidationError{
+			field:  "Request",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
-		switch v := interface{}(m.GetJwt()).(type) {
+		switch v := interface{}(m.GetRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AuxDataValidationError{
-					field:  "Jwt",
+				errors = append(errors, CheckResourcesStreamRequestValidationError{
+					field:  "Request",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AuxDataValidationError{
-					field:  "Jwt",
+				errors = append(errors, CheckResourcesStreamRequestValidationError{
+					field:  "Request",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetJwt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AuxDataValidationError{
-				field:  "Jwt",
+			return CheckResourcesStreamRequestValidationError{
+				field:  "Request",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1381,18 +1661,19 @@ func (m *AuxData) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AuxDataMultiError(errors)
+		return CheckResourcesStreamRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuxDataMultiError is an error wrapping multiple validation errors returned
-// by AuxData.ValidateAll() if the designated constraints aren't met.
-type AuxDataMultiError []error
+// CheckResourcesStreamRequestMultiError is an error wrapping multiple
+// validation errors returned by CheckResourcesStreamRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CheckResourcesStreamRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuxDataMultiError) Error() string {
+func (m CheckResourcesStreamRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1401,11 +1682,12 @@ func (m AuxDataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuxDataMultiError) AllErrors() []error { return m }
+func (m CheckResourcesStreamRequestMultiError) AllErrors() []error { return m }
 
-// AuxDataValidationError is the validation error returned by AuxData.Validate
-// if the designated constraints aren't met.
-type AuxDataValidationError struct {
+// CheckResourcesStreamRequestValidationError is the validation error returned
+// by CheckResourcesStreamRequest.Validate if the designated constraints
+// aren't met.
+type CheckResourcesStreamRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1413,22 +1695,24 @@ type AuxDataValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuxDataValidationError) Field() string { return e.field }
+func (e CheckResourcesStreamRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuxDataValidationError) Reason() string { return e.reason }
+func (e CheckResourcesStreamRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuxDataValidationError) Cause() error { return e.cause }
+func (e CheckResourcesStreamRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuxDataValidationError) Key() bool { return e.key }
+func (e CheckResourcesStreamRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuxDataValidationError) ErrorName() string { return "AuxDataValidationError" }
+func (e CheckResourcesStreamRequestValidationError) ErrorName() string {
+	return "CheckResourcesStreamRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AuxDataValidationError) Error() string {
+func (e CheckResourcesStreamRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1440,14 +1724,14 @@ func (e AuxDataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuxData.%s: %s%s",
+		"invalid %sCheckResourcesStreamRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuxDataValidationError{}
+var _ error = CheckResourcesStreamRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1455,33 +1739,38 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuxDataValidationError{}
+} = CheckResourcesStreamRequestValidationError{}
 
-// Validate checks the field values on File with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *File) Validate() error {
+// Validate checks the field values on WatchDecisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchDecisionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on File with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in FileMultiError, or nil if none found.
-func (m *File) ValidateAll() error {
+// ValidateAll checks the field values on WatchDecisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchDecisionsRequestMultiError, or nil if none found.
+func (m *WatchDecisionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *File) validate(all bool) error {
+func (m *WatchDecisionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetFileName()) < 1 {
-		err := FileValidationError{
-			field:  "FileName",
-			reason: "value length must be at least 1 runes",
+	// no validation rules for RequestId
+
+	// no validation rules for IncludeMeta
+
+	if m.GetPrincipal() == nil {
+		err := WatchDecisionsRequestValidationError{
+			field:  "Principal",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -1489,10 +1778,39 @@ func (m *File) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := len(m.GetContents()); l < 1 || l > 1048576 {
-		err := FileValidationError{
-			field:  "Contents",
-			reason: "value length must be between 1 and 1048576 bytes, inclusive",
+	if all {
+		switch v := interface{}(m.GetPrincipal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchDecisionsRequestValidationError{
+					field:  "Principal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchDecisionsRequestValidationError{
+					field:  "Principal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrincipal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchDecisionsRequestValidationError{
+				field:  "Principal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetResources()) < 1 {
+		err := WatchDecisionsRequestValidationError{
+			field:  "Resources",
+			reason: "value must contain at least 1 item(s)",
 		}
 		if !all {
 			return err
@@ -1500,19 +1818,83 @@ func (m *File) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchDecisionsRequestValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchDecisionsRequestValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchDecisionsRequestValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAuxData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchDecisionsRequestValidationError{
+					field:  "AuxData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchDecisionsRequestValidationError{
+					field:  "AuxData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuxData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchDecisionsRequestValidationError{
+				field:  "AuxData",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return FileMultiError(errors)
+		return WatchDecisionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// FileMultiError is an error wrapping multiple validation errors returned by
-// File.ValidateAll() if the designated constraints aren't met.
-type FileMultiError []error
+// WatchDecisionsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchDecisionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchDecisionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FileMultiError) Error() string {
+func (m WatchDecisionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1521,11 +1903,11 @@ func (m FileMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FileMultiError) AllErrors() []error { return m }
+func (m WatchDecisionsRequestMultiError) AllErrors() []error { return m }
 
-// FileValidationError is the validation error returned by File.Validate if the
-// designated constraints aren't met.
-type FileValidationError struct {
+// WatchDecisionsRequestValidationError is the validation error returned by
+// WatchDecisionsRequest.Validate if the designated constraints aren't met.
+type WatchDecisionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1533,22 +1915,24 @@ type FileValidationError struct {
 }
 
 // Field function returns field value.
-func (e FileValidationError) Field() string { return e.field }
+func (e WatchDecisionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FileValidationError) Reason() string { return e.reason }
+func (e WatchDecisionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FileValidationError) Cause() error { return e.cause }
+func (e WatchDecisionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FileValidationError) Key() bool { return e.key }
+func (e WatchDecisionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FileValidationError) ErrorName() string { return "FileValidationError" }
+func (e WatchDecisionsRequestValidationError) ErrorName() string {
+	return "WatchDecisionsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e FileValidationError) Error() string {
+func (e WatchDecisionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1560,14 +1944,14 @@ func (e FileValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFile.%s: %s%s",
+		"invalid %sWatchDecisionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FileValidationError{}
+var _ error = WatchDecisionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1575,91 +1959,71 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FileValidationError{}
+} = WatchDecisionsRequestValidationError{}
 
-// Validate checks the field values on PlaygroundValidateRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PlaygroundValidateRequest) Validate() error {
+// Validate checks the field values on AuxData with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuxData) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PlaygroundValidateRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PlaygroundValidateRequestMultiError, or nil if none found.
-func (m *PlaygroundValidateRequest) ValidateAll() error {
+// ValidateAll checks the field values on AuxData with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AuxDataMultiError, or nil if none found.
+func (m *AuxData) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PlaygroundValidateRequest) validate(all bool) error {
+func (m *AuxData) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PlaygroundId
-
-	if l := len(m.GetFiles()); l < 1 || l > 30 {
-		err := PlaygroundValidateRequestValidationError{
-			field:  "Files",
-			reason: "value must contain between 1 and 30 items, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	for idx, item := range m.GetFiles() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundValidateRequestValidationError{
-						field:  fmt.Sprintf("Files[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundValidateRequestValidationError{
-						field:  fmt.Sprintf("Files[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetJwt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuxDataValidationError{
+					field:  "Jwt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return PlaygroundValidateRequestValidationError{
-					field:  fmt.Sprintf("Files[%v]", idx),
+				errors = append(errors, AuxDataValidationError{
+					field:  "Jwt",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJwt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuxDataValidationError{
+				field:  "Jwt",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return PlaygroundValidateRequestMultiError(errors)
+		return AuxDataMultiError(errors)
 	}
 
 	return nil
 }
 
-// PlaygroundValidateRequestMultiError is an error wrapping multiple validation
-// errors returned by PlaygroundValidateRequest.ValidateAll() if the
-// designated constraints aren't met.
-type PlaygroundValidateRequestMultiError []error
+// AuxDataMultiError is an error wrapping multiple validation errors returned
+// by AuxData.ValidateAll() if the designated constraints aren't met.
+type AuxDataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PlaygroundValidateRequestMultiError) Error() string {
+func (m AuxDataMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1668,11 +2032,11 @@ func (m PlaygroundValidateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PlaygroundValidateRequestMultiError) AllErrors() []error { return m }
+func (m AuxDataMultiError) AllErrors() []error { return m }
 
-// PlaygroundValidateRequestValidationError is the validation error returned by
-// PlaygroundValidateRequest.Validate if the designated constraints aren't met.
-type PlaygroundValidateRequestValidationError struct {
+// AuxDataValidationError is the validation error returned by AuxData.Validate
+// if the designated constraints aren't met.
+type AuxDataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1680,24 +2044,22 @@ type PlaygroundValidateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PlaygroundValidateRequestValidationError) Field() string { return e.field }
+func (e AuxDataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PlaygroundValidateRequestValidationError) Reason() string { return e.reason }
+func (e AuxDataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PlaygroundValidateRequestValidationError) Cause() error { return e.cause }
+func (e AuxDataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PlaygroundValidateRequestValidationError) Key() bool { return e.key }
+func (e AuxDataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PlaygroundValidateRequestValidationError) ErrorName() string {
-	return "PlaygroundValidateRequestValidationError"
-}
+func (e AuxDataValidationError) ErrorName() string { return "AuxDataValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PlaygroundValidateRequestValidationError) Error() string {
+func (e AuxDataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1709,14 +2071,14 @@ func (e PlaygroundValidateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPlaygroundValidateRequest.%s: %s%s",
+		"invalid %sAuxData.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PlaygroundValidateRequestValidationError{}
+var _ error = AuxDataValidationError{}
 
 var _ interface {
 	Field() string
@@ -1724,24 +2086,144 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PlaygroundValidateRequestValidationError{}
+} = AuxDataValidationError{}
 
-// Validate checks the field values on PlaygroundTestRequest with the rules
+// Validate checks the field values on File with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *File) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on File with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in FileMultiError, or nil if none found.
+func (m *File) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *File) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFileName()) < 1 {
+		err := FileValidationError{
+			field:  "FileName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetContents()); l < 1 || l > 1048576 {
+		err := FileValidationError{
+			field:  "Contents",
+			reason: "value length must be between 1 and 1048576 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FileMultiError(errors)
+	}
+
+	return nil
+}
+
+// FileMultiError is an error wrapping multiple validation errors returned by
+// File.ValidateAll() if the designated constraints aren't met.
+type FileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FileMultiError) AllErrors() []error { return m }
+
+// FileValidationError is the validation error returned by File.Validate if the
+// designated constraints aren't met.
+type FileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileValidationError) ErrorName() string { return "FileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileValidationError{}
+
+// Validate checks the field values on PlaygroundValidateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PlaygroundTestRequest) Validate() error {
+func (m *PlaygroundValidateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PlaygroundTestRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PlaygroundValidateRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PlaygroundTestRequestMultiError, or nil if none found.
-func (m *PlaygroundTestRequest) ValidateAll() error {
+// PlaygroundValidateRequestMultiError, or nil if none found.
+func (m *PlaygroundValidateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PlaygroundTestRequest) validate(all bool) error {
+func (m *PlaygroundValidateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1751,7 +2233,7 @@ func (m *PlaygroundTestRequest) validate(all bool) error {
 	// no validation rules for PlaygroundId
 
 	if l := len(m.GetFiles()); l < 1 || l > 30 {
-		err := PlaygroundTestRequestValidationError{
+		err := PlaygroundValidateRequestValidationError{
 			field:  "Files",
 			reason: "value must contain between 1 and 30 items, inclusive",
 		}
@@ -1768,7 +2250,7 @@ func (m *PlaygroundTestRequest) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundTestRequestValidationError{
+					errors = append(errors, PlaygroundValidateRequestValidationError{
 						field:  fmt.Sprintf("Files[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1776,7 +2258,7 @@ func (m *PlaygroundTestRequest) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundTestRequestValidationError{
+					errors = append(errors, PlaygroundValidateRequestValidationError{
 						field:  fmt.Sprintf("Files[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1785,7 +2267,7 @@ func (m *PlaygroundTestRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PlaygroundTestRequestValidationError{
+				return PlaygroundValidateRequestValidationError{
 					field:  fmt.Sprintf("Files[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1796,19 +2278,19 @@ func (m *PlaygroundTestRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PlaygroundTestRequestMultiError(errors)
+		return PlaygroundValidateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PlaygroundTestRequestMultiError is an error wrapping multiple validation
-// errors returned by PlaygroundTestRequest.ValidateAll() if the designated
-// constraints aren't met.
-type PlaygroundTestRequestMultiError []error
+// PlaygroundValidateRequestMultiError is an error wrapping multiple validation
+// errors returned by PlaygroundValidateRequest.ValidateAll() if the
+// designated constraints aren't met.
+type PlaygroundValidateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PlaygroundTestRequestMultiError) Error() string {
+func (m PlaygroundValidateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1817,11 +2299,11 @@ func (m PlaygroundTestRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PlaygroundTestRequestMultiError) AllErrors() []error { return m }
+func (m PlaygroundValidateRequestMultiError) AllErrors() []error { return m }
 
-// PlaygroundTestRequestValidationError is the validation error returned by
-// PlaygroundTestRequest.Validate if the designated constraints aren't met.
-type PlaygroundTestRequestValidationError struct {
+// PlaygroundValidateRequestValidationError is the validation error returned by
+// PlaygroundValidateRequest.Validate if the designated constraints aren't met.
+type PlaygroundValidateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1829,24 +2311,24 @@ type PlaygroundTestRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PlaygroundTestRequestValidationError) Field() string { return e.field }
+func (e PlaygroundValidateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PlaygroundTestRequestValidationError) Reason() string { return e.reason }
+func (e PlaygroundValidateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PlaygroundTestRequestValidationError) Cause() error { return e.cause }
+func (e PlaygroundValidateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PlaygroundTestRequestValidationError) Key() bool { return e.key }
+func (e PlaygroundValidateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PlaygroundTestRequestValidationError) ErrorName() string {
-	return "PlaygroundTestRequestValidationError"
+func (e PlaygroundValidateRequestValidationError) ErrorName() string {
+	return "PlaygroundValidateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PlaygroundTestRequestValidationError) Error() string {
+func (e PlaygroundValidateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1858,14 +2340,14 @@ func (e PlaygroundTestRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPlaygroundTestRequest.%s: %s%s",
+		"invalid %sPlaygroundValidateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PlaygroundTestRequestValidationError{}
+var _ error = PlaygroundValidateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1873,24 +2355,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PlaygroundTestRequestValidationError{}
+} = PlaygroundValidateRequestValidationError{}
 
-// Validate checks the field values on PlaygroundEvaluateRequest with the rules
+// Validate checks the field values on PlaygroundTestRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PlaygroundEvaluateRequest) Validate() error {
+func (m *PlaygroundTestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PlaygroundEvaluateRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PlaygroundTestRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PlaygroundEvaluateRequestMultiError, or nil if none found.
-func (m *PlaygroundEvaluateRequest) ValidateAll() error {
+// PlaygroundTestRequestMultiError, or nil if none found.
+func (m *PlaygroundTestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PlaygroundEvaluateRequest) validate(all bool) error {
+func (m *PlaygroundTestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1900,7 +2382,7 @@ func (m *PlaygroundEvaluateRequest) validate(all bool) error {
 	// no validation rules for PlaygroundId
 
 	if l := len(m.GetFiles()); l < 1 || l > 30 {
-		err := PlaygroundEvaluateRequestValidationError{
+		err := PlaygroundTestRequestValidationError{
 			field:  "Files",
 			reason: "value must contain between 1 and 30 items, inclusive",
 		}
@@ -1917,7 +2399,7 @@ func (m *PlaygroundEvaluateRequest) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundEvaluateRequestValidationError{
+					errors = append(errors, PlaygroundTestRequestValidationError{
 						field:  fmt.Sprintf("Files[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1925,7 +2407,7 @@ func (m *PlaygroundEvaluateRequest) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundEvaluateRequestValidationError{
+					errors = append(errors, PlaygroundTestRequestValidationError{
 						field:  fmt.Sprintf("Files[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1934,7 +2416,7 @@ func (m *PlaygroundEvaluateRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PlaygroundEvaluateRequestValidationError{
+				return PlaygroundTestRequestValidationError{
 					field:  fmt.Sprintf("Files[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1944,48 +2426,197 @@ func (m *PlaygroundEvaluateRequest) validate(all bool) error {
 
 	}
 
-	if m.GetPrincipal() == nil {
-		err := PlaygroundEvaluateRequestValidationError{
-			field:  "Principal",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if len(errors) > 0 {
+		return PlaygroundTestRequestMultiError(errors)
 	}
 
-	if all {
-		switch v := interface{}(m.GetPrincipal()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PlaygroundEvaluateRequestValidationError{
-					field:  "Principal",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PlaygroundEvaluateRequestValidationError{
-					field:  "Principal",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPrincipal()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PlaygroundEvaluateRequestValidationError{
-				field:  "Principal",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	return nil
+}
 
-	if m.GetResource() == nil {
-		err := PlaygroundEvaluateRequestValidationError{
+// PlaygroundTestRequestMultiError is an error wrapping multiple validation
+// errors returned by PlaygroundTestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PlaygroundTestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaygroundTestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaygroundTestRequestMultiError) AllErrors() []error { return m }
+
+// PlaygroundTestRequestValidationError is the validation error returned by
+// PlaygroundTestRequest.Validate if the designated constraints aren't met.
+type PlaygroundTestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaygroundTestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaygroundTestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaygroundTestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaygroundTestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaygroundTestRequestValidationError) ErrorName() string {
+	return "PlaygroundTestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlaygroundTestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaygroundTestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaygroundTestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaygroundTestRequestValidationError{}
+
+// Validate checks the field values on PlaygroundEvaluateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlaygroundEvaluateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlaygroundEvaluateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlaygroundEvaluateRequestMultiError, or nil if none found.
+func (m *PlaygroundEvaluateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlaygroundEvaluateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlaygroundId
+
+	if l := len(m.GetFiles()); l < 1 || l > 30 {
+		err := PlaygroundEvaluateRequestValidationError{
+			field:  "Files",
+			reason: "value must contain between 1 and 30 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlaygroundEvaluateRequestValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlaygroundEvaluateRequestValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlaygroundEvaluateRequestValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetPrincipal() == nil {
+		err := PlaygroundEvaluateRequestValidationError{
+			field:  "Principal",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrincipal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlaygroundEvaluateRequestValidationError{
+					field:  "Principal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlaygroundEvaluateRequestValidationError{
+					field:  "Principal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrincipal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlaygroundEvaluateRequestValidationError{
+				field:  "Principal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetResource() == nil {
+		err := PlaygroundEvaluateRequestValidationError{
 			field:  "Resource",
 			reason: "value is required",
 		}
@@ -3032,43 +3663,2086 @@ func (m *ListPoliciesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPoliciesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListPoliciesRequestMultiError, or nil if none found.
-func (m *ListPoliciesRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListPoliciesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPoliciesRequestMultiError, or nil if none found.
+func (m *ListPoliciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPoliciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeDisabled
+
+	// no validation rules for NameRegexp
+
+	// no validation rules for ScopeRegexp
+
+	// no validation rules for VersionRegexp
+
+	if len(errors) > 0 {
+		return ListPoliciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPoliciesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPoliciesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPoliciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPoliciesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPoliciesRequestMultiError) AllErrors() []error { return m }
+
+// ListPoliciesRequestValidationError is the validation error returned by
+// ListPoliciesRequest.Validate if the designated constraints aren't met.
+type ListPoliciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPoliciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPoliciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPoliciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPoliciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPoliciesRequestValidationError) ErrorName() string {
+	return "ListPoliciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPoliciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPoliciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPoliciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPoliciesRequestValidationError{}
+
+// Validate checks the field values on GetPolicyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPolicyRequestMultiError, or nil if none found.
+func (m *GetPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetId()); l < 1 || l > 25 {
+		err := GetPolicyRequestValidationError{
+			field:  "Id",
+			reason: "value must contain between 1 and 25 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_GetPolicyRequest_Id_Unique := make(map[string]struct{}, len(m.GetId()))
+
+	for idx, item := range m.GetId() {
+		_, _ = idx, item
+
+		if _, exists := _GetPolicyRequest_Id_Unique[item]; exists {
+			err := GetPolicyRequestValidationError{
+				field:  fmt.Sprintf("Id[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_GetPolicyRequest_Id_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 1280 {
+			err := GetPolicyRequestValidationError{
+				field:  fmt.Sprintf("Id[%v]", idx),
+				reason: "value length must be between 1 and 1280 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPolicyRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPolicyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPolicyRequestMultiError) AllErrors() []error { return m }
+
+// GetPolicyRequestValidationError is the validation error returned by
+// GetPolicyRequest.Validate if the designated constraints aren't met.
+type GetPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPolicyRequestValidationError) ErrorName() string { return "GetPolicyRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPolicyRequestValidationError{}
+
+// Validate checks the field values on DisablePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisablePolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisablePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisablePolicyRequestMultiError, or nil if none found.
+func (m *DisablePolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisablePolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetId()); l < 1 || l > 25 {
+		err := DisablePolicyRequestValidationError{
+			field:  "Id",
+			reason: "value must contain between 1 and 25 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_DisablePolicyRequest_Id_Unique := make(map[string]struct{}, len(m.GetId()))
+
+	for idx, item := range m.GetId() {
+		_, _ = idx, item
+
+		if _, exists := _DisablePolicyRequest_Id_Unique[item]; exists {
+			err := DisablePolicyRequestValidationError{
+				field:  fmt.Sprintf("Id[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_DisablePolicyRequest_Id_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 1280 {
+			err := DisablePolicyRequestValidationError{
+				field:  fmt.Sprintf("Id[%v]", idx),
+				reason: "value length must be between 1 and 1280 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DisablePolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisablePolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by DisablePolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DisablePolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisablePolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisablePolicyRequestMultiError) AllErrors() []error { return m }
+
+// DisablePolicyRequestValidationError is the validation error returned by
+// DisablePolicyRequest.Validate if the designated constraints aren't met.
+type DisablePolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisablePolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisablePolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisablePolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisablePolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisablePolicyRequestValidationError) ErrorName() string {
+	return "DisablePolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisablePolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisablePolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisablePolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisablePolicyRequestValidationError{}
+
+// Validate checks the field values on EnablePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnablePolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnablePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnablePolicyRequestMultiError, or nil if none found.
+func (m *EnablePolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnablePolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetId()); l < 1 || l > 25 {
+		err := EnablePolicyRequestValidationError{
+			field:  "Id",
+			reason: "value must contain between 1 and 25 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_EnablePolicyRequest_Id_Unique := make(map[string]struct{}, len(m.GetId()))
+
+	for idx, item := range m.GetId() {
+		_, _ = idx, item
+
+		if _, exists := _EnablePolicyRequest_Id_Unique[item]; exists {
+			err := EnablePolicyRequestValidationError{
+				field:  fmt.Sprintf("Id[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_EnablePolicyRequest_Id_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 1280 {
+			err := EnablePolicyRequestValidationError{
+				field:  fmt.Sprintf("Id[%v]", idx),
+				reason: "value length must be between 1 and 1280 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EnablePolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnablePolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by EnablePolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EnablePolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnablePolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnablePolicyRequestMultiError) AllErrors() []error { return m }
+
+// EnablePolicyRequestValidationError is the validation error returned by
+// EnablePolicyRequest.Validate if the designated constraints aren't met.
+type EnablePolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnablePolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnablePolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnablePolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnablePolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnablePolicyRequestValidationError) ErrorName() string {
+	return "EnablePolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnablePolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnablePolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnablePolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnablePolicyRequestValidationError{}
+
+// Validate checks the field values on AddOrUpdateSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddOrUpdateSchemaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddOrUpdateSchemaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddOrUpdateSchemaRequestMultiError, or nil if none found.
+func (m *AddOrUpdateSchemaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddOrUpdateSchemaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetSchemas()); l < 1 || l > 10 {
+		err := AddOrUpdateSchemaRequestValidationError{
+			field:  "Schemas",
+			reason: "value must contain between 1 and 10 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSchemas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddOrUpdateSchemaRequestValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddOrUpdateSchemaRequestValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddOrUpdateSchemaRequestValidationError{
+					field:  fmt.Sprintf("Schemas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddOrUpdateSchemaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddOrUpdateSchemaRequestMultiError is an error wrapping multiple validation
+// errors returned by AddOrUpdateSchemaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddOrUpdateSchemaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddOrUpdateSchemaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddOrUpdateSchemaRequestMultiError) AllErrors() []error { return m }
+
+// AddOrUpdateSchemaRequestValidationError is the validation error returned by
+// AddOrUpdateSchemaRequest.Validate if the designated constraints aren't met.
+type AddOrUpdateSchemaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddOrUpdateSchemaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddOrUpdateSchemaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddOrUpdateSchemaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddOrUpdateSchemaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddOrUpdateSchemaRequestValidationError) ErrorName() string {
+	return "AddOrUpdateSchemaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddOrUpdateSchemaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddOrUpdateSchemaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddOrUpdateSchemaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddOrUpdateSchemaRequestValidationError{}
+
+// Validate checks the field values on ListSchemasRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSchemasRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSchemasRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSchemasRequestMultiError, or nil if none found.
+func (m *ListSchemasRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSchemasRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListSchemasRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSchemasRequestMultiError is an error wrapping multiple validation errors
+// returned by ListSchemasRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListSchemasRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSchemasRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSchemasRequestMultiError) AllErrors() []error { return m }
+
+// ListSchemasRequestValidationError is the validation error returned by
+// ListSchemasRequest.Validate if the designated constraints aren't met.
+type ListSchemasRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSchemasRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSchemasRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSchemasRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSchemasRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSchemasRequestValidationError) ErrorName() string {
+	return "ListSchemasRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSchemasRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSchemasRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSchemasRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSchemasRequestValidationError{}
+
+// Validate checks the field values on GetSchemaRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSchemaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSchemaRequestMultiError, or nil if none found.
+func (m *GetSchemaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSchemaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetId()); l < 1 || l > 25 {
+		err := GetSchemaRequestValidationError{
+			field:  "Id",
+			reason: "value must contain between 1 and 25 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_GetSchemaRequest_Id_Unique := make(map[string]struct{}, len(m.GetId()))
+
+	for idx, item := range m.GetId() {
+		_, _ = idx, item
+
+		if _, exists := _GetSchemaRequest_Id_Unique[item]; exists {
+			err := GetSchemaRequestValidationError{
+				field:  fmt.Sprintf("Id[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_GetSchemaRequest_Id_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 255 {
+			err := GetSchemaRequestValidationError{
+				field:  fmt.Sprintf("Id[%v]", idx),
+				reason: "value length must be between 1 and 255 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSchemaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSchemaRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSchemaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSchemaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSchemaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSchemaRequestMultiError) AllErrors() []error { return m }
+
+// GetSchemaRequestValidationError is the validation error returned by
+// GetSchemaRequest.Validate if the designated constraints aren't met.
+type GetSchemaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSchemaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSchemaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSchemaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSchemaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSchemaRequestValidationError) ErrorName() string { return "GetSchemaRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetSchemaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSchemaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSchemaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSchemaRequestValidationError{}
+
+// Validate checks the field values on DeleteSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSchemaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSchemaRequestMultiError, or nil if none found.
+func (m *DeleteSchemaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSchemaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetId()); l < 1 || l > 25 {
+		err := DeleteSchemaRequestValidationError{
+			field:  "Id",
+			reason: "value must contain between 1 and 25 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_DeleteSchemaRequest_Id_Unique := make(map[string]struct{}, len(m.GetId()))
+
+	for idx, item := range m.GetId() {
+		_, _ = idx, item
+
+		if _, exists := _DeleteSchemaRequest_Id_Unique[item]; exists {
+			err := DeleteSchemaRequestValidationError{
+				field:  fmt.Sprintf("Id[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_DeleteSchemaRequest_Id_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 255 {
+			err := DeleteSchemaRequestValidationError{
+				field:  fmt.Sprintf("Id[%v]", idx),
+				reason: "value length must be between 1 and 255 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DeleteSchemaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSchemaRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSchemaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSchemaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSchemaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSchemaRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSchemaRequestValidationError is the validation error returned by
+// DeleteSchemaRequest.Validate if the designated constraints aren't met.
+type DeleteSchemaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSchemaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSchemaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSchemaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSchemaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSchemaRequestValidationError) ErrorName() string {
+	return "DeleteSchemaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSchemaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSchemaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSchemaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSchemaRequestValidationError{}
+
+// Validate checks the field values on ReloadStoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReloadStoreRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReloadStoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReloadStoreRequestMultiError, or nil if none found.
+func (m *ReloadStoreRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReloadStoreRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Wait
+
+	if len(errors) > 0 {
+		return ReloadStoreRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReloadStoreRequestMultiError is an error wrapping multiple validation errors
+// returned by ReloadStoreRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReloadStoreRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReloadStoreRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReloadStoreRequestMultiError) AllErrors() []error { return m }
+
+// ReloadStoreRequestValidationError is the validation error returned by
+// ReloadStoreRequest.Validate if the designated constraints aren't met.
+type ReloadStoreRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReloadStoreRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReloadStoreRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReloadStoreRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReloadStoreRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReloadStoreRequestValidationError) ErrorName() string {
+	return "ReloadStoreRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReloadStoreRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReloadStoreRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReloadStoreRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReloadStoreRequestValidationError{}
+
+// Validate checks the field values on ExportPolicySnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportPolicySnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportPolicySnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportPolicySnapshotRequestMultiError, or nil if none found.
+func (m *ExportPolicySnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportPolicySnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ExportPolicySnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportPolicySnapshotRequestMultiError is an error wrapping multiple
+// validation errors returned by ExportPolicySnapshotRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ExportPolicySnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportPolicySnapshotRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportPolicySnapshotRequestMultiError) AllErrors() []error { return m }
+
+// ExportPolicySnapshotRequestValidationError is the validation error returned
+// by ExportPolicySnapshotRequest.Validate if the designated constraints
+// aren't met.
+type ExportPolicySnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportPolicySnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportPolicySnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportPolicySnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportPolicySnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportPolicySnapshotRequestValidationError) ErrorName() string {
+	return "ExportPolicySnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportPolicySnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportPolicySnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportPolicySnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportPolicySnapshotRequestValidationError{}
+
+// Validate checks the field values on CheckResourcesAsOfRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckResourcesAsOfRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckResourcesAsOfRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckResourcesAsOfRequestMultiError, or nil if none found.
+func (m *CheckResourcesAsOfRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckResourcesAsOfRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCheck() == nil {
+		err := CheckResourcesAsOfRequestValidationError{
+			field:  "Check",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCheck()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckResourcesAsOfRequestValidationError{
+					field:  "Check",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckResourcesAsOfRequestValidationError{
+					field:  "Check",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCheck()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckResourcesAsOfRequestValidationError{
+				field:  "Check",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	oneofAsOfPresent := false
+	switch v := m.AsOf.(type) {
+	case *CheckResourcesAsOfRequest_Revision:
+		if v == nil {
+			err := CheckResourcesAsOfRequestValidationError{
+				field:  "AsOf",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofAsOfPresent = true
+
+		if utf8.RuneCountInString(m.GetRevision()) < 1 {
+			err := CheckResourcesAsOfRequestValidationError{
+				field:  "Revision",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *CheckResourcesAsOfRequest_Time:
+		if v == nil {
+			err := CheckResourcesAsOfRequestValidationError{
+				field:  "AsOf",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofAsOfPresent = true
+
+		if t := m.GetTime(); t != nil {
+			ts, err := t.AsTime(), t.CheckValid()
+			if err != nil {
+				err = CheckResourcesAsOfRequestValidationError{
+					field:  "Time",
+					reason: "value is not a valid timestamp",
+					cause:  err,
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			} else {
+
+				now := time.Now()
+
+				if ts.Sub(now) >= 0 {
+					err := CheckResourcesAsOfRequestValidationError{
+						field:  "Time",
+						reason: "value must be less than now",
+					}
+					if !all {
+						return err
+					}
+					errors = append(errors, err)
+				}
+
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofAsOfPresent {
+		err := CheckResourcesAsOfRequestValidationError{
+			field:  "AsOf",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CheckResourcesAsOfRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckResourcesAsOfRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckResourcesAsOfRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CheckResourcesAsOfRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckResourcesAsOfRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckResourcesAsOfRequestMultiError) AllErrors() []error { return m }
+
+// CheckResourcesAsOfRequestValidationError is the validation error returned by
+// CheckResourcesAsOfRequest.Validate if the designated constraints aren't met.
+type CheckResourcesAsOfRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckResourcesAsOfRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckResourcesAsOfRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckResourcesAsOfRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckResourcesAsOfRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckResourcesAsOfRequestValidationError) ErrorName() string {
+	return "CheckResourcesAsOfRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckResourcesAsOfRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckResourcesAsOfRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckResourcesAsOfRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckResourcesAsOfRequestValidationError{}
+
+// Validate checks the field values on PlanResourcesStreamRequest_Entry with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PlanResourcesStreamRequest_Entry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanResourcesStreamRequest_Entry with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PlanResourcesStreamRequest_EntryMultiError, or nil if none found.
+func (m *PlanResourcesStreamRequest_Entry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanResourcesStreamRequest_Entry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetAction()) < 1 {
+		err := PlanResourcesStreamRequest_EntryValidationError{
+			field:  "Action",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrincipal() == nil {
+		err := PlanResourcesStreamRequest_EntryValidationError{
+			field:  "Principal",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrincipal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlanResourcesStreamRequest_EntryValidationError{
+					field:  "Principal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlanResourcesStreamRequest_EntryValidationError{
+					field:  "Principal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrincipal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlanResourcesStreamRequest_EntryValidationError{
+				field:  "Principal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PlanResourcesStreamRequest_EntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanResourcesStreamRequest_EntryMultiError is an error wrapping multiple
+// validation errors returned by
+// PlanResourcesStreamRequest_Entry.ValidateAll() if the designated
+// constraints aren't met.
+type PlanResourcesStreamRequest_EntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanResourcesStreamRequest_EntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanResourcesStreamRequest_EntryMultiError) AllErrors() []error { return m }
+
+// PlanResourcesStreamRequest_EntryValidationError is the validation error
+// returned by PlanResourcesStreamRequest_Entry.Validate if the designated
+// constraints aren't met.
+type PlanResourcesStreamRequest_EntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanResourcesStreamRequest_EntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanResourcesStreamRequest_EntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanResourcesStreamRequest_EntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanResourcesStreamRequest_EntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanResourcesStreamRequest_EntryValidationError) ErrorName() string {
+	return "PlanResourcesStreamRequest_EntryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanResourcesStreamRequest_EntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanResourcesStreamRequest_Entry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanResourcesStreamRequest_EntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanResourcesStreamRequest_EntryValidationError{}
+
+// Validate checks the field values on CheckResourceBatchRequest_BatchEntry
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CheckResourceBatchRequest_BatchEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckResourceBatchRequest_BatchEntry
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CheckResourceBatchRequest_BatchEntryMultiError, or nil if none found.
+func (m *CheckResourceBatchRequest_BatchEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckResourceBatchRequest_BatchEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetActions()) < 1 {
+		err := CheckResourceBatchRequest_BatchEntryValidationError{
+			field:  "Actions",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CheckResourceBatchRequest_BatchEntry_Actions_Unique := make(map[string]struct{}, len(m.GetActions()))
+
+	for idx, item := range m.GetActions() {
+		_, _ = idx, item
+
+		if _, exists := _CheckResourceBatchRequest_BatchEntry_Actions_Unique[item]; exists {
+			err := CheckResourceBatchRequest_BatchEntryValidationError{
+				field:  fmt.Sprintf("Actions[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CheckResourceBatchRequest_BatchEntry_Actions_Unique[item] = struct{}{}
+		}
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := CheckResourceBatchRequest_BatchEntryValidationError{
+				field:  fmt.Sprintf("Actions[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetResource() == nil {
+		err := CheckResourceBatchRequest_BatchEntryValidationError{
+			field:  "Resource",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckResourceBatchRequest_BatchEntryValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckResourceBatchRequest_BatchEntryValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckResourceBatchRequest_BatchEntryValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CheckResourceBatchRequest_BatchEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckResourceBatchRequest_BatchEntryMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckResourceBatchRequest_BatchEntry.ValidateAll() if the designated
+// constraints aren't met.
+type CheckResourceBatchRequest_BatchEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckResourceBatchRequest_BatchEntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckResourceBatchRequest_BatchEntryMultiError) AllErrors() []error { return m }
+
+// CheckResourceBatchRequest_BatchEntryValidationError is the validation error
+// returned by CheckResourceBatchRequest_BatchEntry.Validate if the designated
+// constraints aren't met.
+type CheckResourceBatchRequest_BatchEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckResourceBatchRequest_BatchEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckResourceBatchRequest_BatchEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckResourceBatchRequest_BatchEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckResourceBatchRequest_BatchEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckResourceBatchRequest_BatchEntryValidationError) ErrorName() string {
+	return "CheckResourceBatchRequest_BatchEntryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckResourceBatchRequest_BatchEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckResourceBatchRequest_BatchEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckResourceBatchRequest_BatchEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckResourceBatchRequest_BatchEntryValidationError{}
+
+// Validate checks the field values on CheckResourcesRequest_ResourceEntry with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CheckResourcesRequest_ResourceEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckResourcesRequest_ResourceEntry
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CheckResourcesRequest_ResourceEntryMultiError, or nil if none found.
+func (m *CheckResourcesRequest_ResourceEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckResourcesRequest_ResourceEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetActions()) < 1 {
+		err := CheckResourcesRequest_ResourceEntryValidationError{
+			field:  "Actions",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CheckResourcesRequest_ResourceEntry_Actions_Unique := make(map[string]struct{}, len(m.GetActions()))
+
+	for idx, item := range m.GetActions() {
+		_, _ = idx, item
+
+		if _, exists := _CheckResourcesRequest_ResourceEntry_Actions_Unique[item]; exists {
+			err := CheckResourcesRequest_ResourceEntryValidationError{
+				field:  fmt.Sprintf("Actions[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CheckResourcesRequest_ResourceEntry_Actions_Unique[item] = struct{}{}
+		}
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := CheckResourcesRequest_ResourceEntryValidationError{
+				field:  fmt.Sprintf("Actions[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetResource() == nil {
+		err := CheckResourcesRequest_ResourceEntryValidationError{
+			field:  "Resource",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckResourcesRequest_ResourceEntryValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckResourcesRequest_ResourceEntryValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckResourcesRequest_ResourceEntryValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CheckResourcesRequest_ResourceEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckResourcesRequest_ResourceEntryMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckResourcesRequest_ResourceEntry.ValidateAll() if the designated
+// constraints aren't met.
+type CheckResourcesRequest_ResourceEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckResourcesRequest_ResourceEntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckResourcesRequest_ResourceEntryMultiError) AllErrors() []error { return m }
+
+// CheckResourcesRequest_ResourceEntryValidationError is the validation error
+// returned by CheckResourcesRequest_ResourceEntry.Validate if the designated
+// constraints aren't met.
+type CheckResourcesRequest_ResourceEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckResourcesRequest_ResourceEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckResourcesRequest_ResourceEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckResourcesRequest_ResourceEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckResourcesRequest_ResourceEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckResourcesRequest_ResourceEntryValidationError) ErrorName() string {
+	return "CheckResourcesRequest_ResourceEntryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckResourcesRequest_ResourceEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckResourcesRequest_ResourceEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckResourcesRequest_ResourceEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckResourcesRequest_ResourceEntryValidationError{}
+
+// Validate checks the field values on AuxData_JWT with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuxData_JWT) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuxData_JWT with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuxData_JWTMultiError, or
+// nil if none found.
+func (m *AuxData_JWT) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPoliciesRequest) validate(all bool) error {
+func (m *AuxData_JWT) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IncludeDisabled
-
-	// no validation rules for NameRegexp
-
-	// no validation rules for ScopeRegexp
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := AuxData_JWTValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for VersionRegexp
+	// no validation rules for KeySetId
 
 	if len(errors) > 0 {
-		return ListPoliciesRequestMultiError(errors)
+		return AuxData_JWTMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPoliciesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListPoliciesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListPoliciesRequestMultiError []error
+// AuxData_JWTMultiError is an error wrapping multiple validation errors
+// returned by AuxData_JWT.ValidateAll() if the designated constraints aren't met.
+type AuxData_JWTMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPoliciesRequestMultiError) Error() string {
+func (m AuxData_JWTMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3077,11 +5751,11 @@ func (m ListPoliciesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPoliciesRequestMultiError) AllErrors() []error { return m }
+func (m AuxData_JWTMultiError) AllErrors() []error { return m }
 
-// ListPoliciesRequestValidationError is the validation error returned by
-// ListPoliciesRequest.Validate if the designated constraints aren't met.
-type ListPoliciesRequestValidationError struct {
+// AuxData_JWTValidationError is the validation error returned by
+// AuxData_JWT.Validate if the designated constraints aren't met.
+type AuxData_JWTValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3089,24 +5763,22 @@ type ListPoliciesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPoliciesRequestValidationError) Field() string { return e.field }
+func (e AuxData_JWTValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPoliciesRequestValidationError) Reason() string { return e.reason }
+func (e AuxData_JWTValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPoliciesRequestValidationError) Cause() error { return e.cause }
+func (e AuxData_JWTValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPoliciesRequestValidationError) Key() bool { return e.key }
+func (e AuxData_JWTValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPoliciesRequestValidationError) ErrorName() string {
-	return "ListPoliciesRequestValidationError"
-}
+func (e AuxData_JWTValidationError) ErrorName() string { return "AuxData_JWTValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListPoliciesRequestValidationError) Error() string {
+func (e AuxData_JWTValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3118,14 +5790,14 @@ func (e ListPoliciesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPoliciesRequest.%s: %s%s",
+		"invalid %sAuxData_JWT.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPoliciesRequestValidationError{}
+var _ error = AuxData_JWTValidationError{}
 
 var _ interface {
 	Field() string
@@ -3133,34 +5805,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPoliciesRequestValidationError{}
+} = AuxData_JWTValidationError{}
 
-// Validate checks the field values on GetPolicyRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetPolicyRequest) Validate() error {
+// Validate checks the field values on ListAuditLogEntriesRequest_TimeRange
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListAuditLogEntriesRequest_TimeRange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPolicyRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetPolicyRequestMultiError, or nil if none found.
-func (m *GetPolicyRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListAuditLogEntriesRequest_TimeRange
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListAuditLogEntriesRequest_TimeRangeMultiError, or nil if none found.
+func (m *ListAuditLogEntriesRequest_TimeRange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPolicyRequest) validate(all bool) error {
+func (m *ListAuditLogEntriesRequest_TimeRange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := len(m.GetId()); l < 1 || l > 25 {
-		err := GetPolicyRequestValidationError{
-			field:  "Id",
-			reason: "value must contain between 1 and 25 items, inclusive",
+	if m.GetStart() == nil {
+		err := ListAuditLogEntriesRequest_TimeRangeValidationError{
+			field:  "Start",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -3168,51 +5841,92 @@ func (m *GetPolicyRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	_GetPolicyRequest_Id_Unique := make(map[string]struct{}, len(m.GetId()))
-
-	for idx, item := range m.GetId() {
-		_, _ = idx, item
-
-		if _, exists := _GetPolicyRequest_Id_Unique[item]; exists {
-			err := GetPolicyRequestValidationError{
-				field:  fmt.Sprintf("Id[%v]", idx),
-				reason: "repeated value must contain unique items",
+	if t := m.GetStart(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			err = ListAuditLogEntriesRequest_TimeRangeValidationError{
+				field:  "Start",
+				reason: "value is not a valid timestamp",
+				cause:  err,
 			}
 			if !all {
 				return err
 			}
 			errors = append(errors, err)
 		} else {
-			_GetPolicyRequest_Id_Unique[item] = struct{}{}
+
+			now := time.Now()
+
+			if ts.Sub(now) >= 0 {
+				err := ListAuditLogEntriesRequest_TimeRangeValidationError{
+					field:  "Start",
+					reason: "value must be less than now",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
 		}
+	}
 
-		if l := utf8.RuneCountInString(item); l < 1 || l > 1280 {
-			err := GetPolicyRequestValidationError{
-				field:  fmt.Sprintf("Id[%v]", idx),
-				reason: "value length must be between 1 and 1280 runes, inclusive",
+	if m.GetEnd() == nil {
+		err := ListAuditLogEntriesRequest_TimeRangeValidationError{
+			field:  "End",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if t := m.GetEnd(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			err = ListAuditLogEntriesRequest_TimeRangeValidationError{
+				field:  "End",
+				reason: "value is not a valid timestamp",
+				cause:  err,
 			}
 			if !all {
 				return err
 			}
 			errors = append(errors, err)
-		}
+		} else {
+
+			now := time.Now()
+
+			if ts.Sub(now) >= 0 {
+				err := ListAuditLogEntriesRequest_TimeRangeValidationError{
+					field:  "End",
+					reason: "value must be less than now",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
 
+		}
 	}
 
 	if len(errors) > 0 {
-		return GetPolicyRequestMultiError(errors)
+		return ListAuditLogEntriesRequest_TimeRangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPolicyRequestMultiError is an error wrapping multiple validation errors
-// returned by GetPolicyRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetPolicyRequestMultiError []error
+// ListAuditLogEntriesRequest_TimeRangeMultiError is an error wrapping multiple
+// validation errors returned by
+// ListAuditLogEntriesRequest_TimeRange.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditLogEntriesRequest_TimeRangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPolicyRequestMultiError) Error() string {
+func (m ListAuditLogEntriesRequest_TimeRangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3221,11 +5935,12 @@ func (m GetPolicyRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPolicyRequestMultiError) AllErrors() []error { return m }
+func (m ListAuditLogEntriesRequest_TimeRangeMultiError) AllErrors() []error { return m }
 
-// GetPolicyRequestValidationError is the validation error returned by
-// GetPolicyRequest.Validate if the designated constraints aren't met.
-type GetPolicyRequestValidationError struct {
+// ListAuditLogEntriesRequest_TimeRangeValidationError is the validation error
+// returned by ListAuditLogEntriesRequest_TimeRange.Validate if the designated
+// constraints aren't met.
+type ListAuditLogEntriesRequest_TimeRangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3233,22 +5948,24 @@ type GetPolicyRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPolicyRequestValidationError) Field() string { return e.field }
+func (e ListAuditLogEntriesRequest_TimeRangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPolicyRequestValidationError) Reason() string { return e.reason }
+func (e ListAuditLogEntriesRequest_TimeRangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPolicyRequestValidationError) Cause() error { return e.cause }
+func (e ListAuditLogEntriesRequest_TimeRangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPolicyRequestValidationError) Key() bool { return e.key }
+func (e ListAuditLogEntriesRequest_TimeRangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPolicyRequestValidationError) ErrorName() string { return "GetPolicyRequestValidationError" }
+func (e ListAuditLogEntriesRequest_TimeRangeValidationError) ErrorName() string {
+	return "ListAuditLogEntriesRequest_TimeRangeValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetPolicyRequestValidationError) Error() string {
+func (e ListAuditLogEntriesRequest_TimeRangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3260,14 +5977,14 @@ func (e GetPolicyRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPolicyRequest.%s: %s%s",
+		"invalid %sListAuditLogEntriesRequest_TimeRange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPolicyRequestValidationError{}
+var _ error = ListAuditLogEntriesRequest_TimeRangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -3275,34 +5992,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPolicyRequestValidationError{}
+} = ListAuditLogEntriesRequest_TimeRangeValidationError{}
 
-// Validate checks the field values on DisablePolicyRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on InspectEffectiveRulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DisablePolicyRequest) Validate() error {
+func (m *InspectEffectiveRulesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DisablePolicyRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on InspectEffectiveRulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DisablePolicyRequestMultiError, or nil if none found.
-func (m *DisablePolicyRequest) ValidateAll() error {
+// InspectEffectiveRulesRequestMultiError, or nil if none found.
+func (m *InspectEffectiveRulesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DisablePolicyRequest) validate(all bool) error {
+func (m *InspectEffectiveRulesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := len(m.GetId()); l < 1 || l > 25 {
-		err := DisablePolicyRequestValidationError{
-			field:  "Id",
-			reason: "value must contain between 1 and 25 items, inclusive",
+	if utf8.RuneCountInString(m.GetResource()) < 1 {
+		err := InspectEffectiveRulesRequestValidationError{
+			field:  "Resource",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -3310,51 +6027,55 @@ func (m *DisablePolicyRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	_DisablePolicyRequest_Id_Unique := make(map[string]struct{}, len(m.GetId()))
-
-	for idx, item := range m.GetId() {
-		_, _ = idx, item
+	if !_InspectEffectiveRulesRequest_Resource_Pattern.MatchString(m.GetResource()) {
+		err := InspectEffectiveRulesRequestValidationError{
+			field:  "Resource",
+			reason: "value does not match regex pattern \"^[[:alpha:]][[:word:]\\\\@\\\\.\\\\-/]*(\\\\:[[:alpha:]][[:word:]\\\\@\\\\.\\\\-/]*)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if _, exists := _DisablePolicyRequest_Id_Unique[item]; exists {
-			err := DisablePolicyRequestValidationError{
-				field:  fmt.Sprintf("Id[%v]", idx),
-				reason: "repeated value must contain unique items",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-			_DisablePolicyRequest_Id_Unique[item] = struct{}{}
+	if !_InspectEffectiveRulesRequest_PolicyVersion_Pattern.MatchString(m.GetPolicyVersion()) {
+		err := InspectEffectiveRulesRequestValidationError{
+			field:  "PolicyVersion",
+			reason: "value does not match regex pattern \"^[[:word:]]*$\"",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-		if l := utf8.RuneCountInString(item); l < 1 || l > 1280 {
-			err := DisablePolicyRequestValidationError{
-				field:  fmt.Sprintf("Id[%v]", idx),
-				reason: "value length must be between 1 and 1280 runes, inclusive",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if !_InspectEffectiveRulesRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		err := InspectEffectiveRulesRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"^([[:alnum:]][[:word:]\\\\-]*(\\\\.[[:word:]\\\\-]*)*)*$\"",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for LenientScopeSearch
+
 	if len(errors) > 0 {
-		return DisablePolicyRequestMultiError(errors)
+		return InspectEffectiveRulesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DisablePolicyRequestMultiError is an error wrapping multiple validation
-// errors returned by DisablePolicyRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DisablePolicyRequestMultiError []error
+// InspectEffectiveRulesRequestMultiError is an error wrapping multiple
+// validation errors returned by InspectEffectiveRulesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InspectEffectiveRulesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DisablePolicyRequestMultiError) Error() string {
+func (m InspectEffectiveRulesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3363,11 +6084,12 @@ func (m DisablePolicyRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DisablePolicyRequestMultiError) AllErrors() []error { return m }
+func (m InspectEffectiveRulesRequestMultiError) AllErrors() []error { return m }
 
-// DisablePolicyRequestValidationError is the validation error returned by
-// DisablePolicyRequest.Validate if the designated constraints aren't met.
-type DisablePolicyRequestValidationError struct {
+// InspectEffectiveRulesRequestValidationError is the validation error returned
+// by InspectEffectiveRulesRequest.Validate if the designated constraints
+// aren't met.
+type InspectEffectiveRulesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3375,24 +6097,24 @@ type DisablePolicyRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DisablePolicyRequestValidationError) Field() string { return e.field }
+func (e InspectEffectiveRulesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DisablePolicyRequestValidationError) Reason() string { return e.reason }
+func (e InspectEffectiveRulesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DisablePolicyRequestValidationError) Cause() error { return e.cause }
+func (e InspectEffectiveRulesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DisablePolicyRequestValidationError) Key() bool { return e.key }
+func (e InspectEffectiveRulesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DisablePolicyRequestValidationError) ErrorName() string {
-	return "DisablePolicyRequestValidationError"
+func (e InspectEffectiveRulesRequestValidationError) ErrorName() string {
+	return "InspectEffectiveRulesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DisablePolicyRequestValidationError) Error() string {
+func (e InspectEffectiveRulesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3404,14 +6126,14 @@ func (e DisablePolicyRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDisablePolicyRequest.%s: %s%s",
+		"invalid %sInspectEffectiveRulesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DisablePolicyRequestValidationError{}
+var _ error = InspectEffectiveRulesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3419,86 +6141,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DisablePolicyRequestValidationError{}
+} = InspectEffectiveRulesRequestValidationError{}
 
-// Validate checks the field values on EnablePolicyRequest with the rules
+var _InspectEffectiveRulesRequest_Resource_Pattern = regexp.MustCompile("^[[:alpha:]][[:word:]\\@\\.\\-/]*(\\:[[:alpha:]][[:word:]\\@\\.\\-/]*)*$")
+
+var _InspectEffectiveRulesRequest_PolicyVersion_Pattern = regexp.MustCompile("^[[:word:]]*$")
+
+var _InspectEffectiveRulesRequest_Scope_Pattern = regexp.MustCompile("^([[:alnum:]][[:word:]\\-]*(\\.[[:word:]\\-]*)*)*$")
+
+// Validate checks the field values on ListResourceKindsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EnablePolicyRequest) Validate() error {
+func (m *ListResourceKindsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EnablePolicyRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListResourceKindsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EnablePolicyRequestMultiError, or nil if none found.
-func (m *EnablePolicyRequest) ValidateAll() error {
+// ListResourceKindsRequestMultiError, or nil if none found.
+func (m *ListResourceKindsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EnablePolicyRequest) validate(all bool) error {
+func (m *ListResourceKindsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := len(m.GetId()); l < 1 || l > 25 {
-		err := EnablePolicyRequestValidationError{
-			field:  "Id",
-			reason: "value must contain between 1 and 25 items, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	_EnablePolicyRequest_Id_Unique := make(map[string]struct{}, len(m.GetId()))
-
-	for idx, item := range m.GetId() {
-		_, _ = idx, item
-
-		if _, exists := _EnablePolicyRequest_Id_Unique[item]; exists {
-			err := EnablePolicyRequestValidationError{
-				field:  fmt.Sprintf("Id[%v]", idx),
-				reason: "repeated value must contain unique items",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-			_EnablePolicyRequest_Id_Unique[item] = struct{}{}
-		}
-
-		if l := utf8.RuneCountInString(item); l < 1 || l > 1280 {
-			err := EnablePolicyRequestValidationError{
-				field:  fmt.Sprintf("Id[%v]", idx),
-				reason: "value length must be between 1 and 1280 runes, inclusive",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return EnablePolicyRequestMultiError(errors)
+		return ListResourceKindsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// EnablePolicyRequestMultiError is an error wrapping multiple validation
-// errors returned by EnablePolicyRequest.ValidateAll() if the designated
+// ListResourceKindsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListResourceKindsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type EnablePolicyRequestMultiError []error
+type ListResourceKindsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EnablePolicyRequestMultiError) Error() string {
+func (m ListResourceKindsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3507,11 +6193,11 @@ func (m EnablePolicyRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EnablePolicyRequestMultiError) AllErrors() []error { return m }
+func (m ListResourceKindsRequestMultiError) AllErrors() []error { return m }
 
-// EnablePolicyRequestValidationError is the validation error returned by
-// EnablePolicyRequest.Validate if the designated constraints aren't met.
-type EnablePolicyRequestValidationError struct {
+// ListResourceKindsRequestValidationError is the validation error returned by
+// ListResourceKindsRequest.Validate if the designated constraints aren't met.
+type ListResourceKindsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3519,24 +6205,24 @@ type EnablePolicyRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e EnablePolicyRequestValidationError) Field() string { return e.field }
+func (e ListResourceKindsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EnablePolicyRequestValidationError) Reason() string { return e.reason }
+func (e ListResourceKindsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EnablePolicyRequestValidationError) Cause() error { return e.cause }
+func (e ListResourceKindsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EnablePolicyRequestValidationError) Key() bool { return e.key }
+func (e ListResourceKindsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EnablePolicyRequestValidationError) ErrorName() string {
-	return "EnablePolicyRequestValidationError"
+func (e ListResourceKindsRequestValidationError) ErrorName() string {
+	return "ListResourceKindsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EnablePolicyRequestValidationError) Error() string {
+func (e ListResourceKindsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3548,14 +6234,14 @@ func (e EnablePolicyRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEnablePolicyRequest.%s: %s%s",
+		"invalid %sListResourceKindsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EnablePolicyRequestValidationError{}
+var _ error = ListResourceKindsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3563,89 +6249,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EnablePolicyRequestValidationError{}
+} = ListResourceKindsRequestValidationError{}
 
-// Validate checks the field values on AddOrUpdateSchemaRequest with the rules
+// Validate checks the field values on ListDeprecationsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddOrUpdateSchemaRequest) Validate() error {
+func (m *ListDeprecationsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddOrUpdateSchemaRequest with the
+// ValidateAll checks the field values on ListDeprecationsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddOrUpdateSchemaRequestMultiError, or nil if none found.
-func (m *AddOrUpdateSchemaRequest) ValidateAll() error {
+// ListDeprecationsRequestMultiError, or nil if none found.
+func (m *ListDeprecationsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddOrUpdateSchemaRequest) validate(all bool) error {
+func (m *ListDeprecationsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := len(m.GetSchemas()); l < 1 || l > 10 {
-		err := AddOrUpdateSchemaRequestValidationError{
-			field:  "Schemas",
-			reason: "value must contain between 1 and 10 items, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	for idx, item := range m.GetSchemas() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddOrUpdateSchemaRequestValidationError{
-						field:  fmt.Sprintf("Schemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AddOrUpdateSchemaRequestValidationError{
-						field:  fmt.Sprintf("Schemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AddOrUpdateSchemaRequestValidationError{
-					field:  fmt.Sprintf("Schemas[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return AddOrUpdateSchemaRequestMultiError(errors)
+		return ListDeprecationsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddOrUpdateSchemaRequestMultiError is an error wrapping multiple validation
-// errors returned by AddOrUpdateSchemaRequest.ValidateAll() if the designated
+// ListDeprecationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeprecationsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AddOrUpdateSchemaRequestMultiError []error
+type ListDeprecationsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddOrUpdateSchemaRequestMultiError) Error() string {
+func (m ListDeprecationsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3654,11 +6295,11 @@ func (m AddOrUpdateSchemaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddOrUpdateSchemaRequestMultiError) AllErrors() []error { return m }
+func (m ListDeprecationsRequestMultiError) AllErrors() []error { return m }
 
-// AddOrUpdateSchemaRequestValidationError is the validation error returned by
-// AddOrUpdateSchemaRequest.Validate if the designated constraints aren't met.
-type AddOrUpdateSchemaRequestValidationError struct {
+// ListDeprecationsRequestValidationError is the validation error returned by
+// ListDeprecationsRequest.Validate if the designated constraints aren't met.
+type ListDeprecationsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3666,24 +6307,24 @@ type AddOrUpdateSchemaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddOrUpdateSchemaRequestValidationError) Field() string { return e.field }
+func (e ListDeprecationsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddOrUpdateSchemaRequestValidationError) Reason() string { return e.reason }
+func (e ListDeprecationsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddOrUpdateSchemaRequestValidationError) Cause() error { return e.cause }
+func (e ListDeprecationsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddOrUpdateSchemaRequestValidationError) Key() bool { return e.key }
+func (e ListDeprecationsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddOrUpdateSchemaRequestValidationError) ErrorName() string {
-	return "AddOrUpdateSchemaRequestValidationError"
+func (e ListDeprecationsRequestValidationError) ErrorName() string {
+	return "ListDeprecationsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddOrUpdateSchemaRequestValidationError) Error() string {
+func (e ListDeprecationsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3695,14 +6336,14 @@ func (e AddOrUpdateSchemaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddOrUpdateSchemaRequest.%s: %s%s",
+		"invalid %sListDeprecationsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddOrUpdateSchemaRequestValidationError{}
+var _ error = ListDeprecationsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3710,44 +6351,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddOrUpdateSchemaRequestValidationError{}
+} = ListDeprecationsRequestValidationError{}
 
-// Validate checks the field values on ListSchemasRequest with the rules
+// Validate checks the field values on ListPolicyVersionsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListSchemasRequest) Validate() error {
+func (m *ListPolicyVersionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListSchemasRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListPolicyVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListSchemasRequestMultiError, or nil if none found.
-func (m *ListSchemasRequest) ValidateAll() error {
+// ListPolicyVersionsRequestMultiError, or nil if none found.
+func (m *ListPolicyVersionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListSchemasRequest) validate(all bool) error {
+func (m *ListPolicyVersionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if l := utf8.RuneCountInString(m.GetId()); l < 1 || l > 1280 {
+		err := ListPolicyVersionsRequestValidationError{
+			field:  "Id",
+			reason: "value length must be between 1 and 1280 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return ListSchemasRequestMultiError(errors)
+		return ListPolicyVersionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListSchemasRequestMultiError is an error wrapping multiple validation errors
-// returned by ListSchemasRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListSchemasRequestMultiError []error
+// ListPolicyVersionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPolicyVersionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListPolicyVersionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListSchemasRequestMultiError) Error() string {
+func (m ListPolicyVersionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3756,11 +6408,11 @@ func (m ListSchemasRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListSchemasRequestMultiError) AllErrors() []error { return m }
+func (m ListPolicyVersionsRequestMultiError) AllErrors() []error { return m }
 
-// ListSchemasRequestValidationError is the validation error returned by
-// ListSchemasRequest.Validate if the designated constraints aren't met.
-type ListSchemasRequestValidationError struct {
+// ListPolicyVersionsRequestValidationError is the validation error returned by
+// ListPolicyVersionsRequest.Validate if the designated constraints aren't met.
+type ListPolicyVersionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3768,24 +6420,24 @@ type ListSchemasRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListSchemasRequestValidationError) Field() string { return e.field }
+func (e ListPolicyVersionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListSchemasRequestValidationError) Reason() string { return e.reason }
+func (e ListPolicyVersionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListSchemasRequestValidationError) Cause() error { return e.cause }
+func (e ListPolicyVersionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListSchemasRequestValidationError) Key() bool { return e.key }
+func (e ListPolicyVersionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListSchemasRequestValidationError) ErrorName() string {
-	return "ListSchemasRequestValidationError"
+func (e ListPolicyVersionsRequestValidationError) ErrorName() string {
+	return "ListPolicyVersionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListSchemasRequestValidationError) Error() string {
+func (e ListPolicyVersionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3797,14 +6449,14 @@ func (e ListSchemasRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListSchemasRequest.%s: %s%s",
+		"invalid %sListPolicyVersionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListSchemasRequestValidationError{}
+var _ error = ListPolicyVersionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3812,86 +6464,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListSchemasRequestValidationError{}
+} = ListPolicyVersionsRequestValidationError{}
 
-// Validate checks the field values on GetSchemaRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetSchemaRequest) Validate() error {
+// Validate checks the field values on GetPolicyVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPolicyVersionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSchemaRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetPolicyVersionRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSchemaRequestMultiError, or nil if none found.
-func (m *GetSchemaRequest) ValidateAll() error {
+// GetPolicyVersionRequestMultiError, or nil if none found.
+func (m *GetPolicyVersionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSchemaRequest) validate(all bool) error {
+func (m *GetPolicyVersionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := len(m.GetId()); l < 1 || l > 25 {
-		err := GetSchemaRequestValidationError{
+	if l := utf8.RuneCountInString(m.GetId()); l < 1 || l > 1280 {
+		err := GetPolicyVersionRequestValidationError{
 			field:  "Id",
-			reason: "value must contain between 1 and 25 items, inclusive",
+			reason: "value length must be between 1 and 1280 runes, inclusive",
 		}
 		if !all {
 			return err
 		}
-		errors = append(errors, err)
-	}
-
-	_GetSchemaRequest_Id_Unique := make(map[string]struct{}, len(m.GetId()))
-
-	for idx, item := range m.GetId() {
-		_, _ = idx, item
-
-		if _, exists := _GetSchemaRequest_Id_Unique[item]; exists {
-			err := GetSchemaRequestValidationError{
-				field:  fmt.Sprintf("Id[%v]", idx),
-				reason: "repeated value must contain unique items",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-			_GetSchemaRequest_Id_Unique[item] = struct{}{}
-		}
+		errors = append(errors, err)
+	}
 
-		if l := utf8.RuneCountInString(item); l < 1 || l > 255 {
-			err := GetSchemaRequestValidationError{
-				field:  fmt.Sprintf("Id[%v]", idx),
-				reason: "value length must be between 1 and 255 runes, inclusive",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if m.GetVersion() <= 0 {
+		err := GetPolicyVersionRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetSchemaRequestMultiError(errors)
+		return GetPolicyVersionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSchemaRequestMultiError is an error wrapping multiple validation errors
-// returned by GetSchemaRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetSchemaRequestMultiError []error
+// GetPolicyVersionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPolicyVersionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPolicyVersionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSchemaRequestMultiError) Error() string {
+func (m GetPolicyVersionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3900,11 +6532,11 @@ func (m GetSchemaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSchemaRequestMultiError) AllErrors() []error { return m }
+func (m GetPolicyVersionRequestMultiError) AllErrors() []error { return m }
 
-// GetSchemaRequestValidationError is the validation error returned by
-// GetSchemaRequest.Validate if the designated constraints aren't met.
-type GetSchemaRequestValidationError struct {
+// GetPolicyVersionRequestValidationError is the validation error returned by
+// GetPolicyVersionRequest.Validate if the designated constraints aren't met.
+type GetPolicyVersionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3912,22 +6544,24 @@ type GetSchemaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSchemaRequestValidationError) Field() string { return e.field }
+func (e GetPolicyVersionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSchemaRequestValidationError) Reason() string { return e.reason }
+func (e GetPolicyVersionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSchemaRequestValidationError) Cause() error { return e.cause }
+func (e GetPolicyVersionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSchemaRequestValidationError) Key() bool { return e.key }
+func (e GetPolicyVersionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSchemaRequestValidationError) ErrorName() string { return "GetSchemaRequestValidationError" }
+func (e GetPolicyVersionRequestValidationError) ErrorName() string {
+	return "GetPolicyVersionRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetSchemaRequestValidationError) Error() string {
+func (e GetPolicyVersionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3939,14 +6573,14 @@ func (e GetSchemaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSchemaRequest.%s: %s%s",
+		"invalid %sGetPolicyVersionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSchemaRequestValidationError{}
+var _ error = GetPolicyVersionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3954,34 +6588,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSchemaRequestValidationError{}
+} = GetPolicyVersionRequestValidationError{}
 
-// Validate checks the field values on DeleteSchemaRequest with the rules
+// Validate checks the field values on RollbackPolicyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteSchemaRequest) Validate() error {
+func (m *RollbackPolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteSchemaRequest with the rules
+// ValidateAll checks the field values on RollbackPolicyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteSchemaRequestMultiError, or nil if none found.
-func (m *DeleteSchemaRequest) ValidateAll() error {
+// RollbackPolicyRequestMultiError, or nil if none found.
+func (m *RollbackPolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteSchemaRequest) validate(all bool) error {
+func (m *RollbackPolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := len(m.GetId()); l < 1 || l > 25 {
-		err := DeleteSchemaRequestValidationError{
+	if l := utf8.RuneCountInString(m.GetId()); l < 1 || l > 1280 {
+		err := RollbackPolicyRequestValidationError{
 			field:  "Id",
-			reason: "value must contain between 1 and 25 items, inclusive",
+			reason: "value length must be between 1 and 1280 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -3989,51 +6623,31 @@ func (m *DeleteSchemaRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	_DeleteSchemaRequest_Id_Unique := make(map[string]struct{}, len(m.GetId()))
-
-	for idx, item := range m.GetId() {
-		_, _ = idx, item
-
-		if _, exists := _DeleteSchemaRequest_Id_Unique[item]; exists {
-			err := DeleteSchemaRequestValidationError{
-				field:  fmt.Sprintf("Id[%v]", idx),
-				reason: "repeated value must contain unique items",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-			_DeleteSchemaRequest_Id_Unique[item] = struct{}{}
+	if m.GetVersion() <= 0 {
+		err := RollbackPolicyRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
 		}
-
-		if l := utf8.RuneCountInString(item); l < 1 || l > 255 {
-			err := DeleteSchemaRequestValidationError{
-				field:  fmt.Sprintf("Id[%v]", idx),
-				reason: "value length must be between 1 and 255 runes, inclusive",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+		if !all {
+			return err
 		}
-
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return DeleteSchemaRequestMultiError(errors)
+		return RollbackPolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteSchemaRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteSchemaRequest.ValidateAll() if the designated
+// RollbackPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by RollbackPolicyRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteSchemaRequestMultiError []error
+type RollbackPolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteSchemaRequestMultiError) Error() string {
+func (m RollbackPolicyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4042,11 +6656,11 @@ func (m DeleteSchemaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteSchemaRequestMultiError) AllErrors() []error { return m }
+func (m RollbackPolicyRequestMultiError) AllErrors() []error { return m }
 
-// DeleteSchemaRequestValidationError is the validation error returned by
-// DeleteSchemaRequest.Validate if the designated constraints aren't met.
-type DeleteSchemaRequestValidationError struct {
+// RollbackPolicyRequestValidationError is the validation error returned by
+// RollbackPolicyRequest.Validate if the designated constraints aren't met.
+type RollbackPolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4054,24 +6668,24 @@ type DeleteSchemaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteSchemaRequestValidationError) Field() string { return e.field }
+func (e RollbackPolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteSchemaRequestValidationError) Reason() string { return e.reason }
+func (e RollbackPolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteSchemaRequestValidationError) Cause() error { return e.cause }
+func (e RollbackPolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteSchemaRequestValidationError) Key() bool { return e.key }
+func (e RollbackPolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteSchemaRequestValidationError) ErrorName() string {
-	return "DeleteSchemaRequestValidationError"
+func (e RollbackPolicyRequestValidationError) ErrorName() string {
+	return "RollbackPolicyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteSchemaRequestValidationError) Error() string {
+func (e RollbackPolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4083,14 +6697,14 @@ func (e DeleteSchemaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteSchemaRequest.%s: %s%s",
+		"invalid %sRollbackPolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteSchemaRequestValidationError{}
+var _ error = RollbackPolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4098,46 +6712,86 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteSchemaRequestValidationError{}
+} = RollbackPolicyRequestValidationError{}
 
-// Validate checks the field values on ReloadStoreRequest with the rules
+// Validate checks the field values on DeletePolicyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReloadStoreRequest) Validate() error {
+func (m *DeletePolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReloadStoreRequest with the rules
+// ValidateAll checks the field values on DeletePolicyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReloadStoreRequestMultiError, or nil if none found.
-func (m *ReloadStoreRequest) ValidateAll() error {
+// DeletePolicyRequestMultiError, or nil if none found.
+func (m *DeletePolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReloadStoreRequest) validate(all bool) error {
+func (m *DeletePolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Wait
+	if l := len(m.GetId()); l < 1 || l > 25 {
+		err := DeletePolicyRequestValidationError{
+			field:  "Id",
+			reason: "value must contain between 1 and 25 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_DeletePolicyRequest_Id_Unique := make(map[string]struct{}, len(m.GetId()))
+
+	for idx, item := range m.GetId() {
+		_, _ = idx, item
+
+		if _, exists := _DeletePolicyRequest_Id_Unique[item]; exists {
+			err := DeletePolicyRequestValidationError{
+				field:  fmt.Sprintf("Id[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_DeletePolicyRequest_Id_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 1280 {
+			err := DeletePolicyRequestValidationError{
+				field:  fmt.Sprintf("Id[%v]", idx),
+				reason: "value length must be between 1 and 1280 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return ReloadStoreRequestMultiError(errors)
+		return DeletePolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReloadStoreRequestMultiError is an error wrapping multiple validation errors
-// returned by ReloadStoreRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ReloadStoreRequestMultiError []error
+// DeletePolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReloadStoreRequestMultiError) Error() string {
+func (m DeletePolicyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4146,11 +6800,11 @@ func (m ReloadStoreRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReloadStoreRequestMultiError) AllErrors() []error { return m }
+func (m DeletePolicyRequestMultiError) AllErrors() []error { return m }
 
-// ReloadStoreRequestValidationError is the validation error returned by
-// ReloadStoreRequest.Validate if the designated constraints aren't met.
-type ReloadStoreRequestValidationError struct {
+// DeletePolicyRequestValidationError is the validation error returned by
+// DeletePolicyRequest.Validate if the designated constraints aren't met.
+type DeletePolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4158,24 +6812,24 @@ type ReloadStoreRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReloadStoreRequestValidationError) Field() string { return e.field }
+func (e DeletePolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReloadStoreRequestValidationError) Reason() string { return e.reason }
+func (e DeletePolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReloadStoreRequestValidationError) Cause() error { return e.cause }
+func (e DeletePolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReloadStoreRequestValidationError) Key() bool { return e.key }
+func (e DeletePolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReloadStoreRequestValidationError) ErrorName() string {
-	return "ReloadStoreRequestValidationError"
+func (e DeletePolicyRequestValidationError) ErrorName() string {
+	return "DeletePolicyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReloadStoreRequestValidationError) Error() string {
+func (e DeletePolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4187,14 +6841,14 @@ func (e ReloadStoreRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReloadStoreRequest.%s: %s%s",
+		"invalid %sDeletePolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReloadStoreRequestValidationError{}
+var _ error = DeletePolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4202,35 +6856,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReloadStoreRequestValidationError{}
+} = DeletePolicyRequestValidationError{}
 
-// Validate checks the field values on CheckResourceBatchRequest_BatchEntry
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *CheckResourceBatchRequest_BatchEntry) Validate() error {
+// Validate checks the field values on RestorePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestorePolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckResourceBatchRequest_BatchEntry
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// CheckResourceBatchRequest_BatchEntryMultiError, or nil if none found.
-func (m *CheckResourceBatchRequest_BatchEntry) ValidateAll() error {
+// ValidateAll checks the field values on RestorePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestorePolicyRequestMultiError, or nil if none found.
+func (m *RestorePolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckResourceBatchRequest_BatchEntry) validate(all bool) error {
+func (m *RestorePolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetActions()) < 1 {
-		err := CheckResourceBatchRequest_BatchEntryValidationError{
-			field:  "Actions",
-			reason: "value must contain at least 1 item(s)",
+	if l := len(m.GetId()); l < 1 || l > 25 {
+		err := RestorePolicyRequestValidationError{
+			field:  "Id",
+			reason: "value must contain between 1 and 25 items, inclusive",
 		}
 		if !all {
 			return err
@@ -4238,14 +6891,14 @@ func (m *CheckResourceBatchRequest_BatchEntry) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	_CheckResourceBatchRequest_BatchEntry_Actions_Unique := make(map[string]struct{}, len(m.GetActions()))
+	_RestorePolicyRequest_Id_Unique := make(map[string]struct{}, len(m.GetId()))
 
-	for idx, item := range m.GetActions() {
+	for idx, item := range m.GetId() {
 		_, _ = idx, item
 
-		if _, exists := _CheckResourceBatchRequest_BatchEntry_Actions_Unique[item]; exists {
-			err := CheckResourceBatchRequest_BatchEntryValidationError{
-				field:  fmt.Sprintf("Actions[%v]", idx),
+		if _, exists := _RestorePolicyRequest_Id_Unique[item]; exists {
+			err := RestorePolicyRequestValidationError{
+				field:  fmt.Sprintf("Id[%v]", idx),
 				reason: "repeated value must contain unique items",
 			}
 			if !all {
@@ -4253,13 +6906,13 @@ func (m *CheckResourceBatchRequest_BatchEntry) validate(all bool) error {
 			}
 			errors = append(errors, err)
 		} else {
-			_CheckResourceBatchRequest_BatchEntry_Actions_Unique[item] = struct{}{}
+			_RestorePolicyRequest_Id_Unique[item] = struct{}{}
 		}
 
-		if utf8.RuneCountInString(item) < 1 {
-			err := CheckResourceBatchRequest_BatchEntryValidationError{
-				field:  fmt.Sprintf("Actions[%v]", idx),
-				reason: "value length must be at least 1 runes",
+		if l := utf8.RuneCountInString(item); l < 1 || l > 1280 {
+			err := RestorePolicyRequestValidationError{
+				field:  fmt.Sprintf("Id[%v]", idx),
+				reason: "value length must be between 1 and 1280 runes, inclusive",
 			}
 			if !all {
 				return err
@@ -4269,61 +6922,20 @@ func (m *CheckResourceBatchRequest_BatchEntry) validate(all bool) error {
 
 	}
 
-	if m.GetResource() == nil {
-		err := CheckResourceBatchRequest_BatchEntryValidationError{
-			field:  "Resource",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CheckResourceBatchRequest_BatchEntryValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CheckResourceBatchRequest_BatchEntryValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CheckResourceBatchRequest_BatchEntryValidationError{
-				field:  "Resource",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return CheckResourceBatchRequest_BatchEntryMultiError(errors)
+		return RestorePolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckResourceBatchRequest_BatchEntryMultiError is an error wrapping multiple
-// validation errors returned by
-// CheckResourceBatchRequest_BatchEntry.ValidateAll() if the designated
+// RestorePolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by RestorePolicyRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CheckResourceBatchRequest_BatchEntryMultiError []error
+type RestorePolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckResourceBatchRequest_BatchEntryMultiError) Error() string {
+func (m RestorePolicyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4332,12 +6944,11 @@ func (m CheckResourceBatchRequest_BatchEntryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckResourceBatchRequest_BatchEntryMultiError) AllErrors() []error { return m }
+func (m RestorePolicyRequestMultiError) AllErrors() []error { return m }
 
-// CheckResourceBatchRequest_BatchEntryValidationError is the validation error
-// returned by CheckResourceBatchRequest_BatchEntry.Validate if the designated
-// constraints aren't met.
-type CheckResourceBatchRequest_BatchEntryValidationError struct {
+// RestorePolicyRequestValidationError is the validation error returned by
+// RestorePolicyRequest.Validate if the designated constraints aren't met.
+type RestorePolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4345,24 +6956,24 @@ type CheckResourceBatchRequest_BatchEntryValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckResourceBatchRequest_BatchEntryValidationError) Field() string { return e.field }
+func (e RestorePolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckResourceBatchRequest_BatchEntryValidationError) Reason() string { return e.reason }
+func (e RestorePolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckResourceBatchRequest_BatchEntryValidationError) Cause() error { return e.cause }
+func (e RestorePolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckResourceBatchRequest_BatchEntryValidationError) Key() bool { return e.key }
+func (e RestorePolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckResourceBatchRequest_BatchEntryValidationError) ErrorName() string {
-	return "CheckResourceBatchRequest_BatchEntryValidationError"
+func (e RestorePolicyRequestValidationError) ErrorName() string {
+	return "RestorePolicyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckResourceBatchRequest_BatchEntryValidationError) Error() string {
+func (e RestorePolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4374,14 +6985,14 @@ func (e CheckResourceBatchRequest_BatchEntryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckResourceBatchRequest_BatchEntry.%s: %s%s",
+		"invalid %sRestorePolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckResourceBatchRequest_BatchEntryValidationError{}
+var _ error = RestorePolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4389,35 +7000,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckResourceBatchRequest_BatchEntryValidationError{}
+} = RestorePolicyRequestValidationError{}
 
-// Validate checks the field values on CheckResourcesRequest_ResourceEntry with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *CheckResourcesRequest_ResourceEntry) Validate() error {
+// Validate checks the field values on ListStoreEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStoreEventsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckResourcesRequest_ResourceEntry
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// CheckResourcesRequest_ResourceEntryMultiError, or nil if none found.
-func (m *CheckResourcesRequest_ResourceEntry) ValidateAll() error {
+// ValidateAll checks the field values on ListStoreEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStoreEventsRequestMultiError, or nil if none found.
+func (m *ListStoreEventsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckResourcesRequest_ResourceEntry) validate(all bool) error {
+func (m *ListStoreEventsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetActions()) < 1 {
-		err := CheckResourcesRequest_ResourceEntryValidationError{
-			field:  "Actions",
-			reason: "value must contain at least 1 item(s)",
+	if utf8.RuneCountInString(m.GetId()) > 1280 {
+		err := ListStoreEventsRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 1280 runes",
 		}
 		if !all {
 			return err
@@ -4425,41 +7035,10 @@ func (m *CheckResourcesRequest_ResourceEntry) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	_CheckResourcesRequest_ResourceEntry_Actions_Unique := make(map[string]struct{}, len(m.GetActions()))
-
-	for idx, item := range m.GetActions() {
-		_, _ = idx, item
-
-		if _, exists := _CheckResourcesRequest_ResourceEntry_Actions_Unique[item]; exists {
-			err := CheckResourcesRequest_ResourceEntryValidationError{
-				field:  fmt.Sprintf("Actions[%v]", idx),
-				reason: "repeated value must contain unique items",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-			_CheckResourcesRequest_ResourceEntry_Actions_Unique[item] = struct{}{}
-		}
-
-		if utf8.RuneCountInString(item) < 1 {
-			err := CheckResourcesRequest_ResourceEntryValidationError{
-				field:  fmt.Sprintf("Actions[%v]", idx),
-				reason: "value length must be at least 1 runes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.GetResource() == nil {
-		err := CheckResourcesRequest_ResourceEntryValidationError{
-			field:  "Resource",
-			reason: "value is required",
+	if m.GetLimit() > 1000 {
+		err := ListStoreEventsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 1000",
 		}
 		if !all {
 			return err
@@ -4467,50 +7046,20 @@ func (m *CheckResourcesRequest_ResourceEntry) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CheckResourcesRequest_ResourceEntryValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CheckResourcesRequest_ResourceEntryValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CheckResourcesRequest_ResourceEntryValidationError{
-				field:  "Resource",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return CheckResourcesRequest_ResourceEntryMultiError(errors)
+		return ListStoreEventsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckResourcesRequest_ResourceEntryMultiError is an error wrapping multiple
-// validation errors returned by
-// CheckResourcesRequest_ResourceEntry.ValidateAll() if the designated
+// ListStoreEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListStoreEventsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CheckResourcesRequest_ResourceEntryMultiError []error
+type ListStoreEventsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckResourcesRequest_ResourceEntryMultiError) Error() string {
+func (m ListStoreEventsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4519,12 +7068,11 @@ func (m CheckResourcesRequest_ResourceEntryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckResourcesRequest_ResourceEntryMultiError) AllErrors() []error { return m }
+func (m ListStoreEventsRequestMultiError) AllErrors() []error { return m }
 
-// CheckResourcesRequest_ResourceEntryValidationError is the validation error
-// returned by CheckResourcesRequest_ResourceEntry.Validate if the designated
-// constraints aren't met.
-type CheckResourcesRequest_ResourceEntryValidationError struct {
+// ListStoreEventsRequestValidationError is the validation error returned by
+// ListStoreEventsRequest.Validate if the designated constraints aren't met.
+type ListStoreEventsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4532,24 +7080,24 @@ type CheckResourcesRequest_ResourceEntryValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckResourcesRequest_ResourceEntryValidationError) Field() string { return e.field }
+func (e ListStoreEventsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckResourcesRequest_ResourceEntryValidationError) Reason() string { return e.reason }
+func (e ListStoreEventsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckResourcesRequest_ResourceEntryValidationError) Cause() error { return e.cause }
+func (e ListStoreEventsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckResourcesRequest_ResourceEntryValidationError) Key() bool { return e.key }
+func (e ListStoreEventsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckResourcesRequest_ResourceEntryValidationError) ErrorName() string {
-	return "CheckResourcesRequest_ResourceEntryValidationError"
+func (e ListStoreEventsRequestValidationError) ErrorName() string {
+	return "ListStoreEventsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckResourcesRequest_ResourceEntryValidationError) Error() string {
+func (e ListStoreEventsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4561,14 +7109,14 @@ func (e CheckResourcesRequest_ResourceEntryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckResourcesRequest_ResourceEntry.%s: %s%s",
+		"invalid %sListStoreEventsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckResourcesRequest_ResourceEntryValidationError{}
+var _ error = ListStoreEventsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4576,34 +7124,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckResourcesRequest_ResourceEntryValidationError{}
+} = ListStoreEventsRequestValidationError{}
 
-// Validate checks the field values on AuxData_JWT with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AuxData_JWT) Validate() error {
+// Validate checks the field values on SnapshotStoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SnapshotStoreRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuxData_JWT with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AuxData_JWTMultiError, or
-// nil if none found.
-func (m *AuxData_JWT) ValidateAll() error {
+// ValidateAll checks the field values on SnapshotStoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SnapshotStoreRequestMultiError, or nil if none found.
+func (m *SnapshotStoreRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuxData_JWT) validate(all bool) error {
+func (m *SnapshotStoreRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetToken()) < 1 {
-		err := AuxData_JWTValidationError{
-			field:  "Token",
-			reason: "value length must be at least 1 runes",
+	if utf8.RuneCountInString(m.GetKey()) > 1024 {
+		err := SnapshotStoreRequestValidationError{
+			field:  "Key",
+			reason: "value length must be at most 1024 runes",
 		}
 		if !all {
 			return err
@@ -4611,21 +7159,20 @@ func (m *AuxData_JWT) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for KeySetId
-
 	if len(errors) > 0 {
-		return AuxData_JWTMultiError(errors)
+		return SnapshotStoreRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuxData_JWTMultiError is an error wrapping multiple validation errors
-// returned by AuxData_JWT.ValidateAll() if the designated constraints aren't met.
-type AuxData_JWTMultiError []error
+// SnapshotStoreRequestMultiError is an error wrapping multiple validation
+// errors returned by SnapshotStoreRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SnapshotStoreRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuxData_JWTMultiError) Error() string {
+func (m SnapshotStoreRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4634,11 +7181,11 @@ func (m AuxData_JWTMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuxData_JWTMultiError) AllErrors() []error { return m }
+func (m SnapshotStoreRequestMultiError) AllErrors() []error { return m }
 
-// AuxData_JWTValidationError is the validation error returned by
-// AuxData_JWT.Validate if the designated constraints aren't met.
-type AuxData_JWTValidationError struct {
+// SnapshotStoreRequestValidationError is the validation error returned by
+// SnapshotStoreRequest.Validate if the designated constraints aren't met.
+type SnapshotStoreRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4646,22 +7193,24 @@ type AuxData_JWTValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuxData_JWTValidationError) Field() string { return e.field }
+func (e SnapshotStoreRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuxData_JWTValidationError) Reason() string { return e.reason }
+func (e SnapshotStoreRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuxData_JWTValidationError) Cause() error { return e.cause }
+func (e SnapshotStoreRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuxData_JWTValidationError) Key() bool { return e.key }
+func (e SnapshotStoreRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuxData_JWTValidationError) ErrorName() string { return "AuxData_JWTValidationError" }
+func (e SnapshotStoreRequestValidationError) ErrorName() string {
+	return "SnapshotStoreRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AuxData_JWTValidationError) Error() string {
+func (e SnapshotStoreRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4673,14 +7222,14 @@ func (e AuxData_JWTValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuxData_JWT.%s: %s%s",
+		"invalid %sSnapshotStoreRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuxData_JWTValidationError{}
+var _ error = SnapshotStoreRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4688,76 +7237,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuxData_JWTValidationError{}
+} = SnapshotStoreRequestValidationError{}
 
-// Validate checks the field values on ListAuditLogEntriesRequest_TimeRange
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *ListAuditLogEntriesRequest_TimeRange) Validate() error {
+// Validate checks the field values on RestoreStoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreStoreRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListAuditLogEntriesRequest_TimeRange
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ListAuditLogEntriesRequest_TimeRangeMultiError, or nil if none found.
-func (m *ListAuditLogEntriesRequest_TimeRange) ValidateAll() error {
+// ValidateAll checks the field values on RestoreStoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreStoreRequestMultiError, or nil if none found.
+func (m *RestoreStoreRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListAuditLogEntriesRequest_TimeRange) validate(all bool) error {
+func (m *RestoreStoreRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetStart() == nil {
-		err := ListAuditLogEntriesRequest_TimeRangeValidationError{
-			field:  "Start",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if t := m.GetStart(); t != nil {
-		ts, err := t.AsTime(), t.CheckValid()
-		if err != nil {
-			err = ListAuditLogEntriesRequest_TimeRangeValidationError{
-				field:  "Start",
-				reason: "value is not a valid timestamp",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-
-			now := time.Now()
-
-			if ts.Sub(now) >= 0 {
-				err := ListAuditLogEntriesRequest_TimeRangeValidationError{
-					field:  "Start",
-					reason: "value must be less than now",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
-		}
-	}
-
-	if m.GetEnd() == nil {
-		err := ListAuditLogEntriesRequest_TimeRangeValidationError{
-			field:  "End",
-			reason: "value is required",
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 1024 {
+		err := RestoreStoreRequestValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -4765,51 +7272,20 @@ func (m *ListAuditLogEntriesRequest_TimeRange) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if t := m.GetEnd(); t != nil {
-		ts, err := t.AsTime(), t.CheckValid()
-		if err != nil {
-			err = ListAuditLogEntriesRequest_TimeRangeValidationError{
-				field:  "End",
-				reason: "value is not a valid timestamp",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-
-			now := time.Now()
-
-			if ts.Sub(now) >= 0 {
-				err := ListAuditLogEntriesRequest_TimeRangeValidationError{
-					field:  "End",
-					reason: "value must be less than now",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
-		}
-	}
-
 	if len(errors) > 0 {
-		return ListAuditLogEntriesRequest_TimeRangeMultiError(errors)
+		return RestoreStoreRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListAuditLogEntriesRequest_TimeRangeMultiError is an error wrapping multiple
-// validation errors returned by
-// ListAuditLogEntriesRequest_TimeRange.ValidateAll() if the designated
+// RestoreStoreRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreStoreRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListAuditLogEntriesRequest_TimeRangeMultiError []error
+type RestoreStoreRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListAuditLogEntriesRequest_TimeRangeMultiError) Error() string {
+func (m RestoreStoreRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4818,12 +7294,11 @@ func (m ListAuditLogEntriesRequest_TimeRangeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListAuditLogEntriesRequest_TimeRangeMultiError) AllErrors() []error { return m }
+func (m RestoreStoreRequestMultiError) AllErrors() []error { return m }
 
-// ListAuditLogEntriesRequest_TimeRangeValidationError is the validation error
-// returned by ListAuditLogEntriesRequest_TimeRange.Validate if the designated
-// constraints aren't met.
-type ListAuditLogEntriesRequest_TimeRangeValidationError struct {
+// RestoreStoreRequestValidationError is the validation error returned by
+// RestoreStoreRequest.Validate if the designated constraints aren't met.
+type RestoreStoreRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4831,24 +7306,24 @@ type ListAuditLogEntriesRequest_TimeRangeValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListAuditLogEntriesRequest_TimeRangeValidationError) Field() string { return e.field }
+func (e RestoreStoreRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListAuditLogEntriesRequest_TimeRangeValidationError) Reason() string { return e.reason }
+func (e RestoreStoreRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListAuditLogEntriesRequest_TimeRangeValidationError) Cause() error { return e.cause }
+func (e RestoreStoreRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListAuditLogEntriesRequest_TimeRangeValidationError) Key() bool { return e.key }
+func (e RestoreStoreRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListAuditLogEntriesRequest_TimeRangeValidationError) ErrorName() string {
-	return "ListAuditLogEntriesRequest_TimeRangeValidationError"
+func (e RestoreStoreRequestValidationError) ErrorName() string {
+	return "RestoreStoreRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListAuditLogEntriesRequest_TimeRangeValidationError) Error() string {
+func (e RestoreStoreRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4860,14 +7335,14 @@ func (e ListAuditLogEntriesRequest_TimeRangeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListAuditLogEntriesRequest_TimeRange.%s: %s%s",
+		"invalid %sRestoreStoreRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListAuditLogEntriesRequest_TimeRangeValidationError{}
+var _ error = RestoreStoreRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4875,4 +7350,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListAuditLogEntriesRequest_TimeRangeValidationError{}
+} = RestoreStoreRequestValidationError{}
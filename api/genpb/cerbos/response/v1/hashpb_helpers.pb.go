@@ -9,6 +9,8 @@ import (
 	v12 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	v13 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
 	protowire "google.golang.org/protobuf/encoding/protowire"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -62,6 +64,50 @@ func cerbos_audit_v1_AccessLogEntry_hashpb_sum(m *v1.AccessLogEntry, hasher hash
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.StatusCode)))
 
 	}
+	if _, ok := ignore["cerbos.audit.v1.AccessLogEntry.system_info"]; !ok {
+		if m.SystemInfo != nil {
+			cerbos_audit_v1_SystemInfo_hashpb_sum(m.SystemInfo, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.AccessLogEntry.admin_change"]; !ok {
+		if m.AdminChange != nil {
+			cerbos_audit_v1_AdminChange_hashpb_sum(m.AdminChange, hasher, ignore)
+		}
+
+	}
+}
+
+func cerbos_audit_v1_AdminChange_PolicyChange_hashpb_sum(m *v1.AdminChange_PolicyChange, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.audit.v1.AdminChange.PolicyChange.policy_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.PolicyId))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.AdminChange.PolicyChange.before_hash"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.BeforeHash))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.AdminChange.PolicyChange.after_hash"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.AfterHash))
+
+	}
+}
+
+func cerbos_audit_v1_AdminChange_hashpb_sum(m *v1.AdminChange, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.audit.v1.AdminChange.user"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.User))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.AdminChange.policies"]; !ok {
+		if len(m.Policies) > 0 {
+			for _, v := range m.Policies {
+				if v != nil {
+					cerbos_audit_v1_AdminChange_PolicyChange_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_audit_v1_DecisionLogEntry_CheckResources_hashpb_sum(m *v1.DecisionLogEntry_CheckResources, hasher hash.Hash, ignore map[string]struct{}) {
@@ -186,6 +232,12 @@ func cerbos_audit_v1_DecisionLogEntry_hashpb_sum(m *v1.DecisionLogEntry, hasher
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.system_info"]; !ok {
+		if m.SystemInfo != nil {
+			cerbos_audit_v1_SystemInfo_hashpb_sum(m.SystemInfo, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_audit_v1_MetaValues_hashpb_sum(m *v1.MetaValues, hasher hash.Hash, ignore map[string]struct{}) {
@@ -218,6 +270,25 @@ func cerbos_audit_v1_Peer_hashpb_sum(m *v1.Peer, hasher hash.Hash, ignore map[st
 	}
 }
 
+func cerbos_audit_v1_SystemInfo_hashpb_sum(m *v1.SystemInfo, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.audit.v1.SystemInfo.version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Version))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.SystemInfo.commit"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Commit))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.SystemInfo.config_hash"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.ConfigHash))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.SystemInfo.store_revision"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.StoreRevision))
+
+	}
+}
+
 func cerbos_engine_v1_AuxData_hashpb_sum(m *v11.AuxData, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.AuxData.jwt"]; !ok {
 		if len(m.Jwt) > 0 {
@@ -476,6 +547,47 @@ func cerbos_engine_v1_PlanResourcesInput_hashpb_sum(m *v11.PlanResourcesInput, h
 		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeMeta)))
 
 	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.expand_conditionals"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.ExpandConditionals)))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.include_rule_residuals"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeRuleResiduals)))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.simplify_filters"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.SimplifyFilters)))
+
+	}
+}
+
+func cerbos_engine_v1_PlanResourcesOutput_RuleResidual_hashpb_sum(m *v11.PlanResourcesOutput_RuleResidual, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.policy"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Policy))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.rule"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Rule))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Effect)))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.filter"]; !ok {
+		if m.Filter != nil {
+			cerbos_engine_v1_PlanResourcesFilter_hashpb_sum(m.Filter, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.RuleResidual.filter_debug"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.FilterDebug))
+
+	}
 }
 
 func cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(m *v11.PlanResourcesOutput, hasher hash.Hash, ignore map[string]struct{}) {
@@ -519,6 +631,16 @@ func cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(m *v11.PlanResourcesOutput,
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.rule_residuals"]; !ok {
+		if len(m.RuleResiduals) > 0 {
+			for _, v := range m.RuleResiduals {
+				if v != nil {
+					cerbos_engine_v1_PlanResourcesOutput_RuleResidual_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_engine_v1_Principal_hashpb_sum(m *v11.Principal, hasher hash.Hash, ignore map[string]struct{}) {
@@ -765,6 +887,19 @@ func cerbos_policy_v1_ExportVariables_hashpb_sum(m *v12.ExportVariables, hasher
 	}
 }
 
+func cerbos_policy_v1_Limit_hashpb_sum(m *v12.Limit, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.Limit.max_allowed"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.MaxAllowed)))
+
+	}
+	if _, ok := ignore["cerbos.policy.v1.Limit.window"]; !ok {
+		if m.Window != nil {
+			google_protobuf_Duration_hashpb_sum(m.Window, hasher, ignore)
+		}
+
+	}
+}
+
 func cerbos_policy_v1_Match_ExprList_hashpb_sum(m *v12.Match_ExprList, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.policy.v1.Match.ExprList.of"]; !ok {
 		if len(m.Of) > 0 {
@@ -841,6 +976,18 @@ func cerbos_policy_v1_Metadata_hashpb_sum(m *v12.Metadata, hasher hash.Hash, ign
 		_, _ = hasher.Write(protowire.AppendString(nil, m.StoreIdentifier))
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.Metadata.active_from"]; !ok {
+		if m.ActiveFrom != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.ActiveFrom, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.policy.v1.Metadata.active_until"]; !ok {
+		if m.ActiveUntil != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.ActiveUntil, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_policy_v1_Output_hashpb_sum(m *v12.Output, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1036,6 +1183,16 @@ func cerbos_policy_v1_ResourcePolicy_hashpb_sum(m *v12.ResourcePolicy, hasher ha
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.ResourcePolicy.local_derived_roles"]; !ok {
+		if len(m.LocalDerivedRoles) > 0 {
+			for _, v := range m.LocalDerivedRoles {
+				if v != nil {
+					cerbos_policy_v1_RoleDef_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_policy_v1_ResourceRule_hashpb_sum(m *v12.ResourceRule, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1083,6 +1240,12 @@ func cerbos_policy_v1_ResourceRule_hashpb_sum(m *v12.ResourceRule, hasher hash.H
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.ResourceRule.limit"]; !ok {
+		if m.Limit != nil {
+			cerbos_policy_v1_Limit_hashpb_sum(m.Limit, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_policy_v1_RoleDef_hashpb_sum(m *v12.RoleDef, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1620,6 +1783,19 @@ func cerbos_response_v1_CheckResourceSetResponse_hashpb_sum(m *CheckResourceSetR
 	}
 }
 
+func cerbos_response_v1_CheckResourcesAsOfResponse_hashpb_sum(m *CheckResourcesAsOfResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.CheckResourcesAsOfResponse.store_revision"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.StoreRevision))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.CheckResourcesAsOfResponse.check"]; !ok {
+		if m.Check != nil {
+			cerbos_response_v1_CheckResourcesResponse_hashpb_sum(m.Check, hasher, ignore)
+		}
+
+	}
+}
+
 func cerbos_response_v1_CheckResourcesResponse_ResultEntry_Meta_EffectMeta_hashpb_sum(m *CheckResourcesResponse_ResultEntry_Meta_EffectMeta, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.EffectMeta.matched_policy"]; !ok {
 		_, _ = hasher.Write(protowire.AppendString(nil, m.MatchedPolicy))
@@ -1749,6 +1925,30 @@ func cerbos_response_v1_CheckResourcesResponse_hashpb_sum(m *CheckResourcesRespo
 	}
 }
 
+func cerbos_response_v1_CheckResourcesStreamResponse_hashpb_sum(m *CheckResourcesStreamResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.CheckResourcesStreamResponse.id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.CheckResourcesStreamResponse.result"]; !ok {
+		if m.Result != nil {
+			cerbos_response_v1_CheckResourcesResponse_hashpb_sum(m.Result, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.response.v1.CheckResourcesStreamResponse.error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Error))
+
+	}
+}
+
+func cerbos_response_v1_DeletePolicyResponse_hashpb_sum(m *DeletePolicyResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.DeletePolicyResponse.deleted_policies"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.DeletedPolicies)))
+
+	}
+}
+
 func cerbos_response_v1_DeleteSchemaResponse_hashpb_sum(m *DeleteSchemaResponse, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.DeleteSchemaResponse.deleted_schemas"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.DeletedSchemas)))
@@ -1770,6 +1970,71 @@ func cerbos_response_v1_EnablePolicyResponse_hashpb_sum(m *EnablePolicyResponse,
 	}
 }
 
+func cerbos_response_v1_ExportPolicySnapshotResponse_Entry_hashpb_sum(m *ExportPolicySnapshotResponse_Entry, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.ExportPolicySnapshotResponse.Entry.policy_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.PolicyId))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ExportPolicySnapshotResponse.Entry.fqn"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Fqn))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ExportPolicySnapshotResponse.Entry.dependencies"]; !ok {
+		if len(m.Dependencies) > 0 {
+			for _, v := range m.Dependencies {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.ExportPolicySnapshotResponse.Entry.policy_set"]; !ok {
+		if m.PolicySet != nil {
+			google_protobuf_Any_hashpb_sum(m.PolicySet, hasher, ignore)
+		}
+
+	}
+}
+
+func cerbos_response_v1_ExportPolicySnapshotResponse_Metadata_hashpb_sum(m *ExportPolicySnapshotResponse_Metadata, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.ExportPolicySnapshotResponse.Metadata.cerbos_version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.CerbosVersion))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ExportPolicySnapshotResponse.Metadata.store_driver"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.StoreDriver))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ExportPolicySnapshotResponse.Metadata.store_revision"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.StoreRevision))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ExportPolicySnapshotResponse.Metadata.created_at"]; !ok {
+		if m.CreatedAt != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.CreatedAt, hasher, ignore)
+		}
+
+	}
+}
+
+func cerbos_response_v1_ExportPolicySnapshotResponse_hashpb_sum(m *ExportPolicySnapshotResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.ExportPolicySnapshotResponse.metadata"]; !ok {
+		if m.Metadata != nil {
+			cerbos_response_v1_ExportPolicySnapshotResponse_Metadata_hashpb_sum(m.Metadata, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ExportPolicySnapshotResponse.entries"]; !ok {
+		if len(m.Entries) > 0 {
+			for _, v := range m.Entries {
+				if v != nil {
+					cerbos_response_v1_ExportPolicySnapshotResponse_Entry_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
 func cerbos_response_v1_GetPolicyResponse_hashpb_sum(m *GetPolicyResponse, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.GetPolicyResponse.policies"]; !ok {
 		if len(m.Policies) > 0 {
@@ -1783,6 +2048,15 @@ func cerbos_response_v1_GetPolicyResponse_hashpb_sum(m *GetPolicyResponse, hashe
 	}
 }
 
+func cerbos_response_v1_GetPolicyVersionResponse_hashpb_sum(m *GetPolicyVersionResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.GetPolicyVersionResponse.policy"]; !ok {
+		if m.Policy != nil {
+			cerbos_policy_v1_Policy_hashpb_sum(m.Policy, hasher, ignore)
+		}
+
+	}
+}
+
 func cerbos_response_v1_GetSchemaResponse_hashpb_sum(m *GetSchemaResponse, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.GetSchemaResponse.schemas"]; !ok {
 		if len(m.Schemas) > 0 {
@@ -1796,6 +2070,83 @@ func cerbos_response_v1_GetSchemaResponse_hashpb_sum(m *GetSchemaResponse, hashe
 	}
 }
 
+func cerbos_response_v1_InspectEffectiveRulesResponse_hashpb_sum(m *InspectEffectiveRulesResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.InspectEffectiveRulesResponse.policy_fqn"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.PolicyFqn))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.InspectEffectiveRulesResponse.scopes"]; !ok {
+		if len(m.Scopes) > 0 {
+			for _, v := range m.Scopes {
+				if v != nil {
+					cerbos_response_v1_InspectEffectiveRulesResponse_Scope_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
+func cerbos_response_v1_InspectEffectiveRulesResponse_Rule_hashpb_sum(m *InspectEffectiveRulesResponse_Rule, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.InspectEffectiveRulesResponse.Rule.name"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Name))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.InspectEffectiveRulesResponse.Rule.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.InspectEffectiveRulesResponse.Rule.actions"]; !ok {
+		if len(m.Actions) > 0 {
+			for _, v := range m.Actions {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.InspectEffectiveRulesResponse.Rule.roles"]; !ok {
+		if len(m.Roles) > 0 {
+			for _, v := range m.Roles {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.InspectEffectiveRulesResponse.Rule.derived_roles"]; !ok {
+		if len(m.DerivedRoles) > 0 {
+			for _, v := range m.DerivedRoles {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.InspectEffectiveRulesResponse.Rule.effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Effect)))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.InspectEffectiveRulesResponse.Rule.conditional"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.Conditional)))
+
+	}
+}
+
+func cerbos_response_v1_InspectEffectiveRulesResponse_Scope_hashpb_sum(m *InspectEffectiveRulesResponse_Scope, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.InspectEffectiveRulesResponse.Scope.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.InspectEffectiveRulesResponse.Scope.rules"]; !ok {
+		if len(m.Rules) > 0 {
+			for _, v := range m.Rules {
+				if v != nil {
+					cerbos_response_v1_InspectEffectiveRulesResponse_Rule_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
 func cerbos_response_v1_ListAuditLogEntriesResponse_hashpb_sum(m *ListAuditLogEntriesResponse, hasher hash.Hash, ignore map[string]struct{}) {
 	if m.Entry != nil {
 		if _, ok := ignore["cerbos.response.v1.ListAuditLogEntriesResponse.entry"]; !ok {
@@ -1815,6 +2166,38 @@ func cerbos_response_v1_ListAuditLogEntriesResponse_hashpb_sum(m *ListAuditLogEn
 	}
 }
 
+func cerbos_response_v1_ListDeprecationsResponse_Deprecation_hashpb_sum(m *ListDeprecationsResponse_Deprecation, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.ListDeprecationsResponse.Deprecation.file"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.File))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ListDeprecationsResponse.Deprecation.location"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Location))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ListDeprecationsResponse.Deprecation.feature"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Feature))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ListDeprecationsResponse.Deprecation.replacement"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Replacement))
+
+	}
+}
+
+func cerbos_response_v1_ListDeprecationsResponse_hashpb_sum(m *ListDeprecationsResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.ListDeprecationsResponse.deprecations"]; !ok {
+		if len(m.Deprecations) > 0 {
+			for _, v := range m.Deprecations {
+				if v != nil {
+					cerbos_response_v1_ListDeprecationsResponse_Deprecation_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
 func cerbos_response_v1_ListPoliciesResponse_hashpb_sum(m *ListPoliciesResponse, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.ListPoliciesResponse.policy_ids"]; !ok {
 		if len(m.PolicyIds) > 0 {
@@ -1826,6 +2209,84 @@ func cerbos_response_v1_ListPoliciesResponse_hashpb_sum(m *ListPoliciesResponse,
 	}
 }
 
+func cerbos_response_v1_ListPolicyVersionsResponse_Version_hashpb_sum(m *ListPolicyVersionsResponse_Version, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.ListPolicyVersionsResponse.Version.version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, m.Version))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ListPolicyVersionsResponse.Version.timestamp"]; !ok {
+		if m.Timestamp != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.Timestamp, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ListPolicyVersionsResponse.Version.change"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Change)))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ListPolicyVersionsResponse.Version.disabled"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.Disabled)))
+
+	}
+}
+
+func cerbos_response_v1_ListPolicyVersionsResponse_hashpb_sum(m *ListPolicyVersionsResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.ListPolicyVersionsResponse.versions"]; !ok {
+		if len(m.Versions) > 0 {
+			for _, v := range m.Versions {
+				if v != nil {
+					cerbos_response_v1_ListPolicyVersionsResponse_Version_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
+func cerbos_response_v1_ListResourceKindsResponse_ResourceKind_hashpb_sum(m *ListResourceKindsResponse_ResourceKind, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.ListResourceKindsResponse.ResourceKind.kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Kind))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ListResourceKindsResponse.ResourceKind.policy_versions"]; !ok {
+		if len(m.PolicyVersions) > 0 {
+			for _, v := range m.PolicyVersions {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.ListResourceKindsResponse.ResourceKind.scopes"]; !ok {
+		if len(m.Scopes) > 0 {
+			for _, v := range m.Scopes {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.ListResourceKindsResponse.ResourceKind.actions"]; !ok {
+		if len(m.Actions) > 0 {
+			for _, v := range m.Actions {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+}
+
+func cerbos_response_v1_ListResourceKindsResponse_hashpb_sum(m *ListResourceKindsResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.ListResourceKindsResponse.resource_kinds"]; !ok {
+		if len(m.ResourceKinds) > 0 {
+			for _, v := range m.ResourceKinds {
+				if v != nil {
+					cerbos_response_v1_ListResourceKindsResponse_ResourceKind_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
 func cerbos_response_v1_ListSchemasResponse_hashpb_sum(m *ListSchemasResponse, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.ListSchemasResponse.schema_ids"]; !ok {
 		if len(m.SchemaIds) > 0 {
@@ -1837,6 +2298,61 @@ func cerbos_response_v1_ListSchemasResponse_hashpb_sum(m *ListSchemasResponse, h
 	}
 }
 
+func cerbos_response_v1_ListStoreEventsResponse_Event_Actor_hashpb_sum(m *ListStoreEventsResponse_Event_Actor, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.ListStoreEventsResponse.Event.Actor.user"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.User))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ListStoreEventsResponse.Event.Actor.client_cert"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.ClientCert))
+
+	}
+}
+
+func cerbos_response_v1_ListStoreEventsResponse_Event_hashpb_sum(m *ListStoreEventsResponse_Event, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.ListStoreEventsResponse.Event.id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, m.Id))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ListStoreEventsResponse.Event.timestamp"]; !ok {
+		if m.Timestamp != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.Timestamp, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ListStoreEventsResponse.Event.kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Kind)))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ListStoreEventsResponse.Event.target"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Target))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ListStoreEventsResponse.Event.revision"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, m.Revision))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.ListStoreEventsResponse.Event.actor"]; !ok {
+		if m.Actor != nil {
+			cerbos_response_v1_ListStoreEventsResponse_Event_Actor_hashpb_sum(m.Actor, hasher, ignore)
+		}
+
+	}
+}
+
+func cerbos_response_v1_ListStoreEventsResponse_hashpb_sum(m *ListStoreEventsResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.ListStoreEventsResponse.events"]; !ok {
+		if len(m.Events) > 0 {
+			for _, v := range m.Events {
+				if v != nil {
+					cerbos_response_v1_ListStoreEventsResponse_Event_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
 func cerbos_response_v1_PlanResourcesResponse_Meta_hashpb_sum(m *PlanResourcesResponse_Meta, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Meta.filter_debug"]; !ok {
 		_, _ = hasher.Write(protowire.AppendString(nil, m.FilterDebug))
@@ -1887,6 +2403,33 @@ func cerbos_response_v1_PlanResourcesResponse_hashpb_sum(m *PlanResourcesRespons
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.rule_residuals"]; !ok {
+		if len(m.RuleResiduals) > 0 {
+			for _, v := range m.RuleResiduals {
+				if v != nil {
+					cerbos_engine_v1_PlanResourcesOutput_RuleResidual_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
+func cerbos_response_v1_PlanResourcesStreamResponse_hashpb_sum(m *PlanResourcesStreamResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesStreamResponse.entry_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.EntryId))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesStreamResponse.plan"]; !ok {
+		if m.Plan != nil {
+			cerbos_response_v1_PlanResourcesResponse_hashpb_sum(m.Plan, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesStreamResponse.error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Error))
+
+	}
 }
 
 func cerbos_response_v1_PlaygroundEvaluateResponse_EvalResultList_hashpb_sum(m *PlaygroundEvaluateResponse_EvalResultList, hasher hash.Hash, ignore map[string]struct{}) {
@@ -2106,6 +2649,37 @@ func cerbos_response_v1_PlaygroundValidateResponse_hashpb_sum(m *PlaygroundValid
 func cerbos_response_v1_ReloadStoreResponse_hashpb_sum(m *ReloadStoreResponse, hasher hash.Hash, ignore map[string]struct{}) {
 }
 
+func cerbos_response_v1_RestorePolicyResponse_hashpb_sum(m *RestorePolicyResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.RestorePolicyResponse.restored_policies"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.RestoredPolicies)))
+
+	}
+}
+
+func cerbos_response_v1_RestoreStoreResponse_hashpb_sum(m *RestoreStoreResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.RestoreStoreResponse.created_at"]; !ok {
+		if m.CreatedAt != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.CreatedAt, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.response.v1.RestoreStoreResponse.policies"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Policies)))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.RestoreStoreResponse.schemas"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Schemas)))
+
+	}
+}
+
+func cerbos_response_v1_RollbackPolicyResponse_hashpb_sum(m *RollbackPolicyResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.RollbackPolicyResponse.version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, m.Version))
+
+	}
+}
+
 func cerbos_response_v1_ServerInfoResponse_hashpb_sum(m *ServerInfoResponse, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.ServerInfoResponse.version"]; !ok {
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Version))
@@ -2121,6 +2695,48 @@ func cerbos_response_v1_ServerInfoResponse_hashpb_sum(m *ServerInfoResponse, has
 	}
 }
 
+func cerbos_response_v1_SnapshotStoreResponse_hashpb_sum(m *SnapshotStoreResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.SnapshotStoreResponse.key"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Key))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.SnapshotStoreResponse.created_at"]; !ok {
+		if m.CreatedAt != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.CreatedAt, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.response.v1.SnapshotStoreResponse.policies"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Policies)))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.SnapshotStoreResponse.schemas"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Schemas)))
+
+	}
+}
+
+func cerbos_response_v1_WatchDecisionsResponse_hashpb_sum(m *WatchDecisionsResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.WatchDecisionsResponse.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.RequestId))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.WatchDecisionsResponse.results"]; !ok {
+		if len(m.Results) > 0 {
+			for _, v := range m.Results {
+				if v != nil {
+					cerbos_response_v1_CheckResourcesResponse_ResultEntry_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.WatchDecisionsResponse.error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Error))
+
+	}
+}
+
 func cerbos_schema_v1_Schema_hashpb_sum(m *v13.Schema, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.schema.v1.Schema.id"]; !ok {
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
@@ -2147,6 +2763,28 @@ func cerbos_schema_v1_ValidationError_hashpb_sum(m *v13.ValidationError, hasher
 	}
 }
 
+func google_protobuf_Any_hashpb_sum(m *anypb.Any, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["google.protobuf.Any.type_url"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.TypeUrl))
+
+	}
+	if _, ok := ignore["google.protobuf.Any.value"]; !ok {
+		_, _ = hasher.Write(protowire.AppendBytes(nil, m.Value))
+
+	}
+}
+
+func google_protobuf_Duration_hashpb_sum(m *durationpb.Duration, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["google.protobuf.Duration.seconds"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Seconds)))
+
+	}
+	if _, ok := ignore["google.protobuf.Duration.nanos"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Nanos)))
+
+	}
+}
+
 func google_protobuf_Empty_hashpb_sum(m *emptypb.Empty, hasher hash.Hash, ignore map[string]struct{}) {
 }
 
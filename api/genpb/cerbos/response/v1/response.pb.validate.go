@@ -161,6 +161,40 @@ func (m *PlanResourcesResponse) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetRuleResiduals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlanResourcesResponseValidationError{
+						field:  fmt.Sprintf("RuleResiduals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlanResourcesResponseValidationError{
+						field:  fmt.Sprintf("RuleResiduals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlanResourcesResponseValidationError{
+					field:  fmt.Sprintf("RuleResiduals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return PlanResourcesResponseMultiError(errors)
 	}
@@ -241,6 +275,142 @@ var _ interface {
 	ErrorName() string
 } = PlanResourcesResponseValidationError{}
 
+// Validate checks the field values on PlanResourcesStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlanResourcesStreamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanResourcesStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanResourcesStreamResponseMultiError, or nil if none found.
+func (m *PlanResourcesStreamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanResourcesStreamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntryId
+
+	if all {
+		switch v := interface{}(m.GetPlan()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlanResourcesStreamResponseValidationError{
+					field:  "Plan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlanResourcesStreamResponseValidationError{
+					field:  "Plan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPlan()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlanResourcesStreamResponseValidationError{
+				field:  "Plan",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return PlanResourcesStreamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanResourcesStreamResponseMultiError is an error wrapping multiple
+// validation errors returned by PlanResourcesStreamResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PlanResourcesStreamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanResourcesStreamResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanResourcesStreamResponseMultiError) AllErrors() []error { return m }
+
+// PlanResourcesStreamResponseValidationError is the validation error returned
+// by PlanResourcesStreamResponse.Validate if the designated constraints
+// aren't met.
+type PlanResourcesStreamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanResourcesStreamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanResourcesStreamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanResourcesStreamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanResourcesStreamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanResourcesStreamResponseValidationError) ErrorName() string {
+	return "PlanResourcesStreamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanResourcesStreamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanResourcesStreamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanResourcesStreamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanResourcesStreamResponseValidationError{}
+
 // Validate checks the field values on CheckResourceSetResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -696,76 +866,75 @@ var _ interface {
 	ErrorName() string
 } = CheckResourcesResponseValidationError{}
 
-// Validate checks the field values on PlaygroundFailure with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PlaygroundFailure) Validate() error {
+// Validate checks the field values on CheckResourcesStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckResourcesStreamResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PlaygroundFailure with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CheckResourcesStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PlaygroundFailureMultiError, or nil if none found.
-func (m *PlaygroundFailure) ValidateAll() error {
+// CheckResourcesStreamResponseMultiError, or nil if none found.
+func (m *CheckResourcesStreamResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PlaygroundFailure) validate(all bool) error {
+func (m *CheckResourcesStreamResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetErrors() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundFailureValidationError{
-						field:  fmt.Sprintf("Errors[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundFailureValidationError{
-						field:  fmt.Sprintf("Errors[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckResourcesStreamResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return PlaygroundFailureValidationError{
-					field:  fmt.Sprintf("Errors[%v]", idx),
+				errors = append(errors, CheckResourcesStreamResponseValidationError{
+					field:  "Result",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckResourcesStreamResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
+	// no validation rules for Error
+
 	if len(errors) > 0 {
-		return PlaygroundFailureMultiError(errors)
+		return CheckResourcesStreamResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PlaygroundFailureMultiError is an error wrapping multiple validation errors
-// returned by PlaygroundFailure.ValidateAll() if the designated constraints
-// aren't met.
-type PlaygroundFailureMultiError []error
+// CheckResourcesStreamResponseMultiError is an error wrapping multiple
+// validation errors returned by CheckResourcesStreamResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CheckResourcesStreamResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PlaygroundFailureMultiError) Error() string {
+func (m CheckResourcesStreamResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -774,11 +943,12 @@ func (m PlaygroundFailureMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PlaygroundFailureMultiError) AllErrors() []error { return m }
+func (m CheckResourcesStreamResponseMultiError) AllErrors() []error { return m }
 
-// PlaygroundFailureValidationError is the validation error returned by
-// PlaygroundFailure.Validate if the designated constraints aren't met.
-type PlaygroundFailureValidationError struct {
+// CheckResourcesStreamResponseValidationError is the validation error returned
+// by CheckResourcesStreamResponse.Validate if the designated constraints
+// aren't met.
+type CheckResourcesStreamResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -786,24 +956,24 @@ type PlaygroundFailureValidationError struct {
 }
 
 // Field function returns field value.
-func (e PlaygroundFailureValidationError) Field() string { return e.field }
+func (e CheckResourcesStreamResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PlaygroundFailureValidationError) Reason() string { return e.reason }
+func (e CheckResourcesStreamResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PlaygroundFailureValidationError) Cause() error { return e.cause }
+func (e CheckResourcesStreamResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PlaygroundFailureValidationError) Key() bool { return e.key }
+func (e CheckResourcesStreamResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PlaygroundFailureValidationError) ErrorName() string {
-	return "PlaygroundFailureValidationError"
+func (e CheckResourcesStreamResponseValidationError) ErrorName() string {
+	return "CheckResourcesStreamResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PlaygroundFailureValidationError) Error() string {
+func (e CheckResourcesStreamResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -815,14 +985,14 @@ func (e PlaygroundFailureValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPlaygroundFailure.%s: %s%s",
+		"invalid %sCheckResourcesStreamResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PlaygroundFailureValidationError{}
+var _ error = CheckResourcesStreamResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -830,133 +1000,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PlaygroundFailureValidationError{}
+} = CheckResourcesStreamResponseValidationError{}
 
-// Validate checks the field values on PlaygroundValidateResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on WatchDecisionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PlaygroundValidateResponse) Validate() error {
+func (m *WatchDecisionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PlaygroundValidateResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on WatchDecisionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PlaygroundValidateResponseMultiError, or nil if none found.
-func (m *PlaygroundValidateResponse) ValidateAll() error {
+// WatchDecisionsResponseMultiError, or nil if none found.
+func (m *WatchDecisionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PlaygroundValidateResponse) validate(all bool) error {
+func (m *WatchDecisionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PlaygroundId
+	// no validation rules for RequestId
 
-	switch v := m.Outcome.(type) {
-	case *PlaygroundValidateResponse_Failure:
-		if v == nil {
-			err := PlaygroundValidateResponseValidationError{
-				field:  "Outcome",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetFailure()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundValidateResponseValidationError{
-						field:  "Failure",
+					errors = append(errors, WatchDecisionsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundValidateResponseValidationError{
-						field:  "Failure",
+					errors = append(errors, WatchDecisionsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetFailure()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PlaygroundValidateResponseValidationError{
-					field:  "Failure",
+				return WatchDecisionsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *PlaygroundValidateResponse_Success:
-		if v == nil {
-			err := PlaygroundValidateResponseValidationError{
-				field:  "Outcome",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetSuccess()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundValidateResponseValidationError{
-						field:  "Success",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundValidateResponseValidationError{
-						field:  "Success",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetSuccess()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return PlaygroundValidateResponseValidationError{
-					field:  "Success",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	default:
-		_ = v // ensures v is used
 	}
 
+	// no validation rules for Error
+
 	if len(errors) > 0 {
-		return PlaygroundValidateResponseMultiError(errors)
+		return WatchDecisionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PlaygroundValidateResponseMultiError is an error wrapping multiple
-// validation errors returned by PlaygroundValidateResponse.ValidateAll() if
-// the designated constraints aren't met.
-type PlaygroundValidateResponseMultiError []error
+// WatchDecisionsResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchDecisionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchDecisionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PlaygroundValidateResponseMultiError) Error() string {
+func (m WatchDecisionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -965,11 +1084,11 @@ func (m PlaygroundValidateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PlaygroundValidateResponseMultiError) AllErrors() []error { return m }
+func (m WatchDecisionsResponseMultiError) AllErrors() []error { return m }
 
-// PlaygroundValidateResponseValidationError is the validation error returned
-// by PlaygroundValidateResponse.Validate if the designated constraints aren't met.
-type PlaygroundValidateResponseValidationError struct {
+// WatchDecisionsResponseValidationError is the validation error returned by
+// WatchDecisionsResponse.Validate if the designated constraints aren't met.
+type WatchDecisionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -977,24 +1096,24 @@ type PlaygroundValidateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PlaygroundValidateResponseValidationError) Field() string { return e.field }
+func (e WatchDecisionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PlaygroundValidateResponseValidationError) Reason() string { return e.reason }
+func (e WatchDecisionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PlaygroundValidateResponseValidationError) Cause() error { return e.cause }
+func (e WatchDecisionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PlaygroundValidateResponseValidationError) Key() bool { return e.key }
+func (e WatchDecisionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PlaygroundValidateResponseValidationError) ErrorName() string {
-	return "PlaygroundValidateResponseValidationError"
+func (e WatchDecisionsResponseValidationError) ErrorName() string {
+	return "WatchDecisionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PlaygroundValidateResponseValidationError) Error() string {
+func (e WatchDecisionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1006,14 +1125,14 @@ func (e PlaygroundValidateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPlaygroundValidateResponse.%s: %s%s",
+		"invalid %sWatchDecisionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PlaygroundValidateResponseValidationError{}
+var _ error = WatchDecisionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1021,133 +1140,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PlaygroundValidateResponseValidationError{}
+} = WatchDecisionsResponseValidationError{}
 
-// Validate checks the field values on PlaygroundTestResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PlaygroundTestResponse) Validate() error {
+// Validate checks the field values on PlaygroundFailure with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PlaygroundFailure) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PlaygroundTestResponse with the rules
+// ValidateAll checks the field values on PlaygroundFailure with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PlaygroundTestResponseMultiError, or nil if none found.
-func (m *PlaygroundTestResponse) ValidateAll() error {
+// PlaygroundFailureMultiError, or nil if none found.
+func (m *PlaygroundFailure) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PlaygroundTestResponse) validate(all bool) error {
+func (m *PlaygroundFailure) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PlaygroundId
-
-	switch v := m.Outcome.(type) {
-	case *PlaygroundTestResponse_Failure:
-		if v == nil {
-			err := PlaygroundTestResponseValidationError{
-				field:  "Outcome",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetFailure()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundTestResponseValidationError{
-						field:  "Failure",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundTestResponseValidationError{
-						field:  "Failure",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetFailure()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return PlaygroundTestResponseValidationError{
-					field:  "Failure",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *PlaygroundTestResponse_Success:
-		if v == nil {
-			err := PlaygroundTestResponseValidationError{
-				field:  "Outcome",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetSuccess()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundTestResponseValidationError{
-						field:  "Success",
+					errors = append(errors, PlaygroundFailureValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundTestResponseValidationError{
-						field:  "Success",
+					errors = append(errors, PlaygroundFailureValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetSuccess()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PlaygroundTestResponseValidationError{
-					field:  "Success",
+				return PlaygroundFailureValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return PlaygroundTestResponseMultiError(errors)
+		return PlaygroundFailureMultiError(errors)
 	}
 
 	return nil
 }
 
-// PlaygroundTestResponseMultiError is an error wrapping multiple validation
-// errors returned by PlaygroundTestResponse.ValidateAll() if the designated
-// constraints aren't met.
-type PlaygroundTestResponseMultiError []error
+// PlaygroundFailureMultiError is an error wrapping multiple validation errors
+// returned by PlaygroundFailure.ValidateAll() if the designated constraints
+// aren't met.
+type PlaygroundFailureMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PlaygroundTestResponseMultiError) Error() string {
+func (m PlaygroundFailureMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1156,11 +1220,11 @@ func (m PlaygroundTestResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PlaygroundTestResponseMultiError) AllErrors() []error { return m }
+func (m PlaygroundFailureMultiError) AllErrors() []error { return m }
 
-// PlaygroundTestResponseValidationError is the validation error returned by
-// PlaygroundTestResponse.Validate if the designated constraints aren't met.
-type PlaygroundTestResponseValidationError struct {
+// PlaygroundFailureValidationError is the validation error returned by
+// PlaygroundFailure.Validate if the designated constraints aren't met.
+type PlaygroundFailureValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1168,24 +1232,24 @@ type PlaygroundTestResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PlaygroundTestResponseValidationError) Field() string { return e.field }
+func (e PlaygroundFailureValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PlaygroundTestResponseValidationError) Reason() string { return e.reason }
+func (e PlaygroundFailureValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PlaygroundTestResponseValidationError) Cause() error { return e.cause }
+func (e PlaygroundFailureValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PlaygroundTestResponseValidationError) Key() bool { return e.key }
+func (e PlaygroundFailureValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PlaygroundTestResponseValidationError) ErrorName() string {
-	return "PlaygroundTestResponseValidationError"
+func (e PlaygroundFailureValidationError) ErrorName() string {
+	return "PlaygroundFailureValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PlaygroundTestResponseValidationError) Error() string {
+func (e PlaygroundFailureValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1197,14 +1261,14 @@ func (e PlaygroundTestResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPlaygroundTestResponse.%s: %s%s",
+		"invalid %sPlaygroundFailure.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PlaygroundTestResponseValidationError{}
+var _ error = PlaygroundFailureValidationError{}
 
 var _ interface {
 	Field() string
@@ -1212,24 +1276,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PlaygroundTestResponseValidationError{}
+} = PlaygroundFailureValidationError{}
 
-// Validate checks the field values on PlaygroundEvaluateResponse with the
+// Validate checks the field values on PlaygroundValidateResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PlaygroundEvaluateResponse) Validate() error {
+func (m *PlaygroundValidateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PlaygroundEvaluateResponse with the
+// ValidateAll checks the field values on PlaygroundValidateResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PlaygroundEvaluateResponseMultiError, or nil if none found.
-func (m *PlaygroundEvaluateResponse) ValidateAll() error {
+// PlaygroundValidateResponseMultiError, or nil if none found.
+func (m *PlaygroundValidateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PlaygroundEvaluateResponse) validate(all bool) error {
+func (m *PlaygroundValidateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1239,9 +1303,9 @@ func (m *PlaygroundEvaluateResponse) validate(all bool) error {
 	// no validation rules for PlaygroundId
 
 	switch v := m.Outcome.(type) {
-	case *PlaygroundEvaluateResponse_Failure:
+	case *PlaygroundValidateResponse_Failure:
 		if v == nil {
-			err := PlaygroundEvaluateResponseValidationError{
+			err := PlaygroundValidateResponseValidationError{
 				field:  "Outcome",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -1255,7 +1319,7 @@ func (m *PlaygroundEvaluateResponse) validate(all bool) error {
 			switch v := interface{}(m.GetFailure()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundEvaluateResponseValidationError{
+					errors = append(errors, PlaygroundValidateResponseValidationError{
 						field:  "Failure",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1263,7 +1327,7 @@ func (m *PlaygroundEvaluateResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundEvaluateResponseValidationError{
+					errors = append(errors, PlaygroundValidateResponseValidationError{
 						field:  "Failure",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1272,7 +1336,7 @@ func (m *PlaygroundEvaluateResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetFailure()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PlaygroundEvaluateResponseValidationError{
+				return PlaygroundValidateResponseValidationError{
 					field:  "Failure",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1280,9 +1344,9 @@ func (m *PlaygroundEvaluateResponse) validate(all bool) error {
 			}
 		}
 
-	case *PlaygroundEvaluateResponse_Success:
+	case *PlaygroundValidateResponse_Success:
 		if v == nil {
-			err := PlaygroundEvaluateResponseValidationError{
+			err := PlaygroundValidateResponseValidationError{
 				field:  "Outcome",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -1296,7 +1360,7 @@ func (m *PlaygroundEvaluateResponse) validate(all bool) error {
 			switch v := interface{}(m.GetSuccess()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundEvaluateResponseValidationError{
+					errors = append(errors, PlaygroundValidateResponseValidationError{
 						field:  "Success",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1304,7 +1368,7 @@ func (m *PlaygroundEvaluateResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundEvaluateResponseValidationError{
+					errors = append(errors, PlaygroundValidateResponseValidationError{
 						field:  "Success",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1313,7 +1377,7 @@ func (m *PlaygroundEvaluateResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetSuccess()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PlaygroundEvaluateResponseValidationError{
+				return PlaygroundValidateResponseValidationError{
 					field:  "Success",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1326,19 +1390,19 @@ func (m *PlaygroundEvaluateResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PlaygroundEvaluateResponseMultiError(errors)
+		return PlaygroundValidateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PlaygroundEvaluateResponseMultiError is an error wrapping multiple
-// validation errors returned by PlaygroundEvaluateResponse.ValidateAll() if
+// PlaygroundValidateResponseMultiError is an error wrapping multiple
+// validation errors returned by PlaygroundValidateResponse.ValidateAll() if
 // the designated constraints aren't met.
-type PlaygroundEvaluateResponseMultiError []error
+type PlaygroundValidateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PlaygroundEvaluateResponseMultiError) Error() string {
+func (m PlaygroundValidateResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1347,11 +1411,11 @@ func (m PlaygroundEvaluateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PlaygroundEvaluateResponseMultiError) AllErrors() []error { return m }
+func (m PlaygroundValidateResponseMultiError) AllErrors() []error { return m }
 
-// PlaygroundEvaluateResponseValidationError is the validation error returned
-// by PlaygroundEvaluateResponse.Validate if the designated constraints aren't met.
-type PlaygroundEvaluateResponseValidationError struct {
+// PlaygroundValidateResponseValidationError is the validation error returned
+// by PlaygroundValidateResponse.Validate if the designated constraints aren't met.
+type PlaygroundValidateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1359,24 +1423,24 @@ type PlaygroundEvaluateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PlaygroundEvaluateResponseValidationError) Field() string { return e.field }
+func (e PlaygroundValidateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PlaygroundEvaluateResponseValidationError) Reason() string { return e.reason }
+func (e PlaygroundValidateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PlaygroundEvaluateResponseValidationError) Cause() error { return e.cause }
+func (e PlaygroundValidateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PlaygroundEvaluateResponseValidationError) Key() bool { return e.key }
+func (e PlaygroundValidateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PlaygroundEvaluateResponseValidationError) ErrorName() string {
-	return "PlaygroundEvaluateResponseValidationError"
+func (e PlaygroundValidateResponseValidationError) ErrorName() string {
+	return "PlaygroundValidateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PlaygroundEvaluateResponseValidationError) Error() string {
+func (e PlaygroundValidateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1388,14 +1452,14 @@ func (e PlaygroundEvaluateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPlaygroundEvaluateResponse.%s: %s%s",
+		"invalid %sPlaygroundValidateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PlaygroundEvaluateResponseValidationError{}
+var _ error = PlaygroundValidateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1403,24 +1467,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PlaygroundEvaluateResponseValidationError{}
+} = PlaygroundValidateResponseValidationError{}
 
-// Validate checks the field values on PlaygroundProxyResponse with the rules
+// Validate checks the field values on PlaygroundTestResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PlaygroundProxyResponse) Validate() error {
+func (m *PlaygroundTestResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PlaygroundProxyResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PlaygroundTestResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PlaygroundProxyResponseMultiError, or nil if none found.
-func (m *PlaygroundProxyResponse) ValidateAll() error {
+// PlaygroundTestResponseMultiError, or nil if none found.
+func (m *PlaygroundTestResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PlaygroundProxyResponse) validate(all bool) error {
+func (m *PlaygroundTestResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1430,9 +1494,9 @@ func (m *PlaygroundProxyResponse) validate(all bool) error {
 	// no validation rules for PlaygroundId
 
 	switch v := m.Outcome.(type) {
-	case *PlaygroundProxyResponse_Failure:
+	case *PlaygroundTestResponse_Failure:
 		if v == nil {
-			err := PlaygroundProxyResponseValidationError{
+			err := PlaygroundTestResponseValidationError{
 				field:  "Outcome",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -1446,7 +1510,7 @@ func (m *PlaygroundProxyResponse) validate(all bool) error {
 			switch v := interface{}(m.GetFailure()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundProxyResponseValidationError{
+					errors = append(errors, PlaygroundTestResponseValidationError{
 						field:  "Failure",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1454,7 +1518,7 @@ func (m *PlaygroundProxyResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundProxyResponseValidationError{
+					errors = append(errors, PlaygroundTestResponseValidationError{
 						field:  "Failure",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1463,7 +1527,7 @@ func (m *PlaygroundProxyResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetFailure()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PlaygroundProxyResponseValidationError{
+				return PlaygroundTestResponseValidationError{
 					field:  "Failure",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1471,9 +1535,9 @@ func (m *PlaygroundProxyResponse) validate(all bool) error {
 			}
 		}
 
-	case *PlaygroundProxyResponse_CheckResourceSet:
+	case *PlaygroundTestResponse_Success:
 		if v == nil {
-			err := PlaygroundProxyResponseValidationError{
+			err := PlaygroundTestResponseValidationError{
 				field:  "Outcome",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -1484,78 +1548,146 @@ func (m *PlaygroundProxyResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetCheckResourceSet()).(type) {
+			switch v := interface{}(m.GetSuccess()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundProxyResponseValidationError{
-						field:  "CheckResourceSet",
+					errors = append(errors, PlaygroundTestResponseValidationError{
+						field:  "Success",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundProxyResponseValidationError{
-						field:  "CheckResourceSet",
+					errors = append(errors, PlaygroundTestResponseValidationError{
+						field:  "Success",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetCheckResourceSet()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetSuccess()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PlaygroundProxyResponseValidationError{
-					field:  "CheckResourceSet",
+				return PlaygroundTestResponseValidationError{
+					field:  "Success",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *PlaygroundProxyResponse_CheckResourceBatch:
-		if v == nil {
-			err := PlaygroundProxyResponseValidationError{
-				field:  "Outcome",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	default:
+		_ = v // ensures v is used
+	}
 
-		if all {
-			switch v := interface{}(m.GetCheckResourceBatch()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundProxyResponseValidationError{
-						field:  "CheckResourceBatch",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundProxyResponseValidationError{
-						field:  "CheckResourceBatch",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetCheckResourceBatch()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return PlaygroundProxyResponseValidationError{
-					field:  "CheckResourceBatch",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	if len(errors) > 0 {
+		return PlaygroundTestResponseMultiError(errors)
+	}
 
-	case *PlaygroundProxyResponse_PlanResources:
+	return nil
+}
+
+// PlaygroundTestResponseMultiError is an error wrapping multiple validation
+// errors returned by PlaygroundTestResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PlaygroundTestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaygroundTestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaygroundTestResponseMultiError) AllErrors() []error { return m }
+
+// PlaygroundTestResponseValidationError is the validation error returned by
+// PlaygroundTestResponse.Validate if the designated constraints aren't met.
+type PlaygroundTestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaygroundTestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaygroundTestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaygroundTestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaygroundTestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaygroundTestResponseValidationError) ErrorName() string {
+	return "PlaygroundTestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlaygroundTestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaygroundTestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaygroundTestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaygroundTestResponseValidationError{}
+
+// Validate checks the field values on PlaygroundEvaluateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlaygroundEvaluateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlaygroundEvaluateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlaygroundEvaluateResponseMultiError, or nil if none found.
+func (m *PlaygroundEvaluateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlaygroundEvaluateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlaygroundId
+
+	switch v := m.Outcome.(type) {
+	case *PlaygroundEvaluateResponse_Failure:
 		if v == nil {
-			err := PlaygroundProxyResponseValidationError{
+			err := PlaygroundEvaluateResponseValidationError{
 				field:  "Outcome",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -1566,37 +1698,37 @@ func (m *PlaygroundProxyResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetPlanResources()).(type) {
+			switch v := interface{}(m.GetFailure()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundProxyResponseValidationError{
-						field:  "PlanResources",
+					errors = append(errors, PlaygroundEvaluateResponseValidationError{
+						field:  "Failure",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundProxyResponseValidationError{
-						field:  "PlanResources",
+					errors = append(errors, PlaygroundEvaluateResponseValidationError{
+						field:  "Failure",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPlanResources()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetFailure()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PlaygroundProxyResponseValidationError{
-					field:  "PlanResources",
+				return PlaygroundEvaluateResponseValidationError{
+					field:  "Failure",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *PlaygroundProxyResponse_CheckResources:
+	case *PlaygroundEvaluateResponse_Success:
 		if v == nil {
-			err := PlaygroundProxyResponseValidationError{
+			err := PlaygroundEvaluateResponseValidationError{
 				field:  "Outcome",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -1607,28 +1739,28 @@ func (m *PlaygroundProxyResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetCheckResources()).(type) {
+			switch v := interface{}(m.GetSuccess()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundProxyResponseValidationError{
-						field:  "CheckResources",
+					errors = append(errors, PlaygroundEvaluateResponseValidationError{
+						field:  "Success",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundProxyResponseValidationError{
-						field:  "CheckResources",
+					errors = append(errors, PlaygroundEvaluateResponseValidationError{
+						field:  "Success",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetCheckResources()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetSuccess()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PlaygroundProxyResponseValidationError{
-					field:  "CheckResources",
+				return PlaygroundEvaluateResponseValidationError{
+					field:  "Success",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1640,19 +1772,19 @@ func (m *PlaygroundProxyResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PlaygroundProxyResponseMultiError(errors)
+		return PlaygroundEvaluateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PlaygroundProxyResponseMultiError is an error wrapping multiple validation
-// errors returned by PlaygroundProxyResponse.ValidateAll() if the designated
-// constraints aren't met.
-type PlaygroundProxyResponseMultiError []error
+// PlaygroundEvaluateResponseMultiError is an error wrapping multiple
+// validation errors returned by PlaygroundEvaluateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PlaygroundEvaluateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PlaygroundProxyResponseMultiError) Error() string {
+func (m PlaygroundEvaluateResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1661,11 +1793,11 @@ func (m PlaygroundProxyResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PlaygroundProxyResponseMultiError) AllErrors() []error { return m }
+func (m PlaygroundEvaluateResponseMultiError) AllErrors() []error { return m }
 
-// PlaygroundProxyResponseValidationError is the validation error returned by
-// PlaygroundProxyResponse.Validate if the designated constraints aren't met.
-type PlaygroundProxyResponseValidationError struct {
+// PlaygroundEvaluateResponseValidationError is the validation error returned
+// by PlaygroundEvaluateResponse.Validate if the designated constraints aren't met.
+type PlaygroundEvaluateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1673,24 +1805,2861 @@ type PlaygroundProxyResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PlaygroundProxyResponseValidationError) Field() string { return e.field }
+func (e PlaygroundEvaluateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PlaygroundProxyResponseValidationError) Reason() string { return e.reason }
+func (e PlaygroundEvaluateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PlaygroundProxyResponseValidationError) Cause() error { return e.cause }
+func (e PlaygroundEvaluateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaygroundEvaluateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaygroundEvaluateResponseValidationError) ErrorName() string {
+	return "PlaygroundEvaluateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlaygroundEvaluateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaygroundEvaluateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaygroundEvaluateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaygroundEvaluateResponseValidationError{}
+
+// Validate checks the field values on PlaygroundProxyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlaygroundProxyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlaygroundProxyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlaygroundProxyResponseMultiError, or nil if none found.
+func (m *PlaygroundProxyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlaygroundProxyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlaygroundId
+
+	switch v := m.Outcome.(type) {
+	case *PlaygroundProxyResponse_Failure:
+		if v == nil {
+			err := PlaygroundProxyResponseValidationError{
+				field:  "Outcome",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFailure()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlaygroundProxyResponseValidationError{
+						field:  "Failure",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlaygroundProxyResponseValidationError{
+						field:  "Failure",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFailure()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlaygroundProxyResponseValidationError{
+					field:  "Failure",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *PlaygroundProxyResponse_CheckResourceSet:
+		if v == nil {
+			err := PlaygroundProxyResponseValidationError{
+				field:  "Outcome",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCheckResourceSet()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlaygroundProxyResponseValidationError{
+						field:  "CheckResourceSet",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlaygroundProxyResponseValidationError{
+						field:  "CheckResourceSet",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCheckResourceSet()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlaygroundProxyResponseValidationError{
+					field:  "CheckResourceSet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *PlaygroundProxyResponse_CheckResourceBatch:
+		if v == nil {
+			err := PlaygroundProxyResponseValidationError{
+				field:  "Outcome",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCheckResourceBatch()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlaygroundProxyResponseValidationError{
+						field:  "CheckResourceBatch",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlaygroundProxyResponseValidationError{
+						field:  "CheckResourceBatch",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCheckResourceBatch()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlaygroundProxyResponseValidationError{
+					field:  "CheckResourceBatch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *PlaygroundProxyResponse_PlanResources:
+		if v == nil {
+			err := PlaygroundProxyResponseValidationError{
+				field:  "Outcome",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPlanResources()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlaygroundProxyResponseValidationError{
+						field:  "PlanResources",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlaygroundProxyResponseValidationError{
+						field:  "PlanResources",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPlanResources()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlaygroundProxyResponseValidationError{
+					field:  "PlanResources",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *PlaygroundProxyResponse_CheckResources:
+		if v == nil {
+			err := PlaygroundProxyResponseValidationError{
+				field:  "Outcome",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCheckResources()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlaygroundProxyResponseValidationError{
+						field:  "CheckResources",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlaygroundProxyResponseValidationError{
+						field:  "CheckResources",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCheckResources()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlaygroundProxyResponseValidationError{
+					field:  "CheckResources",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return PlaygroundProxyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlaygroundProxyResponseMultiError is an error wrapping multiple validation
+// errors returned by PlaygroundProxyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PlaygroundProxyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaygroundProxyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaygroundProxyResponseMultiError) AllErrors() []error { return m }
+
+// PlaygroundProxyResponseValidationError is the validation error returned by
+// PlaygroundProxyResponse.Validate if the designated constraints aren't met.
+type PlaygroundProxyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaygroundProxyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaygroundProxyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaygroundProxyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaygroundProxyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaygroundProxyResponseValidationError) ErrorName() string {
+	return "PlaygroundProxyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlaygroundProxyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaygroundProxyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaygroundProxyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaygroundProxyResponseValidationError{}
+
+// Validate checks the field values on AddOrUpdatePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddOrUpdatePolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddOrUpdatePolicyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddOrUpdatePolicyResponseMultiError, or nil if none found.
+func (m *AddOrUpdatePolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddOrUpdatePolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSuccess()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddOrUpdatePolicyResponseValidationError{
+					field:  "Success",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddOrUpdatePolicyResponseValidationError{
+					field:  "Success",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSuccess()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddOrUpdatePolicyResponseValidationError{
+				field:  "Success",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddOrUpdatePolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddOrUpdatePolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by AddOrUpdatePolicyResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AddOrUpdatePolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddOrUpdatePolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddOrUpdatePolicyResponseMultiError) AllErrors() []error { return m }
+
+// AddOrUpdatePolicyResponseValidationError is the validation error returned by
+// AddOrUpdatePolicyResponse.Validate if the designated constraints aren't met.
+type AddOrUpdatePolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddOrUpdatePolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddOrUpdatePolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddOrUpdatePolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddOrUpdatePolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddOrUpdatePolicyResponseValidationError) ErrorName() string {
+	return "AddOrUpdatePolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddOrUpdatePolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddOrUpdatePolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddOrUpdatePolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddOrUpdatePolicyResponseValidationError{}
+
+// Validate checks the field values on ListAuditLogEntriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditLogEntriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditLogEntriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditLogEntriesResponseMultiError, or nil if none found.
+func (m *ListAuditLogEntriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditLogEntriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Entry.(type) {
+	case *ListAuditLogEntriesResponse_AccessLogEntry:
+		if v == nil {
+			err := ListAuditLogEntriesResponseValidationError{
+				field:  "Entry",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAccessLogEntry()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditLogEntriesResponseValidationError{
+						field:  "AccessLogEntry",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditLogEntriesResponseValidationError{
+						field:  "AccessLogEntry",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccessLogEntry()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditLogEntriesResponseValidationError{
+					field:  "AccessLogEntry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ListAuditLogEntriesResponse_DecisionLogEntry:
+		if v == nil {
+			err := ListAuditLogEntriesResponseValidationError{
+				field:  "Entry",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDecisionLogEntry()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditLogEntriesResponseValidationError{
+						field:  "DecisionLogEntry",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditLogEntriesResponseValidationError{
+						field:  "DecisionLogEntry",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDecisionLogEntry()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditLogEntriesResponseValidationError{
+					field:  "DecisionLogEntry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ListAuditLogEntriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditLogEntriesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListAuditLogEntriesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListAuditLogEntriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditLogEntriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditLogEntriesResponseMultiError) AllErrors() []error { return m }
+
+// ListAuditLogEntriesResponseValidationError is the validation error returned
+// by ListAuditLogEntriesResponse.Validate if the designated constraints
+// aren't met.
+type ListAuditLogEntriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditLogEntriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditLogEntriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditLogEntriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditLogEntriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditLogEntriesResponseValidationError) ErrorName() string {
+	return "ListAuditLogEntriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditLogEntriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditLogEntriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditLogEntriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditLogEntriesResponseValidationError{}
+
+// Validate checks the field values on ServerInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ServerInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServerInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ServerInfoResponseMultiError, or nil if none found.
+func (m *ServerInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServerInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for Commit
+
+	// no validation rules for BuildDate
+
+	if len(errors) > 0 {
+		return ServerInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServerInfoResponseMultiError is an error wrapping multiple validation errors
+// returned by ServerInfoResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ServerInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServerInfoResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServerInfoResponseMultiError) AllErrors() []error { return m }
+
+// ServerInfoResponseValidationError is the validation error returned by
+// ServerInfoResponse.Validate if the designated constraints aren't met.
+type ServerInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServerInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServerInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServerInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServerInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServerInfoResponseValidationError) ErrorName() string {
+	return "ServerInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ServerInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServerInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServerInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServerInfoResponseValidationError{}
+
+// Validate checks the field values on ListPoliciesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPoliciesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPoliciesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPoliciesResponseMultiError, or nil if none found.
+func (m *ListPoliciesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPoliciesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListPoliciesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPoliciesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPoliciesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPoliciesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPoliciesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPoliciesResponseMultiError) AllErrors() []error { return m }
+
+// ListPoliciesResponseValidationError is the validation error returned by
+// ListPoliciesResponse.Validate if the designated constraints aren't met.
+type ListPoliciesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPoliciesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPoliciesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPoliciesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPoliciesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPoliciesResponseValidationError) ErrorName() string {
+	return "ListPoliciesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPoliciesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPoliciesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPoliciesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPoliciesResponseValidationError{}
+
+// Validate checks the field values on GetPolicyResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPolicyResponseMultiError, or nil if none found.
+func (m *GetPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPolicyResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPolicyResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPolicyResponseValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPolicyResponseMultiError is an error wrapping multiple validation errors
+// returned by GetPolicyResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPolicyResponseMultiError) AllErrors() []error { return m }
+
+// GetPolicyResponseValidationError is the validation error returned by
+// GetPolicyResponse.Validate if the designated constraints aren't met.
+type GetPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPolicyResponseValidationError) ErrorName() string {
+	return "GetPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPolicyResponseValidationError{}
+
+// Validate checks the field values on DisablePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisablePolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisablePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisablePolicyResponseMultiError, or nil if none found.
+func (m *DisablePolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisablePolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DisabledPolicies
+
+	if len(errors) > 0 {
+		return DisablePolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisablePolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by DisablePolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DisablePolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisablePolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisablePolicyResponseMultiError) AllErrors() []error { return m }
+
+// DisablePolicyResponseValidationError is the validation error returned by
+// DisablePolicyResponse.Validate if the designated constraints aren't met.
+type DisablePolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisablePolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisablePolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisablePolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisablePolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisablePolicyResponseValidationError) ErrorName() string {
+	return "DisablePolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisablePolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisablePolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisablePolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisablePolicyResponseValidationError{}
+
+// Validate checks the field values on EnablePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnablePolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnablePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnablePolicyResponseMultiError, or nil if none found.
+func (m *EnablePolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnablePolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EnabledPolicies
+
+	if len(errors) > 0 {
+		return EnablePolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnablePolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by EnablePolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EnablePolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnablePolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnablePolicyResponseMultiError) AllErrors() []error { return m }
+
+// EnablePolicyResponseValidationError is the validation error returned by
+// EnablePolicyResponse.Validate if the designated constraints aren't met.
+type EnablePolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnablePolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnablePolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnablePolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnablePolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnablePolicyResponseValidationError) ErrorName() string {
+	return "EnablePolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnablePolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnablePolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnablePolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnablePolicyResponseValidationError{}
+
+// Validate checks the field values on AddOrUpdateSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddOrUpdateSchemaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddOrUpdateSchemaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddOrUpdateSchemaResponseMultiError, or nil if none found.
+func (m *AddOrUpdateSchemaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddOrUpdateSchemaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddOrUpdateSchemaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddOrUpdateSchemaResponseMultiError is an error wrapping multiple validation
+// errors returned by AddOrUpdateSchemaResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AddOrUpdateSchemaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddOrUpdateSchemaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddOrUpdateSchemaResponseMultiError) AllErrors() []error { return m }
+
+// AddOrUpdateSchemaResponseValidationError is the validation error returned by
+// AddOrUpdateSchemaResponse.Validate if the designated constraints aren't met.
+type AddOrUpdateSchemaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddOrUpdateSchemaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddOrUpdateSchemaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddOrUpdateSchemaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddOrUpdateSchemaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddOrUpdateSchemaResponseValidationError) ErrorName() string {
+	return "AddOrUpdateSchemaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddOrUpdateSchemaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddOrUpdateSchemaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddOrUpdateSchemaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddOrUpdateSchemaResponseValidationError{}
+
+// Validate checks the field values on ListSchemasResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSchemasResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSchemasResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSchemasResponseMultiError, or nil if none found.
+func (m *ListSchemasResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSchemasResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListSchemasResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSchemasResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSchemasResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSchemasResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSchemasResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSchemasResponseMultiError) AllErrors() []error { return m }
+
+// ListSchemasResponseValidationError is the validation error returned by
+// ListSchemasResponse.Validate if the designated constraints aren't met.
+type ListSchemasResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSchemasResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSchemasResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSchemasResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSchemasResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSchemasResponseValidationError) ErrorName() string {
+	return "ListSchemasResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSchemasResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSchemasResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSchemasResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSchemasResponseValidationError{}
+
+// Validate checks the field values on GetSchemaResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSchemaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSchemaResponseMultiError, or nil if none found.
+func (m *GetSchemaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSchemaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSchemas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSchemaResponseValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSchemaResponseValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSchemaResponseValidationError{
+					field:  fmt.Sprintf("Schemas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSchemaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSchemaResponseMultiError is an error wrapping multiple validation errors
+// returned by GetSchemaResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetSchemaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSchemaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSchemaResponseMultiError) AllErrors() []error { return m }
+
+// GetSchemaResponseValidationError is the validation error returned by
+// GetSchemaResponse.Validate if the designated constraints aren't met.
+type GetSchemaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSchemaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSchemaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSchemaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSchemaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSchemaResponseValidationError) ErrorName() string {
+	return "GetSchemaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSchemaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSchemaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSchemaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSchemaResponseValidationError{}
+
+// Validate checks the field values on DeleteSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSchemaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSchemaResponseMultiError, or nil if none found.
+func (m *DeleteSchemaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSchemaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeletedSchemas
+
+	if len(errors) > 0 {
+		return DeleteSchemaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSchemaResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteSchemaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSchemaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSchemaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSchemaResponseMultiError) AllErrors() []error { return m }
+
+// DeleteSchemaResponseValidationError is the validation error returned by
+// DeleteSchemaResponse.Validate if the designated constraints aren't met.
+type DeleteSchemaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSchemaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSchemaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSchemaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSchemaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSchemaResponseValidationError) ErrorName() string {
+	return "DeleteSchemaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSchemaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSchemaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSchemaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSchemaResponseValidationError{}
+
+// Validate checks the field values on ReloadStoreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReloadStoreResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReloadStoreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReloadStoreResponseMultiError, or nil if none found.
+func (m *ReloadStoreResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReloadStoreResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReloadStoreResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReloadStoreResponseMultiError is an error wrapping multiple validation
+// errors returned by ReloadStoreResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReloadStoreResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReloadStoreResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReloadStoreResponseMultiError) AllErrors() []error { return m }
+
+// ReloadStoreResponseValidationError is the validation error returned by
+// ReloadStoreResponse.Validate if the designated constraints aren't met.
+type ReloadStoreResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReloadStoreResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReloadStoreResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReloadStoreResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReloadStoreResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReloadStoreResponseValidationError) ErrorName() string {
+	return "ReloadStoreResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReloadStoreResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReloadStoreResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReloadStoreResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReloadStoreResponseValidationError{}
+
+// Validate checks the field values on ExportPolicySnapshotResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportPolicySnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportPolicySnapshotResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportPolicySnapshotResponseMultiError, or nil if none found.
+func (m *ExportPolicySnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportPolicySnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportPolicySnapshotResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportPolicySnapshotResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportPolicySnapshotResponseValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportPolicySnapshotResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportPolicySnapshotResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportPolicySnapshotResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExportPolicySnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportPolicySnapshotResponseMultiError is an error wrapping multiple
+// validation errors returned by ExportPolicySnapshotResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ExportPolicySnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportPolicySnapshotResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportPolicySnapshotResponseMultiError) AllErrors() []error { return m }
+
+// ExportPolicySnapshotResponseValidationError is the validation error returned
+// by ExportPolicySnapshotResponse.Validate if the designated constraints
+// aren't met.
+type ExportPolicySnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportPolicySnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportPolicySnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportPolicySnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportPolicySnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportPolicySnapshotResponseValidationError) ErrorName() string {
+	return "ExportPolicySnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportPolicySnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportPolicySnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportPolicySnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportPolicySnapshotResponseValidationError{}
+
+// Validate checks the field values on CheckResourcesAsOfResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckResourcesAsOfResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckResourcesAsOfResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckResourcesAsOfResponseMultiError, or nil if none found.
+func (m *CheckResourcesAsOfResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckResourcesAsOfResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StoreRevision
+
+	if all {
+		switch v := interface{}(m.GetCheck()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckResourcesAsOfResponseValidationError{
+					field:  "Check",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckResourcesAsOfResponseValidationError{
+					field:  "Check",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCheck()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckResourcesAsOfResponseValidationError{
+				field:  "Check",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CheckResourcesAsOfResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckResourcesAsOfResponseMultiError is an error wrapping multiple
+// validation errors returned by CheckResourcesAsOfResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CheckResourcesAsOfResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckResourcesAsOfResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckResourcesAsOfResponseMultiError) AllErrors() []error { return m }
+
+// CheckResourcesAsOfResponseValidationError is the validation error returned
+// by CheckResourcesAsOfResponse.Validate if the designated constraints aren't met.
+type CheckResourcesAsOfResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckResourcesAsOfResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckResourcesAsOfResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckResourcesAsOfResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckResourcesAsOfResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckResourcesAsOfResponseValidationError) ErrorName() string {
+	return "CheckResourcesAsOfResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckResourcesAsOfResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckResourcesAsOfResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckResourcesAsOfResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckResourcesAsOfResponseValidationError{}
+
+// Validate checks the field values on PlanResourcesResponse_Meta with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlanResourcesResponse_Meta) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanResourcesResponse_Meta with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanResourcesResponse_MetaMultiError, or nil if none found.
+func (m *PlanResourcesResponse_Meta) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanResourcesResponse_Meta) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FilterDebug
+
+	// no validation rules for MatchedScope
+
+	if len(errors) > 0 {
+		return PlanResourcesResponse_MetaMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanResourcesResponse_MetaMultiError is an error wrapping multiple
+// validation errors returned by PlanResourcesResponse_Meta.ValidateAll() if
+// the designated constraints aren't met.
+type PlanResourcesResponse_MetaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanResourcesResponse_MetaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanResourcesResponse_MetaMultiError) AllErrors() []error { return m }
+
+// PlanResourcesResponse_MetaValidationError is the validation error returned
+// by PlanResourcesResponse_Meta.Validate if the designated constraints aren't met.
+type PlanResourcesResponse_MetaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanResourcesResponse_MetaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanResourcesResponse_MetaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanResourcesResponse_MetaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanResourcesResponse_MetaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanResourcesResponse_MetaValidationError) ErrorName() string {
+	return "PlanResourcesResponse_MetaValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanResourcesResponse_MetaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanResourcesResponse_Meta.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanResourcesResponse_MetaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanResourcesResponse_MetaValidationError{}
+
+// Validate checks the field values on CheckResourceSetResponse_ActionEffectMap
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CheckResourceSetResponse_ActionEffectMap) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// CheckResourceSetResponse_ActionEffectMap with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// CheckResourceSetResponse_ActionEffectMapMultiError, or nil if none found.
+func (m *CheckResourceSetResponse_ActionEffectMap) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckResourceSetResponse_ActionEffectMap) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Actions
+
+	for idx, item := range m.GetValidationErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckResourceSetResponse_ActionEffectMapValidationError{
+						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckResourceSetResponse_ActionEffectMapValidationError{
+						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckResourceSetResponse_ActionEffectMapValidationError{
+					field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CheckResourceSetResponse_ActionEffectMapMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckResourceSetResponse_ActionEffectMapMultiError is an error wrapping
+// multiple validation errors returned by
+// CheckResourceSetResponse_ActionEffectMap.ValidateAll() if the designated
+// constraints aren't met.
+type CheckResourceSetResponse_ActionEffectMapMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckResourceSetResponse_ActionEffectMapMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckResourceSetResponse_ActionEffectMapMultiError) AllErrors() []error { return m }
+
+// CheckResourceSetResponse_ActionEffectMapValidationError is the validation
+// error returned by CheckResourceSetResponse_ActionEffectMap.Validate if the
+// designated constraints aren't met.
+type CheckResourceSetResponse_ActionEffectMapValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckResourceSetResponse_ActionEffectMapValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckResourceSetResponse_ActionEffectMapValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckResourceSetResponse_ActionEffectMapValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckResourceSetResponse_ActionEffectMapValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckResourceSetResponse_ActionEffectMapValidationError) ErrorName() string {
+	return "CheckResourceSetResponse_ActionEffectMapValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckResourceSetResponse_ActionEffectMapValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckResourceSetResponse_ActionEffectMap.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckResourceSetResponse_ActionEffectMapValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckResourceSetResponse_ActionEffectMapValidationError{}
+
+// Validate checks the field values on CheckResourceSetResponse_Meta with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckResourceSetResponse_Meta) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckResourceSetResponse_Meta with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CheckResourceSetResponse_MetaMultiError, or nil if none found.
+func (m *CheckResourceSetResponse_Meta) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckResourceSetResponse_Meta) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]string, len(m.GetResourceInstances()))
+		i := 0
+		for key := range m.GetResourceInstances() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetResourceInstances()[key]
+			_ = val
+
+			// no validation rules for ResourceInstances[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, CheckResourceSetResponse_MetaValidationError{
+							field:  fmt.Sprintf("ResourceInstances[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, CheckResourceSetResponse_MetaValidationError{
+							field:  fmt.Sprintf("ResourceInstances[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return CheckResourceSetResponse_MetaValidationError{
+						field:  fmt.Sprintf("ResourceInstances[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return CheckResourceSetResponse_MetaMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckResourceSetResponse_MetaMultiError is an error wrapping multiple
+// validation errors returned by CheckResourceSetResponse_Meta.ValidateAll()
+// if the designated constraints aren't met.
+type CheckResourceSetResponse_MetaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckResourceSetResponse_MetaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckResourceSetResponse_MetaMultiError) AllErrors() []error { return m }
+
+// CheckResourceSetResponse_MetaValidationError is the validation error
+// returned by CheckResourceSetResponse_Meta.Validate if the designated
+// constraints aren't met.
+type CheckResourceSetResponse_MetaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckResourceSetResponse_MetaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckResourceSetResponse_MetaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckResourceSetResponse_MetaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckResourceSetResponse_MetaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckResourceSetResponse_MetaValidationError) ErrorName() string {
+	return "CheckResourceSetResponse_MetaValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckResourceSetResponse_MetaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckResourceSetResponse_Meta.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckResourceSetResponse_MetaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckResourceSetResponse_MetaValidationError{}
+
+// Validate checks the field values on CheckResourceSetResponse_Meta_EffectMeta
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CheckResourceSetResponse_Meta_EffectMeta) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// CheckResourceSetResponse_Meta_EffectMeta with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// CheckResourceSetResponse_Meta_EffectMetaMultiError, or nil if none found.
+func (m *CheckResourceSetResponse_Meta_EffectMeta) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckResourceSetResponse_Meta_EffectMeta) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MatchedPolicy
+
+	// no validation rules for MatchedScope
+
+	if len(errors) > 0 {
+		return CheckResourceSetResponse_Meta_EffectMetaMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckResourceSetResponse_Meta_EffectMetaMultiError is an error wrapping
+// multiple validation errors returned by
+// CheckResourceSetResponse_Meta_EffectMeta.ValidateAll() if the designated
+// constraints aren't met.
+type CheckResourceSetResponse_Meta_EffectMetaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckResourceSetResponse_Meta_EffectMetaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckResourceSetResponse_Meta_EffectMetaMultiError) AllErrors() []error { return m }
+
+// CheckResourceSetResponse_Meta_EffectMetaValidationError is the validation
+// error returned by CheckResourceSetResponse_Meta_EffectMeta.Validate if the
+// designated constraints aren't met.
+type CheckResourceSetResponse_Meta_EffectMetaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckResourceSetResponse_Meta_EffectMetaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckResourceSetResponse_Meta_EffectMetaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckResourceSetResponse_Meta_EffectMetaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckResourceSetResponse_Meta_EffectMetaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckResourceSetResponse_Meta_EffectMetaValidationError) ErrorName() string {
+	return "CheckResourceSetResponse_Meta_EffectMetaValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckResourceSetResponse_Meta_EffectMetaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckResourceSetResponse_Meta_EffectMeta.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckResourceSetResponse_Meta_EffectMetaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckResourceSetResponse_Meta_EffectMetaValidationError{}
+
+// Validate checks the field values on CheckResourceSetResponse_Meta_ActionMeta
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CheckResourceSetResponse_Meta_ActionMeta) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// CheckResourceSetResponse_Meta_ActionMeta with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// CheckResourceSetResponse_Meta_ActionMetaMultiError, or nil if none found.
+func (m *CheckResourceSetResponse_Meta_ActionMeta) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckResourceSetResponse_Meta_ActionMeta) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]string, len(m.GetActions()))
+		i := 0
+		for key := range m.GetActions() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetActions()[key]
+			_ = val
+
+			// no validation rules for Actions[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, CheckResourceSetResponse_Meta_ActionMetaValidationError{
+							field:  fmt.Sprintf("Actions[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, CheckResourceSetResponse_Meta_ActionMetaValidationError{
+							field:  fmt.Sprintf("Actions[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return CheckResourceSetResponse_Meta_ActionMetaValidationError{
+						field:  fmt.Sprintf("Actions[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return CheckResourceSetResponse_Meta_ActionMetaMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckResourceSetResponse_Meta_ActionMetaMultiError is an error wrapping
+// multiple validation errors returned by
+// CheckResourceSetResponse_Meta_ActionMeta.ValidateAll() if the designated
+// constraints aren't met.
+type CheckResourceSetResponse_Meta_ActionMetaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckResourceSetResponse_Meta_ActionMetaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckResourceSetResponse_Meta_ActionMetaMultiError) AllErrors() []error { return m }
+
+// CheckResourceSetResponse_Meta_ActionMetaValidationError is the validation
+// error returned by CheckResourceSetResponse_Meta_ActionMeta.Validate if the
+// designated constraints aren't met.
+type CheckResourceSetResponse_Meta_ActionMetaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckResourceSetResponse_Meta_ActionMetaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckResourceSetResponse_Meta_ActionMetaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckResourceSetResponse_Meta_ActionMetaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckResourceSetResponse_Meta_ActionMetaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckResourceSetResponse_Meta_ActionMetaValidationError) ErrorName() string {
+	return "CheckResourceSetResponse_Meta_ActionMetaValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckResourceSetResponse_Meta_ActionMetaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckResourceSetResponse_Meta_ActionMeta.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckResourceSetResponse_Meta_ActionMetaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckResourceSetResponse_Meta_ActionMetaValidationError{}
+
+// Validate checks the field values on
+// CheckResourceBatchResponse_ActionEffectMap with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CheckResourceBatchResponse_ActionEffectMap) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// CheckResourceBatchResponse_ActionEffectMap with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// CheckResourceBatchResponse_ActionEffectMapMultiError, or nil if none found.
+func (m *CheckResourceBatchResponse_ActionEffectMap) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckResourceBatchResponse_ActionEffectMap) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceId
+
+	// no validation rules for Actions
+
+	for idx, item := range m.GetValidationErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckResourceBatchResponse_ActionEffectMapValidationError{
+						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckResourceBatchResponse_ActionEffectMapValidationError{
+						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckResourceBatchResponse_ActionEffectMapValidationError{
+					field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CheckResourceBatchResponse_ActionEffectMapMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckResourceBatchResponse_ActionEffectMapMultiError is an error wrapping
+// multiple validation errors returned by
+// CheckResourceBatchResponse_ActionEffectMap.ValidateAll() if the designated
+// constraints aren't met.
+type CheckResourceBatchResponse_ActionEffectMapMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckResourceBatchResponse_ActionEffectMapMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckResourceBatchResponse_ActionEffectMapMultiError) AllErrors() []error { return m }
+
+// CheckResourceBatchResponse_ActionEffectMapValidationError is the validation
+// error returned by CheckResourceBatchResponse_ActionEffectMap.Validate if
+// the designated constraints aren't met.
+type CheckResourceBatchResponse_ActionEffectMapValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckResourceBatchResponse_ActionEffectMapValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckResourceBatchResponse_ActionEffectMapValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckResourceBatchResponse_ActionEffectMapValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PlaygroundProxyResponseValidationError) Key() bool { return e.key }
+func (e CheckResourceBatchResponse_ActionEffectMapValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PlaygroundProxyResponseValidationError) ErrorName() string {
-	return "PlaygroundProxyResponseValidationError"
+func (e CheckResourceBatchResponse_ActionEffectMapValidationError) ErrorName() string {
+	return "CheckResourceBatchResponse_ActionEffectMapValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PlaygroundProxyResponseValidationError) Error() string {
+func (e CheckResourceBatchResponse_ActionEffectMapValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1702,14 +4671,14 @@ func (e PlaygroundProxyResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPlaygroundProxyResponse.%s: %s%s",
+		"invalid %sCheckResourceBatchResponse_ActionEffectMap.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PlaygroundProxyResponseValidationError{}
+var _ error = CheckResourceBatchResponse_ActionEffectMapValidationError{}
 
 var _ interface {
 	Field() string
@@ -1717,24 +4686,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PlaygroundProxyResponseValidationError{}
+} = CheckResourceBatchResponse_ActionEffectMapValidationError{}
 
-// Validate checks the field values on AddOrUpdatePolicyResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddOrUpdatePolicyResponse) Validate() error {
+// Validate checks the field values on CheckResourcesResponse_ResultEntry with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CheckResourcesResponse_ResultEntry) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddOrUpdatePolicyResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddOrUpdatePolicyResponseMultiError, or nil if none found.
-func (m *AddOrUpdatePolicyResponse) ValidateAll() error {
+// ValidateAll checks the field values on CheckResourcesResponse_ResultEntry
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CheckResourcesResponse_ResultEntryMultiError, or nil if none found.
+func (m *CheckResourcesResponse_ResultEntry) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddOrUpdatePolicyResponse) validate(all bool) error {
+func (m *CheckResourcesResponse_ResultEntry) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1742,48 +4712,148 @@ func (m *AddOrUpdatePolicyResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetSuccess()).(type) {
+		switch v := interface{}(m.GetResource()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AddOrUpdatePolicyResponseValidationError{
-					field:  "Success",
+				errors = append(errors, CheckResourcesResponse_ResultEntryValidationError{
+					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AddOrUpdatePolicyResponseValidationError{
-					field:  "Success",
+				errors = append(errors, CheckResourcesResponse_ResultEntryValidationError{
+					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSuccess()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AddOrUpdatePolicyResponseValidationError{
-				field:  "Success",
+			return CheckResourcesResponse_ResultEntryValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Actions
+
+	for idx, item := range m.GetValidationErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckResourcesResponse_ResultEntryValidationError{
+						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckResourcesResponse_ResultEntryValidationError{
+						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckResourcesResponse_ResultEntryValidationError{
+					field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckResourcesResponse_ResultEntryValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckResourcesResponse_ResultEntryValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckResourcesResponse_ResultEntryValidationError{
+				field:  "Meta",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	for idx, item := range m.GetOutputs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckResourcesResponse_ResultEntryValidationError{
+						field:  fmt.Sprintf("Outputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckResourcesResponse_ResultEntryValidationError{
+						field:  fmt.Sprintf("Outputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckResourcesResponse_ResultEntryValidationError{
+					field:  fmt.Sprintf("Outputs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return AddOrUpdatePolicyResponseMultiError(errors)
+		return CheckResourcesResponse_ResultEntryMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddOrUpdatePolicyResponseMultiError is an error wrapping multiple validation
-// errors returned by AddOrUpdatePolicyResponse.ValidateAll() if the
-// designated constraints aren't met.
-type AddOrUpdatePolicyResponseMultiError []error
+// CheckResourcesResponse_ResultEntryMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckResourcesResponse_ResultEntry.ValidateAll() if the designated
+// constraints aren't met.
+type CheckResourcesResponse_ResultEntryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddOrUpdatePolicyResponseMultiError) Error() string {
+func (m CheckResourcesResponse_ResultEntryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1792,11 +4862,12 @@ func (m AddOrUpdatePolicyResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddOrUpdatePolicyResponseMultiError) AllErrors() []error { return m }
+func (m CheckResourcesResponse_ResultEntryMultiError) AllErrors() []error { return m }
 
-// AddOrUpdatePolicyResponseValidationError is the validation error returned by
-// AddOrUpdatePolicyResponse.Validate if the designated constraints aren't met.
-type AddOrUpdatePolicyResponseValidationError struct {
+// CheckResourcesResponse_ResultEntryValidationError is the validation error
+// returned by CheckResourcesResponse_ResultEntry.Validate if the designated
+// constraints aren't met.
+type CheckResourcesResponse_ResultEntryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1804,24 +4875,24 @@ type AddOrUpdatePolicyResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddOrUpdatePolicyResponseValidationError) Field() string { return e.field }
+func (e CheckResourcesResponse_ResultEntryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddOrUpdatePolicyResponseValidationError) Reason() string { return e.reason }
+func (e CheckResourcesResponse_ResultEntryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddOrUpdatePolicyResponseValidationError) Cause() error { return e.cause }
+func (e CheckResourcesResponse_ResultEntryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddOrUpdatePolicyResponseValidationError) Key() bool { return e.key }
+func (e CheckResourcesResponse_ResultEntryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddOrUpdatePolicyResponseValidationError) ErrorName() string {
-	return "AddOrUpdatePolicyResponseValidationError"
+func (e CheckResourcesResponse_ResultEntryValidationError) ErrorName() string {
+	return "CheckResourcesResponse_ResultEntryValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddOrUpdatePolicyResponseValidationError) Error() string {
+func (e CheckResourcesResponse_ResultEntryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1833,14 +4904,14 @@ func (e AddOrUpdatePolicyResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddOrUpdatePolicyResponse.%s: %s%s",
+		"invalid %sCheckResourcesResponse_ResultEntry.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddOrUpdatePolicyResponseValidationError{}
+var _ error = CheckResourcesResponse_ResultEntryValidationError{}
 
 var _ interface {
 	Field() string
@@ -1848,131 +4919,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddOrUpdatePolicyResponseValidationError{}
+} = CheckResourcesResponse_ResultEntryValidationError{}
 
-// Validate checks the field values on ListAuditLogEntriesResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListAuditLogEntriesResponse) Validate() error {
+// Validate checks the field values on
+// CheckResourcesResponse_ResultEntry_Resource with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CheckResourcesResponse_ResultEntry_Resource) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListAuditLogEntriesResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListAuditLogEntriesResponseMultiError, or nil if none found.
-func (m *ListAuditLogEntriesResponse) ValidateAll() error {
+// ValidateAll checks the field values on
+// CheckResourcesResponse_ResultEntry_Resource with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// CheckResourcesResponse_ResultEntry_ResourceMultiError, or nil if none found.
+func (m *CheckResourcesResponse_ResultEntry_Resource) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListAuditLogEntriesResponse) validate(all bool) error {
+func (m *CheckResourcesResponse_ResultEntry_Resource) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Entry.(type) {
-	case *ListAuditLogEntriesResponse_AccessLogEntry:
-		if v == nil {
-			err := ListAuditLogEntriesResponseValidationError{
-				field:  "Entry",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetAccessLogEntry()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListAuditLogEntriesResponseValidationError{
-						field:  "AccessLogEntry",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListAuditLogEntriesResponseValidationError{
-						field:  "AccessLogEntry",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetAccessLogEntry()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListAuditLogEntriesResponseValidationError{
-					field:  "AccessLogEntry",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Id
 
-	case *ListAuditLogEntriesResponse_DecisionLogEntry:
-		if v == nil {
-			err := ListAuditLogEntriesResponseValidationError{
-				field:  "Entry",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for Kind
 
-		if all {
-			switch v := interface{}(m.GetDecisionLogEntry()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListAuditLogEntriesResponseValidationError{
-						field:  "DecisionLogEntry",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListAuditLogEntriesResponseValidationError{
-						field:  "DecisionLogEntry",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetDecisionLogEntry()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListAuditLogEntriesResponseValidationError{
-					field:  "DecisionLogEntry",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for PolicyVersion
 
-	default:
-		_ = v // ensures v is used
-	}
+	// no validation rules for Scope
 
 	if len(errors) > 0 {
-		return ListAuditLogEntriesResponseMultiError(errors)
+		return CheckResourcesResponse_ResultEntry_ResourceMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListAuditLogEntriesResponseMultiError is an error wrapping multiple
-// validation errors returned by ListAuditLogEntriesResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListAuditLogEntriesResponseMultiError []error
+// CheckResourcesResponse_ResultEntry_ResourceMultiError is an error wrapping
+// multiple validation errors returned by
+// CheckResourcesResponse_ResultEntry_Resource.ValidateAll() if the designated
+// constraints aren't met.
+type CheckResourcesResponse_ResultEntry_ResourceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListAuditLogEntriesResponseMultiError) Error() string {
+func (m CheckResourcesResponse_ResultEntry_ResourceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1981,12 +4976,12 @@ func (m ListAuditLogEntriesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListAuditLogEntriesResponseMultiError) AllErrors() []error { return m }
+func (m CheckResourcesResponse_ResultEntry_ResourceMultiError) AllErrors() []error { return m }
 
-// ListAuditLogEntriesResponseValidationError is the validation error returned
-// by ListAuditLogEntriesResponse.Validate if the designated constraints
-// aren't met.
-type ListAuditLogEntriesResponseValidationError struct {
+// CheckResourcesResponse_ResultEntry_ResourceValidationError is the validation
+// error returned by CheckResourcesResponse_ResultEntry_Resource.Validate if
+// the designated constraints aren't met.
+type CheckResourcesResponse_ResultEntry_ResourceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1994,24 +4989,24 @@ type ListAuditLogEntriesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListAuditLogEntriesResponseValidationError) Field() string { return e.field }
+func (e CheckResourcesResponse_ResultEntry_ResourceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListAuditLogEntriesResponseValidationError) Reason() string { return e.reason }
+func (e CheckResourcesResponse_ResultEntry_ResourceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListAuditLogEntriesResponseValidationError) Cause() error { return e.cause }
+func (e CheckResourcesResponse_ResultEntry_ResourceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListAuditLogEntriesResponseValidationError) Key() bool { return e.key }
+func (e CheckResourcesResponse_ResultEntry_ResourceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListAuditLogEntriesResponseValidationError) ErrorName() string {
-	return "ListAuditLogEntriesResponseValidationError"
+func (e CheckResourcesResponse_ResultEntry_ResourceValidationError) ErrorName() string {
+	return "CheckResourcesResponse_ResultEntry_ResourceValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListAuditLogEntriesResponseValidationError) Error() string {
+func (e CheckResourcesResponse_ResultEntry_ResourceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2023,14 +5018,14 @@ func (e ListAuditLogEntriesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListAuditLogEntriesResponse.%s: %s%s",
+		"invalid %sCheckResourcesResponse_ResultEntry_Resource.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListAuditLogEntriesResponseValidationError{}
+var _ error = CheckResourcesResponse_ResultEntry_ResourceValidationError{}
 
 var _ interface {
 	Field() string
@@ -2038,50 +5033,93 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListAuditLogEntriesResponseValidationError{}
+} = CheckResourcesResponse_ResultEntry_ResourceValidationError{}
 
-// Validate checks the field values on ServerInfoResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ServerInfoResponse) Validate() error {
+// Validate checks the field values on CheckResourcesResponse_ResultEntry_Meta
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CheckResourcesResponse_ResultEntry_Meta) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ServerInfoResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ServerInfoResponseMultiError, or nil if none found.
-func (m *ServerInfoResponse) ValidateAll() error {
+// ValidateAll checks the field values on
+// CheckResourcesResponse_ResultEntry_Meta with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// CheckResourcesResponse_ResultEntry_MetaMultiError, or nil if none found.
+func (m *CheckResourcesResponse_ResultEntry_Meta) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ServerInfoResponse) validate(all bool) error {
+func (m *CheckResourcesResponse_ResultEntry_Meta) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Version
+	{
+		sorted_keys := make([]string, len(m.GetActions()))
+		i := 0
+		for key := range m.GetActions() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetActions()[key]
+			_ = val
 
-	// no validation rules for Commit
+			// no validation rules for Actions[key]
 
-	// no validation rules for BuildDate
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, CheckResourcesResponse_ResultEntry_MetaValidationError{
+							field:  fmt.Sprintf("Actions[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, CheckResourcesResponse_ResultEntry_MetaValidationError{
+							field:  fmt.Sprintf("Actions[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return CheckResourcesResponse_ResultEntry_MetaValidationError{
+						field:  fmt.Sprintf("Actions[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
 
 	if len(errors) > 0 {
-		return ServerInfoResponseMultiError(errors)
+		return CheckResourcesResponse_ResultEntry_MetaMultiError(errors)
 	}
 
 	return nil
 }
 
-// ServerInfoResponseMultiError is an error wrapping multiple validation errors
-// returned by ServerInfoResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ServerInfoResponseMultiError []error
+// CheckResourcesResponse_ResultEntry_MetaMultiError is an error wrapping
+// multiple validation errors returned by
+// CheckResourcesResponse_ResultEntry_Meta.ValidateAll() if the designated
+// constraints aren't met.
+type CheckResourcesResponse_ResultEntry_MetaMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ServerInfoResponseMultiError) Error() string {
+func (m CheckResourcesResponse_ResultEntry_MetaMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2090,11 +5128,12 @@ func (m ServerInfoResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ServerInfoResponseMultiError) AllErrors() []error { return m }
+func (m CheckResourcesResponse_ResultEntry_MetaMultiError) AllErrors() []error { return m }
 
-// ServerInfoResponseValidationError is the validation error returned by
-// ServerInfoResponse.Validate if the designated constraints aren't met.
-type ServerInfoResponseValidationError struct {
+// CheckResourcesResponse_ResultEntry_MetaValidationError is the validation
+// error returned by CheckResourcesResponse_ResultEntry_Meta.Validate if the
+// designated constraints aren't met.
+type CheckResourcesResponse_ResultEntry_MetaValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2102,24 +5141,24 @@ type ServerInfoResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ServerInfoResponseValidationError) Field() string { return e.field }
+func (e CheckResourcesResponse_ResultEntry_MetaValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ServerInfoResponseValidationError) Reason() string { return e.reason }
+func (e CheckResourcesResponse_ResultEntry_MetaValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ServerInfoResponseValidationError) Cause() error { return e.cause }
+func (e CheckResourcesResponse_ResultEntry_MetaValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ServerInfoResponseValidationError) Key() bool { return e.key }
+func (e CheckResourcesResponse_ResultEntry_MetaValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ServerInfoResponseValidationError) ErrorName() string {
-	return "ServerInfoResponseValidationError"
+func (e CheckResourcesResponse_ResultEntry_MetaValidationError) ErrorName() string {
+	return "CheckResourcesResponse_ResultEntry_MetaValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ServerInfoResponseValidationError) Error() string {
+func (e CheckResourcesResponse_ResultEntry_MetaValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2131,14 +5170,14 @@ func (e ServerInfoResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sServerInfoResponse.%s: %s%s",
+		"invalid %sCheckResourcesResponse_ResultEntry_Meta.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ServerInfoResponseValidationError{}
+var _ error = CheckResourcesResponse_ResultEntry_MetaValidationError{}
 
 var _ interface {
 	Field() string
@@ -2146,44 +5185,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ServerInfoResponseValidationError{}
+} = CheckResourcesResponse_ResultEntry_MetaValidationError{}
 
-// Validate checks the field values on ListPoliciesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListPoliciesResponse) Validate() error {
+// Validate checks the field values on
+// CheckResourcesResponse_ResultEntry_Meta_EffectMeta with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CheckResourcesResponse_ResultEntry_Meta_EffectMeta) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPoliciesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListPoliciesResponseMultiError, or nil if none found.
-func (m *ListPoliciesResponse) ValidateAll() error {
+// ValidateAll checks the field values on
+// CheckResourcesResponse_ResultEntry_Meta_EffectMeta with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// CheckResourcesResponse_ResultEntry_Meta_EffectMetaMultiError, or nil if
+// none found.
+func (m *CheckResourcesResponse_ResultEntry_Meta_EffectMeta) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPoliciesResponse) validate(all bool) error {
+func (m *CheckResourcesResponse_ResultEntry_Meta_EffectMeta) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for MatchedPolicy
+
+	// no validation rules for MatchedScope
+
 	if len(errors) > 0 {
-		return ListPoliciesResponseMultiError(errors)
+		return CheckResourcesResponse_ResultEntry_Meta_EffectMetaMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPoliciesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListPoliciesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListPoliciesResponseMultiError []error
+// CheckResourcesResponse_ResultEntry_Meta_EffectMetaMultiError is an error
+// wrapping multiple validation errors returned by
+// CheckResourcesResponse_ResultEntry_Meta_EffectMeta.ValidateAll() if the
+// designated constraints aren't met.
+type CheckResourcesResponse_ResultEntry_Meta_EffectMetaMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPoliciesResponseMultiError) Error() string {
+func (m CheckResourcesResponse_ResultEntry_Meta_EffectMetaMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2192,11 +5239,13 @@ func (m ListPoliciesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPoliciesResponseMultiError) AllErrors() []error { return m }
+func (m CheckResourcesResponse_ResultEntry_Meta_EffectMetaMultiError) AllErrors() []error { return m }
 
-// ListPoliciesResponseValidationError is the validation error returned by
-// ListPoliciesResponse.Validate if the designated constraints aren't met.
-type ListPoliciesResponseValidationError struct {
+// CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError is the
+// validation error returned by
+// CheckResourcesResponse_ResultEntry_Meta_EffectMeta.Validate if the
+// designated constraints aren't met.
+type CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2204,24 +5253,30 @@ type ListPoliciesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPoliciesResponseValidationError) Field() string { return e.field }
+func (e CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError) Field() string {
+	return e.field
+}
 
 // Reason function returns reason value.
-func (e ListPoliciesResponseValidationError) Reason() string { return e.reason }
+func (e CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError) Reason() string {
+	return e.reason
+}
 
 // Cause function returns cause value.
-func (e ListPoliciesResponseValidationError) Cause() error { return e.cause }
+func (e CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError) Cause() error {
+	return e.cause
+}
 
 // Key function returns key value.
-func (e ListPoliciesResponseValidationError) Key() bool { return e.key }
+func (e CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPoliciesResponseValidationError) ErrorName() string {
-	return "ListPoliciesResponseValidationError"
+func (e CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError) ErrorName() string {
+	return "CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListPoliciesResponseValidationError) Error() string {
+func (e CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2233,14 +5288,14 @@ func (e ListPoliciesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPoliciesResponse.%s: %s%s",
+		"invalid %sCheckResourcesResponse_ResultEntry_Meta_EffectMeta.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPoliciesResponseValidationError{}
+var _ error = CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError{}
 
 var _ interface {
 	Field() string
@@ -2248,78 +5303,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPoliciesResponseValidationError{}
+} = CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError{}
 
-// Validate checks the field values on GetPolicyResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetPolicyResponse) Validate() error {
+// Validate checks the field values on PlaygroundFailure_Error with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlaygroundFailure_Error) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPolicyResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PlaygroundFailure_Error with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetPolicyResponseMultiError, or nil if none found.
-func (m *GetPolicyResponse) ValidateAll() error {
+// PlaygroundFailure_ErrorMultiError, or nil if none found.
+func (m *PlaygroundFailure_Error) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPolicyResponse) validate(all bool) error {
+func (m *PlaygroundFailure_Error) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPolicies() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetPolicyResponseValidationError{
-						field:  fmt.Sprintf("Policies[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetPolicyResponseValidationError{
-						field:  fmt.Sprintf("Policies[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetPolicyResponseValidationError{
-					field:  fmt.Sprintf("Policies[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for File
 
-	}
+	// no validation rules for Error
 
 	if len(errors) > 0 {
-		return GetPolicyResponseMultiError(errors)
+		return PlaygroundFailure_ErrorMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPolicyResponseMultiError is an error wrapping multiple validation errors
-// returned by GetPolicyResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetPolicyResponseMultiError []error
+// PlaygroundFailure_ErrorMultiError is an error wrapping multiple validation
+// errors returned by PlaygroundFailure_Error.ValidateAll() if the designated
+// constraints aren't met.
+type PlaygroundFailure_ErrorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPolicyResponseMultiError) Error() string {
+func (m PlaygroundFailure_ErrorMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2328,11 +5353,11 @@ func (m GetPolicyResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPolicyResponseMultiError) AllErrors() []error { return m }
+func (m PlaygroundFailure_ErrorMultiError) AllErrors() []error { return m }
 
-// GetPolicyResponseValidationError is the validation error returned by
-// GetPolicyResponse.Validate if the designated constraints aren't met.
-type GetPolicyResponseValidationError struct {
+// PlaygroundFailure_ErrorValidationError is the validation error returned by
+// PlaygroundFailure_Error.Validate if the designated constraints aren't met.
+type PlaygroundFailure_ErrorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2340,24 +5365,24 @@ type GetPolicyResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPolicyResponseValidationError) Field() string { return e.field }
+func (e PlaygroundFailure_ErrorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPolicyResponseValidationError) Reason() string { return e.reason }
+func (e PlaygroundFailure_ErrorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPolicyResponseValidationError) Cause() error { return e.cause }
+func (e PlaygroundFailure_ErrorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPolicyResponseValidationError) Key() bool { return e.key }
+func (e PlaygroundFailure_ErrorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPolicyResponseValidationError) ErrorName() string {
-	return "GetPolicyResponseValidationError"
+func (e PlaygroundFailure_ErrorValidationError) ErrorName() string {
+	return "PlaygroundFailure_ErrorValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetPolicyResponseValidationError) Error() string {
+func (e PlaygroundFailure_ErrorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2369,14 +5394,14 @@ func (e GetPolicyResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPolicyResponse.%s: %s%s",
+		"invalid %sPlaygroundFailure_Error.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPolicyResponseValidationError{}
+var _ error = PlaygroundFailure_ErrorValidationError{}
 
 var _ interface {
 	Field() string
@@ -2384,46 +5409,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPolicyResponseValidationError{}
+} = PlaygroundFailure_ErrorValidationError{}
 
-// Validate checks the field values on DisablePolicyResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DisablePolicyResponse) Validate() error {
+// Validate checks the field values on PlaygroundTestResponse_TestResults with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PlaygroundTestResponse_TestResults) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DisablePolicyResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DisablePolicyResponseMultiError, or nil if none found.
-func (m *DisablePolicyResponse) ValidateAll() error {
+// ValidateAll checks the field values on PlaygroundTestResponse_TestResults
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PlaygroundTestResponse_TestResultsMultiError, or nil if none found.
+func (m *PlaygroundTestResponse_TestResults) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DisablePolicyResponse) validate(all bool) error {
+func (m *PlaygroundTestResponse_TestResults) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DisabledPolicies
+	if all {
+		switch v := interface{}(m.GetResults()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlaygroundTestResponse_TestResultsValidationError{
+					field:  "Results",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlaygroundTestResponse_TestResultsValidationError{
+					field:  "Results",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResults()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlaygroundTestResponse_TestResultsValidationError{
+				field:  "Results",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DisablePolicyResponseMultiError(errors)
+		return PlaygroundTestResponse_TestResultsMultiError(errors)
 	}
 
 	return nil
 }
 
-// DisablePolicyResponseMultiError is an error wrapping multiple validation
-// errors returned by DisablePolicyResponse.ValidateAll() if the designated
+// PlaygroundTestResponse_TestResultsMultiError is an error wrapping multiple
+// validation errors returned by
+// PlaygroundTestResponse_TestResults.ValidateAll() if the designated
 // constraints aren't met.
-type DisablePolicyResponseMultiError []error
+type PlaygroundTestResponse_TestResultsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DisablePolicyResponseMultiError) Error() string {
+func (m PlaygroundTestResponse_TestResultsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2432,11 +5486,12 @@ func (m DisablePolicyResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DisablePolicyResponseMultiError) AllErrors() []error { return m }
-
-// DisablePolicyResponseValidationError is the validation error returned by
-// DisablePolicyResponse.Validate if the designated constraints aren't met.
-type DisablePolicyResponseValidationError struct {
+func (m PlaygroundTestResponse_TestResultsMultiError) AllErrors() []error { return m }
+
+// PlaygroundTestResponse_TestResultsValidationError is the validation error
+// returned by PlaygroundTestResponse_TestResults.Validate if the designated
+// constraints aren't met.
+type PlaygroundTestResponse_TestResultsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2444,24 +5499,24 @@ type DisablePolicyResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DisablePolicyResponseValidationError) Field() string { return e.field }
+func (e PlaygroundTestResponse_TestResultsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DisablePolicyResponseValidationError) Reason() string { return e.reason }
+func (e PlaygroundTestResponse_TestResultsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DisablePolicyResponseValidationError) Cause() error { return e.cause }
+func (e PlaygroundTestResponse_TestResultsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DisablePolicyResponseValidationError) Key() bool { return e.key }
+func (e PlaygroundTestResponse_TestResultsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DisablePolicyResponseValidationError) ErrorName() string {
-	return "DisablePolicyResponseValidationError"
+func (e PlaygroundTestResponse_TestResultsValidationError) ErrorName() string {
+	return "PlaygroundTestResponse_TestResultsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DisablePolicyResponseValidationError) Error() string {
+func (e PlaygroundTestResponse_TestResultsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2473,14 +5528,14 @@ func (e DisablePolicyResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDisablePolicyResponse.%s: %s%s",
+		"invalid %sPlaygroundTestResponse_TestResults.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DisablePolicyResponseValidationError{}
+var _ error = PlaygroundTestResponse_TestResultsValidationError{}
 
 var _ interface {
 	Field() string
@@ -2488,46 +5543,86 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DisablePolicyResponseValidationError{}
+} = PlaygroundTestResponse_TestResultsValidationError{}
 
-// Validate checks the field values on EnablePolicyResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EnablePolicyResponse) Validate() error {
+// Validate checks the field values on PlaygroundEvaluateResponse_EvalResult
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *PlaygroundEvaluateResponse_EvalResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EnablePolicyResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// EnablePolicyResponseMultiError, or nil if none found.
-func (m *EnablePolicyResponse) ValidateAll() error {
+// ValidateAll checks the field values on PlaygroundEvaluateResponse_EvalResult
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PlaygroundEvaluateResponse_EvalResultMultiError, or nil if none found.
+func (m *PlaygroundEvaluateResponse_EvalResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EnablePolicyResponse) validate(all bool) error {
+func (m *PlaygroundEvaluateResponse_EvalResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for EnabledPolicies
+	// no validation rules for Action
+
+	// no validation rules for Effect
+
+	// no validation rules for Policy
+
+	for idx, item := range m.GetValidationErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlaygroundEvaluateResponse_EvalResultValidationError{
+						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlaygroundEvaluateResponse_EvalResultValidationError{
+						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlaygroundEvaluateResponse_EvalResultValidationError{
+					field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return EnablePolicyResponseMultiError(errors)
+		return PlaygroundEvaluateResponse_EvalResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// EnablePolicyResponseMultiError is an error wrapping multiple validation
-// errors returned by EnablePolicyResponse.ValidateAll() if the designated
+// PlaygroundEvaluateResponse_EvalResultMultiError is an error wrapping
+// multiple validation errors returned by
+// PlaygroundEvaluateResponse_EvalResult.ValidateAll() if the designated
 // constraints aren't met.
-type EnablePolicyResponseMultiError []error
+type PlaygroundEvaluateResponse_EvalResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EnablePolicyResponseMultiError) Error() string {
+func (m PlaygroundEvaluateResponse_EvalResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2536,11 +5631,12 @@ func (m EnablePolicyResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EnablePolicyResponseMultiError) AllErrors() []error { return m }
+func (m PlaygroundEvaluateResponse_EvalResultMultiError) AllErrors() []error { return m }
 
-// EnablePolicyResponseValidationError is the validation error returned by
-// EnablePolicyResponse.Validate if the designated constraints aren't met.
-type EnablePolicyResponseValidationError struct {
+// PlaygroundEvaluateResponse_EvalResultValidationError is the validation error
+// returned by PlaygroundEvaluateResponse_EvalResult.Validate if the
+// designated constraints aren't met.
+type PlaygroundEvaluateResponse_EvalResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2548,24 +5644,24 @@ type EnablePolicyResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e EnablePolicyResponseValidationError) Field() string { return e.field }
+func (e PlaygroundEvaluateResponse_EvalResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EnablePolicyResponseValidationError) Reason() string { return e.reason }
+func (e PlaygroundEvaluateResponse_EvalResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EnablePolicyResponseValidationError) Cause() error { return e.cause }
+func (e PlaygroundEvaluateResponse_EvalResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EnablePolicyResponseValidationError) Key() bool { return e.key }
+func (e PlaygroundEvaluateResponse_EvalResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EnablePolicyResponseValidationError) ErrorName() string {
-	return "EnablePolicyResponseValidationError"
+func (e PlaygroundEvaluateResponse_EvalResultValidationError) ErrorName() string {
+	return "PlaygroundEvaluateResponse_EvalResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EnablePolicyResponseValidationError) Error() string {
+func (e PlaygroundEvaluateResponse_EvalResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2577,14 +5673,14 @@ func (e EnablePolicyResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEnablePolicyResponse.%s: %s%s",
+		"invalid %sPlaygroundEvaluateResponse_EvalResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EnablePolicyResponseValidationError{}
+var _ error = PlaygroundEvaluateResponse_EvalResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -2592,44 +5688,149 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EnablePolicyResponseValidationError{}
+} = PlaygroundEvaluateResponse_EvalResultValidationError{}
 
-// Validate checks the field values on AddOrUpdateSchemaResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddOrUpdateSchemaResponse) Validate() error {
+// Validate checks the field values on
+// PlaygroundEvaluateResponse_EvalResultList with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PlaygroundEvaluateResponse_EvalResultList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddOrUpdateSchemaResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddOrUpdateSchemaResponseMultiError, or nil if none found.
-func (m *AddOrUpdateSchemaResponse) ValidateAll() error {
+// ValidateAll checks the field values on
+// PlaygroundEvaluateResponse_EvalResultList with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// PlaygroundEvaluateResponse_EvalResultListMultiError, or nil if none found.
+func (m *PlaygroundEvaluateResponse_EvalResultList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddOrUpdateSchemaResponse) validate(all bool) error {
+func (m *PlaygroundEvaluateResponse_EvalResultList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlaygroundEvaluateResponse_EvalResultListValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlaygroundEvaluateResponse_EvalResultListValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlaygroundEvaluateResponse_EvalResultListValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetValidationErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlaygroundEvaluateResponse_EvalResultListValidationError{
+						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlaygroundEvaluateResponse_EvalResultListValidationError{
+						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlaygroundEvaluateResponse_EvalResultListValidationError{
+					field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetOutputs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlaygroundEvaluateResponse_EvalResultListValidationError{
+						field:  fmt.Sprintf("Outputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlaygroundEvaluateResponse_EvalResultListValidationError{
+						field:  fmt.Sprintf("Outputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlaygroundEvaluateResponse_EvalResultListValidationError{
+					field:  fmt.Sprintf("Outputs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return AddOrUpdateSchemaResponseMultiError(errors)
+		return PlaygroundEvaluateResponse_EvalResultListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddOrUpdateSchemaResponseMultiError is an error wrapping multiple validation
-// errors returned by AddOrUpdateSchemaResponse.ValidateAll() if the
-// designated constraints aren't met.
-type AddOrUpdateSchemaResponseMultiError []error
+// PlaygroundEvaluateResponse_EvalResultListMultiError is an error wrapping
+// multiple validation errors returned by
+// PlaygroundEvaluateResponse_EvalResultList.ValidateAll() if the designated
+// constraints aren't met.
+type PlaygroundEvaluateResponse_EvalResultListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddOrUpdateSchemaResponseMultiError) Error() string {
+func (m PlaygroundEvaluateResponse_EvalResultListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2638,11 +5839,12 @@ func (m AddOrUpdateSchemaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddOrUpdateSchemaResponseMultiError) AllErrors() []error { return m }
+func (m PlaygroundEvaluateResponse_EvalResultListMultiError) AllErrors() []error { return m }
 
-// AddOrUpdateSchemaResponseValidationError is the validation error returned by
-// AddOrUpdateSchemaResponse.Validate if the designated constraints aren't met.
-type AddOrUpdateSchemaResponseValidationError struct {
+// PlaygroundEvaluateResponse_EvalResultListValidationError is the validation
+// error returned by PlaygroundEvaluateResponse_EvalResultList.Validate if the
+// designated constraints aren't met.
+type PlaygroundEvaluateResponse_EvalResultListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2650,24 +5852,24 @@ type AddOrUpdateSchemaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddOrUpdateSchemaResponseValidationError) Field() string { return e.field }
+func (e PlaygroundEvaluateResponse_EvalResultListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddOrUpdateSchemaResponseValidationError) Reason() string { return e.reason }
+func (e PlaygroundEvaluateResponse_EvalResultListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddOrUpdateSchemaResponseValidationError) Cause() error { return e.cause }
+func (e PlaygroundEvaluateResponse_EvalResultListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddOrUpdateSchemaResponseValidationError) Key() bool { return e.key }
+func (e PlaygroundEvaluateResponse_EvalResultListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddOrUpdateSchemaResponseValidationError) ErrorName() string {
-	return "AddOrUpdateSchemaResponseValidationError"
+func (e PlaygroundEvaluateResponse_EvalResultListValidationError) ErrorName() string {
+	return "PlaygroundEvaluateResponse_EvalResultListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddOrUpdateSchemaResponseValidationError) Error() string {
+func (e PlaygroundEvaluateResponse_EvalResultListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2679,14 +5881,14 @@ func (e AddOrUpdateSchemaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddOrUpdateSchemaResponse.%s: %s%s",
+		"invalid %sPlaygroundEvaluateResponse_EvalResultList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddOrUpdateSchemaResponseValidationError{}
+var _ error = PlaygroundEvaluateResponse_EvalResultListValidationError{}
 
 var _ interface {
 	Field() string
@@ -2694,44 +5896,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddOrUpdateSchemaResponseValidationError{}
+} = PlaygroundEvaluateResponse_EvalResultListValidationError{}
 
-// Validate checks the field values on ListSchemasResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListSchemasResponse) Validate() error {
+// Validate checks the field values on ExportPolicySnapshotResponse_Metadata
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ExportPolicySnapshotResponse_Metadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListSchemasResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListSchemasResponseMultiError, or nil if none found.
-func (m *ListSchemasResponse) ValidateAll() error {
+// ValidateAll checks the field values on ExportPolicySnapshotResponse_Metadata
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ExportPolicySnapshotResponse_MetadataMultiError, or nil if none found.
+func (m *ExportPolicySnapshotResponse_Metadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListSchemasResponse) validate(all bool) error {
+func (m *ExportPolicySnapshotResponse_Metadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CerbosVersion
+
+	// no validation rules for StoreDriver
+
+	// no validation rules for StoreRevision
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportPolicySnapshotResponse_MetadataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportPolicySnapshotResponse_MetadataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportPolicySnapshotResponse_MetadataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return ListSchemasResponseMultiError(errors)
+		return ExportPolicySnapshotResponse_MetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListSchemasResponseMultiError is an error wrapping multiple validation
-// errors returned by ListSchemasResponse.ValidateAll() if the designated
+// ExportPolicySnapshotResponse_MetadataMultiError is an error wrapping
+// multiple validation errors returned by
+// ExportPolicySnapshotResponse_Metadata.ValidateAll() if the designated
 // constraints aren't met.
-type ListSchemasResponseMultiError []error
+type ExportPolicySnapshotResponse_MetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListSchemasResponseMultiError) Error() string {
+func (m ExportPolicySnapshotResponse_MetadataMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2740,11 +5979,12 @@ func (m ListSchemasResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListSchemasResponseMultiError) AllErrors() []error { return m }
+func (m ExportPolicySnapshotResponse_MetadataMultiError) AllErrors() []error { return m }
 
-// ListSchemasResponseValidationError is the validation error returned by
-// ListSchemasResponse.Validate if the designated constraints aren't met.
-type ListSchemasResponseValidationError struct {
+// ExportPolicySnapshotResponse_MetadataValidationError is the validation error
+// returned by ExportPolicySnapshotResponse_Metadata.Validate if the
+// designated constraints aren't met.
+type ExportPolicySnapshotResponse_MetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2752,24 +5992,24 @@ type ListSchemasResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListSchemasResponseValidationError) Field() string { return e.field }
+func (e ExportPolicySnapshotResponse_MetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListSchemasResponseValidationError) Reason() string { return e.reason }
+func (e ExportPolicySnapshotResponse_MetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListSchemasResponseValidationError) Cause() error { return e.cause }
+func (e ExportPolicySnapshotResponse_MetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListSchemasResponseValidationError) Key() bool { return e.key }
+func (e ExportPolicySnapshotResponse_MetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListSchemasResponseValidationError) ErrorName() string {
-	return "ListSchemasResponseValidationError"
+func (e ExportPolicySnapshotResponse_MetadataValidationError) ErrorName() string {
+	return "ExportPolicySnapshotResponse_MetadataValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListSchemasResponseValidationError) Error() string {
+func (e ExportPolicySnapshotResponse_MetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2781,14 +6021,14 @@ func (e ListSchemasResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListSchemasResponse.%s: %s%s",
+		"invalid %sExportPolicySnapshotResponse_Metadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListSchemasResponseValidationError{}
+var _ error = ExportPolicySnapshotResponse_MetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -2796,78 +6036,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListSchemasResponseValidationError{}
+} = ExportPolicySnapshotResponse_MetadataValidationError{}
 
-// Validate checks the field values on GetSchemaResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetSchemaResponse) Validate() error {
+// Validate checks the field values on ExportPolicySnapshotResponse_Entry with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ExportPolicySnapshotResponse_Entry) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSchemaResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetSchemaResponseMultiError, or nil if none found.
-func (m *GetSchemaResponse) ValidateAll() error {
+// ValidateAll checks the field values on ExportPolicySnapshotResponse_Entry
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ExportPolicySnapshotResponse_EntryMultiError, or nil if none found.
+func (m *ExportPolicySnapshotResponse_Entry) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSchemaResponse) validate(all bool) error {
+func (m *ExportPolicySnapshotResponse_Entry) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSchemas() {
-		_, _ = idx, item
+	// no validation rules for PolicyId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetSchemaResponseValidationError{
-						field:  fmt.Sprintf("Schemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetSchemaResponseValidationError{
-						field:  fmt.Sprintf("Schemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for Fqn
+
+	if all {
+		switch v := interface{}(m.GetPolicySet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportPolicySnapshotResponse_EntryValidationError{
+					field:  "PolicySet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GetSchemaResponseValidationError{
-					field:  fmt.Sprintf("Schemas[%v]", idx),
+				errors = append(errors, ExportPolicySnapshotResponse_EntryValidationError{
+					field:  "PolicySet",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicySet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportPolicySnapshotResponse_EntryValidationError{
+				field:  "PolicySet",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return GetSchemaResponseMultiError(errors)
+		return ExportPolicySnapshotResponse_EntryMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSchemaResponseMultiError is an error wrapping multiple validation errors
-// returned by GetSchemaResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetSchemaResponseMultiError []error
+// ExportPolicySnapshotResponse_EntryMultiError is an error wrapping multiple
+// validation errors returned by
+// ExportPolicySnapshotResponse_Entry.ValidateAll() if the designated
+// constraints aren't met.
+type ExportPolicySnapshotResponse_EntryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSchemaResponseMultiError) Error() string {
+func (m ExportPolicySnapshotResponse_EntryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2876,11 +6117,12 @@ func (m GetSchemaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSchemaResponseMultiError) AllErrors() []error { return m }
+func (m ExportPolicySnapshotResponse_EntryMultiError) AllErrors() []error { return m }
 
-// GetSchemaResponseValidationError is the validation error returned by
-// GetSchemaResponse.Validate if the designated constraints aren't met.
-type GetSchemaResponseValidationError struct {
+// ExportPolicySnapshotResponse_EntryValidationError is the validation error
+// returned by ExportPolicySnapshotResponse_Entry.Validate if the designated
+// constraints aren't met.
+type ExportPolicySnapshotResponse_EntryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2888,24 +6130,24 @@ type GetSchemaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSchemaResponseValidationError) Field() string { return e.field }
+func (e ExportPolicySnapshotResponse_EntryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSchemaResponseValidationError) Reason() string { return e.reason }
+func (e ExportPolicySnapshotResponse_EntryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSchemaResponseValidationError) Cause() error { return e.cause }
+func (e ExportPolicySnapshotResponse_EntryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSchemaResponseValidationError) Key() bool { return e.key }
+func (e ExportPolicySnapshotResponse_EntryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSchemaResponseValidationError) ErrorName() string {
-	return "GetSchemaResponseValidationError"
+func (e ExportPolicySnapshotResponse_EntryValidationError) ErrorName() string {
+	return "ExportPolicySnapshotResponse_EntryValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSchemaResponseValidationError) Error() string {
+func (e ExportPolicySnapshotResponse_EntryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2917,14 +6159,14 @@ func (e GetSchemaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSchemaResponse.%s: %s%s",
+		"invalid %sExportPolicySnapshotResponse_Entry.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSchemaResponseValidationError{}
+var _ error = ExportPolicySnapshotResponse_EntryValidationError{}
 
 var _ interface {
 	Field() string
@@ -2932,46 +6174,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSchemaResponseValidationError{}
+} = ExportPolicySnapshotResponse_EntryValidationError{}
 
-// Validate checks the field values on DeleteSchemaResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on InspectEffectiveRulesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteSchemaResponse) Validate() error {
+func (m *InspectEffectiveRulesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteSchemaResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteSchemaResponseMultiError, or nil if none found.
-func (m *DeleteSchemaResponse) ValidateAll() error {
+// ValidateAll checks the field values on InspectEffectiveRulesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InspectEffectiveRulesResponseMultiError, or nil if none found.
+func (m *InspectEffectiveRulesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteSchemaResponse) validate(all bool) error {
+func (m *InspectEffectiveRulesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DeletedSchemas
+	// no validation rules for PolicyFqn
+
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InspectEffectiveRulesResponseValidationError{
+						field:  fmt.Sprintf("Scopes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InspectEffectiveRulesResponseValidationError{
+						field:  fmt.Sprintf("Scopes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InspectEffectiveRulesResponseValidationError{
+					field:  fmt.Sprintf("Scopes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return DeleteSchemaResponseMultiError(errors)
+		return InspectEffectiveRulesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteSchemaResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteSchemaResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteSchemaResponseMultiError []error
+// InspectEffectiveRulesResponseMultiError is an error wrapping multiple
+// validation errors returned by InspectEffectiveRulesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InspectEffectiveRulesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteSchemaResponseMultiError) Error() string {
+func (m InspectEffectiveRulesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2980,11 +6256,12 @@ func (m DeleteSchemaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteSchemaResponseMultiError) AllErrors() []error { return m }
+func (m InspectEffectiveRulesResponseMultiError) AllErrors() []error { return m }
 
-// DeleteSchemaResponseValidationError is the validation error returned by
-// DeleteSchemaResponse.Validate if the designated constraints aren't met.
-type DeleteSchemaResponseValidationError struct {
+// InspectEffectiveRulesResponseValidationError is the validation error
+// returned by InspectEffectiveRulesResponse.Validate if the designated
+// constraints aren't met.
+type InspectEffectiveRulesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2992,24 +6269,24 @@ type DeleteSchemaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteSchemaResponseValidationError) Field() string { return e.field }
+func (e InspectEffectiveRulesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteSchemaResponseValidationError) Reason() string { return e.reason }
+func (e InspectEffectiveRulesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteSchemaResponseValidationError) Cause() error { return e.cause }
+func (e InspectEffectiveRulesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteSchemaResponseValidationError) Key() bool { return e.key }
+func (e InspectEffectiveRulesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteSchemaResponseValidationError) ErrorName() string {
-	return "DeleteSchemaResponseValidationError"
+func (e InspectEffectiveRulesResponseValidationError) ErrorName() string {
+	return "InspectEffectiveRulesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteSchemaResponseValidationError) Error() string {
+func (e InspectEffectiveRulesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3021,14 +6298,14 @@ func (e DeleteSchemaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteSchemaResponse.%s: %s%s",
+		"invalid %sInspectEffectiveRulesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteSchemaResponseValidationError{}
+var _ error = InspectEffectiveRulesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3036,44 +6313,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteSchemaResponseValidationError{}
+} = InspectEffectiveRulesResponseValidationError{}
 
-// Validate checks the field values on ReloadStoreResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReloadStoreResponse) Validate() error {
+// Validate checks the field values on InspectEffectiveRulesResponse_Rule with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InspectEffectiveRulesResponse_Rule) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReloadStoreResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ReloadStoreResponseMultiError, or nil if none found.
-func (m *ReloadStoreResponse) ValidateAll() error {
+// ValidateAll checks the field values on InspectEffectiveRulesResponse_Rule
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InspectEffectiveRulesResponse_RuleMultiError, or nil if none found.
+func (m *InspectEffectiveRulesResponse_Rule) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReloadStoreResponse) validate(all bool) error {
+func (m *InspectEffectiveRulesResponse_Rule) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Name
+
+	// no validation rules for Scope
+
+	// no validation rules for Effect
+
+	// no validation rules for Conditional
+
 	if len(errors) > 0 {
-		return ReloadStoreResponseMultiError(errors)
+		return InspectEffectiveRulesResponse_RuleMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReloadStoreResponseMultiError is an error wrapping multiple validation
-// errors returned by ReloadStoreResponse.ValidateAll() if the designated
+// InspectEffectiveRulesResponse_RuleMultiError is an error wrapping multiple
+// validation errors returned by
+// InspectEffectiveRulesResponse_Rule.ValidateAll() if the designated
 // constraints aren't met.
-type ReloadStoreResponseMultiError []error
+type InspectEffectiveRulesResponse_RuleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReloadStoreResponseMultiError) Error() string {
+func (m InspectEffectiveRulesResponse_RuleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3082,11 +6369,12 @@ func (m ReloadStoreResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReloadStoreResponseMultiError) AllErrors() []error { return m }
+func (m InspectEffectiveRulesResponse_RuleMultiError) AllErrors() []error { return m }
 
-// ReloadStoreResponseValidationError is the validation error returned by
-// ReloadStoreResponse.Validate if the designated constraints aren't met.
-type ReloadStoreResponseValidationError struct {
+// InspectEffectiveRulesResponse_RuleValidationError is the validation error
+// returned by InspectEffectiveRulesResponse_Rule.Validate if the designated
+// constraints aren't met.
+type InspectEffectiveRulesResponse_RuleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3094,24 +6382,24 @@ type ReloadStoreResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReloadStoreResponseValidationError) Field() string { return e.field }
+func (e InspectEffectiveRulesResponse_RuleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReloadStoreResponseValidationError) Reason() string { return e.reason }
+func (e InspectEffectiveRulesResponse_RuleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReloadStoreResponseValidationError) Cause() error { return e.cause }
+func (e InspectEffectiveRulesResponse_RuleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReloadStoreResponseValidationError) Key() bool { return e.key }
+func (e InspectEffectiveRulesResponse_RuleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReloadStoreResponseValidationError) ErrorName() string {
-	return "ReloadStoreResponseValidationError"
+func (e InspectEffectiveRulesResponse_RuleValidationError) ErrorName() string {
+	return "InspectEffectiveRulesResponse_RuleValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReloadStoreResponseValidationError) Error() string {
+func (e InspectEffectiveRulesResponse_RuleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3123,14 +6411,14 @@ func (e ReloadStoreResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReloadStoreResponse.%s: %s%s",
+		"invalid %sInspectEffectiveRulesResponse_Rule.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReloadStoreResponseValidationError{}
+var _ error = InspectEffectiveRulesResponse_RuleValidationError{}
 
 var _ interface {
 	Field() string
@@ -3138,48 +6426,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReloadStoreResponseValidationError{}
+} = InspectEffectiveRulesResponse_RuleValidationError{}
 
-// Validate checks the field values on PlanResourcesResponse_Meta with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PlanResourcesResponse_Meta) Validate() error {
+// Validate checks the field values on InspectEffectiveRulesResponse_Scope with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InspectEffectiveRulesResponse_Scope) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PlanResourcesResponse_Meta with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PlanResourcesResponse_MetaMultiError, or nil if none found.
-func (m *PlanResourcesResponse_Meta) ValidateAll() error {
+// ValidateAll checks the field values on InspectEffectiveRulesResponse_Scope
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InspectEffectiveRulesResponse_ScopeMultiError, or nil if none found.
+func (m *InspectEffectiveRulesResponse_Scope) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PlanResourcesResponse_Meta) validate(all bool) error {
+func (m *InspectEffectiveRulesResponse_Scope) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for FilterDebug
+	// no validation rules for Scope
 
-	// no validation rules for MatchedScope
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InspectEffectiveRulesResponse_ScopeValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InspectEffectiveRulesResponse_ScopeValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InspectEffectiveRulesResponse_ScopeValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return PlanResourcesResponse_MetaMultiError(errors)
+		return InspectEffectiveRulesResponse_ScopeMultiError(errors)
 	}
 
 	return nil
 }
 
-// PlanResourcesResponse_MetaMultiError is an error wrapping multiple
-// validation errors returned by PlanResourcesResponse_Meta.ValidateAll() if
-// the designated constraints aren't met.
-type PlanResourcesResponse_MetaMultiError []error
+// InspectEffectiveRulesResponse_ScopeMultiError is an error wrapping multiple
+// validation errors returned by
+// InspectEffectiveRulesResponse_Scope.ValidateAll() if the designated
+// constraints aren't met.
+type InspectEffectiveRulesResponse_ScopeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PlanResourcesResponse_MetaMultiError) Error() string {
+func (m InspectEffectiveRulesResponse_ScopeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3188,11 +6510,12 @@ func (m PlanResourcesResponse_MetaMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PlanResourcesResponse_MetaMultiError) AllErrors() []error { return m }
+func (m InspectEffectiveRulesResponse_ScopeMultiError) AllErrors() []error { return m }
 
-// PlanResourcesResponse_MetaValidationError is the validation error returned
-// by PlanResourcesResponse_Meta.Validate if the designated constraints aren't met.
-type PlanResourcesResponse_MetaValidationError struct {
+// InspectEffectiveRulesResponse_ScopeValidationError is the validation error
+// returned by InspectEffectiveRulesResponse_Scope.Validate if the designated
+// constraints aren't met.
+type InspectEffectiveRulesResponse_ScopeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3200,24 +6523,24 @@ type PlanResourcesResponse_MetaValidationError struct {
 }
 
 // Field function returns field value.
-func (e PlanResourcesResponse_MetaValidationError) Field() string { return e.field }
+func (e InspectEffectiveRulesResponse_ScopeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PlanResourcesResponse_MetaValidationError) Reason() string { return e.reason }
+func (e InspectEffectiveRulesResponse_ScopeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PlanResourcesResponse_MetaValidationError) Cause() error { return e.cause }
+func (e InspectEffectiveRulesResponse_ScopeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PlanResourcesResponse_MetaValidationError) Key() bool { return e.key }
+func (e InspectEffectiveRulesResponse_ScopeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PlanResourcesResponse_MetaValidationError) ErrorName() string {
-	return "PlanResourcesResponse_MetaValidationError"
+func (e InspectEffectiveRulesResponse_ScopeValidationError) ErrorName() string {
+	return "InspectEffectiveRulesResponse_ScopeValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PlanResourcesResponse_MetaValidationError) Error() string {
+func (e InspectEffectiveRulesResponse_ScopeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3229,14 +6552,14 @@ func (e PlanResourcesResponse_MetaValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPlanResourcesResponse_Meta.%s: %s%s",
+		"invalid %sInspectEffectiveRulesResponse_Scope.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PlanResourcesResponse_MetaValidationError{}
+var _ error = InspectEffectiveRulesResponse_ScopeValidationError{}
 
 var _ interface {
 	Field() string
@@ -3244,51 +6567,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PlanResourcesResponse_MetaValidationError{}
+} = InspectEffectiveRulesResponse_ScopeValidationError{}
 
-// Validate checks the field values on CheckResourceSetResponse_ActionEffectMap
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *CheckResourceSetResponse_ActionEffectMap) Validate() error {
+// Validate checks the field values on ListResourceKindsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListResourceKindsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CheckResourceSetResponse_ActionEffectMap with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// CheckResourceSetResponse_ActionEffectMapMultiError, or nil if none found.
-func (m *CheckResourceSetResponse_ActionEffectMap) ValidateAll() error {
+// ValidateAll checks the field values on ListResourceKindsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListResourceKindsResponseMultiError, or nil if none found.
+func (m *ListResourceKindsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckResourceSetResponse_ActionEffectMap) validate(all bool) error {
+func (m *ListResourceKindsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Actions
-
-	for idx, item := range m.GetValidationErrors() {
+	for idx, item := range m.GetResourceKinds() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CheckResourceSetResponse_ActionEffectMapValidationError{
-						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+					errors = append(errors, ListResourceKindsResponseValidationError{
+						field:  fmt.Sprintf("ResourceKinds[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, CheckResourceSetResponse_ActionEffectMapValidationError{
-						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+					errors = append(errors, ListResourceKindsResponseValidationError{
+						field:  fmt.Sprintf("ResourceKinds[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3296,8 +6615,8 @@ func (m *CheckResourceSetResponse_ActionEffectMap) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CheckResourceSetResponse_ActionEffectMapValidationError{
-					field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+				return ListResourceKindsResponseValidationError{
+					field:  fmt.Sprintf("ResourceKinds[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3307,20 +6626,19 @@ func (m *CheckResourceSetResponse_ActionEffectMap) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CheckResourceSetResponse_ActionEffectMapMultiError(errors)
+		return ListResourceKindsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckResourceSetResponse_ActionEffectMapMultiError is an error wrapping
-// multiple validation errors returned by
-// CheckResourceSetResponse_ActionEffectMap.ValidateAll() if the designated
-// constraints aren't met.
-type CheckResourceSetResponse_ActionEffectMapMultiError []error
+// ListResourceKindsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListResourceKindsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListResourceKindsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckResourceSetResponse_ActionEffectMapMultiError) Error() string {
+func (m ListResourceKindsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3329,12 +6647,11 @@ func (m CheckResourceSetResponse_ActionEffectMapMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckResourceSetResponse_ActionEffectMapMultiError) AllErrors() []error { return m }
+func (m ListResourceKindsResponseMultiError) AllErrors() []error { return m }
 
-// CheckResourceSetResponse_ActionEffectMapValidationError is the validation
-// error returned by CheckResourceSetResponse_ActionEffectMap.Validate if the
-// designated constraints aren't met.
-type CheckResourceSetResponse_ActionEffectMapValidationError struct {
+// ListResourceKindsResponseValidationError is the validation error returned by
+// ListResourceKindsResponse.Validate if the designated constraints aren't met.
+type ListResourceKindsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3342,24 +6659,24 @@ type CheckResourceSetResponse_ActionEffectMapValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckResourceSetResponse_ActionEffectMapValidationError) Field() string { return e.field }
+func (e ListResourceKindsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckResourceSetResponse_ActionEffectMapValidationError) Reason() string { return e.reason }
+func (e ListResourceKindsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckResourceSetResponse_ActionEffectMapValidationError) Cause() error { return e.cause }
+func (e ListResourceKindsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckResourceSetResponse_ActionEffectMapValidationError) Key() bool { return e.key }
+func (e ListResourceKindsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckResourceSetResponse_ActionEffectMapValidationError) ErrorName() string {
-	return "CheckResourceSetResponse_ActionEffectMapValidationError"
+func (e ListResourceKindsResponseValidationError) ErrorName() string {
+	return "ListResourceKindsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckResourceSetResponse_ActionEffectMapValidationError) Error() string {
+func (e ListResourceKindsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3371,14 +6688,14 @@ func (e CheckResourceSetResponse_ActionEffectMapValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckResourceSetResponse_ActionEffectMap.%s: %s%s",
+		"invalid %sListResourceKindsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckResourceSetResponse_ActionEffectMapValidationError{}
+var _ error = ListResourceKindsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3386,90 +6703,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckResourceSetResponse_ActionEffectMapValidationError{}
+} = ListResourceKindsResponseValidationError{}
 
-// Validate checks the field values on CheckResourceSetResponse_Meta with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckResourceSetResponse_Meta) Validate() error {
+// Validate checks the field values on ListResourceKindsResponse_ResourceKind
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListResourceKindsResponse_ResourceKind) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckResourceSetResponse_Meta with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CheckResourceSetResponse_MetaMultiError, or nil if none found.
-func (m *CheckResourceSetResponse_Meta) ValidateAll() error {
+// ValidateAll checks the field values on
+// ListResourceKindsResponse_ResourceKind with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// ListResourceKindsResponse_ResourceKindMultiError, or nil if none found.
+func (m *ListResourceKindsResponse_ResourceKind) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckResourceSetResponse_Meta) validate(all bool) error {
+func (m *ListResourceKindsResponse_ResourceKind) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetResourceInstances()))
-		i := 0
-		for key := range m.GetResourceInstances() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetResourceInstances()[key]
-			_ = val
+	// no validation rules for Kind
 
-			// no validation rules for ResourceInstances[key]
+	// no validation rules for PolicyVersions
 
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, CheckResourceSetResponse_MetaValidationError{
-							field:  fmt.Sprintf("ResourceInstances[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, CheckResourceSetResponse_MetaValidationError{
-							field:  fmt.Sprintf("ResourceInstances[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return CheckResourceSetResponse_MetaValidationError{
-						field:  fmt.Sprintf("ResourceInstances[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
+	// no validation rules for Scopes
 
-		}
-	}
+	// no validation rules for Actions
 
 	if len(errors) > 0 {
-		return CheckResourceSetResponse_MetaMultiError(errors)
+		return ListResourceKindsResponse_ResourceKindMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckResourceSetResponse_MetaMultiError is an error wrapping multiple
-// validation errors returned by CheckResourceSetResponse_Meta.ValidateAll()
-// if the designated constraints aren't met.
-type CheckResourceSetResponse_MetaMultiError []error
+// ListResourceKindsResponse_ResourceKindMultiError is an error wrapping
+// multiple validation errors returned by
+// ListResourceKindsResponse_ResourceKind.ValidateAll() if the designated
+// constraints aren't met.
+type ListResourceKindsResponse_ResourceKindMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckResourceSetResponse_MetaMultiError) Error() string {
+func (m ListResourceKindsResponse_ResourceKindMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3478,12 +6760,12 @@ func (m CheckResourceSetResponse_MetaMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckResourceSetResponse_MetaMultiError) AllErrors() []error { return m }
+func (m ListResourceKindsResponse_ResourceKindMultiError) AllErrors() []error { return m }
 
-// CheckResourceSetResponse_MetaValidationError is the validation error
-// returned by CheckResourceSetResponse_Meta.Validate if the designated
-// constraints aren't met.
-type CheckResourceSetResponse_MetaValidationError struct {
+// ListResourceKindsResponse_ResourceKindValidationError is the validation
+// error returned by ListResourceKindsResponse_ResourceKind.Validate if the
+// designated constraints aren't met.
+type ListResourceKindsResponse_ResourceKindValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3491,24 +6773,24 @@ type CheckResourceSetResponse_MetaValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckResourceSetResponse_MetaValidationError) Field() string { return e.field }
+func (e ListResourceKindsResponse_ResourceKindValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckResourceSetResponse_MetaValidationError) Reason() string { return e.reason }
+func (e ListResourceKindsResponse_ResourceKindValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckResourceSetResponse_MetaValidationError) Cause() error { return e.cause }
+func (e ListResourceKindsResponse_ResourceKindValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckResourceSetResponse_MetaValidationError) Key() bool { return e.key }
+func (e ListResourceKindsResponse_ResourceKindValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckResourceSetResponse_MetaValidationError) ErrorName() string {
-	return "CheckResourceSetResponse_MetaValidationError"
+func (e ListResourceKindsResponse_ResourceKindValidationError) ErrorName() string {
+	return "ListResourceKindsResponse_ResourceKindValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckResourceSetResponse_MetaValidationError) Error() string {
+func (e ListResourceKindsResponse_ResourceKindValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3520,14 +6802,14 @@ func (e CheckResourceSetResponse_MetaValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckResourceSetResponse_Meta.%s: %s%s",
+		"invalid %sListResourceKindsResponse_ResourceKind.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckResourceSetResponse_MetaValidationError{}
+var _ error = ListResourceKindsResponse_ResourceKindValidationError{}
 
 var _ interface {
 	Field() string
@@ -3535,51 +6817,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckResourceSetResponse_MetaValidationError{}
+} = ListResourceKindsResponse_ResourceKindValidationError{}
 
-// Validate checks the field values on CheckResourceSetResponse_Meta_EffectMeta
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *CheckResourceSetResponse_Meta_EffectMeta) Validate() error {
+// Validate checks the field values on ListDeprecationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeprecationsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CheckResourceSetResponse_Meta_EffectMeta with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// CheckResourceSetResponse_Meta_EffectMetaMultiError, or nil if none found.
-func (m *CheckResourceSetResponse_Meta_EffectMeta) ValidateAll() error {
+// ValidateAll checks the field values on ListDeprecationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeprecationsResponseMultiError, or nil if none found.
+func (m *ListDeprecationsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckResourceSetResponse_Meta_EffectMeta) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *ListDeprecationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeprecations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeprecationsResponseValidationError{
+						field:  fmt.Sprintf("Deprecations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeprecationsResponseValidationError{
+						field:  fmt.Sprintf("Deprecations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeprecationsResponseValidationError{
+					field:  fmt.Sprintf("Deprecations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	var errors []error
-
-	// no validation rules for MatchedPolicy
-
-	// no validation rules for MatchedScope
-
 	if len(errors) > 0 {
-		return CheckResourceSetResponse_Meta_EffectMetaMultiError(errors)
+		return ListDeprecationsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckResourceSetResponse_Meta_EffectMetaMultiError is an error wrapping
-// multiple validation errors returned by
-// CheckResourceSetResponse_Meta_EffectMeta.ValidateAll() if the designated
+// ListDeprecationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListDeprecationsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CheckResourceSetResponse_Meta_EffectMetaMultiError []error
+type ListDeprecationsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckResourceSetResponse_Meta_EffectMetaMultiError) Error() string {
+func (m ListDeprecationsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3588,12 +6897,11 @@ func (m CheckResourceSetResponse_Meta_EffectMetaMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckResourceSetResponse_Meta_EffectMetaMultiError) AllErrors() []error { return m }
+func (m ListDeprecationsResponseMultiError) AllErrors() []error { return m }
 
-// CheckResourceSetResponse_Meta_EffectMetaValidationError is the validation
-// error returned by CheckResourceSetResponse_Meta_EffectMeta.Validate if the
-// designated constraints aren't met.
-type CheckResourceSetResponse_Meta_EffectMetaValidationError struct {
+// ListDeprecationsResponseValidationError is the validation error returned by
+// ListDeprecationsResponse.Validate if the designated constraints aren't met.
+type ListDeprecationsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3601,24 +6909,24 @@ type CheckResourceSetResponse_Meta_EffectMetaValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckResourceSetResponse_Meta_EffectMetaValidationError) Field() string { return e.field }
+func (e ListDeprecationsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckResourceSetResponse_Meta_EffectMetaValidationError) Reason() string { return e.reason }
+func (e ListDeprecationsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckResourceSetResponse_Meta_EffectMetaValidationError) Cause() error { return e.cause }
+func (e ListDeprecationsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckResourceSetResponse_Meta_EffectMetaValidationError) Key() bool { return e.key }
+func (e ListDeprecationsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckResourceSetResponse_Meta_EffectMetaValidationError) ErrorName() string {
-	return "CheckResourceSetResponse_Meta_EffectMetaValidationError"
+func (e ListDeprecationsResponseValidationError) ErrorName() string {
+	return "ListDeprecationsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckResourceSetResponse_Meta_EffectMetaValidationError) Error() string {
+func (e ListDeprecationsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3630,14 +6938,14 @@ func (e CheckResourceSetResponse_Meta_EffectMetaValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckResourceSetResponse_Meta_EffectMeta.%s: %s%s",
+		"invalid %sListDeprecationsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckResourceSetResponse_Meta_EffectMetaValidationError{}
+var _ error = ListDeprecationsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3645,93 +6953,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckResourceSetResponse_Meta_EffectMetaValidationError{}
+} = ListDeprecationsResponseValidationError{}
 
-// Validate checks the field values on CheckResourceSetResponse_Meta_ActionMeta
+// Validate checks the field values on ListDeprecationsResponse_Deprecation
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
 // there are no violations.
-func (m *CheckResourceSetResponse_Meta_ActionMeta) Validate() error {
+func (m *ListDeprecationsResponse_Deprecation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CheckResourceSetResponse_Meta_ActionMeta with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// CheckResourceSetResponse_Meta_ActionMetaMultiError, or nil if none found.
-func (m *CheckResourceSetResponse_Meta_ActionMeta) ValidateAll() error {
+// ValidateAll checks the field values on ListDeprecationsResponse_Deprecation
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListDeprecationsResponse_DeprecationMultiError, or nil if none found.
+func (m *ListDeprecationsResponse_Deprecation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckResourceSetResponse_Meta_ActionMeta) validate(all bool) error {
+func (m *ListDeprecationsResponse_Deprecation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetActions()))
-		i := 0
-		for key := range m.GetActions() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetActions()[key]
-			_ = val
+	// no validation rules for File
 
-			// no validation rules for Actions[key]
+	// no validation rules for Location
 
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, CheckResourceSetResponse_Meta_ActionMetaValidationError{
-							field:  fmt.Sprintf("Actions[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, CheckResourceSetResponse_Meta_ActionMetaValidationError{
-							field:  fmt.Sprintf("Actions[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return CheckResourceSetResponse_Meta_ActionMetaValidationError{
-						field:  fmt.Sprintf("Actions[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
+	// no validation rules for Feature
 
-		}
-	}
+	// no validation rules for Replacement
 
 	if len(errors) > 0 {
-		return CheckResourceSetResponse_Meta_ActionMetaMultiError(errors)
+		return ListDeprecationsResponse_DeprecationMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckResourceSetResponse_Meta_ActionMetaMultiError is an error wrapping
-// multiple validation errors returned by
-// CheckResourceSetResponse_Meta_ActionMeta.ValidateAll() if the designated
+// ListDeprecationsResponse_DeprecationMultiError is an error wrapping multiple
+// validation errors returned by
+// ListDeprecationsResponse_Deprecation.ValidateAll() if the designated
 // constraints aren't met.
-type CheckResourceSetResponse_Meta_ActionMetaMultiError []error
+type ListDeprecationsResponse_DeprecationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckResourceSetResponse_Meta_ActionMetaMultiError) Error() string {
+func (m ListDeprecationsResponse_DeprecationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3740,12 +7009,12 @@ func (m CheckResourceSetResponse_Meta_ActionMetaMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckResourceSetResponse_Meta_ActionMetaMultiError) AllErrors() []error { return m }
+func (m ListDeprecationsResponse_DeprecationMultiError) AllErrors() []error { return m }
 
-// CheckResourceSetResponse_Meta_ActionMetaValidationError is the validation
-// error returned by CheckResourceSetResponse_Meta_ActionMeta.Validate if the
-// designated constraints aren't met.
-type CheckResourceSetResponse_Meta_ActionMetaValidationError struct {
+// ListDeprecationsResponse_DeprecationValidationError is the validation error
+// returned by ListDeprecationsResponse_Deprecation.Validate if the designated
+// constraints aren't met.
+type ListDeprecationsResponse_DeprecationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3753,24 +7022,24 @@ type CheckResourceSetResponse_Meta_ActionMetaValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckResourceSetResponse_Meta_ActionMetaValidationError) Field() string { return e.field }
+func (e ListDeprecationsResponse_DeprecationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckResourceSetResponse_Meta_ActionMetaValidationError) Reason() string { return e.reason }
+func (e ListDeprecationsResponse_DeprecationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckResourceSetResponse_Meta_ActionMetaValidationError) Cause() error { return e.cause }
+func (e ListDeprecationsResponse_DeprecationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckResourceSetResponse_Meta_ActionMetaValidationError) Key() bool { return e.key }
+func (e ListDeprecationsResponse_DeprecationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckResourceSetResponse_Meta_ActionMetaValidationError) ErrorName() string {
-	return "CheckResourceSetResponse_Meta_ActionMetaValidationError"
+func (e ListDeprecationsResponse_DeprecationValidationError) ErrorName() string {
+	return "ListDeprecationsResponse_DeprecationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckResourceSetResponse_Meta_ActionMetaValidationError) Error() string {
+func (e ListDeprecationsResponse_DeprecationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3782,14 +7051,14 @@ func (e CheckResourceSetResponse_Meta_ActionMetaValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckResourceSetResponse_Meta_ActionMeta.%s: %s%s",
+		"invalid %sListDeprecationsResponse_Deprecation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckResourceSetResponse_Meta_ActionMetaValidationError{}
+var _ error = ListDeprecationsResponse_DeprecationValidationError{}
 
 var _ interface {
 	Field() string
@@ -3797,53 +7066,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckResourceSetResponse_Meta_ActionMetaValidationError{}
+} = ListDeprecationsResponse_DeprecationValidationError{}
 
-// Validate checks the field values on
-// CheckResourceBatchResponse_ActionEffectMap with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CheckResourceBatchResponse_ActionEffectMap) Validate() error {
+// Validate checks the field values on ListPolicyVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPolicyVersionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CheckResourceBatchResponse_ActionEffectMap with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// CheckResourceBatchResponse_ActionEffectMapMultiError, or nil if none found.
-func (m *CheckResourceBatchResponse_ActionEffectMap) ValidateAll() error {
+// ValidateAll checks the field values on ListPolicyVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPolicyVersionsResponseMultiError, or nil if none found.
+func (m *ListPolicyVersionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckResourceBatchResponse_ActionEffectMap) validate(all bool) error {
+func (m *ListPolicyVersionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ResourceId
-
-	// no validation rules for Actions
-
-	for idx, item := range m.GetValidationErrors() {
+	for idx, item := range m.GetVersions() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CheckResourceBatchResponse_ActionEffectMapValidationError{
-						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+					errors = append(errors, ListPolicyVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, CheckResourceBatchResponse_ActionEffectMapValidationError{
-						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+					errors = append(errors, ListPolicyVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3851,8 +7114,8 @@ func (m *CheckResourceBatchResponse_ActionEffectMap) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CheckResourceBatchResponse_ActionEffectMapValidationError{
-					field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+				return ListPolicyVersionsResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3862,20 +7125,19 @@ func (m *CheckResourceBatchResponse_ActionEffectMap) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CheckResourceBatchResponse_ActionEffectMapMultiError(errors)
+		return ListPolicyVersionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckResourceBatchResponse_ActionEffectMapMultiError is an error wrapping
-// multiple validation errors returned by
-// CheckResourceBatchResponse_ActionEffectMap.ValidateAll() if the designated
-// constraints aren't met.
-type CheckResourceBatchResponse_ActionEffectMapMultiError []error
+// ListPolicyVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPolicyVersionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListPolicyVersionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckResourceBatchResponse_ActionEffectMapMultiError) Error() string {
+func (m ListPolicyVersionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3884,12 +7146,11 @@ func (m CheckResourceBatchResponse_ActionEffectMapMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckResourceBatchResponse_ActionEffectMapMultiError) AllErrors() []error { return m }
+func (m ListPolicyVersionsResponseMultiError) AllErrors() []error { return m }
 
-// CheckResourceBatchResponse_ActionEffectMapValidationError is the validation
-// error returned by CheckResourceBatchResponse_ActionEffectMap.Validate if
-// the designated constraints aren't met.
-type CheckResourceBatchResponse_ActionEffectMapValidationError struct {
+// ListPolicyVersionsResponseValidationError is the validation error returned
+// by ListPolicyVersionsResponse.Validate if the designated constraints aren't met.
+type ListPolicyVersionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3897,24 +7158,24 @@ type CheckResourceBatchResponse_ActionEffectMapValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckResourceBatchResponse_ActionEffectMapValidationError) Field() string { return e.field }
+func (e ListPolicyVersionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckResourceBatchResponse_ActionEffectMapValidationError) Reason() string { return e.reason }
+func (e ListPolicyVersionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckResourceBatchResponse_ActionEffectMapValidationError) Cause() error { return e.cause }
+func (e ListPolicyVersionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckResourceBatchResponse_ActionEffectMapValidationError) Key() bool { return e.key }
+func (e ListPolicyVersionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckResourceBatchResponse_ActionEffectMapValidationError) ErrorName() string {
-	return "CheckResourceBatchResponse_ActionEffectMapValidationError"
+func (e ListPolicyVersionsResponseValidationError) ErrorName() string {
+	return "ListPolicyVersionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckResourceBatchResponse_ActionEffectMapValidationError) Error() string {
+func (e ListPolicyVersionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3926,189 +7187,96 @@ func (e CheckResourceBatchResponse_ActionEffectMapValidationError) Error() strin
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckResourceBatchResponse_ActionEffectMap.%s: %s%s",
+		"invalid %sListPolicyVersionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckResourceBatchResponse_ActionEffectMapValidationError{}
+var _ error = ListPolicyVersionsResponseValidationError{}
 
 var _ interface {
 	Field() string
 	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = CheckResourceBatchResponse_ActionEffectMapValidationError{}
-
-// Validate checks the field values on CheckResourcesResponse_ResultEntry with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *CheckResourcesResponse_ResultEntry) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on CheckResourcesResponse_ResultEntry
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// CheckResourcesResponse_ResultEntryMultiError, or nil if none found.
-func (m *CheckResourcesResponse_ResultEntry) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *CheckResourcesResponse_ResultEntry) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CheckResourcesResponse_ResultEntryValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CheckResourcesResponse_ResultEntryValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CheckResourcesResponse_ResultEntryValidationError{
-				field:  "Resource",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for Actions
-
-	for idx, item := range m.GetValidationErrors() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CheckResourcesResponse_ResultEntryValidationError{
-						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CheckResourcesResponse_ResultEntryValidationError{
-						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CheckResourcesResponse_ResultEntryValidationError{
-					field:  fmt.Sprintf("ValidationErrors[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPolicyVersionsResponseValidationError{}
+
+// Validate checks the field values on ListPolicyVersionsResponse_Version with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListPolicyVersionsResponse_Version) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPolicyVersionsResponse_Version
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListPolicyVersionsResponse_VersionMultiError, or nil if none found.
+func (m *ListPolicyVersionsResponse_Version) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *ListPolicyVersionsResponse_Version) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Version
+
 	if all {
-		switch v := interface{}(m.GetMeta()).(type) {
+		switch v := interface{}(m.GetTimestamp()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CheckResourcesResponse_ResultEntryValidationError{
-					field:  "Meta",
+				errors = append(errors, ListPolicyVersionsResponse_VersionValidationError{
+					field:  "Timestamp",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CheckResourcesResponse_ResultEntryValidationError{
-					field:  "Meta",
+				errors = append(errors, ListPolicyVersionsResponse_VersionValidationError{
+					field:  "Timestamp",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CheckResourcesResponse_ResultEntryValidationError{
-				field:  "Meta",
+			return ListPolicyVersionsResponse_VersionValidationError{
+				field:  "Timestamp",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetOutputs() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CheckResourcesResponse_ResultEntryValidationError{
-						field:  fmt.Sprintf("Outputs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CheckResourcesResponse_ResultEntryValidationError{
-						field:  fmt.Sprintf("Outputs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CheckResourcesResponse_ResultEntryValidationError{
-					field:  fmt.Sprintf("Outputs[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Change
 
-	}
+	// no validation rules for Disabled
 
 	if len(errors) > 0 {
-		return CheckResourcesResponse_ResultEntryMultiError(errors)
+		return ListPolicyVersionsResponse_VersionMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckResourcesResponse_ResultEntryMultiError is an error wrapping multiple
+// ListPolicyVersionsResponse_VersionMultiError is an error wrapping multiple
 // validation errors returned by
-// CheckResourcesResponse_ResultEntry.ValidateAll() if the designated
+// ListPolicyVersionsResponse_Version.ValidateAll() if the designated
 // constraints aren't met.
-type CheckResourcesResponse_ResultEntryMultiError []error
+type ListPolicyVersionsResponse_VersionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckResourcesResponse_ResultEntryMultiError) Error() string {
+func (m ListPolicyVersionsResponse_VersionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4117,12 +7285,12 @@ func (m CheckResourcesResponse_ResultEntryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckResourcesResponse_ResultEntryMultiError) AllErrors() []error { return m }
+func (m ListPolicyVersionsResponse_VersionMultiError) AllErrors() []error { return m }
 
-// CheckResourcesResponse_ResultEntryValidationError is the validation error
-// returned by CheckResourcesResponse_ResultEntry.Validate if the designated
+// ListPolicyVersionsResponse_VersionValidationError is the validation error
+// returned by ListPolicyVersionsResponse_Version.Validate if the designated
 // constraints aren't met.
-type CheckResourcesResponse_ResultEntryValidationError struct {
+type ListPolicyVersionsResponse_VersionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4130,24 +7298,24 @@ type CheckResourcesResponse_ResultEntryValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckResourcesResponse_ResultEntryValidationError) Field() string { return e.field }
+func (e ListPolicyVersionsResponse_VersionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckResourcesResponse_ResultEntryValidationError) Reason() string { return e.reason }
+func (e ListPolicyVersionsResponse_VersionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckResourcesResponse_ResultEntryValidationError) Cause() error { return e.cause }
+func (e ListPolicyVersionsResponse_VersionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckResourcesResponse_ResultEntryValidationError) Key() bool { return e.key }
+func (e ListPolicyVersionsResponse_VersionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckResourcesResponse_ResultEntryValidationError) ErrorName() string {
-	return "CheckResourcesResponse_ResultEntryValidationError"
+func (e ListPolicyVersionsResponse_VersionValidationError) ErrorName() string {
+	return "ListPolicyVersionsResponse_VersionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckResourcesResponse_ResultEntryValidationError) Error() string {
+func (e ListPolicyVersionsResponse_VersionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4159,14 +7327,14 @@ func (e CheckResourcesResponse_ResultEntryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckResourcesResponse_ResultEntry.%s: %s%s",
+		"invalid %sListPolicyVersionsResponse_Version.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckResourcesResponse_ResultEntryValidationError{}
+var _ error = ListPolicyVersionsResponse_VersionValidationError{}
 
 var _ interface {
 	Field() string
@@ -4174,55 +7342,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckResourcesResponse_ResultEntryValidationError{}
+} = ListPolicyVersionsResponse_VersionValidationError{}
 
-// Validate checks the field values on
-// CheckResourcesResponse_ResultEntry_Resource with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CheckResourcesResponse_ResultEntry_Resource) Validate() error {
+// Validate checks the field values on GetPolicyVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPolicyVersionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CheckResourcesResponse_ResultEntry_Resource with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// CheckResourcesResponse_ResultEntry_ResourceMultiError, or nil if none found.
-func (m *CheckResourcesResponse_ResultEntry_Resource) ValidateAll() error {
+// ValidateAll checks the field values on GetPolicyVersionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPolicyVersionResponseMultiError, or nil if none found.
+func (m *GetPolicyVersionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckResourcesResponse_ResultEntry_Resource) validate(all bool) error {
+func (m *GetPolicyVersionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Kind
-
-	// no validation rules for PolicyVersion
-
-	// no validation rules for Scope
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPolicyVersionResponseValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPolicyVersionResponseValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPolicyVersionResponseValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return CheckResourcesResponse_ResultEntry_ResourceMultiError(errors)
+		return GetPolicyVersionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckResourcesResponse_ResultEntry_ResourceMultiError is an error wrapping
-// multiple validation errors returned by
-// CheckResourcesResponse_ResultEntry_Resource.ValidateAll() if the designated
+// GetPolicyVersionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPolicyVersionResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CheckResourcesResponse_ResultEntry_ResourceMultiError []error
+type GetPolicyVersionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckResourcesResponse_ResultEntry_ResourceMultiError) Error() string {
+func (m GetPolicyVersionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4231,12 +7417,11 @@ func (m CheckResourcesResponse_ResultEntry_ResourceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckResourcesResponse_ResultEntry_ResourceMultiError) AllErrors() []error { return m }
+func (m GetPolicyVersionResponseMultiError) AllErrors() []error { return m }
 
-// CheckResourcesResponse_ResultEntry_ResourceValidationError is the validation
-// error returned by CheckResourcesResponse_ResultEntry_Resource.Validate if
-// the designated constraints aren't met.
-type CheckResourcesResponse_ResultEntry_ResourceValidationError struct {
+// GetPolicyVersionResponseValidationError is the validation error returned by
+// GetPolicyVersionResponse.Validate if the designated constraints aren't met.
+type GetPolicyVersionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4244,24 +7429,24 @@ type CheckResourcesResponse_ResultEntry_ResourceValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckResourcesResponse_ResultEntry_ResourceValidationError) Field() string { return e.field }
+func (e GetPolicyVersionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckResourcesResponse_ResultEntry_ResourceValidationError) Reason() string { return e.reason }
+func (e GetPolicyVersionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckResourcesResponse_ResultEntry_ResourceValidationError) Cause() error { return e.cause }
+func (e GetPolicyVersionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckResourcesResponse_ResultEntry_ResourceValidationError) Key() bool { return e.key }
+func (e GetPolicyVersionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckResourcesResponse_ResultEntry_ResourceValidationError) ErrorName() string {
-	return "CheckResourcesResponse_ResultEntry_ResourceValidationError"
+func (e GetPolicyVersionResponseValidationError) ErrorName() string {
+	return "GetPolicyVersionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckResourcesResponse_ResultEntry_ResourceValidationError) Error() string {
+func (e GetPolicyVersionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4273,14 +7458,14 @@ func (e CheckResourcesResponse_ResultEntry_ResourceValidationError) Error() stri
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckResourcesResponse_ResultEntry_Resource.%s: %s%s",
+		"invalid %sGetPolicyVersionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckResourcesResponse_ResultEntry_ResourceValidationError{}
+var _ error = GetPolicyVersionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4288,93 +7473,150 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckResourcesResponse_ResultEntry_ResourceValidationError{}
+} = GetPolicyVersionResponseValidationError{}
 
-// Validate checks the field values on CheckResourcesResponse_ResultEntry_Meta
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *CheckResourcesResponse_ResultEntry_Meta) Validate() error {
+// Validate checks the field values on RollbackPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackPolicyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CheckResourcesResponse_ResultEntry_Meta with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// CheckResourcesResponse_ResultEntry_MetaMultiError, or nil if none found.
-func (m *CheckResourcesResponse_ResultEntry_Meta) ValidateAll() error {
+// ValidateAll checks the field values on RollbackPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackPolicyResponseMultiError, or nil if none found.
+func (m *RollbackPolicyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckResourcesResponse_ResultEntry_Meta) validate(all bool) error {
+func (m *RollbackPolicyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetActions()))
-		i := 0
-		for key := range m.GetActions() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetActions()[key]
-			_ = val
+	// no validation rules for Version
 
-			// no validation rules for Actions[key]
+	if len(errors) > 0 {
+		return RollbackPolicyResponseMultiError(errors)
+	}
 
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, CheckResourcesResponse_ResultEntry_MetaValidationError{
-							field:  fmt.Sprintf("Actions[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, CheckResourcesResponse_ResultEntry_MetaValidationError{
-							field:  fmt.Sprintf("Actions[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return CheckResourcesResponse_ResultEntry_MetaValidationError{
-						field:  fmt.Sprintf("Actions[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
+	return nil
+}
+
+// RollbackPolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by RollbackPolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackPolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackPolicyResponseMultiError) AllErrors() []error { return m }
+
+// RollbackPolicyResponseValidationError is the validation error returned by
+// RollbackPolicyResponse.Validate if the designated constraints aren't met.
+type RollbackPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackPolicyResponseValidationError) ErrorName() string {
+	return "RollbackPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackPolicyResponseValidationError{}
 
-		}
+// Validate checks the field values on DeletePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePolicyResponseMultiError, or nil if none found.
+func (m *DeletePolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for DeletedPolicies
+
 	if len(errors) > 0 {
-		return CheckResourcesResponse_ResultEntry_MetaMultiError(errors)
+		return DeletePolicyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckResourcesResponse_ResultEntry_MetaMultiError is an error wrapping
-// multiple validation errors returned by
-// CheckResourcesResponse_ResultEntry_Meta.ValidateAll() if the designated
+// DeletePolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by DeletePolicyResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CheckResourcesResponse_ResultEntry_MetaMultiError []error
+type DeletePolicyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckResourcesResponse_ResultEntry_MetaMultiError) Error() string {
+func (m DeletePolicyResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4383,12 +7625,11 @@ func (m CheckResourcesResponse_ResultEntry_MetaMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckResourcesResponse_ResultEntry_MetaMultiError) AllErrors() []error { return m }
+func (m DeletePolicyResponseMultiError) AllErrors() []error { return m }
 
-// CheckResourcesResponse_ResultEntry_MetaValidationError is the validation
-// error returned by CheckResourcesResponse_ResultEntry_Meta.Validate if the
-// designated constraints aren't met.
-type CheckResourcesResponse_ResultEntry_MetaValidationError struct {
+// DeletePolicyResponseValidationError is the validation error returned by
+// DeletePolicyResponse.Validate if the designated constraints aren't met.
+type DeletePolicyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4396,24 +7637,24 @@ type CheckResourcesResponse_ResultEntry_MetaValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckResourcesResponse_ResultEntry_MetaValidationError) Field() string { return e.field }
+func (e DeletePolicyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckResourcesResponse_ResultEntry_MetaValidationError) Reason() string { return e.reason }
+func (e DeletePolicyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckResourcesResponse_ResultEntry_MetaValidationError) Cause() error { return e.cause }
+func (e DeletePolicyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckResourcesResponse_ResultEntry_MetaValidationError) Key() bool { return e.key }
+func (e DeletePolicyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckResourcesResponse_ResultEntry_MetaValidationError) ErrorName() string {
-	return "CheckResourcesResponse_ResultEntry_MetaValidationError"
+func (e DeletePolicyResponseValidationError) ErrorName() string {
+	return "DeletePolicyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckResourcesResponse_ResultEntry_MetaValidationError) Error() string {
+func (e DeletePolicyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4425,14 +7666,14 @@ func (e CheckResourcesResponse_ResultEntry_MetaValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckResourcesResponse_ResultEntry_Meta.%s: %s%s",
+		"invalid %sDeletePolicyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckResourcesResponse_ResultEntry_MetaValidationError{}
+var _ error = DeletePolicyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4440,52 +7681,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckResourcesResponse_ResultEntry_MetaValidationError{}
+} = DeletePolicyResponseValidationError{}
 
-// Validate checks the field values on
-// CheckResourcesResponse_ResultEntry_Meta_EffectMeta with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CheckResourcesResponse_ResultEntry_Meta_EffectMeta) Validate() error {
+// Validate checks the field values on RestorePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestorePolicyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CheckResourcesResponse_ResultEntry_Meta_EffectMeta with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in
-// CheckResourcesResponse_ResultEntry_Meta_EffectMetaMultiError, or nil if
-// none found.
-func (m *CheckResourcesResponse_ResultEntry_Meta_EffectMeta) ValidateAll() error {
+// ValidateAll checks the field values on RestorePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestorePolicyResponseMultiError, or nil if none found.
+func (m *RestorePolicyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckResourcesResponse_ResultEntry_Meta_EffectMeta) validate(all bool) error {
+func (m *RestorePolicyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for MatchedPolicy
-
-	// no validation rules for MatchedScope
+	// no validation rules for RestoredPolicies
 
 	if len(errors) > 0 {
-		return CheckResourcesResponse_ResultEntry_Meta_EffectMetaMultiError(errors)
+		return RestorePolicyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckResourcesResponse_ResultEntry_Meta_EffectMetaMultiError is an error
-// wrapping multiple validation errors returned by
-// CheckResourcesResponse_ResultEntry_Meta_EffectMeta.ValidateAll() if the
-// designated constraints aren't met.
-type CheckResourcesResponse_ResultEntry_Meta_EffectMetaMultiError []error
+// RestorePolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by RestorePolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestorePolicyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckResourcesResponse_ResultEntry_Meta_EffectMetaMultiError) Error() string {
+func (m RestorePolicyResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4494,13 +7729,11 @@ func (m CheckResourcesResponse_ResultEntry_Meta_EffectMetaMultiError) Error() st
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckResourcesResponse_ResultEntry_Meta_EffectMetaMultiError) AllErrors() []error { return m }
+func (m RestorePolicyResponseMultiError) AllErrors() []error { return m }
 
-// CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError is the
-// validation error returned by
-// CheckResourcesResponse_ResultEntry_Meta_EffectMeta.Validate if the
-// designated constraints aren't met.
-type CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError struct {
+// RestorePolicyResponseValidationError is the validation error returned by
+// RestorePolicyResponse.Validate if the designated constraints aren't met.
+type RestorePolicyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4508,30 +7741,24 @@ type CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError) Field() string {
-	return e.field
-}
+func (e RestorePolicyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError) Reason() string {
-	return e.reason
-}
+func (e RestorePolicyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError) Cause() error {
-	return e.cause
-}
+func (e RestorePolicyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError) Key() bool { return e.key }
+func (e RestorePolicyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError) ErrorName() string {
-	return "CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError"
+func (e RestorePolicyResponseValidationError) ErrorName() string {
+	return "RestorePolicyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError) Error() string {
+func (e RestorePolicyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4543,14 +7770,14 @@ func (e CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError) Error
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckResourcesResponse_ResultEntry_Meta_EffectMeta.%s: %s%s",
+		"invalid %sRestorePolicyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError{}
+var _ error = RestorePolicyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4558,48 +7785,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckResourcesResponse_ResultEntry_Meta_EffectMetaValidationError{}
+} = RestorePolicyResponseValidationError{}
 
-// Validate checks the field values on PlaygroundFailure_Error with the rules
+// Validate checks the field values on ListStoreEventsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PlaygroundFailure_Error) Validate() error {
+func (m *ListStoreEventsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PlaygroundFailure_Error with the
+// ValidateAll checks the field values on ListStoreEventsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PlaygroundFailure_ErrorMultiError, or nil if none found.
-func (m *PlaygroundFailure_Error) ValidateAll() error {
+// ListStoreEventsResponseMultiError, or nil if none found.
+func (m *ListStoreEventsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PlaygroundFailure_Error) validate(all bool) error {
+func (m *ListStoreEventsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for File
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
 
-	// no validation rules for Error
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStoreEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStoreEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStoreEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return PlaygroundFailure_ErrorMultiError(errors)
+		return ListStoreEventsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PlaygroundFailure_ErrorMultiError is an error wrapping multiple validation
-// errors returned by PlaygroundFailure_Error.ValidateAll() if the designated
+// ListStoreEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListStoreEventsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type PlaygroundFailure_ErrorMultiError []error
+type ListStoreEventsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PlaygroundFailure_ErrorMultiError) Error() string {
+func (m ListStoreEventsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4608,11 +7865,11 @@ func (m PlaygroundFailure_ErrorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PlaygroundFailure_ErrorMultiError) AllErrors() []error { return m }
+func (m ListStoreEventsResponseMultiError) AllErrors() []error { return m }
 
-// PlaygroundFailure_ErrorValidationError is the validation error returned by
-// PlaygroundFailure_Error.Validate if the designated constraints aren't met.
-type PlaygroundFailure_ErrorValidationError struct {
+// ListStoreEventsResponseValidationError is the validation error returned by
+// ListStoreEventsResponse.Validate if the designated constraints aren't met.
+type ListStoreEventsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4620,24 +7877,24 @@ type PlaygroundFailure_ErrorValidationError struct {
 }
 
 // Field function returns field value.
-func (e PlaygroundFailure_ErrorValidationError) Field() string { return e.field }
+func (e ListStoreEventsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PlaygroundFailure_ErrorValidationError) Reason() string { return e.reason }
+func (e ListStoreEventsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PlaygroundFailure_ErrorValidationError) Cause() error { return e.cause }
+func (e ListStoreEventsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PlaygroundFailure_ErrorValidationError) Key() bool { return e.key }
+func (e ListStoreEventsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PlaygroundFailure_ErrorValidationError) ErrorName() string {
-	return "PlaygroundFailure_ErrorValidationError"
+func (e ListStoreEventsResponseValidationError) ErrorName() string {
+	return "ListStoreEventsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PlaygroundFailure_ErrorValidationError) Error() string {
+func (e ListStoreEventsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4649,14 +7906,14 @@ func (e PlaygroundFailure_ErrorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPlaygroundFailure_Error.%s: %s%s",
+		"invalid %sListStoreEventsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PlaygroundFailure_ErrorValidationError{}
+var _ error = ListStoreEventsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4664,54 +7921,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PlaygroundFailure_ErrorValidationError{}
+} = ListStoreEventsResponseValidationError{}
 
-// Validate checks the field values on PlaygroundTestResponse_TestResults with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *PlaygroundTestResponse_TestResults) Validate() error {
+// Validate checks the field values on ListStoreEventsResponse_Event with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStoreEventsResponse_Event) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PlaygroundTestResponse_TestResults
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// PlaygroundTestResponse_TestResultsMultiError, or nil if none found.
-func (m *PlaygroundTestResponse_TestResults) ValidateAll() error {
+// ValidateAll checks the field values on ListStoreEventsResponse_Event with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListStoreEventsResponse_EventMultiError, or nil if none found.
+func (m *ListStoreEventsResponse_Event) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PlaygroundTestResponse_TestResults) validate(all bool) error {
+func (m *ListStoreEventsResponse_Event) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	if all {
-		switch v := interface{}(m.GetResults()).(type) {
+		switch v := interface{}(m.GetTimestamp()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PlaygroundTestResponse_TestResultsValidationError{
-					field:  "Results",
+				errors = append(errors, ListStoreEventsResponse_EventValidationError{
+					field:  "Timestamp",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PlaygroundTestResponse_TestResultsValidationError{
-					field:  "Results",
+				errors = append(errors, ListStoreEventsResponse_EventValidationError{
+					field:  "Timestamp",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetResults()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PlaygroundTestResponse_TestResultsValidationError{
-				field:  "Results",
+			return ListStoreEventsResponse_EventValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Kind
+
+	// no validation rules for Target
+
+	// no validation rules for Revision
+
+	if all {
+		switch v := interface{}(m.GetActor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListStoreEventsResponse_EventValidationError{
+					field:  "Actor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListStoreEventsResponse_EventValidationError{
+					field:  "Actor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListStoreEventsResponse_EventValidationError{
+				field:  "Actor",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -4719,20 +8012,128 @@ func (m *PlaygroundTestResponse_TestResults) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PlaygroundTestResponse_TestResultsMultiError(errors)
+		return ListStoreEventsResponse_EventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStoreEventsResponse_EventMultiError is an error wrapping multiple
+// validation errors returned by ListStoreEventsResponse_Event.ValidateAll()
+// if the designated constraints aren't met.
+type ListStoreEventsResponse_EventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStoreEventsResponse_EventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStoreEventsResponse_EventMultiError) AllErrors() []error { return m }
+
+// ListStoreEventsResponse_EventValidationError is the validation error
+// returned by ListStoreEventsResponse_Event.Validate if the designated
+// constraints aren't met.
+type ListStoreEventsResponse_EventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStoreEventsResponse_EventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStoreEventsResponse_EventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStoreEventsResponse_EventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStoreEventsResponse_EventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStoreEventsResponse_EventValidationError) ErrorName() string {
+	return "ListStoreEventsResponse_EventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStoreEventsResponse_EventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStoreEventsResponse_Event.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStoreEventsResponse_EventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStoreEventsResponse_EventValidationError{}
+
+// Validate checks the field values on ListStoreEventsResponse_Event_Actor with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListStoreEventsResponse_Event_Actor) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStoreEventsResponse_Event_Actor
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListStoreEventsResponse_Event_ActorMultiError, or nil if none found.
+func (m *ListStoreEventsResponse_Event_Actor) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStoreEventsResponse_Event_Actor) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for User
+
+	// no validation rules for ClientCert
+
+	if len(errors) > 0 {
+		return ListStoreEventsResponse_Event_ActorMultiError(errors)
 	}
 
 	return nil
 }
 
-// PlaygroundTestResponse_TestResultsMultiError is an error wrapping multiple
+// ListStoreEventsResponse_Event_ActorMultiError is an error wrapping multiple
 // validation errors returned by
-// PlaygroundTestResponse_TestResults.ValidateAll() if the designated
+// ListStoreEventsResponse_Event_Actor.ValidateAll() if the designated
 // constraints aren't met.
-type PlaygroundTestResponse_TestResultsMultiError []error
+type ListStoreEventsResponse_Event_ActorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PlaygroundTestResponse_TestResultsMultiError) Error() string {
+func (m ListStoreEventsResponse_Event_ActorMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4741,12 +8142,12 @@ func (m PlaygroundTestResponse_TestResultsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PlaygroundTestResponse_TestResultsMultiError) AllErrors() []error { return m }
+func (m ListStoreEventsResponse_Event_ActorMultiError) AllErrors() []error { return m }
 
-// PlaygroundTestResponse_TestResultsValidationError is the validation error
-// returned by PlaygroundTestResponse_TestResults.Validate if the designated
+// ListStoreEventsResponse_Event_ActorValidationError is the validation error
+// returned by ListStoreEventsResponse_Event_Actor.Validate if the designated
 // constraints aren't met.
-type PlaygroundTestResponse_TestResultsValidationError struct {
+type ListStoreEventsResponse_Event_ActorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4754,24 +8155,24 @@ type PlaygroundTestResponse_TestResultsValidationError struct {
 }
 
 // Field function returns field value.
-func (e PlaygroundTestResponse_TestResultsValidationError) Field() string { return e.field }
+func (e ListStoreEventsResponse_Event_ActorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PlaygroundTestResponse_TestResultsValidationError) Reason() string { return e.reason }
+func (e ListStoreEventsResponse_Event_ActorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PlaygroundTestResponse_TestResultsValidationError) Cause() error { return e.cause }
+func (e ListStoreEventsResponse_Event_ActorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PlaygroundTestResponse_TestResultsValidationError) Key() bool { return e.key }
+func (e ListStoreEventsResponse_Event_ActorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PlaygroundTestResponse_TestResultsValidationError) ErrorName() string {
-	return "PlaygroundTestResponse_TestResultsValidationError"
+func (e ListStoreEventsResponse_Event_ActorValidationError) ErrorName() string {
+	return "ListStoreEventsResponse_Event_ActorValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PlaygroundTestResponse_TestResultsValidationError) Error() string {
+func (e ListStoreEventsResponse_Event_ActorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4783,14 +8184,14 @@ func (e PlaygroundTestResponse_TestResultsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPlaygroundTestResponse_TestResults.%s: %s%s",
+		"invalid %sListStoreEventsResponse_Event_Actor.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PlaygroundTestResponse_TestResultsValidationError{}
+var _ error = ListStoreEventsResponse_Event_ActorValidationError{}
 
 var _ interface {
 	Field() string
@@ -4798,86 +8199,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PlaygroundTestResponse_TestResultsValidationError{}
+} = ListStoreEventsResponse_Event_ActorValidationError{}
 
-// Validate checks the field values on PlaygroundEvaluateResponse_EvalResult
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *PlaygroundEvaluateResponse_EvalResult) Validate() error {
+// Validate checks the field values on SnapshotStoreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SnapshotStoreResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PlaygroundEvaluateResponse_EvalResult
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// PlaygroundEvaluateResponse_EvalResultMultiError, or nil if none found.
-func (m *PlaygroundEvaluateResponse_EvalResult) ValidateAll() error {
+// ValidateAll checks the field values on SnapshotStoreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SnapshotStoreResponseMultiError, or nil if none found.
+func (m *SnapshotStoreResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PlaygroundEvaluateResponse_EvalResult) validate(all bool) error {
+func (m *SnapshotStoreResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Action
-
-	// no validation rules for Effect
-
-	// no validation rules for Policy
-
-	for idx, item := range m.GetValidationErrors() {
-		_, _ = idx, item
+	// no validation rules for Key
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundEvaluateResponse_EvalResultValidationError{
-						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundEvaluateResponse_EvalResultValidationError{
-						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SnapshotStoreResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return PlaygroundEvaluateResponse_EvalResultValidationError{
-					field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+				errors = append(errors, SnapshotStoreResponseValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SnapshotStoreResponseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
+	// no validation rules for Policies
+
+	// no validation rules for Schemas
+
 	if len(errors) > 0 {
-		return PlaygroundEvaluateResponse_EvalResultMultiError(errors)
+		return SnapshotStoreResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PlaygroundEvaluateResponse_EvalResultMultiError is an error wrapping
-// multiple validation errors returned by
-// PlaygroundEvaluateResponse_EvalResult.ValidateAll() if the designated
+// SnapshotStoreResponseMultiError is an error wrapping multiple validation
+// errors returned by SnapshotStoreResponse.ValidateAll() if the designated
 // constraints aren't met.
-type PlaygroundEvaluateResponse_EvalResultMultiError []error
+type SnapshotStoreResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PlaygroundEvaluateResponse_EvalResultMultiError) Error() string {
+func (m SnapshotStoreResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4886,12 +8280,11 @@ func (m PlaygroundEvaluateResponse_EvalResultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PlaygroundEvaluateResponse_EvalResultMultiError) AllErrors() []error { return m }
+func (m SnapshotStoreResponseMultiError) AllErrors() []error { return m }
 
-// PlaygroundEvaluateResponse_EvalResultValidationError is the validation error
-// returned by PlaygroundEvaluateResponse_EvalResult.Validate if the
-// designated constraints aren't met.
-type PlaygroundEvaluateResponse_EvalResultValidationError struct {
+// SnapshotStoreResponseValidationError is the validation error returned by
+// SnapshotStoreResponse.Validate if the designated constraints aren't met.
+type SnapshotStoreResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4899,24 +8292,24 @@ type PlaygroundEvaluateResponse_EvalResultValidationError struct {
 }
 
 // Field function returns field value.
-func (e PlaygroundEvaluateResponse_EvalResultValidationError) Field() string { return e.field }
+func (e SnapshotStoreResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PlaygroundEvaluateResponse_EvalResultValidationError) Reason() string { return e.reason }
+func (e SnapshotStoreResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PlaygroundEvaluateResponse_EvalResultValidationError) Cause() error { return e.cause }
+func (e SnapshotStoreResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PlaygroundEvaluateResponse_EvalResultValidationError) Key() bool { return e.key }
+func (e SnapshotStoreResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PlaygroundEvaluateResponse_EvalResultValidationError) ErrorName() string {
-	return "PlaygroundEvaluateResponse_EvalResultValidationError"
+func (e SnapshotStoreResponseValidationError) ErrorName() string {
+	return "SnapshotStoreResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PlaygroundEvaluateResponse_EvalResultValidationError) Error() string {
+func (e SnapshotStoreResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4928,14 +8321,14 @@ func (e PlaygroundEvaluateResponse_EvalResultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPlaygroundEvaluateResponse_EvalResult.%s: %s%s",
+		"invalid %sSnapshotStoreResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PlaygroundEvaluateResponse_EvalResultValidationError{}
+var _ error = SnapshotStoreResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4943,149 +8336,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PlaygroundEvaluateResponse_EvalResultValidationError{}
+} = SnapshotStoreResponseValidationError{}
 
-// Validate checks the field values on
-// PlaygroundEvaluateResponse_EvalResultList with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PlaygroundEvaluateResponse_EvalResultList) Validate() error {
+// Validate checks the field values on RestoreStoreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreStoreResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// PlaygroundEvaluateResponse_EvalResultList with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// PlaygroundEvaluateResponse_EvalResultListMultiError, or nil if none found.
-func (m *PlaygroundEvaluateResponse_EvalResultList) ValidateAll() error {
+// ValidateAll checks the field values on RestoreStoreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreStoreResponseMultiError, or nil if none found.
+func (m *RestoreStoreResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PlaygroundEvaluateResponse_EvalResultList) validate(all bool) error {
+func (m *RestoreStoreResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetResults() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundEvaluateResponse_EvalResultListValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundEvaluateResponse_EvalResultListValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return PlaygroundEvaluateResponse_EvalResultListValidationError{
-					field:  fmt.Sprintf("Results[%v]", idx),
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreStoreResponseValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	for idx, item := range m.GetValidationErrors() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundEvaluateResponse_EvalResultListValidationError{
-						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundEvaluateResponse_EvalResultListValidationError{
-						field:  fmt.Sprintf("ValidationErrors[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return PlaygroundEvaluateResponse_EvalResultListValidationError{
-					field:  fmt.Sprintf("ValidationErrors[%v]", idx),
+				errors = append(errors, RestoreStoreResponseValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	}
-
-	for idx, item := range m.GetOutputs() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PlaygroundEvaluateResponse_EvalResultListValidationError{
-						field:  fmt.Sprintf("Outputs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PlaygroundEvaluateResponse_EvalResultListValidationError{
-						field:  fmt.Sprintf("Outputs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return PlaygroundEvaluateResponse_EvalResultListValidationError{
-					field:  fmt.Sprintf("Outputs[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreStoreResponseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
+	// no validation rules for Policies
+
+	// no validation rules for Schemas
+
 	if len(errors) > 0 {
-		return PlaygroundEvaluateResponse_EvalResultListMultiError(errors)
+		return RestoreStoreResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PlaygroundEvaluateResponse_EvalResultListMultiError is an error wrapping
-// multiple validation errors returned by
-// PlaygroundEvaluateResponse_EvalResultList.ValidateAll() if the designated
+// RestoreStoreResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreStoreResponse.ValidateAll() if the designated
 // constraints aren't met.
-type PlaygroundEvaluateResponse_EvalResultListMultiError []error
+type RestoreStoreResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PlaygroundEvaluateResponse_EvalResultListMultiError) Error() string {
+func (m RestoreStoreResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5094,12 +8415,11 @@ func (m PlaygroundEvaluateResponse_EvalResultListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PlaygroundEvaluateResponse_EvalResultListMultiError) AllErrors() []error { return m }
+func (m RestoreStoreResponseMultiError) AllErrors() []error { return m }
 
-// PlaygroundEvaluateResponse_EvalResultListValidationError is the validation
-// error returned by PlaygroundEvaluateResponse_EvalResultList.Validate if the
-// designated constraints aren't met.
-type PlaygroundEvaluateResponse_EvalResultListValidationError struct {
+// RestoreStoreResponseValidationError is the validation error returned by
+// RestoreStoreResponse.Validate if the designated constraints aren't met.
+type RestoreStoreResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5107,24 +8427,24 @@ type PlaygroundEvaluateResponse_EvalResultListValidationError struct {
 }
 
 // Field function returns field value.
-func (e PlaygroundEvaluateResponse_EvalResultListValidationError) Field() string { return e.field }
+func (e RestoreStoreResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PlaygroundEvaluateResponse_EvalResultListValidationError) Reason() string { return e.reason }
+func (e RestoreStoreResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PlaygroundEvaluateResponse_EvalResultListValidationError) Cause() error { return e.cause }
+func (e RestoreStoreResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PlaygroundEvaluateResponse_EvalResultListValidationError) Key() bool { return e.key }
+func (e RestoreStoreResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PlaygroundEvaluateResponse_EvalResultListValidationError) ErrorName() string {
-	return "PlaygroundEvaluateResponse_EvalResultListValidationError"
+func (e RestoreStoreResponseValidationError) ErrorName() string {
+	return "RestoreStoreResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PlaygroundEvaluateResponse_EvalResultListValidationError) Error() string {
+func (e RestoreStoreResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5136,14 +8456,14 @@ func (e PlaygroundEvaluateResponse_EvalResultListValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPlaygroundEvaluateResponse_EvalResultList.%s: %s%s",
+		"invalid %sRestoreStoreResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PlaygroundEvaluateResponse_EvalResultListValidationError{}
+var _ error = RestoreStoreResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5151,4 +8471,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PlaygroundEvaluateResponse_EvalResultListValidationError{}
+} = RestoreStoreResponseValidationError{}
@@ -15,6 +15,19 @@ import (
 	sort "sort"
 )
 
+func cerbos_policy_v1_Limit_hashpb_sum(m *v1.Limit, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.Limit.max_allowed"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.MaxAllowed)))
+
+	}
+	if _, ok := ignore["cerbos.policy.v1.Limit.window"]; !ok {
+		if m.Window != nil {
+			google_protobuf_Duration_hashpb_sum(m.Window, hasher, ignore)
+		}
+
+	}
+}
+
 func cerbos_policy_v1_Schemas_IgnoreWhen_hashpb_sum(m *v1.Schemas_IgnoreWhen, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.policy.v1.Schemas.IgnoreWhen.actions"]; !ok {
 		if len(m.Actions) > 0 {
@@ -287,6 +300,25 @@ func cerbos_runtime_v1_RunnableDerivedRole_hashpb_sum(m *RunnableDerivedRole, ha
 		}
 
 	}
+	if _, ok := ignore["cerbos.runtime.v1.RunnableDerivedRole.parent_derived_roles"]; !ok {
+		if len(m.ParentDerivedRoles) > 0 {
+			keys := make([]string, len(m.ParentDerivedRoles))
+			i := 0
+			for k := range m.ParentDerivedRoles {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				if m.ParentDerivedRoles[k] != nil {
+					google_protobuf_Empty_hashpb_sum(m.ParentDerivedRoles[k], hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_runtime_v1_RunnableDerivedRolesSet_Metadata_hashpb_sum(m *RunnableDerivedRolesSet_Metadata, hasher hash.Hash, ignore map[string]struct{}) {
@@ -455,6 +487,18 @@ func cerbos_runtime_v1_RunnablePrincipalPolicySet_Policy_hashpb_sum(m *RunnableP
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.active_from"]; !ok {
+		if m.ActiveFrom != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.ActiveFrom, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.active_until"]; !ok {
+		if m.ActiveUntil != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.ActiveUntil, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_runtime_v1_RunnablePrincipalPolicySet_hashpb_sum(m *RunnablePrincipalPolicySet, hasher hash.Hash, ignore map[string]struct{}) {
@@ -569,6 +613,12 @@ func cerbos_runtime_v1_RunnableResourcePolicySet_Policy_Rule_hashpb_sum(m *Runna
 		}
 
 	}
+	if _, ok := ignore["cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.limit"]; !ok {
+		if m.Limit != nil {
+			cerbos_policy_v1_Limit_hashpb_sum(m.Limit, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_runtime_v1_RunnableResourcePolicySet_Policy_hashpb_sum(m *RunnableResourcePolicySet_Policy, hasher hash.Hash, ignore map[string]struct{}) {
@@ -630,6 +680,18 @@ func cerbos_runtime_v1_RunnableResourcePolicySet_Policy_hashpb_sum(m *RunnableRe
 		}
 
 	}
+	if _, ok := ignore["cerbos.runtime.v1.RunnableResourcePolicySet.Policy.active_from"]; !ok {
+		if m.ActiveFrom != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.ActiveFrom, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.runtime.v1.RunnableResourcePolicySet.Policy.active_until"]; !ok {
+		if m.ActiveUntil != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.ActiveUntil, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_runtime_v1_RunnableResourcePolicySet_hashpb_sum(m *RunnableResourcePolicySet, hasher hash.Hash, ignore map[string]struct{}) {
@@ -651,6 +651,52 @@ func (m *RunnableDerivedRole) validate(all bool) error {
 		}
 	}
 
+	{
+		sorted_keys := make([]string, len(m.GetParentDerivedRoles()))
+		i := 0
+		for key := range m.GetParentDerivedRoles() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetParentDerivedRoles()[key]
+			_ = val
+
+			// no validation rules for ParentDerivedRoles[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, RunnableDerivedRoleValidationError{
+							field:  fmt.Sprintf("ParentDerivedRoles[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, RunnableDerivedRoleValidationError{
+							field:  fmt.Sprintf("ParentDerivedRoles[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return RunnableDerivedRoleValidationError{
+						field:  fmt.Sprintf("ParentDerivedRoles[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
 	if len(errors) > 0 {
 		return RunnableDerivedRoleMultiError(errors)
 	}
@@ -2459,6 +2505,64 @@ func (m *RunnableResourcePolicySet_Policy) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetActiveFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RunnableResourcePolicySet_PolicyValidationError{
+					field:  "ActiveFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RunnableResourcePolicySet_PolicyValidationError{
+					field:  "ActiveFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActiveFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RunnableResourcePolicySet_PolicyValidationError{
+				field:  "ActiveFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetActiveUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RunnableResourcePolicySet_PolicyValidationError{
+					field:  "ActiveUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RunnableResourcePolicySet_PolicyValidationError{
+					field:  "ActiveUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActiveUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RunnableResourcePolicySet_PolicyValidationError{
+				field:  "ActiveUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return RunnableResourcePolicySet_PolicyMultiError(errors)
 	}
@@ -2764,6 +2868,35 @@ func (m *RunnableResourcePolicySet_Policy_Rule) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RunnableResourcePolicySet_Policy_RuleValidationError{
+					field:  "Limit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RunnableResourcePolicySet_Policy_RuleValidationError{
+					field:  "Limit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RunnableResourcePolicySet_Policy_RuleValidationError{
+				field:  "Limit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return RunnableResourcePolicySet_Policy_RuleMultiError(errors)
 	}
@@ -3286,6 +3419,64 @@ func (m *RunnablePrincipalPolicySet_Policy) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetActiveFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RunnablePrincipalPolicySet_PolicyValidationError{
+					field:  "ActiveFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RunnablePrincipalPolicySet_PolicyValidationError{
+					field:  "ActiveFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActiveFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RunnablePrincipalPolicySet_PolicyValidationError{
+				field:  "ActiveFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetActiveUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RunnablePrincipalPolicySet_PolicyValidationError{
+					field:  "ActiveUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RunnablePrincipalPolicySet_PolicyValidationError{
+					field:  "ActiveUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActiveUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RunnablePrincipalPolicySet_PolicyValidationError{
+				field:  "ActiveUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return RunnablePrincipalPolicySet_PolicyMultiError(errors)
 	}
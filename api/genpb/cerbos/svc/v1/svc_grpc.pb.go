@@ -24,11 +24,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	CerbosService_CheckResourceSet_FullMethodName   = "/cerbos.svc.v1.CerbosService/CheckResourceSet"
-	CerbosService_CheckResourceBatch_FullMethodName = "/cerbos.svc.v1.CerbosService/CheckResourceBatch"
-	CerbosService_CheckResources_FullMethodName     = "/cerbos.svc.v1.CerbosService/CheckResources"
-	CerbosService_ServerInfo_FullMethodName         = "/cerbos.svc.v1.CerbosService/ServerInfo"
-	CerbosService_PlanResources_FullMethodName      = "/cerbos.svc.v1.CerbosService/PlanResources"
+	CerbosService_CheckResourceSet_FullMethodName     = "/cerbos.svc.v1.CerbosService/CheckResourceSet"
+	CerbosService_CheckResourceBatch_FullMethodName   = "/cerbos.svc.v1.CerbosService/CheckResourceBatch"
+	CerbosService_CheckResources_FullMethodName       = "/cerbos.svc.v1.CerbosService/CheckResources"
+	CerbosService_CheckResourcesStream_FullMethodName = "/cerbos.svc.v1.CerbosService/CheckResourcesStream"
+	CerbosService_ServerInfo_FullMethodName           = "/cerbos.svc.v1.CerbosService/ServerInfo"
+	CerbosService_PlanResources_FullMethodName        = "/cerbos.svc.v1.CerbosService/PlanResources"
+	CerbosService_PlanResourcesStream_FullMethodName  = "/cerbos.svc.v1.CerbosService/PlanResourcesStream"
+	CerbosService_WatchDecisions_FullMethodName       = "/cerbos.svc.v1.CerbosService/WatchDecisions"
+	CerbosService_ListResourceKinds_FullMethodName    = "/cerbos.svc.v1.CerbosService/ListResourceKinds"
 )
 
 // CerbosServiceClient is the client API for CerbosService service.
@@ -38,8 +42,14 @@ type CerbosServiceClient interface {
 	CheckResourceSet(ctx context.Context, in *v1.CheckResourceSetRequest, opts ...grpc.CallOption) (*v11.CheckResourceSetResponse, error)
 	CheckResourceBatch(ctx context.Context, in *v1.CheckResourceBatchRequest, opts ...grpc.CallOption) (*v11.CheckResourceBatchResponse, error)
 	CheckResources(ctx context.Context, in *v1.CheckResourcesRequest, opts ...grpc.CallOption) (*v11.CheckResourcesResponse, error)
+	// Bidirectional streaming variant of CheckResources for pipelining many checks over a single stream.
+	// It's only available over gRPC.
+	CheckResourcesStream(ctx context.Context, opts ...grpc.CallOption) (CerbosService_CheckResourcesStreamClient, error)
 	ServerInfo(ctx context.Context, in *v1.ServerInfoRequest, opts ...grpc.CallOption) (*v11.ServerInfoResponse, error)
 	PlanResources(ctx context.Context, in *v1.PlanResourcesRequest, opts ...grpc.CallOption) (*v11.PlanResourcesResponse, error)
+	PlanResourcesStream(ctx context.Context, in *v1.PlanResourcesStreamRequest, opts ...grpc.CallOption) (CerbosService_PlanResourcesStreamClient, error)
+	WatchDecisions(ctx context.Context, in *v1.WatchDecisionsRequest, opts ...grpc.CallOption) (CerbosService_WatchDecisionsClient, error)
+	ListResourceKinds(ctx context.Context, in *v1.ListResourceKindsRequest, opts ...grpc.CallOption) (*v11.ListResourceKindsResponse, error)
 }
 
 type cerbosServiceClient struct {
@@ -77,6 +87,37 @@ func (c *cerbosServiceClient) CheckResources(ctx context.Context, in *v1.CheckRe
 	return out, nil
 }
 
+func (c *cerbosServiceClient) CheckResourcesStream(ctx context.Context, opts ...grpc.CallOption) (CerbosService_CheckResourcesStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CerbosService_ServiceDesc.Streams[0], CerbosService_CheckResourcesStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cerbosServiceCheckResourcesStreamClient{stream}
+	return x, nil
+}
+
+type CerbosService_CheckResourcesStreamClient interface {
+	Send(*v1.CheckResourcesStreamRequest) error
+	Recv() (*v11.CheckResourcesStreamResponse, error)
+	grpc.ClientStream
+}
+
+type cerbosServiceCheckResourcesStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *cerbosServiceCheckResourcesStreamClient) Send(m *v1.CheckResourcesStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *cerbosServiceCheckResourcesStreamClient) Recv() (*v11.CheckResourcesStreamResponse, error) {
+	m := new(v11.CheckResourcesStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *cerbosServiceClient) ServerInfo(ctx context.Context, in *v1.ServerInfoRequest, opts ...grpc.CallOption) (*v11.ServerInfoResponse, error) {
 	out := new(v11.ServerInfoResponse)
 	err := c.cc.Invoke(ctx, CerbosService_ServerInfo_FullMethodName, in, out, opts...)
@@ -95,6 +136,79 @@ func (c *cerbosServiceClient) PlanResources(ctx context.Context, in *v1.PlanReso
 	return out, nil
 }
 
+func (c *cerbosServiceClient) PlanResourcesStream(ctx context.Context, in *v1.PlanResourcesStreamRequest, opts ...grpc.CallOption) (CerbosService_PlanResourcesStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CerbosService_ServiceDesc.Streams[1], CerbosService_PlanResourcesStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cerbosServicePlanResourcesStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CerbosService_PlanResourcesStreamClient interface {
+	Recv() (*v11.PlanResourcesStreamResponse, error)
+	grpc.ClientStream
+}
+
+type cerbosServicePlanResourcesStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *cerbosServicePlanResourcesStreamClient) Recv() (*v11.PlanResourcesStreamResponse, error) {
+	m := new(v11.PlanResourcesStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cerbosServiceClient) WatchDecisions(ctx context.Context, in *v1.WatchDecisionsRequest, opts ...grpc.CallOption) (CerbosService_WatchDecisionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CerbosService_ServiceDesc.Streams[2], CerbosService_WatchDecisions_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cerbosServiceWatchDecisionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CerbosService_WatchDecisionsClient interface {
+	Recv() (*v11.WatchDecisionsResponse, error)
+	grpc.ClientStream
+}
+
+type cerbosServiceWatchDecisionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *cerbosServiceWatchDecisionsClient) Recv() (*v11.WatchDecisionsResponse, error) {
+	m := new(v11.WatchDecisionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cerbosServiceClient) ListResourceKinds(ctx context.Context, in *v1.ListResourceKindsRequest, opts ...grpc.CallOption) (*v11.ListResourceKindsResponse, error) {
+	out := new(v11.ListResourceKindsResponse)
+	err := c.cc.Invoke(ctx, CerbosService_ListResourceKinds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CerbosServiceServer is the server API for CerbosService service.
 // All implementations must embed UnimplementedCerbosServiceServer
 // for forward compatibility
@@ -102,8 +216,14 @@ type CerbosServiceServer interface {
 	CheckResourceSet(context.Context, *v1.CheckResourceSetRequest) (*v11.CheckResourceSetResponse, error)
 	CheckResourceBatch(context.Context, *v1.CheckResourceBatchRequest) (*v11.CheckResourceBatchResponse, error)
 	CheckResources(context.Context, *v1.CheckResourcesRequest) (*v11.CheckResourcesResponse, error)
+	// Bidirectional streaming variant of CheckResources for pipelining many checks over a single stream.
+	// It's only available over gRPC.
+	CheckResourcesStream(CerbosService_CheckResourcesStreamServer) error
 	ServerInfo(context.Context, *v1.ServerInfoRequest) (*v11.ServerInfoResponse, error)
 	PlanResources(context.Context, *v1.PlanResourcesRequest) (*v11.PlanResourcesResponse, error)
+	PlanResourcesStream(*v1.PlanResourcesStreamRequest, CerbosService_PlanResourcesStreamServer) error
+	WatchDecisions(*v1.WatchDecisionsRequest, CerbosService_WatchDecisionsServer) error
+	ListResourceKinds(context.Context, *v1.ListResourceKindsRequest) (*v11.ListResourceKindsResponse, error)
 	mustEmbedUnimplementedCerbosServiceServer()
 }
 
@@ -120,12 +240,24 @@ func (UnimplementedCerbosServiceServer) CheckResourceBatch(context.Context, *v1.
 func (UnimplementedCerbosServiceServer) CheckResources(context.Context, *v1.CheckResourcesRequest) (*v11.CheckResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckResources not implemented")
 }
+func (UnimplementedCerbosServiceServer) CheckResourcesStream(CerbosService_CheckResourcesStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method CheckResourcesStream not implemented")
+}
 func (UnimplementedCerbosServiceServer) ServerInfo(context.Context, *v1.ServerInfoRequest) (*v11.ServerInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ServerInfo not implemented")
 }
 func (UnimplementedCerbosServiceServer) PlanResources(context.Context, *v1.PlanResourcesRequest) (*v11.PlanResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlanResources not implemented")
 }
+func (UnimplementedCerbosServiceServer) PlanResourcesStream(*v1.PlanResourcesStreamRequest, CerbosService_PlanResourcesStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method PlanResourcesStream not implemented")
+}
+func (UnimplementedCerbosServiceServer) WatchDecisions(*v1.WatchDecisionsRequest, CerbosService_WatchDecisionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDecisions not implemented")
+}
+func (UnimplementedCerbosServiceServer) ListResourceKinds(context.Context, *v1.ListResourceKindsRequest) (*v11.ListResourceKindsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourceKinds not implemented")
+}
 func (UnimplementedCerbosServiceServer) mustEmbedUnimplementedCerbosServiceServer() {}
 
 // UnsafeCerbosServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -193,6 +325,32 @@ func _CerbosService_CheckResources_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CerbosService_CheckResourcesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CerbosServiceServer).CheckResourcesStream(&cerbosServiceCheckResourcesStreamServer{stream})
+}
+
+type CerbosService_CheckResourcesStreamServer interface {
+	Send(*v11.CheckResourcesStreamResponse) error
+	Recv() (*v1.CheckResourcesStreamRequest, error)
+	grpc.ServerStream
+}
+
+type cerbosServiceCheckResourcesStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *cerbosServiceCheckResourcesStreamServer) Send(m *v11.CheckResourcesStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *cerbosServiceCheckResourcesStreamServer) Recv() (*v1.CheckResourcesStreamRequest, error) {
+	m := new(v1.CheckResourcesStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _CerbosService_ServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1.ServerInfoRequest)
 	if err := dec(in); err != nil {
@@ -229,6 +387,66 @@ func _CerbosService_PlanResources_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CerbosService_PlanResourcesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(v1.PlanResourcesStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CerbosServiceServer).PlanResourcesStream(m, &cerbosServicePlanResourcesStreamServer{stream})
+}
+
+type CerbosService_PlanResourcesStreamServer interface {
+	Send(*v11.PlanResourcesStreamResponse) error
+	grpc.ServerStream
+}
+
+type cerbosServicePlanResourcesStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *cerbosServicePlanResourcesStreamServer) Send(m *v11.PlanResourcesStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CerbosService_WatchDecisions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(v1.WatchDecisionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CerbosServiceServer).WatchDecisions(m, &cerbosServiceWatchDecisionsServer{stream})
+}
+
+type CerbosService_WatchDecisionsServer interface {
+	Send(*v11.WatchDecisionsResponse) error
+	grpc.ServerStream
+}
+
+type cerbosServiceWatchDecisionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *cerbosServiceWatchDecisionsServer) Send(m *v11.WatchDecisionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CerbosService_ListResourceKinds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ListResourceKindsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosServiceServer).ListResourceKinds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosService_ListResourceKinds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosServiceServer).ListResourceKinds(ctx, req.(*v1.ListResourceKindsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CerbosService_ServiceDesc is the grpc.ServiceDesc for CerbosService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -256,23 +474,56 @@ var CerbosService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PlanResources",
 			Handler:    _CerbosService_PlanResources_Handler,
 		},
+		{
+			MethodName: "ListResourceKinds",
+			Handler:    _CerbosService_ListResourceKinds_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CheckResourcesStream",
+			Handler:       _CerbosService_CheckResourcesStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "PlanResourcesStream",
+			Handler:       _CerbosService_PlanResourcesStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchDecisions",
+			Handler:       _CerbosService_WatchDecisions_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cerbos/svc/v1/svc.proto",
 }
 
 const (
-	CerbosAdminService_AddOrUpdatePolicy_FullMethodName   = "/cerbos.svc.v1.CerbosAdminService/AddOrUpdatePolicy"
-	CerbosAdminService_ListPolicies_FullMethodName        = "/cerbos.svc.v1.CerbosAdminService/ListPolicies"
-	CerbosAdminService_GetPolicy_FullMethodName           = "/cerbos.svc.v1.CerbosAdminService/GetPolicy"
-	CerbosAdminService_DisablePolicy_FullMethodName       = "/cerbos.svc.v1.CerbosAdminService/DisablePolicy"
-	CerbosAdminService_EnablePolicy_FullMethodName        = "/cerbos.svc.v1.CerbosAdminService/EnablePolicy"
-	CerbosAdminService_ListAuditLogEntries_FullMethodName = "/cerbos.svc.v1.CerbosAdminService/ListAuditLogEntries"
-	CerbosAdminService_AddOrUpdateSchema_FullMethodName   = "/cerbos.svc.v1.CerbosAdminService/AddOrUpdateSchema"
-	CerbosAdminService_ListSchemas_FullMethodName         = "/cerbos.svc.v1.CerbosAdminService/ListSchemas"
-	CerbosAdminService_GetSchema_FullMethodName           = "/cerbos.svc.v1.CerbosAdminService/GetSchema"
-	CerbosAdminService_DeleteSchema_FullMethodName        = "/cerbos.svc.v1.CerbosAdminService/DeleteSchema"
-	CerbosAdminService_ReloadStore_FullMethodName         = "/cerbos.svc.v1.CerbosAdminService/ReloadStore"
+	CerbosAdminService_AddOrUpdatePolicy_FullMethodName     = "/cerbos.svc.v1.CerbosAdminService/AddOrUpdatePolicy"
+	CerbosAdminService_ListPolicies_FullMethodName          = "/cerbos.svc.v1.CerbosAdminService/ListPolicies"
+	CerbosAdminService_GetPolicy_FullMethodName             = "/cerbos.svc.v1.CerbosAdminService/GetPolicy"
+	CerbosAdminService_DisablePolicy_FullMethodName         = "/cerbos.svc.v1.CerbosAdminService/DisablePolicy"
+	CerbosAdminService_EnablePolicy_FullMethodName          = "/cerbos.svc.v1.CerbosAdminService/EnablePolicy"
+	CerbosAdminService_ListAuditLogEntries_FullMethodName   = "/cerbos.svc.v1.CerbosAdminService/ListAuditLogEntries"
+	CerbosAdminService_AddOrUpdateSchema_FullMethodName     = "/cerbos.svc.v1.CerbosAdminService/AddOrUpdateSchema"
+	CerbosAdminService_ListSchemas_FullMethodName           = "/cerbos.svc.v1.CerbosAdminService/ListSchemas"
+	CerbosAdminService_GetSchema_FullMethodName             = "/cerbos.svc.v1.CerbosAdminService/GetSchema"
+	CerbosAdminService_DeleteSchema_FullMethodName          = "/cerbos.svc.v1.CerbosAdminService/DeleteSchema"
+	CerbosAdminService_ReloadStore_FullMethodName           = "/cerbos.svc.v1.CerbosAdminService/ReloadStore"
+	CerbosAdminService_ExportPolicySnapshot_FullMethodName  = "/cerbos.svc.v1.CerbosAdminService/ExportPolicySnapshot"
+	CerbosAdminService_CheckResourcesAsOf_FullMethodName    = "/cerbos.svc.v1.CerbosAdminService/CheckResourcesAsOf"
+	CerbosAdminService_InspectEffectiveRules_FullMethodName = "/cerbos.svc.v1.CerbosAdminService/InspectEffectiveRules"
+	CerbosAdminService_ListDeprecations_FullMethodName      = "/cerbos.svc.v1.CerbosAdminService/ListDeprecations"
+	CerbosAdminService_ListPolicyVersions_FullMethodName    = "/cerbos.svc.v1.CerbosAdminService/ListPolicyVersions"
+	CerbosAdminService_GetPolicyVersion_FullMethodName      = "/cerbos.svc.v1.CerbosAdminService/GetPolicyVersion"
+	CerbosAdminService_RollbackPolicy_FullMethodName        = "/cerbos.svc.v1.CerbosAdminService/RollbackPolicy"
+	CerbosAdminService_DeletePolicy_FullMethodName          = "/cerbos.svc.v1.CerbosAdminService/DeletePolicy"
+	CerbosAdminService_RestorePolicy_FullMethodName         = "/cerbos.svc.v1.CerbosAdminService/RestorePolicy"
+	CerbosAdminService_ListStoreEvents_FullMethodName       = "/cerbos.svc.v1.CerbosAdminService/ListStoreEvents"
+	CerbosAdminService_SnapshotStore_FullMethodName         = "/cerbos.svc.v1.CerbosAdminService/SnapshotStore"
+	CerbosAdminService_RestoreStore_FullMethodName          = "/cerbos.svc.v1.CerbosAdminService/RestoreStore"
 )
 
 // CerbosAdminServiceClient is the client API for CerbosAdminService service.
@@ -290,6 +541,18 @@ type CerbosAdminServiceClient interface {
 	GetSchema(ctx context.Context, in *v1.GetSchemaRequest, opts ...grpc.CallOption) (*v11.GetSchemaResponse, error)
 	DeleteSchema(ctx context.Context, in *v1.DeleteSchemaRequest, opts ...grpc.CallOption) (*v11.DeleteSchemaResponse, error)
 	ReloadStore(ctx context.Context, in *v1.ReloadStoreRequest, opts ...grpc.CallOption) (*v11.ReloadStoreResponse, error)
+	ExportPolicySnapshot(ctx context.Context, in *v1.ExportPolicySnapshotRequest, opts ...grpc.CallOption) (*v11.ExportPolicySnapshotResponse, error)
+	CheckResourcesAsOf(ctx context.Context, in *v1.CheckResourcesAsOfRequest, opts ...grpc.CallOption) (*v11.CheckResourcesAsOfResponse, error)
+	InspectEffectiveRules(ctx context.Context, in *v1.InspectEffectiveRulesRequest, opts ...grpc.CallOption) (*v11.InspectEffectiveRulesResponse, error)
+	ListDeprecations(ctx context.Context, in *v1.ListDeprecationsRequest, opts ...grpc.CallOption) (*v11.ListDeprecationsResponse, error)
+	ListPolicyVersions(ctx context.Context, in *v1.ListPolicyVersionsRequest, opts ...grpc.CallOption) (*v11.ListPolicyVersionsResponse, error)
+	GetPolicyVersion(ctx context.Context, in *v1.GetPolicyVersionRequest, opts ...grpc.CallOption) (*v11.GetPolicyVersionResponse, error)
+	RollbackPolicy(ctx context.Context, in *v1.RollbackPolicyRequest, opts ...grpc.CallOption) (*v11.RollbackPolicyResponse, error)
+	DeletePolicy(ctx context.Context, in *v1.DeletePolicyRequest, opts ...grpc.CallOption) (*v11.DeletePolicyResponse, error)
+	RestorePolicy(ctx context.Context, in *v1.RestorePolicyRequest, opts ...grpc.CallOption) (*v11.RestorePolicyResponse, error)
+	ListStoreEvents(ctx context.Context, in *v1.ListStoreEventsRequest, opts ...grpc.CallOption) (*v11.ListStoreEventsResponse, error)
+	SnapshotStore(ctx context.Context, in *v1.SnapshotStoreRequest, opts ...grpc.CallOption) (*v11.SnapshotStoreResponse, error)
+	RestoreStore(ctx context.Context, in *v1.RestoreStoreRequest, opts ...grpc.CallOption) (*v11.RestoreStoreResponse, error)
 }
 
 type cerbosAdminServiceClient struct {
@@ -422,6 +685,114 @@ func (c *cerbosAdminServiceClient) ReloadStore(ctx context.Context, in *v1.Reloa
 	return out, nil
 }
 
+func (c *cerbosAdminServiceClient) ExportPolicySnapshot(ctx context.Context, in *v1.ExportPolicySnapshotRequest, opts ...grpc.CallOption) (*v11.ExportPolicySnapshotResponse, error) {
+	out := new(v11.ExportPolicySnapshotResponse)
+	err := c.cc.Invoke(ctx, CerbosAdminService_ExportPolicySnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cerbosAdminServiceClient) CheckResourcesAsOf(ctx context.Context, in *v1.CheckResourcesAsOfRequest, opts ...grpc.CallOption) (*v11.CheckResourcesAsOfResponse, error) {
+	out := new(v11.CheckResourcesAsOfResponse)
+	err := c.cc.Invoke(ctx, CerbosAdminService_CheckResourcesAsOf_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cerbosAdminServiceClient) InspectEffectiveRules(ctx context.Context, in *v1.InspectEffectiveRulesRequest, opts ...grpc.CallOption) (*v11.InspectEffectiveRulesResponse, error) {
+	out := new(v11.InspectEffectiveRulesResponse)
+	err := c.cc.Invoke(ctx, CerbosAdminService_InspectEffectiveRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cerbosAdminServiceClient) ListDeprecations(ctx context.Context, in *v1.ListDeprecationsRequest, opts ...grpc.CallOption) (*v11.ListDeprecationsResponse, error) {
+	out := new(v11.ListDeprecationsResponse)
+	err := c.cc.Invoke(ctx, CerbosAdminService_ListDeprecations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cerbosAdminServiceClient) ListPolicyVersions(ctx context.Context, in *v1.ListPolicyVersionsRequest, opts ...grpc.CallOption) (*v11.ListPolicyVersionsResponse, error) {
+	out := new(v11.ListPolicyVersionsResponse)
+	err := c.cc.Invoke(ctx, CerbosAdminService_ListPolicyVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cerbosAdminServiceClient) GetPolicyVersion(ctx context.Context, in *v1.GetPolicyVersionRequest, opts ...grpc.CallOption) (*v11.GetPolicyVersionResponse, error) {
+	out := new(v11.GetPolicyVersionResponse)
+	err := c.cc.Invoke(ctx, CerbosAdminService_GetPolicyVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cerbosAdminServiceClient) RollbackPolicy(ctx context.Context, in *v1.RollbackPolicyRequest, opts ...grpc.CallOption) (*v11.RollbackPolicyResponse, error) {
+	out := new(v11.RollbackPolicyResponse)
+	err := c.cc.Invoke(ctx, CerbosAdminService_RollbackPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cerbosAdminServiceClient) DeletePolicy(ctx context.Context, in *v1.DeletePolicyRequest, opts ...grpc.CallOption) (*v11.DeletePolicyResponse, error) {
+	out := new(v11.DeletePolicyResponse)
+	err := c.cc.Invoke(ctx, CerbosAdminService_DeletePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cerbosAdminServiceClient) RestorePolicy(ctx context.Context, in *v1.RestorePolicyRequest, opts ...grpc.CallOption) (*v11.RestorePolicyResponse, error) {
+	out := new(v11.RestorePolicyResponse)
+	err := c.cc.Invoke(ctx, CerbosAdminService_RestorePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cerbosAdminServiceClient) ListStoreEvents(ctx context.Context, in *v1.ListStoreEventsRequest, opts ...grpc.CallOption) (*v11.ListStoreEventsResponse, error) {
+	out := new(v11.ListStoreEventsResponse)
+	err := c.cc.Invoke(ctx, CerbosAdminService_ListStoreEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cerbosAdminServiceClient) SnapshotStore(ctx context.Context, in *v1.SnapshotStoreRequest, opts ...grpc.CallOption) (*v11.SnapshotStoreResponse, error) {
+	out := new(v11.SnapshotStoreResponse)
+	err := c.cc.Invoke(ctx, CerbosAdminService_SnapshotStore_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cerbosAdminServiceClient) RestoreStore(ctx context.Context, in *v1.RestoreStoreRequest, opts ...grpc.CallOption) (*v11.RestoreStoreResponse, error) {
+	out := new(v11.RestoreStoreResponse)
+	err := c.cc.Invoke(ctx, CerbosAdminService_RestoreStore_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CerbosAdminServiceServer is the server API for CerbosAdminService service.
 // All implementations must embed UnimplementedCerbosAdminServiceServer
 // for forward compatibility
@@ -437,6 +808,18 @@ type CerbosAdminServiceServer interface {
 	GetSchema(context.Context, *v1.GetSchemaRequest) (*v11.GetSchemaResponse, error)
 	DeleteSchema(context.Context, *v1.DeleteSchemaRequest) (*v11.DeleteSchemaResponse, error)
 	ReloadStore(context.Context, *v1.ReloadStoreRequest) (*v11.ReloadStoreResponse, error)
+	ExportPolicySnapshot(context.Context, *v1.ExportPolicySnapshotRequest) (*v11.ExportPolicySnapshotResponse, error)
+	CheckResourcesAsOf(context.Context, *v1.CheckResourcesAsOfRequest) (*v11.CheckResourcesAsOfResponse, error)
+	InspectEffectiveRules(context.Context, *v1.InspectEffectiveRulesRequest) (*v11.InspectEffectiveRulesResponse, error)
+	ListDeprecations(context.Context, *v1.ListDeprecationsRequest) (*v11.ListDeprecationsResponse, error)
+	ListPolicyVersions(context.Context, *v1.ListPolicyVersionsRequest) (*v11.ListPolicyVersionsResponse, error)
+	GetPolicyVersion(context.Context, *v1.GetPolicyVersionRequest) (*v11.GetPolicyVersionResponse, error)
+	RollbackPolicy(context.Context, *v1.RollbackPolicyRequest) (*v11.RollbackPolicyResponse, error)
+	DeletePolicy(context.Context, *v1.DeletePolicyRequest) (*v11.DeletePolicyResponse, error)
+	RestorePolicy(context.Context, *v1.RestorePolicyRequest) (*v11.RestorePolicyResponse, error)
+	ListStoreEvents(context.Context, *v1.ListStoreEventsRequest) (*v11.ListStoreEventsResponse, error)
+	SnapshotStore(context.Context, *v1.SnapshotStoreRequest) (*v11.SnapshotStoreResponse, error)
+	RestoreStore(context.Context, *v1.RestoreStoreRequest) (*v11.RestoreStoreResponse, error)
 	mustEmbedUnimplementedCerbosAdminServiceServer()
 }
 
@@ -477,6 +860,42 @@ func (UnimplementedCerbosAdminServiceServer) DeleteSchema(context.Context, *v1.D
 func (UnimplementedCerbosAdminServiceServer) ReloadStore(context.Context, *v1.ReloadStoreRequest) (*v11.ReloadStoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReloadStore not implemented")
 }
+func (UnimplementedCerbosAdminServiceServer) ExportPolicySnapshot(context.Context, *v1.ExportPolicySnapshotRequest) (*v11.ExportPolicySnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPolicySnapshot not implemented")
+}
+func (UnimplementedCerbosAdminServiceServer) CheckResourcesAsOf(context.Context, *v1.CheckResourcesAsOfRequest) (*v11.CheckResourcesAsOfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckResourcesAsOf not implemented")
+}
+func (UnimplementedCerbosAdminServiceServer) InspectEffectiveRules(context.Context, *v1.InspectEffectiveRulesRequest) (*v11.InspectEffectiveRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectEffectiveRules not implemented")
+}
+func (UnimplementedCerbosAdminServiceServer) ListDeprecations(context.Context, *v1.ListDeprecationsRequest) (*v11.ListDeprecationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeprecations not implemented")
+}
+func (UnimplementedCerbosAdminServiceServer) ListPolicyVersions(context.Context, *v1.ListPolicyVersionsRequest) (*v11.ListPolicyVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicyVersions not implemented")
+}
+func (UnimplementedCerbosAdminServiceServer) GetPolicyVersion(context.Context, *v1.GetPolicyVersionRequest) (*v11.GetPolicyVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPolicyVersion not implemented")
+}
+func (UnimplementedCerbosAdminServiceServer) RollbackPolicy(context.Context, *v1.RollbackPolicyRequest) (*v11.RollbackPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackPolicy not implemented")
+}
+func (UnimplementedCerbosAdminServiceServer) DeletePolicy(context.Context, *v1.DeletePolicyRequest) (*v11.DeletePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
+}
+func (UnimplementedCerbosAdminServiceServer) RestorePolicy(context.Context, *v1.RestorePolicyRequest) (*v11.RestorePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePolicy not implemented")
+}
+func (UnimplementedCerbosAdminServiceServer) ListStoreEvents(context.Context, *v1.ListStoreEventsRequest) (*v11.ListStoreEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStoreEvents not implemented")
+}
+func (UnimplementedCerbosAdminServiceServer) SnapshotStore(context.Context, *v1.SnapshotStoreRequest) (*v11.SnapshotStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotStore not implemented")
+}
+func (UnimplementedCerbosAdminServiceServer) RestoreStore(context.Context, *v1.RestoreStoreRequest) (*v11.RestoreStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreStore not implemented")
+}
 func (UnimplementedCerbosAdminServiceServer) mustEmbedUnimplementedCerbosAdminServiceServer() {}
 
 // UnsafeCerbosAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -691,6 +1110,222 @@ func _CerbosAdminService_ReloadStore_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CerbosAdminService_ExportPolicySnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ExportPolicySnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosAdminServiceServer).ExportPolicySnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosAdminService_ExportPolicySnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosAdminServiceServer).ExportPolicySnapshot(ctx, req.(*v1.ExportPolicySnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CerbosAdminService_CheckResourcesAsOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.CheckResourcesAsOfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosAdminServiceServer).CheckResourcesAsOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosAdminService_CheckResourcesAsOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosAdminServiceServer).CheckResourcesAsOf(ctx, req.(*v1.CheckResourcesAsOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CerbosAdminService_InspectEffectiveRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.InspectEffectiveRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosAdminServiceServer).InspectEffectiveRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosAdminService_InspectEffectiveRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosAdminServiceServer).InspectEffectiveRules(ctx, req.(*v1.InspectEffectiveRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CerbosAdminService_ListDeprecations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ListDeprecationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosAdminServiceServer).ListDeprecations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosAdminService_ListDeprecations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosAdminServiceServer).ListDeprecations(ctx, req.(*v1.ListDeprecationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CerbosAdminService_ListPolicyVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ListPolicyVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosAdminServiceServer).ListPolicyVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosAdminService_ListPolicyVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosAdminServiceServer).ListPolicyVersions(ctx, req.(*v1.ListPolicyVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CerbosAdminService_GetPolicyVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetPolicyVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosAdminServiceServer).GetPolicyVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosAdminService_GetPolicyVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosAdminServiceServer).GetPolicyVersion(ctx, req.(*v1.GetPolicyVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CerbosAdminService_RollbackPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RollbackPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosAdminServiceServer).RollbackPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosAdminService_RollbackPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosAdminServiceServer).RollbackPolicy(ctx, req.(*v1.RollbackPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CerbosAdminService_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.DeletePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosAdminServiceServer).DeletePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosAdminService_DeletePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosAdminServiceServer).DeletePolicy(ctx, req.(*v1.DeletePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CerbosAdminService_RestorePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RestorePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosAdminServiceServer).RestorePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosAdminService_RestorePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosAdminServiceServer).RestorePolicy(ctx, req.(*v1.RestorePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CerbosAdminService_ListStoreEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ListStoreEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosAdminServiceServer).ListStoreEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosAdminService_ListStoreEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosAdminServiceServer).ListStoreEvents(ctx, req.(*v1.ListStoreEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CerbosAdminService_SnapshotStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.SnapshotStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosAdminServiceServer).SnapshotStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosAdminService_SnapshotStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosAdminServiceServer).SnapshotStore(ctx, req.(*v1.SnapshotStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CerbosAdminService_RestoreStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RestoreStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosAdminServiceServer).RestoreStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosAdminService_RestoreStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosAdminServiceServer).RestoreStore(ctx, req.(*v1.RestoreStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CerbosAdminService_ServiceDesc is the grpc.ServiceDesc for CerbosAdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -738,6 +1373,54 @@ var CerbosAdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReloadStore",
 			Handler:    _CerbosAdminService_ReloadStore_Handler,
 		},
+		{
+			MethodName: "ExportPolicySnapshot",
+			Handler:    _CerbosAdminService_ExportPolicySnapshot_Handler,
+		},
+		{
+			MethodName: "CheckResourcesAsOf",
+			Handler:    _CerbosAdminService_CheckResourcesAsOf_Handler,
+		},
+		{
+			MethodName: "InspectEffectiveRules",
+			Handler:    _CerbosAdminService_InspectEffectiveRules_Handler,
+		},
+		{
+			MethodName: "ListDeprecations",
+			Handler:    _CerbosAdminService_ListDeprecations_Handler,
+		},
+		{
+			MethodName: "ListPolicyVersions",
+			Handler:    _CerbosAdminService_ListPolicyVersions_Handler,
+		},
+		{
+			MethodName: "GetPolicyVersion",
+			Handler:    _CerbosAdminService_GetPolicyVersion_Handler,
+		},
+		{
+			MethodName: "RollbackPolicy",
+			Handler:    _CerbosAdminService_RollbackPolicy_Handler,
+		},
+		{
+			MethodName: "DeletePolicy",
+			Handler:    _CerbosAdminService_DeletePolicy_Handler,
+		},
+		{
+			MethodName: "RestorePolicy",
+			Handler:    _CerbosAdminService_RestorePolicy_Handler,
+		},
+		{
+			MethodName: "ListStoreEvents",
+			Handler:    _CerbosAdminService_ListStoreEvents_Handler,
+		},
+		{
+			MethodName: "SnapshotStore",
+			Handler:    _CerbosAdminService_SnapshotStore_Handler,
+		},
+		{
+			MethodName: "RestoreStore",
+			Handler:    _CerbosAdminService_RestoreStore_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
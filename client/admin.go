@@ -37,6 +37,18 @@ type AdminClient interface {
 	ListSchemas(ctx context.Context) ([]string, error)
 	GetSchema(ctx context.Context, ids ...string) ([]*schemav1.Schema, error)
 	ReloadStore(ctx context.Context, wait bool) error
+	ExportPolicySnapshot(ctx context.Context) (*responsev1.ExportPolicySnapshotResponse, error)
+	CheckResourcesAsOf(ctx context.Context, at HistoryPoint, principal *Principal, resources *ResourceBatch) (string, *CheckResourcesResponse, error)
+	InspectEffectiveRules(ctx context.Context, resource, version, scope string) (*responsev1.InspectEffectiveRulesResponse, error)
+	ListDeprecations(ctx context.Context) (*responsev1.ListDeprecationsResponse, error)
+	ListPolicyVersions(ctx context.Context, id string) ([]*responsev1.ListPolicyVersionsResponse_Version, error)
+	GetPolicyVersion(ctx context.Context, id string, version uint64) (*policyv1.Policy, error)
+	RollbackPolicy(ctx context.Context, id string, version uint64) (uint64, error)
+	DeletePolicy(ctx context.Context, ids ...string) (uint32, error)
+	RestorePolicy(ctx context.Context, ids ...string) (uint32, error)
+	ListStoreEvents(ctx context.Context, id string, limit uint32) ([]*responsev1.ListStoreEventsResponse_Event, error)
+	SnapshotStore(ctx context.Context, key string) (*responsev1.SnapshotStoreResponse, error)
+	RestoreStore(ctx context.Context, key string) (*responsev1.RestoreStoreResponse, error)
 }
 
 // NewAdminClient creates a new admin client.
@@ -319,3 +331,223 @@ func (c *GrpcAdminClient) ReloadStore(ctx context.Context, wait bool) error {
 
 	return nil
 }
+
+// ExportPolicySnapshot returns the compiled form of all the policies evaluated by the server.
+func (c *GrpcAdminClient) ExportPolicySnapshot(ctx context.Context) (*responsev1.ExportPolicySnapshotResponse, error) {
+	req := &requestv1.ExportPolicySnapshotRequest{}
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("could not validate export policy snapshot request: %w", err)
+	}
+
+	res, err := c.client.ExportPolicySnapshot(ctx, req, grpc.PerRPCCredentials(c.creds))
+	if err != nil {
+		return nil, fmt.Errorf("could not export policy snapshot: %w", err)
+	}
+
+	return res, nil
+}
+
+// CheckResourcesAsOf checks access to a batch of resources using the policies that were in the store at the given point in its history.
+// It returns the store revision that was in effect at that point along with the check results.
+func (c *GrpcAdminClient) CheckResourcesAsOf(ctx context.Context, at HistoryPoint, principal *Principal, resources *ResourceBatch) (string, *CheckResourcesResponse, error) {
+	if err := isValid(principal); err != nil {
+		return "", nil, fmt.Errorf("invalid principal: %w", err)
+	}
+
+	if err := isValid(resources); err != nil {
+		return "", nil, fmt.Errorf("invalid resource batch: %w", err)
+	}
+
+	req := &requestv1.CheckResourcesAsOfRequest{
+		Check: &requestv1.CheckResourcesRequest{
+			Principal: principal.p,
+			Resources: resources.batch,
+		},
+	}
+	at(req)
+
+	if err := req.Validate(); err != nil {
+		return "", nil, fmt.Errorf("could not validate check resources as of request: %w", err)
+	}
+
+	res, err := c.client.CheckResourcesAsOf(ctx, req, grpc.PerRPCCredentials(c.creds))
+	if err != nil {
+		return "", nil, fmt.Errorf("could not check resources: %w", err)
+	}
+
+	return res.StoreRevision, &CheckResourcesResponse{CheckResourcesResponse: res.Check}, nil
+}
+
+// InspectEffectiveRules returns the rules of the resource policy that can produce a decision at each level of the given scope,
+// after taking into account the rules of more specific scopes that override the rules of their parents.
+func (c *GrpcAdminClient) InspectEffectiveRules(ctx context.Context, resource, version, scope string) (*responsev1.InspectEffectiveRulesResponse, error) {
+	req := &requestv1.InspectEffectiveRulesRequest{
+		Resource:      resource,
+		PolicyVersion: version,
+		Scope:         scope,
+	}
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("could not validate inspect effective rules request: %w", err)
+	}
+
+	res, err := c.client.InspectEffectiveRules(ctx, req, grpc.PerRPCCredentials(c.creds))
+	if err != nil {
+		return nil, fmt.Errorf("could not inspect effective rules: %w", err)
+	}
+
+	return res, nil
+}
+
+// ListDeprecations returns the usages of deprecated policy features in the policies of the store.
+func (c *GrpcAdminClient) ListDeprecations(ctx context.Context) (*responsev1.ListDeprecationsResponse, error) {
+	req := &requestv1.ListDeprecationsRequest{}
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("could not validate list deprecations request: %w", err)
+	}
+
+	res, err := c.client.ListDeprecations(ctx, req, grpc.PerRPCCredentials(c.creds))
+	if err != nil {
+		return nil, fmt.Errorf("could not list deprecations: %w", err)
+	}
+
+	return res, nil
+}
+
+// ListPolicyVersions returns the versions of the given policy kept by the store, oldest first.
+func (c *GrpcAdminClient) ListPolicyVersions(ctx context.Context, id string) ([]*responsev1.ListPolicyVersionsResponse_Version, error) {
+	req := &requestv1.ListPolicyVersionsRequest{
+		Id: id,
+	}
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("could not validate list policy versions request: %w", err)
+	}
+
+	res, err := c.client.ListPolicyVersions(ctx, req, grpc.PerRPCCredentials(c.creds))
+	if err != nil {
+		return nil, fmt.Errorf("could not list policy versions: %w", err)
+	}
+
+	return res.Versions, nil
+}
+
+// GetPolicyVersion returns the given policy as it was at the given version.
+func (c *GrpcAdminClient) GetPolicyVersion(ctx context.Context, id string, version uint64) (*policyv1.Policy, error) {
+	req := &requestv1.GetPolicyVersionRequest{
+		Id:      id,
+		Version: version,
+	}
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("could not validate get policy version request: %w", err)
+	}
+
+	res, err := c.client.GetPolicyVersion(ctx, req, grpc.PerRPCCredentials(c.creds))
+	if err != nil {
+		return nil, fmt.Errorf("could not get policy version: %w", err)
+	}
+
+	return res.Policy, nil
+}
+
+// RollbackPolicy restores the given policy to the given version and returns the new version created by the rollback.
+func (c *GrpcAdminClient) RollbackPolicy(ctx context.Context, id string, version uint64) (uint64, error) {
+	req := &requestv1.RollbackPolicyRequest{
+		Id:      id,
+		Version: version,
+	}
+	if err := req.Validate(); err != nil {
+		return 0, fmt.Errorf("could not validate rollback policy request: %w", err)
+	}
+
+	res, err := c.client.RollbackPolicy(ctx, req, grpc.PerRPCCredentials(c.creds))
+	if err != nil {
+		return 0, fmt.Errorf("could not rollback policy: %w", err)
+	}
+
+	return res.Version, nil
+}
+
+// DeletePolicy deletes the given policies. Deleted policies can be restored using RestorePolicy until the retention period configured for the store expires.
+func (c *GrpcAdminClient) DeletePolicy(ctx context.Context, ids ...string) (uint32, error) {
+	req := &requestv1.DeletePolicyRequest{
+		Id: ids,
+	}
+	if err := req.Validate(); err != nil {
+		return 0, fmt.Errorf("could not validate delete policy request: %w", err)
+	}
+
+	resp, err := c.client.DeletePolicy(ctx, req, grpc.PerRPCCredentials(c.creds))
+	if err != nil {
+		return 0, fmt.Errorf("could not delete policy: %w", err)
+	}
+
+	return resp.DeletedPolicies, nil
+}
+
+// RestorePolicy restores the given policies that were deleted using DeletePolicy.
+func (c *GrpcAdminClient) RestorePolicy(ctx context.Context, ids ...string) (uint32, error) {
+	req := &requestv1.RestorePolicyRequest{
+		Id: ids,
+	}
+	if err := req.Validate(); err != nil {
+		return 0, fmt.Errorf("could not validate restore policy request: %w", err)
+	}
+
+	resp, err := c.client.RestorePolicy(ctx, req, grpc.PerRPCCredentials(c.creds))
+	if err != nil {
+		return 0, fmt.Errorf("could not restore policy: %w", err)
+	}
+
+	return resp.RestoredPolicies, nil
+}
+
+// ListStoreEvents returns the changes made to the store through the Admin API, most recent first.
+// If id is not empty, only the changes made to the policy or schema with that identifier are returned.
+// The server returns at most 100 events if limit is zero.
+func (c *GrpcAdminClient) ListStoreEvents(ctx context.Context, id string, limit uint32) ([]*responsev1.ListStoreEventsResponse_Event, error) {
+	req := &requestv1.ListStoreEventsRequest{
+		Id:    id,
+		Limit: limit,
+	}
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("could not validate list store events request: %w", err)
+	}
+
+	res, err := c.client.ListStoreEvents(ctx, req, grpc.PerRPCCredentials(c.creds))
+	if err != nil {
+		return nil, fmt.Errorf("could not list store events: %w", err)
+	}
+
+	return res.Events, nil
+}
+
+// SnapshotStore writes the policies and schemas in the store to the snapshots bucket configured on the server.
+// If key is empty, the server generates one based on the current time. The key of the snapshot is returned in the response.
+func (c *GrpcAdminClient) SnapshotStore(ctx context.Context, key string) (*responsev1.SnapshotStoreResponse, error) {
+	req := &requestv1.SnapshotStoreRequest{Key: key}
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("could not validate snapshot store request: %w", err)
+	}
+
+	res, err := c.client.SnapshotStore(ctx, req, grpc.PerRPCCredentials(c.creds))
+	if err != nil {
+		return nil, fmt.Errorf("could not snapshot store: %w", err)
+	}
+
+	return res, nil
+}
+
+// RestoreStore adds or updates the policies and schemas from the snapshot with the given key in the store.
+// Policies and schemas that are not in the snapshot are left unchanged.
+func (c *GrpcAdminClient) RestoreStore(ctx context.Context, key string) (*responsev1.RestoreStoreResponse, error) {
+	req := &requestv1.RestoreStoreRequest{Key: key}
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("could not validate restore store request: %w", err)
+	}
+
+	res, err := c.client.RestoreStore(ctx, req, grpc.PerRPCCredentials(c.creds))
+	if err != nil {
+		return nil, fmt.Errorf("could not restore store: %w", err)
+	}
+
+	return res, nil
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"path/filepath"
+	"sort"
 	"testing"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
 	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
 	responsev1 "github.com/cerbos/cerbos/api/genpb/cerbos/response/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
 	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
 	"github.com/cerbos/cerbos/client/testutil"
 	"github.com/cerbos/cerbos/internal/namer"
@@ -207,3 +209,181 @@ func TestFilterPolicies(t *testing.T) {
 		)
 	})
 }
+
+func TestExportPolicySnapshot(t *testing.T) {
+	ac, ps := setUpAdminClientAndPolicySet(t)
+
+	have, err := ac.ExportPolicySnapshot(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "sqlite3", have.Metadata.StoreDriver)
+	require.NotNil(t, have.Metadata.CreatedAt)
+
+	var want []string
+	for _, p := range ps.GetPolicies() {
+		if !p.Disabled {
+			want = append(want, namer.PolicyKey(p))
+		}
+	}
+
+	policyIDs := make([]string, len(have.Entries))
+	for i, entry := range have.Entries {
+		policyIDs[i] = entry.PolicyId
+
+		rps := &runtimev1.RunnablePolicySet{}
+		require.NoError(t, entry.PolicySet.UnmarshalTo(rps))
+		require.Equal(t, entry.Fqn, rps.Fqn)
+		require.Equal(t, namer.FQNFromPolicyKey(entry.PolicyId), rps.Fqn)
+	}
+	require.ElementsMatch(t, want, policyIDs)
+
+	idx := sort.SearchStrings(policyIDs, "resource.leave_request.vdefault")
+	require.Less(t, idx, len(policyIDs))
+	require.Equal(t, "resource.leave_request.vdefault", policyIDs[idx])
+	require.Contains(t, have.Entries[idx].Dependencies, "cerbos.derived_roles.alpha")
+	require.Contains(t, have.Entries[idx].Dependencies, "cerbos.derived_roles.beta")
+}
+
+func TestInspectEffectiveRules(t *testing.T) {
+	ac, _ := setUpAdminClientAndPolicySet(t)
+
+	have, err := ac.InspectEffectiveRules(context.Background(), "leave_request", "default", "acme.hr.uk")
+	require.NoError(t, err)
+	require.Equal(t, "cerbos.resource.leave_request.vdefault/acme.hr.uk", have.PolicyFqn)
+
+	scopes := make([]string, len(have.Scopes))
+	for i, s := range have.Scopes {
+		scopes[i] = s.Scope
+		require.NotEmpty(t, s.Rules)
+	}
+	require.Equal(t, []string{"acme.hr.uk", "acme.hr", "acme", ""}, scopes)
+
+	_, err = ac.InspectEffectiveRules(context.Background(), "leave_request", "default", "acme.sales")
+	require.Error(t, err)
+}
+
+func TestListDeprecations(t *testing.T) {
+	ac, _ := setUpAdminClientAndPolicySet(t)
+
+	have, err := ac.ListDeprecations(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, have.Deprecations)
+
+	for _, d := range have.Deprecations {
+		require.NotEmpty(t, d.File)
+		require.NotEmpty(t, d.Location)
+		require.NotEmpty(t, d.Feature)
+		require.NotEmpty(t, d.Replacement)
+	}
+}
+
+func TestCheckResourcesAsOf(t *testing.T) {
+	ac, _ := setUpAdminClientAndPolicySet(t)
+
+	principal := NewPrincipal("john", "admin")
+	resources := NewResourceBatch().Add(NewResource("leave_request", "XX125").WithPolicyVersion("20210210"), "approve")
+
+	beforeRevision, have, err := ac.CheckResourcesAsOf(context.Background(), AtTime(time.Now()), principal, resources)
+	require.NoError(t, err)
+	require.NotEmpty(t, beforeRevision)
+	require.True(t, have.GetResource("XX125").IsAllowed("approve"))
+
+	_, err = ac.DisablePolicy(context.Background(), "resource.leave_request.v20210210")
+	require.NoError(t, err)
+
+	afterRevision, have, err := ac.CheckResourcesAsOf(context.Background(), AtTime(time.Now()), principal, resources)
+	require.NoError(t, err)
+	require.NotEqual(t, beforeRevision, afterRevision)
+	require.False(t, have.GetResource("XX125").IsAllowed("approve"))
+
+	haveRevision, have, err := ac.CheckResourcesAsOf(context.Background(), AtRevision(beforeRevision), principal, resources)
+	require.NoError(t, err)
+	require.Equal(t, beforeRevision, haveRevision)
+	require.True(t, have.GetResource("XX125").IsAllowed("approve"))
+
+	_, _, err = ac.CheckResourcesAsOf(context.Background(), AtRevision("0"), principal, resources)
+	require.Error(t, err)
+}
+
+func TestPolicyVersions(t *testing.T) {
+	ac, _ := setUpAdminClientAndPolicySet(t)
+
+	const policyID = "resource.leave_request.v20210210"
+
+	_, err := ac.DisablePolicy(context.Background(), policyID)
+	require.NoError(t, err)
+
+	versions, err := ac.ListPolicyVersions(context.Background(), policyID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.Equal(t, responsev1.ListPolicyVersionsResponse_Version_CHANGE_CREATED, versions[0].Change)
+	require.False(t, versions[0].Disabled)
+	require.Equal(t, responsev1.ListPolicyVersionsResponse_Version_CHANGE_UPDATED, versions[1].Change)
+	require.True(t, versions[1].Disabled)
+
+	have, err := ac.GetPolicyVersion(context.Background(), policyID, versions[0].Version)
+	require.NoError(t, err)
+	require.Equal(t, "leave_request", have.GetResourcePolicy().Resource)
+	require.False(t, have.Disabled)
+
+	newVersion, err := ac.RollbackPolicy(context.Background(), policyID, versions[0].Version)
+	require.NoError(t, err)
+	require.Greater(t, newVersion, versions[1].Version)
+
+	policies, err := ac.GetPolicy(context.Background(), policyID)
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+	require.False(t, policies[0].Disabled)
+
+	_, err = ac.GetPolicyVersion(context.Background(), policyID, newVersion+1)
+	require.Error(t, err)
+}
+
+func TestDeleteAndRestorePolicy(t *testing.T) {
+	ac, _ := setUpAdminClientAndPolicySet(t)
+
+	const policyID = "resource.leave_request.v20210210"
+
+	deleted, err := ac.DeletePolicy(context.Background(), policyID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), deleted)
+
+	policies, err := ac.GetPolicy(context.Background(), policyID)
+	require.NoError(t, err)
+	require.Empty(t, policies)
+
+	restored, err := ac.RestorePolicy(context.Background(), policyID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), restored)
+
+	policies, err = ac.GetPolicy(context.Background(), policyID)
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+
+	restored, err = ac.RestorePolicy(context.Background(), policyID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), restored)
+}
+
+func TestListStoreEvents(t *testing.T) {
+	ac, _ := setUpAdminClientAndPolicySet(t)
+
+	const policyID = "resource.leave_request.v20210210"
+
+	_, err := ac.DisablePolicy(context.Background(), policyID)
+	require.NoError(t, err)
+
+	events, err := ac.ListStoreEvents(context.Background(), policyID, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, responsev1.ListStoreEventsResponse_Event_KIND_DISABLE_POLICY, events[0].Kind)
+	require.Equal(t, responsev1.ListStoreEventsResponse_Event_KIND_ADD_OR_UPDATE_POLICY, events[1].Kind)
+	require.Equal(t, policyID, events[0].Target)
+	require.NotEmpty(t, events[0].Actor.User)
+
+	events, err = ac.ListStoreEvents(context.Background(), "", 1)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	_, err = ac.ListStoreEvents(context.Background(), "", 1001)
+	require.Error(t, err)
+}
@@ -40,6 +40,8 @@ type Client interface {
 	CheckResources(ctx context.Context, principal *Principal, resources *ResourceBatch) (*CheckResourcesResponse, error)
 	// ServerInfo retrieves server information.
 	ServerInfo(ctx context.Context) (*ServerInfo, error)
+	// ResourceKinds lists the resource kinds, policy versions, scopes and actions defined by the policies in the store.
+	ResourceKinds(ctx context.Context) (*ResourceKinds, error)
 	// With sets per-request options for the client.
 	With(opts ...RequestOpt) Client
 	// PlanResources creates a query plan for performing the given action on a set of resources of the given kind.
@@ -338,6 +340,9 @@ func (gc *grpcClient) PlanResources(ctx context.Context, principal *Principal, r
 	if gc.opts != nil {
 		req.AuxData = gc.opts.auxData
 		req.IncludeMeta = gc.opts.includeMeta
+		req.ExpandConditionals = gc.opts.expandConditionals
+		req.IncludeRuleResiduals = gc.opts.includeRuleResiduals
+		req.SimplifyFilters = gc.opts.simplifyFilters
 	}
 
 	result, err := gc.stub.PlanResources(ctx, req)
@@ -512,6 +517,16 @@ func (gc *grpcClient) ServerInfo(ctx context.Context) (*ServerInfo, error) {
 	}, nil
 }
 
+func (gc *grpcClient) ResourceKinds(ctx context.Context) (*ResourceKinds, error) {
+	resp, err := gc.stub.ListResourceKinds(ctx, &requestv1.ListResourceKindsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &ResourceKinds{
+		ListResourceKindsResponse: resp,
+	}, nil
+}
+
 func (gc *grpcClient) With(reqOpts ...RequestOpt) Client {
 	opts := &reqOpt{}
 	for _, ro := range reqOpts {
@@ -15,6 +15,7 @@ import (
 	"go.uber.org/multierr"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
 	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
@@ -889,6 +890,19 @@ func (rp *ResourcePolicy) WithDerivedRolesImports(imp ...string) *ResourcePolicy
 	return rp
 }
 
+// AddLocalDerivedRole adds a derived role that is only visible to this policy.
+func (rp *ResourcePolicy) AddLocalDerivedRole(name string, parentRoles []string) *ResourcePolicy {
+	rp.p.LocalDerivedRoles = append(rp.p.LocalDerivedRoles, &policyv1.RoleDef{Name: name, ParentRoles: parentRoles})
+	return rp
+}
+
+// AddLocalDerivedRoleWithCondition adds a derived role with a condition attached that is only visible to this policy.
+func (rp *ResourcePolicy) AddLocalDerivedRoleWithCondition(name string, parentRoles []string, m match) *ResourcePolicy {
+	cond := &policyv1.Condition{Condition: &policyv1.Condition_Match{Match: m.build()}}
+	rp.p.LocalDerivedRoles = append(rp.p.LocalDerivedRoles, &policyv1.RoleDef{Name: name, ParentRoles: parentRoles, Condition: cond})
+	return rp
+}
+
 func (rp *ResourcePolicy) WithScope(scope string) *ResourcePolicy {
 	rp.p.Scope = scope
 	return rp
@@ -1345,6 +1359,38 @@ func (si *ServerInfo) MarshalJSON() ([]byte, error) {
 	return protojson.Marshal(si.ServerInfoResponse)
 }
 
+type ResourceKinds struct {
+	*responsev1.ListResourceKindsResponse
+}
+
+// Kinds returns the names of the resource kinds.
+func (rk *ResourceKinds) Kinds() []string {
+	kinds := make([]string, len(rk.ResourceKinds))
+	for i, k := range rk.ResourceKinds {
+		kinds[i] = k.Kind
+	}
+	return kinds
+}
+
+// Actions returns the actions referenced by the policies for the given resource kind.
+// Actions can be wildcard patterns such as `view:*`.
+func (rk *ResourceKinds) Actions(kind string) []string {
+	for _, k := range rk.ResourceKinds {
+		if k.Kind == kind {
+			return k.Actions
+		}
+	}
+	return nil
+}
+
+func (rk *ResourceKinds) String() string {
+	return protojson.Format(rk.ListResourceKindsResponse)
+}
+
+func (rk *ResourceKinds) MarshalJSON() ([]byte, error) {
+	return protojson.Marshal(rk.ListResourceKindsResponse)
+}
+
 type AuditLogType uint8
 
 const (
@@ -1406,3 +1452,20 @@ func WithVersionRegexp(v string) ListPoliciesOption {
 		request.VersionRegexp = v
 	}
 }
+
+// HistoryPoint identifies a point in the history of the policy store.
+type HistoryPoint func(*requestv1.CheckResourcesAsOfRequest)
+
+// AtRevision identifies a store revision such as a git commit hash or tag or a database policy revision ID.
+func AtRevision(revision string) HistoryPoint {
+	return func(request *requestv1.CheckResourcesAsOfRequest) {
+		request.AsOf = &requestv1.CheckResourcesAsOfRequest_Revision{Revision: revision}
+	}
+}
+
+// AtTime identifies the contents of the store at the given time.
+func AtTime(t time.Time) HistoryPoint {
+	return func(request *requestv1.CheckResourcesAsOfRequest) {
+		request.AsOf = &requestv1.CheckResourcesAsOfRequest_Time{Time: timestamppb.New(t)}
+	}
+}
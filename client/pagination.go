@@ -0,0 +1,202 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const (
+	filterOpAnd         = "and"
+	filterOpOr          = "or"
+	filterOpEquals      = "eq"
+	filterOpGreaterThan = "gt"
+	filterOpLessThan    = "lt"
+)
+
+var (
+	errNoSortKeys    = errors.New("at least one sort key is required")
+	errInvalidCursor = errors.New("invalid cursor")
+)
+
+// SortKey is a field used to order the resources returned by a paginated query.
+type SortKey struct {
+	// Variable is the name of the field as it appears in query plan filters. For example, `request.resource.attr.createdAt`.
+	Variable string
+	// Descending is true if the resources are ordered by descending values of the field.
+	Descending bool
+}
+
+// Asc returns a sort key that orders resources by ascending values of the given variable.
+func Asc(variable string) SortKey {
+	return SortKey{Variable: variable}
+}
+
+// Desc returns a sort key that orders resources by descending values of the given variable.
+func Desc(variable string) SortKey {
+	return SortKey{Variable: variable, Descending: true}
+}
+
+// KeysetPagination builds the filters required to page through the resources allowed by a query plan using keyset
+// (also known as seek) pagination. Unlike pagination using offsets, pages don't skip or repeat resources when resources
+// are added or removed between requests.
+//
+// The resources must be ordered by the sort keys, in the same order, when they are fetched from the data store.
+// The last sort key must uniquely identify a resource (for example, the resource ID) and the values of the sort keys
+// must never be null, otherwise resources might be skipped.
+type KeysetPagination struct {
+	keys []SortKey
+}
+
+// NewKeysetPagination creates a KeysetPagination that orders resources by the given keys.
+func NewKeysetPagination(keys ...SortKey) (*KeysetPagination, error) {
+	if len(keys) == 0 {
+		return nil, errNoSortKeys
+	}
+
+	for i, k := range keys {
+		if k.Variable == "" {
+			return nil, fmt.Errorf("sort key #%d has no variable", i)
+		}
+	}
+
+	return &KeysetPagination{keys: keys}, nil
+}
+
+// SortKeys returns the keys that the resources must be ordered by.
+func (p *KeysetPagination) SortKeys() []SortKey {
+	return p.keys
+}
+
+// Cursor returns an opaque cursor pointing to the resource with the given values of the sort keys, which is usually the
+// last resource of the current page. The values must be given in the same order as the sort keys.
+func (p *KeysetPagination) Cursor(values ...any) (string, error) {
+	if len(values) != len(p.keys) {
+		return "", fmt.Errorf("expected %d cursor values but got %d", len(p.keys), len(values))
+	}
+
+	list := &structpb.ListValue{Values: make([]*structpb.Value, len(values))}
+	for i, v := range values {
+		pbVal, err := util.ToStructPB(v)
+		if err != nil {
+			return "", fmt.Errorf("invalid value for sort key %q: %w", p.keys[i].Variable, err)
+		}
+		list.Values[i] = pbVal
+	}
+
+	b, err := protojson.Marshal(list)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func (p *KeysetPagination) decodeCursor(cursor string) ([]*structpb.Value, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidCursor, err)
+	}
+
+	list := &structpb.ListValue{}
+	if err := protojson.Unmarshal(b, list); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidCursor, err)
+	}
+
+	if len(list.Values) != len(p.keys) {
+		return nil, fmt.Errorf("%w: expected %d values but got %d", errInvalidCursor, len(p.keys), len(list.Values))
+	}
+
+	for i, v := range list.Values {
+		if _, ok := v.Kind.(*structpb.Value_NullValue); ok {
+			return nil, fmt.Errorf("%w: value for sort key %q is null", errInvalidCursor, p.keys[i].Variable)
+		}
+	}
+
+	return list.Values, nil
+}
+
+// Filter returns a filter that only matches the resources allowed by the given query plan filter that come after the
+// cursor in the sort order. If the cursor is empty, the filter for the first page (the query plan filter itself) is returned.
+// The returned filter can be converted to a query in the same way as a filter returned by the PlanResources API.
+func (p *KeysetPagination) Filter(filter *enginev1.PlanResourcesFilter, cursor string) (*enginev1.PlanResourcesFilter, error) {
+	if cursor == "" || filter.GetKind() == enginev1.PlanResourcesFilter_KIND_ALWAYS_DENIED {
+		return filter, nil
+	}
+
+	values, err := p.decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	after := p.afterCursor(values)
+	switch filter.GetKind() {
+	case enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED:
+		return &enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_CONDITIONAL, Condition: after}, nil
+	case enginev1.PlanResourcesFilter_KIND_CONDITIONAL:
+		return &enginev1.PlanResourcesFilter{
+			Kind:      enginev1.PlanResourcesFilter_KIND_CONDITIONAL,
+			Condition: mkFilterExpr(filterOpAnd, filter.Condition, after),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported filter kind %s", filter.GetKind())
+	}
+}
+
+// afterCursor returns the condition satisfied by the resources that come after the cursor.
+// For keys (a, b) and cursor values (x, y) that is `a > x || (a == x && b > y)`.
+func (p *KeysetPagination) afterCursor(values []*structpb.Value) *enginev1.PlanResourcesFilter_Expression_Operand {
+	alternatives := make([]*enginev1.PlanResourcesFilter_Expression_Operand, len(p.keys))
+	for i, k := range p.keys {
+		op := filterOpGreaterThan
+		if k.Descending {
+			op = filterOpLessThan
+		}
+
+		conjuncts := make([]*enginev1.PlanResourcesFilter_Expression_Operand, 0, i+1)
+		for j := 0; j < i; j++ {
+			conjuncts = append(conjuncts, mkFilterExpr(filterOpEquals, mkFilterVar(p.keys[j].Variable), mkFilterValue(values[j])))
+		}
+		conjuncts = append(conjuncts, mkFilterExpr(op, mkFilterVar(k.Variable), mkFilterValue(values[i])))
+
+		alternatives[i] = conjuncts[0]
+		if len(conjuncts) > 1 {
+			alternatives[i] = mkFilterExpr(filterOpAnd, conjuncts...)
+		}
+	}
+
+	if len(alternatives) == 1 {
+		return alternatives[0]
+	}
+
+	return mkFilterExpr(filterOpOr, alternatives...)
+}
+
+func mkFilterExpr(op string, operands ...*enginev1.PlanResourcesFilter_Expression_Operand) *enginev1.PlanResourcesFilter_Expression_Operand {
+	return &enginev1.PlanResourcesFilter_Expression_Operand{
+		Node: &enginev1.PlanResourcesFilter_Expression_Operand_Expression{
+			Expression: &enginev1.PlanResourcesFilter_Expression{Operator: op, Operands: operands},
+		},
+	}
+}
+
+func mkFilterVar(name string) *enginev1.PlanResourcesFilter_Expression_Operand {
+	return &enginev1.PlanResourcesFilter_Expression_Operand{
+		Node: &enginev1.PlanResourcesFilter_Expression_Operand_Variable{Variable: name},
+	}
+}
+
+func mkFilterValue(v *structpb.Value) *enginev1.PlanResourcesFilter_Expression_Operand {
+	return &enginev1.PlanResourcesFilter_Expression_Operand{
+		Node: &enginev1.PlanResourcesFilter_Expression_Operand_Value{Value: v},
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/client"
+)
+
+func TestKeysetPagination(t *testing.T) {
+	_, err := client.NewKeysetPagination()
+	require.Error(t, err)
+
+	p, err := client.NewKeysetPagination(client.Desc("request.resource.attr.createdAt"), client.Asc("request.resource.id"))
+	require.NoError(t, err)
+
+	conditional := mustFilter(t, `{
+		"kind": "KIND_CONDITIONAL",
+		"condition": {"expression": {"operator": "eq", "operands": [{"variable": "request.resource.attr.owner"}, {"value": "harry"}]}}
+	}`)
+
+	t.Run("first_page", func(t *testing.T) {
+		have, err := p.Filter(conditional, "")
+		require.NoError(t, err)
+		require.Empty(t, cmp.Diff(conditional, have, protocmp.Transform()))
+	})
+
+	cursor, err := p.Cursor("2023-06-01T00:00:00Z", "A001")
+	require.NoError(t, err)
+
+	t.Run("conditional", func(t *testing.T) {
+		have, err := p.Filter(conditional, cursor)
+		require.NoError(t, err)
+
+		want := mustFilter(t, `{
+			"kind": "KIND_CONDITIONAL",
+			"condition": {"expression": {"operator": "and", "operands": [
+				{"expression": {"operator": "eq", "operands": [{"variable": "request.resource.attr.owner"}, {"value": "harry"}]}},
+				{"expression": {"operator": "or", "operands": [
+					{"expression": {"operator": "lt", "operands": [{"variable": "request.resource.attr.createdAt"}, {"value": "2023-06-01T00:00:00Z"}]}},
+					{"expression": {"operator": "and", "operands": [
+						{"expression": {"operator": "eq", "operands": [{"variable": "request.resource.attr.createdAt"}, {"value": "2023-06-01T00:00:00Z"}]}},
+						{"expression": {"operator": "gt", "operands": [{"variable": "request.resource.id"}, {"value": "A001"}]}}
+					]}}
+				]}}
+			]}}
+		}`)
+		require.Empty(t, cmp.Diff(want, have, protocmp.Transform()))
+	})
+
+	t.Run("always_allowed", func(t *testing.T) {
+		single, err := client.NewKeysetPagination(client.Asc("request.resource.id"))
+		require.NoError(t, err)
+
+		c, err := single.Cursor("A001")
+		require.NoError(t, err)
+
+		have, err := single.Filter(&enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED}, c)
+		require.NoError(t, err)
+
+		want := mustFilter(t, `{
+			"kind": "KIND_CONDITIONAL",
+			"condition": {"expression": {"operator": "gt", "operands": [{"variable": "request.resource.id"}, {"value": "A001"}]}}
+		}`)
+		require.Empty(t, cmp.Diff(want, have, protocmp.Transform()))
+	})
+
+	t.Run("always_denied", func(t *testing.T) {
+		denied := &enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_ALWAYS_DENIED}
+		have, err := p.Filter(denied, cursor)
+		require.NoError(t, err)
+		require.Empty(t, cmp.Diff(denied, have, protocmp.Transform()))
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		_, err := p.Cursor("A001")
+		require.Error(t, err, "cursor values must match the sort keys")
+
+		_, err = p.Filter(conditional, "not a cursor")
+		require.Error(t, err)
+
+		single, err := client.NewKeysetPagination(client.Asc("request.resource.id"))
+		require.NoError(t, err)
+
+		_, err = single.Filter(conditional, cursor)
+		require.Error(t, err, "cursor from a different pagination should be rejected")
+
+		nullCursor, err := p.Cursor(nil, "A001")
+		require.NoError(t, err)
+
+		_, err = p.Filter(conditional, nullCursor)
+		require.Error(t, err, "null values can't be compared")
+	})
+}
+
+func mustFilter(t *testing.T, s string) *enginev1.PlanResourcesFilter {
+	t.Helper()
+
+	filter := &enginev1.PlanResourcesFilter{}
+	require.NoError(t, protojson.Unmarshal([]byte(s), filter))
+	return filter
+}
@@ -9,8 +9,11 @@ import requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
 type RequestOpt func(*reqOpt)
 
 type reqOpt struct {
-	auxData     *requestv1.AuxData
-	includeMeta bool
+	auxData              *requestv1.AuxData
+	includeMeta          bool
+	expandConditionals   bool
+	includeRuleResiduals bool
+	simplifyFilters      bool
 }
 
 // AuxDataJWT sets the JWT to be used as auxiliary data for the request.
@@ -35,3 +38,28 @@ func IncludeMeta(f bool) RequestOpt {
 		opt.includeMeta = f
 	}
 }
+
+// ExpandConditionals sets the flag on plan requests to signal that conditional (ternary) expressions in the filter
+// should be rewritten into equivalent logical operations.
+func ExpandConditionals(f bool) RequestOpt {
+	return func(opt *reqOpt) {
+		opt.expandConditionals = f
+	}
+}
+
+// IncludeRuleResiduals sets the flag on plan requests to signal that the residual expression of each policy rule that
+// contributed to the filter should be sent back with the response.
+func IncludeRuleResiduals(f bool) RequestOpt {
+	return func(opt *reqOpt) {
+		opt.includeRuleResiduals = f
+	}
+}
+
+// SimplifyFilters sets the flag on plan requests to signal that equality checks on the same attribute should be collapsed
+// into an `in` expression, redundant numeric bounds should be removed and variables should be the first operand of
+// comparisons with values in the filter.
+func SimplifyFilters(f bool) RequestOpt {
+	return func(opt *reqOpt) {
+		opt.simplifyFilters = f
+	}
+}
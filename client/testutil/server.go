@@ -214,7 +214,7 @@ func startServer(conf *config.Wrapper) (*ServerInfo, error) {
 
 	g, _ := errgroup.WithContext(ctx)
 	g.Go(func() error {
-		return sb.server.Start(ctx, server.Param{Store: sb.store, Engine: sb.engine, AuditLog: sb.auditLog, AuxData: sb.auxData})
+		return sb.server.Start(ctx, server.Param{Store: sb.store, PolicyLoader: sb.policyLoader, Engine: sb.engine, AuditLog: sb.auditLog, AuxData: sb.auxData})
 	})
 	runtime.Gosched()
 
@@ -19,10 +19,13 @@ import (
 	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	compileerrors "github.com/cerbos/cerbos/cmd/cerbos/compile/errors"
 	internalcompile "github.com/cerbos/cerbos/cmd/cerbos/compile/internal/compilation"
+	"github.com/cerbos/cerbos/cmd/cerbos/compile/internal/deprecation"
 	"github.com/cerbos/cerbos/cmd/cerbos/compile/internal/flagset"
+	"github.com/cerbos/cerbos/cmd/cerbos/compile/internal/impact"
 	"github.com/cerbos/cerbos/cmd/cerbos/compile/internal/lint"
 	"github.com/cerbos/cerbos/cmd/cerbos/compile/internal/verification"
 	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/config"
 	"github.com/cerbos/cerbos/internal/engine"
 	"github.com/cerbos/cerbos/internal/outputcolor"
 	"github.com/cerbos/cerbos/internal/printer"
@@ -48,15 +51,31 @@ cerbos compile --run=Delete /path/to/policy/repo
 # Compile but skip tests
 
 cerbos compile --skip-tests /path/to/policy/repo
+
+# Compile and only run tests affected by the changes made since the main branch
+
+cerbos compile --changed-only --against=main /path/to/policy/repo
+
+# Compile and enforce the compile limits defined in the server configuration
+
+cerbos compile --config=/path/to/.cerbos.yaml /path/to/policy/repo
+
+# Compile and report the usages of deprecated policy features
+
+cerbos compile --deprecations /path/to/policy/repo
 `
 )
 
 type Cmd struct { //nolint:govet // Kong prints fields in order, so we don't want to reorder fields to save bytes.
 	Dir           string                            `help:"Policy directory" arg:"" required:"" type:"path"`
 	IgnoreSchemas bool                              `help:"Ignore schemas during compilation"`
+	Config        string                            `help:"Path to a Cerbos configuration file. The compile limits defined in it are enforced" type:"existingfile"`
 	Tests         string                            `help:"Path to the directory containing tests. Defaults to policy directory." type:"path"`
 	RunRegex      string                            `help:"Run only tests that match this regex" name:"run"`
 	SkipTests     bool                              `help:"Skip tests"`
+	Deprecations  bool                              `help:"Report the usages of deprecated policy features"`
+	ChangedOnly   bool                              `help:"Only run tests affected by the policies and tests changed since the git revision given by --against"`
+	Against       string                            `help:"Git revision to compare against when --changed-only is set" default:"HEAD"`
 	Output        flagset.OutputFormat              `help:"Output format (${enum})" default:"tree" enum:"tree,list,json" short:"o"`
 	TestOutput    *flagset.VerificationOutputFormat `help:"Test output format. If unspecified matches the value of the output flag. (tree,list,json,junit)"`
 	Color         *outputcolor.Level                `help:"Output color level (auto,never,always,256,16m). Defaults to auto." xor:"color"`
@@ -80,6 +99,17 @@ func (c *Cmd) Run(k *kong.Kong) error {
 
 	p := printer.New(k.Stdout, k.Stderr)
 
+	if c.Config != "" {
+		if err := config.Load(c.Config, nil); err != nil {
+			return fmt.Errorf("failed to load config file %q: %w", c.Config, err)
+		}
+	}
+
+	compileConf, err := compile.GetConf()
+	if err != nil {
+		return fmt.Errorf("failed to read compile configuration: %w", err)
+	}
+
 	fsys, err := util.OpenDirectoryFS(c.Dir)
 	if err != nil {
 		return err
@@ -103,7 +133,7 @@ func (c *Cmd) Run(k *kong.Kong) error {
 	}
 	schemaMgr := internalschema.NewFromConf(ctx, store, internalschema.NewConf(enforcement))
 
-	if err := compile.BatchCompile(idx.GetAllCompilationUnits(ctx), schemaMgr); err != nil {
+	if err := compile.BatchCompile(idx.GetAllCompilationUnits(ctx), schemaMgr, compile.WithContext(ctx), compile.WithLimits(compileConf.Limits)); err != nil {
 		compErr := new(compile.ErrorList)
 		if errors.As(err, &compErr) {
 			return internalcompile.Display(p, *compErr, c.Output, colorLevel)
@@ -112,6 +142,17 @@ func (c *Cmd) Run(k *kong.Kong) error {
 		return fmt.Errorf("failed to create engine: %w", err)
 	}
 
+	if c.Deprecations {
+		deprecations, err := compile.FindStoreDeprecations(ctx, store)
+		if err != nil {
+			return fmt.Errorf("failed to find deprecations: %w", err)
+		}
+
+		if err := deprecation.Display(p, deprecations, c.Output, colorLevel); err != nil {
+			return fmt.Errorf("failed to display deprecations: %w", err)
+		}
+	}
+
 	if c.TestOutput == nil {
 		var value flagset.VerificationOutputFormat
 		switch c.Output {
@@ -131,7 +172,15 @@ func (c *Cmd) Run(k *kong.Kong) error {
 			Trace: c.Verbose,
 		}
 
-		compiler := compile.NewManagerFromDefaultConf(ctx, store, schemaMgr)
+		if c.ChangedOnly {
+			analysis, err := impact.Analyse(c.Dir, c.testsDirPath(), c.Against, idx)
+			if err != nil {
+				return fmt.Errorf("failed to determine the tests affected by changes: %w", err)
+			}
+			verifyConf.ShouldRunSuite = analysis.ShouldRunSuite
+		}
+
+		compiler := compile.NewManagerFromConf(ctx, compileConf, store, schemaMgr)
 		eng, err := engine.NewEphemeral(compiler, schemaMgr)
 		if err != nil {
 			return fmt.Errorf("failed to create engine: %w", err)
@@ -162,11 +211,15 @@ func (c *Cmd) Run(k *kong.Kong) error {
 }
 
 func (c *Cmd) testsDir() (fs.FS, error) {
+	return util.OpenDirectoryFS(c.testsDirPath())
+}
+
+func (c *Cmd) testsDirPath() string {
 	if c.Tests == "" {
-		return util.OpenDirectoryFS(c.Dir)
+		return c.Dir
 	}
 
-	return util.OpenDirectoryFS(c.Tests)
+	return c.Tests
 }
 
 func (c *Cmd) Help() string {
@@ -0,0 +1,106 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package compile
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/kong"
+	"github.com/stretchr/testify/require"
+
+	compileerrors "github.com/cerbos/cerbos/cmd/cerbos/compile/errors"
+)
+
+const (
+	policyYAML = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: leave_request
+  rules:
+    - actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["employee"]
+    - actions: ["approve"]
+      effect: EFFECT_ALLOW
+      roles: ["manager"]
+    - actions: ["delete"]
+      effect: EFFECT_ALLOW
+      roles: ["admin"]
+`
+
+	confYAML = `---
+compile:
+  limits:
+    maxRulesPerPolicy: 2
+`
+)
+
+func TestCompileLimits(t *testing.T) {
+	policyDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(policyDir, "leave_request.yaml"), []byte(policyYAML), 0o600))
+
+	confFile := filepath.Join(t.TempDir(), "conf.yaml")
+	require.NoError(t, os.WriteFile(confFile, []byte(confYAML), 0o600))
+
+	run := func(t *testing.T, args ...string) (string, error) {
+		t.Helper()
+
+		cmd := &Cmd{}
+		out := new(bytes.Buffer)
+		p, err := kong.New(cmd, kong.Writers(out, out))
+		require.NoError(t, err)
+
+		_, err = p.Parse(append(args, "--skip-tests", "--no-color", "--output=list", policyDir))
+		require.NoError(t, err)
+
+		err = cmd.Run(p)
+		return out.String(), err
+	}
+
+	// The config file is loaded into the global configuration, so the case without it must run first.
+	t.Run("without_config", func(t *testing.T) {
+		_, err := run(t)
+		require.NoError(t, err)
+	})
+
+	t.Run("with_config", func(t *testing.T) {
+		out, err := run(t, "--config", confFile)
+		require.ErrorIs(t, err, compileerrors.ErrFailed)
+		require.Contains(t, out, "leave_request.yaml")
+		require.Contains(t, out, "exceeds the maximum of 2 rules per policy")
+	})
+}
+
+func TestCompileDeprecations(t *testing.T) {
+	policyDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(policyDir, "leave_request.yaml"), []byte(`---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: leave_request
+  rules:
+    - name: view
+      actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["employee"]
+      condition:
+        match:
+          expr: has_intersection(P.attr.teams, R.attr.teams)
+`), 0o600))
+
+	cmd := &Cmd{}
+	out := new(bytes.Buffer)
+	p, err := kong.New(cmd, kong.Writers(out, out))
+	require.NoError(t, err)
+
+	_, err = p.Parse([]string{"--deprecations", "--skip-tests", "--no-color", "--output=list", policyDir})
+	require.NoError(t, err)
+
+	require.NoError(t, cmd.Run(p))
+	require.Contains(t, out.String(), "leave_request.yaml: Function `has_intersection` in resource rule 'view' (#1) is deprecated. Use `hasIntersection` instead.")
+}
@@ -0,0 +1,43 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package deprecation
+
+import (
+	"github.com/cerbos/cerbos/cmd/cerbos/compile/internal/flagset"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/outputcolor"
+	"github.com/cerbos/cerbos/internal/printer"
+	"github.com/cerbos/cerbos/internal/printer/colored"
+)
+
+func Display(p *printer.Printer, deprecations []compile.Deprecation, output flagset.OutputFormat, colorLevel outputcolor.Level) error {
+	switch output {
+	case flagset.OutputFormatJSON:
+		return displayJSON(p, deprecations, colorLevel)
+	case flagset.OutputFormatList, flagset.OutputFormatTree:
+		displayList(p, deprecations)
+	}
+
+	return nil
+}
+
+func displayJSON(p *printer.Printer, deprecations []compile.Deprecation, colorLevel outputcolor.Level) error {
+	if deprecations == nil {
+		deprecations = []compile.Deprecation{}
+	}
+
+	return p.PrintJSON(map[string][]compile.Deprecation{"deprecations": deprecations}, colorLevel)
+}
+
+func displayList(p *printer.Printer, deprecations []compile.Deprecation) {
+	if len(deprecations) == 0 {
+		return
+	}
+
+	p.Println(colored.Header("Deprecations"))
+	for _, d := range deprecations {
+		p.Printf("%s: %s in %s is deprecated. Use %s instead.\n", colored.FileName(d.File), colored.WarningMsg(d.Feature), d.Location, d.Replacement)
+	}
+	p.Println()
+}
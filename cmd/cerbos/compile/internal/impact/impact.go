@@ -0,0 +1,324 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package impact determines the test suites affected by the changes made to a policy repository since a git revision.
+package impact
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/storage/index"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+// Analysis records the changes made since a git revision.
+type Analysis struct {
+	affectedModules    map[namer.ModuleID]struct{}
+	changedTestFiles   map[string]struct{}
+	changedFixtureDirs map[string]struct{}
+	runAll             bool
+}
+
+// Analyse compares the policy and test directories with their contents at the given git revision.
+// A test suite is affected if its file or its fixtures have changed or if it exercises a policy that has changed
+// or that depends on a changed policy (such as by importing derived roles or variables).
+func Analyse(policyDir, testsDir, rev string, idx index.Index) (*Analysis, error) {
+	repo, err := git.PlainOpenWithOptions(policyDir, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open git repository containing %s: %w", policyDir, err)
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get git worktree: %w", err)
+	}
+
+	hash, err := repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve git revision %q: %w", rev, err)
+	}
+
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit %s: %w", hash, err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tree of commit %s: %w", hash, err)
+	}
+
+	root := wt.Filesystem.Root()
+	a := &Analysis{
+		affectedModules:    make(map[namer.ModuleID]struct{}),
+		changedTestFiles:   make(map[string]struct{}),
+		changedFixtureDirs: make(map[string]struct{}),
+	}
+
+	policyChanges, err := changedFiles(root, policyDir, tree)
+	if err != nil {
+		return nil, err
+	}
+
+	var changedModules []namer.ModuleID
+	for file, change := range policyChanges {
+		switch util.FileType(file) {
+		case util.FileTypePolicy:
+			changedModules = append(changedModules, change.moduleIDs()...)
+		case util.FileTypeSchema:
+			// schemas can be referenced by any policy
+			a.runAll = true
+		default:
+		}
+	}
+
+	dependents, err := idx.GetDependents(changedModules...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dependents of changed policies: %w", err)
+	}
+
+	for _, modID := range changedModules {
+		a.affectedModules[modID] = struct{}{}
+		for _, dep := range dependents[modID] {
+			a.affectedModules[dep] = struct{}{}
+		}
+	}
+
+	testChanges := policyChanges
+	if testsDir != policyDir {
+		if testChanges, err = changedFiles(root, testsDir, tree); err != nil {
+			return nil, err
+		}
+	}
+
+	for file := range testChanges {
+		if dir, ok := fixtureDir(file); ok {
+			a.changedFixtureDirs[dir] = struct{}{}
+		} else if util.IsSupportedTestFile(file) {
+			a.changedTestFiles[file] = struct{}{}
+		}
+	}
+
+	return a, nil
+}
+
+// ShouldRunSuite returns true if the test suite is affected by the changes.
+// The file path must be "/"-separated and relative to the tests directory.
+func (a *Analysis) ShouldRunSuite(file string, tests []*policyv1.Test) bool {
+	if a.runAll {
+		return true
+	}
+
+	if _, ok := a.changedTestFiles[file]; ok {
+		return true
+	}
+
+	if _, ok := a.changedFixtureDirs[path.Join(path.Dir(file), util.TestDataDirectory)]; ok {
+		return true
+	}
+
+	for _, test := range tests {
+		if a.isAffected(test.Input) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *Analysis) isAffected(input *enginev1.CheckInput) bool {
+	var candidates []namer.ModuleID
+	if r := input.GetResource(); r != nil {
+		candidates = append(candidates, namer.ScopedResourcePolicyModuleIDs(r.Kind, policyVersion(r.PolicyVersion), r.Scope, true)...)
+	}
+
+	if p := input.GetPrincipal(); p != nil {
+		candidates = append(candidates, namer.ScopedPrincipalPolicyModuleIDs(p.Id, policyVersion(p.PolicyVersion), p.Scope, true)...)
+	}
+
+	for _, modID := range candidates {
+		if _, ok := a.affectedModules[modID]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyVersion(v string) string {
+	if v == "" {
+		return namer.DefaultVersion
+	}
+
+	return v
+}
+
+// fixtureDir returns the path of the fixtures directory containing the file, if any.
+func fixtureDir(file string) (string, bool) {
+	segments := strings.Split(file, "/")
+	for i, segment := range segments[:len(segments)-1] {
+		if segment == util.TestDataDirectory {
+			return path.Join(segments[:i+1]...), true
+		}
+	}
+
+	return "", false
+}
+
+type fileChange struct {
+	oldContents []byte
+	newContents []byte
+}
+
+// moduleIDs returns the IDs of the policies defined by the file before and after the change.
+func (fc fileChange) moduleIDs() []namer.ModuleID {
+	var ids []namer.ModuleID
+	for _, contents := range [][]byte{fc.oldContents, fc.newContents} {
+		if contents == nil {
+			continue
+		}
+
+		// invalid policies are reported by the compiler
+		if p, err := policy.ReadPolicy(bytes.NewReader(contents)); err == nil {
+			ids = append(ids, namer.GenModuleID(p))
+		}
+	}
+
+	return ids
+}
+
+// changedFiles returns the files in dir that have been added, modified or deleted since the commit that produced the tree.
+// The keys of the map are "/"-separated paths relative to dir.
+func changedFiles(root, dir string, tree *object.Tree) (map[string]fileChange, error) {
+	prefix, err := relativeToRoot(root, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	oldFiles := make(map[string]*object.File)
+	subtree := tree
+	if prefix != "." {
+		if subtree, err = tree.Tree(prefix); err != nil {
+			if !errors.Is(err, object.ErrDirectoryNotFound) {
+				return nil, fmt.Errorf("failed to read %s from git: %w", prefix, err)
+			}
+			subtree = nil
+		}
+	}
+
+	if subtree != nil {
+		if err := subtree.Files().ForEach(func(f *object.File) error {
+			oldFiles[f.Name] = f
+			return nil
+		}); err != nil {
+			return nil, fmt.Errorf("failed to list files in git: %w", err)
+		}
+	}
+
+	changes := make(map[string]fileChange)
+	err = filepath.WalkDir(dir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if d.Name() == git.GitDirName {
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, filePath)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		contents, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+
+		oldFile, existed := oldFiles[rel]
+		delete(oldFiles, rel)
+		if !existed {
+			changes[rel] = fileChange{newContents: contents}
+			return nil
+		}
+
+		if oldFile.Hash == plumbing.ComputeHash(plumbing.BlobObject, contents) {
+			return nil
+		}
+
+		oldContents, err := readBlob(oldFile)
+		if err != nil {
+			return err
+		}
+
+		changes[rel] = fileChange{oldContents: oldContents, newContents: contents}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk %s: %w", dir, err)
+	}
+
+	for rel, oldFile := range oldFiles {
+		oldContents, err := readBlob(oldFile)
+		if err != nil {
+			return nil, err
+		}
+
+		changes[rel] = fileChange{oldContents: oldContents}
+	}
+
+	return changes, nil
+}
+
+func relativeToRoot(root, dir string) (string, error) {
+	resolvedRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", root, err)
+	}
+
+	resolvedDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", dir, err)
+	}
+
+	rel, err := filepath.Rel(resolvedRoot, resolvedDir)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s is not inside the git repository at %s", dir, root)
+	}
+
+	return filepath.ToSlash(rel), nil
+}
+
+func readBlob(f *object.File) ([]byte, error) {
+	r, err := f.Reader()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from git: %w", f.Name, err)
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
@@ -0,0 +1,249 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package impact_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/cmd/cerbos/compile/internal/impact"
+	"github.com/cerbos/cerbos/internal/storage/index"
+)
+
+const (
+	commonRoles = `---
+apiVersion: api.cerbos.dev/v1
+derivedRoles:
+  name: common_roles
+  definitions:
+    - name: owner
+      parentRoles: ["user"]
+      condition:
+        match:
+          expr: R.attr.owner == P.id
+`
+
+	leaveRequestPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: leave_request
+  version: default
+  importDerivedRoles: ["common_roles"]
+  rules:
+    - actions: ["view"]
+      effect: EFFECT_ALLOW
+      derivedRoles: ["owner"]
+`
+
+	expensePolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: expense
+  version: default
+  rules:
+    - actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["user"]
+`
+
+	expenseAcmePolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: expense
+  version: default
+  scope: acme
+  rules:
+    - actions: ["approve"]
+      effect: EFFECT_ALLOW
+      roles: ["manager"]
+`
+
+	leaveRequestSuite = "tests/leave_request_test.yaml"
+	expenseSuite      = "tests/expense_test.yaml"
+)
+
+func TestAnalyse(t *testing.T) {
+	leaveRequest := mkTest("leave_request", "")
+	expense := mkTest("expense", "")
+	expenseAcme := mkTest("expense", "acme")
+
+	testCases := []struct {
+		name    string
+		change  func(*testing.T, string)
+		suite   string
+		test    *policyv1.Test
+		wantRun bool
+	}{
+		{
+			name:   "no_changes",
+			change: func(*testing.T, string) {},
+			suite:  leaveRequestSuite,
+			test:   leaveRequest,
+		},
+		{
+			name:    "changed_derived_roles_affect_importing_policies",
+			change:  writeFile("derived_roles/common.yaml", strings.Replace(commonRoles, "P.id", "P.attr.id", 1)),
+			suite:   leaveRequestSuite,
+			test:    leaveRequest,
+			wantRun: true,
+		},
+		{
+			name:   "changed_derived_roles_do_not_affect_other_policies",
+			change: writeFile("derived_roles/common.yaml", strings.Replace(commonRoles, "P.id", "P.attr.id", 1)),
+			suite:  expenseSuite,
+			test:   expense,
+		},
+		{
+			name:    "changed_parent_scope_affects_child_scopes",
+			change:  writeFile("resource_policies/expense.yaml", strings.Replace(expensePolicy, `"view"`, `"view", "edit"`, 1)),
+			suite:   expenseSuite,
+			test:    expenseAcme,
+			wantRun: true,
+		},
+		{
+			name:   "changed_child_scope_does_not_affect_parent_scope",
+			change: writeFile("resource_policies/expense_acme.yaml", strings.Replace(expenseAcmePolicy, `"approve"`, `"approve", "reject"`, 1)),
+			suite:  expenseSuite,
+			test:   expense,
+		},
+		{
+			name:    "deleted_policy",
+			change:  deleteFile("resource_policies/expense_acme.yaml"),
+			suite:   expenseSuite,
+			test:    expenseAcme,
+			wantRun: true,
+		},
+		{
+			name:    "renamed_resource_affects_old_resource",
+			change:  writeFile("resource_policies/leave_request.yaml", strings.Replace(leaveRequestPolicy, "resource: leave_request", "resource: holiday_request", 1)),
+			suite:   leaveRequestSuite,
+			test:    leaveRequest,
+			wantRun: true,
+		},
+		{
+			name:    "changed_test_suite",
+			change:  writeFile(expenseSuite, "---\nname: ExpenseTestSuite\n# changed\n"),
+			suite:   expenseSuite,
+			test:    expense,
+			wantRun: true,
+		},
+		{
+			name:   "changed_other_test_suite",
+			change: writeFile(expenseSuite, "---\nname: ExpenseTestSuite\n# changed\n"),
+			suite:  leaveRequestSuite,
+			test:   leaveRequest,
+		},
+		{
+			name:    "changed_fixtures",
+			change:  writeFile("tests/testdata/principals.yaml", "---\nprincipals: {}\n# changed\n"),
+			suite:   leaveRequestSuite,
+			test:    leaveRequest,
+			wantRun: true,
+		},
+		{
+			name:    "changed_schema",
+			change:  writeFile("_schemas/principal.json", `{"type": "object", "properties": {}}`),
+			suite:   leaveRequestSuite,
+			test:    leaveRequest,
+			wantRun: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			dir := mkRepo(t)
+			tc.change(t, dir)
+
+			idx, err := index.Build(context.Background(), os.DirFS(dir))
+			require.NoError(t, err)
+
+			analysis, err := impact.Analyse(dir, dir, "HEAD", idx)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantRun, analysis.ShouldRunSuite(tc.suite, []*policyv1.Test{tc.test}))
+		})
+	}
+
+	t.Run("invalid_revision", func(t *testing.T) {
+		dir := mkRepo(t)
+
+		idx, err := index.Build(context.Background(), os.DirFS(dir))
+		require.NoError(t, err)
+
+		_, err = impact.Analyse(dir, dir, "no-such-branch", idx)
+		require.Error(t, err)
+	})
+}
+
+func mkTest(kind, scope string) *policyv1.Test {
+	return &policyv1.Test{
+		Input: &enginev1.CheckInput{
+			Principal: &enginev1.Principal{Id: "john", Roles: []string{"user"}},
+			Resource:  &enginev1.Resource{Kind: kind, Id: "XX125", Scope: scope},
+			Actions:   []string{"view"},
+		},
+	}
+}
+
+func mkRepo(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"derived_roles/common.yaml":            commonRoles,
+		"resource_policies/leave_request.yaml": leaveRequestPolicy,
+		"resource_policies/expense.yaml":       expensePolicy,
+		"resource_policies/expense_acme.yaml":  expenseAcmePolicy,
+		leaveRequestSuite:                      "---\nname: LeaveRequestTestSuite\n",
+		expenseSuite:                           "---\nname: ExpenseTestSuite\n",
+		"tests/testdata/principals.yaml":       "---\nprincipals: {}\n",
+	}
+
+	for file, contents := range files {
+		writeFile(file, contents)(t, dir)
+	}
+
+	repo, err := git.PlainInit(dir, false)
+	require.NoError(t, err)
+
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+
+	require.NoError(t, wt.AddGlob("."))
+
+	_, err = wt.Commit("Initial commit", &git.CommitOptions{
+		Author: &object.Signature{Name: "Daffy Duck", Email: "daffy@mallard.dev", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	return dir
+}
+
+func writeFile(file, contents string) func(*testing.T, string) {
+	return func(t *testing.T, dir string) {
+		t.Helper()
+
+		path := filepath.Join(dir, filepath.FromSlash(file))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o744))
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	}
+}
+
+func deleteFile(file string) func(*testing.T, string) {
+	return func(t *testing.T, dir string) {
+		t.Helper()
+
+		require.NoError(t, os.Remove(filepath.Join(dir, filepath.FromSlash(file))))
+	}
+}
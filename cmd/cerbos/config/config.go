@@ -0,0 +1,166 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/audit/file"
+	"github.com/cerbos/cerbos/internal/audit/kafka"
+	"github.com/cerbos/cerbos/internal/audit/local"
+	"github.com/cerbos/cerbos/internal/auxdata"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/observability/tracing"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/server"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/blob"
+	"github.com/cerbos/cerbos/internal/storage/bundle"
+	"github.com/cerbos/cerbos/internal/storage/db/cockroachdb"
+	"github.com/cerbos/cerbos/internal/storage/db/consul"
+	"github.com/cerbos/cerbos/internal/storage/db/etcd"
+	"github.com/cerbos/cerbos/internal/storage/db/memory"
+	"github.com/cerbos/cerbos/internal/storage/db/mongodb"
+	"github.com/cerbos/cerbos/internal/storage/db/mysql"
+	"github.com/cerbos/cerbos/internal/storage/db/nats"
+	"github.com/cerbos/cerbos/internal/storage/db/postgres"
+	"github.com/cerbos/cerbos/internal/storage/db/sqlite3"
+	"github.com/cerbos/cerbos/internal/storage/db/sqlserver"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/storage/federated"
+	"github.com/cerbos/cerbos/internal/storage/git"
+	storagekafka "github.com/cerbos/cerbos/internal/storage/kafka"
+	"github.com/cerbos/cerbos/internal/storage/overlay"
+	"github.com/cerbos/cerbos/internal/telemetry"
+	"github.com/cerbos/cerbos/internal/tenancy"
+)
+
+const (
+	help = `
+Examples:
+
+# Print the JSON schema of the Cerbos configuration file
+
+cerbos config schema > cerbos-config.schema.json
+
+# Check a configuration file before deploying it
+
+cerbos config validate /path/to/.cerbos.yaml
+`
+	textOutput = "text"
+	jsonOutput = "json"
+)
+
+var errInvalidConfig = errors.New("configuration is invalid")
+
+type Cmd struct {
+	Schema   SchemaCmd   `cmd:"" help:"Print the JSON schema of the configuration file"`
+	Validate ValidateCmd `cmd:"" help:"Validate a configuration file"`
+}
+
+func (c *Cmd) Help() string {
+	return help
+}
+
+type SchemaCmd struct{}
+
+func (c *SchemaCmd) Run(k *kong.Kong) error {
+	enc := json.NewEncoder(k.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(config.Schema(sections()...)); err != nil {
+		return fmt.Errorf("failed to write schema: %w", err)
+	}
+
+	return nil
+}
+
+type ValidateCmd struct {
+	File   string `help:"Path to the configuration file" arg:"" required:"" type:"existingfile"`
+	Output string `help:"Output format (${enum})" default:"text" enum:"text,json" short:"o"`
+}
+
+func (c *ValidateCmd) Run(k *kong.Kong) error {
+	errs, err := config.ValidateFile(c.File, sections()...)
+	if err != nil {
+		return err
+	}
+
+	switch c.Output {
+	case jsonOutput:
+		type jsonError struct {
+			Path    string `json:"path,omitempty"`
+			Message string `json:"message"`
+		}
+
+		out := struct {
+			Errors []jsonError `json:"errors"`
+		}{Errors: make([]jsonError, len(errs))}
+		for i, e := range errs {
+			out.Errors[i] = jsonError{Path: e.Path, Message: e.Message}
+		}
+
+		enc := json.NewEncoder(k.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(out); err != nil {
+			return fmt.Errorf("failed to write output: %w", err)
+		}
+	case textOutput:
+		for _, e := range errs {
+			_, _ = fmt.Fprintln(k.Stdout, e.Error())
+		}
+
+		if len(errs) == 0 {
+			_, _ = fmt.Fprintf(k.Stdout, "%s is valid\n", c.File)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errInvalidConfig
+	}
+
+	return nil
+}
+
+// sections returns the configuration sections understood by the Cerbos server.
+func sections() []config.Section {
+	return []config.Section{
+		&audit.Conf{},
+		&file.Conf{},
+		&kafka.Conf{},
+		&local.Conf{},
+		&auxdata.Conf{},
+		&compile.Conf{},
+		&engine.Conf{},
+		&schema.Conf{},
+		&server.Conf{},
+		&storage.Conf{},
+		&blob.Conf{},
+		&bundle.Conf{},
+		&cockroachdb.Conf{},
+		&consul.Conf{},
+		&disk.Conf{},
+		&etcd.Conf{},
+		&federated.Conf{},
+		&git.Conf{},
+		&storagekafka.Conf{},
+		&memory.Conf{},
+		&mongodb.Conf{},
+		&mysql.Conf{},
+		&nats.Conf{},
+		&overlay.Conf{},
+		&postgres.Conf{},
+		&sqlite3.Conf{},
+		&sqlserver.Conf{},
+		&telemetry.Conf{},
+		&tenancy.Conf{},
+		&tracing.Conf{},
+	}
+}
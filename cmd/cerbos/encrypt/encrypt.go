@@ -0,0 +1,131 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package encrypt
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/cerbos/cerbos/internal/encryption"
+)
+
+const (
+	help = `
+Encrypts policy archives and policy files with AES-256-GCM so that they can be kept in shared storage.
+The disk and blob storage drivers decrypt them when the same key is configured in the encryption section.
+
+The key is a base64-encoded 256-bit value, which can be generated with 'openssl rand -base64 32'.
+
+Examples:
+
+# Encrypt a policy archive for the disk driver
+
+cerbos encrypt --key-file=key.txt --output-dir=encrypted policies.zip
+
+# Encrypt a policy directory before uploading it to a bucket for the blob driver
+
+CERBOS_ENCRYPTION_KEY=$(cat key.txt) cerbos encrypt --output-dir=encrypted policies
+`
+	dirPermissions  = 0o755
+	filePermissions = 0o644
+)
+
+type Cmd struct {
+	Key       string   `help:"Base64-encoded key to encrypt with" env:"CERBOS_ENCRYPTION_KEY" xor:"key" required:""`
+	KeyFile   string   `help:"Path to a file containing the base64-encoded key to encrypt with" type:"existingfile" xor:"key" required:""`
+	OutputDir string   `help:"Directory to write the encrypted files to" type:"path" required:""`
+	Paths     []string `help:"Files or directories to encrypt" arg:"" type:"path"`
+}
+
+func (c *Cmd) Help() string {
+	return help
+}
+
+func (c *Cmd) Run(k *kong.Kong) error {
+	cipher, err := encryption.NewCipherFromConf(&encryption.Conf{Key: c.Key, KeyFile: c.KeyFile})
+	if err != nil {
+		return err
+	}
+
+	for _, path := range c.Paths {
+		if err := c.encryptPath(k, cipher, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encryptPath encrypts a file, or all the files in a directory, keeping the directory layout in the output directory.
+func (c *Cmd) encryptPath(k *kong.Kong, cipher *encryption.Cipher, path string) error {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat %q: %w", path, err)
+	}
+
+	root := filepath.Clean(path)
+	if !stat.IsDir() {
+		root = filepath.Dir(root)
+	}
+
+	return filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, file)
+		if err != nil {
+			return fmt.Errorf("failed to determine the relative path of %q: %w", file, err)
+		}
+
+		out := filepath.Join(c.OutputDir, rel)
+		if err := encryptFile(cipher, file, out); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(k.Stdout, "Encrypted %s to %s\n", file, out)
+		return nil
+	})
+}
+
+func encryptFile(cipher *encryption.Cipher, in, out string) error {
+	data, err := os.ReadFile(in)
+	if err != nil {
+		return fmt.Errorf("failed to read %q: %w", in, err)
+	}
+
+	if encryption.IsEncrypted(data) {
+		return fmt.Errorf("%q is already encrypted", in)
+	}
+
+	encrypted, err := cipher.Encrypt(data)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt %q: %w", in, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(out), dirPermissions); err != nil {
+		return fmt.Errorf("failed to create directory for %q: %w", out, err)
+	}
+
+	if _, err := os.Stat(out); err == nil {
+		return fmt.Errorf("%q already exists", out)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to stat %q: %w", out, err)
+	}
+
+	if err := os.WriteFile(out, encrypted, filePermissions); err != nil {
+		return fmt.Errorf("failed to write %q: %w", out, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package initialize
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const (
+	help = `
+Creates a policy repository containing example policies, schemas and tests for the chosen use case,
+along with the configuration required to run a Cerbos server using the policies.
+
+Examples:
+
+# Create a policy repository for a multi-tenant SaaS application in the current directory
+
+cerbos init
+
+# Create a policy repository for an internal application in a new directory
+
+cerbos init --use-case=internal my-policies
+`
+	commonDir       = "common"
+	templatesDir    = "templates"
+	templateExt     = ".tmpl"
+	dirPermissions  = 0o755
+	filePermissions = 0o644
+	latestVersion   = "latest"
+)
+
+var (
+	//go:embed all:templates
+	templates embed.FS
+
+	errDirNotEmpty = errors.New("directory is not empty (use --force to write into it anyway)")
+
+	releaseVersionRegexp = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+
+	useCaseDescriptions = map[string]string{
+		"saas":     "The example policies control access to the projects of a multi-tenant SaaS application, where users can only access the resources of their own tenant.",
+		"internal": "The example policies control access to the documents of an internal application, where employees write documents that are approved by the managers of their department.",
+	}
+)
+
+type Cmd struct {
+	Dir     string `help:"Directory to create the policy repository in" arg:"" default:"." type:"path"`
+	UseCase string `help:"Use case of the example policies (${enum})" default:"saas" enum:"saas,internal"`
+	Force   bool   `help:"Write files even if the directory is not empty, overwriting existing files"`
+}
+
+func (c *Cmd) Help() string {
+	return help
+}
+
+func (c *Cmd) Run(k *kong.Kong) error {
+	if err := c.checkDir(); err != nil {
+		return err
+	}
+
+	data := struct {
+		UseCase     string
+		Description string
+		Version     string
+	}{
+		UseCase:     c.UseCase,
+		Description: useCaseDescriptions[c.UseCase],
+		Version:     imageVersion(),
+	}
+
+	for _, src := range []string{commonDir, c.UseCase} {
+		if err := c.scaffold(path.Join(templatesDir, src), data); err != nil {
+			return err
+		}
+	}
+
+	_, _ = fmt.Fprintf(k.Stdout, "Created a policy repository in %s\n", c.Dir)
+	_, _ = fmt.Fprintf(k.Stdout, "Run `cerbos compile %s` to test the policies\n", filepath.Join(c.Dir, "policies"))
+	return nil
+}
+
+func (c *Cmd) checkDir() error {
+	if c.Force {
+		return nil
+	}
+
+	entries, err := os.ReadDir(c.Dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to read directory %s: %w", c.Dir, err)
+	}
+
+	if len(entries) > 0 {
+		return fmt.Errorf("failed to initialize %s: %w", c.Dir, errDirNotEmpty)
+	}
+
+	return nil
+}
+
+// scaffold copies the files under the given template directory to the target directory.
+// Files with the template extension are rendered using the data and written without the extension.
+func (c *Cmd) scaffold(root string, data any) error {
+	return fs.WalkDir(templates, root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel := strings.TrimPrefix(strings.TrimPrefix(p, root), "/")
+		target := filepath.Join(c.Dir, filepath.FromSlash(rel))
+		if d.IsDir() {
+			if err := os.MkdirAll(target, dirPermissions); err != nil {
+				return fmt.Errorf("failed to create directory %s: %w", target, err)
+			}
+			return nil
+		}
+
+		contents, err := templates.ReadFile(p)
+		if err != nil {
+			return fmt.Errorf("failed to read template %s: %w", p, err)
+		}
+
+		if strings.HasSuffix(p, templateExt) {
+			target = strings.TrimSuffix(target, templateExt)
+			if contents, err = render(p, contents, data); err != nil {
+				return err
+			}
+		}
+
+		if err := os.WriteFile(target, contents, filePermissions); err != nil {
+			return fmt.Errorf("failed to write %s: %w", target, err)
+		}
+
+		return nil
+	})
+}
+
+func render(name string, contents []byte, data any) ([]byte, error) {
+	tmpl, err := template.New(name).Parse(string(contents))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %w", name, err)
+	}
+
+	out := new(bytes.Buffer)
+	if err := tmpl.Execute(out, data); err != nil {
+		return nil, fmt.Errorf("failed to render template %s: %w", name, err)
+	}
+
+	return out.Bytes(), nil
+}
+
+// imageVersion returns the tag of the container image matching this binary.
+// Development builds don't have a published image so they use the latest release.
+func imageVersion() string {
+	v := strings.TrimPrefix(util.Version, "v")
+	if releaseVersionRegexp.MatchString(v) {
+		return v
+	}
+
+	return latestVersion
+}
@@ -0,0 +1,60 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package initialize
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/kong"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/cmd/cerbos/compile"
+)
+
+func TestInit(t *testing.T) {
+	for _, useCase := range []string{"saas", "internal"} {
+		useCase := useCase
+		t.Run(useCase, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "policy-repo")
+
+			out, err := run(t, &Cmd{}, "--use-case", useCase, dir)
+			require.NoError(t, err)
+			require.Contains(t, out, "Created a policy repository")
+
+			for _, f := range []string{".cerbos.yaml", "docker-compose.yaml", "README.md"} {
+				require.FileExists(t, filepath.Join(dir, f))
+			}
+
+			compose, err := os.ReadFile(filepath.Join(dir, "docker-compose.yaml"))
+			require.NoError(t, err)
+			require.Contains(t, string(compose), "ghcr.io/cerbos/cerbos:latest")
+
+			out, err = run(t, &compile.Cmd{}, "--no-color", "--output=list", filepath.Join(dir, "policies"))
+			require.NoError(t, err, "generated policies should compile and pass their tests: %s", out)
+
+			_, err = run(t, &Cmd{}, "--use-case", useCase, dir)
+			require.ErrorIs(t, err, errDirNotEmpty)
+
+			_, err = run(t, &Cmd{}, "--use-case", useCase, "--force", dir)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func run(t *testing.T, cmd interface{ Run(*kong.Kong) error }, args ...string) (string, error) {
+	t.Helper()
+
+	out := new(bytes.Buffer)
+	p, err := kong.New(cmd, kong.Writers(out, out))
+	require.NoError(t, err)
+
+	_, err = p.Parse(args)
+	require.NoError(t, err)
+
+	err = cmd.Run(p)
+	return out.String(), err
+}
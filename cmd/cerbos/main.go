@@ -10,10 +10,15 @@ import (
 
 	"github.com/cerbos/cerbos/cmd/cerbos/compile"
 	compileerr "github.com/cerbos/cerbos/cmd/cerbos/compile/errors"
+	"github.com/cerbos/cerbos/cmd/cerbos/config"
+	"github.com/cerbos/cerbos/cmd/cerbos/encrypt"
 	"github.com/cerbos/cerbos/cmd/cerbos/healthcheck"
+	"github.com/cerbos/cerbos/cmd/cerbos/initialize"
+	"github.com/cerbos/cerbos/cmd/cerbos/migrate"
 	"github.com/cerbos/cerbos/cmd/cerbos/repl"
 	"github.com/cerbos/cerbos/cmd/cerbos/run"
 	"github.com/cerbos/cerbos/cmd/cerbos/server"
+	"github.com/cerbos/cerbos/cmd/cerbos/sign"
 	"github.com/cerbos/cerbos/internal/outputcolor"
 	"github.com/cerbos/cerbos/internal/util"
 )
@@ -31,6 +36,11 @@ func main() {
 		Healthcheck healthcheck.Cmd `cmd:"" help:"Healthcheck utility" aliases:"hc"`
 		Run         run.Cmd         `cmd:"" help:"Run a command in the context of a Cerbos PDP"`
 		Repl        repl.Cmd        `cmd:"" help:"Start a REPL to try out conditions"`
+		Config      config.Cmd      `cmd:"" help:"Inspect and validate Cerbos configuration files"`
+		Init        initialize.Cmd  `cmd:"" help:"Create a policy repository with example policies, tests and configuration"`
+		Sign        sign.Cmd        `cmd:"" help:"Sign policy bundles and archives"`
+		Encrypt     encrypt.Cmd     `cmd:"" help:"Encrypt policy archives and files"`
+		Migrate     migrate.Cmd     `cmd:"" help:"Create or upgrade the database schema of the SQL storage drivers"`
 		Version     kong.VersionFlag
 	}
 
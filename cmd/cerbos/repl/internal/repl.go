@@ -450,6 +450,12 @@ func (r *REPL) loadPolicy(path string) error {
 				ph.rules = append(ph.rules, rule)
 			}
 		}
+
+		for _, def := range pt.ResourcePolicy.LocalDerivedRoles {
+			if def.Condition != nil {
+				ph.rules = append(ph.rules, def)
+			}
+		}
 	case *policyv1.Policy_DerivedRoles:
 		ph.variables, err = r.mergeVariableDefinitions(ph.key, pt.DerivedRoles.Variables, p.Variables) //nolint:staticcheck
 		if err != nil {
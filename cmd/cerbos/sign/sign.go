@@ -0,0 +1,81 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package sign
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/cerbos/cerbos/internal/signature"
+)
+
+const (
+	help = `
+Signs policy bundles and archives so that the disk, blob and bundle storage drivers can verify them before loading.
+The signature of each file is written next to it in a file with the same name and the .sig extension.
+
+Signatures are compatible with cosign key pairs: keys created by 'cosign generate-key-pair' can be used to sign
+and the signatures can be checked with 'cosign verify-blob'. The password of an encrypted key is read from the
+COSIGN_PASSWORD environment variable.
+
+Examples:
+
+# Sign a policy archive
+
+cerbos sign --key=cosign.key policies.zip
+
+# Sign a bundle before pushing it to a registry
+
+cerbos sign --key=cosign.key bundle.crbp
+`
+	passwordEnvVar  = "COSIGN_PASSWORD" //nolint:gosec
+	filePermissions = 0o644
+)
+
+type Cmd struct {
+	Key   string   `help:"Path to the PEM-encoded private key to sign with" required:"" type:"existingfile"`
+	Files []string `help:"Files to sign" arg:"" type:"existingfile"`
+}
+
+func (c *Cmd) Help() string {
+	return help
+}
+
+func (c *Cmd) Run(k *kong.Kong) error {
+	signer, err := signature.LoadSigner(c.Key, []byte(os.Getenv(passwordEnvVar)))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range c.Files {
+		if err := c.sign(signer, file); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(k.Stdout, "Wrote signature of %s to %s\n", file, file+signature.Extension)
+	}
+
+	return nil
+}
+
+func (c *Cmd) sign(signer *signature.Signer, file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return fmt.Errorf("failed to open %q: %w", file, err)
+	}
+	defer f.Close()
+
+	sig, err := signer.Sign(f)
+	if err != nil {
+		return fmt.Errorf("failed to sign %q: %w", file, err)
+	}
+
+	if err := os.WriteFile(file+signature.Extension, sig, filePermissions); err != nil {
+		return fmt.Errorf("failed to write signature of %q: %w", file, err)
+	}
+
+	return nil
+}
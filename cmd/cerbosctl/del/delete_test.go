@@ -59,6 +59,10 @@ func testDeleteCmd(ctx context.Context, cctx *cmdclient.Context, globals *flagse
 					args    []string
 					wantErr bool
 				}{
+					{
+						[]string{"policies", "policy", "p"},
+						false,
+					},
 					{
 						[]string{"schemas", "schema", "s"},
 						false,
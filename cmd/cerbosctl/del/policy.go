@@ -0,0 +1,46 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package del
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/cerbos/cerbos/client"
+	internalclient "github.com/cerbos/cerbos/cmd/cerbosctl/internal/client"
+)
+
+const policyCmdHelp = `# Delete policies (mutable stores). Deleted policies can be restored with cerbosctl restore policies
+cerbosctl delete policies derived_roles.my_derived_roles
+cerbosctl delete policy derived_roles.my_derived_roles
+cerbosctl delete p derived_roles.my_derived_roles
+
+# Delete multiple policies
+cerbosctl delete policies derived_roles.my_derived_roles resource.leave_request.default
+cerbosctl delete policy derived_roles.my_derived_roles resource.leave_request.default
+cerbosctl delete p derived_roles.my_derived_roles resource.leave_request.default`
+
+type PolicyCmd struct {
+	PolicyIds []string `arg:"" name:"id" help:"list of policy ids to delete"`
+}
+
+func (pc *PolicyCmd) Run(k *kong.Kong, ctx *internalclient.Context) error {
+	if len(pc.PolicyIds) == 0 {
+		return fmt.Errorf("no policy id(s) provided")
+	}
+
+	deletedPolicies, err := client.BatchAdminClientCall(context.Background(), ctx.AdminClient.DeletePolicy, pc.PolicyIds...)
+	if err != nil {
+		return fmt.Errorf("failed to delete policies: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(k.Stdout, "Number of policies deleted is %d", deletedPolicies)
+	return nil
+}
+
+func (pc *PolicyCmd) Help() string {
+	return policyCmdHelp
+}
@@ -26,12 +26,12 @@ type SchemaCmd struct {
 	SchemaIds []string `arg:"" name:"id" help:"list of schema ids to delete"`
 }
 
-func (c *Cmd) Run(k *kong.Kong, ctx *client.Context) error {
-	if len(c.Schema.SchemaIds) == 0 {
+func (sc *SchemaCmd) Run(k *kong.Kong, ctx *client.Context) error {
+	if len(sc.SchemaIds) == 0 {
 		return fmt.Errorf("no schema id(s) provided")
 	}
 
-	deletedSchemas, err := schema.Delete(ctx.AdminClient, c.Schema.SchemaIds...)
+	deletedSchemas, err := schema.Delete(ctx.AdminClient, sc.SchemaIds...)
 	if err != nil {
 		return fmt.Errorf("failed to delete schemas: %w", err)
 	}
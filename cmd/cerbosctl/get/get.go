@@ -6,6 +6,7 @@ package get
 import (
 	"github.com/cerbos/cerbos/cmd/cerbosctl/get/derivedroles"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/get/exportvariables"
+	"github.com/cerbos/cerbos/cmd/cerbosctl/get/policyversion"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/get/principalpolicy"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/get/resourcepolicy"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/get/schema"
@@ -14,6 +15,7 @@ import (
 type Cmd struct {
 	DerivedRoles    derivedroles.Cmd    `cmd:"" name:"derived_roles" aliases:"derived_role,dr"`
 	ExportVariables exportvariables.Cmd `cmd:"" name:"export_variables" aliases:"ev"`
+	PolicyVersion   policyversion.Cmd   `cmd:"" name:"policy_versions" aliases:"policy_version,pv"`
 	PrincipalPolicy principalpolicy.Cmd `cmd:"" name:"principal_policies" aliases:"principal_policy,pp"`
 	ResourcePolicy  resourcepolicy.Cmd  `cmd:"" name:"resource_policies" aliases:"resource_policy,rp"`
 	Schema          schema.Cmd          `cmd:"" name:"schemas" aliases:"schema,s"`
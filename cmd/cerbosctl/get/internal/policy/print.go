@@ -9,6 +9,7 @@ import (
 
 	"google.golang.org/protobuf/encoding/protojson"
 
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/get/internal/flagset"
 	"github.com/cerbos/cerbos/internal/policy"
 	"github.com/cerbos/cerbos/internal/util"
@@ -79,3 +80,8 @@ func getHeaders(kind policy.Kind) []string {
 
 	panic(fmt.Errorf("unknown policy kind %d", kind))
 }
+
+// PrintVersion prints the definition of a single policy version in the given format.
+func PrintVersion(w io.Writer, p *policyv1.Policy, output flagset.OutputFormat) error {
+	return printPolicy(w, []policy.Wrapper{policy.Wrap(p)}, output)
+}
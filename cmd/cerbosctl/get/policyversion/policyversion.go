@@ -0,0 +1,91 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package policyversion
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alecthomas/kong"
+
+	responsev1 "github.com/cerbos/cerbos/api/genpb/cerbos/response/v1"
+	"github.com/cerbos/cerbos/cmd/cerbosctl/get/internal/flagset"
+	cmdpolicy "github.com/cerbos/cerbos/cmd/cerbosctl/get/internal/policy"
+	"github.com/cerbos/cerbos/cmd/cerbosctl/get/internal/printer"
+	"github.com/cerbos/cerbos/cmd/cerbosctl/internal/client"
+)
+
+const help = `# List the versions of a policy (mutable stores)
+cerbosctl get policy_versions resource.leave_request.vdefault
+cerbosctl get policy_version resource.leave_request.vdefault
+cerbosctl get pv resource.leave_request.vdefault
+
+# Get the definition of a policy at a specific version
+cerbosctl get policy_versions resource.leave_request.vdefault --version 3
+
+# Get the definition of a policy at a specific version as yaml
+cerbosctl get policy_versions resource.leave_request.vdefault --version 3 -oyaml`
+
+type Cmd struct {
+	flagset.Format
+
+	PolicyID string `arg:"" name:"id" help:"id of the policy"`
+	Version  uint64 `help:"Version of the policy to retrieve"`
+}
+
+func (c *Cmd) Run(k *kong.Kong, ctx *client.Context) error {
+	if c.Version == 0 {
+		if err := c.list(k, ctx); err != nil {
+			return fmt.Errorf("failed to list: %w", err)
+		}
+
+		return nil
+	}
+
+	p, err := ctx.AdminClient.GetPolicyVersion(context.Background(), c.PolicyID, c.Version)
+	if err != nil {
+		return fmt.Errorf("failed to get: %w", err)
+	}
+
+	return cmdpolicy.PrintVersion(k.Stdout, p, c.Output)
+}
+
+func (c *Cmd) list(k *kong.Kong, ctx *client.Context) error {
+	versions, err := ctx.AdminClient.ListPolicyVersions(context.Background(), c.PolicyID)
+	if err != nil {
+		return fmt.Errorf("error while requesting policy versions: %w", err)
+	}
+
+	tw := printer.NewTableWriter(k.Stdout)
+	if !c.NoHeaders {
+		tw.SetHeader([]string{"VERSION", "TIMESTAMP", "CHANGE", "DISABLED"})
+	}
+
+	for _, v := range versions {
+		tw.Append([]string{
+			strconv.FormatUint(v.Version, 10),
+			v.Timestamp.AsTime().Format(time.RFC3339),
+			changeName(v.Change),
+			strconv.FormatBool(v.Disabled),
+		})
+	}
+	tw.Render()
+
+	return nil
+}
+
+func (c *Cmd) Validate() error {
+	return c.Format.Validate(c.Version == 0)
+}
+
+func (c *Cmd) Help() string {
+	return help
+}
+
+func changeName(change responsev1.ListPolicyVersionsResponse_Version_Change) string {
+	return strings.TrimPrefix(change.String(), "CHANGE_")
+}
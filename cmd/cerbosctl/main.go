@@ -4,6 +4,8 @@
 package main
 
 import (
+	"fmt"
+
 	"github.com/alecthomas/kong"
 
 	"github.com/cerbos/cerbos/cmd/cerbosctl/internal/client"
@@ -18,18 +20,23 @@ func main() {
 		kong.UsageOnError(),
 	)
 
-	c, err := client.GetClient(&cli.Globals)
-	if err != nil {
-		ctx.Fatalf("failed to get the client: %v", err)
-	}
+	// clients are only created for the commands that require them so that local commands work without a server
+	ctx.FatalIfErrorf(ctx.BindToProvider(func() (*client.Context, error) {
+		c, err := client.GetClient(&cli.Globals)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the client: %w", err)
+		}
 
-	ac, err := client.GetAdminClient(&cli.Globals)
-	if err != nil {
-		ctx.Fatalf("failed to get the admin client: %v", err)
-	}
+		ac, err := client.GetAdminClient(&cli.Globals)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the admin client: %w", err)
+		}
 
-	ctx.FatalIfErrorf(ctx.Run(&cli.Globals, &client.Context{
-		Client:      c,
-		AdminClient: ac,
+		return &client.Context{
+			Client:      c,
+			AdminClient: ac,
+		}, nil
 	}))
+
+	ctx.FatalIfErrorf(ctx.Run(&cli.Globals))
 }
@@ -0,0 +1,218 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/alecthomas/kong"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	"github.com/cerbos/cerbos/internal/auxdata"
+	"github.com/cerbos/cerbos/internal/util"
+	"github.com/cerbos/cerbos/private/plandiff"
+)
+
+const help = `The input file contains a resource and a list of principal and action combinations in the same format as the
+PlanResourcesStream API request. Revisions can be policy directories or local policy bundles. If the input contains a JWT
+in the auxData section, its claims are made available to the policies without verifying the token.
+
+# Compare the plans produced by the policies in two directories
+cerbosctl plan diff --base=path/to/old/policies --head=path/to/new/policies input.yaml
+
+# Compare the plans produced by a bundle against a directory and print the result as JSON
+cerbosctl plan diff --base=bundle.crbp --base-secret-key=CERBOS-XXX --head=path/to/policies --output=json input.yaml
+
+# Exit with a non-zero status if any of the plans changed
+cerbosctl plan diff --base=path/to/old/policies --head=path/to/new/policies --fail-on-change input.yaml`
+
+var ErrPlansChanged = errors.New("plans changed")
+
+type Cmd struct { //nolint:govet // Kong prints fields in order, so we don't want to reorder fields to save bytes.
+	Input         string `arg:"" help:"Path to the file containing the plan inputs" type:"existingfile"`
+	Base          string `help:"Policy directory or bundle of the base revision" required:"" type:"existingpath"`
+	BaseSecretKey string `help:"Secret key to decrypt the base revision bundle" env:"CERBOS_BASE_SECRET_KEY"`
+	Head          string `help:"Policy directory or bundle of the head revision" required:"" type:"existingpath"`
+	HeadSecretKey string `help:"Secret key to decrypt the head revision bundle" env:"CERBOS_HEAD_SECRET_KEY"`
+	Output        string `help:"Output format (${enum})" default:"text" enum:"text,json" short:"o"`
+	FailOnChange  bool   `help:"Exit with an error if any of the plans changed"`
+}
+
+func (c *Cmd) Run(k *kong.Kong) error {
+	ctx, stopFunc := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stopFunc()
+
+	inputs, err := readInputs(ctx, c.Input)
+	if err != nil {
+		return err
+	}
+
+	workDir, err := os.MkdirTemp("", "cerbosctl-plan-diff-*")
+	if err != nil {
+		return fmt.Errorf("failed to create work directory: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	base, err := mkPlanner(ctx, c.Base, c.BaseSecretKey, workDir)
+	if err != nil {
+		return fmt.Errorf("failed to load base revision: %w", err)
+	}
+
+	head, err := mkPlanner(ctx, c.Head, c.HeadSecretKey, workDir)
+	if err != nil {
+		return fmt.Errorf("failed to load head revision: %w", err)
+	}
+
+	results, err := plandiff.Diff(ctx, base, head, inputs)
+	if err != nil {
+		return err
+	}
+
+	if c.Output == "json" {
+		err = printJSON(k.Stdout, results)
+	} else {
+		err = printText(k.Stdout, results)
+	}
+	if err != nil {
+		return err
+	}
+
+	if c.FailOnChange {
+		for _, r := range results {
+			if r.Changed() {
+				return ErrPlansChanged
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Cmd) Help() string {
+	return help
+}
+
+func readInputs(ctx context.Context, path string) ([]*enginev1.PlanResourcesInput, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %q: %w", path, err)
+	}
+	defer f.Close()
+
+	req := &requestv1.PlanResourcesStreamRequest{}
+	if err := util.ReadJSONOrYAML(f, req); err != nil {
+		return nil, fmt.Errorf("failed to read %q: %w", path, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid input in %q: %w", path, err)
+	}
+
+	// the token is only used to produce plans locally, so there's no need to verify it
+	auxData, err := auxdata.NewWithoutVerification(ctx).Extract(ctx, req.AuxData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract auxData from %q: %w", path, err)
+	}
+
+	inputs := make([]*enginev1.PlanResourcesInput, len(req.Entries))
+	for i, entry := range req.Entries {
+		inputs[i] = &enginev1.PlanResourcesInput{
+			RequestId:          entry.Id,
+			Action:             entry.Action,
+			Principal:          entry.Principal,
+			Resource:           req.Resource,
+			AuxData:            auxData,
+			IncludeMeta:        true,
+			ExpandConditionals: req.ExpandConditionals,
+		}
+	}
+
+	return inputs, nil
+}
+
+func mkPlanner(ctx context.Context, path, secretKey, workDir string) (plandiff.Planner, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		return plandiff.Files(ctx, os.DirFS(path))
+	}
+
+	return plandiff.Bundle(ctx, plandiff.BundleParams{BundlePath: path, SecretKey: secretKey, WorkDir: workDir})
+}
+
+func printText(w io.Writer, results []*plandiff.Result) error {
+	for _, r := range results {
+		if _, err := fmt.Fprintf(w, "%s\n", describeInput(r.Input)); err != nil {
+			return err
+		}
+
+		if !r.Changed() {
+			_, _ = fmt.Fprintln(w, "  no changes")
+			continue
+		}
+
+		for _, c := range r.Changes {
+			path := c.Path
+			if path == "" {
+				path = "filter"
+			}
+
+			switch c.Kind {
+			case plandiff.ChangeAdded:
+				_, _ = fmt.Fprintf(w, "  + %s: %s\n", path, c.Head)
+			case plandiff.ChangeRemoved:
+				_, _ = fmt.Fprintf(w, "  - %s: %s\n", path, c.Base)
+			default:
+				_, _ = fmt.Fprintf(w, "  ~ %s: %s => %s\n", path, c.Base, c.Head)
+			}
+		}
+	}
+
+	return nil
+}
+
+type jsonResult struct {
+	ID        string            `json:"id,omitempty"`
+	Principal string            `json:"principal"`
+	Action    string            `json:"action"`
+	Base      string            `json:"base"`
+	Head      string            `json:"head"`
+	Changes   []plandiff.Change `json:"changes,omitempty"`
+}
+
+func printJSON(w io.Writer, results []*plandiff.Result) error {
+	out := make([]jsonResult, len(results))
+	for i, r := range results {
+		out[i] = jsonResult{
+			ID:        r.Input.RequestId,
+			Principal: r.Input.Principal.Id,
+			Action:    r.Input.Action,
+			Base:      r.Base.FilterDebug,
+			Head:      r.Head.FilterDebug,
+			Changes:   r.Changes,
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+func describeInput(input *enginev1.PlanResourcesInput) string {
+	if input.RequestId != "" {
+		return fmt.Sprintf("%s (principal=%s action=%s)", input.RequestId, input.Principal.Id, input.Action)
+	}
+
+	return fmt.Sprintf("principal=%s action=%s", input.Principal.Id, input.Action)
+}
@@ -0,0 +1,12 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package plan
+
+import (
+	"github.com/cerbos/cerbos/cmd/cerbosctl/plan/diff"
+)
+
+type Cmd struct {
+	Diff diff.Cmd `cmd:"" name:"diff" help:"Compare query plans produced by two policy revisions"`
+}
@@ -0,0 +1,50 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package restore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/cerbos/cerbos/client"
+	internalclient "github.com/cerbos/cerbos/cmd/cerbosctl/internal/client"
+)
+
+const policyCmdHelp = `# Restore deleted policies (mutable stores)
+cerbosctl restore policies derived_roles.my_derived_roles
+cerbosctl restore policy derived_roles.my_derived_roles
+cerbosctl restore p derived_roles.my_derived_roles
+
+# Restore multiple deleted policies
+cerbosctl restore policies derived_roles.my_derived_roles resource.leave_request.default
+cerbosctl restore policy derived_roles.my_derived_roles resource.leave_request.default
+cerbosctl restore p derived_roles.my_derived_roles resource.leave_request.default`
+
+type Cmd struct {
+	Policy PolicyCmd `cmd:"" aliases:"policies,p"`
+}
+
+type PolicyCmd struct {
+	PolicyIds []string `arg:"" name:"id" help:"list of policy ids to restore"`
+}
+
+func (c *Cmd) Run(k *kong.Kong, ctx *internalclient.Context) error {
+	if len(c.Policy.PolicyIds) == 0 {
+		return fmt.Errorf("no policy id(s) provided")
+	}
+
+	restoredPolicies, err := client.BatchAdminClientCall(context.Background(), ctx.AdminClient.RestorePolicy, c.Policy.PolicyIds...)
+	if err != nil {
+		return fmt.Errorf("failed to restore policies: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(k.Stdout, "Number of policies restored is %d", restoredPolicies)
+	return nil
+}
+
+func (pc *PolicyCmd) Help() string {
+	return policyCmdHelp
+}
@@ -0,0 +1,43 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package rollback
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alecthomas/kong"
+
+	internalclient "github.com/cerbos/cerbos/cmd/cerbosctl/internal/client"
+)
+
+const policyCmdHelp = `# Roll a policy back to an earlier version (mutable stores)
+cerbosctl rollback policy resource.leave_request.vdefault 3
+cerbosctl rollback p resource.leave_request.vdefault 3
+
+# List the versions of a policy to find the version to roll back to
+cerbosctl get policy_versions resource.leave_request.vdefault`
+
+type Cmd struct {
+	Policy PolicyCmd `cmd:"" aliases:"p"`
+}
+
+type PolicyCmd struct {
+	PolicyID string `arg:"" name:"id" help:"id of the policy to roll back"`
+	Version  uint64 `arg:"" name:"version" help:"version of the policy to restore"`
+}
+
+func (c *Cmd) Run(k *kong.Kong, ctx *internalclient.Context) error {
+	version, err := ctx.AdminClient.RollbackPolicy(context.Background(), c.Policy.PolicyID, c.Policy.Version)
+	if err != nil {
+		return fmt.Errorf("failed to roll back policy: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(k.Stdout, "Rolled back %s to version %d (new version is %d)", c.Policy.PolicyID, c.Policy.Version, version)
+	return nil
+}
+
+func (pc *PolicyCmd) Help() string {
+	return policyCmdHelp
+}
@@ -11,7 +11,10 @@ import (
 	"github.com/cerbos/cerbos/cmd/cerbosctl/enable"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/get"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/internal/flagset"
+	"github.com/cerbos/cerbos/cmd/cerbosctl/plan"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/put"
+	"github.com/cerbos/cerbos/cmd/cerbosctl/restore"
+	"github.com/cerbos/cerbos/cmd/cerbosctl/rollback"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/store"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/version"
 )
@@ -41,12 +44,15 @@ type Cli struct {
 	Get     get.Cmd     `cmd:"" help:"List or view policies and schemas"`
 	Store   store.Cmd   `cmd:"" help:"Store operations"`
 	flagset.Globals
-	Delete    del.Cmd       `cmd:"" help:"Delete schemas"`
+	Delete    del.Cmd       `cmd:"" help:"Delete policies or schemas"`
 	Disable   disable.Cmd   `cmd:"" help:"Disable policies"`
 	Enable    enable.Cmd    `cmd:"" help:"Enable policies"`
 	Put       put.Cmd       `cmd:"" help:"Put policies or schemas"`
+	Restore   restore.Cmd   `cmd:"" help:"Restore deleted policies"`
+	Rollback  rollback.Cmd  `cmd:"" help:"Roll policies back to an earlier version"`
 	Decisions decisions.Cmd `cmd:"" help:"Interactive decision log viewer"`
 	Audit     audit.Cmd     `cmd:"" help:"View audit logs"`
+	Plan      plan.Cmd      `cmd:"" help:"Query plan operations"`
 }
 
 func (c *Cli) Help() string {
@@ -7,7 +7,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -19,6 +21,7 @@ import (
 	"github.com/cerbos/cerbos/client"
 	internalclient "github.com/cerbos/cerbos/cmd/cerbosctl/internal/client"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/store/export/internal"
+	"github.com/cerbos/cerbos/internal/signature"
 	"github.com/cerbos/cerbos/internal/util"
 )
 
@@ -30,13 +33,49 @@ cerbosctl store export archive.zip
 
 # Export policies and schemas from the store into a gzip archive
 cerbosctl store export path/to/archive.gzip
-cerbosctl store export path/to/archive.tar.gz`
+cerbosctl store export path/to/archive.tar.gz
+
+# Export policies and schemas from the store into a bundle signed with a cosign key
+cerbosctl store export --format=bundle --sign-key=cosign.key path/to/bundle.tar.gz`
+
+const (
+	formatBundle = "bundle"
+
+	passwordEnvVar     = "COSIGN_PASSWORD" //nolint:gosec
+	sigFilePermissions = 0o644
+)
 
 type Cmd struct {
-	Path string `arg:"" help:"Path to write policies and schemas" type:"path"`
+	Path    string `arg:"" help:"Path to write policies and schemas" type:"path"`
+	Format  string `help:"Output format (${enum}). The bundle format writes a single compressed archive that the disk and blob storage drivers can load directly" default:"auto" enum:"auto,bundle"`
+	SignKey string `help:"Path to the PEM-encoded private key to sign the bundle with. The password of an encrypted key is read from the COSIGN_PASSWORD environment variable" type:"existingfile"`
+}
+
+func (c *Cmd) Validate() error {
+	if c.Format != formatBundle {
+		if c.SignKey != "" {
+			return errors.New("--sign-key requires --format=bundle")
+		}
+
+		return nil
+	}
+
+	if !util.IsGzip(c.Path) && !util.IsZip(c.Path) {
+		return fmt.Errorf("bundle path must have a .zip, .tgz or .tar.gz extension: %s", c.Path)
+	}
+
+	return nil
 }
 
 func (c *Cmd) Run(k *kong.Kong, clientCtx *internalclient.Context) error {
+	var signer *signature.Signer
+	if c.SignKey != "" {
+		var err error
+		if signer, err = signature.LoadSigner(c.SignKey, []byte(os.Getenv(passwordEnvVar))); err != nil {
+			return err
+		}
+	}
+
 	policies, err := clientCtx.AdminClient.ListPolicies(context.Background(), client.WithIncludeDisabled())
 	if err != nil {
 		return fmt.Errorf("failed to list policies: %w", err)
@@ -51,8 +90,28 @@ func (c *Cmd) Run(k *kong.Kong, clientCtx *internalclient.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to create exporter: %w", err)
 	}
-	defer exporter.Close()
 
+	if err := c.export(k, clientCtx, exporter, policies, schemas); err != nil {
+		_ = exporter.Close()
+		return err
+	}
+
+	if err := exporter.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", c.Path, err)
+	}
+
+	if signer != nil {
+		if err := sign(signer, c.Path); err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(k.Stdout, "🗸 %s (signature)\n", c.Path+signature.Extension)
+	}
+
+	_, _ = fmt.Fprintf(k.Stdout, "\nExported %d policies and %d schemas to %s\n", len(policies), len(schemas), c.Path)
+	return nil
+}
+
+func (c *Cmd) export(k *kong.Kong, clientCtx *internalclient.Context, exporter internal.Exporter, policies, schemas []string) error {
 	if err := client.BatchAdminClientCall2(context.Background(), clientCtx.AdminClient.GetPolicy, func(ctx context.Context, policies []*policyv1.Policy) error {
 		for _, p := range policies {
 			name := p.Metadata.StoreIdentifier
@@ -107,7 +166,26 @@ func (c *Cmd) Run(k *kong.Kong, clientCtx *internalclient.Context) error {
 		return fmt.Errorf("error while getting schemas: %w", err)
 	}
 
-	_, _ = fmt.Fprintf(k.Stdout, "\nExported %d policies and %d schemas to %s\n", len(policies), len(schemas), c.Path)
+	return nil
+}
+
+// sign writes the signature of the file next to it, where the storage drivers look for it.
+func sign(signer *signature.Signer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	sig, err := signer.Sign(f)
+	if err != nil {
+		return fmt.Errorf("failed to sign %s: %w", path, err)
+	}
+
+	if err := os.WriteFile(path+signature.Extension, sig, sigFilePermissions); err != nil {
+		return fmt.Errorf("failed to write signature of %s: %w", path, err)
+	}
+
 	return nil
 }
 
@@ -0,0 +1 @@
+../../../../internal/storage/db/cockroachdb/schema.sql
\ No newline at end of file
@@ -23,9 +23,17 @@ const (
 	callIDTagKey       = "call_id"
 )
 
-type callIDCtxKeyType struct{}
+type (
+	callIDCtxKeyType        struct{}
+	adminChangeCtxKeyType   struct{}
+	storeRevisionCtxKeyType struct{}
+)
 
-var callIDCtxKey = callIDCtxKeyType{}
+var (
+	callIDCtxKey        = callIDCtxKeyType{}
+	adminChangeCtxKey   = adminChangeCtxKeyType{}
+	storeRevisionCtxKey = storeRevisionCtxKeyType{}
+)
 
 func NewContextWithCallID(ctx context.Context, id ID) context.Context {
 	tags := grpc_ctxtags.Extract(ctx)
@@ -48,6 +56,34 @@ func CallIDFromContext(ctx context.Context) (ID, bool) {
 	return id, true
 }
 
+// newContextWithAdminChange returns a context that Admin API handlers can use to record the changes they make to the store.
+func newContextWithAdminChange(ctx context.Context) (context.Context, *auditv1.AdminChange) {
+	change := &auditv1.AdminChange{}
+	return context.WithValue(ctx, adminChangeCtxKey, change), change
+}
+
+// AdminChangeFromContext returns the record of the changes made by the current Admin API call.
+// Returns nil if the call is not recorded in the access log.
+func AdminChangeFromContext(ctx context.Context) *auditv1.AdminChange {
+	change, ok := ctx.Value(adminChangeCtxKey).(*auditv1.AdminChange)
+	if !ok {
+		return nil
+	}
+
+	return change
+}
+
+// NewContextWithStoreRevision returns a context that makes the audit log record the given store revision instead of the
+// revision currently served by the PDP. It's used for requests that are evaluated against a pinned store revision.
+func NewContextWithStoreRevision(ctx context.Context, revision string) context.Context {
+	return context.WithValue(ctx, storeRevisionCtxKey, revision)
+}
+
+func storeRevisionFromContext(ctx context.Context) (string, bool) {
+	revision, ok := ctx.Value(storeRevisionCtxKey).(string)
+	return revision, ok
+}
+
 func PeerFromContext(ctx context.Context) *auditv1.Peer {
 	p, ok := peer.FromContext(ctx)
 	if !ok {
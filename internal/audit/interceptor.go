@@ -40,20 +40,27 @@ func NewUnaryInterceptor(log Log, exclude ExcludeMethod) (grpc.UnaryServerInterc
 			return handler(ctx, req)
 		}
 
-		resp, err := handler(NewContextWithCallID(ctx, callID), req)
+		handlerCtx, change := newContextWithAdminChange(NewContextWithCallID(ctx, callID))
+		resp, err := handler(handlerCtx, req)
 
 		if logErr := log.WriteAccessLogEntry(ctx, func() (*auditv1.AccessLogEntry, error) {
 			ctx, span := tracing.StartSpan(ctx, "audit.WriteAccessLog")
 			defer span.End()
 
-			return &auditv1.AccessLogEntry{
+			entry := &auditv1.AccessLogEntry{
 				CallId:     string(callID),
 				Timestamp:  timestamppb.New(ts),
 				Peer:       PeerFromContext(ctx),
 				Method:     info.FullMethod,
 				StatusCode: uint32(status.Code(err)),
 				Metadata:   mdExtractor(ctx),
-			}, nil
+			}
+
+			if change.User != "" {
+				entry.AdminChange = change
+			}
+
+			return entry, nil
 		}); logErr != nil {
 			ctxzap.Extract(ctx).Warn("Failed to write access log entry", zap.Error(logErr))
 		}
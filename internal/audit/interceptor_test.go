@@ -0,0 +1,71 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+)
+
+func TestUnaryInterceptorAdminChange(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/cerbos.svc.v1.CerbosAdminService/DisablePolicy"}
+	noExclusions := func(string) bool { return false }
+
+	t.Run("with_change", func(t *testing.T) {
+		backend := &capturingLog{}
+		interceptor, err := audit.NewUnaryInterceptor(backend, noExclusions)
+		require.NoError(t, err)
+
+		want := &auditv1.AdminChange{
+			User: "cerbos",
+			Policies: []*auditv1.AdminChange_PolicyChange{
+				{PolicyId: "resource.leave_request.vdefault", BeforeHash: "1a2b", AfterHash: "3c4d"},
+			},
+		}
+
+		_, err = interceptor(context.Background(), nil, info, func(ctx context.Context, _ any) (any, error) {
+			change := audit.AdminChangeFromContext(ctx)
+			require.NotNil(t, change)
+
+			change.User = want.User
+			change.Policies = want.Policies
+			return nil, nil
+		})
+		require.NoError(t, err)
+		require.Empty(t, cmp.Diff(want, backend.accessEntry.AdminChange, protocmp.Transform()))
+	})
+
+	t.Run("without_change", func(t *testing.T) {
+		backend := &capturingLog{}
+		interceptor, err := audit.NewUnaryInterceptor(backend, noExclusions)
+		require.NoError(t, err)
+
+		_, err = interceptor(context.Background(), nil, info, func(context.Context, any) (any, error) {
+			return nil, nil
+		})
+		require.NoError(t, err)
+		require.Nil(t, backend.accessEntry.AdminChange)
+	})
+
+	t.Run("excluded", func(t *testing.T) {
+		backend := &capturingLog{}
+		interceptor, err := audit.NewUnaryInterceptor(backend, func(string) bool { return true })
+		require.NoError(t, err)
+
+		_, err = interceptor(context.Background(), nil, info, func(ctx context.Context, _ any) (any, error) {
+			require.Nil(t, audit.AdminChangeFromContext(ctx))
+			return nil, nil
+		})
+		require.NoError(t, err)
+		require.Nil(t, backend.accessEntry)
+	})
+}
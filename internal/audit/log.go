@@ -9,11 +9,13 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
 	"github.com/cerbos/cerbos/internal/config"
 	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/util"
 	"go.opencensus.io/stats"
 	"go.opencensus.io/tag"
 )
@@ -66,6 +68,11 @@ type DecisionLogIterator interface {
 	Next() (*auditv1.DecisionLogEntry, error)
 }
 
+// RevisionSource reports the revision of the policies currently served by the PDP.
+type RevisionSource interface {
+	Revision() string
+}
+
 // Constructor for backends.
 type Constructor func(context.Context, *config.Wrapper, DecisionLogEntryFilter) (Log, error)
 
@@ -99,13 +106,20 @@ func NewLogFromConf(ctx context.Context, confW *config.Wrapper) (Log, error) {
 		return nil, fmt.Errorf("unknown backend [%s]", conf.Backend)
 	}
 
-	decisionFilter := NewDecisionLogEntryFilterFromConf(conf)
-	backend, err := cons(ctx, confW, decisionFilter)
+	configHash, err := confW.Hash()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create backend: %w", err)
+		return nil, fmt.Errorf("failed to hash configuration: %w", err)
 	}
 
-	lw := &logWrapper{conf: conf, backend: backend}
+	lw := &logWrapper{version: util.Version, commit: util.Commit, configHash: configHash}
+	lw.setConf(conf)
+
+	// the backend filters through the wrapper so that the filters can be reloaded
+	backend, err := cons(ctx, confW, lw.filterDecisionLogEntry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create backend: %w", err)
+	}
+	lw.backend = backend
 
 	if q, ok := backend.(QueryableLog); ok {
 		return &queryableLogWrapper{logWrapper: lw, queryable: q}, nil
@@ -117,29 +131,108 @@ func NewLogFromConf(ctx context.Context, confW *config.Wrapper) (Log, error) {
 // NewNopLog returns an audit log that does nothing.
 func NewNopLog() Log {
 	conf := &Conf{confHolder: confHolder{Enabled: false, AccessLogsEnabled: false, DecisionLogsEnabled: false}}
-	return &logWrapper{conf: conf}
+	lw := &logWrapper{}
+	lw.setConf(conf)
+	return lw
+}
+
+// AttachRevisionSource makes the audit log record the revision reported by src in every entry.
+// It has no effect if the log was not created by NewLog or NewLogFromConf.
+func AttachRevisionSource(log Log, src RevisionSource) {
+	switch lw := log.(type) {
+	case *logWrapper:
+		lw.revisionSrc.Store(&src)
+	case *queryableLogWrapper:
+		lw.revisionSrc.Store(&src)
+	}
+}
+
+// Reload applies the settings of conf that can be changed while the audit log is in use: whether access and decision
+// logs are enabled and the decision log filters. Other settings, such as the backend, only take effect when a new log
+// is created. It has no effect if the log is disabled or was not created by NewLog or NewLogFromConf.
+func Reload(log Log, conf *Conf) {
+	var lw *logWrapper
+	switch l := log.(type) {
+	case *logWrapper:
+		lw = l
+	case *queryableLogWrapper:
+		lw = l.logWrapper
+	default:
+		return
+	}
+
+	current := lw.getConf()
+	if !current.Enabled {
+		return
+	}
+
+	updated := *current
+	updated.AccessLogsEnabled = conf.AccessLogsEnabled
+	updated.DecisionLogsEnabled = conf.DecisionLogsEnabled
+	updated.DecisionLogFilters = conf.DecisionLogFilters
+	lw.setConf(&updated)
 }
 
 // logWrapper wraps the backends and enforces the config options.
 type logWrapper struct {
-	conf    *Conf
-	backend Log
+	backend        Log
+	conf           atomic.Pointer[Conf]
+	decisionFilter atomic.Pointer[DecisionLogEntryFilter]
+	revisionSrc    atomic.Pointer[RevisionSource]
+	version        string
+	commit         string
+	configHash     string
+}
+
+func (lw *logWrapper) getConf() *Conf {
+	return lw.conf.Load()
+}
+
+func (lw *logWrapper) setConf(conf *Conf) {
+	filter := NewDecisionLogEntryFilterFromConf(conf)
+	lw.decisionFilter.Store(&filter)
+	lw.conf.Store(conf)
+}
+
+func (lw *logWrapper) filterDecisionLogEntry(entry *auditv1.DecisionLogEntry) *auditv1.DecisionLogEntry {
+	return (*lw.decisionFilter.Load())(entry)
+}
+
+func (lw *logWrapper) systemInfo(ctx context.Context) *auditv1.SystemInfo {
+	info := &auditv1.SystemInfo{Version: lw.version, Commit: lw.commit, ConfigHash: lw.configHash}
+	if revision, ok := storeRevisionFromContext(ctx); ok {
+		info.StoreRevision = revision
+	} else if src := lw.revisionSrc.Load(); src != nil {
+		info.StoreRevision = (*src).Revision()
+	}
+
+	return info
 }
 
 func (lw *logWrapper) Backend() string {
-	return lw.conf.Backend
+	return lw.getConf().Backend
 }
 
 func (lw *logWrapper) Enabled() bool {
-	return lw.conf.Enabled
+	return lw.getConf().Enabled
 }
 
 func (lw *logWrapper) WriteAccessLogEntry(ctx context.Context, entry AccessLogEntryMaker) error {
-	if !lw.conf.AccessLogsEnabled {
+	if !lw.getConf().AccessLogsEnabled {
 		return nil
 	}
 
-	if err := lw.backend.WriteAccessLogEntry(ctx, entry); err != nil {
+	withInfo := func() (*auditv1.AccessLogEntry, error) {
+		e, err := entry()
+		if err != nil {
+			return nil, err
+		}
+
+		e.SystemInfo = lw.systemInfo(ctx)
+		return e, nil
+	}
+
+	if err := lw.backend.WriteAccessLogEntry(ctx, withInfo); err != nil {
 		_ = stats.RecordWithTags(ctx,
 			[]tag.Mutator{tag.Upsert(metrics.KeyAuditKind, KindAccess)},
 			metrics.AuditErrorCount.M(1),
@@ -151,11 +244,21 @@ func (lw *logWrapper) WriteAccessLogEntry(ctx context.Context, entry AccessLogEn
 }
 
 func (lw *logWrapper) WriteDecisionLogEntry(ctx context.Context, entry DecisionLogEntryMaker) error {
-	if !lw.conf.DecisionLogsEnabled {
+	if !lw.getConf().DecisionLogsEnabled {
 		return nil
 	}
 
-	if err := lw.backend.WriteDecisionLogEntry(ctx, entry); err != nil {
+	withInfo := func() (*auditv1.DecisionLogEntry, error) {
+		e, err := entry()
+		if err != nil {
+			return nil, err
+		}
+
+		e.SystemInfo = lw.systemInfo(ctx)
+		return e, nil
+	}
+
+	if err := lw.backend.WriteDecisionLogEntry(ctx, withInfo); err != nil {
 		_ = stats.RecordWithTags(ctx,
 			[]tag.Mutator{tag.Upsert(metrics.KeyAuditKind, KindDecision)},
 			metrics.AuditErrorCount.M(1),
@@ -179,7 +282,7 @@ type queryableLogWrapper struct {
 }
 
 func (qlw *queryableLogWrapper) LastNAccessLogEntries(ctx context.Context, n uint) AccessLogIterator {
-	if !qlw.conf.AccessLogsEnabled {
+	if !qlw.getConf().AccessLogsEnabled {
 		return nopAccessLogIterator{}
 	}
 
@@ -187,7 +290,7 @@ func (qlw *queryableLogWrapper) LastNAccessLogEntries(ctx context.Context, n uin
 }
 
 func (qlw *queryableLogWrapper) LastNDecisionLogEntries(ctx context.Context, n uint) DecisionLogIterator {
-	if !qlw.conf.DecisionLogsEnabled {
+	if !qlw.getConf().DecisionLogsEnabled {
 		return nopDecisionLogIterator{}
 	}
 
@@ -195,7 +298,7 @@ func (qlw *queryableLogWrapper) LastNDecisionLogEntries(ctx context.Context, n u
 }
 
 func (qlw *queryableLogWrapper) AccessLogEntriesBetween(ctx context.Context, from, to time.Time) AccessLogIterator {
-	if !qlw.conf.AccessLogsEnabled {
+	if !qlw.getConf().AccessLogsEnabled {
 		return nopAccessLogIterator{}
 	}
 
@@ -203,7 +306,7 @@ func (qlw *queryableLogWrapper) AccessLogEntriesBetween(ctx context.Context, fro
 }
 
 func (qlw *queryableLogWrapper) DecisionLogEntriesBetween(ctx context.Context, from, to time.Time) DecisionLogIterator {
-	if !qlw.conf.DecisionLogsEnabled {
+	if !qlw.getConf().DecisionLogsEnabled {
 		return nopDecisionLogIterator{}
 	}
 
@@ -211,7 +314,7 @@ func (qlw *queryableLogWrapper) DecisionLogEntriesBetween(ctx context.Context, f
 }
 
 func (qlw *queryableLogWrapper) AccessLogEntryByID(ctx context.Context, id ID) AccessLogIterator {
-	if !qlw.conf.AccessLogsEnabled {
+	if !qlw.getConf().AccessLogsEnabled {
 		return nopAccessLogIterator{}
 	}
 
@@ -219,7 +322,7 @@ func (qlw *queryableLogWrapper) AccessLogEntryByID(ctx context.Context, id ID) A
 }
 
 func (qlw *queryableLogWrapper) DecisionLogEntryByID(ctx context.Context, id ID) DecisionLogIterator {
-	if !qlw.conf.DecisionLogsEnabled {
+	if !qlw.getConf().DecisionLogsEnabled {
 		return nopDecisionLogIterator{}
 	}
 
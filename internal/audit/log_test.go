@@ -11,6 +11,8 @@ import (
 
 	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
 	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/util"
 )
 
 func TestNopLog(t *testing.T) {
@@ -40,3 +42,146 @@ func TestNopLog(t *testing.T) {
 		log.Close()
 	})
 }
+
+func TestSystemInfo(t *testing.T) {
+	backend := &capturingLog{}
+	audit.RegisterBackend("capturing", func(context.Context, *config.Wrapper, audit.DecisionLogEntryFilter) (audit.Log, error) {
+		return backend, nil
+	})
+
+	confW, err := config.WrapperFromMap(map[string]any{
+		"audit": map[string]any{
+			"enabled":             true,
+			"backend":             "capturing",
+			"accessLogsEnabled":   true,
+			"decisionLogsEnabled": true,
+		},
+	})
+	require.NoError(t, err)
+
+	configHash, err := confW.Hash()
+	require.NoError(t, err)
+
+	log, err := audit.NewLogFromConf(context.Background(), confW)
+	require.NoError(t, err)
+
+	require.NoError(t, log.WriteAccessLogEntry(context.Background(), func() (*auditv1.AccessLogEntry, error) {
+		return &auditv1.AccessLogEntry{CallId: "1"}, nil
+	}))
+	require.Equal(t, configHash, backend.accessEntry.SystemInfo.ConfigHash)
+	require.Equal(t, util.Version, backend.accessEntry.SystemInfo.Version)
+	require.Empty(t, backend.accessEntry.SystemInfo.StoreRevision)
+
+	audit.AttachRevisionSource(log, staticRevision("bundle-123"))
+
+	require.NoError(t, log.WriteDecisionLogEntry(context.Background(), func() (*auditv1.DecisionLogEntry, error) {
+		return &auditv1.DecisionLogEntry{CallId: "2"}, nil
+	}))
+	require.Equal(t, &auditv1.SystemInfo{
+		Version:       util.Version,
+		Commit:        util.Commit,
+		ConfigHash:    configHash,
+		StoreRevision: "bundle-123",
+	}, backend.decisionEntry.SystemInfo)
+
+	pinnedCtx := audit.NewContextWithStoreRevision(context.Background(), "bundle-100")
+	require.NoError(t, log.WriteDecisionLogEntry(pinnedCtx, func() (*auditv1.DecisionLogEntry, error) {
+		return &auditv1.DecisionLogEntry{CallId: "3"}, nil
+	}))
+	require.Equal(t, "bundle-100", backend.decisionEntry.SystemInfo.StoreRevision)
+}
+
+func TestReload(t *testing.T) {
+	backend := &capturingLog{}
+	audit.RegisterBackend("filtering", func(_ context.Context, _ *config.Wrapper, filter audit.DecisionLogEntryFilter) (audit.Log, error) {
+		backend.filter = filter
+		return backend, nil
+	})
+
+	confW, err := config.WrapperFromMap(map[string]any{
+		"audit": map[string]any{
+			"enabled":             true,
+			"backend":             "filtering",
+			"accessLogsEnabled":   true,
+			"decisionLogsEnabled": true,
+		},
+	})
+	require.NoError(t, err)
+
+	log, err := audit.NewLogFromConf(context.Background(), confW)
+	require.NoError(t, err)
+
+	planEntry := func() (*auditv1.DecisionLogEntry, error) {
+		return &auditv1.DecisionLogEntry{
+			CallId: "1",
+			Method: &auditv1.DecisionLogEntry_PlanResources_{PlanResources: &auditv1.DecisionLogEntry_PlanResources{}},
+		}, nil
+	}
+
+	require.NoError(t, log.WriteDecisionLogEntry(context.Background(), planEntry))
+	require.NotNil(t, backend.decisionEntry)
+
+	reloadedW, err := config.WrapperFromMap(map[string]any{
+		"audit": map[string]any{
+			"enabled":             true,
+			"backend":             "local",
+			"accessLogsEnabled":   false,
+			"decisionLogsEnabled": true,
+			"decisionLogFilters":  map[string]any{"planResources": map[string]any{"ignoreAll": true}},
+		},
+	})
+	require.NoError(t, err)
+
+	reloaded := new(audit.Conf)
+	require.NoError(t, reloadedW.GetSection(reloaded))
+
+	audit.Reload(log, reloaded)
+	// the backend can't be changed without creating a new log
+	require.Equal(t, "filtering", log.Backend())
+
+	backend.decisionEntry = nil
+	require.NoError(t, log.WriteDecisionLogEntry(context.Background(), planEntry))
+	require.Nil(t, backend.decisionEntry)
+
+	require.NoError(t, log.WriteAccessLogEntry(context.Background(), func() (*auditv1.AccessLogEntry, error) {
+		return &auditv1.AccessLogEntry{CallId: "2"}, nil
+	}))
+	require.Nil(t, backend.accessEntry)
+}
+
+type staticRevision string
+
+func (sr staticRevision) Revision() string {
+	return string(sr)
+}
+
+type capturingLog struct {
+	accessEntry   *auditv1.AccessLogEntry
+	decisionEntry *auditv1.DecisionLogEntry
+	filter        audit.DecisionLogEntryFilter
+}
+
+func (*capturingLog) Backend() string {
+	return "capturing"
+}
+
+func (*capturingLog) Enabled() bool {
+	return true
+}
+
+func (*capturingLog) Close() error {
+	return nil
+}
+
+func (cl *capturingLog) WriteAccessLogEntry(_ context.Context, entry audit.AccessLogEntryMaker) (err error) {
+	cl.accessEntry, err = entry()
+	return err
+}
+
+func (cl *capturingLog) WriteDecisionLogEntry(_ context.Context, entry audit.DecisionLogEntryMaker) (err error) {
+	cl.decisionEntry, err = entry()
+	if err == nil && cl.filter != nil {
+		cl.decisionEntry = cl.filter(cl.decisionEntry)
+	}
+	return err
+}
@@ -18,6 +18,19 @@ var alwaysExcludeMetadataKeys = map[string]struct{}{
 
 type MetadataExtractor func(context.Context) map[string]*auditv1.MetaValues
 
+type metadataCtxKey struct{}
+
+// ContextWithMetadata adds metadata that's known to the server, such as the identity of the authenticated client, to
+// the audit log entries of the request. Unlike the request metadata, it's not filtered by the include and exclude lists.
+func ContextWithMetadata(ctx context.Context, md map[string]*auditv1.MetaValues) context.Context {
+	return context.WithValue(ctx, metadataCtxKey{}, md)
+}
+
+func metadataFromContext(ctx context.Context) map[string]*auditv1.MetaValues {
+	md, _ := ctx.Value(metadataCtxKey{}).(map[string]*auditv1.MetaValues)
+	return md
+}
+
 func NewMetadataExtractor() (MetadataExtractor, error) {
 	conf, err := GetConf()
 	if err != nil {
@@ -29,8 +42,8 @@ func NewMetadataExtractor() (MetadataExtractor, error) {
 
 func NewMetadataExtractorFromConf(conf *Conf) MetadataExtractor {
 	if len(conf.ExcludeMetadataKeys) == 0 && len(conf.IncludeMetadataKeys) == 0 {
-		return func(_ context.Context) map[string]*auditv1.MetaValues {
-			return nil
+		return func(ctx context.Context) map[string]*auditv1.MetaValues {
+			return metadataFromContext(ctx)
 		}
 	}
 
@@ -73,12 +86,13 @@ func NewMetadataExtractorFromConf(conf *Conf) MetadataExtractor {
 	}
 
 	return func(ctx context.Context) map[string]*auditv1.MetaValues {
+		ctxMD := metadataFromContext(ctx)
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok || len(md) == 0 {
-			return nil
+			return ctxMD
 		}
 
-		extracted := make(map[string]*auditv1.MetaValues, len(md))
+		extracted := make(map[string]*auditv1.MetaValues, len(md)+len(ctxMD))
 		for key, values := range md {
 			if !shouldInclude(key) {
 				continue
@@ -87,6 +101,10 @@ func NewMetadataExtractorFromConf(conf *Conf) MetadataExtractor {
 			extracted[key] = &auditv1.MetaValues{Values: values}
 		}
 
+		for key, values := range ctxMD {
+			extracted[key] = values
+		}
+
 		if len(extracted) == 0 {
 			return nil
 		}
@@ -18,6 +18,7 @@ func TestMetadataExtractor(t *testing.T) {
 	testCases := []struct {
 		input       map[string]string
 		want        map[string]*auditv1.MetaValues
+		ctxMetadata map[string]*auditv1.MetaValues
 		name        string
 		includeKeys []string
 		excludeKeys []string
@@ -52,6 +53,24 @@ func TestMetadataExtractor(t *testing.T) {
 				"foo": {Values: []string{"a"}},
 			},
 		},
+		{
+			name:        "ContextMetadata",
+			ctxMetadata: map[string]*auditv1.MetaValues{"cerbos.api_key.id": {Values: []string{"app"}}},
+			input:       map[string]string{"foo": "a"},
+			want: map[string]*auditv1.MetaValues{
+				"cerbos.api_key.id": {Values: []string{"app"}},
+			},
+		},
+		{
+			name:        "ContextMetadataWithInclude",
+			includeKeys: []string{"foo"},
+			ctxMetadata: map[string]*auditv1.MetaValues{"cerbos.api_key.id": {Values: []string{"app"}}},
+			input:       map[string]string{"foo": "a", "bar": "b"},
+			want: map[string]*auditv1.MetaValues{
+				"foo":               {Values: []string{"a"}},
+				"cerbos.api_key.id": {Values: []string{"app"}},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -65,6 +84,9 @@ func TestMetadataExtractor(t *testing.T) {
 			}
 			me := NewMetadataExtractorFromConf(conf)
 			ctx := metadata.NewIncomingContext(context.Background(), metadata.New(tc.input))
+			if tc.ctxMetadata != nil {
+				ctx = ContextWithMetadata(ctx, tc.ctxMetadata)
+			}
 
 			have := me(ctx)
 			require.Len(t, have, len(tc.want))
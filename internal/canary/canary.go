@@ -0,0 +1,314 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package canary periodically evaluates a set of checks with known expected outcomes against the engine and raises an
+// alert when the outcome of a check changes, so that accidental policy regressions are noticed soon after they are deployed.
+package canary
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const (
+	requestIDPrefix = "canary:"
+
+	resultError = "error"
+	resultFail  = "fail"
+	resultPass  = "pass"
+
+	statusFailing = "failing"
+	statusPassing = "passing"
+)
+
+// Checker is the subset of the engine API used to evaluate the checks.
+type Checker interface {
+	Check(context.Context, []*enginev1.CheckInput, ...engine.CheckOpt) ([]*enginev1.CheckOutput, error)
+}
+
+// Notification is the body of the request sent to the webhook when the status of a check changes.
+type Notification struct {
+	Time       time.Time  `json:"time"`
+	Check      string     `json:"check"`
+	Status     string     `json:"status"`
+	Mismatches []Mismatch `json:"mismatches,omitempty"`
+}
+
+// Mismatch is an action with an effect that is different from the expected effect.
+type Mismatch struct {
+	Action   string `json:"action"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
+type check struct {
+	input    *enginev1.CheckInput
+	expected map[string]effectv1.Effect
+	name     string
+	failing  bool
+}
+
+type Canary struct {
+	checker    Checker
+	log        *zap.Logger
+	webhook    *WebhookConf
+	httpClient *http.Client
+	checks     []*check
+	interval   time.Duration
+}
+
+// Start evaluates the configured checks in the background until the context is cancelled.
+func Start(ctx context.Context, checker Checker) error {
+	conf := &Conf{}
+	if err := config.GetSection(conf); err != nil {
+		return err
+	}
+
+	if len(conf.Checks) == 0 {
+		return nil
+	}
+
+	c, err := New(conf, checker)
+	if err != nil {
+		return err
+	}
+
+	go c.run(ctx)
+	return nil
+}
+
+func New(conf *Conf, checker Checker) (*Canary, error) {
+	checks := make([]*check, len(conf.Checks))
+	for i, cc := range conf.Checks {
+		chk, err := newCheck(cc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid canary check %q: %w", cc.Name, err)
+		}
+		checks[i] = chk
+	}
+
+	c := &Canary{
+		checker:  checker,
+		log:      zap.L().Named("canary"),
+		webhook:  conf.Webhook,
+		checks:   checks,
+		interval: conf.Interval,
+	}
+
+	if c.webhook != nil {
+		c.httpClient = &http.Client{Timeout: c.webhook.Timeout}
+	}
+
+	return c, nil
+}
+
+func newCheck(cc *CheckConf) (*check, error) {
+	principalAttr, err := toAttr(cc.Principal.Attr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid principal attributes: %w", err)
+	}
+
+	resourceAttr, err := toAttr(cc.Resource.Attr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resource attributes: %w", err)
+	}
+
+	expected := make(map[string]effectv1.Effect, len(cc.Expected))
+	actions := make([]string, 0, len(cc.Expected))
+	for action, e := range cc.Expected {
+		effect, err := parseEffect(e)
+		if err != nil {
+			return nil, err
+		}
+		expected[action] = effect
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+
+	return &check{
+		name:     cc.Name,
+		expected: expected,
+		input: &enginev1.CheckInput{
+			RequestId: requestIDPrefix + cc.Name,
+			Principal: &enginev1.Principal{
+				Id:            cc.Principal.ID,
+				PolicyVersion: cc.Principal.PolicyVersion,
+				Scope:         cc.Principal.Scope,
+				Roles:         cc.Principal.Roles,
+				Attr:          principalAttr,
+			},
+			Resource: &enginev1.Resource{
+				Kind:          cc.Resource.Kind,
+				Id:            cc.Resource.ID,
+				PolicyVersion: cc.Resource.PolicyVersion,
+				Scope:         cc.Resource.Scope,
+				Attr:          resourceAttr,
+			},
+			Actions: actions,
+		},
+	}, nil
+}
+
+func toAttr(attr map[string]any) (map[string]*structpb.Value, error) {
+	if len(attr) == 0 {
+		return nil, nil
+	}
+
+	out := make(map[string]*structpb.Value, len(attr))
+	for k, v := range attr {
+		pbVal, err := util.ToStructPB(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %q: %w", k, err)
+		}
+		out[k] = pbVal
+	}
+
+	return out, nil
+}
+
+func (c *Canary) run(ctx context.Context) {
+	c.log.Info(fmt.Sprintf("Evaluating %d canary checks every %s", len(c.checks), c.interval))
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.Evaluate(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Evaluate runs all the checks once and sends notifications for the checks with a changed status.
+// Checks are assumed to be passing before they are evaluated for the first time.
+func (c *Canary) Evaluate(ctx context.Context) {
+	inputs := make([]*enginev1.CheckInput, len(c.checks))
+	for i, chk := range c.checks {
+		inputs[i] = chk.input
+	}
+
+	// canary checks are not made on behalf of their principals, so they must not use up the rule limits of the principals
+	outputs, err := c.checker.Check(ctx, inputs, engine.WithoutConsumingRuleLimits())
+	if err != nil || len(outputs) != len(c.checks) {
+		c.log.Warn("Failed to evaluate canary checks", zap.Error(err))
+		for _, chk := range c.checks {
+			recordCheck(chk.name, resultError)
+		}
+		return
+	}
+
+	for i, chk := range c.checks {
+		mismatches := chk.mismatches(outputs[i])
+		if len(mismatches) == 0 {
+			recordCheck(chk.name, resultPass)
+		} else {
+			recordCheck(chk.name, resultFail)
+		}
+
+		failing := len(mismatches) > 0
+		if failing == chk.failing {
+			continue
+		}
+		chk.failing = failing
+
+		n := Notification{Time: time.Now().UTC(), Check: chk.name, Status: statusPassing, Mismatches: mismatches}
+		if failing {
+			n.Status = statusFailing
+			c.log.Warn("Canary check is failing", zap.String("check", chk.name), zap.Any("mismatches", mismatches))
+		} else {
+			c.log.Info("Canary check is passing again", zap.String("check", chk.name))
+		}
+
+		recordFlip(chk.name, n.Status)
+		c.notify(ctx, n)
+	}
+}
+
+func (chk *check) mismatches(output *enginev1.CheckOutput) []Mismatch {
+	var mismatches []Mismatch
+	for _, action := range chk.input.Actions {
+		expected := chk.expected[action]
+		actual := output.GetActions()[action].GetEffect()
+		if actual != expected {
+			mismatches = append(mismatches, Mismatch{Action: action, Expected: expected.String(), Actual: actual.String()})
+		}
+	}
+
+	return mismatches
+}
+
+func (c *Canary) notify(ctx context.Context, n Notification) {
+	if c.webhook == nil {
+		return
+	}
+
+	if err := c.sendNotification(ctx, n); err != nil {
+		c.log.Warn("Failed to send canary notification", zap.String("check", n.Check), zap.Error(err))
+	}
+}
+
+func (c *Canary) sendNotification(ctx context.Context, n Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range c.webhook.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+func recordCheck(name, result string) {
+	_ = stats.RecordWithTags(context.Background(),
+		[]tag.Mutator{tag.Upsert(metrics.KeyCanaryCheck, name), tag.Upsert(metrics.KeyCanaryResult, result)},
+		metrics.CanaryCheckCount.M(1),
+	)
+}
+
+func recordFlip(name, status string) {
+	_ = stats.RecordWithTags(context.Background(),
+		[]tag.Mutator{tag.Upsert(metrics.KeyCanaryCheck, name), tag.Upsert(metrics.KeyCanaryStatus, status)},
+		metrics.CanaryFlipCount.M(1),
+	)
+}
@@ -0,0 +1,151 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package canary_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/canary"
+	"github.com/cerbos/cerbos/internal/engine"
+)
+
+func TestCanary(t *testing.T) {
+	checker := &fakeChecker{effects: map[string]effectv1.Effect{
+		"view":   effectv1.Effect_EFFECT_ALLOW,
+		"delete": effectv1.Effect_EFFECT_DENY,
+	}}
+
+	notifications := make(chan canary.Notification, 4)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		var n canary.Notification
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&n))
+		notifications <- n
+	}))
+	t.Cleanup(srv.Close)
+
+	conf := &canary.Conf{}
+	conf.SetDefaults()
+	conf.Webhook = &canary.WebhookConf{URL: srv.URL, Headers: map[string]string{"Authorization": "Bearer secret"}}
+	conf.Checks = []*canary.CheckConf{
+		{
+			Name:      "employee_view",
+			Principal: canary.PrincipalConf{ID: "canary_employee", Roles: []string{"employee"}, Attr: map[string]any{"department": "marketing"}},
+			Resource:  canary.ResourceConf{Kind: "leave_request", ID: "canary_leave_request"},
+			Expected:  map[string]string{"view": "EFFECT_ALLOW", "delete": "EFFECT_DENY"},
+		},
+	}
+	require.NoError(t, conf.Validate())
+
+	c, err := canary.New(conf, checker)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("passing", func(t *testing.T) {
+		c.Evaluate(ctx)
+		require.Len(t, checker.inputs, 1)
+		require.Equal(t, []string{"delete", "view"}, checker.inputs[0].Actions)
+		require.Equal(t, "marketing", checker.inputs[0].Principal.Attr["department"].GetStringValue())
+		require.Empty(t, notifications)
+	})
+
+	t.Run("flip_to_failing", func(t *testing.T) {
+		checker.effects["delete"] = effectv1.Effect_EFFECT_ALLOW
+		c.Evaluate(ctx)
+
+		require.Len(t, notifications, 1)
+		n := <-notifications
+		require.Equal(t, "employee_view", n.Check)
+		require.Equal(t, "failing", n.Status)
+		require.Equal(t, []canary.Mismatch{{Action: "delete", Expected: "EFFECT_DENY", Actual: "EFFECT_ALLOW"}}, n.Mismatches)
+	})
+
+	t.Run("still_failing", func(t *testing.T) {
+		c.Evaluate(ctx)
+		require.Empty(t, notifications)
+	})
+
+	t.Run("flip_to_passing", func(t *testing.T) {
+		checker.effects["delete"] = effectv1.Effect_EFFECT_DENY
+		c.Evaluate(ctx)
+
+		require.Len(t, notifications, 1)
+		n := <-notifications
+		require.Equal(t, "passing", n.Status)
+		require.Empty(t, n.Mismatches)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() *canary.CheckConf {
+		return &canary.CheckConf{
+			Name:      "check",
+			Principal: canary.PrincipalConf{ID: "p", Roles: []string{"user"}},
+			Resource:  canary.ResourceConf{Kind: "doc", ID: "d"},
+			Expected:  map[string]string{"view": "EFFECT_ALLOW"},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		modify  func(*canary.Conf)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(*canary.Conf) {}},
+		{name: "short_interval", modify: func(c *canary.Conf) { c.Interval = 1 }, wantErr: true},
+		{name: "missing_name", modify: func(c *canary.Conf) { c.Checks[0].Name = "" }, wantErr: true},
+		{name: "missing_roles", modify: func(c *canary.Conf) { c.Checks[0].Principal.Roles = nil }, wantErr: true},
+		{name: "missing_resource_id", modify: func(c *canary.Conf) { c.Checks[0].Resource.ID = "" }, wantErr: true},
+		{name: "invalid_effect", modify: func(c *canary.Conf) { c.Checks[0].Expected["view"] = "allow" }, wantErr: true},
+		{name: "duplicate_name", modify: func(c *canary.Conf) { c.Checks = append(c.Checks, valid()) }, wantErr: true},
+		{name: "missing_webhook_url", modify: func(c *canary.Conf) { c.Webhook = &canary.WebhookConf{} }, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			conf := &canary.Conf{}
+			conf.SetDefaults()
+			conf.Checks = []*canary.CheckConf{valid()}
+			tc.modify(conf)
+
+			err := conf.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type fakeChecker struct {
+	effects map[string]effectv1.Effect
+	inputs  []*enginev1.CheckInput
+}
+
+func (fc *fakeChecker) Check(_ context.Context, inputs []*enginev1.CheckInput, _ ...engine.CheckOpt) ([]*enginev1.CheckOutput, error) {
+	fc.inputs = inputs
+
+	outputs := make([]*enginev1.CheckOutput, len(inputs))
+	for i, input := range inputs {
+		actions := make(map[string]*enginev1.CheckOutput_ActionEffect, len(input.Actions))
+		for _, action := range input.Actions {
+			actions[action] = &enginev1.CheckOutput_ActionEffect{Effect: fc.effects[action]}
+		}
+		outputs[i] = &enginev1.CheckOutput{RequestId: input.RequestId, ResourceId: input.Resource.Id, Actions: actions}
+	}
+
+	return outputs, nil
+}
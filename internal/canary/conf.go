@@ -0,0 +1,164 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package canary
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.uber.org/multierr"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+)
+
+const (
+	confKey               = "canary"
+	defaultInterval       = 10 * time.Second
+	defaultWebhookTimeout = 5 * time.Second
+	minInterval           = 1 * time.Second
+)
+
+var (
+	errEmptyName       = errors.New("name must not be empty")
+	errEmptyPrincipal  = errors.New("principal.id and principal.roles must not be empty")
+	errEmptyResource   = errors.New("resource.kind and resource.id must not be empty")
+	errEmptyExpected   = errors.New("expected must contain at least one action")
+	errEmptyWebhookURL = errors.New("webhook.url must not be empty")
+)
+
+// Conf is optional configuration for canary checks.
+type Conf struct {
+	// Webhook is notified when the outcome of a check changes.
+	Webhook *WebhookConf `yaml:"webhook"`
+	// Checks is the list of checks to evaluate periodically. Canary checks are disabled if the list is empty.
+	Checks []*CheckConf `yaml:"checks"`
+	// Interval is the time between evaluations of the checks.
+	Interval time.Duration `yaml:"interval" conf:",example=10s"`
+}
+
+// CheckConf defines a principal, resource and the effects expected for a set of actions.
+type CheckConf struct {
+	// Expected is the expected effect (EFFECT_ALLOW or EFFECT_DENY) of each action.
+	Expected map[string]string `yaml:"expected" conf:"required,example={\"view\": \"EFFECT_ALLOW\", \"delete\": \"EFFECT_DENY\"}"`
+	// Principal is the principal to check access for.
+	Principal PrincipalConf `yaml:"principal"`
+	// Resource is the resource to check access to.
+	Resource ResourceConf `yaml:"resource"`
+	// Name identifies the check in logs, metrics and notifications.
+	Name string `yaml:"name" conf:"required,example=employee_can_view_own_leave_request"`
+}
+
+type PrincipalConf struct {
+	// Attr are the attributes of the principal.
+	Attr map[string]any `yaml:"attr" conf:",example={\"department\": \"marketing\"}"`
+	// ID of the principal.
+	ID string `yaml:"id" conf:"required,example=canary_employee"`
+	// PolicyVersion of the principal policies to use.
+	PolicyVersion string `yaml:"policyVersion" conf:",example=default"`
+	// Scope of the principal policies to use.
+	Scope string `yaml:"scope" conf:",example=acme"`
+	// Roles of the principal.
+	Roles []string `yaml:"roles" conf:"required,example=[\"employee\"]"`
+}
+
+type ResourceConf struct {
+	// Attr are the attributes of the resource.
+	Attr map[string]any `yaml:"attr" conf:",example={\"owner\": \"canary_employee\"}"`
+	// Kind of the resource.
+	Kind string `yaml:"kind" conf:"required,example=leave_request"`
+	// ID of the resource.
+	ID string `yaml:"id" conf:"required,example=canary_leave_request"`
+	// PolicyVersion of the resource policies to use.
+	PolicyVersion string `yaml:"policyVersion" conf:",example=default"`
+	// Scope of the resource policies to use.
+	Scope string `yaml:"scope" conf:",example=acme"`
+}
+
+// WebhookConf configures the HTTP endpoint that receives a POST request when the outcome of a check changes.
+type WebhookConf struct {
+	// Headers are added to each request.
+	Headers map[string]string `yaml:"headers" conf:",example={\"Authorization\": \"Bearer ${ALERTS_TOKEN}\"}"`
+	// URL of the endpoint.
+	URL string `yaml:"url" conf:"required,example=\"https://alerts.acme.com/hooks/cerbos\""`
+	// Timeout is the maximum duration of a single request to the endpoint.
+	Timeout time.Duration `yaml:"timeout" conf:",example=5s"`
+}
+
+func (c *Conf) Key() string {
+	return confKey
+}
+
+func (c *Conf) SetDefaults() {
+	c.Interval = defaultInterval
+}
+
+func (c *Conf) Validate() (outErr error) {
+	if c.Interval < minInterval {
+		outErr = multierr.Append(outErr, fmt.Errorf("interval must be at least %s", minInterval))
+	}
+
+	names := make(map[string]struct{}, len(c.Checks))
+	for i, check := range c.Checks {
+		if err := check.Validate(); err != nil {
+			outErr = multierr.Append(outErr, fmt.Errorf("invalid check #%d: %w", i, err))
+			continue
+		}
+
+		if _, ok := names[check.Name]; ok {
+			outErr = multierr.Append(outErr, fmt.Errorf("check %q is defined more than once", check.Name))
+		}
+		names[check.Name] = struct{}{}
+	}
+
+	if c.Webhook != nil {
+		if c.Webhook.Timeout <= 0 {
+			c.Webhook.Timeout = defaultWebhookTimeout
+		}
+
+		if c.Webhook.URL == "" {
+			outErr = multierr.Append(outErr, errEmptyWebhookURL)
+		} else if _, err := url.Parse(c.Webhook.URL); err != nil {
+			outErr = multierr.Append(outErr, fmt.Errorf("invalid webhook.url: %w", err))
+		}
+	}
+
+	return outErr
+}
+
+func (cc *CheckConf) Validate() (outErr error) {
+	if cc.Name == "" {
+		outErr = multierr.Append(outErr, errEmptyName)
+	}
+
+	if cc.Principal.ID == "" || len(cc.Principal.Roles) == 0 {
+		outErr = multierr.Append(outErr, errEmptyPrincipal)
+	}
+
+	if cc.Resource.Kind == "" || cc.Resource.ID == "" {
+		outErr = multierr.Append(outErr, errEmptyResource)
+	}
+
+	if len(cc.Expected) == 0 {
+		outErr = multierr.Append(outErr, errEmptyExpected)
+	}
+
+	for action, effect := range cc.Expected {
+		if _, err := parseEffect(effect); err != nil {
+			outErr = multierr.Append(outErr, fmt.Errorf("invalid effect for action %q: %w", action, err))
+		}
+	}
+
+	return outErr
+}
+
+func parseEffect(effect string) (effectv1.Effect, error) {
+	switch e := effectv1.Effect(effectv1.Effect_value[effect]); e {
+	case effectv1.Effect_EFFECT_ALLOW, effectv1.Effect_EFFECT_DENY:
+		return e, nil
+	default:
+		return effectv1.Effect_EFFECT_UNSPECIFIED, fmt.Errorf("%q is not one of EFFECT_ALLOW or EFFECT_DENY", effect)
+	}
+}
@@ -0,0 +1,350 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package compile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/common/types/ref"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/proto"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/conditions"
+	"github.com/cerbos/cerbos/internal/schema"
+)
+
+const (
+	attrTypeNamePrefix = "cerbos.schema."
+	// operandsFunction replaces the equality and membership operators while type checking, so that the types of their
+	// operands can be compared using the rules that apply at runtime.
+	operandsFunction = "@cerbos_operands"
+)
+
+var (
+	principalTypeName = string((&enginev1.Principal{}).ProtoReflect().Descriptor().FullName())
+	resourceTypeName  = string((&enginev1.Resource{}).ProtoReflect().Descriptor().FullName())
+
+	// Numbers are typed as doubles because that's how they are represented at runtime. Comparing them for equality
+	// with integers (or null) is allowed at runtime but not by the type checker, so the operands of these operators
+	// are checked separately by comparableTypes.
+	comparisonOperators = map[string]struct{}{operators.Equals: {}, operators.NotEquals: {}, operators.In: {}}
+	operandsDecl        = cel.Function(operandsFunction, cel.Overload("cerbos_operands_dyn_dyn", []*cel.Type{cel.DynType, cel.DynType}, cel.BoolType))
+)
+
+// attrTypeChecker checks that expressions use attributes in ways that are consistent with the types defined in the schemas.
+// A nil checker doesn't check anything.
+type attrTypeChecker struct {
+	types *schema.AttrTypes
+}
+
+func newAttrTypeChecker(ctx context.Context, rp *policyv1.ResourcePolicy, schemaMgr schema.Manager) (*attrTypeChecker, error) {
+	types, err := schemaMgr.AttrTypes(ctx, rp.Schemas, rp.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if types == nil {
+		return nil, nil
+	}
+
+	return &attrTypeChecker{types: types}, nil
+}
+
+// check returns the type errors found in the expression.
+func (c *attrTypeChecker) check(ast *cel.Ast) ([]string, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	pe, err := cel.AstToParsedExpr(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	pe = proto.Clone(pe).(*exprpb.ParsedExpr) //nolint:forcetypeassert
+	comparisons := make(map[int64]string)
+	replaceComparisons(pe.Expr, comparisons)
+
+	provider := &attrTypeProvider{
+		TypeProvider: conditions.StdEnv.TypeProvider(),
+		types:        c.types,
+		objects:      make(map[string]*schema.AttrType),
+		wholeValues:  make(map[string]struct{}),
+	}
+	findWholeValues(pe.Expr, false, provider.wholeValues)
+
+	env, err := conditions.StdEnv.Extend(cel.CustomTypeProvider(provider), operandsDecl)
+	if err != nil {
+		return nil, err
+	}
+
+	checked, issues := env.Check(cel.ParsedExprToAstWithSource(pe, ast.Source()))
+	if issues != nil && issues.Err() != nil {
+		errs := make([]string, len(issues.Errors()))
+		for i, e := range issues.Errors() {
+			errs[i] = e.Message
+		}
+		return errs, nil
+	}
+
+	checkedExpr, err := cel.AstToCheckedExpr(checked)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []string
+	findIncomparableOperands(checkedExpr.Expr, checkedExpr.TypeMap, comparisons, &errs)
+	return errs, nil
+}
+
+// replaceComparisons replaces the calls to the comparison operators with calls to the operands function,
+// recording the operator that was replaced by the ID of the call.
+func replaceComparisons(e *exprpb.Expr, comparisons map[int64]string) {
+	if e == nil {
+		return
+	}
+
+	if call := e.GetCallExpr(); call != nil && call.Target == nil && len(call.Args) == 2 {
+		if _, ok := comparisonOperators[call.Function]; ok {
+			comparisons[e.Id] = call.Function
+			call.Function = operandsFunction
+		}
+	}
+
+	for _, child := range exprChildren(e) {
+		replaceComparisons(child, comparisons)
+	}
+}
+
+// findIncomparableOperands reports the comparisons whose operands can never be equal at runtime because of their types.
+func findIncomparableOperands(e *exprpb.Expr, typeMap map[int64]*exprpb.Type, comparisons map[int64]string, errs *[]string) {
+	if e == nil {
+		return
+	}
+
+	if op, ok := comparisons[e.Id]; ok {
+		args := e.GetCallExpr().Args
+		lhs, rhs := typeMap[args[0].Id], typeMap[args[1].Id]
+
+		compatible := comparableTypes(lhs, rhs)
+		if op == operators.In {
+			compatible = canContain(rhs, lhs)
+		}
+
+		if !compatible {
+			*errs = append(*errs, fmt.Sprintf("found no matching overload for '%s' applied to '(%s, %s)'", op, checker.FormatCheckedType(lhs), checker.FormatCheckedType(rhs)))
+		}
+	}
+
+	for _, child := range exprChildren(e) {
+		findIncomparableOperands(child, typeMap, comparisons, errs)
+	}
+}
+
+// comparableTypes returns true if values of the given types can be equal at runtime.
+// Numbers of different types are compared by value, and any value can be compared with null.
+func comparableTypes(a, b *exprpb.Type) bool {
+	a, b = unwrapType(a), unwrapType(b)
+
+	switch {
+	case isAnyType(a) || isAnyType(b):
+		return true
+	case isNumberType(a) && isNumberType(b):
+		return true
+	case a.GetListType() != nil && b.GetListType() != nil:
+		return comparableTypes(a.GetListType().ElemType, b.GetListType().ElemType)
+	case a.GetMapType() != nil && b.GetMapType() != nil:
+		return comparableTypes(a.GetMapType().KeyType, b.GetMapType().KeyType) && comparableTypes(a.GetMapType().ValueType, b.GetMapType().ValueType)
+	default:
+		return proto.Equal(a, b)
+	}
+}
+
+// canContain returns true if a value of the elem type can be found in a container of the given type.
+func canContain(container, elem *exprpb.Type) bool {
+	container = unwrapType(container)
+
+	switch {
+	case isAnyType(container):
+		return true
+	case container.GetListType() != nil:
+		return comparableTypes(container.GetListType().ElemType, elem)
+	case container.GetMapType() != nil:
+		return comparableTypes(container.GetMapType().KeyType, elem)
+	default:
+		return false
+	}
+}
+
+func unwrapType(t *exprpb.Type) *exprpb.Type {
+	if w, ok := t.GetTypeKind().(*exprpb.Type_Wrapper); ok {
+		return decls.NewPrimitiveType(w.Wrapper)
+	}
+
+	return t
+}
+
+// isAnyType returns true if the type could be the type of any value, including null.
+func isAnyType(t *exprpb.Type) bool {
+	switch t.GetTypeKind().(type) {
+	case nil, *exprpb.Type_Dyn, *exprpb.Type_Null, *exprpb.Type_TypeParam:
+		return true
+	default:
+		return false
+	}
+}
+
+func isNumberType(t *exprpb.Type) bool {
+	switch t.GetPrimitive() {
+	case exprpb.Type_INT64, exprpb.Type_UINT64, exprpb.Type_DOUBLE:
+		return true
+	default:
+		return false
+	}
+}
+
+// attrTypeProvider replaces the types of the principal and resource attributes with the types defined in the schemas.
+// Objects with known properties are represented as message types named after their path (e.g. `cerbos.schema.resource.attr.address`).
+type attrTypeProvider struct {
+	ref.TypeProvider
+	types   *schema.AttrTypes
+	objects map[string]*schema.AttrType
+	// wholeValues are the paths of the attributes that the expression uses as values rather than just selecting fields from.
+	// They are typed as maps because message types can't be indexed or iterated over.
+	wholeValues map[string]struct{}
+}
+
+func (p *attrTypeProvider) FindType(typeName string) (*exprpb.Type, bool) {
+	if _, ok := p.objects[typeName]; ok {
+		return decls.NewTypeType(decls.NewObjectType(typeName)), true
+	}
+
+	return p.TypeProvider.FindType(typeName)
+}
+
+func (p *attrTypeProvider) FindFieldType(messageType, fieldName string) (*ref.FieldType, bool) {
+	switch {
+	case messageType == principalTypeName && fieldName == conditions.CELAttrField && p.types.Principal != nil:
+		return &ref.FieldType{Type: p.celType(conditions.CELPrincipalField+"."+conditions.CELAttrField, p.types.Principal)}, true
+	case messageType == resourceTypeName && fieldName == conditions.CELAttrField && p.types.Resource != nil:
+		return &ref.FieldType{Type: p.celType(conditions.CELResourceField+"."+conditions.CELAttrField, p.types.Resource)}, true
+	}
+
+	if t, ok := p.objects[messageType]; ok {
+		prop, ok := t.Properties[fieldName]
+		if !ok {
+			if t.Closed {
+				return nil, false
+			}
+			return &ref.FieldType{Type: decls.Dyn}, true
+		}
+
+		return &ref.FieldType{Type: p.celType(strings.TrimPrefix(messageType, attrTypeNamePrefix)+"."+fieldName, prop)}, true
+	}
+
+	return p.TypeProvider.FindFieldType(messageType, fieldName)
+}
+
+func (p *attrTypeProvider) celType(path string, t *schema.AttrType) *exprpb.Type {
+	switch t.Kind {
+	case schema.AttrKindBool:
+		return decls.Bool
+	case schema.AttrKindNumber:
+		return decls.Double
+	case schema.AttrKindString:
+		return decls.String
+	case schema.AttrKindArray:
+		// elements of arrays can be used in comprehensions without a path to identify them, so objects are not typed
+		if t.Items == nil || t.Items.Kind == schema.AttrKindObject {
+			return decls.NewListType(decls.Dyn)
+		}
+		return decls.NewListType(p.celType(path, t.Items))
+	case schema.AttrKindObject:
+		if _, ok := p.wholeValues[path]; ok || len(t.Properties) == 0 {
+			return decls.NewMapType(decls.String, decls.Dyn)
+		}
+
+		typeName := attrTypeNamePrefix + path
+		p.objects[typeName] = t
+		return decls.NewObjectType(typeName)
+	default:
+		return decls.Dyn
+	}
+}
+
+// findWholeValues records the paths of the attributes that are used other than as the operand of a field selection.
+func findWholeValues(e *exprpb.Expr, isOperand bool, paths map[string]struct{}) {
+	if e == nil {
+		return
+	}
+
+	switch ek := e.ExprKind.(type) {
+	case *exprpb.Expr_SelectExpr:
+		if path, ok := attrPath(e); ok && !isOperand && !ek.SelectExpr.TestOnly {
+			paths[path] = struct{}{}
+		}
+		findWholeValues(ek.SelectExpr.Operand, true, paths)
+	case *exprpb.Expr_CallExpr:
+		findWholeValues(ek.CallExpr.Target, false, paths)
+		for _, arg := range ek.CallExpr.Args {
+			findWholeValues(arg, false, paths)
+		}
+	case *exprpb.Expr_ListExpr:
+		for _, elem := range ek.ListExpr.Elements {
+			findWholeValues(elem, false, paths)
+		}
+	case *exprpb.Expr_StructExpr:
+		for _, entry := range ek.StructExpr.Entries {
+			findWholeValues(entry.GetMapKey(), false, paths)
+			findWholeValues(entry.Value, false, paths)
+		}
+	case *exprpb.Expr_ComprehensionExpr:
+		ce := ek.ComprehensionExpr
+		for _, child := range []*exprpb.Expr{ce.IterRange, ce.AccuInit, ce.LoopCondition, ce.LoopStep, ce.Result} {
+			findWholeValues(child, false, paths)
+		}
+	}
+}
+
+// attrPath returns the path of the attribute selected by the expression. For example, `principal.attr.address.city`
+// for `P.attr.address.city` or `request.principal.attr.address.city`.
+func attrPath(e *exprpb.Expr) (string, bool) {
+	var fields []string
+	for {
+		sel := e.GetSelectExpr()
+		if sel == nil {
+			break
+		}
+		fields = append(fields, sel.Field)
+		e = sel.Operand
+	}
+
+	for i, j := 0, len(fields)-1; i < j; i, j = i+1, j-1 {
+		fields[i], fields[j] = fields[j], fields[i]
+	}
+
+	switch e.GetIdentExpr().GetName() {
+	case conditions.CELPrincipalAbbrev:
+		fields = append([]string{conditions.CELPrincipalField}, fields...)
+	case conditions.CELResourceAbbrev:
+		fields = append([]string{conditions.CELResourceField}, fields...)
+	case conditions.CELRequestIdent:
+	default:
+		return "", false
+	}
+
+	if len(fields) < 2 || (fields[0] != conditions.CELPrincipalField && fields[0] != conditions.CELResourceField) || fields[1] != conditions.CELAttrField {
+		return "", false
+	}
+
+	return strings.Join(fields, "."), true
+}
@@ -4,12 +4,15 @@
 package compile
 
 import (
-	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
 	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
 	"github.com/cerbos/cerbos/internal/namer"
@@ -21,11 +24,11 @@ const AnyRoleVal = "*"
 
 var emptyVal = &emptypb.Empty{}
 
-func BatchCompile(queue <-chan *policy.CompilationUnit, schemaMgr schema.Manager) error {
+func BatchCompile(queue <-chan *policy.CompilationUnit, schemaMgr schema.Manager, opts ...Option) error {
 	errs := newErrorList()
 
 	for unit := range queue {
-		if _, err := Compile(unit, schemaMgr); err != nil {
+		if _, err := Compile(unit, schemaMgr, opts...); err != nil {
 			errs.Add(err)
 		}
 	}
@@ -33,8 +36,8 @@ func BatchCompile(queue <-chan *policy.CompilationUnit, schemaMgr schema.Manager
 	return errs.ErrOrNil()
 }
 
-func Compile(unit *policy.CompilationUnit, schemaMgr schema.Manager) (rps *runtimev1.RunnablePolicySet, err error) {
-	uc := newUnitCtx(unit)
+func Compile(unit *policy.CompilationUnit, schemaMgr schema.Manager, opts ...Option) (rps *runtimev1.RunnablePolicySet, err error) {
+	uc := newUnitCtx(unit, opts...)
 	mc := uc.moduleCtx(unit.ModID)
 
 	if mc == nil || mc.def == nil {
@@ -47,8 +50,10 @@ func Compile(unit *policy.CompilationUnit, schemaMgr schema.Manager) (rps *runti
 	case *policyv1.Policy_PrincipalPolicy:
 		rps = compilePrincipalPolicySet(mc)
 	case *policyv1.Policy_DerivedRoles:
+		checkNoActivePeriod(mc)
 		rps = compileDerivedRoles(mc)
 	case *policyv1.Policy_ExportVariables:
+		checkNoActivePeriod(mc)
 		rps = compileExportVariables(mc)
 	default:
 		mc.addErrWithDesc(fmt.Errorf("unknown policy type %T", pt), "Unexpected error")
@@ -117,21 +122,39 @@ func compileResourcePolicy(modCtx *moduleCtx, schemaMgr schema.Manager) *runtime
 		return nil
 	}
 
-	referencedRoles, err := compileImportedDerivedRoles(modCtx, rp)
+	// the schemas must be loaded before the conditions are compiled because they are type checked against the attribute types
+	if err := checkReferencedSchemas(modCtx, rp, schemaMgr); err != nil {
+		return nil
+	}
+
+	attrTypes, err := newAttrTypeChecker(modCtx.ctx, rp, schemaMgr)
 	if err != nil {
+		modCtx.addErrWithDesc(errInvalidSchema, "Failed to load attribute types: %v", err)
 		return nil
 	}
+	modCtx.attrTypes = attrTypes
 
-	if err := checkReferencedSchemas(modCtx, rp, schemaMgr); err != nil {
+	variables := compileAllVariables(modCtx, rp.Variables)
+
+	referencedRoles, err := compileImportedDerivedRoles(modCtx, rp, variables)
+	if err != nil {
 		return nil
 	}
 
+	if !checkNumRules(modCtx, len(rp.Rules)) {
+		return nil
+	}
+
+	activeFrom, activeUntil := compileActivePeriod(modCtx)
+
 	rrp := &runtimev1.RunnableResourcePolicySet_Policy{
 		DerivedRoles: referencedRoles,
 		Scope:        rp.Scope,
 		Rules:        make([]*runtimev1.RunnableResourcePolicySet_Policy_Rule, len(rp.Rules)),
-		Variables:    compileAllVariables(modCtx, rp.Variables),
+		Variables:    variables,
 		Schemas:      rp.Schemas,
+		ActiveFrom:   activeFrom,
+		ActiveUntil:  activeUntil,
 	}
 
 	for i, rule := range rp.Rules {
@@ -147,15 +170,29 @@ func compileResourcePolicy(modCtx *moduleCtx, schemaMgr schema.Manager) *runtime
 	return rrp
 }
 
-func compileImportedDerivedRoles(modCtx *moduleCtx, rp *policyv1.ResourcePolicy) (map[string]*runtimev1.RunnableDerivedRole, error) {
+func compileImportedDerivedRoles(modCtx *moduleCtx, rp *policyv1.ResourcePolicy, variables map[string]*runtimev1.Expr) (map[string]*runtimev1.RunnableDerivedRole, error) {
 	type derivedRoleInfo struct {
 		compiledRoles *runtimev1.RunnableDerivedRolesSet
-		importName    string
+		importName    string // empty for derived roles defined locally in the resource policy
 		sourceFile    string
 	}
 
 	roleImports := make(map[string][]derivedRoleInfo)
 
+	if len(rp.LocalDerivedRoles) > 0 {
+		localRoles := &runtimev1.RunnableDerivedRolesSet{
+			Meta:         &runtimev1.RunnableDerivedRolesSet_Metadata{Fqn: modCtx.fqn},
+			DerivedRoles: compileRoleDefs(modCtx, rp.LocalDerivedRoles, variables),
+		}
+
+		for name := range localRoles.DerivedRoles {
+			roleImports[name] = append(roleImports[name], derivedRoleInfo{
+				sourceFile:    modCtx.sourceFile,
+				compiledRoles: localRoles,
+			})
+		}
+	}
+
 	for _, imp := range rp.ImportDerivedRoles {
 		impID := namer.GenModuleIDFromFQN(namer.DerivedRolesFQN(imp))
 
@@ -179,6 +216,18 @@ func compileImportedDerivedRoles(modCtx *moduleCtx, rp *policyv1.ResourcePolicy)
 		}
 	}
 
+	describe := func(imp []derivedRoleInfo) string {
+		rdList := make([]string, len(imp))
+		for i, dri := range imp {
+			if dri.importName == "" {
+				rdList[i] = fmt.Sprintf("%s (local definition)", dri.sourceFile)
+				continue
+			}
+			rdList[i] = fmt.Sprintf("%s (imported as '%s')", dri.sourceFile, dri.importName)
+		}
+		return strings.Join(rdList, ",")
+	}
+
 	referencedRoles := make(map[string]*runtimev1.RunnableDerivedRole)
 
 	// used to dedupe error messages
@@ -198,15 +247,24 @@ func compileImportedDerivedRoles(modCtx *moduleCtx, rp *policyv1.ResourcePolicy)
 					continue
 				}
 
-				rdList := make([]string, len(imp))
-				for i, dri := range imp {
-					rdList[i] = fmt.Sprintf("%s (imported as '%s')", dri.sourceFile, dri.importName)
-				}
-				ambiguousRoles[r] = strings.Join(rdList, ",")
+				ambiguousRoles[r] = describe(imp)
 				continue
 			}
 
-			referencedRoles[r] = imp[0].compiledRoles.DerivedRoles[r]
+			addReferencedRole(referencedRoles, imp[0].compiledRoles, r)
+		}
+	}
+
+	// derived roles that other derived roles build on must be unambiguous as well
+	for _, rdr := range referencedRoles {
+		for pdr := range rdr.ParentDerivedRoles {
+			if imp := roleImports[pdr]; len(imp) > 1 {
+				if _, ok := ambiguousRoles[pdr]; ok {
+					continue
+				}
+
+				ambiguousRoles[pdr] = describe(imp)
+			}
 		}
 	}
 
@@ -221,6 +279,23 @@ func compileImportedDerivedRoles(modCtx *moduleCtx, rp *policyv1.ResourcePolicy)
 	return referencedRoles, modCtx.error()
 }
 
+// addReferencedRole adds the named derived role and all the derived roles it builds on to the referenced set.
+func addReferencedRole(referenced map[string]*runtimev1.RunnableDerivedRole, compiledRoles *runtimev1.RunnableDerivedRolesSet, name string) {
+	if _, ok := referenced[name]; ok {
+		return
+	}
+
+	rdr, ok := compiledRoles.DerivedRoles[name]
+	if !ok {
+		return
+	}
+
+	referenced[name] = rdr
+	for pdr := range rdr.ParentDerivedRoles {
+		addReferencedRole(referenced, compiledRoles, pdr)
+	}
+}
+
 func compileDerivedRoles(modCtx *moduleCtx) *runtimev1.RunnablePolicySet {
 	return &runtimev1.RunnablePolicySet{
 		Fqn: modCtx.fqn,
@@ -238,16 +313,23 @@ func doCompileDerivedRoles(modCtx *moduleCtx) *runtimev1.RunnableDerivedRolesSet
 	}
 
 	// TODO(cell) Because derived roles can be imported many times, cache the result to avoid repeating the work
-	compiled := &runtimev1.RunnableDerivedRolesSet{
+	return &runtimev1.RunnableDerivedRolesSet{
 		Meta: &runtimev1.RunnableDerivedRolesSet_Metadata{
 			Fqn: modCtx.fqn,
 		},
-		DerivedRoles: make(map[string]*runtimev1.RunnableDerivedRole, len(dr.Definitions)),
+		DerivedRoles: compileRoleDefs(modCtx, dr.Definitions, compileAllVariables(modCtx, dr.Variables)),
 	}
+}
+
+func compileRoleDefs(modCtx *moduleCtx, defs []*policyv1.RoleDef, variables map[string]*runtimev1.Expr) map[string]*runtimev1.RunnableDerivedRole {
+	compiled := make(map[string]*runtimev1.RunnableDerivedRole, len(defs))
 
-	variables := compileAllVariables(modCtx, dr.Variables)
+	defined := make(map[string]struct{}, len(defs))
+	for _, def := range defs {
+		defined[def.Name] = struct{}{}
+	}
 
-	for i, def := range dr.Definitions {
+	for i, def := range defs {
 		rdr := &runtimev1.RunnableDerivedRole{
 			Name:        def.Name,
 			ParentRoles: make(map[string]*emptypb.Empty, len(def.ParentRoles)),
@@ -257,31 +339,84 @@ func doCompileDerivedRoles(modCtx *moduleCtx) *runtimev1.RunnableDerivedRolesSet
 		for _, pr := range def.ParentRoles {
 			if pr == AnyRoleVal {
 				rdr.ParentRoles = map[string]*emptypb.Empty{AnyRoleVal: {}}
+				rdr.ParentDerivedRoles = nil
 				break
 			}
+
+			// a parent role that names another derived role in this set refers to that derived role
+			if _, ok := defined[pr]; ok && pr != def.Name {
+				if rdr.ParentDerivedRoles == nil {
+					rdr.ParentDerivedRoles = make(map[string]*emptypb.Empty)
+				}
+				rdr.ParentDerivedRoles[pr] = emptyVal
+				continue
+			}
+
 			rdr.ParentRoles[pr] = emptyVal
 		}
 
 		rdr.Condition = compileCondition(modCtx, fmt.Sprintf("derived role '%s' (#%d)", def.Name, i), def.Condition)
-		compiled.DerivedRoles[def.Name] = rdr
+		compiled[def.Name] = rdr
 	}
 
+	checkDerivedRoleCycles(modCtx, defs, compiled)
+
 	return compiled
 }
 
+func checkDerivedRoleCycles(modCtx *moduleCtx, defs []*policyv1.RoleDef, roles map[string]*runtimev1.RunnableDerivedRole) {
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	state := make(map[string]int, len(roles))
+	var visit func(string, []string) bool
+	visit = func(name string, path []string) bool {
+		switch state[name] {
+		case visiting:
+			modCtx.addErrWithDesc(errCyclicalDerivedRoles, "Derived role '%s' has a cyclical dependency: [%s]", name, strings.Join(append(path, name), " -> "))
+			return false
+		case visited:
+			return true
+		}
+
+		state[name] = visiting
+		parents := make([]string, 0, len(roles[name].GetParentDerivedRoles()))
+		for pdr := range roles[name].GetParentDerivedRoles() {
+			parents = append(parents, pdr)
+		}
+		sort.Strings(parents)
+
+		for _, pdr := range parents {
+			if !visit(pdr, append(path, name)) {
+				return false
+			}
+		}
+		state[name] = visited
+		return true
+	}
+
+	for _, def := range defs {
+		if state[def.Name] == 0 && !visit(def.Name, nil) {
+			return
+		}
+	}
+}
+
 func checkReferencedSchemas(modCtx *moduleCtx, rp *policyv1.ResourcePolicy, schemaMgr schema.Manager) error {
 	if rp.Schemas == nil {
 		return nil
 	}
 
 	if ps := rp.Schemas.PrincipalSchema; ps != nil && ps.Ref != "" {
-		if err := schemaMgr.CheckSchema(context.TODO(), ps.Ref); err != nil {
+		if err := schemaMgr.CheckSchema(modCtx.ctx, ps.Ref); err != nil {
 			modCtx.addErrWithDesc(errInvalidSchema, "Failed to load principal schema %q: %v", ps.Ref, err)
 		}
 	}
 
 	if rs := rp.Schemas.ResourceSchema; rs != nil && rs.Ref != "" {
-		if err := schemaMgr.CheckSchema(context.TODO(), rs.Ref); err != nil {
+		if err := schemaMgr.CheckSchema(modCtx.ctx, rs.Ref); err != nil {
 			modCtx.addErrWithDesc(errInvalidSchema, "Failed to load resource schema %q: %v", rs.Ref, err)
 		}
 	}
@@ -333,6 +468,13 @@ func compileResourceRule(modCtx *moduleCtx, rule *policyv1.ResourceRule) *runtim
 		}
 	}
 
+	if rule.Limit != nil {
+		if rule.Effect != effectv1.Effect_EFFECT_ALLOW {
+			modCtx.addErrWithDesc(errInvalidResourceRule, "Rule '%s' has a limit but limits can only be applied to rules with the ALLOW effect", rule.Name)
+		}
+		cr.Limit = rule.Limit
+	}
+
 	return cr
 }
 
@@ -381,10 +523,23 @@ func compilePrincipalPolicy(modCtx *moduleCtx) *runtimev1.RunnablePrincipalPolic
 		return nil
 	}
 
+	numRules := 0
+	for _, rule := range pp.Rules {
+		numRules += len(rule.Actions)
+	}
+
+	if !checkNumRules(modCtx, numRules) {
+		return nil
+	}
+
+	activeFrom, activeUntil := compileActivePeriod(modCtx)
+
 	rpp := &runtimev1.RunnablePrincipalPolicySet_Policy{
 		Scope:         pp.Scope,
 		ResourceRules: make(map[string]*runtimev1.RunnablePrincipalPolicySet_Policy_ResourceRules, len(pp.Rules)),
 		Variables:     compileAllVariables(modCtx, pp.Variables),
+		ActiveFrom:    activeFrom,
+		ActiveUntil:   activeUntil,
 	}
 
 	for _, rule := range pp.Rules {
@@ -419,6 +574,27 @@ func compilePrincipalPolicy(modCtx *moduleCtx) *runtimev1.RunnablePrincipalPolic
 	return rpp
 }
 
+// compileActivePeriod returns the bounds of the period during which the policy is in effect.
+func compileActivePeriod(modCtx *moduleCtx) (activeFrom, activeUntil *timestamppb.Timestamp) {
+	meta := modCtx.def.GetMetadata()
+	activeFrom, activeUntil = meta.GetActiveFrom(), meta.GetActiveUntil()
+
+	if activeFrom != nil && activeUntil != nil && !activeUntil.AsTime().After(activeFrom.AsTime()) {
+		modCtx.addErrWithDesc(errInvalidActivePeriod, "Policy is active until %s, which is not after the time it becomes active (%s)",
+			activeUntil.AsTime().Format(time.RFC3339), activeFrom.AsTime().Format(time.RFC3339))
+	}
+
+	return activeFrom, activeUntil
+}
+
+// checkNoActivePeriod reports an error if an active period is defined for a policy that is never evaluated on its own.
+func checkNoActivePeriod(modCtx *moduleCtx) {
+	meta := modCtx.def.GetMetadata()
+	if meta.GetActiveFrom() != nil || meta.GetActiveUntil() != nil {
+		modCtx.addErrWithDesc(errInvalidActivePeriod, "activeFrom and activeUntil can only be set on resource and principal policies")
+	}
+}
+
 func compileExportVariables(modCtx *moduleCtx) *runtimev1.RunnablePolicySet {
 	ev := modCtx.def.GetExportVariables()
 	if ev == nil {
@@ -426,6 +602,8 @@ func compileExportVariables(modCtx *moduleCtx) *runtimev1.RunnablePolicySet {
 		return nil
 	}
 
+	checkNumVariables(modCtx, len(ev.Definitions))
+
 	return &runtimev1.RunnablePolicySet{
 		Fqn: modCtx.fqn,
 		PolicySet: &runtimev1.RunnablePolicySet_Variables{
@@ -480,6 +658,8 @@ func compileAllVariables(modCtx *moduleCtx, variables *policyv1.Variables) map[s
 		modCtx.addErrWithDesc(errVariableRedefined, "Variable '%s' has multiple definitions in %s", name, definedInMsg)
 	}
 
+	checkNumVariables(modCtx, len(results))
+
 	return results
 }
 
@@ -490,6 +670,21 @@ func addVariables(modCtx *moduleCtx, results map[string]*runtimev1.Expr, sources
 	}
 }
 
+func checkNumRules(modCtx *moduleCtx, numRules int) bool {
+	if limit := modCtx.limits.MaxRulesPerPolicy; limit > 0 && uint(numRules) > limit {
+		modCtx.addErrWithDesc(errLimitExceeded, "Policy has %d rules, which exceeds the maximum of %d rules per policy", numRules, limit)
+		return false
+	}
+
+	return true
+}
+
+func checkNumVariables(modCtx *moduleCtx, numVariables int) {
+	if limit := modCtx.limits.MaxVariablesPerPolicy; limit > 0 && uint(numVariables) > limit {
+		modCtx.addErrWithDesc(errLimitExceeded, "Policy has %d variables, which exceeds the maximum of %d variables per policy", numVariables, limit)
+	}
+}
+
 func reportMissingAncestors(modCtx *moduleCtx) {
 	required := policy.RequiredAncestors(modCtx.def)
 	defs := modCtx.unit.Definitions
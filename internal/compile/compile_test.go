@@ -21,6 +21,7 @@ import (
 	"google.golang.org/protobuf/testing/protocmp"
 
 	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	privatev1 "github.com/cerbos/cerbos/api/genpb/cerbos/private/v1"
 	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
 	"github.com/cerbos/cerbos/internal/compile"
@@ -73,6 +74,72 @@ func TestCompile(t *testing.T) {
 	}
 }
 
+func TestCompileLimits(t *testing.T) {
+	mkUnit := func() *policy.CompilationUnit {
+		rp := test.NewResourcePolicyBuilder("leave_request", "default").
+			WithRules(
+				test.NewResourceRule("view").WithRoles("user").Build(),
+				test.NewResourceRule("edit").WithRoles("user").WithMatchExpr("R.attr.a == 1 && (R.attr.b == 2 || R.attr.c == 3)").Build(),
+				test.NewResourceRule("delete").WithRoles("admin").Build(),
+			).Build()
+		rp.GetResourcePolicy().Variables = &policyv1.Variables{
+			Local: map[string]string{"is_a": "R.attr.a == 1", "is_b": "R.attr.b == 2"},
+		}
+
+		cu := &policy.CompilationUnit{}
+		cu.ModID = namer.GenModuleID(rp)
+		cu.AddDefinition(cu.ModID, rp)
+
+		return cu
+	}
+
+	testCases := []struct {
+		name     string
+		limits   compile.Limits
+		wantDesc string
+	}{
+		{
+			name: "no_limits",
+		},
+		{
+			name:   "within_limits",
+			limits: compile.Limits{MaxRulesPerPolicy: 3, MaxConditionDepth: 6, MaxVariablesPerPolicy: 2},
+		},
+		{
+			name:     "too_many_rules",
+			limits:   compile.Limits{MaxRulesPerPolicy: 2},
+			wantDesc: "Policy has 3 rules, which exceeds the maximum of 2 rules per policy",
+		},
+		{
+			name:     "condition_too_deep",
+			limits:   compile.Limits{MaxConditionDepth: 5},
+			wantDesc: "Expression in resource rule 'rule-002' has a depth of 6, which exceeds the maximum depth of 5",
+		},
+		{
+			name:     "too_many_variables",
+			limits:   compile.Limits{MaxVariablesPerPolicy: 1},
+			wantDesc: "Policy has 2 variables, which exceeds the maximum of 1 variables per policy",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := compile.Compile(mkUnit(), schema.NewNopManager(), compile.WithLimits(tc.limits))
+			if tc.wantDesc == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			errList := new(compile.ErrorList)
+			require.ErrorAs(t, err, &errList)
+			require.Len(t, errList.Errors, 1)
+			require.Equal(t, "compile limit exceeded", errList.Errors[0].Error)
+			require.Equal(t, tc.wantDesc, errList.Errors[0].Description)
+		})
+	}
+}
+
 func updateGoldenFiles(t *testing.T, schemaMgr schema.Manager, testCases []test.Case) {
 	t.Helper()
 
@@ -241,3 +308,95 @@ func mkRandomRoleNames(n int) []string {
 
 	return roles
 }
+
+func TestFindDeprecations(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy string
+		want   []compile.Deprecation
+	}{
+		{
+			name: "resource_policy",
+			policy: `---
+apiVersion: api.cerbos.dev/v1
+variables:
+  is_owner: R.attr.owner == P.id
+resourcePolicy:
+  resource: leave_request
+  version: default
+  variables:
+    local:
+      in_team: has_intersection(P.attr.teams, R.attr.teams)
+  rules:
+    - name: view
+      actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+      condition:
+        match:
+          all:
+            of:
+              - expr: V.is_owner
+              - expr: is_subset(R.attr.tags, P.attr.tags)
+    - name: edit
+      actions: ["edit"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+      condition:
+        match:
+          expr: P.attr.teams.hasIntersection(R.attr.teams)
+`,
+			want: []compile.Deprecation{
+				{File: "policy.yaml", Location: "top-level variables", Feature: "Top-level `variables` field", Replacement: "the `variables.local` section of the policy"},
+				{File: "policy.yaml", Location: "variable `in_team` in policy local variables", Feature: "Function `has_intersection`", Replacement: "`hasIntersection`"},
+				{File: "policy.yaml", Location: "resource rule 'view' (#1)", Feature: "Function `is_subset`", Replacement: "`isSubset`"},
+			},
+		},
+		{
+			name: "principal_policy",
+			policy: `---
+apiVersion: api.cerbos.dev/v1
+principalPolicy:
+  principal: donald_duck
+  version: default
+  rules:
+    - resource: leave_request
+      actions:
+        - name: view
+          action: view
+          effect: EFFECT_ALLOW
+          condition:
+            match:
+              expr: R.attr.tags.exists(t, is_subset([t], P.attr.tags))
+`,
+			want: []compile.Deprecation{
+				{File: "policy.yaml", Location: "rule 'view' (#1) of resource 'leave_request'", Feature: "Function `is_subset`", Replacement: "`isSubset`"},
+			},
+		},
+		{
+			name: "no_deprecations",
+			policy: `---
+apiVersion: api.cerbos.dev/v1
+derivedRoles:
+  name: common_roles
+  definitions:
+    - name: owner
+      parentRoles: ["user"]
+      condition:
+        match:
+          expr: R.attr.owner == P.id
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := policy.ReadPolicy(bytes.NewBufferString(tc.policy))
+			require.NoError(t, err)
+
+			have := compile.FindDeprecations(policy.WithMetadata(p, "policy.yaml", nil, "policy.yaml"))
+			require.Equal(t, tc.want, have)
+		})
+	}
+}
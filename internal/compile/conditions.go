@@ -5,6 +5,7 @@ package compile
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/google/cel-go/cel"
 	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
@@ -85,9 +86,64 @@ func compileCELExpr(modCtx *moduleCtx, parent, expr string) *exprpb.CheckedExpr
 		return nil
 	}
 
+	if limit := modCtx.limits.MaxConditionDepth; limit > 0 {
+		if depth := exprDepth(checkedExpr.Expr); uint(depth) > limit {
+			modCtx.addErrWithDesc(errLimitExceeded, "Expression in %s has a depth of %d, which exceeds the maximum depth of %d", parent, depth, limit)
+			return nil
+		}
+	}
+
+	typeErrs, err := modCtx.attrTypes.check(celAST)
+	if err != nil {
+		modCtx.addErrWithDesc(err, "Failed to check attribute types of `%s` in %s", expr, parent)
+		return nil
+	}
+
+	if len(typeErrs) > 0 {
+		modCtx.addErrWithDesc(errAttrTypeMismatch, "Expression `%s` in %s does not match the schema: %s", expr, parent, strings.Join(typeErrs, ", "))
+		return nil
+	}
+
 	return checkedExpr
 }
 
+// exprDepth returns the depth of the expression tree rooted at e.
+func exprDepth(e *exprpb.Expr) int {
+	if e == nil {
+		return 0
+	}
+
+	maxChildDepth := 0
+	for _, child := range exprChildren(e) {
+		if d := exprDepth(child); d > maxChildDepth {
+			maxChildDepth = d
+		}
+	}
+
+	return maxChildDepth + 1
+}
+
+func exprChildren(e *exprpb.Expr) []*exprpb.Expr {
+	var children []*exprpb.Expr
+	switch ek := e.ExprKind.(type) {
+	case *exprpb.Expr_SelectExpr:
+		children = []*exprpb.Expr{ek.SelectExpr.Operand}
+	case *exprpb.Expr_CallExpr:
+		children = append([]*exprpb.Expr{ek.CallExpr.Target}, ek.CallExpr.Args...)
+	case *exprpb.Expr_ListExpr:
+		children = ek.ListExpr.Elements
+	case *exprpb.Expr_StructExpr:
+		for _, entry := range ek.StructExpr.Entries {
+			children = append(children, entry.GetMapKey(), entry.Value)
+		}
+	case *exprpb.Expr_ComprehensionExpr:
+		ce := ek.ComprehensionExpr
+		children = []*exprpb.Expr{ce.IterRange, ce.AccuInit, ce.LoopCondition, ce.LoopStep, ce.Result}
+	}
+
+	return children
+}
+
 func compileMatchList(modCtx *moduleCtx, parent string, matches []*policyv1.Match) *runtimev1.Condition_ExprList {
 	exprList := make([]*runtimev1.Condition, len(matches))
 	for i, m := range matches {
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/config"
 )
 
 const (
@@ -21,6 +23,18 @@ type Conf struct {
 	CacheSize uint `yaml:"cacheSize" conf:",example=1024"`
 	// CacheDuration is the duration to cache an entry.
 	CacheDuration time.Duration `yaml:"cacheDuration" conf:",example=60s"`
+	// Limits define the guardrails enforced when compiling policies.
+	Limits Limits `yaml:"limits"`
+}
+
+// Limits define the guardrails enforced when compiling policies. Zero values disable the corresponding check.
+type Limits struct {
+	// MaxRulesPerPolicy is the maximum number of rules allowed in a resource or principal policy.
+	MaxRulesPerPolicy uint `yaml:"maxRulesPerPolicy" conf:",example=1000"`
+	// MaxConditionDepth is the maximum depth of the expression tree of a condition.
+	MaxConditionDepth uint `yaml:"maxConditionDepth" conf:",example=32"`
+	// MaxVariablesPerPolicy is the maximum number of variables available to a policy, including imported variables.
+	MaxVariablesPerPolicy uint `yaml:"maxVariablesPerPolicy" conf:",example=100"`
 }
 
 func (c *Conf) Key() string {
@@ -43,6 +57,13 @@ func (c *Conf) Validate() (outErr error) {
 	return outErr
 }
 
+func GetConf() (*Conf, error) {
+	conf := &Conf{}
+	err := config.GetSection(conf)
+
+	return conf, err
+}
+
 // DefaultConf creates a config with defaults.
 func DefaultConf() *Conf {
 	cconf := &Conf{}
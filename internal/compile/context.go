@@ -4,6 +4,7 @@
 package compile
 
 import (
+	"context"
 	"fmt"
 
 	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
@@ -12,12 +13,36 @@ import (
 )
 
 type unitCtx struct {
+	ctx    context.Context
 	unit   *policy.CompilationUnit
 	errors *ErrorList
+	limits Limits
 }
 
-func newUnitCtx(unit *policy.CompilationUnit) *unitCtx {
-	return &unitCtx{unit: unit, errors: newErrorList()}
+func newUnitCtx(unit *policy.CompilationUnit, opts ...Option) *unitCtx {
+	uc := &unitCtx{ctx: context.Background(), unit: unit, errors: newErrorList()}
+	for _, opt := range opts {
+		opt(uc)
+	}
+
+	return uc
+}
+
+// Option customises the behaviour of the compiler.
+type Option func(*unitCtx)
+
+// WithContext sets the context used to load the schemas referenced by the policies.
+func WithContext(ctx context.Context) Option {
+	return func(uc *unitCtx) {
+		uc.ctx = ctx
+	}
+}
+
+// WithLimits sets the guardrails to enforce during compilation.
+func WithLimits(limits Limits) Option {
+	return func(uc *unitCtx) {
+		uc.limits = limits
+	}
 }
 
 func (uc *unitCtx) error() error {
@@ -41,6 +66,7 @@ func (uc *unitCtx) moduleCtx(id namer.ModuleID) *moduleCtx {
 type moduleCtx struct {
 	*unitCtx
 	def        *policyv1.Policy
+	attrTypes  *attrTypeChecker
 	fqn        string
 	sourceFile string
 }
@@ -0,0 +1,179 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package compile
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/cel-go/cel"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/conditions"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+// Deprecation is a usage of a deprecated policy feature that will stop working in a future release.
+type Deprecation struct {
+	File        string `json:"file"`
+	Location    string `json:"location"`
+	Feature     string `json:"feature"`
+	Replacement string `json:"replacement"`
+}
+
+// FindStoreDeprecations returns the usages of deprecated features in the enabled policies of the store, sorted by file.
+func FindStoreDeprecations(ctx context.Context, store storage.SourceStore) ([]Deprecation, error) {
+	policyIDs, err := store.ListPolicyIDs(ctx, storage.ListPolicyIDsParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+
+	if len(policyIDs) == 0 {
+		return nil, nil
+	}
+
+	wrappers, err := store.LoadPolicy(ctx, policyIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load policies: %w", err)
+	}
+
+	var out []Deprecation
+	for _, w := range wrappers {
+		out = append(out, FindDeprecations(w.Policy)...)
+	}
+
+	sort.SliceStable(out, func(i, j int) bool { return out[i].File < out[j].File })
+	return out, nil
+}
+
+// FindDeprecations returns the usages of deprecated features in the policy.
+// Expressions that can't be parsed are skipped because they are reported as compilation errors.
+func FindDeprecations(p *policyv1.Policy) []Deprecation {
+	df := &deprecationFinder{file: policy.GetSourceFile(p)}
+
+	//nolint:staticcheck
+	if len(p.Variables) > 0 {
+		df.add("top-level variables", "Top-level `variables` field", "the `variables.local` section of the policy")
+		df.variables("top-level variables", p.Variables)
+	}
+
+	switch pt := p.PolicyType.(type) {
+	case *policyv1.Policy_ResourcePolicy:
+		rp := pt.ResourcePolicy
+		df.variables("policy local variables", rp.Variables.GetLocal())
+		for i, rule := range rp.Rules {
+			location := fmt.Sprintf("resource rule '%s' (#%d)", rule.Name, i+1)
+			df.condition(location, rule.Condition)
+			df.expr(location, rule.Output.GetExpr())
+		}
+
+	case *policyv1.Policy_PrincipalPolicy:
+		pp := pt.PrincipalPolicy
+		df.variables("policy local variables", pp.Variables.GetLocal())
+		for _, rule := range pp.Rules {
+			for i, action := range rule.Actions {
+				location := fmt.Sprintf("rule '%s' (#%d) of resource '%s'", action.Name, i+1, rule.Resource)
+				df.condition(location, action.Condition)
+				df.expr(location, action.Output.GetExpr())
+			}
+		}
+
+	case *policyv1.Policy_DerivedRoles:
+		dr := pt.DerivedRoles
+		df.variables("policy local variables", dr.Variables.GetLocal())
+		for i, def := range dr.Definitions {
+			df.condition(fmt.Sprintf("derived role '%s' (#%d)", def.Name, i+1), def.Condition)
+		}
+
+	case *policyv1.Policy_ExportVariables:
+		df.variables("exported variables", pt.ExportVariables.Definitions)
+	}
+
+	return df.found
+}
+
+type deprecationFinder struct {
+	file  string
+	found []Deprecation
+}
+
+func (df *deprecationFinder) add(location, feature, replacement string) {
+	df.found = append(df.found, Deprecation{File: df.file, Location: location, Feature: feature, Replacement: replacement})
+}
+
+func (df *deprecationFinder) variables(source string, variables map[string]string) {
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		df.expr(fmt.Sprintf("variable `%s` in %s", name, source), variables[name])
+	}
+}
+
+func (df *deprecationFinder) condition(location string, cond *policyv1.Condition) {
+	df.match(location, cond.GetMatch())
+}
+
+func (df *deprecationFinder) match(location string, match *policyv1.Match) {
+	if match == nil {
+		return
+	}
+
+	switch t := match.Op.(type) {
+	case *policyv1.Match_Expr:
+		df.expr(location, t.Expr)
+	case *policyv1.Match_All:
+		for _, m := range t.All.Of {
+			df.match(location, m)
+		}
+	case *policyv1.Match_Any:
+		for _, m := range t.Any.Of {
+			df.match(location, m)
+		}
+	case *policyv1.Match_None:
+		for _, m := range t.None.Of {
+			df.match(location, m)
+		}
+	}
+}
+
+func (df *deprecationFinder) expr(location, expr string) {
+	if expr == "" {
+		return
+	}
+
+	celAST, issues := conditions.StdEnv.Parse(expr)
+	if issues != nil && issues.Err() != nil {
+		return
+	}
+
+	parsed, err := cel.AstToParsedExpr(celAST)
+	if err != nil {
+		return
+	}
+
+	df.walk(location, parsed.Expr)
+}
+
+func (df *deprecationFinder) walk(location string, e *exprpb.Expr) {
+	if e == nil {
+		return
+	}
+
+	if call := e.GetCallExpr(); call != nil {
+		if replacement, ok := conditions.DeprecatedFunctions[call.Function]; ok {
+			df.add(location, fmt.Sprintf("Function `%s`", call.Function), fmt.Sprintf("`%s`", replacement))
+		}
+	}
+
+	for _, child := range exprChildren(e) {
+		df.walk(location, child)
+	}
+}
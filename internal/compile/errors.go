@@ -16,10 +16,14 @@ import (
 
 var (
 	errAmbiguousDerivedRole   = errors.New("ambiguous derived role")
+	errAttrTypeMismatch       = errors.New("attribute type mismatch")
+	errCyclicalDerivedRoles   = errors.New("cyclical derived roles")
 	errImportNotFound         = errors.New("import not found")
+	errInvalidActivePeriod    = errors.New("invalid active period")
 	errInvalidCompilationUnit = errors.New("invalid compilation unit")
 	errInvalidResourceRule    = errors.New("invalid resource rule")
 	errInvalidSchema          = errors.New("invalid schema")
+	errLimitExceeded          = errors.New("compile limit exceeded")
 	errMissingDefinition      = errors.New("missing policy definition")
 	errScriptsUnsupported     = errors.New("scripts in conditions are no longer supported")
 	errUnexpectedErr          = errors.New("unexpected error")
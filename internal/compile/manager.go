@@ -33,6 +33,7 @@ const (
 )
 
 type Manager struct {
+	*storage.SubscriptionManager
 	log           *zap.SugaredLogger
 	store         storage.SourceStore
 	schemaMgr     schema.Manager
@@ -40,6 +41,7 @@ type Manager struct {
 	cache         gcache.Cache
 	sf            singleflight.Group
 	cacheDuration time.Duration
+	limits        Limits
 }
 
 func NewManager(ctx context.Context, store storage.SourceStore, schemaMgr schema.Manager) (*Manager, error) {
@@ -57,12 +59,14 @@ func NewManagerFromDefaultConf(ctx context.Context, store storage.SourceStore, s
 
 func NewManagerFromConf(ctx context.Context, conf *Conf, store storage.SourceStore, schemaMgr schema.Manager) *Manager {
 	c := &Manager{
-		log:           zap.S().Named("compiler"),
-		store:         store,
-		schemaMgr:     schemaMgr,
-		updateQueue:   make(chan storage.Event, updateQueueSize),
-		cache:         mkCache(int(conf.CacheSize)),
-		cacheDuration: conf.CacheDuration,
+		SubscriptionManager: storage.NewSubscriptionManager(ctx),
+		log:                 zap.S().Named("compiler"),
+		store:               store,
+		schemaMgr:           schemaMgr,
+		updateQueue:         make(chan storage.Event, updateQueueSize),
+		cache:               mkCache(int(conf.CacheSize)),
+		cacheDuration:       conf.CacheDuration,
+		limits:              conf.Limits,
 	}
 
 	go c.processUpdateQueue(ctx)
@@ -71,6 +75,15 @@ func NewManagerFromConf(ctx context.Context, conf *Conf, store storage.SourceSto
 	return c
 }
 
+// WatchesForChanges returns true if the underlying store publishes change notifications.
+func (c *Manager) WatchesForChanges() bool {
+	if w, ok := c.store.(storage.Watchable); ok {
+		return w.WatchesForChanges()
+	}
+
+	return true
+}
+
 func (c *Manager) SubscriberID() string {
 	return "compile.Manager"
 }
@@ -100,6 +113,11 @@ func (c *Manager) processUpdateQueue(ctx context.Context) {
 			default:
 				c.log.Debugw("Ignoring storage event", "event", evt)
 			}
+
+			// notify downstream subscribers after the cache is updated so that they see the new policies
+			if ctx.Err() == nil {
+				c.NotifySubscribers(evt)
+			}
 		}
 	}
 }
@@ -147,7 +165,7 @@ func (c *Manager) recompile(evt storage.Event) error {
 			c.log.Debugw("Evicted the disabled policy", "id", cu.ModID.String())
 			continue
 		}
-		if _, err := c.compile(cu); err != nil {
+		if _, err := c.compile(ctx, cu); err != nil {
 			// log and remove the module that failed to compile.
 			c.log.Errorw("Failed to recompile", "id", modID, "error", err)
 			c.evict(modID)
@@ -173,9 +191,9 @@ func (c *Manager) getDependents(modID namer.ModuleID) ([]namer.ModuleID, error)
 	return nil, nil
 }
 
-func (c *Manager) compile(unit *policy.CompilationUnit) (*runtimev1.RunnablePolicySet, error) {
+func (c *Manager) compile(ctx context.Context, unit *policy.CompilationUnit) (*runtimev1.RunnablePolicySet, error) {
 	startTime := time.Now()
-	rps, err := Compile(unit, c.schemaMgr)
+	rps, err := Compile(unit, c.schemaMgr, WithContext(ctx), WithLimits(c.limits))
 	durationMs := float64(time.Since(startTime)) / float64(time.Millisecond)
 
 	if err == nil && rps != nil {
@@ -233,7 +251,7 @@ func (c *Manager) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID
 			return nil, nil
 		}
 
-		rps, err := c.compile(cu)
+		rps, err := c.compile(ctx, cu)
 		if err != nil {
 			return nil, PolicyCompilationErr{underlying: err}
 		}
@@ -286,7 +304,7 @@ func (c *Manager) GetPolicySet(ctx context.Context, modID namer.ModuleID) (*runt
 
 		var retVal *runtimev1.RunnablePolicySet
 		for mID, cu := range compileUnits {
-			rps, err := c.compile(cu)
+			rps, err := c.compile(ctx, cu)
 			if err != nil {
 				return nil, PolicyCompilationErr{underlying: err}
 			}
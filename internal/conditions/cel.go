@@ -120,6 +120,8 @@ func ExpandAbbrev(s string) string {
 		expanded = CELVariablesIdent
 	case CELGlobalsAbbrev:
 		expanded = CELGlobalsIdent
+	case CELConstantsAbbrev:
+		expanded = CELConstantsIdent
 	}
 
 	if ok {
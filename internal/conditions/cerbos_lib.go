@@ -4,10 +4,22 @@
 package conditions
 
 import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"math"
 	"net"
+	"net/mail"
+	"regexp"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/common/types"
@@ -15,24 +27,79 @@ import (
 	"github.com/google/cel-go/common/types/traits"
 	"github.com/google/cel-go/interpreter"
 	"github.com/google/cel-go/interpreter/functions"
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	customtypes "github.com/cerbos/cerbos/internal/conditions/types"
 )
 
 const (
+	base64DecodeToStringFn      = "base64.decodeToString"
+	betweenTimesOfDayFn         = "betweenTimesOfDay"
+	bucketFn                    = "bucket"
+	compareIDsFn                = "compareIDs"
+	constantTimeEqualsFn        = "constantTimeEquals"
+	decodeJWTFn                 = "decodeJWT"
+	differenceFn                = "difference"
+	emailDomainFn               = "emailDomain"
 	exceptFn                    = "except"
+	extractFn                   = "extract"
+	extractNamedFn              = "extractNamed"
+	geoDistanceFn               = "geoDistance"
+	getOrDefaultFn              = "getOrDefault"
 	hasIntersectionFnDeprecated = "has_intersection"
 	hasIntersectionFn           = "hasIntersection"
+	hasPathFn                   = "hasPath"
+	hexDecodeFn                 = "hex.decode"
+	hexDecodeToStringFn         = "hex.decodeToString"
+	hexEncodeFn                 = "hex.encode"
+	hmacSHA256Fn                = "hmacSHA256"
+	idTimestampFn               = "idTimestamp"
+	inCIDRFn                    = "inCIDR"
+	inGeoPolygonFn              = "inGeoPolygon"
 	inIPAddrRangeFn             = "inIPAddrRange"
+	inIPRangeFn                 = "inIPRange"
+	inTimezoneFn                = "inTimezone"
 	intersectFn                 = "intersect"
 	isSubsetFnDeprecated        = "is_subset"
+	isBusinessDayFn             = "isBusinessDay"
+	isEmailFn                   = "isEmail"
+	isPhoneNumberFn             = "isPhoneNumber"
+	isPrivateIPFn               = "isPrivateIP"
 	isSubsetFn                  = "isSubset"
+	isULIDFn                    = "isULID"
+	isUUIDFn                    = "isUUID"
+	levenshteinFn               = "levenshtein"
 	nowFn                       = "now"
+	sha256Fn                    = "sha256"
+	similarityFn                = "similarity"
+	symmetricDifferenceFn       = "symmetricDifference"
 	timeSinceFn                 = "timeSince"
+	unionFn                     = "union"
+	uuidVersionFn               = "uuidVersion"
 	IDFn                        = "id"
 	noSuchKeyErrorPrefix        = "no such key: "
+	pathDelimiter               = "."
+	uuidStrLen                  = 36
+	maxEmailLen                 = 254
+	maxEmailLocalPartLen        = 64
+	maxDomainLen                = 253
+	maxDomainLabelLen           = 63
 )
 
+var e164Regexp = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// DeprecatedFunctions maps the names of deprecated functions to the names of the functions that replace them.
+var DeprecatedFunctions = map[string]string{
+	hasIntersectionFnDeprecated: hasIntersectionFn,
+	isSubsetFnDeprecated:        isSubsetFn,
+}
+
+// ErrCostLimitExceeded is returned by Eval when the evaluation is cancelled because it exceeded the limit set with cel.CostLimit.
+var ErrCostLimitExceeded = errors.New("expression evaluation cost limit exceeded")
+
 type NoSuchKeyError struct {
 	Key string
 }
@@ -50,6 +117,7 @@ type cerbosLib struct{}
 
 func (clib cerbosLib) CompileOptions() []cel.EnvOption {
 	genericListType := cel.ListType(cel.TypeParamType("A"))
+	mapType := cel.MapType(cel.StringType, cel.DynType)
 
 	// options for set operations like intersect and except
 	setOpFuncOverloads := func(name string, fn functions.BinaryOp) []cel.FunctionOpt {
@@ -92,18 +160,264 @@ func (clib cerbosLib) CompileOptions() []cel.EnvOption {
 	return []cel.EnvOption{
 		cel.Declarations(customtypes.HierarchyDeclrations...),
 		cel.Types(customtypes.HierarchyType),
+		cel.Declarations(customtypes.SemverDeclarations...),
+		cel.Types(customtypes.SemverType),
+		cel.Declarations(customtypes.DecimalDeclarations...),
+		cel.Types(customtypes.DecimalType),
+		cel.Function(base64DecodeToStringFn,
+			cel.Overload(fmt.Sprintf("%s_overload", base64DecodeToStringFn),
+				[]*cel.Type{cel.StringType},
+				cel.StringType,
+				cel.UnaryBinding(base64DecodeToString),
+			),
+		),
+		cel.Function(bucketFn,
+			cel.Overload(fmt.Sprintf("%s_overload", bucketFn),
+				[]*cel.Type{cel.StringType, cel.StringType, cel.IntType},
+				cel.IntType,
+				cel.FunctionBinding(bucket),
+			),
+		),
+		cel.Function(differenceFn, setOpFuncOverloads(differenceFn, exceptList)...),
+		cel.Function(emailDomainFn,
+			cel.Overload(fmt.Sprintf("%s_overload", emailDomainFn),
+				[]*cel.Type{cel.StringType},
+				cel.StringType,
+				cel.UnaryBinding(emailDomain),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", emailDomainFn),
+				[]*cel.Type{cel.StringType},
+				cel.StringType,
+				cel.UnaryBinding(emailDomain),
+			),
+		),
 		cel.Function(exceptFn, setOpFuncOverloads(exceptFn, exceptList)...),
+		cel.Function(constantTimeEqualsFn,
+			cel.Overload(fmt.Sprintf("%s_string_string", constantTimeEqualsFn),
+				[]*cel.Type{cel.StringType, cel.StringType},
+				cel.BoolType,
+				cel.BinaryBinding(constantTimeEquals),
+			),
+			cel.Overload(fmt.Sprintf("%s_bytes_bytes", constantTimeEqualsFn),
+				[]*cel.Type{cel.BytesType, cel.BytesType},
+				cel.BoolType,
+				cel.BinaryBinding(constantTimeEquals),
+			),
+		),
+		cel.Function(decodeJWTFn,
+			cel.Overload(fmt.Sprintf("%s_overload", decodeJWTFn),
+				[]*cel.Type{cel.StringType},
+				cel.MapType(cel.StringType, cel.DynType),
+				cel.UnaryBinding(decodeJWT),
+			),
+		),
+		cel.Function(extractFn,
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", extractFn),
+				[]*cel.Type{cel.StringType, cel.StringType},
+				cel.ListType(cel.StringType),
+				cel.BinaryBinding(extract),
+			),
+		),
+		cel.Function(extractNamedFn,
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", extractNamedFn),
+				[]*cel.Type{cel.StringType, cel.StringType},
+				cel.MapType(cel.StringType, cel.StringType),
+				cel.BinaryBinding(extractNamed),
+			),
+		),
+		cel.Function(geoDistanceFn,
+			cel.Overload(fmt.Sprintf("%s_overload", geoDistanceFn),
+				[]*cel.Type{cel.DynType, cel.DynType, cel.DynType, cel.DynType},
+				cel.DoubleType,
+				cel.FunctionBinding(geoDistance),
+			),
+		),
+		cel.Function(getOrDefaultFn,
+			cel.Overload(fmt.Sprintf("%s_overload", getOrDefaultFn),
+				[]*cel.Type{mapType, cel.StringType, cel.DynType},
+				cel.DynType,
+				cel.FunctionBinding(getOrDefault),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", getOrDefaultFn),
+				[]*cel.Type{mapType, cel.StringType, cel.DynType},
+				cel.DynType,
+				cel.FunctionBinding(getOrDefault),
+			),
+		),
 		cel.Function(hasIntersectionFn, setCheckFuncOverloads(hasIntersectionFn, hasIntersection)...),
 		cel.Function(hasIntersectionFnDeprecated, setCheckFuncOverloads(hasIntersectionFnDeprecated, hasIntersection)...),
+		cel.Function(hasPathFn,
+			cel.Overload(fmt.Sprintf("%s_overload", hasPathFn),
+				[]*cel.Type{mapType, cel.StringType},
+				cel.BoolType,
+				cel.BinaryBinding(hasPath),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", hasPathFn),
+				[]*cel.Type{mapType, cel.StringType},
+				cel.BoolType,
+				cel.BinaryBinding(hasPath),
+			),
+		),
+		cel.Function(hexDecodeFn,
+			cel.Overload(fmt.Sprintf("%s_overload", hexDecodeFn),
+				[]*cel.Type{cel.StringType},
+				cel.BytesType,
+				cel.UnaryBinding(hexDecode),
+			),
+		),
+		cel.Function(hexDecodeToStringFn,
+			cel.Overload(fmt.Sprintf("%s_overload", hexDecodeToStringFn),
+				[]*cel.Type{cel.StringType},
+				cel.StringType,
+				cel.UnaryBinding(hexDecodeToString),
+			),
+		),
+		cel.Function(hexEncodeFn,
+			cel.Overload(fmt.Sprintf("%s_string", hexEncodeFn),
+				[]*cel.Type{cel.StringType},
+				cel.StringType,
+				cel.UnaryBinding(hexEncode),
+			),
+			cel.Overload(fmt.Sprintf("%s_bytes", hexEncodeFn),
+				[]*cel.Type{cel.BytesType},
+				cel.StringType,
+				cel.UnaryBinding(hexEncode),
+			),
+		),
+		cel.Function(hmacSHA256Fn,
+			cel.Overload(fmt.Sprintf("%s_string_string", hmacSHA256Fn),
+				[]*cel.Type{cel.StringType, cel.StringType},
+				cel.StringType,
+				cel.BinaryBinding(hmacSHA256),
+			),
+			cel.Overload(fmt.Sprintf("%s_bytes_bytes", hmacSHA256Fn),
+				[]*cel.Type{cel.BytesType, cel.BytesType},
+				cel.StringType,
+				cel.BinaryBinding(hmacSHA256),
+			),
+		),
 		cel.Function(inIPAddrRangeFn, cel.MemberOverload(
 			fmt.Sprintf("%s_string", inIPAddrRangeFn),
 			[]*cel.Type{cel.StringType, cel.StringType},
 			cel.BoolType,
 			cel.BinaryBinding(callInStringStringOutBool(clib.inIPAddrRangeFunc)),
 		)),
+		cel.Function(inCIDRFn,
+			cel.Overload(fmt.Sprintf("%s_string_string", inCIDRFn),
+				[]*cel.Type{cel.StringType, cel.StringType},
+				cel.BoolType,
+				cel.BinaryBinding(callInStringStringOutBool(clib.inIPAddrRangeFunc)),
+			),
+			cel.Overload(fmt.Sprintf("%s_string_list", inCIDRFn),
+				[]*cel.Type{cel.StringType, cel.ListType(cel.StringType)},
+				cel.BoolType,
+				cel.BinaryBinding(clib.inCIDRListFunc),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_string_string", inCIDRFn),
+				[]*cel.Type{cel.StringType, cel.StringType},
+				cel.BoolType,
+				cel.BinaryBinding(callInStringStringOutBool(clib.inIPAddrRangeFunc)),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_string_list", inCIDRFn),
+				[]*cel.Type{cel.StringType, cel.ListType(cel.StringType)},
+				cel.BoolType,
+				cel.BinaryBinding(clib.inCIDRListFunc),
+			),
+		),
+		cel.Function(inGeoPolygonFn,
+			cel.Overload(fmt.Sprintf("%s_overload", inGeoPolygonFn),
+				[]*cel.Type{cel.DynType, cel.DynType, cel.ListType(cel.ListType(cel.DynType))},
+				cel.BoolType,
+				cel.FunctionBinding(inGeoPolygon),
+			),
+		),
+		cel.Function(inIPRangeFn,
+			cel.Overload(fmt.Sprintf("%s_overload", inIPRangeFn),
+				[]*cel.Type{cel.StringType, cel.StringType, cel.StringType},
+				cel.BoolType,
+				cel.FunctionBinding(clib.inIPRangeFunc),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", inIPRangeFn),
+				[]*cel.Type{cel.StringType, cel.StringType, cel.StringType},
+				cel.BoolType,
+				cel.FunctionBinding(clib.inIPRangeFunc),
+			),
+		),
+		cel.Function(isPrivateIPFn,
+			cel.Overload(fmt.Sprintf("%s_overload", isPrivateIPFn),
+				[]*cel.Type{cel.StringType},
+				cel.BoolType,
+				cel.UnaryBinding(callInStringOutBool(clib.isPrivateIPFunc)),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", isPrivateIPFn),
+				[]*cel.Type{cel.StringType},
+				cel.BoolType,
+				cel.UnaryBinding(callInStringOutBool(clib.isPrivateIPFunc)),
+			),
+		),
 		cel.Function(intersectFn, setOpFuncOverloads(intersectFn, intersect)...),
+		cel.Function(isEmailFn,
+			cel.Overload(fmt.Sprintf("%s_overload", isEmailFn),
+				[]*cel.Type{cel.StringType},
+				cel.BoolType,
+				cel.UnaryBinding(callInStringOutBool(isEmail)),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", isEmailFn),
+				[]*cel.Type{cel.StringType},
+				cel.BoolType,
+				cel.UnaryBinding(callInStringOutBool(isEmail)),
+			),
+		),
+		cel.Function(isPhoneNumberFn,
+			cel.Overload(fmt.Sprintf("%s_overload", isPhoneNumberFn),
+				[]*cel.Type{cel.StringType},
+				cel.BoolType,
+				cel.UnaryBinding(callInStringOutBool(isPhoneNumber)),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", isPhoneNumberFn),
+				[]*cel.Type{cel.StringType},
+				cel.BoolType,
+				cel.UnaryBinding(callInStringOutBool(isPhoneNumber)),
+			),
+		),
+		cel.Function(isULIDFn,
+			cel.Overload(fmt.Sprintf("%s_overload", isULIDFn),
+				[]*cel.Type{cel.StringType},
+				cel.BoolType,
+				cel.UnaryBinding(isULID),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", isULIDFn),
+				[]*cel.Type{cel.StringType},
+				cel.BoolType,
+				cel.UnaryBinding(isULID),
+			),
+		),
+		cel.Function(isUUIDFn,
+			cel.Overload(fmt.Sprintf("%s_overload", isUUIDFn),
+				[]*cel.Type{cel.StringType},
+				cel.BoolType,
+				cel.UnaryBinding(isUUID),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", isUUIDFn),
+				[]*cel.Type{cel.StringType},
+				cel.BoolType,
+				cel.UnaryBinding(isUUID),
+			),
+		),
 		cel.Function(isSubsetFn, setCheckFuncOverloads(isSubsetFn, isSubset)...),
 		cel.Function(isSubsetFnDeprecated, setCheckFuncOverloads(isSubsetFnDeprecated, isSubset)...),
+		cel.Function(levenshteinFn,
+			cel.Overload(fmt.Sprintf("%s_overload", levenshteinFn),
+				[]*cel.Type{cel.StringType, cel.StringType},
+				cel.IntType,
+				cel.BinaryBinding(levenshtein),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", levenshteinFn),
+				[]*cel.Type{cel.StringType, cel.StringType},
+				cel.IntType,
+				cel.BinaryBinding(levenshtein),
+			),
+		),
 		cel.Function(nowFn,
 			cel.Overload(nowFn,
 				nil,
@@ -111,6 +425,31 @@ func (clib cerbosLib) CompileOptions() []cel.EnvOption {
 				cel.FunctionBinding(callInNothingOutTimestamp(time.Now)),
 			),
 		),
+		cel.Function(sha256Fn,
+			cel.Overload(fmt.Sprintf("%s_string", sha256Fn),
+				[]*cel.Type{cel.StringType},
+				cel.StringType,
+				cel.UnaryBinding(sha256Hex),
+			),
+			cel.Overload(fmt.Sprintf("%s_bytes", sha256Fn),
+				[]*cel.Type{cel.BytesType},
+				cel.StringType,
+				cel.UnaryBinding(sha256Hex),
+			),
+		),
+		cel.Function(similarityFn,
+			cel.Overload(fmt.Sprintf("%s_overload", similarityFn),
+				[]*cel.Type{cel.StringType, cel.StringType},
+				cel.DoubleType,
+				cel.BinaryBinding(similarity),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", similarityFn),
+				[]*cel.Type{cel.StringType, cel.StringType},
+				cel.DoubleType,
+				cel.BinaryBinding(similarity),
+			),
+		),
+		cel.Function(symmetricDifferenceFn, setOpFuncOverloads(symmetricDifferenceFn, symmetricDifferenceList)...),
 		cel.Function(timeSinceFn,
 			cel.Overload(fmt.Sprintf("%s_overload", timeSinceFn),
 				[]*cel.Type{cel.TimestampType},
@@ -123,7 +462,79 @@ func (clib cerbosLib) CompileOptions() []cel.EnvOption {
 				cel.UnaryBinding(callInTimestampOutDuration(time.Now().Sub)),
 			),
 		),
+		cel.Function(unionFn, setOpFuncOverloads(unionFn, unionList)...),
+		cel.Function(inTimezoneFn,
+			cel.Overload(fmt.Sprintf("%s_overload", inTimezoneFn),
+				[]*cel.Type{cel.TimestampType, cel.StringType},
+				cel.TimestampType,
+				cel.BinaryBinding(inTimezone),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", inTimezoneFn),
+				[]*cel.Type{cel.TimestampType, cel.StringType},
+				cel.TimestampType,
+				cel.BinaryBinding(inTimezone),
+			),
+		),
+		cel.Function(isBusinessDayFn,
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", isBusinessDayFn),
+				[]*cel.Type{cel.TimestampType},
+				cel.BoolType,
+				cel.UnaryBinding(isBusinessDay),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_string_overload", isBusinessDayFn),
+				[]*cel.Type{cel.TimestampType, cel.StringType},
+				cel.BoolType,
+				cel.BinaryBinding(func(tsVal, tzVal ref.Val) ref.Val {
+					return isBusinessDay(inTimezone(tsVal, tzVal))
+				}),
+			),
+		),
+		cel.Function(betweenTimesOfDayFn,
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", betweenTimesOfDayFn),
+				[]*cel.Type{cel.TimestampType, cel.StringType, cel.StringType},
+				cel.BoolType,
+				cel.FunctionBinding(betweenTimesOfDay),
+			),
+		),
+		cel.Function(uuidVersionFn,
+			cel.Overload(fmt.Sprintf("%s_overload", uuidVersionFn),
+				[]*cel.Type{cel.StringType},
+				cel.IntType,
+				cel.UnaryBinding(uuidVersion),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", uuidVersionFn),
+				[]*cel.Type{cel.StringType},
+				cel.IntType,
+				cel.UnaryBinding(uuidVersion),
+			),
+		),
+		cel.Function(idTimestampFn,
+			cel.Overload(fmt.Sprintf("%s_overload", idTimestampFn),
+				[]*cel.Type{cel.StringType},
+				cel.TimestampType,
+				cel.UnaryBinding(idTimestamp),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", idTimestampFn),
+				[]*cel.Type{cel.StringType},
+				cel.TimestampType,
+				cel.UnaryBinding(idTimestamp),
+			),
+		),
+		cel.Function(compareIDsFn,
+			cel.Overload(fmt.Sprintf("%s_overload", compareIDsFn),
+				[]*cel.Type{cel.StringType, cel.StringType},
+				cel.IntType,
+				cel.BinaryBinding(compareIDs),
+			),
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", compareIDsFn),
+				[]*cel.Type{cel.StringType, cel.StringType},
+				cel.IntType,
+				cel.BinaryBinding(compareIDs),
+			),
+		),
 		customtypes.HierarchyFunc,
+		customtypes.SemverFunc,
+		customtypes.DecimalFunc,
 		cel.Function(IDFn, cel.Overload(fmt.Sprintf("%s_overload", IDFn),
 			[]*cel.Type{cel.DynType},
 			cel.DynType,
@@ -148,9 +559,18 @@ func Eval(env *cel.Env, ast *cel.Ast, vars any, nowFunc func() time.Time, opts .
 		return nil, nil, err
 	}
 
+	return evalProgram(prg, vars)
+}
+
+func evalProgram(prg cel.Program, vars any) (ref.Val, *cel.EvalDetails, error) {
 	result, details, err := prg.Eval(vars)
-	if err != nil && strings.HasPrefix(err.Error(), noSuchKeyErrorPrefix) {
+	var cancelled interpreter.EvalCancelledError
+	switch {
+	case err == nil:
+	case strings.HasPrefix(err.Error(), noSuchKeyErrorPrefix):
 		err = &NoSuchKeyError{Key: strings.TrimPrefix(err.Error(), noSuchKeyErrorPrefix)}
+	case errors.As(err, &cancelled) && cancelled.Cause == interpreter.CostLimitExceeded:
+		err = ErrCostLimitExceeded
 	}
 	return result, details, err
 }
@@ -240,6 +660,32 @@ func exceptList(lhs, rhs ref.Val) ref.Val {
 	return types.NewRefValList(types.DefaultTypeAdapter, items)
 }
 
+// unionList implements union lhs+rhs returning
+// items in lhs (list) followed by items in rhs (list) that are not members of lhs.
+func unionList(lhs, rhs ref.Val) ref.Val {
+	res := exceptList(rhs, lhs)
+	diff, ok := res.(traits.Lister)
+	if !ok {
+		return res
+	}
+
+	//nolint:forcetypeassert
+	return lhs.(traits.Lister).Add(diff)
+}
+
+// symmetricDifferenceList returns items in lhs (list) that are not members of rhs (list)
+// followed by items in rhs that are not members of lhs.
+func symmetricDifferenceList(lhs, rhs ref.Val) ref.Val {
+	res := exceptList(lhs, rhs)
+	a, ok := res.(traits.Lister)
+	if !ok {
+		return res
+	}
+
+	//nolint:forcetypeassert
+	return a.Add(exceptList(rhs, lhs).(traits.Lister))
+}
+
 // isSubset returns true value if lhs (list) is a subset of rhs (list).
 func isSubset(lhs, rhs ref.Val) ref.Val {
 	a, ok := lhs.(traits.Lister)
@@ -390,50 +836,960 @@ func intersect(lhs, rhs ref.Val) ref.Val {
 	return types.NewRefValList(types.DefaultTypeAdapter, items)
 }
 
-func (clib cerbosLib) inIPAddrRangeFunc(ipAddrVal, cidrVal string) (bool, error) {
-	ipAddr := net.ParseIP(ipAddrVal)
-	if ipAddr == nil {
-		return false, fmt.Errorf("invalid IP address: %s", ipAddrVal)
+// extract returns the capture groups of the first match of the pattern in the string.
+// If the pattern doesn't have any capture groups, the list contains the whole match. The list is empty if there's no match.
+func extract(strVal, patternVal ref.Val) ref.Val {
+	match, _, err := findFirstMatch(strVal, patternVal)
+	if err != nil {
+		return err
 	}
 
-	_, cidr, err := net.ParseCIDR(cidrVal)
+	if len(match) > 1 {
+		match = match[1:]
+	}
+
+	return types.NewStringList(types.DefaultTypeAdapter, match)
+}
+
+// extractNamed returns the named capture groups of the first match of the pattern in the string.
+// The map is empty if there's no match.
+func extractNamed(strVal, patternVal ref.Val) ref.Val {
+	match, re, err := findFirstMatch(strVal, patternVal)
 	if err != nil {
-		return false, err
+		return err
 	}
 
-	return cidr.Contains(ipAddr), nil
+	groups := make(map[string]string)
+	if len(match) > 0 {
+		for i, name := range re.SubexpNames() {
+			if name != "" {
+				groups[name] = match[i]
+			}
+		}
+	}
+
+	return types.NewStringStringMap(types.DefaultTypeAdapter, groups)
 }
 
-func callInStringStringOutBool(fn func(string, string) (bool, error)) functions.BinaryOp {
-	return func(lhsVal, rhsVal ref.Val) ref.Val {
-		lhs, ok := lhsVal.(types.String)
-		if !ok {
-			return types.MaybeNoSuchOverloadErr(lhsVal)
+func findFirstMatch(strVal, patternVal ref.Val) ([]string, *regexp.Regexp, ref.Val) {
+	str, ok := strVal.(types.String)
+	if !ok {
+		return nil, nil, types.MaybeNoSuchOverloadErr(strVal)
+	}
+
+	pattern, ok := patternVal.(types.String)
+	if !ok {
+		return nil, nil, types.MaybeNoSuchOverloadErr(patternVal)
+	}
+
+	re, err := regexp.Compile(string(pattern))
+	if err != nil {
+		return nil, nil, types.NewErr("invalid regular expression %q: %v", string(pattern), err)
+	}
+
+	return re.FindStringSubmatch(string(str)), re, nil
+}
+
+// getOrDefault returns the value at the dotted path (e.g. `a.b.c`) in the map or the fallback value if the path doesn't exist.
+func getOrDefault(args ...ref.Val) ref.Val {
+	if len(args) != 3 { //nolint:gomnd
+		return types.NewErr("wrong number of arguments to %s: %d", getOrDefaultFn, len(args))
+	}
+
+	value, err := lookupPath(args[0], args[1])
+	if err != nil {
+		return err
+	}
+
+	if value == nil {
+		return args[2]
+	}
+
+	return value
+}
+
+// hasPath returns true if the dotted path (e.g. `a.b.c`) exists in the map.
+func hasPath(mapVal, pathVal ref.Val) ref.Val {
+	value, err := lookupPath(mapVal, pathVal)
+	if err != nil {
+		return err
+	}
+
+	return types.Bool(value != nil)
+}
+
+// lookupPath returns the value at the dotted path in the map. The value is nil if a key along the path is missing,
+// has a null value or refers to something other than a map.
+func lookupPath(mapVal, pathVal ref.Val) (ref.Val, ref.Val) {
+	path, ok := pathVal.(types.String)
+	if !ok {
+		return nil, types.MaybeNoSuchOverloadErr(pathVal)
+	}
+
+	keys := strings.Split(string(path), pathDelimiter)
+	for _, key := range keys {
+		if key == "" {
+			return nil, types.NewErr("invalid path %q", string(path))
 		}
+	}
 
-		rhs, ok := rhsVal.(types.String)
+	value := mapVal
+	for _, key := range keys {
+		m, ok := value.(traits.Mapper)
 		if !ok {
-			return types.MaybeNoSuchOverloadErr(rhsVal)
+			return nil, nil
 		}
 
-		retVal, err := fn(string(lhs), string(rhs))
-		if err != nil {
-			return types.NewErr(err.Error())
+		v, found := m.Find(types.String(key))
+		if !found {
+			return nil, nil
 		}
 
-		return types.DefaultTypeAdapter.NativeToValue(retVal)
+		if types.IsError(v) {
+			return nil, v
+		}
+
+		if v.Type() == types.NullType {
+			return nil, nil
+		}
+
+		value = v
 	}
+
+	return value, nil
 }
 
-func callInTimestampOutDuration(fn func(time.Time) time.Duration) functions.UnaryOp {
-	return func(val ref.Val) ref.Val {
-		ts, ok := val.(types.Timestamp)
-		if !ok {
-			return types.MaybeNoSuchOverloadErr(val)
+// levenshtein returns the minimum number of single-character insertions, deletions and substitutions required to change one string into the other.
+func levenshtein(lhsVal, rhsVal ref.Val) ref.Val {
+	lhs, rhs, err := toStringPair(lhsVal, rhsVal)
+	if err != nil {
+		return err
+	}
+
+	return types.Int(levenshteinDistance(lhs, rhs))
+}
+
+// similarity returns a ratio between 0 and 1 describing how similar two strings are, based on their Levenshtein distance.
+// Identical strings have a similarity of 1.
+func similarity(lhsVal, rhsVal ref.Val) ref.Val {
+	lhs, rhs, err := toStringPair(lhsVal, rhsVal)
+	if err != nil {
+		return err
+	}
+
+	maxLen := len(lhs)
+	if len(rhs) > maxLen {
+		maxLen = len(rhs)
+	}
+
+	if maxLen == 0 {
+		return types.Double(1)
+	}
+
+	return types.Double(1 - float64(levenshteinDistance(lhs, rhs))/float64(maxLen))
+}
+
+func toStringPair(lhsVal, rhsVal ref.Val) ([]rune, []rune, ref.Val) {
+	lhs, ok := lhsVal.(types.String)
+	if !ok {
+		return nil, nil, types.MaybeNoSuchOverloadErr(lhsVal)
+	}
+
+	rhs, ok := rhsVal.(types.String)
+	if !ok {
+		return nil, nil, types.MaybeNoSuchOverloadErr(rhsVal)
+	}
+
+	return []rune(string(lhs)), []rune(string(rhs)), nil
+}
+
+func levenshteinDistance(lhs, rhs []rune) int {
+	if len(lhs) < len(rhs) {
+		lhs, rhs = rhs, lhs
+	}
+
+	// only keep the previous row of the distance matrix
+	row := make([]int, len(rhs)+1)
+	for j := range row {
+		row[j] = j
+	}
+
+	for i := 1; i <= len(lhs); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(rhs); j++ {
+			cost := 1
+			if lhs[i-1] == rhs[j-1] {
+				cost = 0
+			}
+
+			curr := row[j]
+			row[j] = minInt(row[j]+1, row[j-1]+1, prev+cost)
+			prev = curr
 		}
+	}
 
-		return types.DefaultTypeAdapter.NativeToValue(fn(ts.Time))
+	return row[len(rhs)]
+}
+
+func minInt(first int, rest ...int) int {
+	m := first
+	for _, v := range rest {
+		if v < m {
+			m = v
+		}
+	}
+
+	return m
+}
+
+// sha256Hex returns the hex-encoded SHA-256 digest of a string or bytes value.
+func sha256Hex(val ref.Val) ref.Val {
+	b, err := toBytes(val)
+	if err != nil {
+		return err
+	}
+
+	sum := sha256.Sum256(b)
+	return types.String(hex.EncodeToString(sum[:]))
+}
+
+// bucket assigns the value to one of n buckets numbered from 0 to n-1 using the SHA-256 hash of the salt and the value.
+// The same inputs always produce the same bucket, which makes it suitable for percentage-based rollouts.
+func bucket(args ...ref.Val) ref.Val {
+	if len(args) != 3 { //nolint:gomnd
+		return types.NewErr("wrong number of arguments to %s: %d", bucketFn, len(args))
+	}
+
+	value, ok := args[0].(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(args[0])
+	}
+
+	salt, ok := args[1].(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(args[1])
 	}
+
+	n, ok := args[2].(types.Int)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(args[2])
+	}
+
+	if n <= 0 {
+		return types.NewErr("%s: number of buckets must be positive: %d", bucketFn, n)
+	}
+
+	h := sha256.New()
+	_, _ = h.Write([]byte(salt))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(value))
+	sum := h.Sum(nil)
+
+	return types.Int(binary.BigEndian.Uint64(sum[:8]) % uint64(n))
+}
+
+// hmacSHA256 returns the hex-encoded HMAC-SHA256 of the message using the given key.
+func hmacSHA256(keyVal, msgVal ref.Val) ref.Val {
+	key, err := toBytes(keyVal)
+	if err != nil {
+		return err
+	}
+
+	msg, err := toBytes(msgVal)
+	if err != nil {
+		return err
+	}
+
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(msg)
+	return types.String(hex.EncodeToString(mac.Sum(nil)))
+}
+
+// constantTimeEquals compares two values in constant time to avoid leaking timing information about secrets.
+func constantTimeEquals(lhsVal, rhsVal ref.Val) ref.Val {
+	lhs, err := toBytes(lhsVal)
+	if err != nil {
+		return err
+	}
+
+	rhs, err := toBytes(rhsVal)
+	if err != nil {
+		return err
+	}
+
+	return types.Bool(subtle.ConstantTimeCompare(lhs, rhs) == 1)
+}
+
+// decodeJWT returns the claims of a JWT without verifying its signature or validating its claims.
+func decodeJWT(tokenVal ref.Val) ref.Val {
+	token, ok := tokenVal.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(tokenVal)
+	}
+
+	parts := strings.Split(string(token), ".")
+	if len(parts) != 3 { //nolint:gomnd
+		return types.NewErr("invalid JWT: expected 3 parts but found %d", len(parts))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return types.NewErr("invalid JWT payload: %v", err)
+	}
+
+	claims := &structpb.Struct{}
+	if err := protojson.Unmarshal(payload, claims); err != nil {
+		return types.NewErr("invalid JWT claims: %v", err)
+	}
+
+	return types.DefaultTypeAdapter.NativeToValue(claims)
+}
+
+const (
+	// earthRadiusMetres is the mean radius of the Earth as defined by the IUGG.
+	earthRadiusMetres = 6371008.8
+	maxLatitude       = 90
+	maxLongitude      = 180
+)
+
+// geoDistance returns the great-circle distance in metres between two points given as latitude and longitude in degrees.
+func geoDistance(args ...ref.Val) ref.Val {
+	coords := make([]float64, len(args))
+	for i, arg := range args {
+		c, ok := toFloat(arg)
+		if !ok {
+			return types.MaybeNoSuchOverloadErr(arg)
+		}
+		coords[i] = c
+	}
+
+	lat1, lon1, lat2, lon2 := coords[0], coords[1], coords[2], coords[3]
+	if err := checkCoordinates(lat1, lon1); err != nil {
+		return err
+	}
+
+	if err := checkCoordinates(lat2, lon2); err != nil {
+		return err
+	}
+
+	// haversine formula
+	phi1, phi2 := toRadians(lat1), toRadians(lat2)
+	dPhi, dLambda := toRadians(lat2-lat1), toRadians(lon2-lon1)
+	a := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2) //nolint:gomnd
+
+	return types.Double(2 * earthRadiusMetres * math.Asin(math.Min(1, math.Sqrt(a)))) //nolint:gomnd
+}
+
+// inGeoPolygon returns true if the point given as latitude and longitude is inside the polygon defined by a list of [latitude, longitude] vertices.
+// The edges of the polygon are treated as straight lines on an equirectangular projection, which is accurate enough for polygons that don't span
+// large distances or cross the antimeridian.
+func inGeoPolygon(args ...ref.Val) ref.Val {
+	lat, ok := toFloat(args[0])
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(args[0])
+	}
+
+	lon, ok := toFloat(args[1])
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(args[1])
+	}
+
+	if err := checkCoordinates(lat, lon); err != nil {
+		return err
+	}
+
+	vertices, ok := args[2].(traits.Lister)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(args[2])
+	}
+
+	var polygon [][2]float64
+	for it := vertices.Iterator(); it.HasNext() == types.True; {
+		item := it.Next()
+		vertex, ok := item.(traits.Lister)
+		if !ok {
+			return types.MaybeNoSuchOverloadErr(item)
+		}
+
+		if vertex.Size() != types.Int(2) { //nolint:gomnd
+			return types.NewErr("polygon vertex must be a [latitude, longitude] pair: got %v", vertex)
+		}
+
+		vLat, ok := toFloat(vertex.Get(types.Int(0)))
+		if !ok {
+			return types.MaybeNoSuchOverloadErr(vertex.Get(types.Int(0)))
+		}
+
+		vLon, ok := toFloat(vertex.Get(types.Int(1)))
+		if !ok {
+			return types.MaybeNoSuchOverloadErr(vertex.Get(types.Int(1)))
+		}
+
+		if err := checkCoordinates(vLat, vLon); err != nil {
+			return err
+		}
+
+		polygon = append(polygon, [2]float64{vLat, vLon})
+	}
+
+	if len(polygon) < 3 { //nolint:gomnd
+		return types.NewErr("polygon must have at least 3 vertices: got %d", len(polygon))
+	}
+
+	// ray casting: count the edges crossed by a ray extending east of the point
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		latI, lonI := polygon[i][0], polygon[i][1]
+		latJ, lonJ := polygon[j][0], polygon[j][1]
+		if (latI > lat) != (latJ > lat) && lon < (lonJ-lonI)*(lat-latI)/(latJ-latI)+lonI {
+			inside = !inside
+		}
+	}
+
+	return types.Bool(inside)
+}
+
+func checkCoordinates(lat, lon float64) ref.Val {
+	if math.Abs(lat) > maxLatitude {
+		return types.NewErr("latitude must be between -%d and %d: got %v", maxLatitude, maxLatitude, lat)
+	}
+
+	if math.Abs(lon) > maxLongitude {
+		return types.NewErr("longitude must be between -%d and %d: got %v", maxLongitude, maxLongitude, lon)
+	}
+
+	return nil
+}
+
+func toFloat(val ref.Val) (float64, bool) {
+	switch v := val.(type) {
+	case types.Double:
+		return float64(v), true
+	case types.Int:
+		return float64(v), true
+	case types.Uint:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180 //nolint:gomnd
+}
+
+// base64DecodeToString decodes a standard base64 string (padding is optional) and returns the result as a string.
+func base64DecodeToString(val ref.Val) ref.Val {
+	s, ok := val.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(val)
+	}
+
+	enc := base64.StdEncoding
+	if !strings.HasSuffix(string(s), "=") {
+		enc = base64.RawStdEncoding
+	}
+
+	b, err := enc.DecodeString(string(s))
+	if err != nil {
+		return types.NewErr("failed to decode base64 string: %v", err)
+	}
+
+	return bytesToString(b)
+}
+
+func hexEncode(val ref.Val) ref.Val {
+	b, err := toBytes(val)
+	if err != nil {
+		return err
+	}
+
+	return types.String(hex.EncodeToString(b))
+}
+
+func hexDecode(val ref.Val) ref.Val {
+	s, ok := val.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(val)
+	}
+
+	b, err := hex.DecodeString(string(s))
+	if err != nil {
+		return types.NewErr("failed to decode hex string: %v", err)
+	}
+
+	return types.Bytes(b)
+}
+
+func hexDecodeToString(val ref.Val) ref.Val {
+	decoded := hexDecode(val)
+	b, ok := decoded.(types.Bytes)
+	if !ok {
+		return decoded
+	}
+
+	return bytesToString(b)
+}
+
+func bytesToString(b []byte) ref.Val {
+	if !utf8.Valid(b) {
+		return types.NewErr("decoded value is not a valid UTF-8 string")
+	}
+
+	return types.String(b)
+}
+
+func toBytes(val ref.Val) ([]byte, ref.Val) {
+	switch v := val.(type) {
+	case types.String:
+		return []byte(v), nil
+	case types.Bytes:
+		return []byte(v), nil
+	default:
+		return nil, types.MaybeNoSuchOverloadErr(val)
+	}
+}
+
+func (clib cerbosLib) inIPAddrRangeFunc(ipAddrVal, cidrVal string) (bool, error) {
+	ipAddr := net.ParseIP(ipAddrVal)
+	if ipAddr == nil {
+		return false, fmt.Errorf("invalid IP address: %s", ipAddrVal)
+	}
+
+	_, cidr, err := net.ParseCIDR(cidrVal)
+	if err != nil {
+		return false, err
+	}
+
+	return cidr.Contains(ipAddr), nil
+}
+
+func (clib cerbosLib) inCIDRListFunc(ipAddrVal, cidrsVal ref.Val) ref.Val {
+	ipAddr, ok := ipAddrVal.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(ipAddrVal)
+	}
+
+	cidrs, ok := cidrsVal.(traits.Lister)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(cidrsVal)
+	}
+
+	for it := cidrs.Iterator(); it.HasNext() == types.True; {
+		item := it.Next()
+		cidr, ok := item.(types.String)
+		if !ok {
+			return types.MaybeNoSuchOverloadErr(item)
+		}
+
+		in, err := clib.inIPAddrRangeFunc(string(ipAddr), string(cidr))
+		if err != nil {
+			return types.NewErr(err.Error())
+		}
+
+		if in {
+			return types.True
+		}
+	}
+
+	return types.False
+}
+
+func (clib cerbosLib) inIPRangeFunc(args ...ref.Val) ref.Val {
+	addrs := make([]net.IP, len(args))
+	for i, arg := range args {
+		s, ok := arg.(types.String)
+		if !ok {
+			return types.MaybeNoSuchOverloadErr(arg)
+		}
+
+		addr, err := parseIP(string(s))
+		if err != nil {
+			return types.NewErr(err.Error())
+		}
+		addrs[i] = addr
+	}
+
+	ipAddr, first, last := addrs[0], addrs[1], addrs[2]
+	if len(first) != len(ipAddr) || len(last) != len(ipAddr) {
+		return types.NewErr("IP addresses %s, %s and %s belong to different address families", args[0], args[1], args[2])
+	}
+
+	return types.Bool(bytes.Compare(first, ipAddr) <= 0 && bytes.Compare(ipAddr, last) <= 0)
+}
+
+// isUUID returns true if the string is a UUID in the canonical 8-4-4-4-12 hexadecimal format.
+func isUUID(val ref.Val) ref.Val {
+	s, ok := val.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(val)
+	}
+
+	_, err := parseUUID(string(s))
+	return types.Bool(err == nil)
+}
+
+// isULID returns true if the string is a ULID.
+func isULID(val ref.Val) ref.Val {
+	s, ok := val.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(val)
+	}
+
+	_, err := ulid.ParseStrict(string(s))
+	return types.Bool(err == nil)
+}
+
+// uuidVersion returns the version number of the UUID.
+func uuidVersion(val ref.Val) ref.Val {
+	s, ok := val.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(val)
+	}
+
+	id, err := parseUUID(string(s))
+	if err != nil {
+		return types.NewErr("%s: %v", uuidVersionFn, err)
+	}
+
+	return types.Int(id.Version())
+}
+
+// idTimestamp returns the creation time embedded in a UUIDv7 or ULID.
+func idTimestamp(val ref.Val) ref.Val {
+	s, ok := val.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(val)
+	}
+
+	id, err := parseTimeOrderedID(string(s))
+	if err != nil {
+		return types.NewErr("%s: %v", idTimestampFn, err)
+	}
+
+	return types.Timestamp{Time: ulid.Time(id.Time()).UTC()}
+}
+
+// compareIDs returns -1, 0 or 1 if the first UUIDv7 or ULID was created before, at the same time as or after the second.
+// IDs created in the same millisecond are ordered by their random bits.
+func compareIDs(lhsVal, rhsVal ref.Val) ref.Val {
+	lhsStr, ok := lhsVal.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(lhsVal)
+	}
+
+	rhsStr, ok := rhsVal.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(rhsVal)
+	}
+
+	lhs, err := parseTimeOrderedID(string(lhsStr))
+	if err != nil {
+		return types.NewErr("%s: %v", compareIDsFn, err)
+	}
+
+	rhs, err := parseTimeOrderedID(string(rhsStr))
+	if err != nil {
+		return types.NewErr("%s: %v", compareIDsFn, err)
+	}
+
+	return types.Int(lhs.Compare(rhs))
+}
+
+func parseUUID(s string) (uuid.UUID, error) {
+	// uuid.Parse also accepts URNs and other encodings, which are not valid IDs for our purposes.
+	if len(s) != uuidStrLen {
+		return uuid.Nil, fmt.Errorf("invalid UUID: %q", s)
+	}
+
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid UUID %q: %w", s, err)
+	}
+
+	return id, nil
+}
+
+// parseTimeOrderedID parses a ULID or a UUIDv7. Both formats start with a 48-bit big-endian Unix timestamp in milliseconds,
+// so a UUIDv7 can be treated as a ULID to extract the timestamp and to compare it with other IDs.
+func parseTimeOrderedID(s string) (ulid.ULID, error) {
+	if len(s) == ulid.EncodedSize {
+		id, err := ulid.ParseStrict(s)
+		if err != nil {
+			return id, fmt.Errorf("invalid ULID %q: %w", s, err)
+		}
+		return id, nil
+	}
+
+	id, err := parseUUID(s)
+	if err != nil {
+		return ulid.ULID{}, err
+	}
+
+	if id.Version() != 7 { //nolint:gomnd
+		return ulid.ULID{}, fmt.Errorf("UUID %q is version %d instead of version 7", s, id.Version())
+	}
+
+	return ulid.ULID(id), nil
+}
+
+// isEmail returns true if the string is a plain email address (without a display name) with a valid domain name.
+func isEmail(s string) (bool, error) {
+	_, err := parseEmailDomain(s)
+	return err == nil, nil
+}
+
+// emailDomain returns the lowercase domain of an email address.
+func emailDomain(val ref.Val) ref.Val {
+	s, ok := val.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(val)
+	}
+
+	domain, err := parseEmailDomain(string(s))
+	if err != nil {
+		return types.NewErr("%s: %v", emailDomainFn, err)
+	}
+
+	return types.String(domain)
+}
+
+// parseEmailDomain validates an email address and returns its domain in lowercase.
+func parseEmailDomain(s string) (string, error) {
+	if len(s) > maxEmailLen {
+		return "", fmt.Errorf("email address is longer than %d characters", maxEmailLen)
+	}
+
+	addr, err := mail.ParseAddress(s)
+	// reject display names and comments. String quotes the local part if necessary, so quoted local parts are accepted.
+	if err != nil || addr.Name != "" || addr.String() != "<"+s+">" {
+		return "", fmt.Errorf("invalid email address: %q", s)
+	}
+
+	at := strings.LastIndexByte(s, '@')
+	if at > maxEmailLocalPartLen {
+		return "", fmt.Errorf("local part of email address is longer than %d characters", maxEmailLocalPartLen)
+	}
+
+	domain := strings.ToLower(s[at+1:])
+	if !isDomainName(domain) {
+		return "", fmt.Errorf("invalid domain in email address: %q", s)
+	}
+
+	return domain, nil
+}
+
+// isDomainName returns true if the string is a fully-qualified ASCII domain name with at least two labels.
+// Internationalised domain names must be in their punycode form.
+func isDomainName(domain string) bool {
+	if len(domain) > maxDomainLen {
+		return false
+	}
+
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 { //nolint:gomnd
+		return false
+	}
+
+	for _, label := range labels {
+		if label == "" || len(label) > maxDomainLabelLen || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for _, c := range label {
+			if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+				return false
+			}
+		}
+	}
+
+	// top-level domains are never numeric, so this rejects IP addresses
+	tld := labels[len(labels)-1]
+	return strings.Trim(tld, "0123456789") != ""
+}
+
+// isPhoneNumber returns true if the string is a phone number in E.164 format.
+func isPhoneNumber(s string) (bool, error) {
+	return e164Regexp.MatchString(s), nil
+}
+
+func (clib cerbosLib) isPrivateIPFunc(ipAddrVal string) (bool, error) {
+	ipAddr, err := parseIP(ipAddrVal)
+	if err != nil {
+		return false, err
+	}
+
+	return ipAddr.IsPrivate(), nil
+}
+
+// parseIP parses an IP address and returns it in its shortest form, so that addresses from the same family can be compared.
+func parseIP(ipAddrVal string) (net.IP, error) {
+	ipAddr := net.ParseIP(ipAddrVal)
+	if ipAddr == nil {
+		return nil, fmt.Errorf("invalid IP address: %s", ipAddrVal)
+	}
+
+	if ip4 := ipAddr.To4(); ip4 != nil {
+		return ip4, nil
+	}
+
+	return ipAddr, nil
+}
+
+func callInStringOutBool(fn func(string) (bool, error)) functions.UnaryOp {
+	return func(val ref.Val) ref.Val {
+		s, ok := val.(types.String)
+		if !ok {
+			return types.MaybeNoSuchOverloadErr(val)
+		}
+
+		retVal, err := fn(string(s))
+		if err != nil {
+			return types.NewErr(err.Error())
+		}
+
+		return types.DefaultTypeAdapter.NativeToValue(retVal)
+	}
+}
+
+func callInStringStringOutBool(fn func(string, string) (bool, error)) functions.BinaryOp {
+	return func(lhsVal, rhsVal ref.Val) ref.Val {
+		lhs, ok := lhsVal.(types.String)
+		if !ok {
+			return types.MaybeNoSuchOverloadErr(lhsVal)
+		}
+
+		rhs, ok := rhsVal.(types.String)
+		if !ok {
+			return types.MaybeNoSuchOverloadErr(rhsVal)
+		}
+
+		retVal, err := fn(string(lhs), string(rhs))
+		if err != nil {
+			return types.NewErr(err.Error())
+		}
+
+		return types.DefaultTypeAdapter.NativeToValue(retVal)
+	}
+}
+
+func callInTimestampOutDuration(fn func(time.Time) time.Duration) functions.UnaryOp {
+	return func(val ref.Val) ref.Val {
+		ts, ok := val.(types.Timestamp)
+		if !ok {
+			return types.MaybeNoSuchOverloadErr(val)
+		}
+
+		return types.DefaultTypeAdapter.NativeToValue(fn(ts.Time))
+	}
+}
+
+// inTimezone returns the timestamp in the given time zone, so that accessors such as getHours operate on local time.
+// The time zone can be an IANA name such as Europe/London or a UTC offset such as +05:30.
+func inTimezone(tsVal, tzVal ref.Val) ref.Val {
+	ts, ok := tsVal.(types.Timestamp)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(tsVal)
+	}
+
+	tz, ok := tzVal.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(tzVal)
+	}
+
+	loc, err := loadLocation(string(tz))
+	if err != nil {
+		return types.NewErr(err.Error())
+	}
+
+	return types.Timestamp{Time: ts.Time.In(loc)}
+}
+
+func loadLocation(tz string) (*time.Location, error) {
+	// the result of a condition must not depend on the time zone of the host running the PDP
+	if tz == "Local" {
+		return nil, fmt.Errorf("invalid time zone %q: the local time zone is not supported", tz)
+	}
+
+	if tz == "" || (tz[0] != '+' && tz[0] != '-') {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", tz, err)
+		}
+
+		return loc, nil
+	}
+
+	offset, err := time.Parse("-07:00", tz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone offset %q: expected format is +HH:MM or -HH:MM", tz)
+	}
+
+	_, secs := offset.Zone()
+	return time.FixedZone(tz, secs), nil
+}
+
+// isBusinessDay returns true if the timestamp falls on a weekday in its time zone.
+func isBusinessDay(tsVal ref.Val) ref.Val {
+	ts, ok := tsVal.(types.Timestamp)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(tsVal)
+	}
+
+	switch ts.Time.Weekday() {
+	case time.Saturday, time.Sunday:
+		return types.False
+	default:
+		return types.True
+	}
+}
+
+// betweenTimesOfDay returns true if the time of day of the timestamp (in its time zone) is at or after start and before end.
+// If end is earlier than start, the window wraps around midnight.
+func betweenTimesOfDay(args ...ref.Val) ref.Val {
+	ts, ok := args[0].(types.Timestamp)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(args[0])
+	}
+
+	bounds := make([]time.Duration, 2) //nolint:gomnd
+	for i, arg := range args[1:] {
+		s, ok := arg.(types.String)
+		if !ok {
+			return types.MaybeNoSuchOverloadErr(arg)
+		}
+
+		d, err := parseTimeOfDay(string(s))
+		if err != nil {
+			return types.NewErr(err.Error())
+		}
+		bounds[i] = d
+	}
+
+	h, m, sec := ts.Time.Clock()
+	tod := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(sec)*time.Second
+	start, end := bounds[0], bounds[1]
+	if start <= end {
+		return types.Bool(start <= tod && tod < end)
+	}
+
+	return types.Bool(tod >= start || tod < end)
+}
+
+// parseTimeOfDay parses a time of day in HH:MM or HH:MM:SS format and returns the duration since midnight.
+func parseTimeOfDay(s string) (time.Duration, error) {
+	layout := "15:04"
+	if strings.Count(s, ":") == 2 { //nolint:gomnd
+		layout = "15:04:05"
+	}
+
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q: expected format is HH:MM or HH:MM:SS", s)
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second, nil
 }
 
 func callInNothingOutTimestamp(fn func() time.Time) functions.FunctionOp {
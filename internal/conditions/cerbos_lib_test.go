@@ -33,6 +33,28 @@ func TestCerbosLib(t *testing.T) {
 		{expr: `"test".inIPAddrRange("192.168.0.0/24") == false`, wantErr: true},
 		{expr: `"2001:0db8:0000:0000:0000:0000:1000:0000".inIPAddrRange("2001:db8::/48") == true`},
 		{expr: `"3001:0fff:0000:0000:0000:0000:0000:0000".inIPAddrRange("2001:db8::/48") == false`},
+		{expr: `inCIDR("10.20.0.13", "10.0.0.0/8")`},
+		{expr: `inCIDR("11.20.0.13", "10.0.0.0/8") == false`},
+		{expr: `"10.20.0.13".inCIDR("10.0.0.0/8")`},
+		{expr: `inCIDR("192.168.1.5", ["10.0.0.0/8", "192.168.0.0/16"])`},
+		{expr: `"2001:db8::1".inCIDR(["10.0.0.0/8", "2001:db8::/48"])`},
+		{expr: `inCIDR("172.16.0.1", ["10.0.0.0/8", "192.168.0.0/16"]) == false`},
+		{expr: `inCIDR("172.16.0.1", []) == false`},
+		{expr: `inCIDR("test", "10.0.0.0/8")`, wantErr: true},
+		{expr: `inCIDR("10.0.0.1", "10.0.0.0")`, wantErr: true},
+		{expr: `isPrivateIP("10.20.0.13")`},
+		{expr: `"192.168.0.1".isPrivateIP()`},
+		{expr: `"fd00::1".isPrivateIP()`},
+		{expr: `isPrivateIP("8.8.8.8") == false`},
+		{expr: `isPrivateIP("2001:db8::1") == false`},
+		{expr: `isPrivateIP("test")`, wantErr: true},
+		{expr: `inIPRange("10.0.0.5", "10.0.0.1", "10.0.0.10")`},
+		{expr: `inIPRange("10.0.0.1", "10.0.0.1", "10.0.0.10")`},
+		{expr: `inIPRange("10.0.0.10", "10.0.0.1", "10.0.0.10")`},
+		{expr: `"10.0.0.11".inIPRange("10.0.0.1", "10.0.0.10") == false`},
+		{expr: `"2001:db8::ff".inIPRange("2001:db8::1", "2001:db8::1:0")`},
+		{expr: `inIPRange("::ffff:10.0.0.5", "10.0.0.1", "10.0.0.10")`},
+		{expr: `inIPRange("2001:db8::1", "10.0.0.1", "10.0.0.10")`, wantErr: true},
 		{expr: `timestamp("2021-05-01T00:00:00.000Z").timeSince() > duration("1h")`},
 		{expr: `has_intersection([1,2,3],[3,5])`},
 		{expr: `has_intersection([1,2,3],[4,5]) == false`},
@@ -66,6 +88,16 @@ func TestCerbosLib(t *testing.T) {
 		{expr: `[1].except([1,2,3]) == []`},
 		{expr: `[1,3,5].except([2,4]) == [1,3,5]`},
 		{expr: `[1,3,5].except([5,3]) == [1]`},
+		{expr: `[1,3,5].difference([5,3]) == [1]`},
+		{expr: `difference([1,3,5], [2,4]) == [1,3,5]`},
+		{expr: `[].difference([1]) == []`},
+		{expr: `[1,2,3].union([3,5]) == [1,2,3,5]`},
+		{expr: `union(["a"], ["b", "a"]) == ["a", "b"]`},
+		{expr: `[].union([]) == []`},
+		{expr: `[[1],[2]].union([[2],[3]]) == [[1],[2],[3]]`},
+		{expr: `[1,2,3].symmetricDifference([3,4]) == [1,2,4]`},
+		{expr: `symmetricDifference(["a","b"], ["a","b"]) == []`},
+		{expr: `[].symmetricDifference([1]) == [1]`},
 		{expr: `[1,2,3] + [3,5] == [1,2,3,3,5]`},
 		{expr: `hierarchy("a.b.c.d") == hierarchy("a.b.c.d")`},
 		{expr: `hierarchy("a.b.c.d") != hierarchy("a.b.c.d.e")`},
@@ -95,6 +127,198 @@ func TestCerbosLib(t *testing.T) {
 		{expr: `hierarchy("a.b.c.d").overlaps(hierarchy("a.b.c")) == true`},
 		{expr: `hierarchy("a.b").overlaps(hierarchy("a.b.c.d")) == true`},
 		{expr: `hierarchy("a.b.x").overlaps(hierarchy("a.b.c.d")) == false`},
+		{expr: `semver("1.2.3") == semver("v1.2.3")`},
+		{expr: `semver("1.2.3") != semver("1.2.4")`},
+		{expr: `semver("1.2.3").isAtLeast("1.2.3")`},
+		{expr: `semver("1.10.0").isAtLeast("1.9.1")`},
+		{expr: `semver("1.2.3").isAtLeast(semver("1.3.0")) == false`},
+		{expr: `semver("2.0.0-beta.1").isAtLeast("2.0.0") == false`},
+		{expr: `semver("1.2.3").isAtMost("1.2.3")`},
+		{expr: `semver("1.2.3").isAtMost("1.2.2") == false`},
+		{expr: `semver("1.2.3").isGreaterThan("1.2.3-rc.1")`},
+		{expr: `semver("1.2.3").isGreaterThan("1.2.3") == false`},
+		{expr: `semver("1.2.3").isLessThan(semver("1.2.10"))`},
+		{expr: `semver("1.2.3").isLessThan("1.2.3") == false`},
+		{expr: `semver("1.2.3").compareTo("1.2.4") == -1`},
+		{expr: `semver("1.2.3").compareTo("1.2.3+build.5") == 0`},
+		{expr: `semver("3.2.1").major() == 3 && semver("3.2.1").minor() == 2 && semver("3.2.1").patch() == 1`},
+		{expr: `semver("v1.2") == semver("1.2.0")`},
+		{expr: `semver("1.2.3") == dyn("1.2.3") == false`},
+		{expr: `semver("1.2.3") != dyn(1)`},
+		{expr: `semver("one.two").isAtLeast("1.0.0")`, wantErr: true},
+		{expr: `decimal("0.1").add("0.2") == decimal("0.3")`},
+		{expr: `decimal(0.1).add(0.2) == decimal(0.3)`},
+		{expr: `decimal("19.99").mul(3) == decimal("59.97")`},
+		{expr: `decimal(10).sub(decimal("0.01")) == decimal("9.99")`},
+		{expr: `decimal(10u).div(4) == decimal("2.5")`},
+		{expr: `decimal(1).div(3).round(2) == decimal("0.33")`},
+		{expr: `decimal("2.345").round(2) == decimal("2.35")`},
+		{expr: `decimal("-2.345").round(2) == decimal("-2.35")`},
+		{expr: `decimal("1250").round(-2) == decimal(1300)`},
+		{expr: `decimal("10.50") == decimal("10.5")`},
+		{expr: `decimal("100.00").isAtLeast(100)`},
+		{expr: `decimal("99.99").isAtMost("100")`},
+		{expr: `decimal("100.01").isGreaterThan(decimal(100))`},
+		{expr: `decimal("99.995").isLessThan(100.0)`},
+		{expr: `decimal("1.10").compareTo("1.1") == 0 && decimal("1.1").compareTo("1.2") == -1`},
+		{expr: `decimal("ten")`, wantErr: true},
+		{expr: `decimal(1).div(0)`, wantErr: true},
+		{expr: `decimal(1).round(100)`, wantErr: true},
+		{expr: `decimal("1e100")`, wantErr: true},
+		{expr: `semver("1.2.3").isAtLeast("latest")`, wantErr: true},
+		{expr: `inTimezone(timestamp("2023-03-01T23:30:00Z"), "Asia/Tokyo").getHours() == 8`},
+		{expr: `timestamp("2023-03-01T23:30:00Z").inTimezone("Asia/Tokyo").getDayOfWeek() == 4`},
+		{expr: `timestamp("2023-07-01T12:00:00Z").inTimezone("Europe/London").getHours() == 13`},
+		{expr: `inTimezone(timestamp("2021-04-20T10:00:20.021-05:00"), "Europe/London").getHours() == 16`},
+		{expr: `timestamp("2023-03-01T12:00:00Z").inTimezone("-05:30").getHours() == 6`},
+		{expr: `timestamp("2023-03-01T12:00:00Z").inTimezone("-05:30").getMinutes() == 30`},
+		{expr: `timestamp("2023-03-01T12:00:00Z").inTimezone("Mars/Olympus_Mons")`, wantErr: true},
+		{expr: `timestamp("2023-03-01T12:00:00Z").inTimezone("+5")`, wantErr: true},
+		{expr: `timestamp("2023-03-01T12:00:00Z").inTimezone("Local")`, wantErr: true},
+		{expr: `timestamp("2023-03-03T12:00:00Z").isBusinessDay("Local")`, wantErr: true},
+		{expr: `inTimezone(timestamp("2023-03-01T12:00:00Z"), "Asia/Tokyo") == timestamp("2023-03-01T12:00:00Z")`},
+		{expr: `timestamp("2023-03-03T12:00:00Z").isBusinessDay()`},
+		{expr: `timestamp("2023-03-04T12:00:00Z").isBusinessDay() == false`},
+		{expr: `timestamp("2023-03-03T20:00:00Z").isBusinessDay("Asia/Tokyo") == false`},
+		{expr: `timestamp("2023-03-05T20:00:00Z").inTimezone("Asia/Tokyo").isBusinessDay()`},
+		{expr: `timestamp("2023-03-01T09:00:00Z").betweenTimesOfDay("09:00", "17:30")`},
+		{expr: `timestamp("2023-03-01T17:30:00Z").betweenTimesOfDay("09:00", "17:30") == false`},
+		{expr: `timestamp("2023-03-01T17:29:59Z").betweenTimesOfDay("09:00", "17:30:00")`},
+		{expr: `timestamp("2023-03-01T08:00:00Z").inTimezone("Europe/Paris").betweenTimesOfDay("09:00", "17:00")`},
+		{expr: `timestamp("2023-03-01T23:00:00Z").betweenTimesOfDay("22:00", "06:00")`},
+		{expr: `timestamp("2023-03-01T03:00:00Z").betweenTimesOfDay("22:00", "06:00")`},
+		{expr: `timestamp("2023-03-01T12:00:00Z").betweenTimesOfDay("22:00", "06:00") == false`},
+		{expr: `timestamp("2023-03-01T12:00:00Z").betweenTimesOfDay("9am", "5pm")`, wantErr: true},
+		{expr: `"arn:aws:s3:us-east-1:123456789012:bucket/reports".extract("^arn:aws:([^:]+):([^:]*):(\\d+):") == ["s3", "us-east-1", "123456789012"]`},
+		{expr: `"/orgs/acme/teams/red".extract("^/orgs/[^/]+") == ["/orgs/acme"]`},
+		{expr: `"/orgs/acme/teams/red".extract("^/users/(.+)") == []`},
+		{expr: `"/orgs/acme/teams/red".extract("/teams/(\\w+)")[0] == "red"`},
+		{expr: `"/orgs/acme".extract("^/orgs/(\\w+)(/teams/(\\w+))?$") == ["acme", "", ""]`},
+		{expr: `"/orgs/acme".extract("^/orgs/(")`, wantErr: true},
+		{expr: `"/orgs/acme/teams/red".extractNamed("^/orgs/(?P<org>[^/]+)/teams/(?P<team>[^/]+)$") == {"org": "acme", "team": "red"}`},
+		{expr: `"/orgs/acme/teams/red".extractNamed("^/orgs/(?P<org>[^/]+)/teams/([^/]+)$") == {"org": "acme"}`},
+		{expr: `"/orgs/acme/teams/red".extractNamed("^/orgs/(?P<org>[^/]+)/teams/(?P<team>[^/]+)$").team == "red"`},
+		{expr: `"/users/jane".extractNamed("^/orgs/(?P<org>[^/]+)") == {}`},
+		{expr: `"/users/jane".extractNamed("(?P<bad")`, wantErr: true},
+		{expr: `getOrDefault({"a": {"b": {"c": 1}}}, "a.b.c", 0) == 1`},
+		{expr: `getOrDefault({"a": {"b": {"c": 1}}}, "a.b", {}) == {"c": 1}`},
+		{expr: `getOrDefault({"a": {"b": {"c": 1}}}, "a.x.c", 0) == 0`},
+		{expr: `getOrDefault({"a": {"b": "c"}}, "a.b.c", "none") == "none"`},
+		{expr: `getOrDefault({"a": {"b": null}}, "a.b", "none") == "none"`},
+		{expr: `{"a": {"b": {"c": true}}}.getOrDefault("a.b.c", false)`},
+		{expr: `getOrDefault({"a": 1}, "a..b", 0)`, wantErr: true},
+		{expr: `getOrDefault({"a": 1}, "", 0)`, wantErr: true},
+		{expr: `hasPath({"a": {"b": {"c": 1}}}, "a.b.c")`},
+		{expr: `hasPath({"a": {"b": {"c": 1}}}, "a")`},
+		{expr: `hasPath({"a": {"b": {"c": 1}}}, "a.c") == false`},
+		{expr: `hasPath({"a": {"b": {"c": 1}}}, "a.b.c.d") == false`},
+		{expr: `hasPath({"a": {"b": null}}, "a.b") == false`},
+		{expr: `{"a": {"b": 1}}.hasPath("a.b")`},
+		{expr: `hasPath({"a": 1}, "a.")`, wantErr: true},
+		{expr: `isEmail("jane.doe+leave@example.com")`},
+		{expr: `"Jane@Mail.Example.CO.UK".isEmail()`},
+		{expr: `isEmail("\"jane doe\"@example.com")`},
+		{expr: `isEmail("Jane <jane@example.com>") == false`},
+		{expr: `isEmail("jane@example.com (Jane)") == false`},
+		{expr: `isEmail("jane@localhost") == false`},
+		{expr: `isEmail("jane@[192.168.0.1]") == false`},
+		{expr: `isEmail("jane@192.168.0.1") == false`},
+		{expr: `isEmail("jane@-example.com") == false`},
+		{expr: `isEmail("jane@example..com") == false`},
+		{expr: `isEmail("jane.example.com") == false`},
+		{expr: `isEmail("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa@example.com") == false`},
+		{expr: `isEmail("") == false`},
+		{expr: `emailDomain("jane@Mail.Example.com") == "mail.example.com"`},
+		{expr: `"jane@example.com".emailDomain() == "example.com"`},
+		{expr: `emailDomain("jane")`, wantErr: true},
+		{expr: `isPhoneNumber("+447911123456")`},
+		{expr: `"+14155552671".isPhoneNumber()`},
+		{expr: `isPhoneNumber("+4407911123456789") == false`},
+		{expr: `isPhoneNumber("07911123456") == false`},
+		{expr: `isPhoneNumber("+44 7911 123456") == false`},
+		{expr: `isPhoneNumber("+0123456") == false`},
+		{expr: `isUUID("01890a5d-ac96-774b-bcce-b302099a8057")`},
+		{expr: `"f47ac10b-58cc-4372-a567-0e02b2c3d479".isUUID()`},
+		{expr: `isUUID("urn:uuid:f47ac10b-58cc-4372-a567-0e02b2c3d479") == false`},
+		{expr: `isUUID("01H455VB4PEX5VSKNK084SN02Q") == false`},
+		{expr: `isULID("01H455VB4PEX5VSKNK084SN02Q")`},
+		{expr: `"01h455vb4pex5vsknk084sn02q".isULID()`},
+		{expr: `isULID("01H455VB4PEX5VSKNK084SN02U") == false`},
+		{expr: `isULID("01890a5d-ac96-774b-bcce-b302099a8057") == false`},
+		{expr: `uuidVersion("01890a5d-ac96-774b-bcce-b302099a8057") == 7`},
+		{expr: `"f47ac10b-58cc-4372-a567-0e02b2c3d479".uuidVersion() == 4`},
+		{expr: `uuidVersion("not-a-uuid")`, wantErr: true},
+		{expr: `idTimestamp("01890a5d-ac96-774b-bcce-b302099a8057") == timestamp("2023-06-30T03:34:18.518Z")`},
+		{expr: `"01H455VB4PEX5VSKNK084SN02Q".idTimestamp() == timestamp("2023-06-30T03:34:18.518Z")`},
+		{expr: `idTimestamp("01H455VB4PEX5VSKNK084SN02Q") < timestamp("2023-07-01T00:00:00Z")`},
+		{expr: `idTimestamp("f47ac10b-58cc-4372-a567-0e02b2c3d479")`, wantErr: true},
+		{expr: `idTimestamp("01H455VB4P")`, wantErr: true},
+		{expr: `compareIDs("01890a5d-ac96-774b-bcce-b302099a8057", "01H455VB4PEX5VSKNK084SN02Q") == 0`},
+		{expr: `compareIDs("01H455VB4PEX5VSKNK084SN02Q", "01H455VC3Y0000000000000000") == -1`},
+		{expr: `"01H455VC3Y0000000000000000".compareIDs("01890a5d-ac96-774b-bcce-b302099a8057") == 1`},
+		{expr: `compareIDs("01H455VB4PEX5VSKNK084SN02Q", "f47ac10b-58cc-4372-a567-0e02b2c3d479")`, wantErr: true},
+		{expr: `sha256("hello") == "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"`},
+		{expr: `sha256(b"hello") == sha256("hello")`},
+		{expr: `sha256("") == "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"`},
+		{expr: `hmacSHA256("key", "The quick brown fox jumps over the lazy dog") == "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"`},
+		{expr: `hmacSHA256(b"key", b"The quick brown fox jumps over the lazy dog") == hmacSHA256("key", "The quick brown fox jumps over the lazy dog")`},
+		{expr: `bucket("alice", "feature-x", 100) == 22`},
+		{expr: `bucket("bob", "feature-x", 100) == 98`},
+		{expr: `bucket("alice", "feature-y", 100) == 78`},
+		{expr: `bucket("alice", "feature-x", 1) == 0`},
+		{expr: `bucket("alice", "feature-x", 100) == bucket("alice", "feature-x", 100)`},
+		{expr: `bucket("alice", "feature-x", 0) == 0`, wantErr: true},
+		{expr: `bucket("alice", "feature-x", -10) == 0`, wantErr: true},
+		{expr: `constantTimeEquals("abc", "abc")`},
+		{expr: `constantTimeEquals("abc", "abd") == false`},
+		{expr: `constantTimeEquals("abc", "abcd") == false`},
+		{expr: `constantTimeEquals(b"abc", b"abc")`},
+		{expr: `constantTimeEquals(sha256("hello"), "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824")`},
+		{expr: `decodeJWT("eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.eyJzdWIiOiJqb2huIiwiYXVkIjpbImNlcmJvcyIsImFjbWUiXSwiZXhwIjoxNzAwMDAwMDAwLCJvcmciOnsiaWQiOiJhY21lIn19.c2ln").sub == "john"`},
+		{expr: `"acme" in decodeJWT("eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.eyJzdWIiOiJqb2huIiwiYXVkIjpbImNlcmJvcyIsImFjbWUiXSwiZXhwIjoxNzAwMDAwMDAwLCJvcmciOnsiaWQiOiJhY21lIn19.c2ln").aud`},
+		{expr: `decodeJWT("eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.eyJzdWIiOiJqb2huIiwiYXVkIjpbImNlcmJvcyIsImFjbWUiXSwiZXhwIjoxNzAwMDAwMDAwLCJvcmciOnsiaWQiOiJhY21lIn19.c2ln").org.id == "acme"`},
+		{expr: `timestamp(int(decodeJWT("eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.eyJzdWIiOiJqb2huIiwiYXVkIjpbImNlcmJvcyIsImFjbWUiXSwiZXhwIjoxNzAwMDAwMDAwLCJvcmciOnsiaWQiOiJhY21lIn19.c2ln").exp)) == timestamp("2023-11-14T22:13:20Z")`},
+		{expr: `"nbf" in decodeJWT("eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.eyJzdWIiOiJqb2huIiwiYXVkIjpbImNlcmJvcyIsImFjbWUiXSwiZXhwIjoxNzAwMDAwMDAwLCJvcmciOnsiaWQiOiJhY21lIn19.c2ln") == false`},
+		{expr: `decodeJWT("not-a-jwt").sub == "john"`, wantErr: true},
+		{expr: `decodeJWT("a.!!!.c").sub == "john"`, wantErr: true},
+		{expr: `decodeJWT("eyJhbGciOiJub25lIn0.bm90LWpzb24.").sub == "john"`, wantErr: true},
+		{expr: `geoDistance(51.5074, -0.1278, 48.8566, 2.3522) > 343000.0 && geoDistance(51.5074, -0.1278, 48.8566, 2.3522) < 344000.0`},
+		{expr: `geoDistance(51.5074, -0.1278, 51.5074, -0.1278) == 0.0`},
+		{expr: `geoDistance(dyn(51), dyn(0), dyn(51), dyn(0)) == 0.0`},
+		{expr: `geoDistance(91.0, 0.0, 0.0, 0.0) > 0.0`, wantErr: true},
+		{expr: `geoDistance(0.0, 0.0, 0.0, -181.0) > 0.0`, wantErr: true},
+		{expr: `inGeoPolygon(51.5074, -0.1278, [[51.7, -0.5], [51.7, 0.3], [51.3, 0.3], [51.3, -0.5]])`},
+		{expr: `inGeoPolygon(48.8566, 2.3522, [[51.7, -0.5], [51.7, 0.3], [51.3, 0.3], [51.3, -0.5]]) == false`},
+		{expr: `inGeoPolygon(dyn(1), dyn(1), dyn([[0, 0], [0, 2], [2, 2], [2, 0]]))`},
+		{expr: `inGeoPolygon(51.5074, -0.1278, [[51.7, -0.5], [51.7, 0.3]])`, wantErr: true},
+		{expr: `inGeoPolygon(51.5074, -0.1278, [[51.7], [51.7, 0.3], [51.3, 0.3]])`, wantErr: true},
+		{expr: `inGeoPolygon(51.5074, -0.1278, [[95.0, -0.5], [51.7, 0.3], [51.3, 0.3]])`, wantErr: true},
+		{expr: `inGeoPolygon("51.5074", -0.1278, [[51.7, -0.5], [51.7, 0.3], [51.3, 0.3]])`, wantErr: true},
+		{expr: `geoDistance(51, 0, 52, 0) > 111000.0`},
+		{expr: `levenshtein("kitten", "sitting") == 3`},
+		{expr: `"kitten".levenshtein("kitten") == 0`},
+		{expr: `levenshtein("", "abc") == 3 && levenshtein("abc", "") == 3`},
+		{expr: `levenshtein("café", "cafe") == 1`},
+		{expr: `similarity("kitten", "kitten") == 1.0`},
+		{expr: `similarity("", "") == 1.0`},
+		{expr: `similarity("abc", "xyz") == 0.0`},
+		{expr: `"ACME Ltd".similarity("ACME Ltd.") > 0.85`},
+		{expr: `levenshtein(dyn(1), "abc") == 3`, wantErr: true},
+		{expr: `base64.decodeToString("aGVsbG8=") == "hello"`},
+		{expr: `base64.decodeToString("aGVsbG8") == "hello"`},
+		{expr: `base64.decodeToString("not base64!") == "hello"`, wantErr: true},
+		{expr: `base64.decodeToString("/w==") == "hello"`, wantErr: true},
+		{expr: `base64.decodeToString("aGVsbG8==") == "hello"`, wantErr: true},
+		{expr: `base64.decodeToString("aGVsbA=") == "hell"`, wantErr: true},
+		{expr: `base64.decodeToString("aGVsbA==") == "hell"`},
+		{expr: `base64.decodeToString("aGVs=bG8") == "hello"`, wantErr: true},
+		{expr: `hex.encode("hello") == "68656c6c6f"`},
+		{expr: `hex.encode(b"\xff\x00") == "ff00"`},
+		{expr: `hex.decode("68656C6C6F") == b"hello"`},
+		{expr: `hex.decode("xyz") == b"hello"`, wantErr: true},
+		{expr: `hex.decodeToString("68656c6c6f") == "hello"`},
+		{expr: `hex.decodeToString("ff") == "hello"`, wantErr: true},
+		{expr: `hex.decodeToString(hex.encode(base64.decodeToString("Y2Fmw6k="))) == "café"`},
 		{expr: `now().timeSince() == duration("0")`},
 		{expr: `now() == now()`},
 	}
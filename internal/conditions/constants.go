@@ -0,0 +1,191 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"go.uber.org/multierr"
+)
+
+const (
+	CELConstantsIdent  = "constants"
+	CELConstantsAbbrev = "C"
+)
+
+var (
+	errEmptyConstantName = errors.New("name must not be empty")
+	errEmptyConstantType = errors.New("type must not be empty")
+	errNilConstantValue  = errors.New("value must not be null")
+
+	constantNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+	constantTypes = map[string]*cel.Type{
+		"bool":   cel.BoolType,
+		"int":    cel.IntType,
+		"uint":   cel.UintType,
+		"double": cel.DoubleType,
+		"string": cel.StringType,
+	}
+
+	// constantVars are the values of the constants enabled by EnableFunctionPlugins.
+	constantVars map[string]any
+)
+
+// ConstantConf defines a named value with a declared type that is made available to policy conditions as `constants.<name>` or `C.<name>`.
+type ConstantConf struct {
+	// Value is the value of the constant.
+	Value any `yaml:"value" conf:"required,example=eu-west-1"`
+	// Name is the name of the constant in policy conditions.
+	Name string `yaml:"name" conf:"required,example=region"`
+	// Type is the CEL type of the constant. Possible values are bool, int, uint, double and string.
+	Type string `yaml:"type" conf:"required,example=string"`
+}
+
+func (cc *ConstantConf) Validate() (outErr error) {
+	switch {
+	case cc.Name == "":
+		outErr = multierr.Append(outErr, errEmptyConstantName)
+	case !constantNameRegexp.MatchString(cc.Name):
+		outErr = multierr.Append(outErr, fmt.Errorf("invalid name %q: must be a valid CEL identifier", cc.Name))
+	}
+
+	if cc.Type == "" {
+		return multierr.Append(outErr, errEmptyConstantType)
+	}
+
+	if _, err := cc.celValue(); err != nil {
+		outErr = multierr.Append(outErr, err)
+	}
+
+	return outErr
+}
+
+// celValue returns the value of the constant converted to its declared type.
+// Only primitive types are supported because constant values are embedded in compiled policies.
+func (cc *ConstantConf) celValue() (ref.Val, error) {
+	t, ok := constantTypes[cc.Type]
+	if !ok {
+		return nil, fmt.Errorf("unsupported type %q", cc.Type)
+	}
+
+	if cc.Value == nil {
+		return nil, errNilConstantValue
+	}
+
+	return convertConstantValue(t, cc.Value)
+}
+
+func convertConstantValue(t *cel.Type, value any) (ref.Val, error) {
+	switch t.Kind() {
+	case types.BoolKind:
+		if v, ok := value.(bool); ok {
+			return types.Bool(v), nil
+		}
+
+	case types.IntKind:
+		if v, ok := toInt64(value); ok {
+			return types.Int(v), nil
+		}
+
+	case types.UintKind:
+		if v, ok := toInt64(value); ok && v >= 0 {
+			return types.Uint(v), nil
+		}
+
+	case types.DoubleKind:
+		switch v := value.(type) {
+		case float64:
+			return types.Double(v), nil
+		case float32:
+			return types.Double(v), nil
+		default:
+			if i, ok := toInt64(value); ok {
+				return types.Double(i), nil
+			}
+		}
+
+	case types.StringKind:
+		if v, ok := value.(string); ok {
+			return types.String(v), nil
+		}
+	}
+
+	return nil, fmt.Errorf("value %v is not of type %s", value, t)
+}
+
+func toInt64(value any) (int64, bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, false
+		}
+		return int64(v), true
+	case float64:
+		if v != math.Trunc(v) || v > math.MaxInt64 || v < math.MinInt64 {
+			return 0, false
+		}
+		return int64(v), true
+	default:
+		return 0, false
+	}
+}
+
+// NewConstantsLibrary returns a CEL library that declares the constants defined in the configuration.
+// Like function plugins, it must be enabled using EnableFunctionPlugins before policies are compiled.
+func NewConstantsLibrary(confs []*ConstantConf) (cel.Library, error) {
+	lib := &constantsLib{vars: make(map[string]any, 2*len(confs))}
+	for _, cc := range confs {
+		if err := cc.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid constant [%s]: %w", cc.Name, err)
+		}
+
+		for _, prefix := range []string{CELConstantsIdent, CELConstantsAbbrev} {
+			name := prefix + "." + cc.Name
+			if _, ok := lib.vars[name]; ok {
+				return nil, fmt.Errorf("constant [%s] is defined more than once", cc.Name)
+			}
+
+			val, _ := cc.celValue()
+			lib.opts = append(lib.opts, cel.Constant(name, constantTypes[cc.Type], val))
+			lib.vars[name] = val
+		}
+	}
+
+	return lib, nil
+}
+
+// constantsLib declares constants in the CEL environment.
+// Checked expressions refer to the values of constants directly, but the query planner evaluates unchecked expressions
+// so the values are made available to it as variables as well.
+type constantsLib struct {
+	vars map[string]any
+	opts []cel.EnvOption
+}
+
+func (l *constantsLib) CompileOptions() []cel.EnvOption {
+	return l.opts
+}
+
+func (l *constantsLib) ProgramOptions() []cel.ProgramOption {
+	return nil
+}
+
+// ConstantVars returns the values of the enabled constants keyed by their qualified names (e.g. `constants.region`).
+// The returned map must not be modified.
+func ConstantVars() map[string]any {
+	return constantVars
+}
@@ -0,0 +1,104 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions
+
+import (
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstantConfValidate(t *testing.T) {
+	testCases := []struct {
+		conf    ConstantConf
+		wantErr bool
+	}{
+		{conf: ConstantConf{Name: "region", Type: "string", Value: "eu-west-1"}},
+		{conf: ConstantConf{Name: "replicas", Type: "int", Value: 3}},
+		{conf: ConstantConf{Name: "replicas", Type: "uint", Value: 3}},
+		{conf: ConstantConf{Name: "ratio", Type: "double", Value: 1}},
+		{conf: ConstantConf{Name: "ratio", Type: "double", Value: 0.5}},
+		{conf: ConstantConf{Name: "is_prod", Type: "bool", Value: true}},
+		{conf: ConstantConf{Type: "string", Value: "eu-west-1"}, wantErr: true},
+		{conf: ConstantConf{Name: "deployment.tier", Type: "string", Value: "gold"}, wantErr: true},
+		{conf: ConstantConf{Name: "region", Value: "eu-west-1"}, wantErr: true},
+		{conf: ConstantConf{Name: "region", Type: "timestamp", Value: "2023-01-01T00:00:00Z"}, wantErr: true},
+		{conf: ConstantConf{Name: "region", Type: "string"}, wantErr: true},
+		{conf: ConstantConf{Name: "region", Type: "string", Value: 1}, wantErr: true},
+		{conf: ConstantConf{Name: "replicas", Type: "int", Value: 1.5}, wantErr: true},
+		{conf: ConstantConf{Name: "replicas", Type: "uint", Value: -1}, wantErr: true},
+		{conf: ConstantConf{Name: "is_prod", Type: "bool", Value: "true"}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.conf.Name+"_"+tc.conf.Type, func(t *testing.T) {
+			err := tc.conf.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConstants(t *testing.T) {
+	resetFunctionPlugins(t)
+
+	_, err := NewConstantsLibrary([]*ConstantConf{
+		{Name: "region", Type: "string", Value: "eu-west-1"},
+		{Name: "region", Type: "string", Value: "us-east-1"},
+	})
+	require.Error(t, err, "duplicate constants should be rejected")
+
+	lib, err := NewConstantsLibrary([]*ConstantConf{
+		{Name: "region", Type: "string", Value: "eu-west-1"},
+		{Name: "replicas", Type: "int", Value: 3},
+	})
+	require.NoError(t, err)
+
+	RegisterFunctionPlugin("test.constants", lib)
+	require.NoError(t, EnableFunctionPlugins("test.constants"))
+
+	expr := `C.region == "eu-west-1" && constants.replicas > 2`
+
+	t.Run("checked", func(t *testing.T) {
+		ast, iss := StdEnv.Compile(expr)
+		require.NoError(t, iss.Err())
+		require.Equal(t, cel.BoolType, ast.OutputType())
+
+		prg, err := StdEnv.Program(ast)
+		require.NoError(t, err)
+
+		out, _, err := prg.Eval(map[string]any{})
+		require.NoError(t, err)
+		require.Equal(t, types.True, out)
+	})
+
+	t.Run("type_mismatch", func(t *testing.T) {
+		_, iss := StdEnv.Compile(`C.replicas == "3"`)
+		require.Error(t, iss.Err())
+	})
+
+	t.Run("undefined", func(t *testing.T) {
+		_, iss := StdEnv.Compile(`C.tier == "gold"`)
+		require.Error(t, iss.Err())
+	})
+
+	t.Run("unchecked", func(t *testing.T) {
+		// the query planner evaluates unchecked expressions, so the constants must be supplied as variables
+		ast, iss := StdPartialEnv.Parse(expr)
+		require.NoError(t, iss.Err())
+
+		prg, err := StdPartialEnv.Program(ast)
+		require.NoError(t, err)
+
+		out, _, err := prg.Eval(ConstantVars())
+		require.NoError(t, err)
+		require.Equal(t, types.True, out)
+	})
+}
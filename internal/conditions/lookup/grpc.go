@@ -0,0 +1,48 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package lookup
+
+import (
+	"context"
+	"crypto/tls"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type grpcBackend struct {
+	conn   *grpc.ClientConn
+	method string
+}
+
+func newGRPCBackend(ctx context.Context, conf *GRPCConf) (*grpcBackend, error) {
+	creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	if conf.Plaintext {
+		creds = insecure.NewCredentials()
+	}
+
+	conn, err := grpc.DialContext(ctx, conf.Addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcBackend{conn: conn, method: conf.Method}, nil
+}
+
+func (b *grpcBackend) fetch(ctx context.Context, key string) (*structpb.Value, error) {
+	value := &structpb.Value{}
+	if err := b.conn.Invoke(ctx, b.method, wrapperspb.String(key), value); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return value, nil
+}
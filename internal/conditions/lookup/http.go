@@ -0,0 +1,64 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package lookup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	keyPlaceholder  = "{key}"
+	maxResponseSize = 1 << 20 // 1 MiB
+)
+
+type httpBackend struct {
+	client  *http.Client
+	headers map[string]string
+	url     string
+}
+
+func newHTTPBackend(conf *HTTPConf) *httpBackend {
+	return &httpBackend{client: &http.Client{}, headers: conf.Headers, url: conf.URL}
+}
+
+func (b *httpBackend) fetch(ctx context.Context, key string) (*structpb.Value, error) {
+	u := strings.ReplaceAll(b.url, keyPlaceholder, url.PathEscape(key))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	for k, v := range b.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return decodeValue(body), nil
+}
@@ -0,0 +1,282 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package lookup provides the `lookup(source, key)` condition function, which retrieves values such as feature flags or
+// entitlements from external data sources. Each source is backed by an HTTP endpoint, a gRPC method or a Redis server.
+// Values are cached for a configurable period, so conditions don't call the source for every request.
+package lookup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bluele/gcache"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/multierr"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+)
+
+const (
+	cacheKind        = "lookup"
+	defaultCacheSize = 1024
+	defaultTimeout   = 500 * time.Millisecond
+	defaultTTL       = 1 * time.Minute
+	lookupFn         = "lookup"
+)
+
+var (
+	errEmptyName       = errors.New("name must not be empty")
+	errNoBackend       = errors.New("exactly one of http, grpc or redis must be configured")
+	errEmptyURL        = errors.New("http.url must not be empty")
+	errEmptyGRPCAddr   = errors.New("grpc.addr must not be empty")
+	errEmptyGRPCMethod = errors.New("grpc.method must not be empty")
+	errEmptyRedisAddr  = errors.New("redis.addr must not be empty")
+)
+
+// SourceConf defines an external data source that can be queried from policy conditions using `lookup("<name>", key)`.
+type SourceConf struct {
+	// HTTP configures a source that fetches values from an HTTP endpoint.
+	HTTP *HTTPConf `yaml:"http"`
+	// GRPC configures a source that fetches values by calling a gRPC method.
+	GRPC *GRPCConf `yaml:"grpc"`
+	// Redis configures a source that fetches values from a Redis server.
+	Redis *RedisConf `yaml:"redis"`
+	// Name is the name of the source in policy conditions.
+	Name string `yaml:"name" conf:"required,example=flags"`
+	// TTL is how long values (including missing values) are cached for.
+	TTL time.Duration `yaml:"ttl" conf:",example=1m"`
+	// Timeout is the maximum duration of a single request to the source.
+	Timeout time.Duration `yaml:"timeout" conf:",example=500ms"`
+	// CacheSize is the maximum number of values to cache.
+	CacheSize uint `yaml:"cacheSize" conf:",example=1024"`
+}
+
+// HTTPConf configures a source backed by an HTTP endpoint.
+// The endpoint must respond to GET requests with the JSON-encoded value or a 404 status if the key doesn't exist.
+type HTTPConf struct {
+	// Headers are added to each request.
+	Headers map[string]string `yaml:"headers" conf:",example={\"Authorization\": \"Bearer ${FLAGS_TOKEN}\"}"`
+	// URL of the value. The placeholder `{key}` is replaced by the URL-escaped key.
+	URL string `yaml:"url" conf:"required,example=\"https://flags.acme.com/v1/flags/{key}\""`
+}
+
+// GRPCConf configures a source backed by a unary gRPC method that accepts a `google.protobuf.StringValue` containing the key
+// and returns a `google.protobuf.Value`. The method should return a `NOT_FOUND` status if the key doesn't exist.
+type GRPCConf struct {
+	// Addr is the address of the gRPC server.
+	Addr string `yaml:"addr" conf:"required,example=entitlements.acme.svc:9000"`
+	// Method is the full name of the method.
+	Method string `yaml:"method" conf:"required,example=/acme.entitlements.v1.EntitlementService/Lookup"`
+	// Plaintext disables TLS.
+	Plaintext bool `yaml:"plaintext" conf:",example=false"`
+}
+
+// RedisConf configures a source backed by a Redis server. Values are read using the GET command.
+// Values that are not valid JSON are returned as strings.
+type RedisConf struct {
+	// Addr is the address of the Redis server.
+	Addr string `yaml:"addr" conf:"required,example=localhost:6379"`
+	// Password to authenticate with.
+	Password string `yaml:"password" conf:",example=${REDIS_PASSWORD}"`
+	// KeyPrefix is prepended to the keys.
+	KeyPrefix string `yaml:"keyPrefix" conf:",example=\"flags:\""`
+	// DB is the number of the database to read from.
+	DB uint `yaml:"db" conf:",example=0"`
+}
+
+func (sc *SourceConf) SetDefaults() {
+	if sc.TTL <= 0 {
+		sc.TTL = defaultTTL
+	}
+
+	if sc.Timeout <= 0 {
+		sc.Timeout = defaultTimeout
+	}
+
+	if sc.CacheSize == 0 {
+		sc.CacheSize = defaultCacheSize
+	}
+}
+
+func (sc *SourceConf) Validate() (outErr error) {
+	if sc.Name == "" {
+		outErr = multierr.Append(outErr, errEmptyName)
+	}
+
+	numBackends := 0
+	if sc.HTTP != nil {
+		numBackends++
+		if sc.HTTP.URL == "" {
+			outErr = multierr.Append(outErr, errEmptyURL)
+		}
+	}
+
+	if sc.GRPC != nil {
+		numBackends++
+		if sc.GRPC.Addr == "" {
+			outErr = multierr.Append(outErr, errEmptyGRPCAddr)
+		}
+
+		if sc.GRPC.Method == "" {
+			outErr = multierr.Append(outErr, errEmptyGRPCMethod)
+		}
+	}
+
+	if sc.Redis != nil {
+		numBackends++
+		if sc.Redis.Addr == "" {
+			outErr = multierr.Append(outErr, errEmptyRedisAddr)
+		}
+	}
+
+	if numBackends != 1 {
+		outErr = multierr.Append(outErr, errNoBackend)
+	}
+
+	return outErr
+}
+
+// backend fetches values from a data source. It returns a nil value if the key doesn't exist.
+type backend interface {
+	fetch(ctx context.Context, key string) (*structpb.Value, error)
+}
+
+// NewLibrary creates the clients for the given sources and returns a CEL library providing the lookup function.
+func NewLibrary(ctx context.Context, confs []*SourceConf) (cel.Library, error) {
+	lib := &library{sources: make(map[string]*source, len(confs))}
+	for _, sc := range confs {
+		sc.SetDefaults()
+		if err := sc.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid lookup source configuration %q: %w", sc.Name, err)
+		}
+
+		if _, ok := lib.sources[sc.Name]; ok {
+			return nil, fmt.Errorf("lookup source %q is defined more than once", sc.Name)
+		}
+
+		var b backend
+		var err error
+		switch {
+		case sc.HTTP != nil:
+			b = newHTTPBackend(sc.HTTP)
+		case sc.GRPC != nil:
+			b, err = newGRPCBackend(ctx, sc.GRPC)
+		case sc.Redis != nil:
+			b = newRedisBackend(sc.Redis)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create lookup source %q: %w", sc.Name, err)
+		}
+
+		lib.sources[sc.Name] = &source{
+			backend: b,
+			cache:   gcache.New(int(sc.CacheSize)).LRU().Expiration(sc.TTL).Build(),
+			timeout: sc.Timeout,
+		}
+	}
+
+	return lib, nil
+}
+
+type library struct {
+	sources map[string]*source
+}
+
+func (l *library) CompileOptions() []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.Function(lookupFn,
+			cel.Overload(fmt.Sprintf("%s_overload", lookupFn),
+				[]*cel.Type{cel.StringType, cel.StringType},
+				cel.DynType,
+				cel.BinaryBinding(l.lookup),
+			),
+		),
+	}
+}
+
+func (l *library) ProgramOptions() []cel.ProgramOption {
+	return nil
+}
+
+func (l *library) lookup(sourceVal, keyVal ref.Val) ref.Val {
+	name, ok := sourceVal.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(sourceVal)
+	}
+
+	src, ok := l.sources[string(name)]
+	if !ok {
+		return types.NewErr("%s: unknown source %q", lookupFn, string(name))
+	}
+
+	key, ok := keyVal.ConvertToType(types.StringType).(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(keyVal)
+	}
+
+	value, err := src.get(string(key))
+	if err != nil {
+		return types.NewErr("%s: failed to look up %q in source %q: %v", lookupFn, string(key), string(name), err)
+	}
+
+	return types.DefaultTypeAdapter.NativeToValue(value)
+}
+
+type source struct {
+	backend backend
+	cache   gcache.Cache
+	timeout time.Duration
+}
+
+// get returns the value of the key from the cache or fetches it from the backend. Errors are not cached.
+func (s *source) get(key string) (*structpb.Value, error) {
+	if v, err := s.cache.GetIFPresent(key); err == nil {
+		recordCacheAccess("hit")
+		return v.(*structpb.Value), nil //nolint:forcetypeassert
+	}
+
+	recordCacheAccess("miss")
+	ctx, cancelFunc := context.WithTimeout(context.Background(), s.timeout)
+	defer cancelFunc()
+
+	value, err := s.backend.fetch(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if value == nil {
+		value = structpb.NewNullValue()
+	}
+
+	_ = s.cache.Set(key, value)
+	return value, nil
+}
+
+func recordCacheAccess(result string) {
+	_ = stats.RecordWithTags(context.Background(),
+		[]tag.Mutator{tag.Upsert(metrics.KeyCacheKind, cacheKind), tag.Upsert(metrics.KeyCacheResult, result)},
+		metrics.CacheAccessCount.M(1),
+	)
+}
+
+// decodeValue decodes a JSON-encoded value. Data that is not valid JSON is returned as a string.
+func decodeValue(data []byte) *structpb.Value {
+	value := &structpb.Value{}
+	if json.Valid(data) {
+		if err := value.UnmarshalJSON(data); err == nil {
+			return value
+		}
+	}
+
+	return structpb.NewStringValue(string(data))
+}
@@ -0,0 +1,291 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package lookup_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/cerbos/cerbos/internal/conditions/lookup"
+)
+
+func TestHTTPSource(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.Header.Get("Authorization") != "Bearer s3cr3t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/flags/beta":
+			_, _ = w.Write([]byte(`{"enabled": true, "tenants": ["acme"]}`))
+		case "/flags/slow":
+			time.Sleep(500 * time.Millisecond)
+			_, _ = w.Write([]byte(`true`))
+		case "/flags/broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	eval := mkEval(t, &lookup.SourceConf{
+		Name:    "flags",
+		HTTP:    &lookup.HTTPConf{URL: srv.URL + "/flags/{key}", Headers: map[string]string{"Authorization": "Bearer s3cr3t"}},
+		Timeout: 100 * time.Millisecond,
+	})
+
+	t.Run("found", func(t *testing.T) {
+		before := atomic.LoadInt32(&calls)
+		for i := 0; i < 3; i++ {
+			out, err := eval(t, `lookup("flags", "beta").enabled && "acme" in lookup("flags", "beta").tenants`)
+			require.NoError(t, err)
+			require.Equal(t, types.True, out)
+		}
+		require.Equal(t, int32(1), atomic.LoadInt32(&calls)-before, "value should be cached")
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		out, err := eval(t, `lookup("flags", "alpha") == null`)
+		require.NoError(t, err)
+		require.Equal(t, types.True, out)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		_, err := eval(t, `lookup("flags", "slow")`)
+		require.Error(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := eval(t, `lookup("flags", "broken")`)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown_source", func(t *testing.T) {
+		_, err := eval(t, `lookup("entitlements", "beta")`)
+		require.Error(t, err)
+	})
+}
+
+func TestGRPCSource(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer()
+	srv.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "acme.entitlements.v1.EntitlementService",
+		HandlerType: (*any)(nil),
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: "Lookup",
+				Handler: func(_ any, ctx context.Context, dec func(any) error, _ grpc.UnaryServerInterceptor) (any, error) {
+					key := &wrapperspb.StringValue{}
+					if err := dec(key); err != nil {
+						return nil, err
+					}
+
+					if key.Value != "harry" {
+						return nil, status.Error(codes.NotFound, "no entitlements")
+					}
+
+					return structpb.NewValue([]any{"reports", "exports"})
+				},
+			},
+		},
+	}, struct{}{})
+
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	eval := mkEval(t, &lookup.SourceConf{
+		Name: "entitlements",
+		GRPC: &lookup.GRPCConf{Addr: lis.Addr().String(), Method: "/acme.entitlements.v1.EntitlementService/Lookup", Plaintext: true},
+	})
+
+	out, err := eval(t, `"exports" in lookup("entitlements", "harry")`)
+	require.NoError(t, err)
+	require.Equal(t, types.True, out)
+
+	out, err = eval(t, `lookup("entitlements", "maggie") == null`)
+	require.NoError(t, err)
+	require.Equal(t, types.True, out)
+}
+
+func TestRedisSource(t *testing.T) {
+	values := map[string]string{
+		"flags:beta":  `{"enabled": true}`,
+		"flags:plain": "on",
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = lis.Close() })
+
+	go serveRedis(lis, "s3cr3t", values)
+
+	eval := mkEval(t, &lookup.SourceConf{
+		Name:  "flags",
+		Redis: &lookup.RedisConf{Addr: lis.Addr().String(), Password: "s3cr3t", KeyPrefix: "flags:", DB: 1},
+	})
+
+	testCases := []struct {
+		expr string
+		want any
+	}{
+		{expr: `lookup("flags", "beta").enabled`, want: types.True},
+		{expr: `lookup("flags", "plain")`, want: types.String("on")},
+		{expr: `lookup("flags", "alpha") == null`, want: types.True},
+	}
+
+	for _, tc := range testCases {
+		out, err := eval(t, tc.expr)
+		require.NoError(t, err, tc.expr)
+		require.Equal(t, tc.want, out, tc.expr)
+	}
+}
+
+func TestNewLibraryErrors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		confs []*lookup.SourceConf
+	}{
+		{name: "missing_name", confs: []*lookup.SourceConf{{HTTP: &lookup.HTTPConf{URL: "http://localhost/{key}"}}}},
+		{name: "missing_backend", confs: []*lookup.SourceConf{{Name: "flags"}}},
+		{
+			name: "multiple_backends",
+			confs: []*lookup.SourceConf{{
+				Name:  "flags",
+				HTTP:  &lookup.HTTPConf{URL: "http://localhost/{key}"},
+				Redis: &lookup.RedisConf{Addr: "localhost:6379"},
+			}},
+		},
+		{name: "missing_grpc_method", confs: []*lookup.SourceConf{{Name: "flags", GRPC: &lookup.GRPCConf{Addr: "localhost:9000"}}}},
+		{
+			name: "duplicate_name",
+			confs: []*lookup.SourceConf{
+				{Name: "flags", HTTP: &lookup.HTTPConf{URL: "http://localhost/{key}"}},
+				{Name: "flags", Redis: &lookup.RedisConf{Addr: "localhost:6379"}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := lookup.NewLibrary(context.Background(), tc.confs)
+			require.Error(t, err)
+		})
+	}
+}
+
+func mkEval(t *testing.T, confs ...*lookup.SourceConf) func(*testing.T, string) (any, error) {
+	t.Helper()
+
+	lib, err := lookup.NewLibrary(context.Background(), confs)
+	require.NoError(t, err)
+
+	env, err := cel.NewEnv(cel.Lib(lib))
+	require.NoError(t, err)
+
+	return func(t *testing.T, expr string) (any, error) {
+		t.Helper()
+
+		ast, iss := env.Compile(expr)
+		require.NoError(t, iss.Err())
+
+		prg, err := env.Program(ast)
+		require.NoError(t, err)
+
+		out, _, err := prg.Eval(cel.NoVars())
+		return out, err
+	}
+}
+
+// serveRedis is a fake Redis server that supports the AUTH, SELECT and GET commands.
+func serveRedis(lis net.Listener, password string, values map[string]string) {
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			defer conn.Close()
+
+			r := bufio.NewReader(conn)
+			for {
+				args, err := readRedisCommand(r)
+				if err != nil {
+					return
+				}
+
+				var reply string
+				switch strings.ToUpper(args[0]) {
+				case "AUTH":
+					reply = "+OK\r\n"
+					if args[1] != password {
+						reply = "-WRONGPASS invalid password\r\n"
+					}
+				case "SELECT":
+					reply = "+OK\r\n"
+				case "GET":
+					reply = "$-1\r\n"
+					if v, ok := values[args[1]]; ok {
+						reply = fmt.Sprintf("$%d\r\n%s\r\n", len(v), v)
+					}
+				default:
+					reply = "-ERR unknown command\r\n"
+				}
+
+				if _, err := conn.Write([]byte(reply)); err != nil {
+					return
+				}
+			}
+		}()
+	}
+}
+
+func readRedisCommand(r *bufio.Reader) ([]string, error) {
+	var n int
+	if _, err := fmt.Fscanf(r, "*%d\r\n", &n); err != nil {
+		return nil, err
+	}
+
+	args := make([]string, n)
+	for i := range args {
+		var size int
+		if _, err := fmt.Fscanf(r, "$%d\r\n", &size); err != nil {
+			return nil, err
+		}
+
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		args[i] = string(buf[:size])
+	}
+
+	return args, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package lookup
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// redisBackend reads values using the GET command of the Redis serialization protocol (RESP).
+// A new connection is made for each value because values are cached and fetched infrequently.
+type redisBackend struct {
+	conf *RedisConf
+}
+
+func newRedisBackend(conf *RedisConf) *redisBackend {
+	return &redisBackend{conf: conf}
+}
+
+func (b *redisBackend) fetch(ctx context.Context, key string) (*structpb.Value, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", b.conf.Addr)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	r := bufio.NewReader(conn)
+	if b.conf.Password != "" {
+		if _, err := redisCommand(conn, r, "AUTH", b.conf.Password); err != nil {
+			return nil, fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+
+	if b.conf.DB > 0 {
+		if _, err := redisCommand(conn, r, "SELECT", strconv.FormatUint(uint64(b.conf.DB), 10)); err != nil {
+			return nil, fmt.Errorf("failed to select database: %w", err)
+		}
+	}
+
+	data, err := redisCommand(conn, r, "GET", b.conf.KeyPrefix+key)
+	if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		return nil, nil
+	}
+
+	return decodeValue(data), nil
+}
+
+// redisCommand sends a command and returns the reply. The reply is nil if the server returned a null bulk string.
+func redisCommand(w io.Writer, r *bufio.Reader, args ...string) ([]byte, error) {
+	cmd := fmt.Appendf(nil, "*%d\r\n", len(args))
+	for _, arg := range args {
+		cmd = fmt.Appendf(cmd, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+
+	if _, err := w.Write(cmd); err != nil {
+		return nil, err
+	}
+
+	line, err := readRedisLine(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(line) == 0 {
+		return nil, errors.New("empty reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, fmt.Errorf("server error: %s", line[1:])
+	case ':':
+		return line[1:], nil
+	case '$':
+		n, err := strconv.Atoi(string(line[1:]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid bulk string length: %w", err)
+		}
+
+		if n < 0 {
+			return nil, nil
+		}
+
+		if n > maxResponseSize {
+			return nil, fmt.Errorf("value of %d bytes is too large", n)
+		}
+
+		data := make([]byte, n+2) //nolint:gomnd
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, err
+		}
+
+		return data[:n], nil
+	default:
+		return nil, fmt.Errorf("unsupported reply type %q", line[0])
+	}
+}
+
+func readRedisLine(r *bufio.Reader) ([]byte, error) {
+	line, err := r.ReadBytes('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	if len(line) < 2 || line[len(line)-2] != '\r' { //nolint:gomnd
+		return nil, errors.New("malformed reply")
+	}
+
+	return line[:len(line)-2], nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+)
+
+var (
+	pluginsMu sync.RWMutex
+	plugins   = map[string]cel.Library{}
+
+	enablePluginsOnce sync.Once
+	enablePluginsErr  error
+)
+
+// RegisterFunctionPlugin registers a library of custom CEL functions under the given name.
+// Registered plugins are not available to policy conditions until they are enabled using EnableFunctionPlugins.
+// It is intended to be called from the init function of the package providing the plugin.
+func RegisterFunctionPlugin(name string, lib cel.Library) {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+
+	plugins[name] = lib
+}
+
+// FunctionPluginRegistered returns true if a plugin with the given name has been registered.
+func FunctionPluginRegistered(name string) bool {
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+
+	_, ok := plugins[name]
+	return ok
+}
+
+// EnableFunctionPlugins rebuilds the standard CEL environments with the functions provided by the named plugins in addition to the built-in ones.
+// Because it replaces StdEnv and StdPartialEnv, it must be called before any policies are compiled.
+// Only the first call has any effect. Subsequent calls return the result of the first call.
+func EnableFunctionPlugins(names ...string) error {
+	enablePluginsOnce.Do(func() {
+		enablePluginsErr = enableFunctionPlugins(names)
+	})
+
+	return enablePluginsErr
+}
+
+func enableFunctionPlugins(names []string) error {
+	pluginsMu.RLock()
+	libs := make([]cel.EnvOption, len(names))
+	var vars map[string]any
+	for i, name := range names {
+		lib, ok := plugins[name]
+		if !ok {
+			pluginsMu.RUnlock()
+			return fmt.Errorf("unknown CEL function plugin [%s]: registered plugins are %v", name, registeredPluginNames())
+		}
+		libs[i] = cel.Lib(lib)
+
+		if cl, ok := lib.(*constantsLib); ok {
+			vars = cl.vars
+		}
+	}
+	pluginsMu.RUnlock()
+
+	env, err := initEnv(append(newCELEnvOptions(), libs...))
+	if err != nil {
+		return fmt.Errorf("failed to initialize standard CEL environment with plugins %v: %w", names, err)
+	}
+
+	partialEnv, err := initEnv(append(newCELQueryPlanEnvOptions(), libs...))
+	if err != nil {
+		return fmt.Errorf("failed to initialize CEL environment for partial evaluation with plugins %v: %w", names, err)
+	}
+
+	StdEnv = env
+	StdPartialEnv = partialEnv
+	constantVars = vars
+
+	return nil
+}
+
+// registeredPluginNames must be called with pluginsMu held.
+func registeredPluginNames() []string {
+	names := make([]string, 0, len(plugins))
+	for name := range plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,95 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+)
+
+type shoutLib struct{}
+
+func (shoutLib) CompileOptions() []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.Function("shout",
+			cel.MemberOverload("string_shout", []*cel.Type{cel.StringType}, cel.StringType,
+				cel.UnaryBinding(func(v ref.Val) ref.Val {
+					return types.String(strings.ToUpper(string(v.(types.String))))
+				}),
+			),
+		),
+	}
+}
+
+func (shoutLib) ProgramOptions() []cel.ProgramOption {
+	return nil
+}
+
+func TestFunctionPlugins(t *testing.T) {
+	RegisterFunctionPlugin("shout", shoutLib{})
+	require.True(t, FunctionPluginRegistered("shout"))
+	require.False(t, FunctionPluginRegistered("whisper"))
+
+	expr := `R.attr.name.shout() == "ACME"`
+
+	_, iss := StdEnv.Compile(expr)
+	require.Error(t, iss.Err(), "function should not be available before the plugin is enabled")
+
+	t.Run("unknown_plugin", func(t *testing.T) {
+		resetFunctionPlugins(t)
+
+		require.Error(t, EnableFunctionPlugins("whisper"))
+		require.Error(t, EnableFunctionPlugins("shout"), "subsequent calls should return the result of the first call")
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		resetFunctionPlugins(t)
+
+		require.NoError(t, EnableFunctionPlugins("shout"))
+
+		for _, env := range []*cel.Env{StdEnv, StdPartialEnv} {
+			ast, iss := env.Compile(expr)
+			require.NoError(t, iss.Err())
+			require.Equal(t, cel.BoolType, ast.OutputType())
+		}
+
+		ast, iss := StdEnv.Compile(expr)
+		require.NoError(t, iss.Err())
+
+		prg, err := StdEnv.Program(ast)
+		require.NoError(t, err)
+
+		out, _, err := prg.Eval(map[string]any{
+			CELResourceAbbrev: &enginev1.Resource{Attr: map[string]*structpb.Value{"name": structpb.NewStringValue("acme")}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, types.True, out)
+	})
+
+	_, iss = StdEnv.Compile(expr)
+	require.Error(t, iss.Err(), "function should not be available after the environments are restored")
+}
+
+// resetFunctionPlugins allows EnableFunctionPlugins to be called again and restores the standard environments when the test ends.
+func resetFunctionPlugins(t *testing.T) {
+	t.Helper()
+
+	env, partialEnv, vars := StdEnv, StdPartialEnv, constantVars
+	enablePluginsOnce = sync.Once{}
+
+	t.Cleanup(func() {
+		StdEnv, StdPartialEnv, constantVars = env, partialEnv, vars
+		enablePluginsOnce = sync.Once{}
+		enablePluginsErr = nil
+	})
+}
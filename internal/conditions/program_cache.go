@@ -0,0 +1,203 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions
+
+import (
+	"context"
+	"time"
+
+	"github.com/bluele/gcache"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/interpreter"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+)
+
+const (
+	programCacheKind = "cel_program"
+	programCacheSize = 4096
+	// nowVar is the name of the activation variable holding the time to use for `now` in cached programs.
+	nowVar = "cerbos.internal.now"
+)
+
+var programCache = newProgramCache(programCacheSize)
+
+// programCacheEntry is a program compiled from a checked expression.
+type programCacheEntry struct {
+	prg cel.Program
+	// size is the size of the checked expression, which is used as a proxy for the memory used by the program.
+	size int
+}
+
+type programCacheT struct {
+	cache gcache.Cache
+}
+
+func newProgramCache(size int) *programCacheT {
+	_ = stats.RecordWithTags(context.Background(),
+		[]tag.Mutator{tag.Upsert(metrics.KeyCacheKind, programCacheKind)},
+		metrics.CacheMaxSize.M(int64(size)),
+	)
+
+	gauge := metrics.MakeCacheGauge(programCacheKind)
+	bytesGauge := metrics.MakeCacheBytesGauge(programCacheKind)
+	return &programCacheT{
+		cache: gcache.New(size).
+			ARC().
+			AddedFunc(func(_, value any) {
+				gauge.Add(1)
+				bytesGauge.Add(int64(value.(*programCacheEntry).size)) //nolint:forcetypeassert
+			}).
+			EvictedFunc(func(_, value any) {
+				gauge.Add(-1)
+				bytesGauge.Add(-int64(value.(*programCacheEntry).size)) //nolint:forcetypeassert
+			}).
+			Build(),
+	}
+}
+
+// get returns the program for the checked expression, compiling and caching it if necessary.
+// Compiled policies are cached and shared by all requests, so the pointer to the expression identifies it.
+func (pc *programCacheT) get(expr *exprpb.CheckedExpr) (cel.Program, error) {
+	if entry, err := pc.cache.GetIFPresent(expr); err == nil {
+		recordProgramCacheAccess("hit")
+		return entry.(*programCacheEntry).prg, nil //nolint:forcetypeassert
+	}
+
+	recordProgramCacheAccess("miss")
+	prg, err := StdEnv.Program(cel.CheckedExprToAst(expr), cel.CustomDecorator(activationTimeDecorator))
+	if err != nil {
+		return nil, err
+	}
+
+	_ = pc.cache.Set(expr, &programCacheEntry{prg: prg, size: proto.Size(expr)})
+	return prg, nil
+}
+
+func recordProgramCacheAccess(result string) {
+	_ = stats.RecordWithTags(context.Background(),
+		[]tag.Mutator{tag.Upsert(metrics.KeyCacheKind, programCacheKind), tag.Upsert(metrics.KeyCacheResult, result)},
+		metrics.CacheAccessCount.M(1),
+	)
+}
+
+// EvalChecked evaluates an expression checked by StdEnv, reusing the program compiled for it by earlier calls.
+// Programs with cost limits are tied to a single evaluation, so they are not cached if any program options are given.
+func EvalChecked(expr *exprpb.CheckedExpr, vars map[string]any, nowFunc func() time.Time, opts ...cel.ProgramOption) (ref.Val, *cel.EvalDetails, error) {
+	if len(opts) > 0 {
+		return Eval(StdEnv, cel.CheckedExprToAst(expr), vars, nowFunc, opts...)
+	}
+
+	prg, err := programCache.get(expr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	act, err := interpreter.NewActivation(vars)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return evalProgram(prg, interpreter.NewHierarchicalActivation(act, nowActivation{now: nowFunc()}))
+}
+
+// nowActivation provides the value of `now` to cached programs.
+type nowActivation struct {
+	now time.Time
+}
+
+func (a nowActivation) ResolveName(name string) (any, bool) {
+	if name == nowVar {
+		return a.now, true
+	}
+
+	return nil, false
+}
+
+func (a nowActivation) Parent() interpreter.Activation {
+	return nil
+}
+
+// activationTimeDecorator is like the time decorator used by Eval but it resolves `now` from the activation
+// instead of fixing it when the program is created.
+func activationTimeDecorator(in interpreter.Interpretable) (interpreter.Interpretable, error) {
+	call, ok := in.(interpreter.InterpretableCall)
+	if !ok {
+		return in, nil
+	}
+
+	switch call.Function() {
+	case nowFn:
+		return nowInterpretable{id: call.ID()}, nil
+	case timeSinceFn:
+		args := call.Args()
+		if len(args) != 1 {
+			return in, nil
+		}
+		return timeSinceInterpretable{id: call.ID(), arg: args[0]}, nil
+	default:
+		return in, nil
+	}
+}
+
+func nowFromActivation(act interpreter.Activation) (time.Time, bool) {
+	v, ok := act.ResolveName(nowVar)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	now, ok := v.(time.Time)
+	return now, ok
+}
+
+type nowInterpretable struct {
+	id int64
+}
+
+func (n nowInterpretable) ID() int64 {
+	return n.id
+}
+
+func (n nowInterpretable) Eval(act interpreter.Activation) ref.Val {
+	now, ok := nowFromActivation(act)
+	if !ok {
+		return types.NewErr("current time is not available")
+	}
+
+	return types.DefaultTypeAdapter.NativeToValue(now)
+}
+
+type timeSinceInterpretable struct {
+	arg interpreter.Interpretable
+	id  int64
+}
+
+func (t timeSinceInterpretable) ID() int64 {
+	return t.id
+}
+
+func (t timeSinceInterpretable) Eval(act interpreter.Activation) ref.Val {
+	val := t.arg.Eval(act)
+	if types.IsUnknownOrError(val) {
+		return val
+	}
+
+	ts, ok := val.Value().(time.Time)
+	if !ok {
+		return types.NoSuchOverloadErr()
+	}
+
+	now, ok := nowFromActivation(act)
+	if !ok {
+		return types.NewErr("current time is not available")
+	}
+
+	return types.DefaultTypeAdapter.NativeToValue(now.Sub(ts))
+}
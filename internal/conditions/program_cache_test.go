@@ -0,0 +1,59 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/conditions"
+)
+
+func TestEvalChecked(t *testing.T) {
+	env, err := conditions.StdEnv.Extend(cel.Variable("x", cel.IntType))
+	require.NoError(t, err)
+
+	compile := func(t *testing.T, expr string) *cel.Ast {
+		t.Helper()
+		ast, issues := env.Compile(expr)
+		require.NoError(t, issues.Err())
+		return ast
+	}
+
+	t.Run("reuses_program", func(t *testing.T) {
+		checked, err := cel.AstToCheckedExpr(compile(t, "x + 1"))
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			val, _, err := conditions.EvalChecked(checked, map[string]any{"x": i}, time.Now)
+			require.NoError(t, err)
+			require.EqualValues(t, i+1, val.Value())
+		}
+	})
+
+	t.Run("now_is_evaluated_per_call", func(t *testing.T) {
+		checked, err := cel.AstToCheckedExpr(compile(t, `timestamp("2021-01-01T00:00:00Z").timeSince() == duration("24h") && now() == now()`))
+		require.NoError(t, err)
+
+		start := time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)
+		val, _, err := conditions.EvalChecked(checked, nil, func() time.Time { return start })
+		require.NoError(t, err)
+		require.Equal(t, true, val.Value())
+
+		val, _, err = conditions.EvalChecked(checked, nil, func() time.Time { return start.Add(time.Hour) })
+		require.NoError(t, err)
+		require.Equal(t, false, val.Value())
+	})
+
+	t.Run("program_options", func(t *testing.T) {
+		checked, err := cel.AstToCheckedExpr(compile(t, "[1, 2, 3].all(i, i > x)"))
+		require.NoError(t, err)
+
+		_, _, err = conditions.EvalChecked(checked, map[string]any{"x": 0}, time.Now, cel.CostLimit(1))
+		require.ErrorIs(t, err, conditions.ErrCostLimitExceeded)
+	})
+}
@@ -0,0 +1,302 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"github.com/shopspring/decimal"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	decimalFn               = "decimal"
+	decimalTypeName         = "cerbos.lib.decimal"
+	overloadDecimalAdd      = "add"
+	overloadDecimalSub      = "sub"
+	overloadDecimalMul      = "mul"
+	overloadDecimalDiv      = "div"
+	overloadDecimalRound    = "round"
+	overloadDecimalCompare  = "compareTo"
+	decimalOverloadIDFormat = "%s_decimal_%s"
+
+	// decimalDivPrecision is the number of decimal places kept in the result of a division that doesn't terminate.
+	decimalDivPrecision = 16
+	// maxDecimalExponent limits the magnitude of the exponent of decimal values to keep arithmetic cheap.
+	maxDecimalExponent = 64
+	// maxDecimalRoundPlaces limits the number of places accepted by round.
+	maxDecimalRoundPlaces = 32
+)
+
+var (
+	DecimalType = cel.ObjectType(decimalTypeName, traits.ReceiverType)
+
+	decimalCelType = cel.ObjectType(decimalTypeName)
+
+	decimalTypeExpr = decls.NewObjectType(decimalTypeName)
+
+	// decimalArgTypes are the types accepted wherever a decimal is expected.
+	decimalArgTypes = []decimalArgType{
+		{name: "decimal", celType: decimalCelType, typeExpr: decimalTypeExpr},
+		{name: "string", celType: cel.StringType, typeExpr: decls.String},
+		{name: "int", celType: cel.IntType, typeExpr: decls.Int},
+		{name: "uint", celType: cel.UintType, typeExpr: decls.Uint},
+		{name: "double", celType: cel.DoubleType, typeExpr: decls.Double},
+	}
+
+	DecimalFunc = cel.Function(decimalFn, decimalFnOverloads()...)
+
+	DecimalDeclarations = decimalDecls()
+
+	decimalArithmeticOverloads = map[string]func(Decimal, Decimal) ref.Val{
+		overloadDecimalAdd: func(d, other Decimal) ref.Val {
+			return newDecimal(d.d.Add(other.d))
+		},
+		overloadDecimalSub: func(d, other Decimal) ref.Val {
+			return newDecimal(d.d.Sub(other.d))
+		},
+		overloadDecimalMul: func(d, other Decimal) ref.Val {
+			return newDecimal(d.d.Mul(other.d))
+		},
+		overloadDecimalDiv: func(d, other Decimal) ref.Val {
+			if other.d.IsZero() {
+				return types.NewErr("division by zero")
+			}
+
+			return newDecimal(d.d.DivRound(other.d, decimalDivPrecision))
+		},
+	}
+
+	decimalComparisonOverloads = map[string]func(Decimal, Decimal) ref.Val{
+		overloadIsAtLeast: func(d, other Decimal) ref.Val {
+			return types.Bool(d.d.Cmp(other.d) >= 0)
+		},
+		overloadIsAtMost: func(d, other Decimal) ref.Val {
+			return types.Bool(d.d.Cmp(other.d) <= 0)
+		},
+		overloadIsGreaterThan: func(d, other Decimal) ref.Val {
+			return types.Bool(d.d.GreaterThan(other.d))
+		},
+		overloadIsLessThan: func(d, other Decimal) ref.Val {
+			return types.Bool(d.d.LessThan(other.d))
+		},
+		overloadDecimalCompare: func(d, other Decimal) ref.Val {
+			return types.Int(d.d.Cmp(other.d))
+		},
+	}
+)
+
+type decimalArgType struct {
+	celType  *cel.Type
+	typeExpr *exprpb.Type
+	name     string
+}
+
+func decimalFnOverloads() []cel.FunctionOpt {
+	out := make([]cel.FunctionOpt, 0, len(decimalArgTypes))
+	for _, t := range decimalArgTypes[1:] {
+		out = append(out, cel.Overload(
+			fmt.Sprintf("%s_%s", decimalFn, t.name),
+			[]*cel.Type{t.celType},
+			decimalCelType,
+			cel.UnaryBinding(decimalFnImpl),
+		))
+	}
+
+	return out
+}
+
+func decimalDecls() []*exprpb.Decl {
+	var out []*exprpb.Decl //nolint:prealloc
+	for _, name := range []string{overloadDecimalAdd, overloadDecimalSub, overloadDecimalMul, overloadDecimalDiv} {
+		out = append(out, decimalOneArgDecl(name, decimalTypeExpr))
+	}
+
+	for _, name := range []string{overloadIsAtLeast, overloadIsAtMost, overloadIsGreaterThan, overloadIsLessThan} {
+		out = append(out, decimalOneArgDecl(name, decls.Bool))
+	}
+
+	out = append(out, decimalOneArgDecl(overloadDecimalCompare, decls.Int),
+		decls.NewFunction(overloadDecimalRound,
+			decls.NewInstanceOverload(fmt.Sprintf(decimalOverloadIDFormat, overloadDecimalRound, "int"),
+				[]*exprpb.Type{decimalTypeExpr, decls.Int},
+				decimalTypeExpr,
+			),
+		),
+	)
+
+	return out
+}
+
+// decimalOneArgDecl declares a function that accepts a decimal, a numeric string or a number as the argument.
+func decimalOneArgDecl(name string, resultType *exprpb.Type) *exprpb.Decl {
+	overloads := make([]*exprpb.Decl_FunctionDecl_Overload, len(decimalArgTypes))
+	for i, t := range decimalArgTypes {
+		overloads[i] = decls.NewInstanceOverload(fmt.Sprintf(decimalOverloadIDFormat, name, t.name),
+			[]*exprpb.Type{decimalTypeExpr, t.typeExpr},
+			resultType,
+		)
+	}
+
+	return decls.NewFunction(name, overloads...)
+}
+
+func decimalFnImpl(v ref.Val) ref.Val {
+	d, err := toDecimal(v)
+	if err != nil {
+		return err
+	}
+
+	return d
+}
+
+// Decimal is a type that represents an arbitrary-precision decimal number such as a monetary amount.
+type Decimal struct {
+	d decimal.Decimal
+}
+
+func newDecimal(d decimal.Decimal) ref.Val {
+	if exp := d.Exponent(); exp > maxDecimalExponent || exp < -maxDecimalExponent {
+		return types.NewErr("decimal %s is out of range", d.String())
+	}
+
+	return Decimal{d: d}
+}
+
+// ConvertToNative implements ref.Val.ConvertToNative.
+func (d Decimal) ConvertToNative(typeDesc reflect.Type) (any, error) {
+	//nolint:exhaustive
+	switch typeDesc.Kind() {
+	case reflect.String:
+		return d.d.String(), nil
+	case reflect.Float32, reflect.Float64:
+		return d.d.InexactFloat64(), nil
+	case reflect.Ptr:
+		if typeDesc == reflect.TypeOf(&structpb.Value{}) {
+			return structpb.NewStringValue(d.d.String()), nil
+		}
+	case reflect.Interface:
+		dv := d.Value()
+		if reflect.TypeOf(dv).Implements(typeDesc) {
+			return dv, nil
+		}
+
+		if reflect.TypeOf(d).Implements(typeDesc) {
+			return d, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported native conversion from decimal to '%v'", typeDesc)
+}
+
+// ConvertToType implements ref.Val.ConvertToType.
+func (d Decimal) ConvertToType(typeVal ref.Type) ref.Val {
+	switch typeVal {
+	case types.StringType:
+		return types.String(d.d.String())
+	case types.DoubleType:
+		return types.Double(d.d.InexactFloat64())
+	case types.TypeType:
+		return DecimalType
+	}
+
+	return types.NewErr("type conversion error from '%s' to '%s'", DecimalType, typeVal)
+}
+
+// Type implements ref.Val.Type.
+func (d Decimal) Type() ref.Type {
+	return DecimalType
+}
+
+// Value implements ref.Val.Value.
+func (d Decimal) Value() any {
+	return d.d.String()
+}
+
+// Equal implements ref.Val.Equal.
+func (d Decimal) Equal(other ref.Val) ref.Val {
+	otherD, ok := other.(Decimal)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(other)
+	}
+
+	return types.Bool(d.d.Equal(otherD.d))
+}
+
+// Receive implements traits.Reciever.Receive.
+func (d Decimal) Receive(function, _ string, args []ref.Val) ref.Val {
+	if len(args) != 1 {
+		return types.NoSuchOverloadErr()
+	}
+
+	if function == overloadDecimalRound {
+		places, ok := args[0].(types.Int)
+		if !ok {
+			return types.MaybeNoSuchOverloadErr(args[0])
+		}
+
+		if places > maxDecimalRoundPlaces || places < -maxDecimalRoundPlaces {
+			return types.NewErr("number of places to round to must be between %d and %d", -maxDecimalRoundPlaces, maxDecimalRoundPlaces)
+		}
+
+		return newDecimal(d.d.Round(int32(places)))
+	}
+
+	f, found := decimalArithmeticOverloads[function]
+	if !found {
+		f, found = decimalComparisonOverloads[function]
+	}
+
+	if !found {
+		return types.NoSuchOverloadErr()
+	}
+
+	other, err := toDecimal(args[0])
+	if err != nil {
+		return err
+	}
+
+	return f(d, other)
+}
+
+func toDecimal(v ref.Val) (Decimal, ref.Val) {
+	var d decimal.Decimal
+	switch dv := v.(type) {
+	case Decimal:
+		return dv, nil
+	case types.String:
+		parsed, err := decimal.NewFromString(string(dv))
+		if err != nil {
+			return Decimal{}, types.NewErr("invalid decimal %q: %v", string(dv), err)
+		}
+		d = parsed
+	case types.Int:
+		d = decimal.NewFromInt(int64(dv))
+	case types.Uint:
+		d = decimal.NewFromBigInt(new(big.Int).SetUint64(uint64(dv)), 0)
+	case types.Double:
+		if math.IsNaN(float64(dv)) || math.IsInf(float64(dv), 0) {
+			return Decimal{}, types.NewErr("invalid decimal %v", float64(dv))
+		}
+		d = decimal.NewFromFloat(float64(dv))
+	default:
+		return Decimal{}, types.MaybeNoSuchOverloadErr(v)
+	}
+
+	result := newDecimal(d)
+	if types.IsError(result) {
+		return Decimal{}, result
+	}
+
+	return result.(Decimal), nil //nolint:forcetypeassert
+}
@@ -0,0 +1,217 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+const (
+	semverFn               = "semver"
+	semverTypeName         = "cerbos.lib.semver"
+	overloadIsAtLeast      = "isAtLeast"
+	overloadIsAtMost       = "isAtMost"
+	overloadIsGreaterThan  = "isGreaterThan"
+	overloadIsLessThan     = "isLessThan"
+	overloadSemverMajor    = "major"
+	overloadSemverMinor    = "minor"
+	overloadSemverPatch    = "patch"
+	overloadSemverCompare  = "compareTo"
+	semverOverloadIDFormat = "%s_semver_%s"
+)
+
+var (
+	SemverType = cel.ObjectType(semverTypeName, traits.ReceiverType)
+
+	semverCelType = cel.ObjectType(semverTypeName)
+
+	SemverFunc = cel.Function(semverFn,
+		cel.Overload(
+			fmt.Sprintf("%s_string", semverFn),
+			[]*cel.Type{cel.StringType},
+			semverCelType,
+			cel.UnaryBinding(semverFnImpl),
+		),
+	)
+
+	semverTypeExpr = decls.NewObjectType(semverTypeName)
+
+	SemverDeclarations = semverDecls()
+
+	semverOneArgOverloads = map[string]func(Semver, Semver) ref.Val{
+		overloadIsAtLeast: func(v, other Semver) ref.Val {
+			return types.Bool(v.v.Compare(other.v) >= 0)
+		},
+		overloadIsAtMost: func(v, other Semver) ref.Val {
+			return types.Bool(v.v.Compare(other.v) <= 0)
+		},
+		overloadIsGreaterThan: func(v, other Semver) ref.Val {
+			return types.Bool(v.v.GreaterThan(other.v))
+		},
+		overloadIsLessThan: func(v, other Semver) ref.Val {
+			return types.Bool(v.v.LessThan(other.v))
+		},
+		overloadSemverCompare: func(v, other Semver) ref.Val {
+			return types.Int(v.v.Compare(other.v))
+		},
+	}
+
+	semverNoArgOverloads = map[string]func(Semver) ref.Val{
+		overloadSemverMajor: func(v Semver) ref.Val {
+			return types.Int(v.v.Major())
+		},
+		overloadSemverMinor: func(v Semver) ref.Val {
+			return types.Int(v.v.Minor())
+		},
+		overloadSemverPatch: func(v Semver) ref.Val {
+			return types.Int(v.v.Patch())
+		},
+	}
+)
+
+func semverDecls() []*exprpb.Decl {
+	var out []*exprpb.Decl //nolint:prealloc
+	for _, name := range []string{overloadIsAtLeast, overloadIsAtMost, overloadIsGreaterThan, overloadIsLessThan} {
+		out = append(out, semverOneArgDecl(name, decls.Bool))
+	}
+
+	out = append(out, semverOneArgDecl(overloadSemverCompare, decls.Int))
+
+	for _, name := range []string{overloadSemverMajor, overloadSemverMinor, overloadSemverPatch} {
+		out = append(out, decls.NewFunction(name,
+			decls.NewInstanceOverload(fmt.Sprintf("%s_semver", name),
+				[]*exprpb.Type{semverTypeExpr},
+				decls.Int,
+			),
+		))
+	}
+
+	return out
+}
+
+// semverOneArgDecl declares a function that accepts either a semver or a version string as the argument.
+func semverOneArgDecl(name string, resultType *exprpb.Type) *exprpb.Decl {
+	return decls.NewFunction(name,
+		decls.NewInstanceOverload(fmt.Sprintf(semverOverloadIDFormat, name, "semver"),
+			[]*exprpb.Type{semverTypeExpr, semverTypeExpr},
+			resultType,
+		),
+		decls.NewInstanceOverload(fmt.Sprintf(semverOverloadIDFormat, name, "string"),
+			[]*exprpb.Type{semverTypeExpr, decls.String},
+			resultType,
+		),
+	)
+}
+
+func semverFnImpl(v ref.Val) ref.Val {
+	sv, err := toSemver(v)
+	if err != nil {
+		return err
+	}
+
+	return sv
+}
+
+// Semver is a type that represents a semantic version such as 1.2.3-beta.1.
+type Semver struct {
+	v *semver.Version
+}
+
+// ConvertToNative implements ref.Val.ConvertToNative.
+func (s Semver) ConvertToNative(typeDesc reflect.Type) (any, error) {
+	//nolint:exhaustive
+	switch typeDesc.Kind() {
+	case reflect.String:
+		return s.v.String(), nil
+	case reflect.Interface:
+		sv := s.Value()
+		if reflect.TypeOf(sv).Implements(typeDesc) {
+			return sv, nil
+		}
+
+		if reflect.TypeOf(s).Implements(typeDesc) {
+			return s, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported native conversion from semver to '%v'", typeDesc)
+}
+
+// ConvertToType implements ref.Val.ConvertToType.
+func (s Semver) ConvertToType(typeVal ref.Type) ref.Val {
+	switch typeVal {
+	case types.StringType:
+		return types.String(s.v.String())
+	case types.TypeType:
+		return SemverType
+	}
+
+	return types.NewErr("type conversion error from '%s' to '%s'", SemverType, typeVal)
+}
+
+// Type implements ref.Val.Type.
+func (s Semver) Type() ref.Type {
+	return SemverType
+}
+
+// Value implements ref.Val.Value.
+func (s Semver) Value() any {
+	return s.v.String()
+}
+
+// Equal implements ref.Val.Equal. Values of other types are never equal to a semantic version.
+func (s Semver) Equal(other ref.Val) ref.Val {
+	otherS, ok := other.(Semver)
+	if !ok {
+		return types.False
+	}
+
+	return types.Bool(s.v.Equal(otherS.v))
+}
+
+// Receive implements traits.Reciever.Receive.
+func (s Semver) Receive(function, _ string, args []ref.Val) ref.Val {
+	switch len(args) {
+	case 0:
+		if f, found := semverNoArgOverloads[function]; found {
+			return f(s)
+		}
+	case 1:
+		if f, found := semverOneArgOverloads[function]; found {
+			other, err := toSemver(args[0])
+			if err != nil {
+				return err
+			}
+
+			return f(s, other)
+		}
+	}
+
+	return types.NoSuchOverloadErr()
+}
+
+func toSemver(v ref.Val) (Semver, ref.Val) {
+	switch sv := v.(type) {
+	case Semver:
+		return sv, nil
+	case types.String:
+		parsed, err := semver.NewVersion(string(sv))
+		if err != nil {
+			return Semver{}, types.NewErr("invalid semantic version %q: %v", string(sv), err)
+		}
+
+		return Semver{v: parsed}, nil
+	default:
+		return Semver{}, types.MaybeNoSuchOverloadErr(v)
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package wasm provides condition functions implemented by WebAssembly modules.
+//
+// A module must export its linear memory as `memory`, an allocator function `alloc(size: i32) -> i32` and the condition
+// function itself with the signature `(ptr: i32, len: i32) -> i64`. The arguments of the condition function are written
+// to a buffer obtained from `alloc` as a JSON array. The function must return the location of its JSON-encoded result
+// packed as `(ptr << 32) | len`. Modules cannot import any host functions, so they have no access to the file system,
+// network, clock or any other resources outside their own memory. Each call runs in a fresh instance of the module.
+package wasm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"go.uber.org/multierr"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	allocFn               = "alloc"
+	defaultMaxMemoryPages = 16 // 1 MiB
+	defaultTimeout        = 100 * time.Millisecond
+	resultLenMask         = 0xFFFFFFFF
+	resultPtrShift        = 32
+)
+
+var (
+	errEmptyName      = errors.New("name must not be empty")
+	errEmptyModule    = errors.New("module must not be empty")
+	structpbValueType = reflect.TypeOf(&structpb.Value{})
+)
+
+// FunctionConf defines a condition function implemented by a WebAssembly module.
+type FunctionConf struct {
+	// Name is the name of the function in policy conditions.
+	Name string `yaml:"name" conf:"required,example=entitlementScore"`
+	// Module is the path to the WebAssembly module implementing the function.
+	Module string `yaml:"module" conf:"required,example=/etc/cerbos/functions/entitlements.wasm"`
+	// Export is the name of the function exported by the module. Defaults to Name.
+	Export string `yaml:"export" conf:",example=entitlement_score"`
+	// NumArgs is the number of arguments accepted by the function.
+	NumArgs uint `yaml:"numArgs" conf:",example=2"`
+	// Timeout is the maximum duration of a single function call.
+	Timeout time.Duration `yaml:"timeout" conf:",example=100ms"`
+	// MaxMemoryPages is the maximum number of 64 KiB memory pages the module can use.
+	MaxMemoryPages uint32 `yaml:"maxMemoryPages" conf:",example=16"`
+}
+
+func (fc *FunctionConf) SetDefaults() {
+	if fc.Export == "" {
+		fc.Export = fc.Name
+	}
+
+	if fc.Timeout <= 0 {
+		fc.Timeout = defaultTimeout
+	}
+
+	if fc.MaxMemoryPages == 0 {
+		fc.MaxMemoryPages = defaultMaxMemoryPages
+	}
+}
+
+func (fc *FunctionConf) Validate() (outErr error) {
+	if fc.Name == "" {
+		outErr = multierr.Append(outErr, errEmptyName)
+	}
+
+	if fc.Module == "" {
+		outErr = multierr.Append(outErr, errEmptyModule)
+	}
+
+	return outErr
+}
+
+// NewLibrary loads the WebAssembly modules implementing the given functions and returns a CEL library exposing them.
+func NewLibrary(ctx context.Context, confs []*FunctionConf) (cel.Library, error) {
+	lib := &library{}
+	for _, fc := range confs {
+		fc.SetDefaults()
+		if err := fc.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid WASM function configuration %q: %w", fc.Name, err)
+		}
+
+		wasm, err := os.ReadFile(fc.Module)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read WASM module for function %q: %w", fc.Name, err)
+		}
+
+		fn, err := newFunction(ctx, fc, wasm)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load WASM function %q: %w", fc.Name, err)
+		}
+
+		lib.functions = append(lib.functions, fn)
+	}
+
+	return lib, nil
+}
+
+type library struct {
+	functions []*function
+}
+
+func (l *library) CompileOptions() []cel.EnvOption {
+	opts := make([]cel.EnvOption, len(l.functions))
+	for i, fn := range l.functions {
+		argTypes := make([]*cel.Type, fn.numArgs)
+		for j := range argTypes {
+			argTypes[j] = cel.DynType
+		}
+
+		opts[i] = cel.Function(fn.name,
+			cel.Overload(fmt.Sprintf("%s_wasm_overload", fn.name), argTypes, cel.DynType, cel.FunctionBinding(fn.call)),
+		)
+	}
+
+	return opts
+}
+
+func (l *library) ProgramOptions() []cel.ProgramOption {
+	return nil
+}
+
+type function struct {
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	name     string
+	export   string
+	numArgs  uint
+	timeout  time.Duration
+}
+
+func newFunction(ctx context.Context, fc *FunctionConf, wasm []byte) (*function, error) {
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(fc.MaxMemoryPages).
+		WithCloseOnContextDone(true))
+
+	compiled, err := runtime.CompileModule(ctx, wasm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile module: %w", err)
+	}
+
+	if imports := compiled.ImportedFunctions(); len(imports) > 0 {
+		moduleName, name, _ := imports[0].Import()
+		return nil, fmt.Errorf("module imports host function %s.%s: imports are not allowed", moduleName, name)
+	}
+
+	exports := compiled.ExportedFunctions()
+	if err := checkSignature(exports[allocFn], allocFn, []api.ValueType{api.ValueTypeI32}, api.ValueTypeI32); err != nil {
+		return nil, err
+	}
+
+	if err := checkSignature(exports[fc.Export], fc.Export, []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}, api.ValueTypeI64); err != nil {
+		return nil, err
+	}
+
+	if _, ok := compiled.ExportedMemories()["memory"]; !ok {
+		return nil, errors.New("module does not export memory")
+	}
+
+	return &function{
+		runtime:  runtime,
+		compiled: compiled,
+		name:     fc.Name,
+		export:   fc.Export,
+		numArgs:  fc.NumArgs,
+		timeout:  fc.Timeout,
+	}, nil
+}
+
+func checkSignature(def api.FunctionDefinition, name string, params []api.ValueType, result api.ValueType) error {
+	if def == nil {
+		return fmt.Errorf("module does not export function %q", name)
+	}
+
+	haveParams := def.ParamTypes()
+	haveResults := def.ResultTypes()
+	if len(haveParams) != len(params) || len(haveResults) != 1 || haveResults[0] != result {
+		return fmt.Errorf("function %q has an invalid signature", name)
+	}
+
+	for i, p := range params {
+		if haveParams[i] != p {
+			return fmt.Errorf("function %q has an invalid signature", name)
+		}
+	}
+
+	return nil
+}
+
+func (fn *function) call(args ...ref.Val) ref.Val {
+	input, err := encodeArgs(args)
+	if err != nil {
+		return types.NewErr("%s: failed to encode arguments: %v", fn.name, err)
+	}
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), fn.timeout)
+	defer cancelFunc()
+
+	output, err := fn.invoke(ctx, input)
+	if err != nil {
+		return types.NewErr("%s: %v", fn.name, err)
+	}
+
+	result := &structpb.Value{}
+	if err := protojson.Unmarshal(output, result); err != nil {
+		return types.NewErr("%s: failed to decode result: %v", fn.name, err)
+	}
+
+	return types.DefaultTypeAdapter.NativeToValue(result)
+}
+
+func (fn *function) invoke(ctx context.Context, input []byte) ([]byte, error) {
+	mod, err := fn.runtime.InstantiateModule(ctx, fn.compiled, wazero.NewModuleConfig().WithName("").WithStartFunctions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate module: %w", err)
+	}
+	defer mod.Close(ctx)
+
+	res, err := mod.ExportedFunction(allocFn).Call(ctx, uint64(len(input)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to allocate memory: %w", err)
+	}
+
+	ptr := uint32(res[0])
+	if !mod.Memory().Write(ptr, input) {
+		return nil, fmt.Errorf("allocated memory at %d is out of range", ptr)
+	}
+
+	res, err = mod.ExportedFunction(fn.export).Call(ctx, uint64(ptr), uint64(len(input)))
+	if err != nil {
+		return nil, fmt.Errorf("call failed: %w", err)
+	}
+
+	resultPtr := uint32(res[0] >> resultPtrShift)
+	resultLen := uint32(res[0] & resultLenMask)
+	output, ok := mod.Memory().Read(resultPtr, resultLen)
+	if !ok {
+		return nil, fmt.Errorf("result at %d with length %d is out of range", resultPtr, resultLen)
+	}
+
+	// the slice is a view of the module memory, which is released when the module is closed
+	return append([]byte(nil), output...), nil
+}
+
+func encodeArgs(args []ref.Val) ([]byte, error) {
+	values := make([]*structpb.Value, len(args))
+	for i, arg := range args {
+		v, err := arg.ConvertToNative(structpbValueType)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %w", i, err)
+		}
+
+		values[i] = v.(*structpb.Value) //nolint:forcetypeassert
+	}
+
+	return protojson.Marshal(structpb.NewListValue(&structpb.ListValue{Values: values}))
+}
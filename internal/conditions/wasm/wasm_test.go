@@ -0,0 +1,79 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package wasm_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/conditions/wasm"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+func TestFunctions(t *testing.T) {
+	module := filepath.Join(test.PathToDir(t, "wasm"), "functions.wasm")
+
+	lib, err := wasm.NewLibrary(context.Background(), []*wasm.FunctionConf{
+		{Name: "echo", Module: module, NumArgs: 2},
+		{Name: "spinForever", Module: module, Export: "spin", NumArgs: 1, Timeout: 50 * time.Millisecond},
+	})
+	require.NoError(t, err)
+
+	env, err := cel.NewEnv(cel.Lib(lib))
+	require.NoError(t, err)
+
+	eval := func(t *testing.T, expr string) (any, error) {
+		t.Helper()
+
+		ast, iss := env.Compile(expr)
+		require.NoError(t, iss.Err())
+
+		prg, err := env.Program(ast)
+		require.NoError(t, err)
+
+		out, _, err := prg.Eval(cel.NoVars())
+		return out, err
+	}
+
+	t.Run("echo", func(t *testing.T) {
+		out, err := eval(t, `echo("acme", 42) == ["acme", 42]`)
+		require.NoError(t, err)
+		require.Equal(t, types.True, out)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		start := time.Now()
+		_, err := eval(t, `spinForever(1) == 1`)
+		require.Error(t, err)
+		require.Less(t, time.Since(start), 5*time.Second)
+	})
+}
+
+func TestNewLibraryErrors(t *testing.T) {
+	module := filepath.Join(test.PathToDir(t, "wasm"), "functions.wasm")
+
+	testCases := []struct {
+		name string
+		conf *wasm.FunctionConf
+	}{
+		{name: "missing_name", conf: &wasm.FunctionConf{Module: module}},
+		{name: "missing_module_file", conf: &wasm.FunctionConf{Name: "echo", Module: filepath.Join(t.TempDir(), "missing.wasm")}},
+		{name: "missing_export", conf: &wasm.FunctionConf{Name: "missing", Module: module}},
+		{name: "invalid_signature", conf: &wasm.FunctionConf{Name: "alloc", Module: module}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := wasm.NewLibrary(context.Background(), []*wasm.FunctionConf{tc.conf})
+			require.Error(t, err)
+		})
+	}
+}
@@ -5,7 +5,9 @@ package config
 
 import (
 	"bytes"
+	"crypto/sha256"
 	_ "embed"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"html/template"
@@ -16,6 +18,7 @@ import (
 	"sync"
 
 	"go.uber.org/config"
+	"gopkg.in/yaml.v3"
 )
 
 const DefaultMarker = "__default__"
@@ -23,7 +26,10 @@ const DefaultMarker = "__default__"
 //go:embed conf.yaml.gotmpl
 var defaultConfTmpl string
 
-var ErrConfigNotLoaded = errors.New("config not loaded")
+var (
+	ErrConfigNotLoaded    = errors.New("config not loaded")
+	ErrReloadNotSupported = errors.New("config was not loaded from a file")
+)
 
 var conf = &Wrapper{}
 
@@ -41,39 +47,45 @@ type Validator interface {
 
 // Load loads the config file at the given path.
 func Load(confFile string, overrides map[string]any) error {
+	return loadFrom(func() ([]config.YAMLOption, error) {
+		return fileSources(confFile, overrides)
+	})
+}
+
+func fileSources(confFile string, overrides map[string]any) ([]config.YAMLOption, error) {
 	if confFile == "" || confFile == DefaultMarker {
-		return loadDefault(overrides)
+		return defaultSources(overrides)
 	}
 
 	finfo, err := os.Stat(confFile)
 	if err != nil {
-		return fmt.Errorf("failed to stat %s: %w", confFile, err)
+		return nil, fmt.Errorf("failed to stat %s: %w", confFile, err)
 	}
 
 	if finfo.IsDir() {
-		return fmt.Errorf("config file path is a directory: %s", confFile)
+		return nil, fmt.Errorf("config file path is a directory: %s", confFile)
 	}
 
-	return doLoad(config.File(confFile), config.Static(overrides))
+	return []config.YAMLOption{config.File(confFile), config.Static(overrides)}, nil
 }
 
-func loadDefault(overrides map[string]any) error {
+func defaultSources(overrides map[string]any) ([]config.YAMLOption, error) {
 	tmpl, err := template.New("conf").Parse(defaultConfTmpl)
 	if err != nil {
-		return fmt.Errorf("failed to parse default config template: %w", err)
+		return nil, fmt.Errorf("failed to parse default config template: %w", err)
 	}
 
 	currDir, err := os.Getwd()
 	if err != nil {
-		return fmt.Errorf("failed to determine current working directory: %w", err)
+		return nil, fmt.Errorf("failed to determine current working directory: %w", err)
 	}
 
 	renderedConf := new(bytes.Buffer)
 	if err := tmpl.Execute(renderedConf, map[string]string{"directory": filepath.Join(currDir, "policies")}); err != nil {
-		return fmt.Errorf("failed to render default config template: %w", err)
+		return nil, fmt.Errorf("failed to render default config template: %w", err)
 	}
 
-	return doLoad(config.Source(renderedConf), config.Static(overrides))
+	return []config.YAMLOption{config.Source(renderedConf), config.Static(overrides)}, nil
 }
 
 func LoadReader(reader io.Reader, overrides map[string]any) error {
@@ -90,10 +102,33 @@ func doLoad(sources ...config.YAMLOption) error {
 		return err
 	}
 
-	conf.replaceProvider(provider)
+	conf.replaceProvider(provider, nil)
+	return nil
+}
+
+// loadFrom loads the config from the given sources and remembers them so that the config can be reloaded.
+func loadFrom(sources sourcesFunc) error {
+	provider, err := sources.provider()
+	if err != nil {
+		return err
+	}
+
+	conf.replaceProvider(provider, sources)
 	return nil
 }
 
+// sourcesFunc opens the sources that the config is read from.
+type sourcesFunc func() ([]config.YAMLOption, error)
+
+func (sf sourcesFunc) provider() (config.Provider, error) {
+	sources, err := sf()
+	if err != nil {
+		return nil, err
+	}
+
+	return mkProvider(sources...)
+}
+
 func mkProvider(sources ...config.YAMLOption) (config.Provider, error) {
 	opts := append(sources, config.Expand(os.LookupEnv)) //nolint:gocritic
 	provider, err := config.NewYAML(opts...)
@@ -142,7 +177,9 @@ func newWrapper(sources ...config.YAMLOption) (*Wrapper, error) {
 
 type Wrapper struct {
 	provider config.Provider
-	mu       sync.RWMutex
+	// sources reopens the sources that the config was loaded from. It's nil if the config can't be reloaded.
+	sources sourcesFunc
+	mu      sync.RWMutex
 }
 
 func (w *Wrapper) Get(key string, out any) error {
@@ -179,9 +216,62 @@ func (w *Wrapper) GetSection(section Section) error {
 	return w.Get(section.Key(), section)
 }
 
-func (w *Wrapper) replaceProvider(provider config.Provider) {
+// Hash returns the hex-encoded SHA-256 hash of the effective configuration.
+// Configurations with the same contents produce the same hash regardless of the order of the keys.
+func (w *Wrapper) Hash() (string, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.provider == nil {
+		return "", ErrConfigNotLoaded
+	}
+
+	// maps are marshalled with sorted keys, making the output stable
+	confBytes, err := yaml.Marshal(w.provider.Get(config.Root).Value())
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	sum := sha256.Sum256(confBytes)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Reload reads the config again from the file and overrides that it was loaded from.
+// The config held by the receiver doesn't change: the returned wrapper holds the new config instead.
+func (w *Wrapper) Reload() (*Wrapper, error) {
+	w.mu.RLock()
+	sources := w.sources
+	w.mu.RUnlock()
+
+	if sources == nil {
+		return nil, ErrReloadNotSupported
+	}
+
+	provider, err := sources.provider()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Wrapper{provider: provider, sources: sources}, nil
+}
+
+// Merge returns a wrapper that holds the config with the values of m merged over it.
+// Maps are merged recursively, and other values in m replace the values in the config.
+func (w *Wrapper) Merge(m map[string]any) (*Wrapper, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.provider == nil {
+		return nil, ErrConfigNotLoaded
+	}
+
+	return newWrapper(config.Static(w.provider.Get(config.Root).Value()), config.Static(m))
+}
+
+func (w *Wrapper) replaceProvider(provider config.Provider, sources sourcesFunc) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
 	w.provider = provider
+	w.sources = sources
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -226,3 +227,81 @@ func TestBuiltInConfig(t *testing.T) {
 	require.NoError(t, config.Get("storage.disk.directory", &diskDir))
 	require.Equal(t, filepath.Join(cwd, "policies"), diskDir)
 }
+
+func TestHash(t *testing.T) {
+	confA, err := config.WrapperFromMap(map[string]any{
+		"server":  map[string]any{"httpListenAddr": ":6666", "grpcListenAddr": ":6667"},
+		"storage": map[string]any{"driver": "disk"},
+	})
+	require.NoError(t, err)
+
+	confB, err := config.WrapperFromReader(strings.NewReader("storage:\n  driver: disk\nserver:\n  grpcListenAddr: \":6667\"\n  httpListenAddr: \":6666\"\n"), nil)
+	require.NoError(t, err)
+
+	confC, err := config.WrapperFromMap(map[string]any{
+		"server":  map[string]any{"httpListenAddr": ":7777", "grpcListenAddr": ":6667"},
+		"storage": map[string]any{"driver": "disk"},
+	})
+	require.NoError(t, err)
+
+	hashA, err := confA.Hash()
+	require.NoError(t, err)
+	require.Len(t, hashA, 64)
+
+	hashB, err := confB.Hash()
+	require.NoError(t, err)
+	require.Equal(t, hashA, hashB)
+
+	hashC, err := confC.Hash()
+	require.NoError(t, err)
+	require.NotEqual(t, hashA, hashC)
+}
+
+func TestReload(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(confFile, []byte("server:\n  listenAddr: \":6666\"\n"), 0o600))
+	require.NoError(t, config.Load(confFile, map[string]any{"server": map[string]any{"dataDir": "/data"}}))
+
+	require.NoError(t, os.WriteFile(confFile, []byte("server:\n  listenAddr: \":7777\"\n"), 0o600))
+
+	reloaded, err := config.Global().Reload()
+	require.NoError(t, err)
+
+	var haveServer Server
+	require.NoError(t, reloaded.GetSection(&haveServer))
+	require.Equal(t, Server{ListenAddr: ":7777", DataDir: "/data"}, haveServer)
+
+	// the global config doesn't change until the new config is applied
+	require.NoError(t, config.GetSection(&haveServer))
+	require.Equal(t, ":6666", haveServer.ListenAddr)
+
+	t.Run("invalid_file", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(confFile, []byte("server: [\n"), 0o600))
+
+		_, err := config.Global().Reload()
+		require.Error(t, err)
+	})
+
+	t.Run("not_loaded_from_file", func(t *testing.T) {
+		require.NoError(t, config.LoadMap(map[string]any{"server": map[string]any{"listenAddr": ":8888"}}))
+
+		_, err := config.Global().Reload()
+		require.ErrorIs(t, err, config.ErrReloadNotSupported)
+	})
+}
+
+func TestMerge(t *testing.T) {
+	base, err := config.WrapperFromMap(map[string]any{"server": map[string]any{"listenAddr": ":6666", "dataDir": "/data"}})
+	require.NoError(t, err)
+
+	merged, err := base.Merge(map[string]any{"server": map[string]any{"dataDir": "/tenant"}})
+	require.NoError(t, err)
+
+	var haveServer Server
+	require.NoError(t, merged.GetSection(&haveServer))
+	require.Equal(t, Server{ListenAddr: ":6666", DataDir: "/tenant"}, haveServer)
+
+	// the base config doesn't change
+	require.NoError(t, base.GetSection(&haveServer))
+	require.Equal(t, "/data", haveServer.DataDir)
+}
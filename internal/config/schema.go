@@ -0,0 +1,371 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	jsonschema "github.com/santhosh-tekuri/jsonschema/v5"
+	"go.uber.org/config"
+	"go.uber.org/multierr"
+)
+
+const (
+	schemaDraft     = "https://json-schema.org/draft/2020-12/schema"
+	schemaURL       = "https://api.cerbos.dev/latest/cerbos/config.schema.json"
+	durationPattern = `^(0|([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+)$`
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Schema returns a JSON schema describing a configuration file made up of the given sections.
+// Sections with nested keys such as `storage.disk` are added to the properties of their parent section.
+func Schema(sections ...Section) map[string]any {
+	root := map[string]any{
+		"$schema":              schemaDraft,
+		"$id":                  schemaURL,
+		"type":                 "object",
+		"properties":           map[string]any{},
+		"additionalProperties": false,
+	}
+
+	// add parents before children so that the children can be merged into the parent schemas
+	sorted := make([]Section, len(sections))
+	copy(sorted, sections)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return strings.Count(sorted[i].Key(), ".") < strings.Count(sorted[j].Key(), ".")
+	})
+
+	for _, s := range sorted {
+		parent := root
+		keyParts := strings.Split(s.Key(), ".")
+		for _, part := range keyParts[:len(keyParts)-1] {
+			props := parent["properties"].(map[string]any) //nolint:forcetypeassert
+			child, ok := props[part].(map[string]any)
+			if !ok {
+				child = map[string]any{"type": "object", "properties": map[string]any{}, "additionalProperties": false}
+				props[part] = child
+			}
+			parent = child
+		}
+
+		props := parent["properties"].(map[string]any) //nolint:forcetypeassert
+		props[keyParts[len(keyParts)-1]] = typeSchema(reflect.TypeOf(s), sectionDefaults(s))
+	}
+
+	return root
+}
+
+// sectionDefaults returns a new instance of the section populated with its default values.
+func sectionDefaults(s Section) reflect.Value {
+	v := reflect.New(reflect.TypeOf(s).Elem())
+	if d, ok := v.Interface().(Defaulter); ok {
+		d.SetDefaults()
+	}
+
+	return v
+}
+
+// typeSchema returns the schema of the given type. If defaults is valid, it holds the default value of the type.
+func typeSchema(t reflect.Type, defaults reflect.Value) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+		if defaults.IsValid() {
+			defaults = defaults.Elem()
+		}
+	}
+
+	if t == durationType {
+		return map[string]any{"type": []string{"string", "integer"}, "pattern": durationPattern}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]any{"type": "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": typeSchema(t.Elem(), reflect.Value{})}
+	case reflect.Map:
+		if t.Elem().Kind() == reflect.Interface {
+			return map[string]any{"type": "object"}
+		}
+		return map[string]any{"type": "object", "additionalProperties": typeSchema(t.Elem(), reflect.Value{})}
+	case reflect.Struct:
+		props := make(map[string]any)
+		var required []string
+		addStructFields(t, defaults, props, &required)
+
+		s := map[string]any{"type": "object", "properties": props, "additionalProperties": false}
+		if len(required) > 0 {
+			sort.Strings(required)
+			s["required"] = required
+		}
+		return s
+	default:
+		return map[string]any{}
+	}
+}
+
+func addStructFields(t reflect.Type, defaults reflect.Value, props map[string]any, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		var fieldDefault reflect.Value
+		if defaults.IsValid() {
+			fieldDefault = defaults.Field(i)
+			if fieldDefault.Kind() == reflect.Pointer && fieldDefault.IsNil() {
+				fieldDefault = reflect.Value{}
+			}
+		}
+
+		yamlTag, hasYAMLTag := f.Tag.Lookup("yaml")
+		// embedded structs without a name are inlined, like the confHolder structs of the sections with custom unmarshalers
+		if f.Anonymous && !hasYAMLTag {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+				if fieldDefault.IsValid() {
+					fieldDefault = fieldDefault.Elem()
+				}
+			}
+
+			if ft.Kind() == reflect.Struct {
+				addStructFields(ft, fieldDefault, props, required)
+			}
+			continue
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(yamlTag, ",")
+		if name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = strings.ToLower(f.Name)
+		}
+
+		// fields hidden from the documentation are still accepted by the parser, so they are included in the schema
+		props[name] = typeSchema(f.Type, fieldDefault)
+
+		// the documentation marks some fields with defaults or boolean fields as required, but they can be omitted in practice
+		confOpts, _, _ := strings.Cut(f.Tag.Get("conf"), ",")
+		if confOpts == "required" && f.Type.Kind() != reflect.Bool && (!fieldDefault.IsValid() || fieldDefault.IsZero()) {
+			*required = append(*required, name)
+		}
+	}
+}
+
+// ValidationError describes a problem with the value at a path of the configuration file.
+type ValidationError struct {
+	// Path is the location of the invalid value in the configuration file. For example, `server.tls.cert`.
+	Path    string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// ValidateFile checks the configuration file at the given path against the schema of the given sections
+// and the validation rules of the sections that are defined in the file.
+// It returns an error only if the file can't be read. Problems with the configuration are returned as a list.
+func ValidateFile(confFile string, sections ...Section) ([]ValidationError, error) {
+	if _, err := os.Stat(confFile); err != nil {
+		return nil, fmt.Errorf("failed to stat %s: %w", confFile, err)
+	}
+
+	provider, err := mkProvider(config.File(confFile))
+	if err != nil {
+		return []ValidationError{{Message: err.Error()}}, nil
+	}
+
+	conf, _ := toJSONCompatible(provider.Get(config.Root).Value()).(map[string]any)
+	errs, err := validateSchema(conf, Schema(sections...))
+	if err != nil {
+		return nil, err
+	}
+
+	// only check the rules of the sections that passed the schema validation to avoid reporting the same problem twice
+	invalid := make(map[string]struct{}, len(errs))
+	for _, e := range errs {
+		invalid[e.Path] = struct{}{}
+	}
+
+	w := &Wrapper{provider: provider}
+	for _, s := range sections {
+		key := s.Key()
+		if !hasKey(conf, key) || hasInvalidPath(invalid, key) {
+			continue
+		}
+
+		if err := w.GetSection(s); err != nil {
+			for _, e := range multierr.Errors(err) {
+				errs = append(errs, ValidationError{Path: key, Message: e.Error()})
+			}
+		}
+	}
+
+	return errs, nil
+}
+
+func hasKey(conf map[string]any, key string) bool {
+	keyParts := strings.Split(key, ".")
+	for _, part := range keyParts[:len(keyParts)-1] {
+		child, ok := conf[part].(map[string]any)
+		if !ok {
+			return false
+		}
+		conf = child
+	}
+
+	_, ok := conf[keyParts[len(keyParts)-1]]
+	return ok
+}
+
+func hasInvalidPath(invalid map[string]struct{}, key string) bool {
+	for path := range invalid {
+		if path == key || strings.HasPrefix(path, key+".") || strings.HasPrefix(path, key+"[") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateSchema(conf map[string]any, schema map[string]any) ([]ValidationError, error) {
+	schemaBytes, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config schema: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(schemaURL, bytes.NewReader(schemaBytes)); err != nil {
+		return nil, fmt.Errorf("failed to add config schema: %w", err)
+	}
+
+	sch, err := compiler.Compile(schemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile config schema: %w", err)
+	}
+
+	if conf == nil {
+		return nil, nil
+	}
+
+	// round trip through JSON to convert the YAML types to the types expected by the validator
+	confBytes, err := json.Marshal(conf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	var confJSON any
+	dec := json.NewDecoder(bytes.NewReader(confBytes))
+	dec.UseNumber()
+	if err := dec.Decode(&confJSON); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	if err := sch.Validate(confJSON); err != nil {
+		var validationErr *jsonschema.ValidationError
+		if !errors.As(err, &validationErr) {
+			return nil, fmt.Errorf("failed to validate config: %w", err)
+		}
+
+		errs := leafValidationErrors(validationErr)
+		sort.SliceStable(errs, func(i, j int) bool { return errs[i].Path < errs[j].Path })
+		return errs, nil
+	}
+
+	return nil, nil
+}
+
+func leafValidationErrors(err *jsonschema.ValidationError) []ValidationError {
+	if len(err.Causes) == 0 {
+		msg := err.Message
+		// durations are the only values validated with a pattern
+		if strings.HasSuffix(err.KeywordLocation, "/pattern") {
+			msg = "must be a duration such as 30s or 1h30m"
+		}
+		return []ValidationError{{Path: pointerToPath(err.InstanceLocation), Message: msg}}
+	}
+
+	var errs []ValidationError
+	for _, cause := range err.Causes {
+		errs = append(errs, leafValidationErrors(cause)...)
+	}
+
+	return errs
+}
+
+// pointerToPath converts a JSON pointer such as `/engine/overrides/0/kindPrefix` to `engine.overrides[0].kindPrefix`.
+func pointerToPath(pointer string) string {
+	if pointer == "" {
+		return ""
+	}
+
+	var path strings.Builder
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		if _, err := strconv.Atoi(token); err == nil {
+			path.WriteString("[" + token + "]")
+			continue
+		}
+
+		if path.Len() > 0 {
+			path.WriteString(".")
+		}
+		path.WriteString(token)
+	}
+
+	return path.String()
+}
+
+// toJSONCompatible converts the maps produced by the YAML decoder to maps with string keys.
+func toJSONCompatible(v any) any {
+	switch t := v.(type) {
+	case map[any]any:
+		m := make(map[string]any, len(t))
+		for k, val := range t {
+			m[fmt.Sprintf("%v", k)] = toJSONCompatible(val)
+		}
+		return m
+	case map[string]any:
+		m := make(map[string]any, len(t))
+		for k, val := range t {
+			m[k] = toJSONCompatible(val)
+		}
+		return m
+	case []any:
+		l := make([]any, len(t))
+		for i, val := range t {
+			l[i] = toJSONCompatible(val)
+		}
+		return l
+	default:
+		return v
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package config_test
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+)
+
+func schemaTestSections() []config.Section {
+	return []config.Section{&disk.Conf{}, &storage.Conf{}, &Server{}, &engine.Conf{}, &compile.Conf{}}
+}
+
+func TestSchema(t *testing.T) {
+	schema := config.Schema(schemaTestSections()...)
+
+	// round trip through JSON to make the schema easier to navigate
+	schemaBytes, err := json.Marshal(schema)
+	require.NoError(t, err)
+
+	var have map[string]any
+	require.NoError(t, json.Unmarshal(schemaBytes, &have))
+
+	prop := func(s any, path ...string) map[string]any {
+		t.Helper()
+
+		for _, p := range path {
+			m, ok := s.(map[string]any)
+			require.True(t, ok, "schema at %v is not an object", path)
+			s = m["properties"].(map[string]any)[p]
+		}
+
+		require.NotNil(t, s, "missing schema at %v", path)
+		return s.(map[string]any)
+	}
+
+	require.Equal(t, false, have["additionalProperties"])
+	require.Equal(t, "object", prop(have, "server", "tls")["type"])
+	require.Equal(t, "string", prop(have, "server", "tls", "certificate")["type"])
+	require.Equal(t, []any{"driver"}, prop(have, "storage")["required"])
+	require.Equal(t, []any{"directory"}, prop(have, "storage", "disk")["required"], "boolean fields should not be required")
+	require.Equal(t, "boolean", prop(have, "storage", "disk", "watchForChanges")["type"])
+	require.Equal(t, "string", prop(have, "storage", "disk", "scratchDir")["type"], "ignored fields should be accepted")
+	require.Equal(t, []any{"kindPrefix"}, prop(have, "engine", "overrides")["items"].(map[string]any)["required"])
+	require.Equal(t, "integer", prop(have, "engine", "costLimits", "maxRequestCost")["type"])
+	require.Equal(t, []any{"string", "integer"}, prop(have, "compile", "cacheDuration")["type"])
+}
+
+func TestValidateFile(t *testing.T) {
+	t.Run("schema_errors", func(t *testing.T) {
+		have, err := config.ValidateFile(filepath.Join("testdata", "test_validate_file.yaml"), schemaTestSections()...)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []config.ValidationError{
+			{Path: "", Message: "additionalProperties 'sever' not allowed"},
+			{Path: "compile.cacheDuration", Message: "must be a duration such as 30s or 1h30m"},
+			{Path: "engine.costLimits.maxConditionCost", Message: "must be >= 0 but found -1"},
+			{Path: "engine.overrides[0]", Message: "missing properties: 'kindPrefix'"},
+			{Path: "server.listenAddr", Message: "expected string, but got number"},
+			{Path: "server.tls", Message: "additionalProperties 'cert' not allowed"},
+			{Path: "storage.disk.watchForChanges", Message: "expected boolean, but got string"},
+		}, have)
+	})
+
+	t.Run("section_rules", func(t *testing.T) {
+		have, err := config.ValidateFile(filepath.Join("testdata", "test_validate_file_rules.yaml"), schemaTestSections()...)
+		require.NoError(t, err)
+		require.Equal(t, []config.ValidationError{
+			{Path: "engine", Message: "engine.defaultVersion must not be an empty string"},
+		}, have)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		have, err := config.ValidateFile(filepath.Join("testdata", "test_load.yaml"), &Server{})
+		require.NoError(t, err)
+		require.Empty(t, have)
+	})
+
+	t.Run("missing_file", func(t *testing.T) {
+		_, err := config.ValidateFile(filepath.Join("testdata", "missing.yaml"), &Server{})
+		require.Error(t, err)
+	})
+}
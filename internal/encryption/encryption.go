@@ -0,0 +1,164 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package encryption encrypts and decrypts policy archives and files stored in shared storage.
+// Data is encrypted with AES-256-GCM and stored as a header identifying the format, followed by the random nonce
+// and the ciphertext. The header is authenticated along with the ciphertext.
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// KeySize is the size of the AES-256 keys in bytes.
+const KeySize = 32
+
+var (
+	ErrNotEncrypted     = errors.New("data is not encrypted")
+	ErrDecryptionFailed = errors.New("decryption failed: the key is wrong or the data has been tampered with")
+
+	header = []byte("CERBOS-AES256GCM-V1\n")
+)
+
+// Conf holds the key used to encrypt and decrypt data.
+type Conf struct {
+	// Key is the base64-encoded 256-bit key.
+	Key string `yaml:"key" conf:",example=${POLICY_ENCRYPTION_KEY}"`
+	// KeyFile is the path to a file containing the base64-encoded 256-bit key. Use it to read keys mounted from a secrets manager or a KMS.
+	KeyFile string `yaml:"keyFile" conf:",example=/path/to/key"`
+}
+
+// Cipher encrypts and decrypts data with a single key.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipherFromConf creates a cipher from the configuration, which can be nil if encryption is disabled.
+func NewCipherFromConf(conf *Conf) (*Cipher, error) {
+	if conf == nil {
+		return nil, nil
+	}
+
+	switch {
+	case conf.Key != "" && conf.KeyFile != "":
+		return nil, errors.New("only one of key or keyFile can be specified")
+	case conf.Key != "":
+		return NewCipherFromString(conf.Key)
+	case conf.KeyFile != "":
+		return LoadCipher(conf.KeyFile)
+	default:
+		return nil, errors.New("one of key or keyFile must be specified")
+	}
+}
+
+// LoadCipher creates a cipher from the base64-encoded key stored at the given path.
+func LoadCipher(path string) (*Cipher, error) {
+	key, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key from %q: %w", path, err)
+	}
+
+	return NewCipherFromString(string(key))
+}
+
+// NewCipherFromString creates a cipher from a base64-encoded key.
+func NewCipherFromString(key string) (*Cipher, error) {
+	rawKey, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return nil, fmt.Errorf("key is not valid base64: %w", err)
+	}
+
+	return NewCipher(rawKey)
+}
+
+// NewCipher creates a cipher from a raw 256-bit key.
+func NewCipher(key []byte) (*Cipher, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("key must be %d bytes long, got %d bytes", KeySize, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	return &Cipher{aead: aead}, nil
+}
+
+// GenerateKey returns a new random base64-encoded key.
+func GenerateKey() (string, error) {
+	key := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return "", fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// Encrypt returns the encrypted form of the plaintext.
+func (c *Cipher) Encrypt(plaintext []byte) ([]byte, error) {
+	nonceSize := c.aead.NonceSize()
+	out := make([]byte, len(header)+nonceSize, len(header)+nonceSize+len(plaintext)+c.aead.Overhead())
+	copy(out, header)
+
+	nonce := out[len(header):]
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return c.aead.Seal(out, nonce, plaintext, header), nil
+}
+
+// Decrypt returns the plaintext of data produced by Encrypt.
+func (c *Cipher) Decrypt(data []byte) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return nil, ErrNotEncrypted
+	}
+
+	nonceSize := c.aead.NonceSize()
+	if len(data) < len(header)+nonceSize {
+		return nil, ErrDecryptionFailed
+	}
+
+	nonce := data[len(header) : len(header)+nonceSize]
+	plaintext, err := c.aead.Open(nil, nonce, data[len(header)+nonceSize:], header)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+
+	return plaintext, nil
+}
+
+// DecryptFile reads and decrypts the file at the given path.
+func (c *Cipher) DecryptFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %q: %w", path, err)
+	}
+
+	plaintext, err := c.Decrypt(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt %q: %w", path, err)
+	}
+
+	return plaintext, nil
+}
+
+// IsEncrypted returns true if the data starts with the header written by Encrypt.
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, header)
+}
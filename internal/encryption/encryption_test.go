@@ -0,0 +1,77 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryption_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/encryption"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	key, err := encryption.GenerateKey()
+	require.NoError(t, err)
+
+	c, err := encryption.NewCipherFromConf(&encryption.Conf{Key: key})
+	require.NoError(t, err)
+
+	plaintext := []byte("policies")
+
+	ciphertext, err := c.Encrypt(plaintext)
+	require.NoError(t, err)
+	require.True(t, encryption.IsEncrypted(ciphertext))
+	require.NotContains(t, string(ciphertext), string(plaintext))
+
+	t.Run("roundtrip", func(t *testing.T) {
+		have, err := c.Decrypt(ciphertext)
+		require.NoError(t, err)
+		require.Equal(t, plaintext, have)
+	})
+
+	t.Run("not_encrypted", func(t *testing.T) {
+		_, err := c.Decrypt(plaintext)
+		require.ErrorIs(t, err, encryption.ErrNotEncrypted)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		tampered := append([]byte(nil), ciphertext...)
+		tampered[len(tampered)-1] ^= 0xff
+
+		_, err := c.Decrypt(tampered)
+		require.ErrorIs(t, err, encryption.ErrDecryptionFailed)
+	})
+
+	t.Run("wrong_key", func(t *testing.T) {
+		otherKey, err := encryption.GenerateKey()
+		require.NoError(t, err)
+
+		keyFile := filepath.Join(t.TempDir(), "key")
+		require.NoError(t, os.WriteFile(keyFile, []byte(otherKey+"\n"), 0o600))
+
+		other, err := encryption.NewCipherFromConf(&encryption.Conf{KeyFile: keyFile})
+		require.NoError(t, err)
+
+		_, err = other.Decrypt(ciphertext)
+		require.ErrorIs(t, err, encryption.ErrDecryptionFailed)
+	})
+}
+
+func TestNewCipherFromConf(t *testing.T) {
+	c, err := encryption.NewCipherFromConf(nil)
+	require.NoError(t, err)
+	require.Nil(t, c)
+
+	_, err = encryption.NewCipherFromConf(&encryption.Conf{})
+	require.Error(t, err)
+
+	_, err = encryption.NewCipherFromConf(&encryption.Conf{Key: "c2hvcnQ="})
+	require.Error(t, err, "Short keys should be rejected")
+
+	_, err = encryption.NewCipherFromConf(&encryption.Conf{Key: "c2hvcnQ=", KeyFile: "/path/to/key"})
+	require.Error(t, err)
+}
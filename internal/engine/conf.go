@@ -4,27 +4,112 @@
 package engine
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"runtime"
 	"strings"
 
+	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/conditions"
+	"github.com/cerbos/cerbos/internal/conditions/lookup"
+	"github.com/cerbos/cerbos/internal/conditions/wasm"
 	"github.com/cerbos/cerbos/internal/config"
 	"github.com/cerbos/cerbos/internal/namer"
 )
 
-const confKey = "engine"
+const (
+	confKey             = "engine"
+	wasmPluginName      = "cerbos.wasm"
+	lookupPluginName    = "cerbos.lookup"
+	constantsPluginName = "cerbos.constants"
+)
+
+var (
+	errEmptyDefaultVersion = errors.New("engine.defaultVersion must not be an empty string")
+	errEmptyKindPrefix     = errors.New("engine.overrides.kindPrefix must not be an empty string")
+)
+
+// CombiningAlgorithm decides the effect of an action when both the principal policy and the resource policy have rules for it.
+type CombiningAlgorithm string
 
-var errEmptyDefaultVersion = errors.New("engine.defaultVersion must not be an empty string")
+const (
+	// CombiningFirstApplicable uses the effect produced by the principal policy and only consults the resource policy if the principal policy doesn't match.
+	CombiningFirstApplicable CombiningAlgorithm = "first-applicable"
+	// CombiningDenyOverrides evaluates both policies and denies the action if either of them denies it.
+	CombiningDenyOverrides CombiningAlgorithm = "deny-overrides"
+	// CombiningPermitOverrides evaluates both policies and allows the action if either of them allows it.
+	CombiningPermitOverrides CombiningAlgorithm = "permit-overrides"
+)
+
+func (ca CombiningAlgorithm) validate() error {
+	switch ca {
+	case "", CombiningFirstApplicable, CombiningDenyOverrides, CombiningPermitOverrides:
+		return nil
+	default:
+		return fmt.Errorf("unknown combining algorithm [%s]: must be one of %s, %s or %s", ca, CombiningFirstApplicable, CombiningDenyOverrides, CombiningPermitOverrides)
+	}
+}
 
 // Conf is optional configuration for engine.
 type Conf struct {
 	// Globals are environment-specific variables to be made available to policy conditions.
 	Globals map[string]any `yaml:"globals" conf:",example={\"environment\": \"staging\"}"`
+	// Constants are named values with declared types to be made available to policy conditions as `constants.<name>` or `C.<name>`.
+	Constants []*conditions.ConstantConf `yaml:"constants"`
 	// DefaultPolicyVersion defines what version to assume if the request does not specify one.
 	DefaultPolicyVersion string `yaml:"defaultPolicyVersion" conf:",example=\"default\""`
 	// LenientScopeSearch configures the engine to ignore missing scopes and search upwards through the scope tree until it finds a usable policy.
 	LenientScopeSearch bool `yaml:"lenientScopeSearch" conf:",example=false"`
-	NumWorkers         uint `yaml:"numWorkers" conf:",ignore"`
+	// CombiningAlgorithm decides the effect of an action when both the principal policy and the resource policy have rules for it. One of first-applicable (default), deny-overrides or permit-overrides.
+	CombiningAlgorithm CombiningAlgorithm `yaml:"combiningAlgorithm" conf:",example=\"first-applicable\""`
+	// FunctionPlugins is the list of registered CEL function plugins to make available to policy conditions.
+	FunctionPlugins []string `yaml:"functionPlugins" conf:",example=[\"acme\"]"`
+	// WASMFunctions are condition functions implemented by WebAssembly modules.
+	WASMFunctions []*wasm.FunctionConf `yaml:"wasmFunctions"`
+	// LookupSources are external data sources that policy conditions can query using `lookup("<name>", key)`.
+	LookupSources []*lookup.SourceConf `yaml:"lookupSources"`
+	// Overrides customise the engine settings for resource kinds starting with a given prefix. If several prefixes match a kind, the longest one wins.
+	Overrides []*KindOverride `yaml:"overrides"`
+	// CostLimits cap the CEL evaluation cost of policy conditions to protect the PDP from pathological policies.
+	CostLimits CostLimits `yaml:"costLimits"`
+	// Profiling records the evaluation time of policy conditions to help find the expressions that dominate the decision latency.
+	Profiling  ProfilingConf `yaml:"profiling"`
+	NumWorkers uint          `yaml:"numWorkers" conf:",ignore"`
+}
+
+// CostLimits cap the CEL evaluation cost of policy conditions. Zero values disable the corresponding limit.
+type CostLimits struct {
+	// MaxConditionCost is the maximum cost of evaluating a single condition or variable expression.
+	MaxConditionCost uint64 `yaml:"maxConditionCost" conf:",example=100000"`
+	// MaxRequestCost is the maximum cost of evaluating all the expressions required to produce the decision for a single resource.
+	MaxRequestCost uint64 `yaml:"maxRequestCost" conf:",example=1000000"`
+}
+
+// ProfilingConf configures the condition profiler.
+type ProfilingConf struct {
+	// Enabled turns on recording the evaluation time and count of each condition and variable expression. It adds a small overhead to each evaluation.
+	Enabled bool `yaml:"enabled" conf:",example=false"`
+}
+
+// KindOverride overrides engine settings for resource kinds starting with KindPrefix.
+type KindOverride struct {
+	// KindPrefix is the resource kind prefix this override applies to.
+	KindPrefix string `yaml:"kindPrefix" conf:"required,example=\"billing.\""`
+	// DefaultPolicyVersion overrides the global defaultPolicyVersion setting for matching resource kinds.
+	DefaultPolicyVersion string `yaml:"defaultPolicyVersion" conf:",example=\"billing\""`
+	// LenientScopeSearch overrides the global lenientScopeSearch setting for matching resource kinds.
+	LenientScopeSearch *bool `yaml:"lenientScopeSearch" conf:",example=true"`
+	// CombiningAlgorithm overrides the global combiningAlgorithm setting for matching resource kinds.
+	CombiningAlgorithm CombiningAlgorithm `yaml:"combiningAlgorithm" conf:",example=\"deny-overrides\""`
+}
+
+// kindSettings are the effective engine settings for a resource kind.
+type kindSettings struct {
+	defaultPolicyVersion string
+	combiningAlgorithm   CombiningAlgorithm
+	lenientScopeSearch   bool
 }
 
 func (c *Conf) Key() string {
@@ -41,7 +126,84 @@ func (c *Conf) Validate() error {
 		return errEmptyDefaultVersion
 	}
 
-	return nil
+	var errs error
+	if err := c.CombiningAlgorithm.validate(); err != nil {
+		errs = multierr.Append(errs, err)
+	}
+
+	for _, name := range c.FunctionPlugins {
+		if !conditions.FunctionPluginRegistered(name) {
+			errs = multierr.Append(errs, fmt.Errorf("unknown CEL function plugin [%s]", name))
+		}
+	}
+
+	for i, fc := range c.WASMFunctions {
+		if err := fc.Validate(); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid WASM function #%d: %w", i, err))
+		}
+	}
+
+	for i, sc := range c.LookupSources {
+		if err := sc.Validate(); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid lookup source #%d: %w", i, err))
+		}
+	}
+
+	seenConstants := make(map[string]struct{}, len(c.Constants))
+	for i, cc := range c.Constants {
+		if err := cc.Validate(); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid constant #%d: %w", i, err))
+			continue
+		}
+
+		if _, ok := seenConstants[cc.Name]; ok {
+			errs = multierr.Append(errs, fmt.Errorf("constant [%s] is defined more than once", cc.Name))
+		}
+		seenConstants[cc.Name] = struct{}{}
+	}
+
+	for i, o := range c.Overrides {
+		if o == nil || strings.TrimSpace(o.KindPrefix) == "" {
+			errs = multierr.Append(errs, fmt.Errorf("override #%d: %w", i, errEmptyKindPrefix))
+			continue
+		}
+
+		if err := o.CombiningAlgorithm.validate(); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("override #%d: %w", i, err))
+		}
+	}
+
+	return errs
+}
+
+// settingsFor returns the engine settings that apply to the given resource kind.
+func (c *Conf) settingsFor(kind string) kindSettings {
+	ks := kindSettings{defaultPolicyVersion: c.DefaultPolicyVersion, combiningAlgorithm: c.CombiningAlgorithm, lenientScopeSearch: c.LenientScopeSearch}
+
+	var match *KindOverride
+	for _, o := range c.Overrides {
+		if strings.HasPrefix(kind, o.KindPrefix) && (match == nil || len(o.KindPrefix) > len(match.KindPrefix)) {
+			match = o
+		}
+	}
+
+	if match == nil {
+		return ks
+	}
+
+	if match.DefaultPolicyVersion != "" {
+		ks.defaultPolicyVersion = match.DefaultPolicyVersion
+	}
+
+	if match.LenientScopeSearch != nil {
+		ks.lenientScopeSearch = *match.LenientScopeSearch
+	}
+
+	if match.CombiningAlgorithm != "" {
+		ks.combiningAlgorithm = match.CombiningAlgorithm
+	}
+
+	return ks
 }
 
 func GetConf() (*Conf, error) {
@@ -50,3 +212,45 @@ func GetConf() (*Conf, error) {
 
 	return conf, err
 }
+
+// EnableFunctionPlugins makes the CEL function plugins, WASM functions, lookup sources and constants listed in the configuration available to policy conditions.
+// It must be called before any policies are compiled.
+func EnableFunctionPlugins(ctx context.Context) error {
+	conf, err := GetConf()
+	if err != nil {
+		return fmt.Errorf("failed to read engine configuration: %w", err)
+	}
+
+	plugins := conf.FunctionPlugins
+	if len(conf.WASMFunctions) > 0 {
+		lib, err := wasm.NewLibrary(ctx, conf.WASMFunctions)
+		if err != nil {
+			return err
+		}
+
+		conditions.RegisterFunctionPlugin(wasmPluginName, lib)
+		plugins = append(plugins, wasmPluginName)
+	}
+
+	if len(conf.LookupSources) > 0 {
+		lib, err := lookup.NewLibrary(ctx, conf.LookupSources)
+		if err != nil {
+			return err
+		}
+
+		conditions.RegisterFunctionPlugin(lookupPluginName, lib)
+		plugins = append(plugins, lookupPluginName)
+	}
+
+	if len(conf.Constants) > 0 {
+		lib, err := conditions.NewConstantsLibrary(conf.Constants)
+		if err != nil {
+			return err
+		}
+
+		conditions.RegisterFunctionPlugin(constantsPluginName, lib)
+		plugins = append(plugins, constantsPluginName)
+	}
+
+	return conditions.EnableFunctionPlugins(plugins...)
+}
@@ -0,0 +1,94 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/conditions"
+	"github.com/cerbos/cerbos/internal/conditions/lookup"
+)
+
+func TestSettingsFor(t *testing.T) {
+	lenient := true
+	strict := false
+
+	conf := &Conf{}
+	conf.SetDefaults()
+	conf.Overrides = []*KindOverride{
+		{KindPrefix: "billing.", DefaultPolicyVersion: "billing", LenientScopeSearch: &lenient},
+		{KindPrefix: "billing.invoice", LenientScopeSearch: &strict},
+		{KindPrefix: "hr.", DefaultPolicyVersion: "hr", CombiningAlgorithm: CombiningDenyOverrides},
+	}
+	require.NoError(t, conf.Validate())
+
+	testCases := []struct {
+		kind string
+		want kindSettings
+	}{
+		{kind: "leave_request", want: kindSettings{defaultPolicyVersion: "default"}},
+		{kind: "billing.account", want: kindSettings{defaultPolicyVersion: "billing", lenientScopeSearch: true}},
+		{kind: "billing.invoice", want: kindSettings{defaultPolicyVersion: "default"}},
+		{kind: "hr.leave_request", want: kindSettings{defaultPolicyVersion: "hr", combiningAlgorithm: CombiningDenyOverrides}},
+		{kind: "billing", want: kindSettings{defaultPolicyVersion: "default"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.kind, func(t *testing.T) {
+			require.Equal(t, tc.want, conf.settingsFor(tc.kind))
+		})
+	}
+
+	conf.Overrides = append(conf.Overrides, &KindOverride{DefaultPolicyVersion: "oops"})
+	require.ErrorIs(t, conf.Validate(), errEmptyKindPrefix)
+}
+
+func TestValidateCombiningAlgorithm(t *testing.T) {
+	conf := &Conf{}
+	conf.SetDefaults()
+	require.NoError(t, conf.Validate())
+
+	conf.CombiningAlgorithm = CombiningPermitOverrides
+	conf.Overrides = []*KindOverride{{KindPrefix: "billing.", CombiningAlgorithm: CombiningDenyOverrides}}
+	require.NoError(t, conf.Validate())
+
+	conf.CombiningAlgorithm = "only-one-applicable"
+	require.Error(t, conf.Validate())
+
+	conf.CombiningAlgorithm = CombiningFirstApplicable
+	conf.Overrides[0].CombiningAlgorithm = "deny-unless-permit"
+	require.Error(t, conf.Validate())
+}
+
+func TestValidateConstants(t *testing.T) {
+	conf := &Conf{}
+	conf.SetDefaults()
+	conf.Constants = []*conditions.ConstantConf{
+		{Name: "region", Type: "string", Value: "eu-west-1"},
+		{Name: "tier", Type: "int", Value: 2},
+	}
+	require.NoError(t, conf.Validate())
+
+	conf.Constants = append(conf.Constants, &conditions.ConstantConf{Name: "region", Type: "string", Value: "us-east-1"})
+	require.ErrorContains(t, conf.Validate(), "constant [region] is defined more than once")
+
+	conf.Constants = []*conditions.ConstantConf{{Name: "tier", Type: "int", Value: "gold"}}
+	require.ErrorContains(t, conf.Validate(), "invalid constant #0")
+}
+
+func TestValidateLookupSources(t *testing.T) {
+	conf := &Conf{}
+	conf.SetDefaults()
+	conf.LookupSources = []*lookup.SourceConf{
+		{Name: "flags", HTTP: &lookup.HTTPConf{URL: "https://flags.acme.com/v1/flags/{key}"}},
+		{Name: "quotas", Redis: &lookup.RedisConf{Addr: "localhost:6379"}},
+	}
+	require.NoError(t, conf.Validate())
+
+	conf.LookupSources = append(conf.LookupSources, &lookup.SourceConf{Name: "entitlements", GRPC: &lookup.GRPCConf{Addr: "localhost:9000"}})
+	require.ErrorContains(t, conf.Validate(), "invalid lookup source #2")
+}
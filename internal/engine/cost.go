@@ -0,0 +1,93 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+)
+
+// CostLimitExceededError is returned when evaluating the conditions for a resource exceeds one of the configured cost limits.
+type CostLimitExceededError struct {
+	// Limit is the value of the limit that was exceeded.
+	Limit uint64
+	// PerRequest is true if the limit on the total cost of all the expressions was exceeded.
+	PerRequest bool
+}
+
+func (e *CostLimitExceededError) Error() string {
+	if e.PerRequest {
+		return fmt.Sprintf("total cost of evaluating conditions exceeded the limit of %d", e.Limit)
+	}
+
+	return fmt.Sprintf("cost of evaluating a condition exceeded the limit of %d", e.Limit)
+}
+
+// costBudget tracks the CEL evaluation cost spent on producing the decision for a single resource.
+// A nil budget does not impose any limits.
+type costBudget struct {
+	exceeded         *CostLimitExceededError
+	maxConditionCost uint64
+	maxRequestCost   uint64
+	spent            uint64
+}
+
+func newCostBudget(limits CostLimits) *costBudget {
+	if limits.MaxConditionCost == 0 && limits.MaxRequestCost == 0 {
+		return nil
+	}
+
+	return &costBudget{maxConditionCost: limits.MaxConditionCost, maxRequestCost: limits.MaxRequestCost}
+}
+
+// programOptions returns the program options that cap the cost of evaluating the next expression.
+// The second return value reports whether the cap comes from the remaining request budget rather than the condition limit.
+func (cb *costBudget) programOptions() ([]cel.ProgramOption, bool) {
+	if cb == nil {
+		return nil, false
+	}
+
+	limit, perRequest := cb.maxConditionCost, false
+	if cb.maxRequestCost > 0 {
+		if remaining := cb.maxRequestCost - cb.spent; limit == 0 || remaining < limit {
+			limit, perRequest = remaining, true
+		}
+	}
+
+	return []cel.ProgramOption{cel.CostLimit(limit)}, perRequest
+}
+
+func (cb *costBudget) spend(details *cel.EvalDetails) {
+	if cb == nil || details == nil {
+		return
+	}
+
+	if cost := details.ActualCost(); cost != nil {
+		cb.spent += *cost
+		if cb.maxRequestCost > 0 && cb.spent > cb.maxRequestCost {
+			cb.spent = cb.maxRequestCost
+		}
+	}
+}
+
+// setExceeded records that a limit was exceeded and returns the error to report.
+func (cb *costBudget) setExceeded(perRequest bool) error {
+	limit := cb.maxConditionCost
+	if perRequest {
+		limit = cb.maxRequestCost
+	}
+
+	cb.exceeded = &CostLimitExceededError{Limit: limit, PerRequest: perRequest}
+	return cb.exceeded
+}
+
+// err returns the error describing the exceeded limit or nil if the evaluation stayed within the limits.
+func (cb *costBudget) err() error {
+	if cb == nil || cb.exceeded == nil {
+		return nil
+	}
+
+	return cb.exceeded
+}
@@ -0,0 +1,282 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/proto"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const decisionCacheKind = "decision"
+
+// decisionCacheIgnoreFields are the input fields that don't influence the decision.
+var decisionCacheIgnoreFields = map[string]struct{}{
+	"cerbos.engine.v1.CheckInput.request_id": {},
+}
+
+// volatileFunctions are the functions whose results can change between calls with the same arguments because they
+// depend on the current time or on external data.
+var volatileFunctions = map[string]struct{}{
+	"lookup":    {},
+	"now":       {},
+	"timeSince": {},
+}
+
+// DecisionCache caches the outputs of check requests for a limited time so that repeated checks of the same principal,
+// resource, actions and auxiliary data don't have to be evaluated again.
+// The cache is purged whenever the policy loader reports a change to the policies or schemas.
+// Decisions that consume or check a rule limit, evaluate policies with an active period or evaluate conditions that call
+// now(), timeSince() or lookup() are never cached because they can change without the policies changing.
+type DecisionCache struct {
+	cache gcache.Cache
+	// revision returns the revision of the store. It is nil if the store is not revisioned.
+	revision func() string
+	log      *zap.Logger
+	// volatile records whether each expression calls one of the volatileFunctions.
+	volatile map[*exprpb.CheckedExpr]bool
+	// generation is incremented when the cache is purged to prevent caching decisions made with the old policies.
+	generation atomic.Uint64
+	// mu prevents the cache from being purged between checking the generation and adding an entry.
+	mu         sync.RWMutex
+	volatileMu sync.RWMutex
+}
+
+type decisionCacheKey struct {
+	revision string
+	hash     uint64
+}
+
+type decisionCacheEntry struct {
+	input  *enginev1.CheckInput
+	output *enginev1.CheckOutput
+}
+
+// NewDecisionCache creates a cache that holds at most size decisions for the given TTL.
+// If the store is revisioned, its revision is included in the cache key.
+func NewDecisionCache(size uint, ttl time.Duration, store storage.Store) *DecisionCache {
+	_ = stats.RecordWithTags(context.Background(),
+		[]tag.Mutator{tag.Upsert(metrics.KeyCacheKind, decisionCacheKind)},
+		metrics.CacheMaxSize.M(int64(size)),
+	)
+
+	gauge := metrics.MakeCacheGauge(decisionCacheKind)
+	dc := &DecisionCache{
+		cache: gcache.New(int(size)).
+			LRU().
+			Expiration(ttl).
+			AddedFunc(func(_, _ any) {
+				gauge.Add(1)
+			}).
+			EvictedFunc(func(_, _ any) {
+				gauge.Add(-1)
+			}).Build(),
+		log:      zap.L().Named("decision-cache"),
+		volatile: make(map[*exprpb.CheckedExpr]bool),
+	}
+
+	if r, ok := store.(storage.Revisioned); ok {
+		dc.revision = r.Revision
+	}
+
+	return dc
+}
+
+func (dc *DecisionCache) SubscriberID() string {
+	return "engine.DecisionCache"
+}
+
+func (dc *DecisionCache) OnStorageEvent(events ...storage.Event) {
+	if len(events) == 0 {
+		return
+	}
+
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+
+	dc.generation.Add(1)
+	dc.log.Debug("Purging decision cache", zap.Int("events", len(events)))
+	dc.cache.Purge()
+
+	// the expressions of the old policies are no longer evaluated
+	dc.volatileMu.Lock()
+	dc.volatile = make(map[*exprpb.CheckedExpr]bool)
+	dc.volatileMu.Unlock()
+}
+
+func (dc *DecisionCache) key(input *enginev1.CheckInput) decisionCacheKey {
+	key := decisionCacheKey{hash: util.HashPB(input, decisionCacheIgnoreFields)}
+	if dc.revision != nil {
+		key.revision = dc.revision()
+	}
+
+	return key
+}
+
+// get returns the cached output for the input or nil if there isn't one.
+func (dc *DecisionCache) get(key decisionCacheKey, input *enginev1.CheckInput) *enginev1.CheckOutput {
+	v, err := dc.cache.Get(key)
+	if err != nil {
+		if !errors.Is(err, gcache.KeyNotFoundError) {
+			dc.log.Warn("Failed to read from decision cache", zap.Error(err))
+		}
+		decisionCacheAccess("miss")
+		return nil
+	}
+
+	entry, ok := v.(decisionCacheEntry)
+	if !ok || !sameDecisionInput(entry.input, input) {
+		decisionCacheAccess("miss")
+		return nil
+	}
+
+	decisionCacheAccess("hit")
+
+	// the caller owns the returned output so it must not share anything with the cached entry
+	output := proto.Clone(entry.output).(*enginev1.CheckOutput) //nolint:forcetypeassert
+	output.RequestId = input.RequestId
+	return output
+}
+
+// set caches a copy of the input and the output unless the cache has been purged since the given generation.
+func (dc *DecisionCache) set(key decisionCacheKey, generation uint64, input *enginev1.CheckInput, output *enginev1.CheckOutput) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+
+	if dc.generation.Load() != generation {
+		return
+	}
+
+	entry := decisionCacheEntry{
+		input:  proto.Clone(input).(*enginev1.CheckInput),   //nolint:forcetypeassert
+		output: proto.Clone(output).(*enginev1.CheckOutput), //nolint:forcetypeassert
+	}
+	if err := dc.cache.Set(key, entry); err != nil {
+		dc.log.Warn("Failed to write to decision cache", zap.Error(err))
+	}
+}
+
+// isVolatile returns true if the expression calls one of the volatileFunctions.
+func (dc *DecisionCache) isVolatile(expr *exprpb.CheckedExpr) bool {
+	dc.volatileMu.RLock()
+	volatile, analysed := dc.volatile[expr]
+	dc.volatileMu.RUnlock()
+
+	if !analysed {
+		volatile = callsVolatileFunction(expr.GetExpr())
+		dc.volatileMu.Lock()
+		dc.volatile[expr] = volatile
+		dc.volatileMu.Unlock()
+	}
+
+	return volatile
+}
+
+func callsVolatileFunction(expr *exprpb.Expr) bool {
+	switch e := expr.GetExprKind().(type) {
+	case *exprpb.Expr_SelectExpr:
+		return callsVolatileFunction(e.SelectExpr.Operand)
+
+	case *exprpb.Expr_CallExpr:
+		if _, ok := volatileFunctions[e.CallExpr.Function]; ok {
+			return true
+		}
+
+		if e.CallExpr.Target != nil && callsVolatileFunction(e.CallExpr.Target) {
+			return true
+		}
+		return anyCallsVolatileFunction(e.CallExpr.Args...)
+
+	case *exprpb.Expr_ListExpr:
+		return anyCallsVolatileFunction(e.ListExpr.Elements...)
+
+	case *exprpb.Expr_StructExpr:
+		for _, entry := range e.StructExpr.Entries {
+			if callsVolatileFunction(entry.GetMapKey()) || callsVolatileFunction(entry.Value) {
+				return true
+			}
+		}
+		return false
+
+	case *exprpb.Expr_ComprehensionExpr:
+		c := e.ComprehensionExpr
+		return anyCallsVolatileFunction(c.IterRange, c.AccuInit, c.LoopCondition, c.LoopStep, c.Result)
+
+	default:
+		return false
+	}
+}
+
+func anyCallsVolatileFunction(exprs ...*exprpb.Expr) bool {
+	for _, e := range exprs {
+		if callsVolatileFunction(e) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cacheability records whether the decision for an input can be cached. A nil cacheability is used when the decision
+// is not going to be cached.
+type cacheability struct {
+	dc          *DecisionCache
+	uncacheable bool
+}
+
+// markUncacheable prevents the decision from being cached.
+func (c *cacheability) markUncacheable() {
+	if c != nil {
+		c.uncacheable = true
+	}
+}
+
+// checkExpr prevents the decision from being cached if the expression calls one of the volatileFunctions.
+func (c *cacheability) checkExpr(expr *exprpb.CheckedExpr) {
+	if c == nil || c.uncacheable {
+		return
+	}
+
+	if c.dc.isVolatile(expr) {
+		c.uncacheable = true
+	}
+}
+
+// sameDecisionInput guards against hash collisions by comparing the fields that influence the decision.
+func sameDecisionInput(a, b *enginev1.CheckInput) bool {
+	if len(a.Actions) != len(b.Actions) {
+		return false
+	}
+
+	for i, action := range a.Actions {
+		if b.Actions[i] != action {
+			return false
+		}
+	}
+
+	return proto.Equal(a.Principal, b.Principal) &&
+		proto.Equal(a.Resource, b.Resource) &&
+		proto.Equal(a.AuxData, b.AuxData)
+}
+
+func decisionCacheAccess(result string) {
+	_ = stats.RecordWithTags(context.Background(),
+		[]tag.Mutator{tag.Upsert(metrics.KeyCacheKind, decisionCacheKind), tag.Upsert(metrics.KeyCacheResult, result)},
+		metrics.CacheAccessCount.M(1),
+	)
+}
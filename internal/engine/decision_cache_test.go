@@ -0,0 +1,173 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage/db/sqlite3"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+func TestCheckWithDecisionCache(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	store, err := sqlite3.NewStore(ctx, &sqlite3.Conf{DSN: fmt.Sprintf("%s?_fk=true&_pragma=busy_timeout(5000)", filepath.Join(t.TempDir(), "cerbos.db"))})
+	require.NoError(t, err)
+
+	mkPolicy := func(effect effectv1.Effect) policy.Wrapper {
+		return policy.Wrap(test.NewResourcePolicyBuilder("leave_request", "default").
+			WithRules(test.NewResourceRule("view").WithRoles("user").WithEffect(effect).Build()).
+			Build())
+	}
+
+	require.NoError(t, store.AddOrUpdate(ctx, mkPolicy(effectv1.Effect_EFFECT_ALLOW)))
+	require.NoError(t, store.AddOrUpdate(ctx, policy.Wrap(test.NewResourcePolicyBuilder("timesheet", "default").
+		WithRules(test.NewResourceRule("view").WithRoles("user").WithMatchExpr(`now() > timestamp("2000-01-01T00:00:00Z")`).Build()).
+		Build())))
+
+	schemaMgr := schema.NewNopManager()
+	loader := &countingPolicyLoader{Manager: compile.NewManagerFromDefaultConf(ctx, store, schemaMgr)}
+
+	conf := &Conf{}
+	conf.SetDefaults()
+	eng := NewFromConf(ctx, conf, Components{
+		PolicyLoader:  loader,
+		SchemaMgr:     schemaMgr,
+		AuditLog:      audit.NewNopLog(),
+		DecisionCache: NewDecisionCache(10, time.Minute, store),
+	})
+
+	checkKind := func(t *testing.T, kind, requestID string, opts ...CheckOpt) *enginev1.CheckOutput {
+		t.Helper()
+
+		outputs, err := eng.Check(ctx, []*enginev1.CheckInput{
+			{
+				RequestId: requestID,
+				Actions:   []string{"view"},
+				Principal: &enginev1.Principal{Id: "harry", PolicyVersion: "default", Roles: []string{"user"}},
+				Resource:  &enginev1.Resource{Kind: kind, PolicyVersion: "default", Id: "XX125"},
+			},
+		}, opts...)
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+		return outputs[0]
+	}
+
+	check := func(t *testing.T, requestID string, opts ...CheckOpt) *enginev1.CheckOutput {
+		t.Helper()
+		return checkKind(t, "leave_request", requestID, opts...)
+	}
+
+	t.Run("repeated_check_is_cached", func(t *testing.T) {
+		have := check(t, "first")
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, have.Actions["view"].Effect)
+
+		calls := loader.calls.Load()
+		have = check(t, "second")
+		require.Equal(t, "second", have.RequestId)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, have.Actions["view"].Effect)
+		require.Equal(t, calls, loader.calls.Load(), "cached decision should not load policies")
+	})
+
+	t.Run("cached_output_is_not_shared", func(t *testing.T) {
+		have := check(t, "mutated")
+		have.Actions["view"].Effect = effectv1.Effect_EFFECT_DENY
+		have.Outputs = append(have.Outputs, &enginev1.OutputEntry{Src: "mutated"})
+
+		have = check(t, "after_mutation")
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, have.Actions["view"].Effect)
+		require.Empty(t, have.Outputs)
+	})
+
+	t.Run("time_dependent_check_is_not_cached", func(t *testing.T) {
+		have := checkKind(t, "timesheet", "first")
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, have.Actions["view"].Effect)
+
+		calls := loader.calls.Load()
+		have = checkKind(t, "timesheet", "second")
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, have.Actions["view"].Effect)
+		require.Greater(t, loader.calls.Load(), calls, "decision that depends on the current time should not be cached")
+	})
+
+	t.Run("check_with_options_bypasses_cache", func(t *testing.T) {
+		calls := loader.calls.Load()
+		have := check(t, "with_options", WithNowFunc(time.Now))
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, have.Actions["view"].Effect)
+		require.Greater(t, loader.calls.Load(), calls)
+	})
+
+	t.Run("policy_change_purges_cache", func(t *testing.T) {
+		require.NoError(t, store.AddOrUpdate(ctx, mkPolicy(effectv1.Effect_EFFECT_DENY)))
+		require.Eventually(t, func() bool {
+			return check(t, "after_change").Actions["view"].Effect == effectv1.Effect_EFFECT_DENY
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+}
+
+func TestCheckWithDecisionCacheAndRuleLimits(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	store, err := disk.NewStore(ctx, &disk.Conf{Directory: test.PathToDir(t, "query_planner/policies")})
+	require.NoError(t, err)
+
+	schemaMgr := schema.NewFromConf(ctx, store, schema.NewConf(schema.EnforcementNone))
+	conf := &Conf{}
+	conf.SetDefaults()
+	eng := NewFromConf(ctx, conf, Components{
+		PolicyLoader:  compile.NewManagerFromDefaultConf(ctx, store, schemaMgr),
+		SchemaMgr:     schemaMgr,
+		AuditLog:      audit.NewNopLog(),
+		DecisionCache: NewDecisionCache(10, time.Minute, store),
+	})
+
+	input := &enginev1.CheckInput{
+		RequestId: "test",
+		Actions:   []string{"download"},
+		Principal: &enginev1.Principal{Id: "harry", PolicyVersion: "default", Roles: []string{"user"}},
+		Resource: &enginev1.Resource{
+			Kind:          "export",
+			Id:            "e1",
+			PolicyVersion: "default",
+			Attr:          map[string]*structpb.Value{"owner": structpb.NewStringValue("harry")},
+		},
+	}
+
+	// decisions that consume a limit must not be cached, otherwise the limit would never be reached
+	want := []effectv1.Effect{effectv1.Effect_EFFECT_ALLOW, effectv1.Effect_EFFECT_ALLOW, effectv1.Effect_EFFECT_DENY}
+	for i, effect := range want {
+		outputs, err := eng.Check(ctx, []*enginev1.CheckInput{input})
+		require.NoError(t, err)
+		require.Equal(t, effect, outputs[0].Actions["download"].Effect, "check %d", i)
+	}
+}
+
+type countingPolicyLoader struct {
+	*compile.Manager
+	calls atomic.Int32
+}
+
+func (l *countingPolicyLoader) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID) (*runtimev1.RunnablePolicySet, error) {
+	l.calls.Add(1)
+	return l.Manager.GetFirstMatch(ctx, candidates)
+}
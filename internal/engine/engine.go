@@ -27,7 +27,9 @@ import (
 	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/observability/logging"
 	"github.com/cerbos/cerbos/internal/observability/tracing"
+	"github.com/cerbos/cerbos/internal/quota"
 	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage"
 )
 
 var errNoPoliciesMatched = errors.New("no matching policies")
@@ -47,7 +49,9 @@ type PolicyLoader interface {
 
 type checkOptions struct {
 	tracerSink tracer.Sink
-	evalParams evalParams
+	// decisionCache is set if the outputs can be served from and added to the decision cache.
+	decisionCache *DecisionCache
+	evalParams    evalParams
 }
 
 func newCheckOptions(ctx context.Context, globals map[string]any, opts ...CheckOpt) *checkOptions {
@@ -57,6 +61,7 @@ func newCheckOptions(ctx context.Context, globals map[string]any, opts ...CheckO
 	}
 
 	co := &checkOptions{tracerSink: tracerSink, evalParams: defaultEvalParams(globals)}
+	co.evalParams.memo = newConditionMemo()
 	for _, opt := range opts {
 		opt(co)
 	}
@@ -78,6 +83,14 @@ func WithZapTraceSink(log *zap.Logger) CheckOpt {
 	return WithTraceSink(tracer.NewZapSink(log))
 }
 
+// WithoutConsumingRuleLimits makes rules with limits allow actions only if the principal hasn't reached the limit, without
+// counting the decision towards the limit. It's used for evaluations that are not made on behalf of the principal.
+func WithoutConsumingRuleLimits() CheckOpt {
+	return func(co *checkOptions) {
+		co.evalParams.peekLimits = true
+	}
+}
+
 // WithNowFunc sets the function for determining `now` during condition evaluation.
 func WithNowFunc(nowFunc func() time.Time) CheckOpt {
 	return func(co *checkOptions) {
@@ -91,6 +104,9 @@ type Engine struct {
 	policyLoader      PolicyLoader
 	conf              *Conf
 	metadataExtractor audit.MetadataExtractor
+	quotaStore        quota.Store
+	profiler          *conditionProfiler
+	decisionCache     *DecisionCache
 	workerPool        []chan<- workIn
 	workerIndex       uint64
 }
@@ -100,6 +116,10 @@ type Components struct {
 	PolicyLoader      PolicyLoader
 	SchemaMgr         schema.Manager
 	MetadataExtractor audit.MetadataExtractor
+	// QuotaStore tracks the usage of rule limits. An in-memory store is used if it is not set.
+	QuotaStore quota.Store
+	// DecisionCache caches the outputs of checks. Decisions are not cached if it is not set.
+	DecisionCache *DecisionCache
 }
 
 func New(ctx context.Context, components Components) (*Engine, error) {
@@ -112,7 +132,20 @@ func New(ctx context.Context, components Components) (*Engine, error) {
 }
 
 func NewFromConf(ctx context.Context, conf *Conf, components Components) *Engine {
+	if components.QuotaStore == nil {
+		components.QuotaStore = quota.NewMemStore()
+	}
+
 	engine := newEngine(conf, components)
+	if conf.Profiling.Enabled {
+		engine.profiler = newConditionProfiler()
+	}
+
+	if dc := components.DecisionCache; dc != nil {
+		if s, ok := components.PolicyLoader.(storage.Subscribable); ok {
+			s.Subscribe(dc)
+		}
+	}
 
 	if numWorkers := conf.NumWorkers; numWorkers > 0 {
 		engine.workerPool = make([]chan<- workIn, numWorkers)
@@ -136,6 +169,32 @@ func NewEphemeral(policyLoader PolicyLoader, schemaMgr schema.Manager) (*Engine,
 	return newEngine(conf, Components{PolicyLoader: policyLoader, SchemaMgr: schemaMgr, AuditLog: audit.NewNopLog()}), nil
 }
 
+// WithPolicyLoader returns an engine that shares the configuration, the schema manager and the rule limits of this engine
+// but loads policies from the given policy loader. The returned engine doesn't have a worker pool and doesn't write to the audit log.
+func (engine *Engine) WithPolicyLoader(policyLoader PolicyLoader) *Engine {
+	return newEngine(engine.conf, Components{
+		PolicyLoader: policyLoader,
+		SchemaMgr:    engine.schemaMgr,
+		AuditLog:     audit.NewNopLog(),
+		QuotaStore:   engine.quotaStore,
+	})
+}
+
+// WithPoliciesFrom returns an engine that shares the configuration, the audit log, the rule limits and the condition profiler
+// of this engine but evaluates the policies and schemas of the other engine. The returned engine doesn't have a worker pool or a decision cache.
+func (engine *Engine) WithPoliciesFrom(other *Engine) *Engine {
+	e := newEngine(engine.conf, Components{
+		PolicyLoader:      other.policyLoader,
+		SchemaMgr:         other.schemaMgr,
+		AuditLog:          engine.auditLog,
+		MetadataExtractor: engine.metadataExtractor,
+		QuotaStore:        engine.quotaStore,
+	})
+	e.profiler = engine.profiler
+
+	return e
+}
+
 func newEngine(conf *Conf, c Components) *Engine {
 	return &Engine{
 		conf:              conf,
@@ -143,6 +202,25 @@ func newEngine(conf *Conf, c Components) *Engine {
 		schemaMgr:         c.SchemaMgr,
 		auditLog:          c.AuditLog,
 		metadataExtractor: c.MetadataExtractor,
+		quotaStore:        c.QuotaStore,
+		decisionCache:     c.DecisionCache,
+	}
+}
+
+// ConditionProfile returns the evaluation statistics of the policy expressions sorted by the total evaluation time, highest first.
+// The second return value is false if profiling is not enabled.
+func (engine *Engine) ConditionProfile() ([]ConditionProfile, bool) {
+	if engine.profiler == nil {
+		return nil, false
+	}
+
+	return engine.profiler.snapshot(), true
+}
+
+// ResetConditionProfile discards the evaluation statistics recorded so far.
+func (engine *Engine) ResetConditionProfile() {
+	if engine.profiler != nil {
+		engine.profiler.reset()
 	}
 }
 
@@ -183,11 +261,11 @@ func (engine *Engine) submitWork(ctx context.Context, work workIn) error {
 }
 
 func (engine *Engine) PlanResources(ctx context.Context, input *enginev1.PlanResourcesInput) (*enginev1.PlanResourcesOutput, error) {
-	output, err := measurePlanLatency(func() (output *enginev1.PlanResourcesOutput, err error) {
+	output, err := measurePlanLatency(ctx, func() (output *enginev1.PlanResourcesOutput, err error) {
 		ctx, span := tracing.StartSpan(ctx, "engine.Plan")
 		defer span.End()
 
-		output, err = engine.doPlanResources(ctx, input)
+		output, err = engine.doPlanResources(ctx, input, engine)
 		if err != nil {
 			tracing.MarkFailed(span, http.StatusBadRequest, err)
 		}
@@ -198,15 +276,48 @@ func (engine *Engine) PlanResources(ctx context.Context, input *enginev1.PlanRes
 	return engine.logPlanDecision(ctx, input, output, err)
 }
 
-func (engine *Engine) doPlanResources(ctx context.Context, input *enginev1.PlanResourcesInput) (*enginev1.PlanResourcesOutput, error) {
+// PlanResourcesStream produces query plans for a sequence of inputs and passes each output to sendFn in order.
+// Policy sets are retrieved once for each distinct principal and resource and reused for the rest of the inputs.
+// If the plan for an input cannot be produced, sendFn is called with the error and the rest of the inputs are still processed.
+func (engine *Engine) PlanResourcesStream(ctx context.Context, inputs []*enginev1.PlanResourcesInput, sendFn func(*enginev1.PlanResourcesOutput, error) error) error {
+	cache := newPolicySetCache(engine)
+	for _, input := range inputs {
+		output, err := measurePlanLatency(ctx, func() (output *enginev1.PlanResourcesOutput, err error) {
+			ctx, span := tracing.StartSpan(ctx, "engine.PlanStream")
+			defer span.End()
+
+			output, err = engine.doPlanResources(ctx, input, cache)
+			if err != nil {
+				tracing.MarkFailed(span, http.StatusBadRequest, err)
+			}
+
+			return output, err
+		})
+
+		output, err = engine.logPlanDecision(ctx, input, output, err)
+		if err != nil && ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err := sendFn(output, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (engine *Engine) doPlanResources(ctx context.Context, input *enginev1.PlanResourcesInput, policySets policySetGetter) (*enginev1.PlanResourcesOutput, error) {
 	// exit early if the context is cancelled
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
 
+	settings := engine.conf.settingsFor(input.Resource.Kind)
+
 	// get the principal policy check
-	ppName, ppVersion, ppScope := engine.policyAttr(input.Principal.Id, input.Principal.PolicyVersion, input.Principal.Scope)
-	policySet, err := engine.getPrincipalPolicySet(ctx, ppName, ppVersion, ppScope)
+	ppName, ppVersion, ppScope := engine.policyAttr(input.Principal.Id, input.Principal.PolicyVersion, input.Principal.Scope, settings)
+	policySet, err := policySets.getPrincipalPolicySet(ctx, ppName, ppVersion, ppScope, settings.lenientScopeSearch)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get check for [%s.%s]: %w", ppName, ppVersion, err)
 	}
@@ -222,8 +333,8 @@ func (engine *Engine) doPlanResources(ctx context.Context, input *enginev1.PlanR
 	}
 
 	// get the resource policy check
-	rpName, rpVersion, rpScope := engine.policyAttr(input.Resource.Kind, input.Resource.PolicyVersion, input.Resource.Scope)
-	policySet, err = engine.getResourcePolicySet(ctx, rpName, rpVersion, rpScope)
+	rpName, rpVersion, rpScope := engine.policyAttr(input.Resource.Kind, input.Resource.PolicyVersion, input.Resource.Scope, settings)
+	policySet, err = policySets.getResourcePolicySet(ctx, rpName, rpVersion, rpScope, settings.lenientScopeSearch)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get check for [%s.%s]: %w", rpName, rpVersion, err)
 	}
@@ -235,10 +346,17 @@ func (engine *Engine) doPlanResources(ctx context.Context, input *enginev1.PlanR
 			return nil, err
 		}
 
-		result = planner.CombinePlans(result, plan)
+		switch settings.combiningAlgorithm {
+		case CombiningDenyOverrides:
+			result = planner.CombinePlansDenyOverrides(result, plan)
+		case CombiningPermitOverrides:
+			result = planner.CombinePlansPermitOverrides(result, plan)
+		default:
+			result = planner.CombinePlans(result, plan)
+		}
 	}
 
-	output, err := result.ToPlanResourcesOutput(input)
+	output, err := result.ToPlanResourcesOutput(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -292,11 +410,15 @@ func (engine *Engine) logPlanDecision(ctx context.Context, input *enginev1.PlanR
 }
 
 func (engine *Engine) Check(ctx context.Context, inputs []*enginev1.CheckInput, opts ...CheckOpt) ([]*enginev1.CheckOutput, error) {
-	outputs, err := measureCheckLatency(len(inputs), func() (outputs []*enginev1.CheckOutput, err error) {
+	outputs, err := measureCheckLatency(ctx, len(inputs), func() (outputs []*enginev1.CheckOutput, err error) {
 		ctx, span := tracing.StartSpan(ctx, "engine.Check")
 		defer span.End()
 
 		checkOpts := newCheckOptions(ctx, engine.conf.Globals, opts...)
+		// decisions are traced or evaluated at a different time if there are options so they can't be served from the cache
+		if len(opts) == 0 && checkOpts.tracerSink == nil {
+			checkOpts.decisionCache = engine.decisionCache
+		}
 
 		// if the number of inputs is less than the threshold, do a serial execution as it is usually faster.
 		// ditto if the worker pool is not initialized
@@ -419,13 +541,33 @@ func (engine *Engine) evaluate(ctx context.Context, input *enginev1.CheckInput,
 		return nil, err
 	}
 
+	dc := checkOpts.decisionCache
+	var cacheKey decisionCacheKey
+	var cacheGeneration uint64
+	if dc != nil {
+		cacheGeneration = dc.generation.Load()
+		cacheKey = dc.key(input)
+		if output := dc.get(cacheKey, input); output != nil {
+			return output, nil
+		}
+	}
+
 	output := &enginev1.CheckOutput{
 		RequestId:  input.RequestId,
 		ResourceId: input.Resource.Id,
 		Actions:    make(map[string]*enginev1.CheckOutput_ActionEffect, len(input.Actions)),
 	}
 
-	ec, err := engine.buildEvaluationCtx(ctx, checkOpts.evalParams, input)
+	// each resource gets its own cost budget
+	eparams := checkOpts.evalParams
+	eparams.costBudget = newCostBudget(engine.conf.CostLimits)
+	eparams.quotaStore = engine.quotaStore
+	eparams.profiler = engine.profiler
+	if dc != nil {
+		eparams.cacheability = &cacheability{dc: dc}
+	}
+
+	ec, err := engine.buildEvaluationCtx(ctx, eparams, input)
 	if err != nil {
 		return nil, err
 	}
@@ -434,6 +576,12 @@ func (engine *Engine) evaluate(ctx context.Context, input *enginev1.CheckInput,
 
 	// evaluate the policies
 	result, err := ec.evaluate(ctx, tctx, input)
+	if err := eparams.costBudget.err(); err != nil {
+		// errors from conditions are normally swallowed by the evaluator so check the budget explicitly
+		logging.FromContext(ctx).Warn("Evaluation cost limit exceeded", zap.Error(err))
+		return nil, err
+	}
+
 	if err != nil {
 		logging.FromContext(ctx).Error("Failed to evaluate policies", zap.Error(err))
 		return nil, fmt.Errorf("failed to evaluate policies: %w", err)
@@ -458,23 +606,30 @@ func (engine *Engine) evaluate(ctx context.Context, input *enginev1.CheckInput,
 	output.ValidationErrors = result.validationErrors
 	output.Outputs = result.outputs
 
+	// decisions that consumed a rule limit would not be counted against the limit if they were served from the cache,
+	// and decisions that depend on the current time or on external data could change before the entry expires
+	if dc != nil && !eparams.cacheability.uncacheable {
+		dc.set(cacheKey, cacheGeneration, input, output)
+	}
+
 	return output, nil
 }
 
 func (engine *Engine) buildEvaluationCtx(ctx context.Context, eparams evalParams, input *enginev1.CheckInput) (*evaluationCtx, error) {
-	ec := &evaluationCtx{}
+	settings := engine.conf.settingsFor(input.Resource.Kind)
+	ec := &evaluationCtx{combiningAlgorithm: settings.combiningAlgorithm}
 
 	// get the principal policy check
-	ppName, ppVersion, ppScope := engine.policyAttr(input.Principal.Id, input.Principal.PolicyVersion, input.Principal.Scope)
-	ppCheck, err := engine.getPrincipalPolicyEvaluator(ctx, eparams, ppName, ppVersion, ppScope)
+	ppName, ppVersion, ppScope := engine.policyAttr(input.Principal.Id, input.Principal.PolicyVersion, input.Principal.Scope, settings)
+	ppCheck, err := engine.getPrincipalPolicyEvaluator(ctx, eparams, ppName, ppVersion, ppScope, settings.lenientScopeSearch)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get check for [%s.%s]: %w", ppName, ppVersion, err)
 	}
 	ec.addCheck(ppCheck)
 
 	// get the resource policy check
-	rpName, rpVersion, rpScope := engine.policyAttr(input.Resource.Kind, input.Resource.PolicyVersion, input.Resource.Scope)
-	rpCheck, err := engine.getResourcePolicyEvaluator(ctx, eparams, rpName, rpVersion, rpScope)
+	rpName, rpVersion, rpScope := engine.policyAttr(input.Resource.Kind, input.Resource.PolicyVersion, input.Resource.Scope, settings)
+	rpCheck, err := engine.getResourcePolicyEvaluator(ctx, eparams, rpName, rpVersion, rpScope, settings.lenientScopeSearch)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get check for [%s.%s]: %w", rpName, rpVersion, err)
 	}
@@ -483,8 +638,8 @@ func (engine *Engine) buildEvaluationCtx(ctx context.Context, eparams evalParams
 	return ec, nil
 }
 
-func (engine *Engine) getPrincipalPolicyEvaluator(ctx context.Context, eparams evalParams, principal, policyVer, scope string) (Evaluator, error) {
-	rps, err := engine.getPrincipalPolicySet(ctx, principal, policyVer, scope)
+func (engine *Engine) getPrincipalPolicyEvaluator(ctx context.Context, eparams evalParams, principal, policyVer, scope string, lenientScopeSearch bool) (Evaluator, error) {
+	rps, err := engine.getPrincipalPolicySet(ctx, principal, policyVer, scope, lenientScopeSearch)
 	if err != nil {
 		return nil, err
 	}
@@ -496,12 +651,12 @@ func (engine *Engine) getPrincipalPolicyEvaluator(ctx context.Context, eparams e
 	return NewEvaluator(rps, engine.schemaMgr, eparams), nil
 }
 
-func (engine *Engine) getPrincipalPolicySet(ctx context.Context, principal, policyVer, scope string) (*runtimev1.RunnablePolicySet, error) {
+func (engine *Engine) getPrincipalPolicySet(ctx context.Context, principal, policyVer, scope string, lenientScopeSearch bool) (*runtimev1.RunnablePolicySet, error) {
 	ctx, span := tracing.StartSpan(ctx, "engine.GetPrincipalPolicy")
 	defer span.End()
 	span.SetAttributes(tracing.PolicyName(principal), tracing.PolicyVersion(policyVer), tracing.PolicyScope(scope))
 
-	principalModIDs := namer.ScopedPrincipalPolicyModuleIDs(principal, policyVer, scope, engine.conf.LenientScopeSearch)
+	principalModIDs := namer.ScopedPrincipalPolicyModuleIDs(principal, policyVer, scope, lenientScopeSearch)
 	rps, err := engine.policyLoader.GetFirstMatch(ctx, principalModIDs)
 	if err != nil {
 		tracing.MarkFailed(span, http.StatusInternalServerError, err)
@@ -511,8 +666,8 @@ func (engine *Engine) getPrincipalPolicySet(ctx context.Context, principal, poli
 	return rps, nil
 }
 
-func (engine *Engine) getResourcePolicyEvaluator(ctx context.Context, eparams evalParams, resource, policyVer, scope string) (Evaluator, error) {
-	rps, err := engine.getResourcePolicySet(ctx, resource, policyVer, scope)
+func (engine *Engine) getResourcePolicyEvaluator(ctx context.Context, eparams evalParams, resource, policyVer, scope string, lenientScopeSearch bool) (Evaluator, error) {
+	rps, err := engine.getResourcePolicySet(ctx, resource, policyVer, scope, lenientScopeSearch)
 	if err != nil {
 		return nil, err
 	}
@@ -524,12 +679,12 @@ func (engine *Engine) getResourcePolicyEvaluator(ctx context.Context, eparams ev
 	return NewEvaluator(rps, engine.schemaMgr, eparams), nil
 }
 
-func (engine *Engine) getResourcePolicySet(ctx context.Context, resource, policyVer, scope string) (*runtimev1.RunnablePolicySet, error) {
+func (engine *Engine) getResourcePolicySet(ctx context.Context, resource, policyVer, scope string, lenientScopeSearch bool) (*runtimev1.RunnablePolicySet, error) {
 	ctx, span := tracing.StartSpan(ctx, "engine.GetResourcePolicy")
 	defer span.End()
 	span.SetAttributes(tracing.PolicyName(resource), tracing.PolicyVersion(policyVer), tracing.PolicyScope(scope))
 
-	resourceModIDs := namer.ScopedResourcePolicyModuleIDs(resource, policyVer, scope, engine.conf.LenientScopeSearch)
+	resourceModIDs := namer.ScopedResourcePolicyModuleIDs(resource, policyVer, scope, lenientScopeSearch)
 	rps, err := engine.policyLoader.GetFirstMatch(ctx, resourceModIDs)
 	if err != nil {
 		tracing.MarkFailed(span, http.StatusInternalServerError, err)
@@ -539,21 +694,22 @@ func (engine *Engine) getResourcePolicySet(ctx context.Context, resource, policy
 	return rps, nil
 }
 
-func (engine *Engine) policyAttr(name, version, scope string) (pName, pVersion, pScope string) {
+func (engine *Engine) policyAttr(name, version, scope string, settings kindSettings) (pName, pVersion, pScope string) {
 	pName = name
 	pVersion = version
 	pScope = scope
 
 	if version == "" {
-		pVersion = engine.conf.DefaultPolicyVersion
+		pVersion = settings.defaultPolicyVersion
 	}
 
 	return pName, pVersion, pScope
 }
 
 type evaluationCtx struct {
-	checks    [2]Evaluator
-	numChecks int
+	combiningAlgorithm CombiningAlgorithm
+	checks             [2]Evaluator
+	numChecks          int
 }
 
 func (ec *evaluationCtx) addCheck(eval Evaluator) {
@@ -586,12 +742,25 @@ func (ec *evaluationCtx) evaluate(ctx context.Context, tctx tracer.Context, inpu
 			return nil, fmt.Errorf("failed to execute policy: %w", err)
 		}
 
-		incomplete := resp.merge(result)
-		if !incomplete {
-			return resp, nil
+		switch ec.combiningAlgorithm {
+		case CombiningDenyOverrides:
+			resp.combine(result, effectv1.Effect_EFFECT_DENY)
+		case CombiningPermitOverrides:
+			resp.combine(result, effectv1.Effect_EFFECT_ALLOW)
+		default:
+			incomplete := resp.merge(result)
+			if !incomplete {
+				return resp, nil
+			}
 		}
 	}
 
+	if ec.combiningAlgorithm == CombiningDenyOverrides || ec.combiningAlgorithm == CombiningPermitOverrides {
+		// both policies have been evaluated so only the actions that neither of them matched need the default effect
+		resp.setDefaultsForUnmatchedActions(tctx, input)
+		return resp, nil
+	}
+
 	tracing.MarkFailed(span, http.StatusNotFound, errNoPoliciesMatched)
 	resp.setDefaultsForUnmatchedActions(tctx, input)
 
@@ -608,7 +777,25 @@ type evaluationResult struct {
 // merge the results by only updating the actions that have a no_match effect.
 func (er *evaluationResult) merge(res *PolicyEvalResult) bool {
 	hasNoMatches := false
+	er.mergeDetails(res)
+
+	for action, effect := range res.Effects {
+		// if the action doesn't already exist or if it has a no_match effect, update it.
+		if currEffect, ok := er.effects[action]; !ok || currEffect.Effect == effectv1.Effect_EFFECT_NO_MATCH {
+			er.effects[action] = effect
+
+			// if this effect is a no_match, we still need to traverse the policy hierarchy until we find a definitive answer
+			if effect.Effect == effectv1.Effect_EFFECT_NO_MATCH {
+				hasNoMatches = true
+			}
+		}
+	}
 
+	return hasNoMatches
+}
+
+// mergeDetails adds the derived roles, validation errors and outputs of the policy to the result.
+func (er *evaluationResult) mergeDetails(res *PolicyEvalResult) {
 	if er.effects == nil {
 		er.effects = make(map[string]EffectInfo, len(res.Effects))
 	}
@@ -626,20 +813,18 @@ func (er *evaluationResult) merge(res *PolicyEvalResult) bool {
 	if len(res.Outputs) > 0 {
 		er.outputs = append(er.outputs, res.Outputs...)
 	}
+}
+
+// combine the results by replacing the effects that are no_match or weaker than the overriding effect.
+func (er *evaluationResult) combine(res *PolicyEvalResult, overridingEffect effectv1.Effect) {
+	er.mergeDetails(res)
 
 	for action, effect := range res.Effects {
-		// if the action doesn't already exist or if it has a no_match effect, update it.
-		if currEffect, ok := er.effects[action]; !ok || currEffect.Effect == effectv1.Effect_EFFECT_NO_MATCH {
+		currEffect, ok := er.effects[action]
+		if !ok || currEffect.Effect == effectv1.Effect_EFFECT_NO_MATCH || (effect.Effect == overridingEffect && currEffect.Effect != overridingEffect) {
 			er.effects[action] = effect
-
-			// if this effect is a no_match, we still need to traverse the policy hierarchy until we find a definitive answer
-			if effect.Effect == effectv1.Effect_EFFECT_NO_MATCH {
-				hasNoMatches = true
-			}
 		}
 	}
-
-	return hasNoMatches
 }
 
 func (er *evaluationResult) setDefaultsForUnmatchedActions(tctx tracer.Context, input *enginev1.CheckInput) {
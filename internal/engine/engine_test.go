@@ -6,23 +6,28 @@ package engine
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/structpb"
 
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
 	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
 	privatev1 "github.com/cerbos/cerbos/api/genpb/cerbos/private/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
 	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
 	"github.com/cerbos/cerbos/internal/audit"
 	"github.com/cerbos/cerbos/internal/audit/local"
 	"github.com/cerbos/cerbos/internal/compile"
 	"github.com/cerbos/cerbos/internal/engine/tracer"
+	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/printer"
 	"github.com/cerbos/cerbos/internal/schema"
 	"github.com/cerbos/cerbos/internal/storage/disk"
@@ -65,7 +70,27 @@ func TestCheck(t *testing.T) {
 }
 
 func TestCheckWithLenientScopeSearch(t *testing.T) {
-	eng, cancelFunc := mkEngine(t, param{schemaEnforcement: schema.EnforcementNone, lenientScopeSearch: true})
+	lenient := true
+	params := map[string]param{
+		"global": {schemaEnforcement: schema.EnforcementNone, lenientScopeSearch: true},
+		"override": {schemaEnforcement: schema.EnforcementNone, overrides: []*KindOverride{
+			{KindPrefix: "leave_", LenientScopeSearch: &lenient},
+			{KindPrefix: "salary_", LenientScopeSearch: &lenient},
+		}},
+	}
+
+	for name, p := range params {
+		p := p
+		t.Run(name, func(t *testing.T) {
+			runLenientScopeSearchCases(t, p)
+		})
+	}
+}
+
+func runLenientScopeSearchCases(t *testing.T, p param) {
+	t.Helper()
+
+	eng, cancelFunc := mkEngine(t, p)
 	defer cancelFunc()
 
 	testCases := test.LoadTestCases(t, "engine")
@@ -96,6 +121,437 @@ func TestCheckWithLenientScopeSearch(t *testing.T) {
 	}
 }
 
+func TestCheckWithLayeredDerivedRoles(t *testing.T) {
+	eng, cancelFunc := mkEngine(t, param{subDir: "query_planner/policies", schemaEnforcement: schema.EnforcementNone})
+	defer cancelFunc()
+
+	mkInput := func(seniority float64) *enginev1.CheckInput {
+		return &enginev1.CheckInput{
+			RequestId: "test",
+			Actions:   []string{"view", "edit", "publish"},
+			Principal: &enginev1.Principal{
+				Id:            "maria",
+				PolicyVersion: "default",
+				Roles:         []string{"user"},
+				Attr: map[string]*structpb.Value{
+					"team":      structpb.NewStringValue("news"),
+					"can_edit":  structpb.NewBoolValue(true),
+					"seniority": structpb.NewNumberValue(seniority),
+				},
+			},
+			Resource: &enginev1.Resource{
+				Kind:          "article",
+				Id:            "a1",
+				PolicyVersion: "default",
+				Attr: map[string]*structpb.Value{
+					"team":   structpb.NewStringValue("news"),
+					"status": structpb.NewStringValue("DRAFT"),
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		seniority        float64
+		wantPublish      effectv1.Effect
+		wantDerivedRoles []string
+	}{
+		{
+			name:             "senior",
+			seniority:        10,
+			wantPublish:      effectv1.Effect_EFFECT_ALLOW,
+			wantDerivedRoles: []string{"editor", "member", "senior_editor"},
+		},
+		{
+			name:             "junior",
+			seniority:        1,
+			wantPublish:      effectv1.Effect_EFFECT_DENY,
+			wantDerivedRoles: []string{"editor", "member"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			outputs, err := eng.Check(context.Background(), []*enginev1.CheckInput{mkInput(tc.seniority)})
+			require.NoError(t, err)
+			require.Len(t, outputs, 1)
+
+			have := outputs[0]
+			require.Equal(t, effectv1.Effect_EFFECT_ALLOW, have.Actions["view"].Effect)
+			require.Equal(t, effectv1.Effect_EFFECT_ALLOW, have.Actions["edit"].Effect)
+			require.Equal(t, tc.wantPublish, have.Actions["publish"].Effect)
+			require.ElementsMatch(t, tc.wantDerivedRoles, have.EffectiveDerivedRoles)
+		})
+	}
+}
+
+func TestCheckWithCostLimits(t *testing.T) {
+	input := &enginev1.CheckInput{
+		RequestId: "test",
+		Actions:   []string{"view", "edit", "publish"},
+		Principal: &enginev1.Principal{
+			Id:            "maria",
+			PolicyVersion: "default",
+			Roles:         []string{"user"},
+			Attr: map[string]*structpb.Value{
+				"team":      structpb.NewStringValue("news"),
+				"can_edit":  structpb.NewBoolValue(true),
+				"seniority": structpb.NewNumberValue(10),
+			},
+		},
+		Resource: &enginev1.Resource{
+			Kind:          "article",
+			Id:            "a1",
+			PolicyVersion: "default",
+			Attr: map[string]*structpb.Value{
+				"team":   structpb.NewStringValue("news"),
+				"status": structpb.NewStringValue("DRAFT"),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		costLimits CostLimits
+		wantErr    *CostLimitExceededError
+	}{
+		{
+			name: "no_limits",
+		},
+		{
+			name:       "within_limits",
+			costLimits: CostLimits{MaxConditionCost: 1000, MaxRequestCost: 10000},
+		},
+		{
+			name:       "condition_limit_exceeded",
+			costLimits: CostLimits{MaxConditionCost: 1},
+			wantErr:    &CostLimitExceededError{Limit: 1},
+		},
+		{
+			name:       "request_limit_exceeded",
+			costLimits: CostLimits{MaxConditionCost: 1000, MaxRequestCost: 5},
+			wantErr:    &CostLimitExceededError{Limit: 5, PerRequest: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			eng, cancelFunc := mkEngine(t, param{subDir: "query_planner/policies", schemaEnforcement: schema.EnforcementNone, costLimits: tc.costLimits})
+			t.Cleanup(cancelFunc)
+
+			outputs, err := eng.Check(context.Background(), []*enginev1.CheckInput{input})
+			if tc.wantErr == nil {
+				require.NoError(t, err)
+				require.Len(t, outputs, 1)
+				require.Equal(t, effectv1.Effect_EFFECT_ALLOW, outputs[0].Actions["publish"].Effect)
+				return
+			}
+
+			haveErr := new(CostLimitExceededError)
+			require.ErrorAs(t, err, &haveErr)
+			require.Equal(t, tc.wantErr, haveErr)
+		})
+	}
+}
+
+func TestCheckWithCombiningAlgorithm(t *testing.T) {
+	mkInput := func(roles []string, attr map[string]*structpb.Value) *enginev1.CheckInput {
+		return &enginev1.CheckInput{
+			RequestId: "test",
+			Actions:   []string{"view"},
+			Principal: &enginev1.Principal{
+				Id:            "donald_duck",
+				PolicyVersion: "default",
+				Roles:         roles,
+			},
+			Resource: &enginev1.Resource{
+				Kind:          "leave_request",
+				Id:            "lr1",
+				PolicyVersion: "default",
+				Attr:          attr,
+			},
+		}
+	}
+
+	// the principal policy allows access to dev records but the resource policy denies access to hidden records
+	principalAllowsResourceDenies := mkInput([]string{"employee"}, map[string]*structpb.Value{
+		"dev_record":           structpb.NewBoolValue(true),
+		"owner":                structpb.NewStringValue("donald_duck"),
+		"hidden_from_employee": structpb.NewBoolValue(true),
+	})
+
+	// the principal policy denies access to records owned by mickey_mouse but the resource policy allows access to admins
+	principalDeniesResourceAllows := mkInput([]string{"admin"}, map[string]*structpb.Value{
+		"dev_record": structpb.NewBoolValue(false),
+		"owner":      structpb.NewStringValue("mickey_mouse"),
+	})
+
+	testCases := []struct {
+		algorithm  CombiningAlgorithm
+		wantFilter string
+		want       [2]effectv1.Effect
+	}{
+		{
+			algorithm:  "",
+			wantFilter: `(and (not (eq request.resource.attr.owner "mickey_mouse")) (or (eq request.resource.attr.dev_record true) (and (not request.resource.attr.hidden_from_employee) (eq request.resource.attr.owner "donald_duck"))))`,
+			want:       [2]effectv1.Effect{effectv1.Effect_EFFECT_ALLOW, effectv1.Effect_EFFECT_DENY},
+		},
+		{
+			algorithm:  CombiningFirstApplicable,
+			wantFilter: `(and (not (eq request.resource.attr.owner "mickey_mouse")) (or (eq request.resource.attr.dev_record true) (and (not request.resource.attr.hidden_from_employee) (eq request.resource.attr.owner "donald_duck"))))`,
+			want:       [2]effectv1.Effect{effectv1.Effect_EFFECT_ALLOW, effectv1.Effect_EFFECT_DENY},
+		},
+		{
+			algorithm:  CombiningDenyOverrides,
+			wantFilter: `(and (not (eq request.resource.attr.owner "mickey_mouse")) (not request.resource.attr.hidden_from_employee) (or (eq request.resource.attr.dev_record true) (eq request.resource.attr.owner "donald_duck")))`,
+			want:       [2]effectv1.Effect{effectv1.Effect_EFFECT_DENY, effectv1.Effect_EFFECT_DENY},
+		},
+		{
+			algorithm:  CombiningPermitOverrides,
+			wantFilter: `(or (and (not (eq request.resource.attr.owner "mickey_mouse")) (eq request.resource.attr.dev_record true)) (and (not request.resource.attr.hidden_from_employee) (eq request.resource.attr.owner "donald_duck")))`,
+			want:       [2]effectv1.Effect{effectv1.Effect_EFFECT_ALLOW, effectv1.Effect_EFFECT_ALLOW},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(string(tc.algorithm), func(t *testing.T) {
+			eng, cancelFunc := mkEngine(t, param{subDir: "query_planner/policies", schemaEnforcement: schema.EnforcementNone, combiningAlgorithm: tc.algorithm})
+			t.Cleanup(cancelFunc)
+
+			outputs, err := eng.Check(context.Background(), []*enginev1.CheckInput{principalAllowsResourceDenies, principalDeniesResourceAllows})
+			require.NoError(t, err)
+			require.Len(t, outputs, 2)
+
+			for i, output := range outputs {
+				require.Equal(t, tc.want[i], output.Actions["view"].Effect, "unexpected effect for input #%d", i)
+			}
+
+			plan, err := eng.PlanResources(context.Background(), &enginev1.PlanResourcesInput{
+				RequestId:   "test",
+				Action:      "view",
+				IncludeMeta: true,
+				Principal:   &enginev1.Principal{Id: "donald_duck", PolicyVersion: "default", Roles: []string{"employee"}},
+				Resource:    &enginev1.PlanResourcesInput_Resource{Kind: "leave_request", PolicyVersion: "default"},
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.wantFilter, plan.FilterDebug)
+		})
+	}
+}
+
+func TestCheckWithConditionProfiling(t *testing.T) {
+	input := &enginev1.CheckInput{
+		RequestId: "test",
+		Actions:   []string{"view", "edit"},
+		Principal: &enginev1.Principal{
+			Id:            "maria",
+			PolicyVersion: "default",
+			Roles:         []string{"user"},
+			Attr: map[string]*structpb.Value{
+				"team":      structpb.NewStringValue("news"),
+				"can_edit":  structpb.NewBoolValue(true),
+				"seniority": structpb.NewNumberValue(10),
+			},
+		},
+		Resource: &enginev1.Resource{
+			Kind:          "article",
+			Id:            "a1",
+			PolicyVersion: "default",
+			Attr: map[string]*structpb.Value{
+				"team":   structpb.NewStringValue("news"),
+				"status": structpb.NewStringValue("DRAFT"),
+			},
+		},
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		eng, cancelFunc := mkEngine(t, param{subDir: "query_planner/policies", schemaEnforcement: schema.EnforcementNone})
+		t.Cleanup(cancelFunc)
+
+		_, err := eng.Check(context.Background(), []*enginev1.CheckInput{input})
+		require.NoError(t, err)
+
+		profile, ok := eng.ConditionProfile()
+		require.False(t, ok)
+		require.Empty(t, profile)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		eng, cancelFunc := mkEngine(t, param{subDir: "query_planner/policies", schemaEnforcement: schema.EnforcementNone, profiling: true})
+		t.Cleanup(cancelFunc)
+
+		for i := 0; i < 3; i++ {
+			_, err := eng.Check(context.Background(), []*enginev1.CheckInput{input})
+			require.NoError(t, err)
+		}
+
+		profile, ok := eng.ConditionProfile()
+		require.True(t, ok)
+		require.NotEmpty(t, profile)
+
+		for i, p := range profile {
+			require.Equal(t, "resource.article.vdefault", p.Policy)
+			require.NotEmpty(t, p.Expr)
+			require.Zero(t, p.Count%3, "expression %q should be evaluated the same number of times in each request", p.Expr)
+			require.GreaterOrEqual(t, p.MaxTimeMs, p.MeanTimeMs)
+			if i > 0 {
+				require.GreaterOrEqual(t, profile[i-1].TotalTimeMs, p.TotalTimeMs)
+			}
+		}
+
+		eng.ResetConditionProfile()
+		profile, ok = eng.ConditionProfile()
+		require.True(t, ok)
+		require.Empty(t, profile)
+	})
+
+	t.Run("pinned", func(t *testing.T) {
+		eng, cancelFunc := mkEngine(t, param{subDir: "query_planner/policies", schemaEnforcement: schema.EnforcementNone, profiling: true})
+		t.Cleanup(cancelFunc)
+
+		_, err := eng.WithPoliciesFrom(eng).Check(context.Background(), []*enginev1.CheckInput{input})
+		require.NoError(t, err)
+
+		profile, ok := eng.ConditionProfile()
+		require.True(t, ok)
+		require.NotEmpty(t, profile)
+	})
+}
+
+func TestCheckWithRuleLimits(t *testing.T) {
+	eng, cancelFunc := mkEngine(t, param{subDir: "query_planner/policies", schemaEnforcement: schema.EnforcementNone})
+	defer cancelFunc()
+
+	mkInput := func(principalID string) *enginev1.CheckInput {
+		return &enginev1.CheckInput{
+			RequestId: "test",
+			Actions:   []string{"view", "download"},
+			Principal: &enginev1.Principal{
+				Id:            principalID,
+				PolicyVersion: "default",
+				Roles:         []string{"user"},
+			},
+			Resource: &enginev1.Resource{
+				Kind:          "export",
+				Id:            "e1",
+				PolicyVersion: "default",
+				Attr:          map[string]*structpb.Value{"owner": structpb.NewStringValue(principalID)},
+			},
+		}
+	}
+
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	checkWith := func(eng *Engine, principalID string, offset time.Duration, opts ...CheckOpt) *enginev1.CheckOutput {
+		t.Helper()
+
+		opts = append(opts, WithNowFunc(func() time.Time { return now.Add(offset) }))
+		outputs, err := eng.Check(context.Background(), []*enginev1.CheckInput{mkInput(principalID)}, opts...)
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, outputs[0].Actions["view"].Effect)
+		return outputs[0]
+	}
+	check := func(principalID string, offset time.Duration) *enginev1.CheckOutput {
+		t.Helper()
+		return checkWith(eng, principalID, offset)
+	}
+
+	for i := 0; i < 2; i++ {
+		have := check("harry", time.Duration(i)*time.Second)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, have.Actions["download"].Effect)
+		require.Empty(t, have.Outputs)
+	}
+
+	have := check("harry", 2*time.Second)
+	require.Equal(t, effectv1.Effect_EFFECT_DENY, have.Actions["download"].Effect)
+	require.Len(t, have.Outputs, 1)
+	require.Equal(t, "resource.export.vdefault#limited_download", have.Outputs[0].Src)
+	require.Equal(t, LimitExceededCode, have.Outputs[0].Val.GetStructValue().Fields["code"].GetStringValue())
+	require.Equal(t, "download", have.Outputs[0].Val.GetStructValue().Fields["action"].GetStringValue())
+
+	have = check("sally", 2*time.Second)
+	require.Equal(t, effectv1.Effect_EFFECT_ALLOW, have.Actions["download"].Effect, "limits should apply to each principal separately")
+
+	have = checkWith(eng.WithPolicyLoader(eng.policyLoader), "harry", 3*time.Second)
+	require.Equal(t, effectv1.Effect_EFFECT_DENY, have.Actions["download"].Effect, "limits should be enforced when evaluating request policies")
+
+	for i := 0; i < 3; i++ {
+		have = checkWith(eng, "ron", 2*time.Second, WithoutConsumingRuleLimits())
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, have.Actions["download"].Effect, "checking the limit should not consume it")
+	}
+
+	have = check("ron", 2*time.Second)
+	require.Equal(t, effectv1.Effect_EFFECT_ALLOW, have.Actions["download"].Effect)
+	have = check("ron", 2*time.Second)
+	require.Equal(t, effectv1.Effect_EFFECT_ALLOW, have.Actions["download"].Effect)
+	have = checkWith(eng, "ron", 2*time.Second, WithoutConsumingRuleLimits())
+	require.Equal(t, effectv1.Effect_EFFECT_DENY, have.Actions["download"].Effect, "exhausted limit should be enforced without consuming it")
+
+	have = check("harry", time.Minute)
+	require.Equal(t, effectv1.Effect_EFFECT_ALLOW, have.Actions["download"].Effect, "limit should be reset in the next window")
+}
+
+func TestCheckWithActivePeriod(t *testing.T) {
+	eng, cancelFunc := mkEngine(t, param{subDir: "query_planner/policies", schemaEnforcement: schema.EnforcementNone})
+	defer cancelFunc()
+
+	input := &enginev1.CheckInput{
+		RequestId: "test",
+		Actions:   []string{"view"},
+		Principal: &enginev1.Principal{
+			Id:            "responder",
+			PolicyVersion: "default",
+			Roles:         []string{"user"},
+		},
+		Resource: &enginev1.Resource{
+			Kind:          "incident",
+			Id:            "i1",
+			PolicyVersion: "default",
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		now        time.Time
+		wantEffect effectv1.Effect
+		wantPolicy string
+	}{
+		{
+			name:       "before_period",
+			now:        time.Date(2023, 5, 31, 23, 59, 59, 0, time.UTC),
+			wantEffect: effectv1.Effect_EFFECT_DENY,
+			wantPolicy: noPolicyMatch,
+		},
+		{
+			name:       "within_period",
+			now:        time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC),
+			wantEffect: effectv1.Effect_EFFECT_ALLOW,
+			wantPolicy: "resource.incident.vdefault",
+		},
+		{
+			name:       "after_period",
+			now:        time.Date(2023, 6, 2, 0, 0, 0, 0, time.UTC),
+			wantEffect: effectv1.Effect_EFFECT_DENY,
+			wantPolicy: noPolicyMatch,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			outputs, err := eng.Check(context.Background(), []*enginev1.CheckInput{input}, WithNowFunc(func() time.Time { return tc.now }))
+			require.NoError(t, err)
+			require.Len(t, outputs, 1)
+			require.Equal(t, tc.wantEffect, outputs[0].Actions["view"].Effect)
+			require.Equal(t, tc.wantPolicy, outputs[0].Actions["view"].Policy)
+		})
+	}
+}
+
 func TestSchemaValidation(t *testing.T) {
 	for _, enforcement := range []string{"warn", "reject"} {
 		enforcement := enforcement
@@ -194,7 +650,11 @@ type param struct {
 	enableAuditLog     bool
 	schemaEnforcement  schema.Enforcement
 	subDir             string
+	overrides          []*KindOverride
+	costLimits         CostLimits
+	combiningAlgorithm CombiningAlgorithm
 	lenientScopeSearch bool
+	profiling          bool
 }
 
 func mkEngine(tb testing.TB, p param) (*Engine, context.CancelFunc) {
@@ -233,6 +693,10 @@ func mkEngine(tb testing.TB, p param) (*Engine, context.CancelFunc) {
 	engineConf.SetDefaults()
 	engineConf.Globals = map[string]any{"environment": "test"}
 	engineConf.LenientScopeSearch = p.lenientScopeSearch
+	engineConf.Overrides = p.overrides
+	engineConf.CostLimits = p.costLimits
+	engineConf.CombiningAlgorithm = p.combiningAlgorithm
+	engineConf.Profiling.Enabled = p.profiling
 
 	eng := NewFromConf(ctx, engineConf, Components{
 		PolicyLoader:      compiler,
@@ -254,13 +718,41 @@ func readQPTestSuite(t *testing.T, data []byte) *privatev1.QueryPlannerTestSuite
 }
 
 func TestQueryPlan(t *testing.T) {
-	eng, cancelFunc := mkEngine(t, param{subDir: "query_planner/policies"})
-	defer cancelFunc()
+	testCases := []struct {
+		name               string
+		suiteDir           string
+		enforcement        schema.Enforcement
+		expandConditionals bool
+		simplifyFilters    bool
+	}{
+		{name: "reject", suiteDir: "query_planner/suite", enforcement: schema.EnforcementReject},
+		// filters must not be pruned using the schema if the engine still evaluates resources that fail validation
+		{name: "warn", suiteDir: "query_planner/suite_schema_warn", enforcement: schema.EnforcementWarn},
+		{name: "expand_conditionals", suiteDir: "query_planner/suite_expand_conditionals", enforcement: schema.EnforcementReject, expandConditionals: true},
+		{name: "simplify_filters", suiteDir: "query_planner/suite_simplify_filters", enforcement: schema.EnforcementReject, simplifyFilters: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			eng, cancelFunc := mkEngine(t, param{subDir: "query_planner/policies", schemaEnforcement: tc.enforcement})
+			defer cancelFunc()
+
+			runQueryPlanSuites(t, eng, tc.suiteDir, func(input *enginev1.PlanResourcesInput) {
+				input.ExpandConditionals = tc.expandConditionals
+				input.SimplifyFilters = tc.simplifyFilters
+			})
+		})
+	}
+}
+
+func runQueryPlanSuites(t *testing.T, eng *Engine, suiteDir string, setOpts func(*enginev1.PlanResourcesInput)) {
+	t.Helper()
 
 	auxData := &enginev1.AuxData{Jwt: make(map[string]*structpb.Value)}
 	auxData.Jwt["customInt"] = structpb.NewNumberValue(42)
 
-	suites := test.LoadTestCases(t, "query_planner/suite")
+	suites := test.LoadTestCases(t, suiteDir)
 	for _, suite := range suites {
 		s := suite
 		t.Run(s.Name, func(t *testing.T) {
@@ -280,6 +772,7 @@ func TestQueryPlan(t *testing.T) {
 						IncludeMeta: true,
 						AuxData:     auxData,
 					}
+					setOpts(request)
 
 					response, err := eng.PlanResources(context.Background(), request)
 					if tt.WantErr {
@@ -295,6 +788,133 @@ func TestQueryPlan(t *testing.T) {
 	}
 }
 
+func TestPlanResourcesStream(t *testing.T) {
+	eng, cancelFunc := mkEngine(t, param{subDir: "query_planner/policies"})
+	defer cancelFunc()
+
+	suites := test.LoadTestCases(t, "query_planner/suite")
+	for _, suite := range suites {
+		s := suite
+		t.Run(s.Name, func(t *testing.T) {
+			is := require.New(t)
+			ts := readQPTestSuite(t, s.Input)
+
+			var inputs []*enginev1.PlanResourcesInput
+			for _, tt := range ts.Tests {
+				inputs = append(inputs, &enginev1.PlanResourcesInput{
+					RequestId: "requestId",
+					Action:    tt.Action,
+					Principal: ts.Principal,
+					Resource: &enginev1.PlanResourcesInput_Resource{
+						Kind:          tt.Resource.Kind,
+						Attr:          tt.Resource.Attr,
+						PolicyVersion: tt.Resource.PolicyVersion,
+					},
+					AuxData: &enginev1.AuxData{Jwt: map[string]*structpb.Value{"customInt": structpb.NewNumberValue(42)}},
+				})
+			}
+
+			var have []*enginev1.PlanResourcesOutput
+			var haveErrs []error
+			is.NoError(eng.PlanResourcesStream(context.Background(), inputs, func(output *enginev1.PlanResourcesOutput, err error) error {
+				have = append(have, output)
+				haveErrs = append(haveErrs, err)
+				return nil
+			}))
+			is.Len(have, len(inputs))
+
+			for i, input := range inputs {
+				want, err := eng.PlanResources(context.Background(), input)
+				if ts.Tests[i].WantErr {
+					is.Error(err)
+					is.Error(haveErrs[i])
+					continue
+				}
+
+				is.NoError(err)
+				is.NoError(haveErrs[i])
+				is.Empty(cmp.Diff(want, have[i], protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestPlanResourcesWithoutJWT(t *testing.T) {
+	eng, cancelFunc := mkEngine(t, param{subDir: "query_planner/policies"})
+	defer cancelFunc()
+
+	is := require.New(t)
+	response, err := eng.PlanResources(context.Background(), &enginev1.PlanResourcesInput{
+		RequestId: "requestId",
+		Action:    "approve:with-jwt",
+		Principal: &enginev1.Principal{Id: "maggie", PolicyVersion: "default", Roles: []string{"manager"}},
+		Resource:  &enginev1.PlanResourcesInput_Resource{Kind: "leave_request", PolicyVersion: "default"},
+	})
+	is.NoError(err)
+
+	want := &enginev1.PlanResourcesFilter{
+		Kind: enginev1.PlanResourcesFilter_KIND_CONDITIONAL,
+		Condition: &enginev1.PlanResourcesFilter_Expression_Operand{
+			Node: &enginev1.PlanResourcesFilter_Expression_Operand_Expression{
+				Expression: &enginev1.PlanResourcesFilter_Expression{
+					Operator: "eq",
+					Operands: []*enginev1.PlanResourcesFilter_Expression_Operand{
+						{Node: &enginev1.PlanResourcesFilter_Expression_Operand_Variable{Variable: "request.resource.attr.groupID"}},
+						{Node: &enginev1.PlanResourcesFilter_Expression_Operand_Variable{Variable: "request.aux_data.jwt.customInt"}},
+					},
+				},
+			},
+		},
+	}
+	is.Empty(cmp.Diff(want, response.Filter, protocmp.Transform()), "AST: %s", response.FilterDebug)
+}
+
+func TestPlanResourcesStreamEntryErrors(t *testing.T) {
+	eng, cancelFunc := mkEngine(t, param{subDir: "query_planner/policies"})
+	defer cancelFunc()
+
+	brokenKind := "broken"
+	eng.policyLoader = failingPolicyLoader{PolicyLoader: eng.policyLoader, failOn: namer.ResourcePolicyModuleID(brokenKind, "default", "")}
+
+	principal := &enginev1.Principal{Id: "maggie", PolicyVersion: "default", Roles: []string{"manager"}}
+	inputs := []*enginev1.PlanResourcesInput{
+		{Action: "approve", Principal: principal, Resource: &enginev1.PlanResourcesInput_Resource{Kind: "leave_request", PolicyVersion: "default"}},
+		{Action: "approve", Principal: principal, Resource: &enginev1.PlanResourcesInput_Resource{Kind: brokenKind, PolicyVersion: "default"}},
+		{Action: "view", Principal: principal, Resource: &enginev1.PlanResourcesInput_Resource{Kind: "leave_request", PolicyVersion: "default"}},
+	}
+
+	var haveErrs []error
+	require.NoError(t, eng.PlanResourcesStream(context.Background(), inputs, func(output *enginev1.PlanResourcesOutput, err error) error {
+		if err == nil {
+			require.NotNil(t, output)
+		}
+		haveErrs = append(haveErrs, err)
+		return nil
+	}))
+
+	require.Len(t, haveErrs, len(inputs))
+	require.NoError(t, haveErrs[0])
+	require.ErrorIs(t, haveErrs[1], errPolicyLoaderFailure)
+	require.NoError(t, haveErrs[2])
+}
+
+var errPolicyLoaderFailure = errors.New("policy loader failure")
+
+type failingPolicyLoader struct {
+	PolicyLoader
+	failOn namer.ModuleID
+}
+
+func (f failingPolicyLoader) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID) (*runtimev1.RunnablePolicySet, error) {
+	for _, c := range candidates {
+		if c == f.failOn {
+			return nil, errPolicyLoaderFailure
+		}
+	}
+
+	return f.PolicyLoader.GetFirstMatch(ctx, candidates)
+}
+
 type testTraceSink struct {
 	t *testing.T
 }
@@ -313,3 +933,99 @@ func (s *testTraceSink) AddTrace(trace *enginev1.Trace) {
 	printer.New(&stdout, io.Discard).PrintTrace(trace)
 	s.t.Logf("%s\n", stdout.String())
 }
+
+func TestPlanResourcesRuleResiduals(t *testing.T) {
+	eng, cancelFunc := mkEngine(t, param{subDir: "query_planner/policies"})
+	defer cancelFunc()
+
+	type residual struct {
+		policy      string
+		rule        string
+		effect      effectv1.Effect
+		filterDebug string
+	}
+
+	testCases := []struct {
+		name      string
+		principal string
+		want      []residual
+	}{
+		{
+			name:      "resource_policy_only",
+			principal: "maggie",
+			want: []residual{
+				{
+					policy:      "resource.leave_request.vdefault",
+					rule:        "rule-007",
+					effect:      effectv1.Effect_EFFECT_DENY,
+					filterDebug: `(eq request.resource.attr.owner "maggie")`,
+				},
+				{
+					policy:      "resource.leave_request.vdefault",
+					rule:        "rule-008",
+					effect:      effectv1.Effect_EFFECT_ALLOW,
+					filterDebug: `(eq request.resource.attr.status "PENDING_APPROVAL")`,
+				},
+			},
+		},
+		{
+			name:      "principal_and_resource_policy",
+			principal: "donald_duck",
+			want: []residual{
+				{
+					policy:      "principal.donald_duck.vdefault",
+					rule:        "dev_admin",
+					effect:      effectv1.Effect_EFFECT_ALLOW,
+					filterDebug: "(eq request.resource.attr.dev_record true)",
+				},
+				{
+					policy:      "principal.donald_duck.vdefault",
+					rule:        "leave_request_rule-002",
+					effect:      effectv1.Effect_EFFECT_DENY,
+					filterDebug: `(eq request.resource.attr.owner "mickey_mouse")`,
+				},
+				{
+					policy:      "resource.leave_request.vdefault",
+					rule:        "rule-007",
+					effect:      effectv1.Effect_EFFECT_DENY,
+					filterDebug: `(eq request.resource.attr.owner "donald_duck")`,
+				},
+				{
+					policy:      "resource.leave_request.vdefault",
+					rule:        "rule-008",
+					effect:      effectv1.Effect_EFFECT_ALLOW,
+					filterDebug: `(eq request.resource.attr.status "PENDING_APPROVAL")`,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			input := &enginev1.PlanResourcesInput{
+				RequestId:            "requestId",
+				Action:               "approve:allow-deny",
+				Principal:            &enginev1.Principal{Id: tc.principal, PolicyVersion: "default", Roles: []string{"manager"}},
+				Resource:             &enginev1.PlanResourcesInput_Resource{Kind: "leave_request", PolicyVersion: "default"},
+				IncludeMeta:          true,
+				IncludeRuleResiduals: true,
+			}
+
+			have, err := eng.PlanResources(context.Background(), input)
+			require.NoError(t, err)
+
+			haveResiduals := make([]residual, len(have.RuleResiduals))
+			for i, rr := range have.RuleResiduals {
+				require.Equal(t, enginev1.PlanResourcesFilter_KIND_CONDITIONAL, rr.Filter.Kind)
+				haveResiduals[i] = residual{policy: rr.Policy, rule: rr.Rule, effect: rr.Effect, filterDebug: rr.FilterDebug}
+			}
+			require.Equal(t, tc.want, haveResiduals)
+
+			input.IncludeRuleResiduals = false
+			have, err = eng.PlanResources(context.Background(), input)
+			require.NoError(t, err)
+			require.Empty(t, have.RuleResiduals)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
 	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
 	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
 	"github.com/cerbos/cerbos/internal/conditions"
@@ -19,9 +20,9 @@ import (
 	"github.com/cerbos/cerbos/internal/engine/tracer"
 	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/observability/tracing"
+	"github.com/cerbos/cerbos/internal/quota"
 	"github.com/cerbos/cerbos/internal/schema"
 	"github.com/cerbos/cerbos/internal/util"
-	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/common/types/ref"
 	"go.uber.org/multierr"
 	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
@@ -30,9 +31,23 @@ import (
 
 var ErrPolicyNotExecutable = errors.New("policy not executable")
 
+// LimitExceededCode is the code included in the output produced when a rule is denied because its limit was exceeded.
+const LimitExceededCode = "LIMIT_EXCEEDED"
+
 type evalParams struct {
-	globals map[string]any
-	nowFunc func() time.Time
+	globals    map[string]any
+	nowFunc    func() time.Time
+	costBudget *costBudget
+	memo       *conditionMemo
+	quotaStore quota.Store
+	profiler   *conditionProfiler
+	// cacheability is set when the decision could be cached. It records whether the evaluation did anything that
+	// prevents the decision from being cached.
+	cacheability *cacheability
+	// peekLimits is true if rule limits are checked without being consumed.
+	peekLimits bool
+	// policyKey identifies the policy being evaluated. It is used to attribute evaluation statistics.
+	policyKey string
 }
 
 func defaultEvalParams(globals map[string]any) evalParams {
@@ -49,8 +64,10 @@ type Evaluator interface {
 func NewEvaluator(rps *runtimev1.RunnablePolicySet, schemaMgr schema.Manager, eparams evalParams) Evaluator {
 	switch rp := rps.PolicySet.(type) {
 	case *runtimev1.RunnablePolicySet_ResourcePolicy:
+		eparams.policyKey = namer.PolicyKeyFromFQN(rp.ResourcePolicy.GetMeta().GetFqn())
 		return &resourcePolicyEvaluator{policy: rp.ResourcePolicy, schemaMgr: schemaMgr, evalParams: eparams}
 	case *runtimev1.RunnablePolicySet_PrincipalPolicy:
+		eparams.policyKey = namer.PolicyKeyFromFQN(rp.PrincipalPolicy.GetMeta().GetFqn())
 		return &principalPolicyEvaluator{policy: rp.PrincipalPolicy, evalParams: eparams}
 	default:
 		return noopEvaluator{}
@@ -106,6 +123,8 @@ func (rpe *resourcePolicyEvaluator) Evaluate(ctx context.Context, tctx tracer.Co
 	}
 
 	// evaluate policies in the set
+	now := rpe.evalParams.nowFunc()
+	hasActivePolicy := false
 	for _, p := range rpe.policy.Policies {
 		// Get the actions that are yet to be resolved. This is to implement first-match-wins semantics.
 		// Within the context of a single policy, later rules can potentially override the result for an action (unless it was DENY).
@@ -115,6 +134,15 @@ func (rpe *resourcePolicyEvaluator) Evaluate(ctx context.Context, tctx tracer.Co
 		}
 
 		sctx := pctx.StartScope(p.Scope)
+		if p.ActiveFrom != nil || p.ActiveUntil != nil {
+			rpe.evalParams.cacheability.markUncacheable()
+		}
+
+		if !internal.IsActive(p.ActiveFrom, p.ActiveUntil, now) {
+			sctx.Skipped(nil, "Policy is not active")
+			continue
+		}
+		hasActivePolicy = true
 
 		// evaluate the variables of this policy
 		variables, err := rpe.evalParams.evaluateVariables(sctx.StartVariables(), p.Variables, input)
@@ -125,35 +153,61 @@ func (rpe *resourcePolicyEvaluator) Evaluate(ctx context.Context, tctx tracer.Co
 
 		// calculate the set of effective derived roles
 		effectiveDerivedRoles := internal.StringSet{}
-		for drName, dr := range p.DerivedRoles {
+		evaluatedDerivedRoles := make(map[string]bool, len(p.DerivedRoles))
+
+		var activateDerivedRole func(string, *runtimev1.RunnableDerivedRole) bool
+		activateDerivedRole = func(drName string, dr *runtimev1.RunnableDerivedRole) bool {
+			if active, ok := evaluatedDerivedRoles[drName]; ok {
+				return active
+			}
+			// cycles are rejected by the compiler but mark the role as evaluated up front to be safe
+			evaluatedDerivedRoles[drName] = false
+
+			hasParentRole := internal.SetIntersects(dr.ParentRoles, effectiveRoles)
+			for pdrName := range dr.ParentDerivedRoles {
+				if hasParentRole {
+					break
+				}
+
+				if pdr, ok := p.DerivedRoles[pdrName]; ok {
+					hasParentRole = activateDerivedRole(pdrName, pdr)
+				}
+			}
+
 			dctx := sctx.StartDerivedRole(drName)
-			if !internal.SetIntersects(dr.ParentRoles, effectiveRoles) {
+			if !hasParentRole {
 				dctx.Skipped(nil, "No matching roles")
-				continue
+				return false
 			}
 
 			// evaluate variables of this derived roles set
 			drVariables, err := rpe.evalParams.evaluateVariables(dctx.StartVariables(), dr.Variables, input)
 			if err != nil {
 				dctx.Skipped(err, "Error evaluating variables")
-				continue
+				return false
 			}
 
 			ok, err := rpe.evalParams.satisfiesCondition(dctx.StartCondition(), dr.Condition, drVariables, input)
 			if err != nil {
 				dctx.Skipped(err, "Error evaluating condition")
-				continue
+				return false
 			}
 
 			if !ok {
 				dctx.Skipped(nil, "Condition not satisfied")
-				continue
+				return false
 			}
 
 			effectiveDerivedRoles[drName] = struct{}{}
 			result.EffectiveDerivedRoles[drName] = struct{}{}
+			evaluatedDerivedRoles[drName] = true
 
 			dctx.Activated()
+			return true
+		}
+
+		for drName, dr := range p.DerivedRoles {
+			activateDerivedRole(drName, dr)
 		}
 
 		// evaluate each rule until all actions have a result
@@ -181,6 +235,16 @@ func (rpe *resourcePolicyEvaluator) Evaluate(ctx context.Context, tctx tracer.Co
 						continue
 					}
 
+					if rule.Limit != nil {
+						ruleFQN := namer.RuleFQN(rpe.policy.Meta, p.Scope, rule.Name)
+						if !rpe.evalParams.withinLimit(ruleFQN, input.Principal.Id, rule.Limit) {
+							result.setEffect(action, EffectInfo{Effect: effectv1.Effect_EFFECT_DENY, Policy: policyKey, Scope: p.Scope})
+							actx.AppliedEffect(effectv1.Effect_EFFECT_DENY, "Limit exceeded")
+							result.Outputs = append(result.Outputs, limitExceededOutput(ruleFQN, action))
+							continue
+						}
+					}
+
 					result.setEffect(action, EffectInfo{Effect: rule.Effect, Policy: policyKey, Scope: p.Scope})
 					actx.AppliedEffect(rule.Effect, "")
 					ruleActivated = true
@@ -203,6 +267,12 @@ func (rpe *resourcePolicyEvaluator) Evaluate(ctx context.Context, tctx tracer.Co
 	}
 
 	// set the default effect for actions that were not matched
+	if !hasActivePolicy {
+		// none of the policies in the set are in effect, so the outcome is the same as not having a policy at all
+		result.setDefaultEffect(pctx, EffectInfo{Effect: effectv1.Effect_EFFECT_DENY, Policy: noPolicyMatch})
+		return result, nil
+	}
+
 	result.setDefaultEffect(pctx, EffectInfo{Effect: effectv1.Effect_EFFECT_DENY, Policy: policyKey})
 
 	return result, nil
@@ -222,6 +292,7 @@ func (ppe *principalPolicyEvaluator) Evaluate(ctx context.Context, tctx tracer.C
 	result := newEvalResult(input.Actions)
 
 	pctx := tctx.StartPolicy(ppe.policy.Meta.Fqn)
+	now := ppe.evalParams.nowFunc()
 	for _, p := range ppe.policy.Policies {
 		actionsToResolve := result.unresolvedActions()
 		if len(actionsToResolve) == 0 {
@@ -229,6 +300,14 @@ func (ppe *principalPolicyEvaluator) Evaluate(ctx context.Context, tctx tracer.C
 		}
 
 		sctx := pctx.StartScope(p.Scope)
+		if p.ActiveFrom != nil || p.ActiveUntil != nil {
+			ppe.evalParams.cacheability.markUncacheable()
+		}
+
+		if !internal.IsActive(p.ActiveFrom, p.ActiveUntil, now) {
+			sctx.Skipped(nil, "Policy is not active")
+			continue
+		}
 		// evaluate the variables of this policy
 		variables, err := ppe.evalParams.evaluateVariables(sctx.StartVariables(), p.Variables, input)
 		if err != nil {
@@ -284,12 +363,41 @@ func (ppe *principalPolicyEvaluator) Evaluate(ctx context.Context, tctx tracer.C
 	return result, nil
 }
 
+// withinLimit consumes one allowed decision from the limit of the rule for the principal, unless limits are only peeked at.
+// It returns false if the principal has already reached the limit in the current window.
+func (ep evalParams) withinLimit(ruleFQN, principalID string, limit *policyv1.Limit) bool {
+	if ep.quotaStore == nil {
+		return true
+	}
+
+	ep.cacheability.markUncacheable()
+
+	key := ruleFQN + "\x00" + principalID
+	if ep.peekLimits {
+		return ep.quotaStore.Available(key, limit.MaxAllowed, ep.nowFunc())
+	}
+
+	return ep.quotaStore.Take(key, limit.MaxAllowed, limit.Window.AsDuration(), ep.nowFunc())
+}
+
+func limitExceededOutput(ruleFQN, action string) *enginev1.OutputEntry {
+	return &enginev1.OutputEntry{
+		Src: ruleFQN,
+		Val: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+			"code":   structpb.NewStringValue(LimitExceededCode),
+			"action": structpb.NewStringValue(action),
+		}}),
+	}
+}
+
 func (ep evalParams) evaluateVariables(tctx tracer.Context, variables map[string]*runtimev1.Expr, input *enginev1.CheckInput) (map[string]any, error) {
 	var errs error
 	evalVars := make(map[string]any, len(variables))
 	for varName, varExpr := range variables {
 		vctx := tctx.StartVariable(varName, varExpr.Original)
+		start := ep.profiler.start()
 		val, err := ep.evaluateCELExprToRaw(varExpr.Checked, evalVars, input)
+		ep.profiler.record(ep.policyKey, varExpr.Original, start, err)
 		if err != nil {
 			vctx.Skipped(err, "Failed to evaluate expression")
 			errs = multierr.Append(errs, fmt.Errorf("error evaluating `%s := %s`: %w", varName, varExpr.Original, err))
@@ -312,7 +420,9 @@ func (ep evalParams) satisfiesCondition(tctx tracer.Context, cond *runtimev1.Con
 	switch t := cond.Op.(type) {
 	case *runtimev1.Condition_Expr:
 		ectx := tctx.StartExpr(t.Expr.Original)
+		start := ep.profiler.start()
 		val, err := ep.evaluateBoolCELExpr(t.Expr.Checked, variables, input)
+		ep.profiler.record(ep.policyKey, t.Expr.Original, start, err)
 		if err != nil {
 			ectx.ComputedBoolResult(false, err, "Failed to evaluate expression")
 			return false, fmt.Errorf("failed to evaluate `%s`: %w", t.Expr.Original, err)
@@ -424,12 +534,41 @@ func (ep evalParams) evaluateProtobufValueCELExpr(expr *exprpb.CheckedExpr, vari
 	return pbVal
 }
 
+// evaluateCELExpr evaluates the expression, reusing the result of an earlier evaluation in the same request if the expression only depends on the principal.
 func (ep evalParams) evaluateCELExpr(expr *exprpb.CheckedExpr, variables map[string]any, input *enginev1.CheckInput) (ref.Val, error) {
 	if expr == nil {
 		return nil, nil
 	}
 
-	result, _, err := conditions.Eval(conditions.StdEnv, cel.CheckedExprToAst(expr), map[string]any{
+	ep.cacheability.checkExpr(expr)
+
+	key, memoizable := ep.memo.key(expr, input.Principal)
+	if memoizable {
+		if result, ok := ep.memo.get(key); ok {
+			return result, nil
+		}
+	}
+
+	result, err := ep.evalCELExpr(expr, variables, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if memoizable {
+		ep.memo.set(key, result)
+	}
+
+	return result, nil
+}
+
+func (ep evalParams) evalCELExpr(expr *exprpb.CheckedExpr, variables map[string]any, input *enginev1.CheckInput) (ref.Val, error) {
+	// stop evaluating expressions once a cost limit has been exceeded because the request is going to fail anyway
+	if err := ep.costBudget.err(); err != nil {
+		return nil, err
+	}
+
+	opts, perRequestLimit := ep.costBudget.programOptions()
+	result, details, err := conditions.EvalChecked(expr, map[string]any{
 		conditions.CELRequestIdent:    input,
 		conditions.CELResourceAbbrev:  input.Resource,
 		conditions.CELPrincipalAbbrev: input.Principal,
@@ -437,8 +576,13 @@ func (ep evalParams) evaluateCELExpr(expr *exprpb.CheckedExpr, variables map[str
 		conditions.CELVariablesAbbrev: variables,
 		conditions.CELGlobalsIdent:    ep.globals,
 		conditions.CELGlobalsAbbrev:   ep.globals,
-	}, ep.nowFunc)
+	}, ep.nowFunc, opts...)
+	ep.costBudget.spend(details)
 	if err != nil {
+		if errors.Is(err, conditions.ErrCostLimitExceeded) {
+			return nil, ep.costBudget.setExceeded(perRequestLimit)
+		}
+
 		// ignore expressions that access non-existent keys
 		noSuchKey := &conditions.NoSuchKeyError{}
 		if errors.As(err, &noSuchKey) {
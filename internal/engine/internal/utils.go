@@ -4,8 +4,11 @@
 package internal
 
 import (
+	"time"
+
 	"github.com/cerbos/cerbos/internal/compile"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ProtoSet map[string]*emptypb.Empty
@@ -34,3 +37,13 @@ func SetIntersects(s1 ProtoSet, s2 StringSet) bool {
 
 	return false
 }
+
+// IsActive returns true if now is within the period during which a policy is in effect.
+// The period includes its start and excludes its end. A nil bound leaves that side of the period open.
+func IsActive(activeFrom, activeUntil *timestamppb.Timestamp, now time.Time) bool {
+	if activeFrom != nil && now.Before(activeFrom.AsTime()) {
+		return false
+	}
+
+	return activeUntil == nil || now.Before(activeUntil.AsTime())
+}
@@ -8,8 +8,10 @@ package internal
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestSetIntersects(t *testing.T) {
@@ -55,3 +57,72 @@ func TestSetIntersects(t *testing.T) {
 		})
 	}
 }
+
+func TestIsActive(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2023, 7, 2, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name  string
+		from  *timestamppb.Timestamp
+		until *timestamppb.Timestamp
+		now   time.Time
+		want  bool
+	}{
+		{
+			name: "no_bounds",
+			now:  from,
+			want: true,
+		},
+		{
+			name: "before_start",
+			from: timestamppb.New(from),
+			now:  from.Add(-time.Second),
+			want: false,
+		},
+		{
+			name: "at_start",
+			from: timestamppb.New(from),
+			now:  from,
+			want: true,
+		},
+		{
+			name:  "before_end",
+			until: timestamppb.New(until),
+			now:   until.Add(-time.Second),
+			want:  true,
+		},
+		{
+			name:  "at_end",
+			until: timestamppb.New(until),
+			now:   until,
+			want:  false,
+		},
+		{
+			name:  "within_period",
+			from:  timestamppb.New(from),
+			until: timestamppb.New(until),
+			now:   from.Add(time.Hour),
+			want:  true,
+		},
+		{
+			name:  "after_period",
+			from:  timestamppb.New(from),
+			until: timestamppb.New(until),
+			now:   until.Add(time.Hour),
+			want:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			have := IsActive(tc.from, tc.until, tc.now)
+			require.Equal(t, tc.want, have)
+		})
+	}
+}
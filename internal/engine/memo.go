@@ -0,0 +1,146 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"sync"
+
+	"github.com/google/cel-go/common/types/ref"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/conditions"
+)
+
+// conditionMemo caches the results of expressions that only depend on the principal and the globals.
+// Such expressions (typically derived role conditions) produce the same result for every resource in a batch request
+// so they only need to be evaluated once per request. It is safe for concurrent use by the workers evaluating the inputs.
+type conditionMemo struct {
+	results    map[memoKey]ref.Val
+	memoizable map[*exprpb.CheckedExpr]bool
+	mu         sync.RWMutex
+}
+
+// memoKey identifies an expression evaluated for a principal.
+// Compiled policies and the principal are shared by all the inputs of a request so pointer identity is sufficient.
+type memoKey struct {
+	expr      *exprpb.CheckedExpr
+	principal *enginev1.Principal
+}
+
+func newConditionMemo() *conditionMemo {
+	return &conditionMemo{
+		results:    make(map[memoKey]ref.Val),
+		memoizable: make(map[*exprpb.CheckedExpr]bool),
+	}
+}
+
+// key returns the key to cache the result of the expression under or false if the result of the expression can't be cached.
+func (m *conditionMemo) key(expr *exprpb.CheckedExpr, principal *enginev1.Principal) (memoKey, bool) {
+	if m == nil {
+		return memoKey{}, false
+	}
+
+	m.mu.RLock()
+	ok, analysed := m.memoizable[expr]
+	m.mu.RUnlock()
+
+	if !analysed {
+		ok = dependsOnlyOnPrincipal(expr.Expr, nil)
+		m.mu.Lock()
+		m.memoizable[expr] = ok
+		m.mu.Unlock()
+	}
+
+	return memoKey{expr: expr, principal: principal}, ok
+}
+
+func (m *conditionMemo) get(key memoKey) (ref.Val, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	val, ok := m.results[key]
+	return val, ok
+}
+
+func (m *conditionMemo) set(key memoKey, val ref.Val) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.results[key] = val
+}
+
+// dependsOnlyOnPrincipal returns true if the expression doesn't reference anything other than the principal, the globals,
+// the constants and the variables introduced by comprehensions. locals is the set of comprehension variables in scope.
+func dependsOnlyOnPrincipal(expr *exprpb.Expr, locals map[string]struct{}) bool {
+	switch e := expr.GetExprKind().(type) {
+	case *exprpb.Expr_ConstExpr:
+		return true
+
+	case *exprpb.Expr_IdentExpr:
+		switch name := e.IdentExpr.Name; name {
+		case conditions.CELPrincipalAbbrev, conditions.CELGlobalsIdent, conditions.CELGlobalsAbbrev,
+			conditions.CELConstantsIdent, conditions.CELConstantsAbbrev:
+			return true
+		default:
+			_, ok := locals[name]
+			return ok
+		}
+
+	case *exprpb.Expr_SelectExpr:
+		if ident := e.SelectExpr.Operand.GetIdentExpr(); ident != nil && ident.Name == conditions.CELRequestIdent {
+			return e.SelectExpr.Field == conditions.CELPrincipalField
+		}
+		return dependsOnlyOnPrincipal(e.SelectExpr.Operand, locals)
+
+	case *exprpb.Expr_CallExpr:
+		if e.CallExpr.Target != nil && !dependsOnlyOnPrincipal(e.CallExpr.Target, locals) {
+			return false
+		}
+		return allDependOnlyOnPrincipal(e.CallExpr.Args, locals)
+
+	case *exprpb.Expr_ListExpr:
+		return allDependOnlyOnPrincipal(e.ListExpr.Elements, locals)
+
+	case *exprpb.Expr_StructExpr:
+		for _, entry := range e.StructExpr.Entries {
+			if k := entry.GetMapKey(); k != nil && !dependsOnlyOnPrincipal(k, locals) {
+				return false
+			}
+
+			if !dependsOnlyOnPrincipal(entry.Value, locals) {
+				return false
+			}
+		}
+		return true
+
+	case *exprpb.Expr_ComprehensionExpr:
+		c := e.ComprehensionExpr
+		if !dependsOnlyOnPrincipal(c.IterRange, locals) || !dependsOnlyOnPrincipal(c.AccuInit, locals) {
+			return false
+		}
+
+		inner := make(map[string]struct{}, len(locals)+2) //nolint:gomnd
+		for name := range locals {
+			inner[name] = struct{}{}
+		}
+		inner[c.IterVar] = struct{}{}
+		inner[c.AccuVar] = struct{}{}
+
+		return allDependOnlyOnPrincipal([]*exprpb.Expr{c.LoopCondition, c.LoopStep, c.Result}, inner)
+
+	default:
+		return false
+	}
+}
+
+func allDependOnlyOnPrincipal(exprs []*exprpb.Expr, locals map[string]struct{}) bool {
+	for _, e := range exprs {
+		if !dependsOnlyOnPrincipal(e, locals) {
+			return false
+		}
+	}
+
+	return true
+}
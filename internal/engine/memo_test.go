@@ -0,0 +1,75 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/conditions"
+)
+
+func TestConditionMemo(t *testing.T) {
+	testCases := []struct {
+		expr       string
+		memoizable bool
+	}{
+		{expr: `P.attr.department == "marketing"`, memoizable: true},
+		{expr: `request.principal.attr.department == "marketing"`, memoizable: true},
+		{expr: `"admin" in P.roles && G.environment == "test"`, memoizable: true},
+		{expr: `P.attr.teams.exists(t, t.startsWith("news"))`, memoizable: true},
+		{expr: `P.attr.teams.all(t, P.attr.teams.exists(u, u == t))`, memoizable: true},
+		{expr: `{"a": P.id}.a == "maria"`, memoizable: true},
+		{expr: `R.attr.owner == P.id`, memoizable: false},
+		{expr: `request.resource.attr.owner == "maria"`, memoizable: false},
+		{expr: `request.aux_data.jwt.sub == "maria"`, memoizable: false},
+		{expr: `V.is_owner`, memoizable: false},
+		{expr: `P.attr.teams.exists(t, t == R.attr.team)`, memoizable: false},
+	}
+
+	principal := &enginev1.Principal{
+		Id:    "maria",
+		Roles: []string{"admin"},
+		Attr: map[string]*structpb.Value{
+			"department": structpb.NewStringValue("marketing"),
+			"teams":      structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("news")}}),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.expr, func(t *testing.T) {
+			ast, issues := conditions.StdEnv.Compile(tc.expr)
+			require.NoError(t, issues.Err())
+
+			expr, err := cel.AstToCheckedExpr(ast)
+			require.NoError(t, err)
+
+			memo := newConditionMemo()
+			key, ok := memo.key(expr, principal)
+			require.Equal(t, tc.memoizable, ok)
+			if !ok {
+				return
+			}
+
+			_, found := memo.get(key)
+			require.False(t, found)
+
+			memo.set(key, types.True)
+			have, found := memo.get(key)
+			require.True(t, found)
+			require.Equal(t, types.True, have)
+
+			otherKey, ok := memo.key(expr, &enginev1.Principal{Id: "maria"})
+			require.True(t, ok)
+			_, found = memo.get(otherKey)
+			require.False(t, found)
+		})
+	}
+}
@@ -19,7 +19,7 @@ const (
 	statusSuccess = "success"
 )
 
-func measureCheckLatency(batchSize int, checkFn func() ([]*enginev1.CheckOutput, error)) ([]*enginev1.CheckOutput, error) {
+func measureCheckLatency(ctx context.Context, batchSize int, checkFn func() ([]*enginev1.CheckOutput, error)) ([]*enginev1.CheckOutput, error) {
 	startTime := time.Now()
 	result, err := checkFn()
 
@@ -30,7 +30,7 @@ func measureCheckLatency(batchSize int, checkFn func() ([]*enginev1.CheckOutput,
 		status = statusFailure
 	}
 
-	_ = stats.RecordWithTags(context.Background(),
+	_ = stats.RecordWithTags(ctx,
 		[]tag.Mutator{
 			tag.Upsert(metrics.KeyEngineDecisionStatus, status),
 		},
@@ -41,7 +41,7 @@ func measureCheckLatency(batchSize int, checkFn func() ([]*enginev1.CheckOutput,
 	return result, err
 }
 
-func measurePlanLatency(planFn func() (*enginev1.PlanResourcesOutput, error)) (*enginev1.PlanResourcesOutput, error) {
+func measurePlanLatency(ctx context.Context, planFn func() (*enginev1.PlanResourcesOutput, error)) (*enginev1.PlanResourcesOutput, error) {
 	startTime := time.Now()
 	result, err := planFn()
 
@@ -52,7 +52,7 @@ func measurePlanLatency(planFn func() (*enginev1.PlanResourcesOutput, error)) (*
 		status = statusFailure
 	}
 
-	_ = stats.RecordWithTags(context.Background(),
+	_ = stats.RecordWithTags(ctx,
 		[]tag.Mutator{
 			tag.Upsert(metrics.KeyEnginePlanStatus, status),
 		},
@@ -516,7 +516,7 @@ func visitConst(c *exprpb.Constant) (*structpb.Value, error) {
 	}
 }
 
-func toFilter(plan *enginev1.PlanResourcesAst_Node) (*enginev1.PlanResourcesFilter, error) {
+func toFilter(plan *enginev1.PlanResourcesAst_Node, expandConds bool) (*enginev1.PlanResourcesFilter, error) {
 	filter := &enginev1.PlanResourcesFilter{
 		Kind:      enginev1.PlanResourcesFilter_KIND_CONDITIONAL,
 		Condition: new(enginev1.PlanResourcesFilter_Expression_Operand),
@@ -526,6 +526,10 @@ func toFilter(plan *enginev1.PlanResourcesAst_Node) (*enginev1.PlanResourcesFilt
 		return nil, err
 	}
 
+	if expandConds {
+		filter.Condition = expandConditionals(filter.Condition)
+	}
+
 	return normaliseFilter(filter), nil
 }
 
@@ -683,9 +687,49 @@ func normaliseFilterExprOpExpr(expr *enginev1.PlanResourcesFilter_Expression_Ope
 	}
 
 	expr.Expression.Operands = operands
+	orderAttrComparison(expr.Expression)
 	return &enginev1.PlanResourcesFilter_Expression_Operand{Node: expr}
 }
 
+// orderAttrComparison puts the operands of a comparison of two resource attributes in the order of their names, so that
+// adapters receive the same expression regardless of how the comparison is written in the condition.
+// For example, both `R.attr.spent <= R.attr.budget` and `R.attr.budget >= R.attr.spent` become `budget >= spent`.
+func orderAttrComparison(expr *enginev1.PlanResourcesFilter_Expression) {
+	switch expr.Operator {
+	case Equals, NotEquals, GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual:
+	default:
+		return
+	}
+
+	if len(expr.Operands) != 2 { //nolint:gomnd
+		return
+	}
+
+	lhs, rhs := expr.Operands[0].GetVariable(), expr.Operands[1].GetVariable()
+	if !strings.HasPrefix(lhs, resourceAttrPrefix) || !strings.HasPrefix(rhs, resourceAttrPrefix) || lhs <= rhs {
+		return
+	}
+
+	expr.Operator = mirrorComparison(expr.Operator)
+	expr.Operands[0], expr.Operands[1] = expr.Operands[1], expr.Operands[0]
+}
+
+// mirrorComparison returns the operator that produces the same result when the operands are swapped.
+func mirrorComparison(operator string) string {
+	switch operator {
+	case GreaterThan:
+		return LessThan
+	case GreaterThanOrEqual:
+		return LessThanOrEqual
+	case LessThan:
+		return GreaterThan
+	case LessThanOrEqual:
+		return GreaterThanOrEqual
+	default:
+		return operator
+	}
+}
+
 // normaliseInExpr normalises an IN expression in place.
 // If the return value is nil, then the expression can be simplified further by other normalisers.
 func normaliseInExpr(expr *enginev1.PlanResourcesFilter_Expression_Operand_Expression) *enginev1.PlanResourcesFilter_Expression_Operand {
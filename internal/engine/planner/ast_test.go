@@ -67,3 +67,52 @@ func Test_buildExpr(t *testing.T) {
 		})
 	}
 }
+
+func Test_expandConditionals(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{
+			expr: `R.attr.public ? R.attr.published : R.attr.owner == "harry"`,
+			want: `(or (and request.resource.attr.public request.resource.attr.published) (and (not request.resource.attr.public) (eq request.resource.attr.owner "harry")))`,
+		},
+		{
+			expr: `(R.attr.public ? R.attr.owner : R.attr.delegate) == "harry"`,
+			want: `(or (and request.resource.attr.public (eq request.resource.attr.owner "harry")) (and (not request.resource.attr.public) (eq request.resource.attr.delegate "harry")))`,
+		},
+		{
+			expr: `R.attr.level > (R.attr.public ? 1 : 5)`,
+			want: `(or (and request.resource.attr.public (gt request.resource.attr.level 1)) (and (not request.resource.attr.public) (gt request.resource.attr.level 5)))`,
+		},
+		{
+			expr: `R.attr.public ? true : false`,
+			want: `request.resource.attr.public`,
+		},
+		{
+			expr: `R.attr.archived || (R.attr.public ? R.attr.published : false)`,
+			want: `(or request.resource.attr.archived (and request.resource.attr.public request.resource.attr.published))`,
+		},
+		{
+			expr: `(R.attr.public ? R.attr.owner : R.attr.delegate) + "_suffix" == "harry_suffix"`,
+			want: `(eq (add (_?_:_ request.resource.attr.public request.resource.attr.owner request.resource.attr.delegate) "_suffix") "harry_suffix")`,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.expr, func(t *testing.T) {
+			ast, iss := conditions.StdEnv.Parse(tc.expr)
+			require.Nil(t, iss, iss.Err())
+
+			filter := &enginev1.PlanResourcesFilter{
+				Kind:      enginev1.PlanResourcesFilter_KIND_CONDITIONAL,
+				Condition: new(exOp),
+			}
+			require.NoError(t, buildExpr(ast.Expr(), filter.Condition))
+
+			filter.Condition = expandConditionals(filter.Condition)
+			require.Equal(t, tc.want, filterToString(normaliseFilter(filter)))
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package planner
+
+import (
+	"github.com/google/cel-go/common/operators"
+	"google.golang.org/protobuf/proto"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+)
+
+const conditionalNumOperands = 3
+
+// expandConditionals rewrites conditional (ternary) expressions into logical operations that can be translated by adapters.
+// A conditional `c ? a : b` in a boolean position is replaced with `(c && a) || (!c && b)`.
+// Comparisons that have a conditional operand are distributed over its branches first.
+// E.g. `(c ? a : b) == x` is replaced with `(c && a == x) || (!c && b == x)`.
+func expandConditionals(cond *enginev1.PlanResourcesFilter_Expression_Operand) *enginev1.PlanResourcesFilter_Expression_Operand {
+	return expandConditionalsImpl(cond, true)
+}
+
+func expandConditionalsImpl(cond *enginev1.PlanResourcesFilter_Expression_Operand, boolCtx bool) *enginev1.PlanResourcesFilter_Expression_Operand {
+	type ExprOp = enginev1.PlanResourcesFilter_Expression_Operand
+
+	expr := cond.GetExpression()
+	if expr == nil {
+		return cond
+	}
+
+	operands := make([]*ExprOp, len(expr.Operands))
+	for i, op := range expr.Operands {
+		operands[i] = expandConditionalsImpl(op, isOperandInBoolCtx(expr.Operator, i, boolCtx))
+	}
+
+	if isComparisonOperator(expr.Operator) {
+		for i, op := range operands {
+			c := asConditional(op)
+			if c == nil {
+				continue
+			}
+
+			whenTrue := cloneOperands(operands)
+			whenTrue[i] = c.Operands[1]
+			whenFalse := cloneOperands(operands)
+			whenFalse[i] = c.Operands[2]
+
+			distributed := &ExprOp{Node: mkExprOpExpr(operators.Conditional,
+				c.Operands[0],
+				&ExprOp{Node: mkExprOpExpr(expr.Operator, whenTrue...)},
+				&ExprOp{Node: mkExprOpExpr(expr.Operator, whenFalse...)},
+			)}
+
+			return expandConditionalsImpl(distributed, boolCtx)
+		}
+	}
+
+	if boolCtx && expr.Operator == operators.Conditional && len(operands) == conditionalNumOperands {
+		//nolint:forcetypeassert
+		negated := proto.Clone(operands[0]).(*ExprOp)
+		return &ExprOp{Node: mkExprOpExpr(Or,
+			&ExprOp{Node: mkExprOpExpr(And, operands[0], operands[1])},
+			&ExprOp{Node: mkExprOpExpr(And, &ExprOp{Node: mkExprOpExpr(Not, negated)}, operands[2])},
+		)}
+	}
+
+	return &ExprOp{Node: mkExprOpExpr(expr.Operator, operands...)}
+}
+
+// isOperandInBoolCtx reports whether the operand at index i of the given operator must evaluate to a boolean value.
+func isOperandInBoolCtx(operator string, i int, boolCtx bool) bool {
+	switch operator {
+	case And, Or, Not:
+		return true
+	case operators.Conditional:
+		// the condition is always a boolean and the branches inherit the context of the conditional expression
+		return i == 0 || boolCtx
+	default:
+		return false
+	}
+}
+
+func isComparisonOperator(operator string) bool {
+	switch operator {
+	case Equals, NotEquals, GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual, In:
+		return true
+	default:
+		return false
+	}
+}
+
+func asConditional(op *enginev1.PlanResourcesFilter_Expression_Operand) *enginev1.PlanResourcesFilter_Expression {
+	if expr := op.GetExpression(); expr != nil && expr.Operator == operators.Conditional && len(expr.Operands) == conditionalNumOperands {
+		return expr
+	}
+
+	return nil
+}
+
+func cloneOperands(operands []*enginev1.PlanResourcesFilter_Expression_Operand) []*enginev1.PlanResourcesFilter_Expression_Operand {
+	clones := make([]*enginev1.PlanResourcesFilter_Expression_Operand, len(operands))
+	for i, op := range operands {
+		//nolint:forcetypeassert
+		clones[i] = proto.Clone(op).(*enginev1.PlanResourcesFilter_Expression_Operand)
+	}
+
+	return clones
+}
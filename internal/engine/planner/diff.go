@@ -0,0 +1,133 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package planner
+
+import (
+	"fmt"
+	"strings"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+type FilterChangeKind string
+
+const (
+	FilterChangeAdded    FilterChangeKind = "added"
+	FilterChangeRemoved  FilterChangeKind = "removed"
+	FilterChangeModified FilterChangeKind = "modified"
+)
+
+// FilterChange describes a single difference between two filters.
+// Path identifies the node of the filter AST that changed (e.g. `condition.operands[1]`)
+// and Base and Head contain the string representations of the node before and after the change.
+// Operands of `and` and `or` expressions can be reordered, so the paths of removed and added operands
+// are prefixed with `base.` and `head.` respectively to indicate the filter they refer to.
+type FilterChange struct {
+	Path string           `json:"path"`
+	Kind FilterChangeKind `json:"kind"`
+	Base string           `json:"base,omitempty"`
+	Head string           `json:"head,omitempty"`
+}
+
+// DiffFilters computes the structural differences between two filters.
+// Operands of `and` and `or` expressions are compared regardless of their order and nesting.
+// Paths of their operands refer to the position in the flattened list of operands.
+func DiffFilters(base, head *enginev1.PlanResourcesFilter) []FilterChange {
+	if base.GetKind() != head.GetKind() {
+		return []FilterChange{{Path: "", Kind: FilterChangeModified, Base: filterToString(base), Head: filterToString(head)}}
+	}
+
+	if base.GetKind() != enginev1.PlanResourcesFilter_KIND_CONDITIONAL {
+		return nil
+	}
+
+	var changes []FilterChange
+	diffOperands("condition", base.Condition, head.Condition, &changes)
+	return changes
+}
+
+func diffOperands(path string, base, head *enginev1.PlanResourcesFilter_Expression_Operand, changes *[]FilterChange) {
+	if util.HashPB(base, nil) == util.HashPB(head, nil) {
+		return
+	}
+
+	baseExpr, headExpr := base.GetExpression(), head.GetExpression()
+	if baseExpr == nil || headExpr == nil || baseExpr.Operator != headExpr.Operator {
+		*changes = append(*changes, FilterChange{Path: path, Kind: FilterChangeModified, Base: operandToString(base), Head: operandToString(head)})
+		return
+	}
+
+	if baseExpr.Operator == And || baseExpr.Operator == Or {
+		diffUnorderedOperands(path, flattenOperands(baseExpr), flattenOperands(headExpr), changes)
+		return
+	}
+
+	if len(baseExpr.Operands) != len(headExpr.Operands) {
+		*changes = append(*changes, FilterChange{Path: path, Kind: FilterChangeModified, Base: operandToString(base), Head: operandToString(head)})
+		return
+	}
+
+	for i := range baseExpr.Operands {
+		diffOperands(operandPath(path, i), baseExpr.Operands[i], headExpr.Operands[i], changes)
+	}
+}
+
+func diffUnorderedOperands(path string, base, head []*enginev1.PlanResourcesFilter_Expression_Operand, changes *[]FilterChange) {
+	headHashes := make(map[uint64]struct{}, len(head))
+	for _, op := range head {
+		headHashes[util.HashPB(op, nil)] = struct{}{}
+	}
+
+	baseHashes := make(map[uint64]struct{}, len(base))
+	var removed []int
+	for i, op := range base {
+		h := util.HashPB(op, nil)
+		baseHashes[h] = struct{}{}
+		if _, ok := headHashes[h]; !ok {
+			removed = append(removed, i)
+		}
+	}
+
+	var added []int
+	for i, op := range head {
+		if _, ok := baseHashes[util.HashPB(op, nil)]; !ok {
+			added = append(added, i)
+		}
+	}
+
+	for _, i := range removed {
+		*changes = append(*changes, FilterChange{Path: "base." + operandPath(path, i), Kind: FilterChangeRemoved, Base: operandToString(base[i])})
+	}
+
+	for _, i := range added {
+		*changes = append(*changes, FilterChange{Path: "head." + operandPath(path, i), Kind: FilterChangeAdded, Head: operandToString(head[i])})
+	}
+}
+
+// flattenOperands collects the operands of nested expressions that have the same operator.
+// E.g. the operands of `(a || b) || c` are `a`, `b` and `c`.
+func flattenOperands(expr *enginev1.PlanResourcesFilter_Expression) []*enginev1.PlanResourcesFilter_Expression_Operand {
+	var operands []*enginev1.PlanResourcesFilter_Expression_Operand
+	for _, op := range expr.Operands {
+		if e := op.GetExpression(); e != nil && e.Operator == expr.Operator {
+			operands = append(operands, flattenOperands(e)...)
+			continue
+		}
+
+		operands = append(operands, op)
+	}
+
+	return operands
+}
+
+func operandPath(path string, i int) string {
+	return fmt.Sprintf("%s.operands[%d]", path, i)
+}
+
+func operandToString(op *enginev1.PlanResourcesFilter_Expression_Operand) string {
+	b := new(strings.Builder)
+	filterExprOpToString(b, op)
+	return b.String()
+}
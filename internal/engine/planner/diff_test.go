@@ -0,0 +1,91 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package planner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/conditions"
+)
+
+func TestDiffFilters(t *testing.T) {
+	mkFilter := func(t *testing.T, expr string) *enginev1.PlanResourcesFilter {
+		t.Helper()
+
+		switch expr {
+		case "true":
+			return &enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED}
+		case "false":
+			return &enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_ALWAYS_DENIED}
+		}
+
+		ast, iss := conditions.StdEnv.Parse(expr)
+		require.Nil(t, iss, iss.Err())
+
+		filter := &enginev1.PlanResourcesFilter{
+			Kind:      enginev1.PlanResourcesFilter_KIND_CONDITIONAL,
+			Condition: new(exOp),
+		}
+		require.NoError(t, buildExpr(ast.Expr(), filter.Condition))
+		return normaliseFilter(filter)
+	}
+
+	tests := []struct {
+		base string
+		head string
+		want []FilterChange
+	}{
+		{
+			base: `R.attr.owner == "harry"`,
+			head: `R.attr.owner == "harry"`,
+		},
+		{
+			base: `R.attr.owner == "harry" && R.attr.public`,
+			head: `R.attr.public && R.attr.owner == "harry"`,
+		},
+		{
+			base: "true",
+			head: `R.attr.public`,
+			want: []FilterChange{{Path: "", Kind: FilterChangeModified, Base: "(true)", Head: "request.resource.attr.public"}},
+		},
+		{
+			base: `R.attr.owner == "harry"`,
+			head: `R.attr.owner == "maggie"`,
+			want: []FilterChange{{Path: "condition.operands[1]", Kind: FilterChangeModified, Base: `"harry"`, Head: `"maggie"`}},
+		},
+		{
+			base: `R.attr.public && R.attr.owner == "harry"`,
+			head: `R.attr.public && R.attr.owner != "harry"`,
+			want: []FilterChange{
+				{Path: "base.condition.operands[1]", Kind: FilterChangeRemoved, Base: `(eq request.resource.attr.owner "harry")`},
+				{Path: "head.condition.operands[1]", Kind: FilterChangeAdded, Head: `(ne request.resource.attr.owner "harry")`},
+			},
+		},
+		{
+			base: `R.attr.public || R.attr.owner == "harry"`,
+			head: `R.attr.public || R.attr.owner == "harry" || R.attr.archived`,
+			want: []FilterChange{{Path: "head.condition.operands[2]", Kind: FilterChangeAdded, Head: "request.resource.attr.archived"}},
+		},
+		{
+			base: `R.attr.public || R.attr.owner == "harry" || R.attr.archived`,
+			head: `R.attr.public || R.attr.flagged`,
+			want: []FilterChange{
+				{Path: "base.condition.operands[1]", Kind: FilterChangeRemoved, Base: `(eq request.resource.attr.owner "harry")`},
+				{Path: "base.condition.operands[2]", Kind: FilterChangeRemoved, Base: "request.resource.attr.archived"},
+				{Path: "head.condition.operands[1]", Kind: FilterChangeAdded, Head: "request.resource.attr.flagged"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.base+" => "+tc.head, func(t *testing.T) {
+			have := DiffFilters(mkFilter(t, tc.base), mkFilter(t, tc.head))
+			require.Equal(t, tc.want, have)
+		})
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package planner
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/common/overloads"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cerbos/cerbos/internal/engine/planner/internal"
+)
+
+// Names of the hierarchy functions defined in the conditions/types package.
+const (
+	hierarchyFn                 = "hierarchy"
+	hierarchyDefaultDelim       = "."
+	hierarchyAncestorOfFn       = "ancestorOf"
+	hierarchyDescendentOfFn     = "descendentOf"
+	hierarchyImmediateChildOfFn = "immediateChildOf"
+	hierarchyImmediateParentFn  = "immediateParentOf"
+	hierarchyOverlapsFn         = "overlaps"
+	hierarchySiblingOfFn        = "siblingOf"
+)
+
+// hierarchyOperand is an argument of a hierarchy function. Either segments (if the hierarchy is known) or expr
+// (the expression producing the delimited string representation of an unknown hierarchy) is set.
+type hierarchyOperand struct {
+	expr     *exprpb.Expr
+	delim    string
+	segments []string
+}
+
+// rewriteHierarchyCalls replaces calls to hierarchy functions that compare an unknown hierarchy with a known one
+// with equivalent string comparisons, so that query plan adapters don't need to implement the hierarchy functions.
+// For example, `hierarchy(R.attr.path).descendentOf(hierarchy("a.b"))` becomes `R.attr.path.startsWith("a.b.")`.
+// Unknown hierarchies are assumed to be created from strings because their types are not known at this point.
+func rewriteHierarchyCalls(e *exprpb.Expr) (*exprpb.Expr, bool) {
+	var rewritten bool
+	var r func(e *exprpb.Expr) *exprpb.Expr
+	r = func(e *exprpb.Expr) *exprpb.Expr {
+		if e == nil {
+			return nil
+		}
+
+		switch ex := e.ExprKind.(type) {
+		case *exprpb.Expr_SelectExpr:
+			ex.SelectExpr.Operand = r(ex.SelectExpr.Operand)
+		case *exprpb.Expr_CallExpr:
+			ex.CallExpr.Target = r(ex.CallExpr.Target)
+			for i, arg := range ex.CallExpr.Args {
+				ex.CallExpr.Args[i] = r(arg)
+			}
+			if out, ok := rewriteHierarchyCall(ex.CallExpr); ok {
+				rewritten = true
+				return out
+			}
+		case *exprpb.Expr_StructExpr:
+			for _, entry := range ex.StructExpr.Entries {
+				if k, ok := entry.KeyKind.(*exprpb.Expr_CreateStruct_Entry_MapKey); ok {
+					k.MapKey = r(k.MapKey)
+				}
+				entry.Value = r(entry.Value)
+			}
+		case *exprpb.Expr_ComprehensionExpr:
+			ce := ex.ComprehensionExpr
+			ce.IterRange = r(ce.IterRange)
+			ce.AccuInit = r(ce.AccuInit)
+			ce.LoopStep = r(ce.LoopStep)
+			ce.LoopCondition = r(ce.LoopCondition)
+		case *exprpb.Expr_ListExpr:
+			for i, element := range ex.ListExpr.Elements {
+				ex.ListExpr.Elements[i] = r(element)
+			}
+		}
+		return e
+	}
+
+	output := r(e)
+	if rewritten {
+		internal.UpdateIds(output)
+	}
+
+	return output, rewritten
+}
+
+func rewriteHierarchyCall(call *exprpb.Expr_Call) (*exprpb.Expr, bool) {
+	var lhs, rhs *exprpb.Expr
+	switch {
+	case call.Target != nil && len(call.Args) == 1:
+		lhs, rhs = call.Target, call.Args[0]
+	case call.Target == nil && len(call.Args) == 2 && (call.Function == operators.Equals || call.Function == operators.NotEquals):
+		lhs, rhs = call.Args[0], call.Args[1]
+	default:
+		return nil, false
+	}
+
+	a, ok := asHierarchyOperand(lhs)
+	if !ok {
+		return nil, false
+	}
+
+	b, ok := asHierarchyOperand(rhs)
+	if !ok {
+		return nil, false
+	}
+
+	// unknown is the hierarchy being filtered on and known is the hierarchy it is compared with
+	unknown, known, unknownIsReceiver := a, b, true
+	if a.expr == nil {
+		unknown, known, unknownIsReceiver = b, a, false
+	}
+
+	if unknown.expr == nil || known.expr != nil {
+		return nil, false
+	}
+
+	// the string form of the known hierarchy must be unambiguous when it is joined using the delimiter of the unknown one
+	delim := unknown.delim
+	for _, s := range known.segments {
+		if strings.Contains(s, delim) {
+			return nil, false
+		}
+	}
+
+	path := strings.Join(known.segments, delim)
+	ancestors := make([]*exprpb.Expr, len(known.segments)-1)
+	for i := range ancestors {
+		ancestors[i] = mkConstStringExpr(strings.Join(known.segments[:i+1], delim))
+	}
+
+	// x is a descendant of the known hierarchy
+	descendant := func() *exprpb.Expr {
+		return mkMethodCallExpr(overloads.StartsWith, unknown.expr, mkConstStringExpr(path+delim))
+	}
+	// x is an ancestor of the known hierarchy
+	ancestor := func() *exprpb.Expr {
+		return mkInExpr(unknown.expr, ancestors)
+	}
+
+	switch call.Function {
+	case operators.Equals:
+		return internal.MkCallExpr(operators.Equals, unknown.expr, mkConstStringExpr(path)), true
+	case operators.NotEquals:
+		return internal.MkCallExpr(operators.NotEquals, unknown.expr, mkConstStringExpr(path)), true
+	case hierarchyAncestorOfFn:
+		if unknownIsReceiver {
+			return ancestor(), true
+		}
+		return descendant(), true
+	case hierarchyDescendentOfFn:
+		if unknownIsReceiver {
+			return descendant(), true
+		}
+		return ancestor(), true
+	case hierarchyImmediateParentFn, hierarchyImmediateChildOfFn:
+		if unknownIsReceiver == (call.Function == hierarchyImmediateParentFn) {
+			// x is the parent of the known hierarchy
+			if len(known.segments) < 2 { //nolint:gomnd
+				return mkConstBoolExpr(false), true
+			}
+			return internal.MkCallExpr(operators.Equals, unknown.expr, ancestors[len(ancestors)-1]), true
+		}
+		// x is a child of the known hierarchy
+		return mkLastSegmentMatchExpr(unknown.expr, path+delim, delim)
+	case hierarchySiblingOfFn:
+		prefix := ""
+		if len(ancestors) > 0 {
+			prefix = strings.Join(known.segments[:len(known.segments)-1], delim) + delim
+		}
+		return mkLastSegmentMatchExpr(unknown.expr, prefix, delim)
+	case hierarchyOverlapsFn:
+		return internal.MkCallExpr(operators.LogicalOr,
+			mkInExpr(proto.Clone(unknown.expr).(*exprpb.Expr), append(ancestors, mkConstStringExpr(path))), //nolint:forcetypeassert
+			descendant(),
+		), true
+	default:
+		return nil, false
+	}
+}
+
+// asHierarchyOperand returns the operand if the expression is a call to the hierarchy function.
+func asHierarchyOperand(e *exprpb.Expr) (*hierarchyOperand, bool) {
+	call := e.GetCallExpr()
+	if call == nil || call.Function != hierarchyFn || call.Target != nil || len(call.Args) == 0 || len(call.Args) > 2 {
+		return nil, false
+	}
+
+	op := &hierarchyOperand{delim: hierarchyDefaultDelim}
+	if len(call.Args) == 2 { //nolint:gomnd
+		c := call.Args[1].GetConstExpr()
+		if c == nil || c.GetStringValue() == "" {
+			return nil, false
+		}
+		op.delim = c.GetStringValue()
+	}
+
+	arg := call.Args[0]
+	switch k := arg.ExprKind.(type) {
+	case *exprpb.Expr_ConstExpr:
+		s, ok := k.ConstExpr.ConstantKind.(*exprpb.Constant_StringValue)
+		if !ok {
+			return nil, false
+		}
+		op.segments = strings.Split(s.StringValue, op.delim)
+	case *exprpb.Expr_ListExpr:
+		if len(call.Args) != 1 {
+			return nil, false
+		}
+		op.segments = make([]string, len(k.ListExpr.Elements))
+		for i, elem := range k.ListExpr.Elements {
+			s, ok := elem.GetConstExpr().GetConstantKind().(*exprpb.Constant_StringValue)
+			if !ok {
+				return nil, false
+			}
+			op.segments[i] = s.StringValue
+		}
+		if len(op.segments) == 0 {
+			return nil, false
+		}
+	default:
+		op.expr = arg
+	}
+
+	return op, true
+}
+
+// mkLastSegmentMatchExpr returns an expression that checks that x consists of the prefix followed by a single segment.
+func mkLastSegmentMatchExpr(x *exprpb.Expr, prefix, delim string) (*exprpb.Expr, bool) {
+	// segments can't be matched by a character class if the delimiter has more than one character
+	if len([]rune(delim)) != 1 {
+		return nil, false
+	}
+
+	pattern := "^" + regexp.QuoteMeta(prefix) + "[^" + regexp.QuoteMeta(delim) + "]*$"
+	return mkMethodCallExpr(overloads.Matches, x, mkConstStringExpr(pattern)), true
+}
+
+func mkInExpr(x *exprpb.Expr, values []*exprpb.Expr) *exprpb.Expr {
+	if len(values) == 0 {
+		return mkConstBoolExpr(false)
+	}
+
+	return internal.MkCallExpr(operators.In, x, mkListExpr(values))
+}
+
+func mkMethodCallExpr(fn string, target *exprpb.Expr, args ...*exprpb.Expr) *exprpb.Expr {
+	e := internal.MkCallExpr(fn, args...)
+	e.GetCallExpr().Target = target
+	return e
+}
+
+func mkConstBoolExpr(b bool) *exprpb.Expr {
+	return &exprpb.Expr{ExprKind: &exprpb.Expr_ConstExpr{ConstExpr: &exprpb.Constant{ConstantKind: &exprpb.Constant_BoolValue{BoolValue: b}}}}
+}
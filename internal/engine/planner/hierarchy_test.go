@@ -0,0 +1,146 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package planner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/parser"
+	"github.com/stretchr/testify/require"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/conditions"
+)
+
+func TestRewriteHierarchyCalls(t *testing.T) {
+	principal := &enginev1.Principal{Attr: map[string]*structpb.Value{"scope": structpb.NewStringValue("a.b.c")}}
+	input := &enginev1.PlanResourcesInput{Principal: principal, Resource: &enginev1.PlanResourcesInput_Resource{Kind: "document"}}
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{
+			expr: `hierarchy(R.attr.scope).ancestorOf(hierarchy(P.attr.scope))`,
+			want: `R.attr.scope in ["a", "a.b"]`,
+		},
+		{
+			expr: `hierarchy(P.attr.scope).ancestorOf(hierarchy(R.attr.scope))`,
+			want: `R.attr.scope.startsWith("a.b.c.")`,
+		},
+		{
+			expr: `hierarchy(R.attr.scope).descendentOf(hierarchy(P.attr.scope))`,
+			want: `R.attr.scope.startsWith("a.b.c.")`,
+		},
+		{
+			expr: `hierarchy(P.attr.scope).descendentOf(hierarchy(R.attr.scope))`,
+			want: `R.attr.scope in ["a", "a.b"]`,
+		},
+		{
+			expr: `hierarchy(R.attr.scope).descendentOf(hierarchy("a"))`,
+			want: `R.attr.scope.startsWith("a.")`,
+		},
+		{
+			expr: `hierarchy(R.attr.scope).ancestorOf(hierarchy("a"))`,
+			want: `false`,
+		},
+		{
+			expr: `hierarchy(R.attr.scope).immediateParentOf(hierarchy(P.attr.scope))`,
+			want: `R.attr.scope == "a.b"`,
+		},
+		{
+			expr: `hierarchy(R.attr.scope).immediateChildOf(hierarchy(P.attr.scope))`,
+			want: `R.attr.scope.matches("^a\\.b\\.c\\.[^\\.]*$")`,
+		},
+		{
+			expr: `hierarchy(P.attr.scope).immediateParentOf(hierarchy(R.attr.scope))`,
+			want: `R.attr.scope.matches("^a\\.b\\.c\\.[^\\.]*$")`,
+		},
+		{
+			expr: `hierarchy(R.attr.scope).siblingOf(hierarchy(P.attr.scope))`,
+			want: `R.attr.scope.matches("^a\\.b\\.[^\\.]*$")`,
+		},
+		{
+			expr: `hierarchy(R.attr.scope).siblingOf(hierarchy("a"))`,
+			want: `R.attr.scope.matches("^[^\\.]*$")`,
+		},
+		{
+			expr: `hierarchy(R.attr.scope).overlaps(hierarchy(P.attr.scope))`,
+			want: `R.attr.scope in ["a", "a.b", "a.b.c"] || R.attr.scope.startsWith("a.b.c.")`,
+		},
+		{
+			expr: `hierarchy(R.attr.scope) == hierarchy(P.attr.scope)`,
+			want: `R.attr.scope == "a.b.c"`,
+		},
+		{
+			expr: `hierarchy(R.attr.scope) != hierarchy(["a", "b"])`,
+			want: `R.attr.scope != "a.b"`,
+		},
+		{
+			expr: `hierarchy(R.attr.scope, ":").descendentOf(hierarchy(P.attr.scope))`,
+			want: `R.attr.scope.startsWith("a:b:c:")`,
+		},
+		{
+			expr: `hierarchy(R.attr.scope, "::").siblingOf(hierarchy("a::b", "::"))`,
+			want: `hierarchy(R.attr.scope, "::").siblingOf(hierarchy("a::b", "::"))`,
+		},
+		{
+			expr: `hierarchy(R.attr.scope).descendentOf(hierarchy("a.b:c", ":"))`,
+			want: `hierarchy(R.attr.scope).descendentOf(hierarchy("a.b:c", ":"))`,
+		},
+		{
+			expr: `hierarchy(R.attr.scope).commonAncestors(hierarchy(P.attr.scope)).size() > 1`,
+			want: `hierarchy(R.attr.scope).commonAncestors(hierarchy("a.b.c")).size() > 1`,
+		},
+		{
+			expr: `hierarchy(R.attr.scope).ancestorOf(hierarchy(R.attr.parent))`,
+			want: `hierarchy(R.attr.scope).ancestorOf(hierarchy(R.attr.parent))`,
+		},
+	}
+
+	// values of R.attr.scope used to check that the residual is equivalent to the original expression
+	scopes := []string{"", "a", "a.b", "a.b.c", "a.b.c.d", "a.b.c.d.e", "a.b.d", "a.bc", "x", "a:b:c:d", "a::b", "a::c", "a::b::c"}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.expr, func(t *testing.T) {
+			is := require.New(t)
+			ast, iss := conditions.StdEnv.Compile(tt.expr)
+			is.NoError(iss.Err())
+			checkedExpr, err := cel.AstToCheckedExpr(ast)
+			is.NoError(err)
+
+			c := &runtimev1.Condition{Op: &runtimev1.Condition_Expr{Expr: &runtimev1.Expr{Original: tt.expr, Checked: checkedExpr}}}
+			got, err := evaluateCondition(c, input, nil, nil)
+			is.NoError(err)
+
+			residual := got.GetExpression().Expr
+			source, err := parser.Unparse(residual, nil)
+			is.NoError(err)
+			is.Equal(tt.want, source)
+
+			for _, scope := range scopes {
+				vars := map[string]any{
+					conditions.CELPrincipalAbbrev: principal,
+					conditions.CELResourceAbbrev: &enginev1.Resource{Attr: map[string]*structpb.Value{
+						"scope":  structpb.NewStringValue(scope),
+						"parent": structpb.NewStringValue("a"),
+					}},
+				}
+
+				want, _, err := conditions.Eval(conditions.StdEnv, ast, vars, time.Now)
+				is.NoError(err)
+
+				have, _, err := conditions.Eval(conditions.StdEnv, cel.ParsedExprToAst(&exprpb.ParsedExpr{Expr: residual}), vars, time.Now)
+				is.NoError(err)
+				is.Equal(want, have, "R.attr.scope=%q", scope)
+			}
+		})
+	}
+}
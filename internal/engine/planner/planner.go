@@ -16,6 +16,7 @@ import (
 
 	"github.com/google/cel-go/common/types/ref"
 	"github.com/google/cel-go/interpreter"
+	"go.uber.org/zap"
 
 	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
 	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
@@ -25,6 +26,8 @@ import (
 	"github.com/cerbos/cerbos/internal/engine/internal"
 	plannerutils "github.com/cerbos/cerbos/internal/engine/planner/internal"
 	"github.com/cerbos/cerbos/internal/engine/planner/matchers"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/observability/logging"
 	"github.com/cerbos/cerbos/internal/observability/tracing"
 	"github.com/cerbos/cerbos/internal/schema"
 	"github.com/cerbos/cerbos/internal/util"
@@ -45,6 +48,17 @@ type (
 		AllowFilter      []*qpN
 		DenyFilter       []*qpN
 		ValidationErrors []*schemav1.ValidationError
+		AttrDomains      map[string]*schema.AttrDomain
+		rules            []*ruleResidual
+	}
+
+	// ruleResidual is the partially evaluated condition of a rule that contributed to the plan.
+	ruleResidual struct {
+		node   *qpN
+		policy string
+		scope  string
+		rule   string
+		effect effectv1.Effect
 	}
 )
 
@@ -65,14 +79,48 @@ func CombinePlans(principalPolicyPlan, resourcePolicyPlan *PolicyPlanResult) *Po
 	}
 
 	if resourcePolicyPlan.Empty() {
+		principalPolicyPlan.AttrDomains = resourcePolicyPlan.AttrDomains
 		return principalPolicyPlan
 	}
 
+	return combinedPlan(principalPolicyPlan, resourcePolicyPlan,
+		append(principalPolicyPlan.AllowFilter, resourcePolicyPlan.toAST()),
+		principalPolicyPlan.DenyFilter,
+	)
+}
+
+// CombinePlansDenyOverrides combines the plans so that a resource is excluded if either policy denies access to it.
+func CombinePlansDenyOverrides(principalPolicyPlan, resourcePolicyPlan *PolicyPlanResult) *PolicyPlanResult {
+	if principalPolicyPlan.Empty() || resourcePolicyPlan.Empty() {
+		return CombinePlans(principalPolicyPlan, resourcePolicyPlan)
+	}
+
+	return combinedPlan(principalPolicyPlan, resourcePolicyPlan,
+		append(principalPolicyPlan.AllowFilter, resourcePolicyPlan.AllowFilter...),
+		append(principalPolicyPlan.DenyFilter, resourcePolicyPlan.DenyFilter...),
+	)
+}
+
+// CombinePlansPermitOverrides combines the plans so that a resource is included if either policy allows access to it.
+func CombinePlansPermitOverrides(principalPolicyPlan, resourcePolicyPlan *PolicyPlanResult) *PolicyPlanResult {
+	if principalPolicyPlan.Empty() || resourcePolicyPlan.Empty() {
+		return CombinePlans(principalPolicyPlan, resourcePolicyPlan)
+	}
+
+	return combinedPlan(principalPolicyPlan, resourcePolicyPlan,
+		[]*qpN{principalPolicyPlan.allowAST(), resourcePolicyPlan.allowAST()},
+		nil,
+	)
+}
+
+func combinedPlan(principalPolicyPlan, resourcePolicyPlan *PolicyPlanResult, allowFilter, denyFilter []*qpN) *PolicyPlanResult {
 	return &PolicyPlanResult{
 		Scope:            fmt.Sprintf("principal: %q; resource: %q", principalPolicyPlan.Scope, resourcePolicyPlan.Scope),
-		AllowFilter:      append(principalPolicyPlan.AllowFilter, resourcePolicyPlan.toAST()),
-		DenyFilter:       principalPolicyPlan.DenyFilter,
+		AllowFilter:      allowFilter,
+		DenyFilter:       denyFilter,
 		ValidationErrors: resourcePolicyPlan.ValidationErrors, // schemas aren't validated for principal policies
+		AttrDomains:      resourcePolicyPlan.AttrDomains,
+		rules:            append(principalPolicyPlan.rules, resourcePolicyPlan.rules...),
 	}
 }
 
@@ -84,11 +132,16 @@ func (p *PolicyPlanResult) Add(filter *qpN, effect effectv1.Effect) {
 	}
 }
 
+func (p *PolicyPlanResult) addRule(filter *qpN, effect effectv1.Effect, policy, scope, rule string) {
+	p.Add(filter, effect)
+	p.rules = append(p.rules, &ruleResidual{node: filter, policy: policy, scope: scope, rule: rule, effect: effect})
+}
+
 func (p *PolicyPlanResult) Empty() bool {
 	return len(p.AllowFilter) == 0 && len(p.DenyFilter) == 0
 }
 
-func (p *PolicyPlanResult) ToPlanResourcesOutput(input *enginev1.PlanResourcesInput) (*enginev1.PlanResourcesOutput, error) {
+func (p *PolicyPlanResult) ToPlanResourcesOutput(ctx context.Context, input *enginev1.PlanResourcesInput) (*enginev1.PlanResourcesOutput, error) {
 	result := &enginev1.PlanResourcesOutput{
 		RequestId:        input.RequestId,
 		Kind:             input.Resource.Kind,
@@ -99,18 +152,62 @@ func (p *PolicyPlanResult) ToPlanResourcesOutput(input *enginev1.PlanResourcesIn
 	}
 
 	var err error
-	result.Filter, err = toFilter(p.toAST())
+	result.Filter, err = toFilter(p.toAST(), input.ExpandConditionals)
 	if err != nil {
 		return nil, err
 	}
 
+	var pruned []string
+	if result.Filter, pruned = pruneFilter(result.Filter, p.AttrDomains); len(pruned) > 0 {
+		logging.FromContext(ctx).Debug("Pruned filter expressions that can never match the resource schema", zap.Strings("expressions", pruned))
+	}
+
+	if input.SimplifyFilters {
+		result.Filter = simplifyFilter(result.Filter)
+	}
+
 	if input.IncludeMeta {
 		result.FilterDebug = filterToString(result.Filter)
 	}
 
+	if input.IncludeRuleResiduals {
+		if result.RuleResiduals, err = p.ruleResiduals(input); err != nil {
+			return nil, err
+		}
+	}
+
 	return result, nil
 }
 
+func (p *PolicyPlanResult) ruleResiduals(input *enginev1.PlanResourcesInput) ([]*enginev1.PlanResourcesOutput_RuleResidual, error) {
+	residuals := make([]*enginev1.PlanResourcesOutput_RuleResidual, len(p.rules))
+	for i, r := range p.rules {
+		filter, err := toFilter(r.node, input.ExpandConditionals)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert the residual of rule %q: %w", r.rule, err)
+		}
+
+		filter, _ = pruneFilter(filter, p.AttrDomains)
+		if input.SimplifyFilters {
+			filter = simplifyFilter(filter)
+		}
+
+		residuals[i] = &enginev1.PlanResourcesOutput_RuleResidual{
+			Policy: r.policy,
+			Scope:  r.scope,
+			Rule:   r.rule,
+			Effect: r.effect,
+			Filter: filter,
+		}
+
+		if input.IncludeMeta {
+			residuals[i].FilterDebug = filterToString(filter)
+		}
+	}
+
+	return residuals, nil
+}
+
 func (p *PolicyPlanResult) toAST() *qpN {
 	a := len(p.AllowFilter)
 	d := len(p.DenyFilter)
@@ -144,18 +241,33 @@ func (p *PolicyPlanResult) toAST() *qpN {
 	}
 }
 
+// allowAST returns the filter for the resources the plan allows access to. Unlike toAST, a plan without any ALLOW rules doesn't allow access to anything.
+func (p *PolicyPlanResult) allowAST() *qpN {
+	if len(p.AllowFilter) == 0 {
+		return mkFalseNode()
+	}
+
+	return p.toAST()
+}
+
 func (ppe *PrincipalPolicyEvaluator) EvaluateResourcesQueryPlan(ctx context.Context, input *enginev1.PlanResourcesInput) (*PolicyPlanResult, error) {
 	_, span := tracing.StartSpan(ctx, "principal_policy.EvaluateResourcesQueryPlan")
 	span.SetAttributes(tracing.PolicyFQN(ppe.Policy.Meta.Fqn))
 	defer span.End()
 
+	policyKey := namer.PolicyKeyFromFQN(ppe.Policy.Meta.Fqn)
 	result := &PolicyPlanResult{}
+	now := time.Now()
 	for _, p := range ppe.Policy.Policies { // there might be more than 1 policy if there are scoped policies
 		// if previous iteration has found a matching policy, then quit the loop
 		if !result.Empty() {
 			break
 		}
 
+		if !internal.IsActive(p.ActiveFrom, p.ActiveUntil, now) {
+			continue
+		}
+
 		result.Scope = p.Scope
 		for resource, resourceRules := range p.ResourceRules {
 			if !util.MatchesGlob(resource, input.Resource.Kind) {
@@ -177,7 +289,7 @@ func (ppe *PrincipalPolicyEvaluator) EvaluateResourcesQueryPlan(ctx context.Cont
 					return nil, err
 				}
 
-				result.Add(filter, rule.Effect)
+				result.addRule(filter, rule.Effect, policyKey, p.Scope, rule.Name)
 			}
 		}
 	}
@@ -206,7 +318,14 @@ func (rpe *ResourcePolicyEvaluator) EvaluateResourcesQueryPlan(ctx context.Conte
 		}
 	}
 
+	result.AttrDomains, err = rpe.SchemaMgr.ResourceAttrDomains(ctx, rpe.Policy.Schemas, input.Resource.Kind, input.Action)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource attribute domains: %w", err)
+	}
+
+	policyKey := namer.PolicyKeyFromFQN(rpe.Policy.Meta.Fqn)
 	effectiveRoles := internal.ToSet(input.Principal.Roles)
+	now := time.Now()
 
 	for _, p := range rpe.Policy.Policies { // there might be more than 1 policy if there are scoped policies
 		// if previous iteration has found a matching policy, then quit the loop
@@ -214,31 +333,114 @@ func (rpe *ResourcePolicyEvaluator) EvaluateResourcesQueryPlan(ctx context.Conte
 			break
 		}
 
+		if !internal.IsActive(p.ActiveFrom, p.ActiveUntil, now) {
+			continue
+		}
+
 		result.Scope = p.Scope
 
 		var derivedRoles []rN
 
-		for drName, dr := range p.DerivedRoles {
-			dr := dr
+		// a derived role can be activated if the principal has one of its parent roles or if one of the
+		// derived roles it builds on can be activated
+		reachable := make(map[string]bool, len(p.DerivedRoles))
+		var isReachable func(string) bool
+		isReachable = func(drName string) bool {
+			if r, ok := reachable[drName]; ok {
+				return r
+			}
+			reachable[drName] = false
+
+			dr, ok := p.DerivedRoles[drName]
+			if !ok {
+				return false
+			}
+
+			r := internal.SetIntersects(dr.ParentRoles, effectiveRoles)
+			for pdrName := range dr.ParentDerivedRoles {
+				if r {
+					break
+				}
+				r = isReachable(pdrName)
+			}
+
+			reachable[drName] = r
+			return r
+		}
+
+		drNodes := make(map[string]*qpN, len(p.DerivedRoles))
+		var derivedRoleNode func(string) (*qpN, error)
+		derivedRoleNode = func(drName string) (*qpN, error) {
+			if node, ok := drNodes[drName]; ok {
+				return node, nil
+			}
+
+			dr := p.DerivedRoles[drName]
+			node := mkTrueNode()
+			if dr.Condition != nil {
+				drVariables := make(map[string]*exprpb.Expr, len(dr.Variables))
+				for k, v := range dr.Variables {
+					drVariables[k] = v.Checked.Expr
+				}
+
+				var err error
+				if node, err = evaluateCondition(dr.Condition, input, rpe.Globals, drVariables); err != nil {
+					return nil, err
+				}
+			}
+
 			if !internal.SetIntersects(dr.ParentRoles, effectiveRoles) {
+				// restrictions imposed by the derived roles this one builds on
+				var parentNodes []*qpN
+				for pdrName := range dr.ParentDerivedRoles {
+					if !isReachable(pdrName) {
+						continue
+					}
+
+					pNode, err := derivedRoleNode(pdrName)
+					if err != nil {
+						return nil, err
+					}
+
+					if v, ok := isNodeConstBool(pNode); ok && v {
+						parentNodes = nil
+						break
+					}
+					parentNodes = append(parentNodes, pNode)
+				}
+
+				var parentNode *qpN
+				switch len(parentNodes) {
+				case 0:
+				case 1:
+					parentNode = parentNodes[0]
+				default:
+					parentNode = mkNodeFromLO(mkOrLogicalOperation(parentNodes))
+				}
+
+				if parentNode != nil {
+					if v, ok := isNodeConstBool(node); ok && v {
+						node = parentNode
+					} else {
+						node = mkNodeFromLO(mkAndLogicalOperation([]*qpN{parentNode, node}))
+					}
+				}
+			}
+
+			drNodes[drName] = node
+			return node, nil
+		}
+
+		for drName := range p.DerivedRoles {
+			if !isReachable(drName) {
 				continue
 			}
 
+			drName := drName
 			derivedRoles = append(derivedRoles, rN{
 				role: drName,
 				f: func() (*qpN, error) {
-					if dr.Condition == nil {
-						return mkTrueNode(), nil
-					}
-					drVariables := make(map[string]*exprpb.Expr, len(dr.Variables))
-					for k, v := range dr.Variables {
-						drVariables[k] = v.Checked.Expr
-					}
-					node, err := evaluateCondition(dr.Condition, input, rpe.Globals, drVariables)
-					if err != nil {
-						return nil, err
-					}
-					return node, nil
+					return derivedRoleNode(drName)
 				},
 				node: nil,
 			})
@@ -295,7 +497,9 @@ func (rpe *ResourcePolicyEvaluator) EvaluateResourcesQueryPlan(ctx context.Conte
 					filter = mkNodeFromLO(mkAndLogicalOperation([]*qpN{drNode, node}))
 				}
 
-				result.Add(filter, rule.Effect)
+				// Rule limits are not applied to query plans because a plan doesn't perform the action. A rule with a limit
+				// contributes its filter as if the principal hadn't reached the limit yet.
+				result.addRule(filter, rule.Effect, policyKey, p.Scope, rule.Name)
 			}
 		}
 	}
@@ -503,6 +707,7 @@ func evaluateConditionExpression(expr *exprpb.CheckedExpr, input *enginev1.PlanR
 		if err != nil {
 			return nil, err
 		}
+		residual, _ = rewriteHierarchyCalls(residual)
 		m := matchers.NewExpressionProcessor()
 		var r bool
 		r, e, err = m.Process(residual)
@@ -552,6 +757,9 @@ func newEvaluator(input *enginev1.PlanResourcesInput, globals map[string]any) (p
 	knownVars[conditions.Fqn(conditions.CELPrincipalField)] = input.Principal
 	knownVars[conditions.CELGlobalsIdent] = globals
 	knownVars[conditions.CELGlobalsAbbrev] = globals
+	for name, value := range conditions.ConstantVars() {
+		knownVars[name] = value
+	}
 
 	p.env = conditions.StdPartialEnv
 	if len(input.Resource.GetAttr()) > 0 {
@@ -567,10 +775,17 @@ func newEvaluator(input *enginev1.PlanResourcesInput, globals map[string]any) (p
 			return nil, err
 		}
 	}
-	p.vars, err = cel.PartialVars(knownVars,
+	unknowns := []*interpreter.AttributePattern{
 		cel.AttributePattern(conditions.CELResourceAbbrev),
-		cel.AttributePattern(conditions.CELRequestIdent).QualString(conditions.CELResourceField))
+		cel.AttributePattern(conditions.CELRequestIdent).QualString(conditions.CELResourceField),
+	}
+	// the JWT may not be available when planning (e.g. when the plan is generated by a backend service on behalf of a user)
+	// so references to its claims are left in the residual to be resolved later instead of being treated as missing keys.
+	if len(input.GetAuxData().GetJwt()) == 0 {
+		unknowns = append(unknowns, cel.AttributePattern(conditions.CELRequestIdent).QualString(conditions.CELAuxDataField))
+	}
 
+	p.vars, err = cel.PartialVars(knownVars, unknowns...)
 	if err != nil {
 		return nil, err
 	}
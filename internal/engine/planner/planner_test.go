@@ -230,6 +230,55 @@ func Test_evaluateCondition(t *testing.T) {
 	}
 }
 
+func TestEvaluateConditionWithoutJWT(t *testing.T) {
+	jwt := &enginev1.AuxData{Jwt: map[string]*structpb.Value{"sub": structpb.NewStringValue("harry")}}
+	tests := []struct {
+		input *enginev1.PlanResourcesInput
+		expr  string
+		want  string
+	}{
+		{
+			expr:  `R.attr.owner == request.aux_data.jwt.sub`,
+			input: &enginev1.PlanResourcesInput{AuxData: jwt},
+			want:  `R.attr.owner == "harry"`,
+		},
+		{
+			expr:  `R.attr.owner == request.aux_data.jwt.sub`,
+			input: &enginev1.PlanResourcesInput{},
+			want:  `R.attr.owner == request.aux_data.jwt.sub`,
+		},
+		{
+			expr:  `request.aux_data.jwt.aud == "cerbos"`,
+			input: &enginev1.PlanResourcesInput{AuxData: &enginev1.AuxData{}},
+			want:  `request.aux_data.jwt.aud == "cerbos"`,
+		},
+		{
+			expr:  `request.aux_data.jwt.aud == "cerbos"`,
+			input: &enginev1.PlanResourcesInput{AuxData: jwt},
+			want:  "false",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.expr, func(t *testing.T) {
+			is := require.New(t)
+			ast, iss := conditions.StdEnv.Compile(tt.expr)
+			is.Nil(iss, iss.Err())
+			checkedExpr, err := cel.AstToCheckedExpr(ast)
+			is.NoError(err)
+
+			c := &runtimev1.Condition{Op: &runtimev1.Condition_Expr{Expr: &runtimev1.Expr{Original: tt.expr, Checked: checkedExpr}}}
+			got, err := evaluateCondition(c, tt.input, nil, nil)
+			is.NoError(err)
+
+			source, err := parser.Unparse(got.GetExpression().Expr, nil)
+			is.NoError(err)
+			is.Equal(tt.want, source)
+		})
+	}
+}
+
 // TestResidualExpr compares two approaches to evaluate `residual expression`.
 // 1. ast := env.ResidualAst(); ast.Expr()
 // 2. ResidualExpr()
@@ -335,6 +384,34 @@ func TestPartialEvaluationWithGlobalVars(t *testing.T) {
 			expr: `intersect(R.attr.workspaces, V.gb_us)`,
 			want: `intersect(R.attr.workspaces, ["GB", "US"])`,
 		},
+		{
+			expr: `union(R.attr.workspaces, V.gb_us).symmetricDifference(V.gb_us.difference(["US"]))`,
+			want: `union(R.attr.workspaces, ["GB", "US"]).symmetricDifference(["GB"])`,
+		},
+		{
+			expr: `getOrDefault(R.attr, "owner.locale", "en_GB") == V.locale`,
+			want: `getOrDefault(R.attr, "owner.locale", "en_GB") == R.attr.language + "_" + R.attr.country`,
+		},
+		{
+			expr: `hasPath(V.info, "country") && getOrDefault(V.info, "region", ca) == R.attr.region`,
+			want: `"ca" == R.attr.region`,
+		},
+		{
+			expr: `compareIDs(R.attr.orderID, "01890a5d-ac96-774b-bcce-b302099a8057") < 0 && isULID(gbLoc)`,
+			want: `false`,
+		},
+		{
+			expr: `compareIDs(R.attr.orderID, "01890a5d-ac96-774b-bcce-b302099a8057") < 0 && uuidVersion("01890a5d-ac96-774b-bcce-b302099a8057") == 7`,
+			want: `compareIDs(R.attr.orderID, "01890a5d-ac96-774b-bcce-b302099a8057") < 0`,
+		},
+		{
+			expr: `decimal(R.attr.amount).mul("1.2").isAtMost(decimal("100.00").add(20))`,
+			want: `decimal(R.attr.amount).mul("1.2").isAtMost(decimal("100.00").add(20))`,
+		},
+		{
+			expr: `decimal(R.attr.amount).isAtMost(100) && decimal("0.1").add("0.2") == decimal("0.3")`,
+			want: `decimal(R.attr.amount).isAtMost(100)`,
+		},
 	}
 
 	env, pvars, variables := setupEnv(t)
@@ -416,6 +493,22 @@ func TestNormaliseFilter(t *testing.T) {
 	}
 }
 
+func TestSimplifyFilter(t *testing.T) {
+	tcases := test.LoadTestCases(t, "query_planner_simplify")
+
+	for _, tcase := range tcases {
+		tcase := tcase
+		t.Run(tcase.Name, func(t *testing.T) {
+			tc := readQPFilterTestCase(t, tcase.Input)
+			haveFilter := simplifyFilter(normaliseFilter(tc.Input))
+			require.Empty(t, cmp.Diff(tc.WantFilter, haveFilter, protocmp.Transform()))
+
+			haveStr := filterToString(haveFilter)
+			require.Equal(t, tc.WantString, haveStr)
+		})
+	}
+}
+
 func readQPFilterTestCase(tb testing.TB, data []byte) *privatev1.QueryPlannerFilterTestCase {
 	tb.Helper()
 
@@ -0,0 +1,166 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package planner
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const resourceAttrPrefix = "request.resource.attr."
+
+// pruneFilter removes the branches of the filter that can never match because they compare a resource attribute
+// against values that are not allowed by the resource schema. E.g. if the schema restricts `status` to `open` and `closed`,
+// `R.attr.status == "archived"` becomes `false`. It returns the string representations of the pruned expressions.
+//
+// Replacing a comparison with a constant is only safe if the attribute is required or if the constant makes the enclosing
+// expression evaluate to false, because comparisons involving missing attributes never match.
+func pruneFilter(filter *enginev1.PlanResourcesFilter, domains map[string]*schema.AttrDomain) (*enginev1.PlanResourcesFilter, []string) {
+	if filter.Kind != enginev1.PlanResourcesFilter_KIND_CONDITIONAL || len(domains) == 0 {
+		return filter, nil
+	}
+
+	p := &pruner{domains: domains}
+	filter.Condition = p.prune(filter.Condition, false)
+	if len(p.pruned) == 0 {
+		return filter, nil
+	}
+
+	return normaliseFilter(filter), p.pruned
+}
+
+type pruner struct {
+	domains map[string]*schema.AttrDomain
+	pruned  []string
+}
+
+func (p *pruner) prune(op *enginev1.PlanResourcesFilter_Expression_Operand, negated bool) *enginev1.PlanResourcesFilter_Expression_Operand {
+	expr := op.GetExpression()
+	if expr == nil {
+		return op
+	}
+
+	switch expr.Operator {
+	case And, Or:
+		for i, o := range expr.Operands {
+			expr.Operands[i] = p.prune(o, negated)
+		}
+		return op
+	case Not:
+		for i, o := range expr.Operands {
+			expr.Operands[i] = p.prune(o, !negated)
+		}
+		return op
+	case Equals, NotEquals:
+		return p.pruneComparison(op, negated)
+	case In:
+		return p.pruneMembership(op, negated)
+	default:
+		return op
+	}
+}
+
+func (p *pruner) pruneComparison(op *enginev1.PlanResourcesFilter_Expression_Operand, negated bool) *enginev1.PlanResourcesFilter_Expression_Operand {
+	expr := op.GetExpression()
+	if len(expr.Operands) != 2 {
+		return op
+	}
+
+	variable, value, _, ok := asVariableAndValue(expr.Operands)
+	if !ok {
+		return op
+	}
+
+	domain, ok := p.domain(variable)
+	if !ok || !isDomainValue(value) || domainContains(domain, value) {
+		return op
+	}
+
+	return p.replace(op, expr.Operator == NotEquals, domain, negated)
+}
+
+func (p *pruner) pruneMembership(op *enginev1.PlanResourcesFilter_Expression_Operand, negated bool) *enginev1.PlanResourcesFilter_Expression_Operand {
+	expr := op.GetExpression()
+	if len(expr.Operands) != 2 {
+		return op
+	}
+
+	list := expr.Operands[1].GetValue().GetListValue()
+	domain, ok := p.domain(expr.Operands[0].GetVariable())
+	if !ok || list == nil {
+		return op
+	}
+
+	values := make([]*structpb.Value, 0, len(list.Values))
+	for _, v := range list.Values {
+		if !isDomainValue(v) {
+			return op
+		}
+
+		if domainContains(domain, v) {
+			values = append(values, v)
+		}
+	}
+
+	switch len(values) {
+	case len(list.Values):
+		return op
+	case 0:
+		return p.replace(op, false, domain, negated)
+	default:
+		// removing values that are outside the domain doesn't change the result for any resource
+		p.pruned = append(p.pruned, operandToString(op))
+		return &enginev1.PlanResourcesFilter_Expression_Operand{
+			Node: mkExprOpExpr(In, expr.Operands[0], mkValueOp(structpb.NewListValue(&structpb.ListValue{Values: values}))),
+		}
+	}
+}
+
+// replace substitutes the operand with the given constant if it's safe to do so.
+func (p *pruner) replace(op *enginev1.PlanResourcesFilter_Expression_Operand, value bool, domain *schema.AttrDomain, negated bool) *enginev1.PlanResourcesFilter_Expression_Operand {
+	if value != negated && !domain.Required {
+		return op
+	}
+
+	p.pruned = append(p.pruned, operandToString(op))
+	return mkValueOp(structpb.NewBoolValue(value))
+}
+
+func (p *pruner) domain(variable string) (*schema.AttrDomain, bool) {
+	if !strings.HasPrefix(variable, resourceAttrPrefix) {
+		return nil, false
+	}
+
+	attr := strings.TrimPrefix(variable, resourceAttrPrefix)
+	if attr == "" || strings.Contains(attr, ".") {
+		return nil, false
+	}
+
+	domain, ok := p.domains[attr]
+	return domain, ok && domain != nil
+}
+
+func isDomainValue(v *structpb.Value) bool {
+	if _, ok := v.Kind.(*structpb.Value_NullValue); ok {
+		return true
+	}
+
+	return scalarKind(v) != ""
+}
+
+func domainContains(domain *schema.AttrDomain, v *structpb.Value) bool {
+	h := util.HashPB(mkValueOp(v), nil)
+	for _, dv := range domain.Values {
+		if util.HashPB(mkValueOp(dv), nil) == h {
+			return true
+		}
+	}
+
+	return false
+}
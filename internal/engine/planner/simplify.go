@@ -0,0 +1,323 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package planner
+
+import (
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+// simplifyFilter collapses equality checks on the same variable into IN expressions, removes redundant numeric bounds
+// and puts the operands of comparisons in a canonical order. The filter must be normalised before it is simplified.
+func simplifyFilter(filter *enginev1.PlanResourcesFilter) *enginev1.PlanResourcesFilter {
+	if filter.Kind != enginev1.PlanResourcesFilter_KIND_CONDITIONAL {
+		return filter
+	}
+
+	filter.Condition = simplifyFilterExprOp(filter.Condition)
+	// AND or OR expressions might have been reduced to a single operand
+	return normaliseFilter(filter)
+}
+
+func simplifyFilterExprOp(op *enginev1.PlanResourcesFilter_Expression_Operand) *enginev1.PlanResourcesFilter_Expression_Operand {
+	expr := op.GetExpression()
+	if expr == nil {
+		return op
+	}
+
+	operands := make([]*enginev1.PlanResourcesFilter_Expression_Operand, len(expr.Operands))
+	for i, o := range expr.Operands {
+		operands[i] = simplifyFilterExprOp(o)
+	}
+
+	operator := expr.Operator
+	switch operator {
+	case Or:
+		operands = mergeRanges(Or, collapseInLists(operands))
+	case And:
+		operands = mergeRanges(And, operands)
+	case Equals, NotEquals, GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual:
+		operator, operands = orientComparison(operator, operands)
+	}
+
+	return &enginev1.PlanResourcesFilter_Expression_Operand{Node: mkExprOpExpr(operator, operands...)}
+}
+
+// orientComparison puts the variable on the left of a comparison of a variable with a value, so that adapters only
+// need to handle `x < 5` instead of also handling `5 > x`. Comparisons of two resource attributes are already ordered by normaliseFilter.
+func orientComparison(operator string, operands []*enginev1.PlanResourcesFilter_Expression_Operand) (string, []*enginev1.PlanResourcesFilter_Expression_Operand) {
+	if len(operands) != 2 || operands[0].GetValue() == nil || operands[1].GetVariable() == "" { //nolint:gomnd
+		return operator, operands
+	}
+
+	return mirrorComparison(operator), []*enginev1.PlanResourcesFilter_Expression_Operand{operands[1], operands[0]}
+}
+
+// collapseInLists merges equality and membership checks on the same variable in the operands of an OR expression.
+// E.g. `x == "a" || x == "b" || x in ["c"]` becomes `x in ["a", "b", "c"]`.
+// Only checks against values of the same type are merged because adapters might not support lists of mixed types.
+func collapseInLists(operands []*enginev1.PlanResourcesFilter_Expression_Operand) []*enginev1.PlanResourcesFilter_Expression_Operand {
+	type group struct {
+		variable string
+		values   []*structpb.Value
+		index    int
+		count    int
+	}
+
+	groups := make(map[string]*group)
+	for i, op := range operands {
+		key, variable, values, ok := asMembershipCheck(op)
+		if !ok {
+			continue
+		}
+
+		g, ok := groups[key]
+		if !ok {
+			g = &group{variable: variable, index: i}
+			groups[key] = g
+		}
+
+		g.values = append(g.values, values...)
+		g.count++
+	}
+
+	result := make([]*enginev1.PlanResourcesFilter_Expression_Operand, 0, len(operands))
+	for i, op := range operands {
+		key, _, _, ok := asMembershipCheck(op)
+		if !ok || groups[key].count < 2 {
+			result = append(result, op)
+			continue
+		}
+
+		g := groups[key]
+		if g.index != i {
+			continue
+		}
+
+		values := uniqueValues(g.values)
+		varOp := &enginev1.PlanResourcesFilter_Expression_Operand{
+			Node: &enginev1.PlanResourcesFilter_Expression_Operand_Variable{Variable: g.variable},
+		}
+
+		if len(values) == 1 {
+			result = append(result, &enginev1.PlanResourcesFilter_Expression_Operand{Node: mkExprOpExpr(Equals, varOp, mkValueOp(values[0]))})
+			continue
+		}
+
+		result = append(result, &enginev1.PlanResourcesFilter_Expression_Operand{
+			Node: mkExprOpExpr(In, varOp, mkValueOp(structpb.NewListValue(&structpb.ListValue{Values: values}))),
+		})
+	}
+
+	return result
+}
+
+// asMembershipCheck returns the variable and the candidate values if the operand is of the form `x == value` or `x in [values]`
+// and all the values have the same scalar type. The key identifies the combination of the variable and the value type.
+func asMembershipCheck(op *enginev1.PlanResourcesFilter_Expression_Operand) (key, variable string, values []*structpb.Value, ok bool) {
+	expr := op.GetExpression()
+	if expr == nil || len(expr.Operands) != 2 {
+		return "", "", nil, false
+	}
+
+	switch expr.Operator {
+	case Equals:
+		v, value, _, ok := asVariableAndValue(expr.Operands)
+		if !ok {
+			return "", "", nil, false
+		}
+
+		variable, values = v, []*structpb.Value{value}
+	case In:
+		list := expr.Operands[1].GetValue().GetListValue()
+		if list == nil || len(list.Values) == 0 {
+			return "", "", nil, false
+		}
+
+		variable, values = expr.Operands[0].GetVariable(), list.Values
+	default:
+		return "", "", nil, false
+	}
+
+	if variable == "" {
+		return "", "", nil, false
+	}
+
+	kind := scalarKind(values[0])
+	if kind == "" {
+		return "", "", nil, false
+	}
+
+	for _, v := range values[1:] {
+		if scalarKind(v) != kind {
+			return "", "", nil, false
+		}
+	}
+
+	return variable + "/" + kind, variable, values, true
+}
+
+type rangeBound struct {
+	value     float64
+	operand   *enginev1.PlanResourcesFilter_Expression_Operand
+	inclusive bool
+}
+
+// mergeRanges removes redundant numeric bounds on the same variable from the operands of an AND or OR expression.
+// For AND, the tightest lower and upper bounds are retained (`x > 5 && x > 10` becomes `x > 10`).
+// For OR, the loosest bounds are retained (`x > 5 || x > 10` becomes `x > 5`).
+func mergeRanges(logicalOperator string, operands []*enginev1.PlanResourcesFilter_Expression_Operand) []*enginev1.PlanResourcesFilter_Expression_Operand {
+	lower := make(map[string]*rangeBound)
+	upper := make(map[string]*rangeBound)
+
+	keep := func(existing, candidate *rangeBound, isLower bool) bool {
+		if existing == nil {
+			return true
+		}
+
+		if candidate.value == existing.value {
+			// for AND the exclusive bound is tighter and for OR the inclusive bound is looser
+			if logicalOperator == And {
+				return existing.inclusive && !candidate.inclusive
+			}
+			return !existing.inclusive && candidate.inclusive
+		}
+
+		tighter := candidate.value > existing.value
+		if !isLower {
+			tighter = candidate.value < existing.value
+		}
+
+		if logicalOperator == And {
+			return tighter
+		}
+		return !tighter
+	}
+
+	for _, op := range operands {
+		variable, bound, isLower, ok := asRangeBound(op)
+		if !ok {
+			continue
+		}
+
+		bounds := upper
+		if isLower {
+			bounds = lower
+		}
+
+		if keep(bounds[variable], bound, isLower) {
+			bounds[variable] = bound
+		}
+	}
+
+	result := make([]*enginev1.PlanResourcesFilter_Expression_Operand, 0, len(operands))
+	for _, op := range operands {
+		variable, _, isLower, ok := asRangeBound(op)
+		if !ok {
+			result = append(result, op)
+			continue
+		}
+
+		bounds := upper
+		if isLower {
+			bounds = lower
+		}
+
+		if bounds[variable].operand == op {
+			result = append(result, op)
+		}
+	}
+
+	return result
+}
+
+// asRangeBound returns the variable and the bound if the operand is a numeric comparison.
+// Comparisons of the form `value < x` are treated as their equivalent `x > value`.
+func asRangeBound(op *enginev1.PlanResourcesFilter_Expression_Operand) (variable string, bound *rangeBound, isLower, ok bool) {
+	expr := op.GetExpression()
+	if expr == nil || len(expr.Operands) != 2 {
+		return "", nil, false, false
+	}
+
+	operator := expr.Operator
+	switch operator {
+	case GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual:
+	default:
+		return "", nil, false, false
+	}
+
+	variable, value, swapped, ok := asVariableAndValue(expr.Operands)
+	if !ok {
+		return "", nil, false, false
+	}
+
+	n, isNumber := value.Kind.(*structpb.Value_NumberValue)
+	if !isNumber {
+		return "", nil, false, false
+	}
+
+	if swapped {
+		operator = mirrorComparison(operator)
+	}
+
+	bound = &rangeBound{
+		value:     n.NumberValue,
+		operand:   op,
+		inclusive: operator == GreaterThanOrEqual || operator == LessThanOrEqual,
+	}
+
+	return variable, bound, operator == GreaterThan || operator == GreaterThanOrEqual, true
+}
+
+// asVariableAndValue returns the variable and the value from a pair of operands in either order.
+// The swapped flag is set if the value is the first operand.
+func asVariableAndValue(operands []*enginev1.PlanResourcesFilter_Expression_Operand) (variable string, value *structpb.Value, swapped, ok bool) {
+	if variable, value = operands[0].GetVariable(), operands[1].GetValue(); variable != "" && value != nil {
+		return variable, value, false, true
+	}
+
+	if variable, value = operands[1].GetVariable(), operands[0].GetValue(); variable != "" && value != nil {
+		return variable, value, true, true
+	}
+
+	return "", nil, false, false
+}
+
+// scalarKind returns the name of the type of the value or an empty string if the value is not a scalar.
+func scalarKind(v *structpb.Value) string {
+	switch v.Kind.(type) {
+	case *structpb.Value_StringValue:
+		return "string"
+	case *structpb.Value_NumberValue:
+		return "number"
+	case *structpb.Value_BoolValue:
+		return "bool"
+	default:
+		return ""
+	}
+}
+
+func uniqueValues(values []*structpb.Value) []*structpb.Value {
+	seen := make(map[uint64]struct{}, len(values))
+	result := make([]*structpb.Value, 0, len(values))
+	for _, v := range values {
+		h := util.HashPB(mkValueOp(v), nil)
+		if _, ok := seen[h]; ok {
+			continue
+		}
+
+		seen[h] = struct{}{}
+		result = append(result, v)
+	}
+
+	return result
+}
+
+func mkValueOp(v *structpb.Value) *enginev1.PlanResourcesFilter_Expression_Operand {
+	return &enginev1.PlanResourcesFilter_Expression_Operand{
+		Node: &enginev1.PlanResourcesFilter_Expression_Operand_Value{Value: v},
+	}
+}
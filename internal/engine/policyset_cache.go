@@ -0,0 +1,68 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+)
+
+type policySetGetter interface {
+	getPrincipalPolicySet(ctx context.Context, principal, policyVer, scope string, lenientScopeSearch bool) (*runtimev1.RunnablePolicySet, error)
+	getResourcePolicySet(ctx context.Context, resource, policyVer, scope string, lenientScopeSearch bool) (*runtimev1.RunnablePolicySet, error)
+}
+
+type policySetKey struct {
+	name               string
+	version            string
+	scope              string
+	lenientScopeSearch bool
+}
+
+// policySetCache memoises the policy sets retrieved by the engine for the lifetime of a single request.
+// It is not safe for concurrent use.
+type policySetCache struct {
+	engine     *Engine
+	principals map[policySetKey]*runtimev1.RunnablePolicySet
+	resources  map[policySetKey]*runtimev1.RunnablePolicySet
+}
+
+func newPolicySetCache(engine *Engine) *policySetCache {
+	return &policySetCache{
+		engine:     engine,
+		principals: make(map[policySetKey]*runtimev1.RunnablePolicySet),
+		resources:  make(map[policySetKey]*runtimev1.RunnablePolicySet),
+	}
+}
+
+func (c *policySetCache) getPrincipalPolicySet(ctx context.Context, principal, policyVer, scope string, lenientScopeSearch bool) (*runtimev1.RunnablePolicySet, error) {
+	key := policySetKey{name: principal, version: policyVer, scope: scope, lenientScopeSearch: lenientScopeSearch}
+	if rps, ok := c.principals[key]; ok {
+		return rps, nil
+	}
+
+	rps, err := c.engine.getPrincipalPolicySet(ctx, principal, policyVer, scope, lenientScopeSearch)
+	if err != nil {
+		return nil, err
+	}
+
+	c.principals[key] = rps
+	return rps, nil
+}
+
+func (c *policySetCache) getResourcePolicySet(ctx context.Context, resource, policyVer, scope string, lenientScopeSearch bool) (*runtimev1.RunnablePolicySet, error) {
+	key := policySetKey{name: resource, version: policyVer, scope: scope, lenientScopeSearch: lenientScopeSearch}
+	if rps, ok := c.resources[key]; ok {
+		return rps, nil
+	}
+
+	rps, err := c.engine.getResourcePolicySet(ctx, resource, policyVer, scope, lenientScopeSearch)
+	if err != nil {
+		return nil, err
+	}
+
+	c.resources[key] = rps
+	return rps, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+)
+
+// maxProfiledExprs limits the number of distinct expressions tracked by the profiler to bound its memory usage.
+const maxProfiledExprs = 4096
+
+// ConditionProfile is the evaluation statistics of a single condition or variable expression.
+type ConditionProfile struct {
+	Policy        string  `json:"policy"`
+	Expr          string  `json:"expr"`
+	Count         uint64  `json:"count"`
+	Errors        uint64  `json:"errors"`
+	TotalTimeMs   float64 `json:"totalTimeMs"`
+	MeanTimeMs    float64 `json:"meanTimeMs"`
+	MaxTimeMs     float64 `json:"maxTimeMs"`
+	totalDuration time.Duration
+	maxDuration   time.Duration
+}
+
+type profileKey struct {
+	policy string
+	expr   string
+}
+
+// conditionProfiler records the evaluation time of the expressions in policies.
+// A nil profiler doesn't record anything.
+type conditionProfiler struct {
+	stats map[profileKey]*ConditionProfile
+	mu    sync.Mutex
+}
+
+func newConditionProfiler() *conditionProfiler {
+	return &conditionProfiler{stats: make(map[profileKey]*ConditionProfile)}
+}
+
+// start returns the time to pass to record after evaluating an expression.
+func (cp *conditionProfiler) start() time.Time {
+	if cp == nil {
+		return time.Time{}
+	}
+
+	return time.Now()
+}
+
+func (cp *conditionProfiler) record(policy, expr string, start time.Time, err error) {
+	if cp == nil {
+		return
+	}
+
+	elapsed := time.Since(start)
+
+	_ = stats.RecordWithTags(context.Background(),
+		[]tag.Mutator{tag.Upsert(metrics.KeyEnginePolicy, policy)},
+		metrics.EngineConditionLatency.M(float64(elapsed)/float64(time.Millisecond)),
+	)
+
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	key := profileKey{policy: policy, expr: expr}
+	p, ok := cp.stats[key]
+	if !ok {
+		if len(cp.stats) >= maxProfiledExprs {
+			return
+		}
+
+		p = &ConditionProfile{Policy: policy, Expr: expr}
+		cp.stats[key] = p
+	}
+
+	p.Count++
+	p.totalDuration += elapsed
+	if elapsed > p.maxDuration {
+		p.maxDuration = elapsed
+	}
+
+	if err != nil {
+		p.Errors++
+	}
+}
+
+// snapshot returns the statistics sorted by the total evaluation time, highest first.
+func (cp *conditionProfiler) snapshot() []ConditionProfile {
+	cp.mu.Lock()
+	out := make([]ConditionProfile, 0, len(cp.stats))
+	for _, p := range cp.stats {
+		out = append(out, *p)
+	}
+	cp.mu.Unlock()
+
+	for i := range out {
+		p := &out[i]
+		p.TotalTimeMs = float64(p.totalDuration) / float64(time.Millisecond)
+		p.MaxTimeMs = float64(p.maxDuration) / float64(time.Millisecond)
+		p.MeanTimeMs = p.TotalTimeMs / float64(p.Count)
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].totalDuration == out[j].totalDuration {
+			return out[i].Policy < out[j].Policy || (out[i].Policy == out[j].Policy && out[i].Expr < out[j].Expr)
+		}
+		return out[i].totalDuration > out[j].totalDuration
+	})
+
+	return out
+}
+
+func (cp *conditionProfiler) reset() {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	cp.stats = make(map[profileKey]*ConditionProfile)
+}
@@ -0,0 +1,152 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/observability/tracing"
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+// ErrWatchUnsupported is returned when the policy loader doesn't publish change notifications.
+var ErrWatchUnsupported = errors.New("policy loader does not support change notifications")
+
+var watcherCounter uint64
+
+// WatchDecisions evaluates the inputs and then re-evaluates them whenever the policy loader reports a change.
+// sendFn is called with the outputs for all inputs and the indexes of the inputs whose decisions have changed.
+// The first call reports all inputs as changed. Subsequent calls are only made if at least one decision has changed.
+// If a re-evaluation fails, errFn is called with the error and the watch continues until the next change. The first
+// successful evaluation after a failure reports all inputs as changed.
+// It blocks until the context is cancelled, the initial evaluation fails or one of the callbacks returns an error.
+func (engine *Engine) WatchDecisions(ctx context.Context, inputs []*enginev1.CheckInput, sendFn func(changed []int, outputs []*enginev1.CheckOutput) error, errFn func(error) error) error {
+	source, ok := engine.policyLoader.(storage.Subscribable)
+	if !ok {
+		return ErrWatchUnsupported
+	}
+
+	if w, ok := engine.policyLoader.(storage.Watchable); ok && !w.WatchesForChanges() {
+		return ErrWatchUnsupported
+	}
+
+	w := &decisionWatcher{
+		id:      fmt.Sprintf("engine.watcher.%d", atomic.AddUint64(&watcherCounter, 1)),
+		changed: make(chan struct{}, 1),
+	}
+	source.Subscribe(w)
+	defer source.Unsubscribe(w)
+
+	var prev []*enginev1.CheckOutput
+	for first := true; ; first = false {
+		outputs, err := engine.evaluateWatched(ctx, inputs, first)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			// the evaluation might have been interrupted by the watch ending
+			return nil
+		case err != nil && first:
+			return err
+		case err != nil:
+			if err := errFn(err); err != nil {
+				return err
+			}
+			// the client can't rely on the decisions it received before the error
+			prev = nil
+		default:
+			if changed := changedDecisions(prev, outputs); len(changed) > 0 {
+				engine.logWatchedDecisions(ctx, inputs, outputs, changed)
+				if err := sendFn(changed, outputs); err != nil {
+					return err
+				}
+			}
+			prev = outputs
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-w.changed:
+		}
+	}
+}
+
+// evaluateWatched evaluates the inputs without writing to the decision log or recording request metrics
+// because re-evaluations are triggered by storage events rather than client requests.
+// For the same reason, only the first evaluation counts towards the rule limits of the principal.
+func (engine *Engine) evaluateWatched(ctx context.Context, inputs []*enginev1.CheckInput, first bool) ([]*enginev1.CheckOutput, error) {
+	ctx, span := tracing.StartSpan(ctx, "engine.WatchDecisions")
+	defer span.End()
+
+	var opts []CheckOpt
+	if !first {
+		opts = append(opts, WithoutConsumingRuleLimits())
+	}
+
+	checkOpts := newCheckOptions(ctx, engine.conf.Globals, opts...)
+	if len(inputs) < parallelismThreshold || len(engine.workerPool) == 0 {
+		return engine.checkSerial(ctx, inputs, checkOpts)
+	}
+
+	return engine.checkParallel(ctx, inputs, checkOpts)
+}
+
+// logWatchedDecisions writes the decisions that are about to be sent to the client to the decision log.
+func (engine *Engine) logWatchedDecisions(ctx context.Context, inputs []*enginev1.CheckInput, outputs []*enginev1.CheckOutput, changed []int) {
+	changedInputs := make([]*enginev1.CheckInput, len(changed))
+	changedOutputs := make([]*enginev1.CheckOutput, len(changed))
+	for i, idx := range changed {
+		changedInputs[i] = inputs[idx]
+		changedOutputs[i] = outputs[idx]
+	}
+
+	_, _ = engine.logCheckDecision(ctx, changedInputs, changedOutputs, nil)
+}
+
+// changedDecisions returns the indexes of the outputs that have a different effect for at least one action.
+func changedDecisions(prev, outputs []*enginev1.CheckOutput) []int {
+	var changed []int
+	for i, out := range outputs {
+		if prev == nil || !sameEffects(prev[i], out) {
+			changed = append(changed, i)
+		}
+	}
+
+	return changed
+}
+
+func sameEffects(a, b *enginev1.CheckOutput) bool {
+	if len(a.Actions) != len(b.Actions) {
+		return false
+	}
+
+	for action, ae := range a.Actions {
+		be, ok := b.Actions[action]
+		if !ok || ae.Effect != be.Effect {
+			return false
+		}
+	}
+
+	return true
+}
+
+type decisionWatcher struct {
+	changed chan struct{}
+	id      string
+}
+
+func (w *decisionWatcher) SubscriberID() string {
+	return w.id
+}
+
+func (w *decisionWatcher) OnStorageEvent(...storage.Event) {
+	// coalesce notifications that arrive while the decisions are being re-evaluated
+	select {
+	case w.changed <- struct{}{}:
+	default:
+	}
+}
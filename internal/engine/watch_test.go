@@ -0,0 +1,140 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage/db/sqlite3"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+func TestWatchDecisions(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	store, err := sqlite3.NewStore(ctx, &sqlite3.Conf{DSN: fmt.Sprintf("%s?_fk=true&_pragma=busy_timeout(5000)", filepath.Join(t.TempDir(), "cerbos.db"))})
+	require.NoError(t, err)
+
+	mkPolicy := func(resource string, effect effectv1.Effect) policy.Wrapper {
+		return policy.Wrap(test.NewResourcePolicyBuilder(resource, "default").
+			WithRules(test.NewResourceRule("view").WithRoles("user").WithEffect(effect).Build()).
+			Build())
+	}
+
+	require.NoError(t, store.AddOrUpdate(ctx, mkPolicy("leave_request", effectv1.Effect_EFFECT_ALLOW)))
+
+	schemaMgr := schema.NewNopManager()
+	compiler := compile.NewManagerFromDefaultConf(ctx, store, schemaMgr)
+
+	conf := &Conf{}
+	conf.SetDefaults()
+	eng := NewFromConf(ctx, conf, Components{PolicyLoader: compiler, SchemaMgr: schemaMgr, AuditLog: audit.NewNopLog()})
+
+	inputs := []*enginev1.CheckInput{
+		{
+			Actions:   []string{"view"},
+			Principal: &enginev1.Principal{Id: "harry", PolicyVersion: "default", Roles: []string{"user"}},
+			Resource:  &enginev1.Resource{Kind: "leave_request", PolicyVersion: "default", Id: "XX125"},
+		},
+	}
+
+	type update struct {
+		err     error
+		changed []int
+		effect  effectv1.Effect
+	}
+
+	updates := make(chan update, 1)
+	watchCtx, stopWatch := context.WithCancel(ctx)
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- eng.WatchDecisions(watchCtx, inputs, func(changed []int, outputs []*enginev1.CheckOutput) error {
+			updates <- update{changed: changed, effect: outputs[0].Actions["view"].Effect}
+			return nil
+		}, func(err error) error {
+			updates <- update{err: err}
+			return nil
+		})
+	}()
+
+	waitForUpdate := func(t *testing.T) update {
+		t.Helper()
+
+		select {
+		case u := <-updates:
+			return u
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "Timed out waiting for update")
+			return update{}
+		}
+	}
+
+	// initial decisions
+	have := waitForUpdate(t)
+	require.Equal(t, []int{0}, have.changed)
+	require.Equal(t, effectv1.Effect_EFFECT_ALLOW, have.effect)
+
+	// a change that doesn't affect the decision should not produce an update
+	require.NoError(t, store.AddOrUpdate(ctx, mkPolicy("purchase_order", effectv1.Effect_EFFECT_DENY)))
+
+	// a change that flips the decision
+	require.NoError(t, store.AddOrUpdate(ctx, mkPolicy("leave_request", effectv1.Effect_EFFECT_DENY)))
+	have = waitForUpdate(t)
+	require.Equal(t, []int{0}, have.changed)
+	require.Equal(t, effectv1.Effect_EFFECT_DENY, have.effect)
+
+	// an invalid policy should be reported without ending the watch
+	invalid := test.NewResourcePolicyBuilder("leave_request", "default").
+		WithRules(test.NewResourceRule("view").WithRoles("user").WithEffect(effectv1.Effect_EFFECT_ALLOW).WithMatchExpr("R.attr.department ==").Build()).
+		Build()
+	require.NoError(t, store.AddOrUpdate(ctx, policy.Wrap(invalid)))
+	have = waitForUpdate(t)
+	require.ErrorIs(t, have.err, compile.PolicyCompilationErr{})
+
+	// all decisions are sent again after the error is fixed, even if they are unchanged
+	require.NoError(t, store.AddOrUpdate(ctx, mkPolicy("leave_request", effectv1.Effect_EFFECT_DENY)))
+	// the invalid policy might be reported more than once before the fix is picked up
+	for have = waitForUpdate(t); have.err != nil; have = waitForUpdate(t) {
+		require.ErrorIs(t, have.err, compile.PolicyCompilationErr{})
+	}
+	require.Equal(t, []int{0}, have.changed)
+	require.Equal(t, effectv1.Effect_EFFECT_DENY, have.effect)
+
+	stopWatch()
+	require.NoError(t, <-watchErr)
+}
+
+func TestWatchDecisionsUnsupported(t *testing.T) {
+	// the disk store doesn't publish change notifications unless watchForChanges is enabled
+	eng, cancelFunc := mkEngine(t, param{})
+	defer cancelFunc()
+
+	inputs := []*enginev1.CheckInput{
+		{
+			Actions:   []string{"view"},
+			Principal: &enginev1.Principal{Id: "harry", PolicyVersion: "default", Roles: []string{"user"}},
+			Resource:  &enginev1.Resource{Kind: "leave_request", PolicyVersion: "default", Id: "XX125"},
+		},
+	}
+
+	err := eng.WatchDecisions(context.Background(), inputs, func([]int, []*enginev1.CheckOutput) error {
+		return nil
+	}, func(error) error {
+		return nil
+	})
+	require.ErrorIs(t, err, ErrWatchUnsupported)
+}
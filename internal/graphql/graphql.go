@@ -0,0 +1,137 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package graphql implements a GraphQL endpoint for checking and planning resources and inspecting policies.
+package graphql
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
+	"github.com/cerbos/cerbos/internal/svc"
+)
+
+const (
+	// maxDepth is the maximum nesting depth of a query, which is deeper than any query of the schema needs.
+	maxDepth = 10
+	// maxParallelism is the maximum number of fields of a query that are resolved concurrently.
+	maxParallelism = 4
+)
+
+//go:embed schema.graphql
+var schemaDef string
+
+// Handler serves GraphQL queries over HTTP. The queries are resolved by calling the Cerbos API through a gRPC
+// connection, so they are authenticated, validated, audited and subject to the same limits as the other API requests.
+type Handler struct {
+	schema       *graphqlgo.Schema
+	gwmux        *runtime.ServeMux
+	maxBodyBytes int64
+}
+
+// NewHandler creates a handler that calls the API through the given connection. The gateway mux determines how
+// the HTTP request headers are forwarded as gRPC metadata, in the same way as for the requests to the REST API.
+func NewHandler(conn grpc.ClientConnInterface, gwmux *runtime.ServeMux, maxBodyBytes int64) (*Handler, error) {
+	res := &queryResolver{
+		cerbos: svcv1.NewCerbosServiceClient(conn),
+		admin:  svcv1.NewCerbosAdminServiceClient(conn),
+	}
+
+	schema, err := graphqlgo.ParseSchema(schemaDef, res, graphqlgo.MaxDepth(maxDepth), graphqlgo.MaxParallelism(maxParallelism))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse GraphQL schema: %w", err)
+	}
+
+	return &Handler{schema: schema, gwmux: gwmux, maxBodyBytes: maxBodyBytes}, nil
+}
+
+type request struct {
+	Variables     map[string]any `json:"variables"`
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req := request{}
+	switch r.Method {
+	case http.MethodGet:
+		// Queries can be sent as URL parameters, which allows the responses to be cached.
+		params := r.URL.Query()
+		req.Query = params.Get("query")
+		req.OperationName = params.Get("operationName")
+		if vars := params.Get("variables"); vars != "" {
+			if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+				http.Error(w, "Invalid variables", http.StatusBadRequest)
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, h.maxBodyBytes)).Decode(&req); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, "Request too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+
+			http.Error(w, "Invalid request", http.StatusBadRequest)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if req.Query == "" {
+		http.Error(w, "Query is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, err := runtime.AnnotateContext(r.Context(), h.gwmux, r, "graphql")
+	if err != nil {
+		http.Error(w, "Invalid request headers", http.StatusBadRequest)
+		return
+	}
+
+	resp := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+	out, err := json.Marshal(resp)
+	if err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(out)
+}
+
+// apiError is the error returned by a resolver when an API call fails. The error code of the API is added to the
+// extensions of the GraphQL error so that clients can tell the different failures apart.
+type apiError struct {
+	st *status.Status
+}
+
+func newAPIError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	return apiError{st: st}
+}
+
+func (e apiError) Error() string {
+	return e.st.Message()
+}
+
+func (e apiError) Extensions() map[string]any {
+	return map[string]any{"code": svc.ErrorCodeOf(e.st)}
+}
@@ -0,0 +1,252 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	responsev1 "github.com/cerbos/cerbos/api/genpb/cerbos/response/v1"
+	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
+	"github.com/cerbos/cerbos/internal/graphql"
+	"github.com/cerbos/cerbos/internal/svc"
+)
+
+const checkQuery = `query Check($principal: PrincipalInput!, $resources: [ResourceEntryInput!]!) {
+  checkResources(requestId: "test", principal: $principal, resources: $resources) {
+    requestId
+    results {
+      resource { id kind }
+      actions { action effect }
+    }
+  }
+}`
+
+const checkVariables = `{
+  "principal": {"id": "alice", "roles": ["user"], "attr": {"department": "marketing"}},
+  "resources": [{"actions": ["view", "delete"], "resource": {"kind": "leave_request", "id": "XX125", "attr": {"owner": "alice"}}}]
+}`
+
+func TestHandler(t *testing.T) {
+	h := mkHandler(t)
+
+	t.Run("check_resources", func(t *testing.T) {
+		resp := doPost(t, h, checkQuery, checkVariables)
+		require.Empty(t, resp.Errors)
+		require.JSONEq(t, `{
+  "checkResources": {
+    "requestId": "test",
+    "results": [
+      {
+        "resource": {"id": "XX125", "kind": "leave_request"},
+        "actions": [{"action": "delete", "effect": "EFFECT_DENY"}, {"action": "view", "effect": "EFFECT_ALLOW"}]
+      }
+    ]
+  }
+}`, string(resp.Data))
+	})
+
+	t.Run("check_resources_get", func(t *testing.T) {
+		params := url.Values{"query": {checkQuery}, "variables": {checkVariables}}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/graphql?"+params.Encode(), nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		resp := decode(t, rec)
+		require.Empty(t, resp.Errors)
+		require.Contains(t, string(resp.Data), `"requestId":"test"`)
+	})
+
+	t.Run("plan_resources", func(t *testing.T) {
+		resp := doPost(t, h, `{
+  planResources(action: "view", principal: {id: "alice", roles: ["user"]}, resource: {kind: "leave_request"}) {
+    action
+    resourceKind
+    filter { kind condition }
+  }
+}`, "")
+		require.Empty(t, resp.Errors)
+		require.JSONEq(t, `{
+  "planResources": {
+    "action": "view",
+    "resourceKind": "leave_request",
+    "filter": {"kind": "KIND_CONDITIONAL", "condition": {"variable": "request.resource.attr.owner"}}
+  }
+}`, string(resp.Data))
+	})
+
+	t.Run("resource_kinds", func(t *testing.T) {
+		resp := doPost(t, h, `{ resourceKinds { kind actions } }`, "")
+		require.Empty(t, resp.Errors)
+		require.JSONEq(t, `{"resourceKinds": [{"kind": "leave_request", "actions": ["delete", "view"]}]}`, string(resp.Data))
+	})
+
+	t.Run("api_error", func(t *testing.T) {
+		resp := doPost(t, h, `{ policyIds }`, "")
+		require.Len(t, resp.Errors, 1)
+		require.Equal(t, "Admin API is disabled", resp.Errors[0].Message)
+		require.Equal(t, string(svc.ErrCodeFeatureDisabled), resp.Errors[0].Extensions["code"])
+	})
+
+	t.Run("invalid_query", func(t *testing.T) {
+		resp := doPost(t, h, `{ checkResources }`, "")
+		require.NotEmpty(t, resp.Errors)
+		require.Nil(t, resp.Data)
+	})
+
+	t.Run("missing_query", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/graphql", strings.NewReader(`{}`)))
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("request_too_large", func(t *testing.T) {
+		body := `{"query": "` + strings.Repeat(" ", 2048) + `{ resourceKinds { kind } }"}`
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/graphql", strings.NewReader(body)))
+		require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	})
+
+	t.Run("method_not_allowed", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/api/graphql", nil))
+		require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+		require.Equal(t, "GET, POST", rec.Header().Get("Allow"))
+	})
+}
+
+type response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Extensions map[string]any `json:"extensions"`
+		Message    string         `json:"message"`
+	} `json:"errors"`
+}
+
+func doPost(t *testing.T, h http.Handler, query, variables string) response {
+	t.Helper()
+
+	req := map[string]any{"query": query}
+	if variables != "" {
+		req["variables"] = json.RawMessage(variables)
+	}
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/graphql", strings.NewReader(string(body))))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	return decode(t, rec)
+}
+
+func decode(t *testing.T, rec *httptest.ResponseRecorder) response {
+	t.Helper()
+
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var resp response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	if string(resp.Data) == "null" {
+		resp.Data = nil
+	}
+
+	return resp
+}
+
+func mkHandler(t *testing.T) *graphql.Handler {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	svcv1.RegisterCerbosServiceServer(srv, cerbosService{})
+	svcv1.RegisterCerbosAdminServiceServer(srv, adminService{})
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	h, err := graphql.NewHandler(conn, runtime.NewServeMux(), 1024)
+	require.NoError(t, err)
+
+	return h
+}
+
+type cerbosService struct {
+	svcv1.UnimplementedCerbosServiceServer
+}
+
+func (cerbosService) CheckResources(_ context.Context, req *requestv1.CheckResourcesRequest) (*responsev1.CheckResourcesResponse, error) {
+	resp := &responsev1.CheckResourcesResponse{RequestId: req.RequestId}
+	for _, entry := range req.Resources {
+		owner := entry.Resource.Attr["owner"].GetStringValue()
+		actions := make(map[string]effectv1.Effect, len(entry.Actions))
+		for _, action := range entry.Actions {
+			if action == "view" && owner == req.Principal.Id {
+				actions[action] = effectv1.Effect_EFFECT_ALLOW
+			} else {
+				actions[action] = effectv1.Effect_EFFECT_DENY
+			}
+		}
+
+		resp.Results = append(resp.Results, &responsev1.CheckResourcesResponse_ResultEntry{
+			Resource: &responsev1.CheckResourcesResponse_ResultEntry_Resource{Id: entry.Resource.Id, Kind: entry.Resource.Kind},
+			Actions:  actions,
+		})
+	}
+
+	return resp, nil
+}
+
+func (cerbosService) PlanResources(_ context.Context, req *requestv1.PlanResourcesRequest) (*responsev1.PlanResourcesResponse, error) {
+	return &responsev1.PlanResourcesResponse{
+		RequestId:    req.RequestId,
+		Action:       req.Action,
+		ResourceKind: req.Resource.Kind,
+		Filter: &enginev1.PlanResourcesFilter{
+			Kind: enginev1.PlanResourcesFilter_KIND_CONDITIONAL,
+			Condition: &enginev1.PlanResourcesFilter_Expression_Operand{
+				Node: &enginev1.PlanResourcesFilter_Expression_Operand_Variable{Variable: "request.resource.attr.owner"},
+			},
+		},
+	}, nil
+}
+
+func (cerbosService) ListResourceKinds(context.Context, *requestv1.ListResourceKindsRequest) (*responsev1.ListResourceKindsResponse, error) {
+	return &responsev1.ListResourceKindsResponse{
+		ResourceKinds: []*responsev1.ListResourceKindsResponse_ResourceKind{
+			{Kind: "leave_request", Actions: []string{"delete", "view"}},
+		},
+	}, nil
+}
+
+type adminService struct {
+	svcv1.UnimplementedCerbosAdminServiceServer
+}
+
+func (adminService) ListPolicies(context.Context, *requestv1.ListPoliciesRequest) (*responsev1.ListPoliciesResponse, error) {
+	return nil, svc.NewError(codes.Unimplemented, svc.ErrCodeFeatureDisabled, "Admin API is disabled")
+}
@@ -0,0 +1,546 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	responsev1 "github.com/cerbos/cerbos/api/genpb/cerbos/response/v1"
+	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
+	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
+)
+
+// JSON is the Go type of the JSON scalar.
+type JSON struct {
+	raw json.RawMessage
+}
+
+func (JSON) ImplementsGraphQLType(name string) bool {
+	return name == "JSON"
+}
+
+func (j *JSON) UnmarshalGraphQL(input any) error {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("invalid JSON value: %w", err)
+	}
+
+	j.raw = raw
+	return nil
+}
+
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if j.raw == nil {
+		return []byte("null"), nil
+	}
+
+	return j.raw, nil
+}
+
+// attr converts the value of an attr field to the attributes of a principal or a resource.
+func (j *JSON) attr() (map[string]*structpb.Value, error) {
+	if j == nil {
+		return nil, nil
+	}
+
+	s := &structpb.Struct{}
+	if err := protojson.Unmarshal(j.raw, s); err != nil {
+		return nil, fmt.Errorf("attr must be a JSON object: %w", err)
+	}
+
+	return s.Fields, nil
+}
+
+func protoJSON(msg proto.Message) (JSON, error) {
+	raw, err := protojson.Marshal(msg)
+	if err != nil {
+		return JSON{}, fmt.Errorf("failed to encode %T: %w", msg, err)
+	}
+
+	return JSON{raw: raw}, nil
+}
+
+// optionalProtoJSON returns nil if the message is nil, so that the field is null in the response.
+func optionalProtoJSON[T proto.Message](msg T) (*JSON, error) {
+	if !msg.ProtoReflect().IsValid() {
+		return nil, nil
+	}
+
+	j, err := protoJSON(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &j, nil
+}
+
+type principalInput struct {
+	PolicyVersion *string
+	Scope         *string
+	Attr          *JSON
+	ID            string
+	Roles         []string
+}
+
+func (p principalInput) toProto() (*enginev1.Principal, error) {
+	attr, err := p.Attr.attr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid principal: %w", err)
+	}
+
+	return &enginev1.Principal{
+		Id:            p.ID,
+		Roles:         p.Roles,
+		PolicyVersion: deref(p.PolicyVersion),
+		Scope:         deref(p.Scope),
+		Attr:          attr,
+	}, nil
+}
+
+type resourceEntryInput struct {
+	Resource resourceInput
+	Actions  []string
+}
+
+type resourceInput struct {
+	PolicyVersion *string
+	Scope         *string
+	Attr          *JSON
+	Kind          string
+	ID            string
+}
+
+func (r resourceInput) toProto() (*enginev1.Resource, error) {
+	attr, err := r.Attr.attr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid resource %q: %w", r.ID, err)
+	}
+
+	return &enginev1.Resource{
+		Kind:          r.Kind,
+		Id:            r.ID,
+		PolicyVersion: deref(r.PolicyVersion),
+		Scope:         deref(r.Scope),
+		Attr:          attr,
+	}, nil
+}
+
+type planResourceInput struct {
+	PolicyVersion *string
+	Scope         *string
+	Attr          *JSON
+	Kind          string
+}
+
+func (r planResourceInput) toProto() (*enginev1.PlanResourcesInput_Resource, error) {
+	attr, err := r.Attr.attr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid resource: %w", err)
+	}
+
+	return &enginev1.PlanResourcesInput_Resource{
+		Kind:          r.Kind,
+		PolicyVersion: deref(r.PolicyVersion),
+		Scope:         deref(r.Scope),
+		Attr:          attr,
+	}, nil
+}
+
+type auxDataInput struct {
+	JWT *jwtInput
+}
+
+type jwtInput struct {
+	KeySetID *string
+	Token    string
+}
+
+func (a *auxDataInput) toProto() *requestv1.AuxData {
+	if a == nil || a.JWT == nil {
+		return nil
+	}
+
+	return &requestv1.AuxData{Jwt: &requestv1.AuxData_JWT{Token: a.JWT.Token, KeySetId: deref(a.JWT.KeySetID)}}
+}
+
+type queryResolver struct {
+	cerbos svcv1.CerbosServiceClient
+	admin  svcv1.CerbosAdminServiceClient
+}
+
+type checkResourcesArgs struct {
+	RequestID   *string
+	AuxData     *auxDataInput
+	Principal   principalInput
+	Resources   []resourceEntryInput
+	IncludeMeta bool
+}
+
+func (q *queryResolver) CheckResources(ctx context.Context, args checkResourcesArgs) (*checkResourcesResolver, error) {
+	principal, err := args.Principal.toProto()
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]*requestv1.CheckResourcesRequest_ResourceEntry, len(args.Resources))
+	for i, entry := range args.Resources {
+		resource, err := entry.Resource.toProto()
+		if err != nil {
+			return nil, err
+		}
+
+		resources[i] = &requestv1.CheckResourcesRequest_ResourceEntry{Actions: entry.Actions, Resource: resource}
+	}
+
+	resp, err := q.cerbos.CheckResources(ctx, &requestv1.CheckResourcesRequest{
+		RequestId:   deref(args.RequestID),
+		IncludeMeta: args.IncludeMeta,
+		Principal:   principal,
+		Resources:   resources,
+		AuxData:     args.AuxData.toProto(),
+	})
+	if err != nil {
+		return nil, newAPIError(err)
+	}
+
+	return &checkResourcesResolver{resp: resp}, nil
+}
+
+type planResourcesArgs struct {
+	Resource             planResourceInput
+	RequestID            *string
+	AuxData              *auxDataInput
+	Action               string
+	Principal            principalInput
+	IncludeMeta          bool
+	ExpandConditionals   bool
+	IncludeRuleResiduals bool
+	SimplifyFilters      bool
+}
+
+func (q *queryResolver) PlanResources(ctx context.Context, args planResourcesArgs) (*planResourcesResolver, error) {
+	principal, err := args.Principal.toProto()
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := args.Resource.toProto()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := q.cerbos.PlanResources(ctx, &requestv1.PlanResourcesRequest{
+		RequestId:            deref(args.RequestID),
+		Action:               args.Action,
+		Principal:            principal,
+		Resource:             resource,
+		AuxData:              args.AuxData.toProto(),
+		IncludeMeta:          args.IncludeMeta,
+		ExpandConditionals:   args.ExpandConditionals,
+		IncludeRuleResiduals: args.IncludeRuleResiduals,
+		SimplifyFilters:      args.SimplifyFilters,
+	})
+	if err != nil {
+		return nil, newAPIError(err)
+	}
+
+	return &planResourcesResolver{resp: resp}, nil
+}
+
+func (q *queryResolver) ResourceKinds(ctx context.Context) ([]*resourceKindResolver, error) {
+	resp, err := q.cerbos.ListResourceKinds(ctx, &requestv1.ListResourceKindsRequest{})
+	if err != nil {
+		return nil, newAPIError(err)
+	}
+
+	out := make([]*resourceKindResolver, len(resp.ResourceKinds))
+	for i, rk := range resp.ResourceKinds {
+		out[i] = &resourceKindResolver{rk: rk}
+	}
+
+	return out, nil
+}
+
+type policyIDsArgs struct {
+	NameRegexp      *string
+	ScopeRegexp     *string
+	VersionRegexp   *string
+	IncludeDisabled bool
+}
+
+func (q *queryResolver) PolicyIDs(ctx context.Context, args policyIDsArgs) ([]string, error) {
+	resp, err := q.admin.ListPolicies(ctx, &requestv1.ListPoliciesRequest{
+		IncludeDisabled: args.IncludeDisabled,
+		NameRegexp:      deref(args.NameRegexp),
+		ScopeRegexp:     deref(args.ScopeRegexp),
+		VersionRegexp:   deref(args.VersionRegexp),
+	})
+	if err != nil {
+		return nil, newAPIError(err)
+	}
+
+	return resp.PolicyIds, nil
+}
+
+func (q *queryResolver) Policies(ctx context.Context, args struct{ IDs []string }) ([]JSON, error) {
+	resp, err := q.admin.GetPolicy(ctx, &requestv1.GetPolicyRequest{Id: args.IDs})
+	if err != nil {
+		return nil, newAPIError(err)
+	}
+
+	out := make([]JSON, len(resp.Policies))
+	for i, p := range resp.Policies {
+		if out[i], err = protoJSON(p); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+type effectiveRulesArgs struct {
+	PolicyVersion      *string
+	Scope              *string
+	Resource           string
+	LenientScopeSearch bool
+}
+
+func (q *queryResolver) EffectiveRules(ctx context.Context, args effectiveRulesArgs) (JSON, error) {
+	resp, err := q.admin.InspectEffectiveRules(ctx, &requestv1.InspectEffectiveRulesRequest{
+		Resource:           args.Resource,
+		PolicyVersion:      deref(args.PolicyVersion),
+		Scope:              deref(args.Scope),
+		LenientScopeSearch: args.LenientScopeSearch,
+	})
+	if err != nil {
+		return JSON{}, newAPIError(err)
+	}
+
+	return protoJSON(resp)
+}
+
+type checkResourcesResolver struct {
+	resp *responsev1.CheckResourcesResponse
+}
+
+func (r *checkResourcesResolver) RequestID() string {
+	return r.resp.RequestId
+}
+
+func (r *checkResourcesResolver) Results() []*resourceResultResolver {
+	out := make([]*resourceResultResolver, len(r.resp.Results))
+	for i, result := range r.resp.Results {
+		out[i] = &resourceResultResolver{result: result}
+	}
+
+	return out
+}
+
+type resourceResultResolver struct {
+	result *responsev1.CheckResourcesResponse_ResultEntry
+}
+
+func (r *resourceResultResolver) Resource() *resourceRefResolver {
+	return &resourceRefResolver{resource: r.result.Resource}
+}
+
+func (r *resourceResultResolver) Actions() []*actionEffectResolver {
+	out := make([]*actionEffectResolver, 0, len(r.result.Actions))
+	for action, effect := range r.result.Actions {
+		out = append(out, &actionEffectResolver{action: action, effect: effect})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].action < out[j].action })
+	return out
+}
+
+func (r *resourceResultResolver) ValidationErrors() []*validationErrorResolver {
+	return validationErrors(r.result.ValidationErrors)
+}
+
+func (r *resourceResultResolver) Outputs() ([]*outputResolver, error) {
+	out := make([]*outputResolver, len(r.result.Outputs))
+	for i, o := range r.result.Outputs {
+		val, err := optionalProtoJSON(o.Val)
+		if err != nil {
+			return nil, err
+		}
+
+		out[i] = &outputResolver{src: o.Src, val: val}
+	}
+
+	return out, nil
+}
+
+func (r *resourceResultResolver) Meta() (*JSON, error) {
+	return optionalProtoJSON(r.result.Meta)
+}
+
+type resourceRefResolver struct {
+	resource *responsev1.CheckResourcesResponse_ResultEntry_Resource
+}
+
+func (r *resourceRefResolver) ID() string {
+	return r.resource.GetId()
+}
+
+func (r *resourceRefResolver) Kind() string {
+	return r.resource.GetKind()
+}
+
+func (r *resourceRefResolver) PolicyVersion() string {
+	return r.resource.GetPolicyVersion()
+}
+
+func (r *resourceRefResolver) Scope() string {
+	return r.resource.GetScope()
+}
+
+type actionEffectResolver struct {
+	action string
+	effect effectv1.Effect
+}
+
+func (r *actionEffectResolver) Action() string {
+	return r.action
+}
+
+func (r *actionEffectResolver) Effect() string {
+	return r.effect.String()
+}
+
+type validationErrorResolver struct {
+	err *schemav1.ValidationError
+}
+
+func validationErrors(errs []*schemav1.ValidationError) []*validationErrorResolver {
+	out := make([]*validationErrorResolver, len(errs))
+	for i, err := range errs {
+		out[i] = &validationErrorResolver{err: err}
+	}
+
+	return out
+}
+
+func (r *validationErrorResolver) Path() string {
+	return r.err.Path
+}
+
+func (r *validationErrorResolver) Message() string {
+	return r.err.Message
+}
+
+func (r *validationErrorResolver) Source() string {
+	return r.err.Source.String()
+}
+
+type outputResolver struct {
+	val *JSON
+	src string
+}
+
+func (r *outputResolver) Src() string {
+	return r.src
+}
+
+func (r *outputResolver) Val() *JSON {
+	return r.val
+}
+
+type planResourcesResolver struct {
+	resp *responsev1.PlanResourcesResponse
+}
+
+func (r *planResourcesResolver) RequestID() string {
+	return r.resp.RequestId
+}
+
+func (r *planResourcesResolver) Action() string {
+	return r.resp.Action
+}
+
+func (r *planResourcesResolver) ResourceKind() string {
+	return r.resp.ResourceKind
+}
+
+func (r *planResourcesResolver) PolicyVersion() string {
+	return r.resp.PolicyVersion
+}
+
+func (r *planResourcesResolver) Filter() *planFilterResolver {
+	return &planFilterResolver{filter: r.resp.Filter}
+}
+
+func (r *planResourcesResolver) ValidationErrors() []*validationErrorResolver {
+	return validationErrors(r.resp.ValidationErrors)
+}
+
+func (r *planResourcesResolver) RuleResiduals() ([]JSON, error) {
+	out := make([]JSON, len(r.resp.RuleResiduals))
+	for i, rr := range r.resp.RuleResiduals {
+		j, err := protoJSON(rr)
+		if err != nil {
+			return nil, err
+		}
+
+		out[i] = j
+	}
+
+	return out, nil
+}
+
+func (r *planResourcesResolver) Meta() (*JSON, error) {
+	return optionalProtoJSON(r.resp.Meta)
+}
+
+type planFilterResolver struct {
+	filter *enginev1.PlanResourcesFilter
+}
+
+func (r *planFilterResolver) Kind() string {
+	return r.filter.GetKind().String()
+}
+
+func (r *planFilterResolver) Condition() (*JSON, error) {
+	return optionalProtoJSON(r.filter.GetCondition())
+}
+
+type resourceKindResolver struct {
+	rk *responsev1.ListResourceKindsResponse_ResourceKind
+}
+
+func (r *resourceKindResolver) Kind() string {
+	return r.rk.Kind
+}
+
+func (r *resourceKindResolver) PolicyVersions() []string {
+	return r.rk.PolicyVersions
+}
+
+func (r *resourceKindResolver) Scopes() []string {
+	return r.rk.Scopes
+}
+
+func (r *resourceKindResolver) Actions() []string {
+	return r.rk.Actions
+}
+
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+
+	return *v
+}
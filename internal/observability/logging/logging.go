@@ -5,6 +5,7 @@ package logging
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -25,6 +26,13 @@ type ctxLog struct{}
 
 var ctxLogKey = &ctxLog{}
 
+var (
+	// atomicLevel is the minimum level of the global logger.
+	atomicLevel = zap.NewAtomicLevel()
+	// configuredLevel is the level that the global logger reverts to after the level is temporarily changed.
+	configuredLevel = zap.NewAtomicLevel()
+)
+
 // InitLogging initializes the global logger.
 func InitLogging(ctx context.Context, level string) {
 	if envLevel := os.Getenv("CERBOS_LOG_LEVEL"); envLevel != "" {
@@ -38,22 +46,9 @@ func InitLogging(ctx context.Context, level string) {
 func doInitLogging(ctx context.Context, level string) {
 	var logger *zap.Logger
 
-	minLogLevel := zapcore.InfoLevel
-	switch strings.ToUpper(level) {
-	case "DEBUG":
-		minLogLevel = zapcore.DebugLevel
-	case "INFO":
+	minLogLevel, err := ParseLevel(level)
+	if err != nil {
 		minLogLevel = zapcore.InfoLevel
-	case "WARN":
-		minLogLevel = zapcore.WarnLevel
-	case "ERROR":
-		minLogLevel = zapcore.ErrorLevel
-	default:
-		if strings.HasPrefix(level, "V") {
-			if vLevel, err := strconv.Atoi(strings.TrimPrefix(level, "V")); err == nil {
-				minLogLevel = zapcore.Level(-vLevel)
-			}
-		}
 	}
 
 	encoderConf := ecszap.NewDefaultEncoderConfig().ToZapCoreEncoderConfig()
@@ -68,7 +63,8 @@ func doInitLogging(ctx context.Context, level string) {
 
 	consoleErrors := zapcore.Lock(os.Stderr)
 	consoleInfo := zapcore.Lock(os.Stdout)
-	atomicLevel := zap.NewAtomicLevelAt(minLogLevel)
+	atomicLevel.SetLevel(minLogLevel)
+	configuredLevel.SetLevel(minLogLevel)
 
 	errorPriority := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
 		return atomicLevel.Enabled(lvl) && lvl >= zapcore.ErrorLevel
@@ -98,12 +94,40 @@ func doInitLogging(ctx context.Context, level string) {
 	)
 
 	if minLogLevel > zap.DebugLevel {
-		handleUSR1Signal(ctx, minLogLevel, &atomicLevel)
+		handleUSR1Signal(ctx)
+	}
+}
+
+// ParseLevel parses a log level name (debug, info, warn or error) or a verbosity level of the form V<n>.
+func ParseLevel(level string) (zapcore.Level, error) {
+	switch strings.ToUpper(level) {
+	case "DEBUG":
+		return zapcore.DebugLevel, nil
+	case "INFO":
+		return zapcore.InfoLevel, nil
+	case "WARN":
+		return zapcore.WarnLevel, nil
+	case "ERROR":
+		return zapcore.ErrorLevel, nil
+	default:
+		if strings.HasPrefix(level, "V") {
+			if vLevel, err := strconv.Atoi(strings.TrimPrefix(level, "V")); err == nil {
+				return zapcore.Level(-vLevel), nil
+			}
+		}
+
+		return zapcore.InfoLevel, fmt.Errorf("unknown log level %q: must be one of debug, info, warn or error", level)
 	}
 }
 
+// SetLevel changes the minimum level of the global logger.
+func SetLevel(level zapcore.Level) {
+	configuredLevel.SetLevel(level)
+	atomicLevel.SetLevel(level)
+}
+
 // setLogLevelForDuration temporarily sets the global log level to the given level for a period of time.
-func setLogLevelForDuration(ctx context.Context, doneChan chan<- struct{}, extendChan <-chan struct{}, originalLevel zapcore.Level, atomicLevel *zap.AtomicLevel) {
+func setLogLevelForDuration(ctx context.Context, doneChan chan<- struct{}, extendChan <-chan struct{}) {
 	log := zap.S().Named("logging")
 
 	tmpLogLevelDuration := defaultTmpLogLevelDuration
@@ -119,8 +143,8 @@ func setLogLevelForDuration(ctx context.Context, doneChan chan<- struct{}, exten
 	timer := time.NewTimer(tmpLogLevelDuration)
 	defer func() {
 		timer.Stop()
-		log.Infof("Reverting global log level to %s", originalLevel)
-		atomicLevel.SetLevel(originalLevel)
+		log.Infof("Reverting global log level to %s", configuredLevel.Level())
+		atomicLevel.SetLevel(configuredLevel.Level())
 		doneChan <- struct{}{}
 	}()
 
@@ -10,13 +10,10 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
-
-	"go.uber.org/zap"
-	"go.uber.org/zap/zapcore"
 )
 
 // handleUSR1Signal temporarily sets the log level to debug when a SIGUSR1 signal is received.
-func handleUSR1Signal(ctx context.Context, originalLevel zapcore.Level, atomicLevel *zap.AtomicLevel) {
+func handleUSR1Signal(ctx context.Context) {
 	sigusr1 := make(chan os.Signal, 1)
 	signal.Notify(sigusr1, syscall.SIGUSR1)
 
@@ -33,7 +30,7 @@ func handleUSR1Signal(ctx context.Context, originalLevel zapcore.Level, atomicLe
 					extendChan <- struct{}{}
 				} else {
 					inProgress = true
-					go setLogLevelForDuration(ctx, doneChan, extendChan, originalLevel, atomicLevel)
+					go setLogLevelForDuration(ctx, doneChan, extendChan)
 				}
 			case <-doneChan:
 				inProgress = false
@@ -5,13 +5,8 @@
 
 package logging
 
-import (
-	"context"
+import "context"
 
-	"go.uber.org/zap"
-	"go.uber.org/zap/zapcore"
-)
-
-func handleUSR1Signal(_ context.Context, _ zapcore.Level, _ *zap.AtomicLevel) {
+func handleUSR1Signal(_ context.Context) {
 	return
 }
@@ -13,8 +13,13 @@ import (
 )
 
 var (
-	registry   = metric.NewRegistry()
-	cacheGauge *metric.Int64Gauge
+	registry        = metric.NewRegistry()
+	cacheGauge      *metric.Int64Gauge
+	cacheBytesGauge *metric.Int64Gauge
+
+	storeLastSyncGauge  *metric.Int64DerivedGauge
+	storeSyncLagGauge   *metric.Float64DerivedGauge
+	storeSyncFailsGauge *metric.Int64DerivedGauge
 )
 
 func init() {
@@ -29,6 +34,42 @@ func init() {
 	if err != nil {
 		zap.L().Warn("Failed to create cache gauge", zap.Error(err))
 	}
+
+	cacheBytesGauge, err = registry.AddInt64Gauge("cerbos.dev/cache/live_bytes",
+		metric.WithDescription("Approximate memory used by the live objects in the cache"),
+		metric.WithLabelKeys(KeyCacheKind.Name()),
+		metric.WithUnit(metricdata.UnitBytes),
+	)
+	if err != nil {
+		zap.L().Warn("Failed to create cache bytes gauge", zap.Error(err))
+	}
+
+	storeLastSyncGauge, err = registry.AddInt64DerivedGauge("cerbos.dev/store/last_successful_sync",
+		metric.WithDescription("Unix timestamp of the last successful sync with the remote store"),
+		metric.WithLabelKeys(KeyStoreDriver.Name()),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		zap.L().Warn("Failed to create store last sync gauge", zap.Error(err))
+	}
+
+	storeSyncLagGauge, err = registry.AddFloat64DerivedGauge("cerbos.dev/store/sync_lag",
+		metric.WithDescription("Time elapsed since the store was last known to be in sync with the remote store"),
+		metric.WithLabelKeys(KeyStoreDriver.Name()),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		zap.L().Warn("Failed to create store sync lag gauge", zap.Error(err))
+	}
+
+	storeSyncFailsGauge, err = registry.AddInt64DerivedGauge("cerbos.dev/store/consecutive_sync_failures",
+		metric.WithDescription("Number of consecutive failures to sync with the remote store"),
+		metric.WithLabelKeys(KeyStoreDriver.Name()),
+		metric.WithUnit(metricdata.UnitDimensionless),
+	)
+	if err != nil {
+		zap.L().Warn("Failed to create store sync failures gauge", zap.Error(err))
+	}
 }
 
 var (
@@ -39,11 +80,17 @@ var (
 	KeyBundleRemoteEvent    = tag.MustNewKey("remote_event")
 	KeyCacheKind            = tag.MustNewKey("kind")
 	KeyCacheResult          = tag.MustNewKey("result")
+	KeyCanaryCheck          = tag.MustNewKey("check")
+	KeyCanaryResult         = tag.MustNewKey("result")
+	KeyCanaryStatus         = tag.MustNewKey("status")
 	KeyCompileStatus        = tag.MustNewKey("status")
 	KeyEngineDecisionStatus = tag.MustNewKey("status")
+	KeyEnginePolicy         = tag.MustNewKey("policy")
 	KeyEnginePlanStatus     = tag.MustNewKey("status")
 	KeyIndexCRUDKind        = tag.MustNewKey("kind")
+	KeyPriorityClass        = tag.MustNewKey("priority_class")
 	KeyStoreDriver          = tag.MustNewKey("driver")
+	KeyTenant               = tag.MustNewKey("tenant")
 )
 
 var (
@@ -140,6 +187,30 @@ var (
 		Aggregation: view.LastValue(),
 	}
 
+	CanaryCheckCount = stats.Int64(
+		"cerbos.dev/canary/check_count",
+		"Counter of canary check evaluations",
+		stats.UnitDimensionless,
+	)
+
+	CanaryCheckCountView = &view.View{
+		Measure:     CanaryCheckCount,
+		TagKeys:     []tag.Key{KeyCanaryCheck, KeyCanaryResult},
+		Aggregation: view.Count(),
+	}
+
+	CanaryFlipCount = stats.Int64(
+		"cerbos.dev/canary/flip_count",
+		"Counter of changes to the status of canary checks",
+		stats.UnitDimensionless,
+	)
+
+	CanaryFlipCountView = &view.View{
+		Measure:     CanaryFlipCount,
+		TagKeys:     []tag.Key{KeyCanaryCheck, KeyCanaryStatus},
+		Aggregation: view.Count(),
+	}
+
 	CloudConnectedCount = stats.Int64(
 		"cerbos.dev/cloud/connected",
 		"Is the instance connected to Cerbos Cloud",
@@ -171,7 +242,19 @@ var (
 
 	EngineCheckLatencyView = &view.View{
 		Measure:     EngineCheckLatency,
-		TagKeys:     []tag.Key{KeyEngineDecisionStatus},
+		TagKeys:     []tag.Key{KeyEngineDecisionStatus, KeyTenant},
+		Aggregation: defaultLatencyDistribution(),
+	}
+
+	EngineConditionLatency = stats.Float64(
+		"cerbos.dev/engine/condition_latency",
+		"Time to evaluate a condition or variable expression (only recorded when condition profiling is enabled)",
+		stats.UnitMilliseconds,
+	)
+
+	EngineConditionLatencyView = &view.View{
+		Measure:     EngineConditionLatency,
+		TagKeys:     []tag.Key{KeyEnginePolicy},
 		Aggregation: defaultLatencyDistribution(),
 	}
 
@@ -194,7 +277,7 @@ var (
 
 	EnginePlanLatencyView = &view.View{
 		Measure:     EnginePlanLatency,
-		TagKeys:     []tag.Key{KeyEnginePlanStatus},
+		TagKeys:     []tag.Key{KeyEnginePlanStatus, KeyTenant},
 		Aggregation: defaultLatencyDistribution(),
 	}
 
@@ -221,6 +304,29 @@ var (
 		Aggregation: view.LastValue(),
 	}
 
+	LoadSheddingRejectedCount = stats.Int64(
+		"cerbos.dev/server/load_shedding_rejected_count",
+		"Number of requests rejected because the server was overloaded",
+		stats.UnitDimensionless,
+	)
+
+	LoadSheddingRejectedCountView = &view.View{
+		Measure:     LoadSheddingRejectedCount,
+		TagKeys:     []tag.Key{KeyPriorityClass},
+		Aggregation: view.Count(),
+	}
+
+	RateLimitedCount = stats.Int64(
+		"cerbos.dev/server/rate_limited_count",
+		"Number of requests rejected because the client exceeded its rate limit",
+		stats.UnitDimensionless,
+	)
+
+	RateLimitedCountView = &view.View{
+		Measure:     RateLimitedCount,
+		Aggregation: view.Count(),
+	}
+
 	StorePollCount = stats.Int64(
 		"cerbos.dev/store/poll_count",
 		"Number of times the remote store was polled for updates",
@@ -255,13 +361,18 @@ var DefaultCerbosViews = []*view.View{
 	BundleStoreUpdatesCountView,
 	CacheAccessCountView,
 	CacheMaxSizeView,
+	CanaryCheckCountView,
+	CanaryFlipCountView,
 	CloudConnectedCountView,
 	CompileDurationView,
 	EngineCheckLatencyView,
 	EngineCheckBatchSizeView,
+	EngineConditionLatencyView,
 	EnginePlanLatencyView,
 	IndexCRUDCountView,
 	IndexEntryCountView,
+	LoadSheddingRejectedCountView,
+	RateLimitedCountView,
 	StorePollCountView,
 	StoreSyncErrorCountView,
 }
@@ -281,6 +392,42 @@ func MakeCacheGauge(kind string) CacheGauge {
 	}
 }
 
+// MakeCacheBytesGauge returns a gauge for tracking the approximate memory used by the objects in the cache.
+func MakeCacheBytesGauge(kind string) CacheGauge {
+	if cacheBytesGauge == nil {
+		return CacheGauge{}
+	}
+
+	return CacheGauge{
+		lbl: metricdata.NewLabelValue(kind),
+		g:   cacheBytesGauge,
+	}
+}
+
+// RegisterStoreSyncGauges reports the sync status of the store using the given driver through the functions, which are
+// called whenever the metrics are collected.
+func RegisterStoreSyncGauges(driver string, lastSync func() int64, lag func() float64, failures func() int64) {
+	lbl := metricdata.NewLabelValue(driver)
+
+	if storeLastSyncGauge != nil {
+		if err := storeLastSyncGauge.UpsertEntry(lastSync, lbl); err != nil {
+			zap.L().Warn("Failed to register store last sync gauge", zap.Error(err))
+		}
+	}
+
+	if storeSyncLagGauge != nil {
+		if err := storeSyncLagGauge.UpsertEntry(lag, lbl); err != nil {
+			zap.L().Warn("Failed to register store sync lag gauge", zap.Error(err))
+		}
+	}
+
+	if storeSyncFailsGauge != nil {
+		if err := storeSyncFailsGauge.UpsertEntry(failures, lbl); err != nil {
+			zap.L().Warn("Failed to register store sync failures gauge", zap.Error(err))
+		}
+	}
+}
+
 type CacheGauge struct {
 	g   *metric.Int64Gauge
 	lbl metricdata.LabelValue
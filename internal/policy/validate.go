@@ -55,6 +55,8 @@ func validateResourcePolicy(rp *policyv1.ResourcePolicy) (err error) {
 		}
 	}
 
+	err = multierr.Append(err, validateRoleDefs(rp.LocalDerivedRoles))
+
 	return
 }
 
@@ -87,9 +89,13 @@ func validatePrincipalPolicy(rp *policyv1.PrincipalPolicy) (err error) {
 	return
 }
 
-func validateDerivedRoles(dr *policyv1.DerivedRoles) (err error) {
-	roleNames := make(map[string]int, len(dr.Definitions))
-	for i, rd := range dr.Definitions {
+func validateDerivedRoles(dr *policyv1.DerivedRoles) error {
+	return validateRoleDefs(dr.Definitions)
+}
+
+func validateRoleDefs(defs []*policyv1.RoleDef) (err error) {
+	roleNames := make(map[string]int, len(defs))
+	for i, rd := range defs {
 		// Check for name clashes
 		if idx, exists := roleNames[rd.Name]; exists {
 			err = multierr.Append(err, fmt.Errorf("derived role definition #%d has the same name as definition #%d: '%s'", i+1, idx, rd.Name))
@@ -34,4 +34,5 @@ var (
 	TraceEventEffectAllow   = color.New(color.FgGreen).SprintFunc()
 	TraceEventEffectDeny    = color.New(color.FgRed).SprintFunc()
 	TraceEventSkipped       = color.New(color.FgHiWhite).SprintFunc()
+	WarningMsg              = color.New(color.FgYellow).SprintFunc()
 )
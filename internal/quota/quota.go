@@ -0,0 +1,86 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package quota keeps track of how many times a limited operation has been performed within a time window.
+package quota
+
+import (
+	"sync"
+	"time"
+)
+
+const pruneInterval = 1 * time.Minute
+
+// Store tracks the usage of quotas.
+type Store interface {
+	// Take consumes one unit of the quota identified by key, which allows max units per window.
+	// It returns false without consuming anything if the quota has already been exhausted in the current window.
+	Take(key string, max uint32, window time.Duration, now time.Time) bool
+	// Available returns true if the quota identified by key, which allows max units per window, hasn't been exhausted
+	// in the current window. It doesn't consume anything.
+	Available(key string, max uint32, now time.Time) bool
+}
+
+// MemStore is a Store that keeps the usage counts in memory using fixed windows.
+// Counts are not shared between processes, so each Cerbos instance enforces quotas independently.
+type MemStore struct {
+	counters  map[string]*counter
+	lastPrune time.Time
+	mu        sync.Mutex
+}
+
+type counter struct {
+	windowEnd time.Time
+	count     uint32
+}
+
+func NewMemStore() *MemStore {
+	return &MemStore{counters: make(map[string]*counter)}
+}
+
+func (s *MemStore) Take(key string, max uint32, window time.Duration, now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.prune(now)
+
+	c, ok := s.counters[key]
+	if !ok || !now.Before(c.windowEnd) {
+		c = &counter{windowEnd: now.Add(window)}
+		s.counters[key] = c
+	}
+
+	if c.count >= max {
+		return false
+	}
+
+	c.count++
+	return true
+}
+
+func (s *MemStore) Available(key string, max uint32, now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.counters[key]
+	if !ok || !now.Before(c.windowEnd) {
+		return max > 0
+	}
+
+	return c.count < max
+}
+
+// prune removes the counters of windows that have ended so that keys that are no longer used don't accumulate.
+func (s *MemStore) prune(now time.Time) {
+	if now.Sub(s.lastPrune) < pruneInterval {
+		return
+	}
+
+	for key, c := range s.counters {
+		if !now.Before(c.windowEnd) {
+			delete(s.counters, key)
+		}
+	}
+
+	s.lastPrune = now
+}
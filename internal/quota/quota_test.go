@@ -0,0 +1,42 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/quota"
+)
+
+func TestMemStore(t *testing.T) {
+	s := quota.NewMemStore()
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		require.True(t, s.Take("harry", 3, time.Hour, now.Add(time.Duration(i)*time.Minute)))
+	}
+
+	require.False(t, s.Take("harry", 3, time.Hour, now.Add(10*time.Minute)), "quota should be exhausted")
+	require.True(t, s.Take("sally", 3, time.Hour, now.Add(10*time.Minute)), "keys should have independent quotas")
+
+	require.False(t, s.Take("harry", 3, time.Hour, now.Add(59*time.Minute)), "window should not have ended")
+	require.True(t, s.Take("harry", 3, time.Hour, now.Add(time.Hour)), "quota should be replenished in the next window")
+}
+
+func TestMemStoreAvailable(t *testing.T) {
+	s := quota.NewMemStore()
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	require.True(t, s.Available("harry", 2, now), "unused quota should be available")
+	require.True(t, s.Take("harry", 2, time.Hour, now))
+	require.True(t, s.Available("harry", 2, now.Add(time.Minute)))
+	require.True(t, s.Available("harry", 2, now.Add(time.Minute)), "checking availability should not consume the quota")
+	require.True(t, s.Take("harry", 2, time.Hour, now.Add(2*time.Minute)))
+
+	require.False(t, s.Available("harry", 2, now.Add(3*time.Minute)), "quota should be exhausted")
+	require.True(t, s.Available("harry", 2, now.Add(time.Hour)), "quota should be replenished in the next window")
+}
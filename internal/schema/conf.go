@@ -3,7 +3,15 @@
 
 package schema
 
-import "github.com/cerbos/cerbos/internal/config"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/config"
+)
 
 const (
 	confKey            = "schema"
@@ -11,12 +19,24 @@ const (
 	defaultCacheSize   = 1024
 )
 
+var errEmptyKindPrefix = errors.New("schema.overrides.kindPrefix must not be an empty string")
+
 // Conf is optional configuration for schema validation.
 type Conf struct {
 	// Enforcement defines level of the validations. Possible values are none, warn, reject.
 	Enforcement Enforcement `yaml:"enforcement" conf:",example=reject"`
 	// CacheSize defines the number of schemas to cache in memory.
 	CacheSize uint `yaml:"cacheSize" conf:",example=1024"`
+	// Overrides customise the enforcement level for resource kinds starting with a given prefix. If several prefixes match a kind, the longest one wins.
+	Overrides []*EnforcementOverride `yaml:"overrides"`
+}
+
+// EnforcementOverride overrides the enforcement level for resource kinds starting with KindPrefix.
+type EnforcementOverride struct {
+	// KindPrefix is the resource kind prefix this override applies to.
+	KindPrefix string `yaml:"kindPrefix" conf:"required,example=\"billing.\""`
+	// Enforcement defines level of the validations for matching resource kinds. Possible values are none, warn, reject.
+	Enforcement Enforcement `yaml:"enforcement" conf:"required,example=warn"`
 }
 
 func (c *Conf) Key() string {
@@ -28,6 +48,55 @@ func (c *Conf) SetDefaults() {
 	c.CacheSize = defaultCacheSize
 }
 
+func (c *Conf) Validate() error {
+	var errs error
+	for i, o := range c.Overrides {
+		if o == nil || strings.TrimSpace(o.KindPrefix) == "" {
+			errs = multierr.Append(errs, fmt.Errorf("override #%d: %w", i, errEmptyKindPrefix))
+			continue
+		}
+
+		switch o.Enforcement {
+		case EnforcementNone, EnforcementWarn, EnforcementReject:
+		default:
+			errs = multierr.Append(errs, fmt.Errorf("override for %q: invalid enforcement level %q", o.KindPrefix, o.Enforcement))
+		}
+	}
+
+	return errs
+}
+
+// EnforcementFor returns the enforcement level that applies to the given resource kind.
+func (c *Conf) EnforcementFor(kind string) Enforcement {
+	var match *EnforcementOverride
+	for _, o := range c.Overrides {
+		if strings.HasPrefix(kind, o.KindPrefix) && (match == nil || len(o.KindPrefix) > len(match.KindPrefix)) {
+			match = o
+		}
+	}
+
+	if match == nil {
+		return c.Enforcement
+	}
+
+	return match.Enforcement
+}
+
+// enforcementDisabled returns true if no resource kind is subject to schema enforcement.
+func (c *Conf) enforcementDisabled() bool {
+	if c.Enforcement != EnforcementNone {
+		return false
+	}
+
+	for _, o := range c.Overrides {
+		if o.Enforcement != EnforcementNone {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Enforcement level for schema validation.
 type Enforcement string
 
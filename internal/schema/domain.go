@@ -0,0 +1,119 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+
+	jsonschema "github.com/santhosh-tekuri/jsonschema/v5"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+)
+
+// AttrDomain is the set of values an attribute is allowed to have according to its schema.
+type AttrDomain struct {
+	Values   []*structpb.Value
+	Required bool
+}
+
+// ResourceAttrDomains returns the domains of the top-level resource attributes that are restricted to a fixed set of
+// scalar values using `enum` or `const`. Schema loading errors are not returned because they are reported by validation.
+// Domains are only returned if the enforcement level for the resource kind is `reject` because resources with attribute
+// values outside the domain are still evaluated by the engine otherwise.
+func (m *manager) ResourceAttrDomains(ctx context.Context, schemas *policyv1.Schemas, kind, action string) (map[string]*AttrDomain, error) {
+	if m.conf.EnforcementFor(kind) != EnforcementReject {
+		return nil, nil
+	}
+
+	schemaRef := schemas.GetResourceSchema()
+	if schemaRef == nil || schemaRef.Ref == "" {
+		return nil, nil
+	}
+
+	if ignore := schemaRef.IgnoreWhen; ignore != nil && len(ignore.Actions) > 0 {
+		if len(filterActionsToValidate(ignore.Actions, []string{action})) == 0 {
+			return nil, nil
+		}
+	}
+
+	schema, err := m.loadSchema(ctx, schemaRef.Ref)
+	if err != nil {
+		return nil, nil //nolint:nilerr
+	}
+
+	root := resolveRef(schema)
+	if root == nil || len(root.Properties) == 0 {
+		return nil, nil
+	}
+
+	required := make(map[string]struct{}, len(root.Required))
+	for _, r := range root.Required {
+		required[r] = struct{}{}
+	}
+
+	domains := make(map[string]*AttrDomain)
+	for name, prop := range root.Properties {
+		prop = resolveRef(prop)
+		if prop == nil {
+			continue
+		}
+
+		var allowed []interface{}
+		switch {
+		case len(prop.Constant) > 0:
+			allowed = prop.Constant[:1]
+		case len(prop.Enum) > 0:
+			allowed = prop.Enum
+		default:
+			continue
+		}
+
+		values, ok := toScalarValues(allowed)
+		if !ok {
+			continue
+		}
+
+		_, isRequired := required[name]
+		domains[name] = &AttrDomain{Values: values, Required: isRequired}
+	}
+
+	return domains, nil
+}
+
+// resolveRef follows references until it finds a schema that defines constraints of its own.
+func resolveRef(s *jsonschema.Schema) *jsonschema.Schema {
+	for s != nil && s.Ref != nil && len(s.Properties) == 0 && len(s.Enum) == 0 && len(s.Constant) == 0 {
+		s = s.Ref
+	}
+
+	return s
+}
+
+func toScalarValues(values []interface{}) ([]*structpb.Value, bool) {
+	result := make([]*structpb.Value, len(values))
+	for i, v := range values {
+		switch t := v.(type) {
+		case nil:
+			result[i] = structpb.NewNullValue()
+		case bool:
+			result[i] = structpb.NewBoolValue(t)
+		case string:
+			result[i] = structpb.NewStringValue(t)
+		case float64:
+			result[i] = structpb.NewNumberValue(t)
+		case json.Number:
+			n, err := t.Float64()
+			if err != nil {
+				return nil, false
+			}
+			result[i] = structpb.NewNumberValue(n)
+		default:
+			return nil, false
+		}
+	}
+
+	return result, true
+}
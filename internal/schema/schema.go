@@ -52,6 +52,8 @@ func (vr *ValidationResult) add(errs ...ValidationError) {
 type Manager interface {
 	ValidateCheckInput(context.Context, *policyv1.Schemas, *enginev1.CheckInput) (*ValidationResult, error)
 	ValidatePlanResourcesInput(context.Context, *policyv1.Schemas, *enginev1.PlanResourcesInput) (*ValidationResult, error)
+	ResourceAttrDomains(ctx context.Context, schemas *policyv1.Schemas, kind, action string) (map[string]*AttrDomain, error)
+	AttrTypes(ctx context.Context, schemas *policyv1.Schemas, kind string) (*AttrTypes, error)
 	CheckSchema(context.Context, string) error
 }
 
@@ -73,6 +75,14 @@ func (NopManager) ValidatePlanResourcesInput(_ context.Context, _ *policyv1.Sche
 	return alwaysValidResult, nil
 }
 
+func (NopManager) ResourceAttrDomains(_ context.Context, _ *policyv1.Schemas, _, _ string) (map[string]*AttrDomain, error) {
+	return nil, nil
+}
+
+func (NopManager) AttrTypes(_ context.Context, _ *policyv1.Schemas, _ string) (*AttrTypes, error) {
+	return nil, nil
+}
+
 func (NopManager) CheckSchema(_ context.Context, _ string) error {
 	return nil
 }
@@ -94,7 +104,7 @@ func New(ctx context.Context, loader Loader) (Manager, error) {
 }
 
 func NewFromConf(_ context.Context, loader Loader, conf *Conf) Manager {
-	if conf.Enforcement == EnforcementNone {
+	if conf.enforcementDisabled() {
 		return NopManager{}
 	}
 
@@ -119,19 +129,24 @@ func (m *manager) CheckSchema(ctx context.Context, url string) error {
 
 func (m *manager) ValidateCheckInput(ctx context.Context, schemas *policyv1.Schemas, input *enginev1.CheckInput) (*ValidationResult, error) {
 	log := logging.FromContext(ctx).With(zap.Any("input", input))
-	return m.validate(ctx, log, schemas, input.Principal.Attr, input.Resource.Attr, input.Actions, nil)
+	return m.validate(ctx, log, schemas, input.Resource.Kind, input.Principal.Attr, input.Resource.Attr, input.Actions, nil)
 }
 
 func (m *manager) ValidatePlanResourcesInput(ctx context.Context, schemas *policyv1.Schemas, input *enginev1.PlanResourcesInput) (*ValidationResult, error) {
 	log := logging.FromContext(ctx).With(zap.Any("input", input))
-	return m.validate(ctx, log, schemas, input.Principal.Attr, input.Resource.Attr, []string{input.Action}, func(err *jsonschema.ValidationError) bool {
+	return m.validate(ctx, log, schemas, input.Resource.Kind, input.Principal.Attr, input.Resource.Attr, []string{input.Action}, func(err *jsonschema.ValidationError) bool {
 		// resource attributes are optional for query planning, so ignore errors from required properties
 		return !strings.HasSuffix(err.KeywordLocation, "/required")
 	})
 }
 
-func (m *manager) validate(ctx context.Context, log *zap.Logger, schemas *policyv1.Schemas, principalAttr, resourceAttr map[string]*structpb.Value, actions []string, resourceErrorFilter validationErrorFilter) (*ValidationResult, error) {
-	result := &ValidationResult{Reject: m.conf.Enforcement == EnforcementReject}
+func (m *manager) validate(ctx context.Context, log *zap.Logger, schemas *policyv1.Schemas, kind string, principalAttr, resourceAttr map[string]*structpb.Value, actions []string, resourceErrorFilter validationErrorFilter) (*ValidationResult, error) {
+	enforcement := m.conf.EnforcementFor(kind)
+	if enforcement == EnforcementNone {
+		return alwaysValidResult, nil
+	}
+
+	result := &ValidationResult{Reject: enforcement == EnforcementReject}
 	if schemas == nil {
 		return result, nil
 	}
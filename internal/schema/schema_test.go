@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/testing/protocmp"
 
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	privatev1 "github.com/cerbos/cerbos/api/genpb/cerbos/private/v1"
 	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
 	"github.com/cerbos/cerbos/internal/schema"
@@ -143,6 +144,75 @@ func TestValidate(t *testing.T) {
 	})
 }
 
+func TestValidateWithOverrides(t *testing.T) {
+	testCases := test.LoadTestCases(t, filepath.Join("schema", "test_cases"))
+
+	t.Run("enforced_by_override", func(t *testing.T) {
+		store := mkStore(t)
+		conf := schema.NewConf(schema.EnforcementNone)
+		conf.Overrides = []*schema.EnforcementOverride{
+			{KindPrefix: "leave_", Enforcement: schema.EnforcementReject},
+			{KindPrefix: "expense_", Enforcement: schema.EnforcementReject},
+		}
+		require.NoError(t, conf.Validate())
+		mgr := schema.NewFromConf(context.Background(), store, conf)
+
+		for _, tcase := range testCases {
+			tcase := tcase
+			t.Run(tcase.Name, func(t *testing.T) {
+				tc := readTestCase(t, tcase.Input)
+
+				have, err := validate(mgr, tc)
+				if tc.WantError {
+					require.Error(t, err)
+					return
+				}
+
+				require.True(t, have.Reject)
+				require.Len(t, have.Errors, len(tc.WantValidationErrors))
+			})
+		}
+	})
+
+	t.Run("disabled_by_override", func(t *testing.T) {
+		store := mkStore(t)
+		conf := schema.NewConf(schema.EnforcementReject)
+		conf.Overrides = []*schema.EnforcementOverride{
+			{KindPrefix: "leave_", Enforcement: schema.EnforcementNone},
+			{KindPrefix: "expense_", Enforcement: schema.EnforcementNone},
+		}
+		mgr := schema.NewFromConf(context.Background(), store, conf)
+
+		for _, tcase := range testCases {
+			tcase := tcase
+			t.Run(tcase.Name, func(t *testing.T) {
+				tc := readTestCase(t, tcase.Input)
+
+				have, err := validate(mgr, tc)
+				require.NoError(t, err)
+				require.False(t, have.Reject)
+				require.Empty(t, have.Errors)
+			})
+		}
+	})
+}
+
+func TestEnforcementFor(t *testing.T) {
+	conf := schema.NewConf(schema.EnforcementWarn)
+	conf.Overrides = []*schema.EnforcementOverride{
+		{KindPrefix: "billing.", Enforcement: schema.EnforcementReject},
+		{KindPrefix: "billing.legacy.", Enforcement: schema.EnforcementNone},
+	}
+	require.NoError(t, conf.Validate())
+
+	require.Equal(t, schema.EnforcementWarn, conf.EnforcementFor("leave_request"))
+	require.Equal(t, schema.EnforcementReject, conf.EnforcementFor("billing.invoice"))
+	require.Equal(t, schema.EnforcementNone, conf.EnforcementFor("billing.legacy.invoice"))
+
+	conf.Overrides = append(conf.Overrides, &schema.EnforcementOverride{KindPrefix: "hr.", Enforcement: "strict"})
+	require.Error(t, conf.Validate())
+}
+
 func validate(mgr schema.Manager, tc *privatev1.SchemaTestCase) (*schema.ValidationResult, error) {
 	switch tc.Input.(type) {
 	case *privatev1.SchemaTestCase_CheckInput:
@@ -154,6 +224,72 @@ func validate(mgr schema.Manager, tc *privatev1.SchemaTestCase) (*schema.Validat
 	}
 }
 
+func TestAttrTypes(t *testing.T) {
+	fsDir := test.PathToDir(t, filepath.Join("schema", "fs"))
+	index, err := index.Build(context.Background(), os.DirFS(fsDir))
+	require.NoError(t, err)
+
+	store := disk.NewFromIndexWithConf(index, &disk.Conf{})
+	schemas := &policyv1.Schemas{
+		PrincipalSchema: &policyv1.Schemas_Schema{Ref: "cerbos:///customer_absolute.json"},
+		ResourceSchema:  &policyv1.Schemas_Schema{Ref: "cerbos:///complex_object.json"},
+	}
+
+	t.Run("enforced", func(t *testing.T) {
+		mgr := schema.NewFromConf(context.Background(), store, schema.NewConf(schema.EnforcementWarn))
+		types, err := mgr.AttrTypes(context.Background(), schemas, "leave_request")
+		require.NoError(t, err)
+		require.NotNil(t, types)
+
+		city := types.Principal.Properties["shipping_address"].Properties["city"]
+		require.Equal(t, schema.AttrKindString, city.Kind)
+
+		require.Equal(t, schema.AttrKindNumber, types.Resource.Properties["intField"].Kind)
+		require.Equal(t, schema.AttrKindBool, types.Resource.Properties["boolField"].Kind)
+		require.Equal(t, schema.AttrKindArray, types.Resource.Properties["intList"].Kind)
+		require.Equal(t, schema.AttrKindNumber, types.Resource.Properties["intList"].Items.Kind)
+		require.Equal(t, schema.AttrKindObject, types.Resource.Properties["nestedObject"].Properties["key1"].Kind)
+		require.False(t, types.Resource.Closed)
+	})
+
+	t.Run("ignored_actions", func(t *testing.T) {
+		mgr := schema.NewFromConf(context.Background(), store, schema.NewConf(schema.EnforcementReject))
+		withIgnore := &policyv1.Schemas{
+			PrincipalSchema: schemas.PrincipalSchema,
+			ResourceSchema: &policyv1.Schemas_Schema{
+				Ref:        schemas.ResourceSchema.Ref,
+				IgnoreWhen: &policyv1.Schemas_IgnoreWhen{Actions: []string{"create"}},
+			},
+		}
+
+		types, err := mgr.AttrTypes(context.Background(), withIgnore, "leave_request")
+		require.NoError(t, err)
+		require.NotNil(t, types.Principal)
+		require.Nil(t, types.Resource)
+	})
+
+	t.Run("missing_schema", func(t *testing.T) {
+		mgr := schema.NewFromConf(context.Background(), store, schema.NewConf(schema.EnforcementReject))
+		missing := &policyv1.Schemas{
+			PrincipalSchema: schemas.PrincipalSchema,
+			ResourceSchema:  &policyv1.Schemas_Schema{Ref: "cerbos:///missing.json"},
+		}
+
+		_, err := mgr.AttrTypes(context.Background(), missing, "leave_request")
+		require.Error(t, err)
+	})
+
+	t.Run("not_enforced", func(t *testing.T) {
+		conf := schema.NewConf(schema.EnforcementReject)
+		conf.Overrides = []*schema.EnforcementOverride{{KindPrefix: "leave_", Enforcement: schema.EnforcementNone}}
+		mgr := schema.NewFromConf(context.Background(), store, conf)
+
+		types, err := mgr.AttrTypes(context.Background(), schemas, "leave_request")
+		require.NoError(t, err)
+		require.Nil(t, types)
+	})
+}
+
 func TestCache(t *testing.T) {
 	fsDir := test.PathToDir(t, filepath.Join("schema", "fs"))
 	fsys := afero.NewCopyOnWriteFs(afero.FromIOFS{FS: os.DirFS(fsDir)}, afero.NewMemMapFs())
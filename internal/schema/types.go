@@ -0,0 +1,144 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	jsonschema "github.com/santhosh-tekuri/jsonschema/v5"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+)
+
+// AttrKind is the kind of value an attribute holds.
+type AttrKind int
+
+const (
+	// AttrKindAny is used for attributes that can hold values of more than one kind or whose kind can't be determined from the schema.
+	AttrKindAny AttrKind = iota
+	AttrKindBool
+	AttrKindNumber
+	AttrKindString
+	AttrKindArray
+	AttrKindObject
+)
+
+// AttrType is the type of an attribute as defined by a schema.
+type AttrType struct {
+	// Items is the type of the elements of an array.
+	Items *AttrType
+	// Properties are the types of the known properties of an object.
+	Properties map[string]*AttrType
+	Kind       AttrKind
+	// Closed is true if the object can't have properties other than the known properties.
+	Closed bool
+}
+
+// AttrTypes are the types of the principal and resource attributes of a policy.
+// Either of them can be nil if the corresponding schema is not defined.
+type AttrTypes struct {
+	Principal *AttrType
+	Resource  *AttrType
+}
+
+// AttrTypes returns the types of the attributes defined by the schemas referenced by a policy for the given resource kind.
+// Schemas that are not enforced for all actions don't guarantee the types of the attributes, so they are ignored.
+func (m *manager) AttrTypes(ctx context.Context, schemas *policyv1.Schemas, kind string) (*AttrTypes, error) {
+	if m.conf.EnforcementFor(kind) == EnforcementNone {
+		return nil, nil
+	}
+
+	load := func(s *policyv1.Schemas_Schema) (*AttrType, error) {
+		if s == nil || s.Ref == "" || len(s.IgnoreWhen.GetActions()) > 0 {
+			return nil, nil
+		}
+
+		schema, err := m.loadSchema(ctx, s.Ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load schema %q: %w", s.Ref, err)
+		}
+
+		return attrType(schema, make(map[*jsonschema.Schema]struct{})), nil
+	}
+
+	principal, err := load(schemas.GetPrincipalSchema())
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := load(schemas.GetResourceSchema())
+	if err != nil {
+		return nil, err
+	}
+
+	if principal == nil && resource == nil {
+		return nil, nil
+	}
+
+	return &AttrTypes{Principal: principal, Resource: resource}, nil
+}
+
+func attrType(s *jsonschema.Schema, visiting map[*jsonschema.Schema]struct{}) *AttrType {
+	for s != nil && s.Ref != nil && len(s.Types) == 0 && len(s.Properties) == 0 {
+		s = s.Ref
+	}
+
+	anyType := &AttrType{Kind: AttrKindAny}
+	if s == nil || hasSubschemas(s) {
+		return anyType
+	}
+
+	// recursive schemas are cut off at the first repetition
+	if _, ok := visiting[s]; ok {
+		return anyType
+	}
+	visiting[s] = struct{}{}
+	defer delete(visiting, s)
+
+	kind := ""
+	switch len(s.Types) {
+	case 0:
+		if len(s.Properties) == 0 {
+			return anyType
+		}
+		kind = "object"
+	case 1:
+		kind = s.Types[0]
+	default:
+		return anyType
+	}
+
+	switch kind {
+	case "boolean":
+		return &AttrType{Kind: AttrKindBool}
+	case "integer", "number":
+		return &AttrType{Kind: AttrKindNumber}
+	case "string":
+		return &AttrType{Kind: AttrKindString}
+	case "array":
+		items := s.Items2020
+		if items == nil {
+			items, _ = s.Items.(*jsonschema.Schema)
+		}
+		return &AttrType{Kind: AttrKindArray, Items: attrType(items, visiting)}
+	case "object":
+		t := &AttrType{Kind: AttrKindObject, Properties: make(map[string]*AttrType, len(s.Properties))}
+		for name, prop := range s.Properties {
+			t.Properties[name] = attrType(prop, visiting)
+		}
+
+		if additional, ok := s.AdditionalProperties.(bool); ok && !additional {
+			t.Closed = len(s.PatternProperties) == 0 && s.UnevaluatedProperties == nil
+		}
+		return t
+	default:
+		return anyType
+	}
+}
+
+// hasSubschemas returns true if the type of a value depends on subschemas that are combined with the schema.
+func hasSubschemas(s *jsonschema.Schema) bool {
+	return len(s.AllOf) > 0 || len(s.AnyOf) > 0 || len(s.OneOf) > 0 || s.If != nil || s.DynamicRef != nil || s.RecursiveRef != nil
+}
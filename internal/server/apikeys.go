@@ -0,0 +1,291 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/yaml.v3"
+
+	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
+	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/svc"
+)
+
+const apiKeyAuditPrefix = "cerbos.api_key."
+
+var (
+	errAPIKeyRequired    = svc.NewError(codes.Unauthenticated, svc.ErrCodeAuthenticationFailed, "API key required")
+	errAPIKeyInvalid     = svc.NewError(codes.Unauthenticated, svc.ErrCodeAuthenticationFailed, "invalid API key")
+	errAPIKeyWrongTenant = svc.NewError(codes.PermissionDenied, svc.ErrCodePermissionDenied, "API key is not allowed to access this tenant")
+)
+
+// apiKey is an API key that has been validated and hashed.
+type apiKey struct {
+	// auditMetadata holds the ID and the metadata of the key in the format of the audit logs.
+	auditMetadata map[string]*auditv1.MetaValues
+	id            string
+	// hash is the hex-encoded SHA-256 hash of the key. It identifies the client for rate limiting.
+	hash string
+	// tenant is the tenant the key is bound to. It's empty if the key can only be used with the default store.
+	tenant string
+}
+
+// apiKeySet maps the SHA-256 hashes of the API keys to the keys.
+// Keys are looked up by their hash so that the comparison doesn't depend on how much of the presented key is correct.
+type apiKeySet map[[sha256.Size]byte]*apiKey
+
+func newAPIKeySet(keys []*APIKeyConf) (apiKeySet, error) {
+	set := make(apiKeySet, len(keys))
+	ids := make(map[string]struct{}, len(keys))
+	if err := set.add(keys, ids); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+func (s apiKeySet) add(keys []*APIKeyConf, ids map[string]struct{}) error {
+	for i, k := range keys {
+		if k == nil || k.ID == "" {
+			return fmt.Errorf("key %d: id is required", i)
+		}
+
+		if _, ok := ids[k.ID]; ok {
+			return fmt.Errorf("key %q: duplicate id", k.ID)
+		}
+		ids[k.ID] = struct{}{}
+
+		var hash [sha256.Size]byte
+		switch {
+		case k.Key != "" && k.Hash != "":
+			return fmt.Errorf("key %q: only one of key or hash can be set", k.ID)
+		case k.Key != "":
+			hash = sha256.Sum256([]byte(k.Key))
+		case k.Hash != "":
+			decoded, err := hex.DecodeString(k.Hash)
+			if err != nil || len(decoded) != sha256.Size {
+				return fmt.Errorf("key %q: hash must be a hex-encoded SHA-256 hash", k.ID)
+			}
+			copy(hash[:], decoded)
+		default:
+			return fmt.Errorf("key %q: key or hash is required", k.ID)
+		}
+
+		if _, ok := s[hash]; ok {
+			return fmt.Errorf("key %q: the same key is defined more than once", k.ID)
+		}
+
+		md := make(map[string]*auditv1.MetaValues, len(k.Metadata)+1)
+		for name, value := range k.Metadata {
+			md[apiKeyAuditPrefix+name] = &auditv1.MetaValues{Values: []string{value}}
+		}
+		md[apiKeyAuditPrefix+"id"] = &auditv1.MetaValues{Values: []string{k.ID}}
+
+		s[hash] = &apiKey{id: k.ID, hash: hex.EncodeToString(hash[:]), tenant: k.Tenant, auditMetadata: md}
+	}
+
+	return nil
+}
+
+type apiKeysFile struct {
+	Keys []*APIKeyConf `yaml:"keys"`
+}
+
+// apiKeyAuth authenticates requests to the Cerbos API using the keys defined in the configuration and the keys file.
+type apiKeyAuth struct {
+	keys atomic.Pointer[apiKeySet]
+	log  *zap.Logger
+	// metadataKey is the header name in the form used by gRPC metadata.
+	metadataKey string
+	conf        APIKeysConf
+}
+
+func newAPIKeyAuth(ctx context.Context, conf APIKeysConf) (*apiKeyAuth, error) {
+	a := &apiKeyAuth{
+		log:         zap.L().Named("api-keys"),
+		conf:        conf,
+		metadataKey: strings.ToLower(conf.Header),
+	}
+
+	if err := a.load(); err != nil {
+		return nil, err
+	}
+
+	if conf.File != "" {
+		go a.reload(ctx)
+	}
+
+	return a, nil
+}
+
+func (a *apiKeyAuth) load() error {
+	keys, err := newAPIKeySet(a.conf.Keys)
+	if err != nil {
+		return fmt.Errorf("invalid API keys: %w", err)
+	}
+
+	if a.conf.File != "" {
+		contents, err := os.ReadFile(a.conf.File)
+		if err != nil {
+			return fmt.Errorf("failed to read API keys file: %w", err)
+		}
+
+		var f apiKeysFile
+		if err := yaml.Unmarshal(contents, &f); err != nil {
+			return fmt.Errorf("failed to parse API keys file: %w", err)
+		}
+
+		ids := make(map[string]struct{}, len(keys)+len(f.Keys))
+		for _, k := range keys {
+			ids[k.id] = struct{}{}
+		}
+
+		if err := keys.add(f.Keys, ids); err != nil {
+			return fmt.Errorf("invalid API keys file: %w", err)
+		}
+	}
+
+	a.keys.Store(&keys)
+	return nil
+}
+
+// reload periodically reloads the keys file. The previous keys remain in effect if the file can't be loaded.
+func (a *apiKeyAuth) reload(ctx context.Context) {
+	ticker := time.NewTicker(a.conf.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := a.load(); err != nil {
+				a.log.Error("Failed to reload API keys", zap.Error(err))
+			}
+		}
+	}
+}
+
+// lookup returns the key that matches the given value.
+func (a *apiKeyAuth) lookup(value string) (*apiKey, bool) {
+	if value == "" {
+		return nil, false
+	}
+
+	key, ok := (*a.keys.Load())[sha256.Sum256([]byte(value))]
+	return key, ok
+}
+
+// authenticate checks the API key of the request. It returns a context that doesn't include the API key in the request
+// metadata, so that it's never written to the audit logs, and adds the ID and metadata of the key to the audit logs instead.
+// If the key is bound to a tenant, the returned context is bound to that tenant.
+// The verified key can be retrieved from the returned context using authenticatedAPIKey.
+func (a *apiKeyAuth) authenticate(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, errAPIKeyRequired
+	}
+
+	values := md.Get(a.metadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return nil, errAPIKeyRequired
+	}
+
+	key, ok := a.lookup(values[0])
+	if !ok {
+		return nil, errAPIKeyInvalid
+	}
+
+	md = md.Copy()
+	delete(md, a.metadataKey)
+
+	if key.tenant != "" {
+		if tenants := md.Get(svc.TenantHeader); len(tenants) > 0 && tenants[0] != key.tenant {
+			return nil, errAPIKeyWrongTenant
+		}
+
+		// the request might already be bound to a tenant by the SPIFFE ID of the caller
+		if tenant, ok := svc.TenantFromContext(ctx); ok && tenant != key.tenant {
+			return nil, errAPIKeyWrongTenant
+		}
+		ctx = svc.ContextWithTenant(ctx, key.tenant)
+	}
+
+	ctx = metadata.NewIncomingContext(ctx, md)
+	ctx = context.WithValue(ctx, apiKeyCtxKey{}, key)
+
+	return audit.ContextWithMetadata(ctx, key.auditMetadata), nil
+}
+
+type apiKeyCtxKey struct{}
+
+// authenticatedAPIKey returns the API key that the request was authenticated with.
+func authenticatedAPIKey(ctx context.Context) (*apiKey, bool) {
+	key, ok := ctx.Value(apiKeyCtxKey{}).(*apiKey)
+	return key, ok
+}
+
+// apiKeyRequired returns true for the methods of the Cerbos API. ServerInfo is exempt because it's as harmless as a health check.
+func apiKeyRequired(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+svcv1.CerbosService_ServiceDesc.ServiceName+"/") &&
+		fullMethod != svcv1.CerbosService_ServerInfo_FullMethodName
+}
+
+func (a *apiKeyAuth) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !apiKeyRequired(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		authCtx, err := a.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(authCtx, req)
+	}
+}
+
+func (a *apiKeyAuth) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !apiKeyRequired(info.FullMethod) {
+			return handler(srv, stream)
+		}
+
+		authCtx, err := a.authenticate(stream.Context())
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = authCtx
+		return handler(srv, wrapped)
+	}
+}
+
+// headerMatcher forwards the API key header of HTTP requests to the gRPC server.
+func (a *apiKeyAuth) headerMatcher() runtime.HeaderMatcherFunc {
+	return func(key string) (string, bool) {
+		if strings.EqualFold(key, a.conf.Header) {
+			return a.metadataKey, true
+		}
+
+		return runtime.DefaultHeaderMatcher(key)
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/svc"
+)
+
+func TestAPIKeySet(t *testing.T) {
+	hash := sha256.Sum256([]byte("secret"))
+
+	testCases := []struct {
+		name    string
+		keys    []*APIKeyConf
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			keys: []*APIKeyConf{
+				{ID: "a", Key: "secret"},
+				{ID: "b", Hash: hex.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))},
+			},
+		},
+		{name: "missing_id", keys: []*APIKeyConf{{Key: "secret"}}, wantErr: true},
+		{name: "missing_key", keys: []*APIKeyConf{{ID: "a"}}, wantErr: true},
+		{name: "key_and_hash", keys: []*APIKeyConf{{ID: "a", Key: "secret", Hash: hex.EncodeToString(hash[:])}}, wantErr: true},
+		{name: "invalid_hash", keys: []*APIKeyConf{{ID: "a", Hash: "secret"}}, wantErr: true},
+		{name: "duplicate_id", keys: []*APIKeyConf{{ID: "a", Key: "secret"}, {ID: "a", Key: "other"}}, wantErr: true},
+		{name: "duplicate_key", keys: []*APIKeyConf{{ID: "a", Key: "secret"}, {ID: "b", Hash: hex.EncodeToString(hash[:])}}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newAPIKeySet(tc.keys)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAPIKeyAuth(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	hash := sha256.Sum256([]byte("hashed-secret"))
+	keysFile := filepath.Join(t.TempDir(), "api_keys.yaml")
+	require.NoError(t, os.WriteFile(keysFile, []byte("keys:\n  - id: from-file\n    key: file-secret\n"), 0o600))
+
+	a, err := newAPIKeyAuth(ctx, APIKeysConf{
+		Enabled: true,
+		Header:  "X-API-Key",
+		File:    keysFile,
+		Keys: []*APIKeyConf{
+			{ID: "static", Key: "static-secret", Metadata: map[string]string{"team": "billing"}},
+			{ID: "hashed", Hash: hex.EncodeToString(hash[:])},
+			{ID: "tenant", Key: "tenant-secret", Tenant: "acme"},
+		},
+		ReloadInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	const method = "/cerbos.svc.v1.CerbosService/CheckResources"
+	interceptor := a.UnaryServerInterceptor()
+	invoke := func(method string, md ...string) (context.Context, error) {
+		var handlerCtx context.Context
+		_, err := interceptor(metadata.NewIncomingContext(ctx, metadata.Pairs(md...)), nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, _ any) (any, error) {
+			handlerCtx = ctx
+			return nil, nil
+		})
+		return handlerCtx, err
+	}
+
+	requireAuthFailure := func(t *testing.T, err error) {
+		t.Helper()
+
+		st := status.Convert(err)
+		require.Equal(t, codes.Unauthenticated, st.Code())
+		require.Equal(t, svc.ErrCodeAuthenticationFailed, svc.ErrorCodeOf(st))
+	}
+
+	t.Run("valid_keys", func(t *testing.T) {
+		for _, key := range []string{"static-secret", "hashed-secret", "file-secret"} {
+			_, err := invoke(method, "x-api-key", key)
+			require.NoError(t, err, key)
+		}
+	})
+
+	t.Run("invalid_key", func(t *testing.T) {
+		_, err := invoke(method, "x-api-key", "wrong")
+		requireAuthFailure(t, err)
+	})
+
+	t.Run("missing_key", func(t *testing.T) {
+		_, err := invoke(method)
+		requireAuthFailure(t, err)
+	})
+
+	t.Run("exempt_methods", func(t *testing.T) {
+		for _, m := range []string{svcv1.CerbosService_ServerInfo_FullMethodName, "/cerbos.svc.v1.CerbosAdminService/ListPolicies", "/grpc.health.v1.Health/Check"} {
+			_, err := invoke(m)
+			require.NoError(t, err, m)
+		}
+	})
+
+	t.Run("audit_metadata", func(t *testing.T) {
+		handlerCtx, err := invoke(method, "x-api-key", "static-secret", "foo", "bar")
+		require.NoError(t, err)
+
+		md, _ := metadata.FromIncomingContext(handlerCtx)
+		require.Empty(t, md.Get("x-api-key"), "API key should be removed from the request metadata")
+		require.Equal(t, []string{"bar"}, md.Get("foo"))
+
+		have := audit.NewMetadataExtractorFromConf(&audit.Conf{})(handlerCtx)
+		require.Equal(t, []string{"static"}, have["cerbos.api_key.id"].Values)
+		require.Equal(t, []string{"billing"}, have["cerbos.api_key.team"].Values)
+	})
+
+	t.Run("tenant", func(t *testing.T) {
+		for _, md := range [][]string{{"x-api-key", "tenant-secret"}, {"x-api-key", "tenant-secret", "cerbos-tenant", "acme"}} {
+			handlerCtx, err := invoke(method, md...)
+			require.NoError(t, err)
+
+			tenant, ok := svc.TenantFromContext(handlerCtx)
+			require.True(t, ok)
+			require.Equal(t, "acme", tenant)
+		}
+
+		_, err := invoke(method, "x-api-key", "tenant-secret", "cerbos-tenant", "globex")
+		st := status.Convert(err)
+		require.Equal(t, codes.PermissionDenied, st.Code())
+		require.Equal(t, svc.ErrCodePermissionDenied, svc.ErrorCodeOf(st))
+
+		// keys that are not bound to a tenant don't bind the request to the tenant named by the header
+		handlerCtx, err := invoke(method, "x-api-key", "static-secret", "cerbos-tenant", "globex")
+		require.NoError(t, err)
+		_, ok := svc.TenantFromContext(handlerCtx)
+		require.False(t, ok)
+
+		// the key can't be used by a caller whose SPIFFE ID is bound to a different tenant
+		_, err = a.authenticate(metadata.NewIncomingContext(svc.ContextWithTenant(ctx, "globex"), metadata.Pairs("x-api-key", "tenant-secret")))
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("reloads_file", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(keysFile, []byte("keys:\n  - id: rotated\n    key: rotated-secret\n"), 0o600))
+		require.Eventually(t, func() bool {
+			_, err := invoke(method, "x-api-key", "rotated-secret")
+			return err == nil
+		}, 5*time.Second, 10*time.Millisecond)
+
+		_, err := invoke(method, "x-api-key", "file-secret")
+		requireAuthFailure(t, err)
+
+		// keys from the configuration are not affected by the file
+		_, err = invoke(method, "x-api-key", "static-secret")
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,256 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/codes"
+
+	"github.com/cerbos/cerbos/internal/svc"
+)
+
+const (
+	encodingGzip     = "gzip"
+	encodingIdentity = "identity"
+	encodingZstd     = "zstd"
+)
+
+// encoder is implemented by both the gzip and the zstd writers.
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(io.Writer)
+}
+
+var encoderPools = map[string]*sync.Pool{
+	encodingGzip: {New: func() any { return gzip.NewWriter(io.Discard) }},
+	encodingZstd: {New: func() any {
+		// NewWriter only fails if the options are invalid.
+		enc, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		return enc
+	}},
+}
+
+// withCompression compresses the responses of the HTTP API with the encoding preferred by the client and decompresses
+// the compressed requests if HTTP compression is enabled.
+func (s *Server) withCompression(gwmux *runtime.ServeMux, h http.Handler) http.Handler {
+	if !s.conf.HTTPCompression.Enabled {
+		return h
+	}
+
+	return compressionHandler(s.conf.HTTPCompression, gwmux, h)
+}
+
+func compressionHandler(conf HTTPCompressionConf, gwmux *runtime.ServeMux, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := decompressRequest(w, r, int64(conf.MaxRequestBytes)); err != nil {
+			_, marshaler := runtime.MarshalerForRequest(gwmux, r)
+			handleHTTPError(r.Context(), gwmux, marshaler, w, r, err)
+			return
+		}
+
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		encoding := negotiateEncoding(r.Header.Values("Accept-Encoding"))
+		if encoding == "" {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		cw := &compressWriter{ResponseWriter: w, encoding: encoding, minSize: int(conf.MinSizeBytes)}
+		defer cw.close()
+
+		h.ServeHTTP(cw, r)
+	})
+}
+
+// decompressRequest replaces the body of a compressed request with the decompressed body, which is limited to maxBytes.
+func decompressRequest(w http.ResponseWriter, r *http.Request, maxBytes int64) error {
+	encoding := strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding")))
+
+	var body io.ReadCloser
+	switch encoding {
+	case "", encodingIdentity:
+		return nil
+	case encodingGzip:
+		zr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return svc.NewErrorf(codes.InvalidArgument, svc.ErrCodeInvalidRequest, "invalid gzip request body: %v", err)
+		}
+		body = zr
+	case encodingZstd:
+		zr, err := zstd.NewReader(r.Body, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(uint64(maxBytes)))
+		if err != nil {
+			return svc.NewErrorf(codes.InvalidArgument, svc.ErrCodeInvalidRequest, "invalid zstd request body: %v", err)
+		}
+		body = zr.IOReadCloser()
+	default:
+		return svc.NewErrorf(codes.InvalidArgument, svc.ErrCodeInvalidRequest, "unsupported content encoding %q: use gzip or zstd", encoding)
+	}
+
+	r.Body = http.MaxBytesReader(w, body, maxBytes)
+	r.ContentLength = -1
+	r.Header.Del("Content-Encoding")
+	r.Header.Del("Content-Length")
+
+	return nil
+}
+
+// negotiateEncoding returns the encoding with the highest weight in the Accept-Encoding header or an empty string
+// if the client doesn't accept any of the supported encodings. zstd is preferred over gzip if they have the same weight.
+func negotiateEncoding(acceptEncoding []string) string {
+	weights := make(map[string]float64)
+	for _, header := range acceptEncoding {
+		for _, entry := range strings.Split(header, ",") {
+			name, params, _ := strings.Cut(entry, ";")
+			weight := 1.0
+			for _, param := range strings.Split(params, ";") {
+				if value, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+					if w, err := strconv.ParseFloat(value, 64); err == nil {
+						weight = w
+					}
+				}
+			}
+			weights[strings.ToLower(strings.TrimSpace(name))] = weight
+		}
+	}
+
+	best, bestWeight := "", 0.0
+	for _, encoding := range []string{encodingZstd, encodingGzip} {
+		weight, ok := weights[encoding]
+		if !ok {
+			weight, ok = weights["*"]
+		}
+
+		if ok && weight > bestWeight {
+			best, bestWeight = encoding, weight
+		}
+	}
+
+	return best
+}
+
+// compressWriter compresses the response if it's at least minSize bytes long. The start of the response is buffered
+// until it reaches minSize, the handler flushes the response or the handler returns, whichever happens first.
+type compressWriter struct {
+	http.ResponseWriter
+	// encoder is nil until the response is known to be compressed.
+	encoder  encoder
+	encoding string
+	buf      []byte
+	minSize  int
+	status   int
+	started  bool
+}
+
+func (cw *compressWriter) WriteHeader(status int) {
+	if cw.started {
+		cw.ResponseWriter.WriteHeader(status)
+		return
+	}
+
+	if cw.status == 0 {
+		cw.status = status
+	}
+}
+
+func (cw *compressWriter) Write(p []byte) (int, error) {
+	if !cw.started {
+		cw.buf = append(cw.buf, p...)
+		if len(cw.buf) < cw.minSize {
+			return len(p), nil
+		}
+
+		if err := cw.start(true); err != nil {
+			return 0, err
+		}
+
+		return len(p), nil
+	}
+
+	if cw.encoder != nil {
+		return cw.encoder.Write(p)
+	}
+
+	return cw.ResponseWriter.Write(p)
+}
+
+// Flush sends the data written so far to the client. Streamed responses are compressed regardless of their size
+// because their final size is unknown when the first message is flushed.
+func (cw *compressWriter) Flush() {
+	if !cw.started {
+		if err := cw.start(len(cw.buf) > 0); err != nil {
+			return
+		}
+	}
+
+	if cw.encoder != nil {
+		if err := cw.encoder.Flush(); err != nil {
+			return
+		}
+	}
+
+	_ = http.NewResponseController(cw.ResponseWriter).Flush()
+}
+
+func (cw *compressWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
+
+// start writes the response header and the buffered data, compressing it if compress is true and the response can be compressed.
+func (cw *compressWriter) start(compress bool) error {
+	cw.started = true
+
+	status := cw.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	header := cw.Header()
+	if compress && status != http.StatusNoContent && status != http.StatusNotModified && header.Get("Content-Encoding") == "" {
+		header.Set("Content-Encoding", cw.encoding)
+		header.Del("Content-Length")
+
+		cw.encoder = encoderPools[cw.encoding].Get().(encoder) //nolint:forcetypeassert
+		cw.encoder.Reset(cw.ResponseWriter)
+	}
+
+	cw.ResponseWriter.WriteHeader(status)
+
+	buf := cw.buf
+	cw.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+
+	if cw.encoder != nil {
+		_, err := cw.encoder.Write(buf)
+		return err
+	}
+
+	_, err := cw.ResponseWriter.Write(buf)
+	return err
+}
+
+// close sends the rest of the response after the handler returns.
+func (cw *compressWriter) close() {
+	if !cw.started {
+		_ = cw.start(false)
+	}
+
+	if cw.encoder != nil {
+		_ = cw.encoder.Close()
+		cw.encoder.Reset(io.Discard)
+		encoderPools[cw.encoding].Put(cw.encoder)
+		cw.encoder = nil
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	testCases := []struct {
+		acceptEncoding string
+		want           string
+	}{
+		{acceptEncoding: "", want: ""},
+		{acceptEncoding: "identity", want: ""},
+		{acceptEncoding: "br, deflate", want: ""},
+		{acceptEncoding: "gzip", want: encodingGzip},
+		{acceptEncoding: "GZIP", want: encodingGzip},
+		{acceptEncoding: "zstd", want: encodingZstd},
+		{acceptEncoding: "gzip, deflate, br, zstd", want: encodingZstd},
+		{acceptEncoding: "zstd;q=0.5, gzip;q=0.8", want: encodingGzip},
+		{acceptEncoding: "zstd;q=0, gzip", want: encodingGzip},
+		{acceptEncoding: "*", want: encodingZstd},
+		{acceptEncoding: "zstd;q=0, *", want: encodingGzip},
+		{acceptEncoding: "*;q=0", want: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.acceptEncoding, func(t *testing.T) {
+			require.Equal(t, tc.want, negotiateEncoding([]string{tc.acceptEncoding}))
+		})
+	}
+}
+
+func TestCompressionHandler(t *testing.T) {
+	conf := HTTPCompressionConf{Enabled: true, MinSizeBytes: 64, MaxRequestBytes: 1024}
+	largeBody := strings.Repeat(`{"effect":"EFFECT_ALLOW"}`, 100)
+
+	decode := func(t *testing.T, rec *httptest.ResponseRecorder) string {
+		t.Helper()
+
+		var r io.Reader
+		switch rec.Header().Get("Content-Encoding") {
+		case encodingGzip:
+			zr, err := gzip.NewReader(rec.Body)
+			require.NoError(t, err)
+			r = zr
+		case encodingZstd:
+			zr, err := zstd.NewReader(rec.Body)
+			require.NoError(t, err)
+			defer zr.Close()
+			r = zr
+		default:
+			r = rec.Body
+		}
+
+		have, err := io.ReadAll(r)
+		require.NoError(t, err)
+		return string(have)
+	}
+
+	respond := func(body string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(w, body)
+		})
+	}
+
+	do := func(h http.Handler, req *http.Request) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		compressionHandler(conf, runtime.NewServeMux(), h).ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("compress_response", func(t *testing.T) {
+		for _, encoding := range []string{encodingGzip, encodingZstd} {
+			t.Run(encoding, func(t *testing.T) {
+				req := httptest.NewRequest(http.MethodPost, "/api/plan/resources", nil)
+				req.Header.Set("Accept-Encoding", encoding)
+
+				rec := do(respond(largeBody), req)
+				require.Equal(t, http.StatusOK, rec.Code)
+				require.Equal(t, encoding, rec.Header().Get("Content-Encoding"))
+				require.Equal(t, "Accept-Encoding", rec.Header().Get("Vary"))
+				require.Less(t, rec.Body.Len(), len(largeBody))
+				require.Equal(t, largeBody, decode(t, rec))
+			})
+		}
+	})
+
+	t.Run("small_response", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/check/resources", nil)
+		req.Header.Set("Accept-Encoding", encodingGzip)
+
+		rec := do(respond(`{}`), req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Empty(t, rec.Header().Get("Content-Encoding"))
+		require.Equal(t, `{}`, rec.Body.String())
+	})
+
+	t.Run("not_accepted", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/plan/resources", nil)
+
+		rec := do(respond(largeBody), req)
+		require.Empty(t, rec.Header().Get("Content-Encoding"))
+		require.Equal(t, largeBody, rec.Body.String())
+	})
+
+	t.Run("status_code", func(t *testing.T) {
+		h := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, largeBody)
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/plan/resources", nil)
+		req.Header.Set("Accept-Encoding", encodingZstd)
+
+		rec := do(h, req)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Equal(t, encodingZstd, rec.Header().Get("Content-Encoding"))
+		require.Equal(t, largeBody, decode(t, rec))
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		flushed := make(chan string, 1)
+		h := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, `{"result":{}}`)
+			w.(http.Flusher).Flush() //nolint:forcetypeassert
+			flushed <- w.Header().Get("Content-Encoding")
+			_, _ = io.WriteString(w, `{"result":{}}`)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/watch", nil)
+		req.Header.Set("Accept-Encoding", encodingGzip)
+
+		rec := do(h, req)
+		require.Equal(t, encodingGzip, <-flushed)
+		require.True(t, rec.Flushed)
+		require.Equal(t, `{"result":{}}{"result":{}}`, decode(t, rec))
+	})
+
+	t.Run("decompress_request", func(t *testing.T) {
+		compress := map[string]func(*testing.T, []byte) []byte{
+			encodingGzip: func(t *testing.T, data []byte) []byte {
+				t.Helper()
+
+				buf := new(bytes.Buffer)
+				zw := gzip.NewWriter(buf)
+				_, err := zw.Write(data)
+				require.NoError(t, err)
+				require.NoError(t, zw.Close())
+				return buf.Bytes()
+			},
+			encodingZstd: func(t *testing.T, data []byte) []byte {
+				t.Helper()
+
+				zw, err := zstd.NewWriter(nil)
+				require.NoError(t, err)
+				return zw.EncodeAll(data, nil)
+			},
+		}
+
+		echo := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusRequestEntityTooLarge)
+				return
+			}
+
+			require.Empty(t, r.Header.Get("Content-Encoding"))
+			_, _ = w.Write(body)
+		})
+
+		for encoding, compressFn := range compress {
+			t.Run(encoding, func(t *testing.T) {
+				req := httptest.NewRequest(http.MethodPost, "/api/check/resources", bytes.NewReader(compressFn(t, []byte(`{"requestId":"test"}`))))
+				req.Header.Set("Content-Encoding", encoding)
+
+				rec := do(echo, req)
+				require.Equal(t, http.StatusOK, rec.Code)
+				require.Equal(t, `{"requestId":"test"}`, rec.Body.String())
+			})
+
+			t.Run(encoding+"_too_large", func(t *testing.T) {
+				req := httptest.NewRequest(http.MethodPost, "/api/check/resources", bytes.NewReader(compressFn(t, []byte(largeBody))))
+				req.Header.Set("Content-Encoding", encoding)
+
+				rec := do(echo, req)
+				require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+			})
+		}
+	})
+
+	t.Run("invalid_request", func(t *testing.T) {
+		testCases := map[string]string{
+			"br":         "compressed",
+			encodingGzip: "not gzip",
+		}
+
+		for encoding, body := range testCases {
+			t.Run(encoding, func(t *testing.T) {
+				req := httptest.NewRequest(http.MethodPost, "/api/check/resources", strings.NewReader(body))
+				req.Header.Set("Content-Encoding", encoding)
+
+				rec := do(respond(`{}`), req)
+				require.Equal(t, http.StatusBadRequest, rec.Code)
+			})
+		}
+	})
+}
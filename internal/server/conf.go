@@ -7,39 +7,63 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"go.uber.org/multierr"
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/observability/logging"
+	"github.com/cerbos/cerbos/internal/storage/blob"
 	"github.com/cerbos/cerbos/internal/util"
 )
 
 const (
-	confKey                        = "server"
-	defaultAdminPassword           = "cerbosAdmin"
-	defaultAdminUsername           = "cerbos"
-	defaultGRPCConnectionTimeout   = 60 * time.Second
-	defaultGRPCListenAddr          = ":3593"
-	defaultGRPCMaxConnectionAge    = 10 * time.Minute
-	defaultGRPCMaxRecvMsgSizeBytes = 4 * 1024 * 1024 // 4MiB
-	defaultHTTPIdleTimeout         = 120 * time.Second
-	defaultHTTPListenAddr          = ":3592"
-	defaultHTTPReadHeaderTimeout   = 15 * time.Second
-	defaultHTTPReadTimeout         = 30 * time.Second
-	defaultHTTPWriteTimeout        = 30 * time.Second
-	defaultMaxActionsPerResource   = 50
-	defaultMaxResourcesPerRequest  = 50
-	defaultRawAdminPasswordHash    = "$2y$10$VlPwcwpgcGZ5KjTaN1Pzk.vpFiQVG6F2cSWzQa9RtrNo3IacbzsEi" //nolint:gosec
-	defaultUDSFileMode             = "0o766"
-	requestItemsMax                = 500
+	confKey                         = "server"
+	defaultAdminPassword            = "cerbosAdmin"
+	defaultAdminUsername            = "cerbos"
+	defaultAPIKeyHeader             = "X-API-Key"
+	defaultAPIKeysReloadInterval    = 30 * time.Second
+	defaultAdminOIDCRoleClaim       = "roles"
+	defaultDecisionCacheMaxEntries  = 10000
+	defaultDecisionCacheTTL         = 30 * time.Second
+	defaultDrainTimeout             = 30 * time.Second
+	defaultGRPCConnectionTimeout    = 60 * time.Second
+	defaultGRPCListenAddr           = ":3593"
+	defaultGRPCMaxConnectionAge     = 10 * time.Minute
+	defaultGRPCMaxRecvMsgSizeBytes  = 4 * 1024 * 1024 // 4MiB
+	defaultHTTPCompressionMaxReq    = 16 * 1024 * 1024
+	defaultHTTPCompressionMinSize   = 1024
+	defaultHTTPIdleTimeout          = 120 * time.Second
+	defaultHTTPListenAddr           = ":3592"
+	defaultHTTPReadHeaderTimeout    = 15 * time.Second
+	defaultHTTPReadTimeout          = 30 * time.Second
+	defaultHTTPWriteTimeout         = 30 * time.Second
+	defaultMaxActionsPerResource    = 50
+	defaultMaxResourcesPerRequest   = 50
+	defaultMaxPlanEntriesPerReq     = 100
+	defaultMaxPoliciesPerReq        = 10
+	defaultMaxPolicyBytesPerReq     = 256 * 1024
+	defaultMaxAttrBytesPerReq       = 1024 * 1024
+	defaultMaxAuxDataBytesPerReq    = 16 * 1024
+	defaultMaxConcurrentRequests    = 1000
+	defaultLoadSheddingQueueTimeout = 100 * time.Millisecond
+	defaultLoadSheddingRetryAfter   = 1 * time.Second
+	defaultRateLimitBurst           = 200
+	defaultRateLimitRequestsPerSec  = 100
+	defaultRawAdminPasswordHash     = "$2y$10$VlPwcwpgcGZ5KjTaN1Pzk.vpFiQVG6F2cSWzQa9RtrNo3IacbzsEi" //nolint:gosec
+	defaultSnapshotRequestTimeout   = 60 * time.Second
+	defaultUDSFileMode              = "0o766"
+	requestItemsMax                 = 500
 )
 
 var (
 	defaultAdminPasswordHash = base64.StdEncoding.EncodeToString([]byte(defaultRawAdminPasswordHash))
 	errAdminCredsUndefined   = errors.New("admin credentials not defined")
+	errNoConcurrencyLimit    = errors.New("loadShedding.maxConcurrentRequests must be greater than zero when load shedding is enabled")
 )
 
 // Conf is required configuration for the server.
@@ -48,22 +72,46 @@ type Conf struct {
 	TLS *TLSConf `yaml:"tls"`
 	// AdminAPI defines the admin API configuration.
 	AdminAPI AdminAPIConf `yaml:"adminAPI"`
+	// APIKeys defines the API keys that clients must present to use the Cerbos API.
+	APIKeys APIKeysConf `yaml:"apiKeys"`
 	// HTTPListenAddr is the dedicated HTTP address.
 	HTTPListenAddr string `yaml:"httpListenAddr" conf:"required,example=\":3592\""`
 	// GRPCListenAddr is the dedicated GRPC address.
 	GRPCListenAddr string `yaml:"grpcListenAddr" conf:"required,example=\":3593\""`
+	// HTTP3 defines whether the HTTP API is also served over HTTP/3 (QUIC).
+	HTTP3 HTTP3Conf `yaml:"http3"`
+	// HTTPCompression defines how the responses of the HTTP API are compressed and how compressed requests are decompressed.
+	HTTPCompression HTTPCompressionConf `yaml:"httpCompression"`
 	// UDSFileMode sets the file mode of the unix domain sockets created by the server.
 	UDSFileMode string `yaml:"udsFileMode" conf:",example=0o766"`
-	// CORS defines the CORS configuration for the server.
+	// LogLevel sets the minimum level of the log messages (debug, info, warn or error). Takes precedence over the --log-level flag and the CERBOS_LOG_LEVEL environment variable when set.
+	LogLevel string `yaml:"logLevel" conf:",example=info"`
+	// CORS defines the CORS configuration of the HTTP listener. It also applies to the requests received over HTTP/3.
 	CORS CORSConf `yaml:"cors"`
 	// RequestLimits defines the limits for requests.
 	RequestLimits RequestLimitsConf `yaml:"requestLimits"`
+	// LoadShedding defines how requests are rejected when the server is overloaded.
+	LoadShedding LoadSheddingConf `yaml:"loadShedding"`
+	// DecisionCache defines how the decisions of check requests are cached.
+	DecisionCache DecisionCacheConf `yaml:"decisionCache"`
+	// RateLimits defines how many requests each client is allowed to make.
+	RateLimits RateLimitsConf `yaml:"rateLimits"`
+	// Drain defines how the server waits for the requests in progress to complete when it shuts down.
+	Drain DrainConf `yaml:"drain"`
+	// SPIFFE defines which workloads are allowed to use each API, based on the SPIFFE IDs of their TLS client certificates.
+	SPIFFE SPIFFEConf `yaml:"spiffe"`
 	// MetricsEnabled defines whether the metrics endpoint is enabled.
 	MetricsEnabled bool `yaml:"metricsEnabled" conf:",example=true"`
 	// LogRequestPayloads defines whether the request payloads should be logged.
 	LogRequestPayloads bool `yaml:"logRequestPayloads" conf:",example=false"`
 	// PlaygroundEnabled defines whether the playground API is enabled.
 	PlaygroundEnabled bool `yaml:"playgroundEnabled" conf:",ignore"`
+	// WatchDecisionsEnabled defines whether the experimental WatchDecisions API is enabled.
+	WatchDecisionsEnabled bool `yaml:"watchDecisionsEnabled" conf:",example=false"`
+	// RequestPoliciesEnabled defines whether CheckResources requests can include ad-hoc policies that are layered over the policy store for the duration of the request.
+	RequestPoliciesEnabled bool `yaml:"requestPoliciesEnabled" conf:",example=false"`
+	// GraphQLEnabled defines whether the GraphQL endpoint for checks, plans and policy introspection is enabled at /api/graphql.
+	GraphQLEnabled bool `yaml:"graphqlEnabled" conf:",example=false"`
 	// Advanced server settings.
 	Advanced AdvancedConf `yaml:"advanced"`
 }
@@ -83,10 +131,16 @@ type CORSConf struct {
 	AllowedOrigins []string `yaml:"allowedOrigins" conf:",example=['*']"`
 	// AllowedHeaders is the contents of the allowed-headers header.
 	AllowedHeaders []string `yaml:"allowedHeaders" conf:",example=['content-type']"`
-	// Disabled sets whether CORS is disabled.
-	Disabled bool `yaml:"disabled" conf:",example=false"`
+	// AllowedMethods is the contents of the allowed-methods header. Defaults to HEAD, GET, POST, PUT, PATCH and DELETE.
+	AllowedMethods []string `yaml:"allowedMethods" conf:",example=['GET', 'POST']"`
+	// ExposedHeaders is the contents of the expose-headers header, which lists the response headers that browsers make available to scripts.
+	ExposedHeaders []string `yaml:"exposedHeaders" conf:",example=['cerbos-store-revision']"`
 	// MaxAge is the max age of the CORS preflight check.
 	MaxAge time.Duration `yaml:"maxAge" conf:",example=10s"`
+	// AllowCredentials sets whether browsers are allowed to send credentials such as cookies and client certificates with cross-origin requests. Requires allowedOrigins to list the allowed origins.
+	AllowCredentials bool `yaml:"allowCredentials" conf:",example=false"`
+	// Disabled sets whether CORS is disabled.
+	Disabled bool `yaml:"disabled" conf:",example=false"`
 }
 
 type AdminAPIConf struct {
@@ -94,6 +148,70 @@ type AdminAPIConf struct {
 	AdminCredentials *AdminCredentialsConf `yaml:"adminCredentials"`
 	// Enabled defines whether the admin API is enabled.
 	Enabled bool `yaml:"enabled" conf:",example=true"`
+	// StoreSyncWebhookSecret is the secret of the GitHub or GitLab webhooks that call /admin/store/sync to make the store pull changes immediately. Requests signed with the secret are accepted in addition to requests authenticated with the admin credentials.
+	StoreSyncWebhookSecret string `yaml:"storeSyncWebhookSecret" conf:",example=${CERBOS_WEBHOOK_SECRET}"`
+	// Snapshots defines the bucket that store snapshots are written to and restored from. The SnapshotStore and RestoreStore methods are unavailable if it's not set.
+	Snapshots *SnapshotsConf `yaml:"snapshots"`
+	// OIDC defines the OpenID Connect provider that issues bearer tokens for the admin API. Bearer tokens are accepted in addition to the admin credentials.
+	OIDC *AdminOIDCConf `yaml:"oidc"`
+}
+
+type AdminOIDCConf struct {
+	// Issuer is the URL of the OpenID Connect provider. It must match the iss claim of the tokens.
+	Issuer string `yaml:"issuer" conf:"required,example=\"https://auth.example.com/realms/acme\""`
+	// JWKSURL is the URL of the keys used to sign the tokens. It's discovered from the issuer if it's not set.
+	JWKSURL string `yaml:"jwksURL" conf:",example=\"https://auth.example.com/realms/acme/protocol/openid-connect/certs\""`
+	// RoleClaim is the claim that holds the roles of the user. Nested claims are separated by dots. Defaults to roles.
+	RoleClaim string `yaml:"roleClaim" conf:",example=realm_access.roles"`
+	// UserClaim is the claim that identifies the user in the audit logs and the store change history. Defaults to the sub claim.
+	UserClaim string `yaml:"userClaim" conf:",example=email"`
+	// Audience is the list of accepted audiences. The aud claim of the tokens must contain one of them.
+	Audience []string `yaml:"audience" conf:"required,example=[\"cerbos\"]"`
+	// Roles is the list of roles that are allowed to use the admin API. Any valid token is accepted if it's empty.
+	Roles []string `yaml:"roles" conf:",example=[\"cerbos-admin\"]"`
+	// DisableBasicAuth rejects the admin credentials so that only bearer tokens are accepted.
+	DisableBasicAuth bool `yaml:"disableBasicAuth" conf:",example=false"`
+}
+
+type APIKeysConf struct {
+	// Header sets the name of the header (or gRPC metadata key) that holds the API key.
+	Header string `yaml:"header" conf:",example=X-API-Key"`
+	// File is the path to an optional YAML file that defines more keys under a top-level keys field. The file is reloaded periodically so that keys can be added or revoked without restarting the server.
+	File string `yaml:"file" conf:",example=/path/to/api_keys.yaml"`
+	// Keys defines the API keys. Use environment variable references such as ${APP_API_KEY} to keep the keys out of the configuration file.
+	Keys []*APIKeyConf `yaml:"keys"`
+	// ReloadInterval sets how often the keys file is reloaded.
+	ReloadInterval time.Duration `yaml:"reloadInterval" conf:",example=30s"`
+	// Enabled defines whether requests to the Cerbos API (checks, plans and resource kinds) must include a valid API key. The Admin API is authenticated separately with the admin credentials.
+	Enabled bool `yaml:"enabled" conf:",example=false"`
+}
+
+type APIKeyConf struct {
+	// Metadata is added to the audit log entries of the requests made with the key.
+	Metadata map[string]string `yaml:"metadata" conf:",example={\"team\": \"billing\"}"`
+	// ID identifies the key in the audit logs.
+	ID string `yaml:"id" conf:"required,example=billing-service"`
+	// Key is the API key. Either key or hash must be set.
+	Key string `yaml:"key" conf:",example=${BILLING_API_KEY}"`
+	// Hash is the hex-encoded SHA-256 hash of the API key. Either key or hash must be set.
+	Hash string `yaml:"hash" conf:",example=9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	// Tenant binds the key to a tenant. Requests made with the key are evaluated using the policies of the tenant and are rejected if they name a different one. Keys without a tenant can only use the default store.
+	Tenant string `yaml:"tenant" conf:",example=acme"`
+}
+
+type SnapshotsConf struct {
+	// Bucket URL (Examples: s3://my-bucket?region=us-west-1 gs://my-bucket azblob://my-container).
+	Bucket string `yaml:"bucket" conf:",example=\"s3://my-bucket-name?region=us-east-2\""`
+	// Prefix specifies a subdirectory of the bucket to keep the snapshots in.
+	Prefix string `yaml:"prefix" conf:",example=snapshots"`
+	// RequestTimeout specifies the timeout for an HTTP request to the bucket. Defaults to 60s.
+	RequestTimeout time.Duration `yaml:"requestTimeout" conf:",example=60s"`
+	// S3 holds the settings that only apply to S3 buckets. Set s3.kmsKeyID to encrypt the snapshots with a KMS key.
+	S3 *blob.S3Conf `yaml:"s3,omitempty"`
+	// GCS holds the settings that only apply to Google Cloud Storage buckets. Set gcs.kmsKeyName to encrypt the snapshots with a customer-managed key.
+	GCS *blob.GCSConf `yaml:"gcs,omitempty"`
+	// Azure holds the settings that only apply to Azure Blob Storage containers.
+	Azure *blob.AzureConf `yaml:"azure,omitempty"`
 }
 
 type AdminCredentialsConf struct {
@@ -132,6 +250,110 @@ type RequestLimitsConf struct {
 	MaxActionsPerResource uint `yaml:"maxActionsPerResource" conf:",example=50"`
 	// MaxResourcesPerBatch sets the maximum number of resources that could be sent in a single request.
 	MaxResourcesPerRequest uint `yaml:"maxResourcesPerRequest" conf:",example=50"`
+	// MaxPlanEntriesPerRequest sets the maximum number of entries that could be sent in a single PlanResourcesStream request.
+	MaxPlanEntriesPerRequest uint `yaml:"maxPlanEntriesPerRequest" conf:",example=100"`
+	// MaxPoliciesPerRequest sets the maximum number of ad-hoc policies that could be sent in a single CheckResources request.
+	MaxPoliciesPerRequest uint `yaml:"maxPoliciesPerRequest" conf:",example=10"`
+	// MaxPolicyBytesPerRequest sets the maximum combined size in bytes of the ad-hoc policies that could be sent in a single CheckResources request.
+	MaxPolicyBytesPerRequest uint `yaml:"maxPolicyBytesPerRequest" conf:",example=262144"`
+	// MaxAttributeBytesPerRequest sets the maximum combined size in bytes of the principal and resource attributes that could be sent in a single request.
+	MaxAttributeBytesPerRequest uint `yaml:"maxAttributeBytesPerRequest" conf:",example=1048576"`
+	// MaxAuxDataBytesPerRequest sets the maximum size in bytes of the auxData (such as the JWT) that could be sent in a single request.
+	MaxAuxDataBytesPerRequest uint `yaml:"maxAuxDataBytesPerRequest" conf:",example=16384"`
+}
+
+type LoadSheddingConf struct {
+	// Limits sets the maximum number of concurrent requests of each priority class. Defaults to MaxConcurrentRequests for checks, half of it for plans and a quarter of it for admin requests.
+	Limits LoadSheddingLimitsConf `yaml:"limits"`
+	// Enabled defines whether requests are rejected when the concurrency limits are reached. Health checks are never rejected.
+	Enabled bool `yaml:"enabled" conf:",example=false"`
+	// MaxConcurrentRequests sets the maximum number of requests processed concurrently across all priority classes.
+	MaxConcurrentRequests uint `yaml:"maxConcurrentRequests" conf:",example=1000"`
+	// QueueTimeout sets how long a request waits for capacity before it is rejected.
+	QueueTimeout time.Duration `yaml:"queueTimeout" conf:",example=100ms"`
+	// RetryAfter sets the delay that rejected clients are advised to wait before retrying.
+	RetryAfter time.Duration `yaml:"retryAfter" conf:",example=1s"`
+}
+
+type LoadSheddingLimitsConf struct {
+	// Check sets the maximum number of concurrent CheckResources, CheckResourceSet and CheckResourceBatch requests.
+	Check uint `yaml:"check" conf:",example=1000"`
+	// Plan sets the maximum number of concurrent PlanResources and PlanResourcesStream requests.
+	Plan uint `yaml:"plan" conf:",example=500"`
+	// Admin sets the maximum number of concurrent Admin API, Playground API and ListResourceKinds requests.
+	Admin uint `yaml:"admin" conf:",example=250"`
+}
+
+type DecisionCacheConf struct {
+	// Enabled defines whether the decisions of check requests are cached. Cached decisions are discarded when the policies change.
+	Enabled bool `yaml:"enabled" conf:",example=false"`
+	// MaxEntries sets the maximum number of decisions to cache.
+	MaxEntries uint `yaml:"maxEntries" conf:",example=10000"`
+	// TTL sets how long a decision is cached for. Decisions of conditions that depend on the current time or on lookups can be stale for up to this duration.
+	TTL time.Duration `yaml:"ttl" conf:",example=30s"`
+}
+
+type RateLimitsConf struct {
+	// Key defines how clients are identified. Valid values are sourceIP, clientCert (subject of the verified TLS client certificate) and apiKey (the API key verified using the apiKeys configuration). Requests without a verified client certificate or a valid API key are limited by their source IP address.
+	Key RateLimitKey `yaml:"key" conf:",example=sourceIP"`
+	// Overrides sets different limits for specific clients.
+	Overrides []*RateLimitOverride `yaml:"overrides"`
+	// RequestsPerSecond sets the rate at which each client is allowed to make requests.
+	RequestsPerSecond float64 `yaml:"requestsPerSecond" conf:",example=100"`
+	// Burst sets the maximum number of requests a client is allowed to make at once.
+	Burst uint `yaml:"burst" conf:",example=200"`
+	// Enabled defines whether requests are rate limited per client. Health checks are never rate limited.
+	Enabled bool `yaml:"enabled" conf:",example=false"`
+}
+
+type DrainConf struct {
+	// Delay sets how long the server keeps accepting requests after it starts failing readiness probes, to give load balancers time to stop sending requests to it.
+	Delay time.Duration `yaml:"delay" conf:",example=5s"`
+	// Timeout sets how long the server waits for the requests in progress to complete before aborting them.
+	Timeout time.Duration `yaml:"timeout" conf:",example=30s"`
+}
+
+type RateLimitOverride struct {
+	// Client is the source IP address, client certificate subject or hex-encoded SHA-256 hash of the API key of the client, depending on the configured key.
+	Client string `yaml:"client" conf:"required,example=\"10.0.0.1\""`
+	// RequestsPerSecond sets the rate at which the client is allowed to make requests.
+	RequestsPerSecond float64 `yaml:"requestsPerSecond" conf:"required,example=1000"`
+	// Burst sets the maximum number of requests the client is allowed to make at once.
+	Burst uint `yaml:"burst" conf:"required,example=2000"`
+}
+
+type HTTP3Conf struct {
+	// ListenAddr is the UDP address to receive HTTP/3 requests on. Defaults to the same address as httpListenAddr.
+	ListenAddr string `yaml:"listenAddr" conf:",example=\":3592\""`
+	// Enabled defines whether HTTP/3 requests are accepted in addition to HTTP/1.1 and HTTP/2 requests. Responses to HTTP/1.1 and HTTP/2 requests advertise HTTP/3 with the Alt-Svc header. Requires TLS.
+	Enabled bool `yaml:"enabled" conf:",example=false"`
+}
+
+type HTTPCompressionConf struct {
+	// MinSizeBytes sets the minimum size of the responses that are compressed. Smaller responses are sent uncompressed. Streamed responses are always compressed.
+	MinSizeBytes uint `yaml:"minSizeBytes" conf:",example=1024"`
+	// MaxRequestBytes sets the maximum size of a compressed request body after decompression. Defaults to 16MiB.
+	MaxRequestBytes uint `yaml:"maxRequestBytes" conf:",example=16777216"`
+	// Enabled defines whether responses are compressed with gzip or zstd if the client accepts it (zstd is preferred) and whether requests compressed with gzip or zstd are accepted.
+	Enabled bool `yaml:"enabled" conf:",example=false"`
+}
+
+type SPIFFEConf struct {
+	// Tenants binds callers to tenants by their SPIFFE IDs. Requests from a SPIFFE ID that matches one of the patterns of a tenant are evaluated using the policies of the tenant. Callers that don't match any tenant can only use the default store.
+	Tenants map[string][]string `yaml:"tenants" conf:",example={\"acme\": [\"spiffe://example.org/ns/acme/sa/*\"]}"`
+	// AllowedIDs defines the patterns of the SPIFFE IDs that are allowed to use each API. An API without patterns can't be used by any caller. In patterns, * matches a single path segment.
+	AllowedIDs SPIFFEAllowedIDsConf `yaml:"allowedIDs"`
+	// Enabled defines whether callers are authorized by the SPIFFE ID of their TLS client certificate. Requires tls.caCert to be set. Health checks are never authorized.
+	Enabled bool `yaml:"enabled" conf:",example=false"`
+}
+
+type SPIFFEAllowedIDsConf struct {
+	// API is the list of SPIFFE ID patterns that are allowed to use the Cerbos API, including the GraphQL endpoint.
+	API []string `yaml:"api" conf:",example=[\"spiffe://example.org/ns/*/sa/*\"]"`
+	// Admin is the list of SPIFFE ID patterns that are allowed to use the admin API.
+	Admin []string `yaml:"admin" conf:",example=[\"spiffe://example.org/ns/cerbos/sa/admin\"]"`
+	// Playground is the list of SPIFFE ID patterns that are allowed to use the playground API.
+	Playground []string `yaml:"playground" conf:",ignore"`
 }
 
 type AdvancedConf struct {
@@ -171,8 +393,38 @@ func (c *Conf) SetDefaults() {
 	c.MetricsEnabled = true
 	c.UDSFileMode = defaultUDSFileMode
 	c.RequestLimits = RequestLimitsConf{
-		MaxActionsPerResource:  defaultMaxActionsPerResource,
-		MaxResourcesPerRequest: defaultMaxResourcesPerRequest,
+		MaxActionsPerResource:       defaultMaxActionsPerResource,
+		MaxResourcesPerRequest:      defaultMaxResourcesPerRequest,
+		MaxPlanEntriesPerRequest:    defaultMaxPlanEntriesPerReq,
+		MaxPoliciesPerRequest:       defaultMaxPoliciesPerReq,
+		MaxPolicyBytesPerRequest:    defaultMaxPolicyBytesPerReq,
+		MaxAttributeBytesPerRequest: defaultMaxAttrBytesPerReq,
+		MaxAuxDataBytesPerRequest:   defaultMaxAuxDataBytesPerReq,
+	}
+	c.LoadShedding = LoadSheddingConf{
+		MaxConcurrentRequests: defaultMaxConcurrentRequests,
+		QueueTimeout:          defaultLoadSheddingQueueTimeout,
+		RetryAfter:            defaultLoadSheddingRetryAfter,
+	}
+	c.DecisionCache = DecisionCacheConf{
+		MaxEntries: defaultDecisionCacheMaxEntries,
+		TTL:        defaultDecisionCacheTTL,
+	}
+	c.APIKeys = APIKeysConf{
+		Header:         defaultAPIKeyHeader,
+		ReloadInterval: defaultAPIKeysReloadInterval,
+	}
+	c.RateLimits = RateLimitsConf{
+		Key:               RateLimitKeySourceIP,
+		RequestsPerSecond: defaultRateLimitRequestsPerSec,
+		Burst:             defaultRateLimitBurst,
+	}
+	c.Drain = DrainConf{
+		Timeout: defaultDrainTimeout,
+	}
+	c.HTTPCompression = HTTPCompressionConf{
+		MinSizeBytes:    defaultHTTPCompressionMinSize,
+		MaxRequestBytes: defaultHTTPCompressionMaxReq,
 	}
 
 	if c.AdminAPI.AdminCredentials == nil {
@@ -212,6 +464,12 @@ func (c *Conf) Validate() (errs error) {
 		errs = multierr.Append(errs, fmt.Errorf("invalid udsFileMode %q", c.UDSFileMode))
 	}
 
+	if c.LogLevel != "" {
+		if _, err := logging.ParseLevel(c.LogLevel); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid logLevel: %w", err))
+		}
+	}
+
 	if c.RequestLimits.MaxActionsPerResource < 1 || c.RequestLimits.MaxActionsPerResource > requestItemsMax {
 		errs = multierr.Append(errs, fmt.Errorf("maxActionsPerResource must be between 1 and %d", requestItemsMax))
 	}
@@ -220,6 +478,214 @@ func (c *Conf) Validate() (errs error) {
 		errs = multierr.Append(errs, fmt.Errorf("maxResourcesPerRequest must be between 1 and %d", requestItemsMax))
 	}
 
+	if c.RequestLimits.MaxPlanEntriesPerRequest < 1 || c.RequestLimits.MaxPlanEntriesPerRequest > requestItemsMax {
+		errs = multierr.Append(errs, fmt.Errorf("maxPlanEntriesPerRequest must be between 1 and %d", requestItemsMax))
+	}
+
+	if c.RequestLimits.MaxPoliciesPerRequest < 1 || c.RequestLimits.MaxPoliciesPerRequest > requestItemsMax {
+		errs = multierr.Append(errs, fmt.Errorf("maxPoliciesPerRequest must be between 1 and %d", requestItemsMax))
+	}
+
+	if c.RequestLimits.MaxPolicyBytesPerRequest < 1 {
+		errs = multierr.Append(errs, errors.New("maxPolicyBytesPerRequest must be greater than 0"))
+	}
+
+	if c.RequestLimits.MaxAttributeBytesPerRequest < 1 {
+		errs = multierr.Append(errs, errors.New("maxAttributeBytesPerRequest must be greater than 0"))
+	}
+
+	if c.RequestLimits.MaxAuxDataBytesPerRequest < 1 {
+		errs = multierr.Append(errs, errors.New("maxAuxDataBytesPerRequest must be greater than 0"))
+	}
+
+	if c.LoadShedding.Enabled && c.LoadShedding.MaxConcurrentRequests == 0 {
+		errs = multierr.Append(errs, errNoConcurrencyLimit)
+	}
+
+	if c.DecisionCache.Enabled {
+		if c.DecisionCache.MaxEntries < 1 {
+			errs = multierr.Append(errs, errors.New("decisionCache.maxEntries must be greater than zero"))
+		}
+
+		if c.DecisionCache.TTL <= 0 {
+			errs = multierr.Append(errs, errors.New("decisionCache.ttl must be greater than zero"))
+		}
+	}
+
+	if !c.CORS.Disabled {
+		errs = multierr.Append(errs, c.CORS.validate())
+	}
+
+	if c.RateLimits.Enabled {
+		errs = multierr.Append(errs, c.RateLimits.validate(c.APIKeys))
+	}
+
+	if c.Drain.Delay < 0 {
+		errs = multierr.Append(errs, errors.New("drain.delay must not be negative"))
+	}
+
+	if c.Drain.Timeout <= 0 {
+		errs = multierr.Append(errs, errors.New("drain.timeout must be greater than zero"))
+	}
+
+	if c.APIKeys.Enabled {
+		errs = multierr.Append(errs, c.APIKeys.validate())
+	}
+
+	if c.HTTP3.Enabled {
+		errs = multierr.Append(errs, c.validateHTTP3())
+	}
+
+	if c.HTTPCompression.Enabled && c.HTTPCompression.MaxRequestBytes == 0 {
+		errs = multierr.Append(errs, errors.New("httpCompression.maxRequestBytes must be greater than zero"))
+	}
+
+	if c.SPIFFE.Enabled {
+		errs = multierr.Append(errs, c.SPIFFE.validate(c.TLS))
+	}
+
+	if oc := c.AdminAPI.OIDC; oc != nil {
+		if oc.Issuer == "" {
+			errs = multierr.Append(errs, errors.New("adminAPI.oidc.issuer is required"))
+		}
+
+		if len(oc.Audience) == 0 {
+			errs = multierr.Append(errs, errors.New("adminAPI.oidc.audience is required"))
+		}
+
+		if oc.RoleClaim == "" {
+			oc.RoleClaim = defaultAdminOIDCRoleClaim
+		}
+	}
+
+	if sc := c.AdminAPI.Snapshots; sc != nil {
+		if sc.Bucket == "" {
+			errs = multierr.Append(errs, errors.New("adminAPI.snapshots.bucket is required"))
+		}
+
+		if sc.RequestTimeout == 0 {
+			sc.RequestTimeout = defaultSnapshotRequestTimeout
+		}
+
+		if err := sc.S3.Validate(); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid adminAPI.snapshots configuration: %w", err))
+		}
+
+		if err := sc.GCS.Validate(); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid adminAPI.snapshots configuration: %w", err))
+		}
+
+		if err := sc.Azure.Validate(); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid adminAPI.snapshots configuration: %w", err))
+		}
+	}
+
+	return errs
+}
+
+func (cc CORSConf) validate() (errs error) {
+	for _, method := range cc.AllowedMethods {
+		if method == "" || strings.ContainsAny(method, " ,") {
+			errs = multierr.Append(errs, fmt.Errorf("invalid cors.allowedMethods entry %q", method))
+		}
+	}
+
+	if cc.MaxAge < 0 {
+		errs = multierr.Append(errs, errors.New("cors.maxAge must not be negative"))
+	}
+
+	if cc.AllowCredentials && (len(cc.AllowedOrigins) == 0 || slices.Contains(cc.AllowedOrigins, "*")) {
+		errs = multierr.Append(errs, errors.New("cors.allowedOrigins must list the allowed origins when cors.allowCredentials is true"))
+	}
+
+	return errs
+}
+
+func (ac APIKeysConf) validate() (errs error) {
+	if ac.Header == "" {
+		errs = multierr.Append(errs, errors.New("apiKeys.header is required"))
+	}
+
+	if ac.File == "" && len(ac.Keys) == 0 {
+		errs = multierr.Append(errs, errors.New("apiKeys.keys or apiKeys.file must be set when API keys are enabled"))
+	}
+
+	if ac.File != "" && ac.ReloadInterval <= 0 {
+		errs = multierr.Append(errs, errors.New("apiKeys.reloadInterval must be greater than zero"))
+	}
+
+	if _, err := newAPIKeySet(ac.Keys); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("invalid apiKeys.keys: %w", err))
+	}
+
+	return errs
+}
+
+func (c *Conf) validateHTTP3() (errs error) {
+	if c.TLS == nil || c.TLS.Cert == "" || c.TLS.Key == "" {
+		errs = multierr.Append(errs, errors.New("tls.cert and tls.key are required when HTTP/3 is enabled"))
+	}
+
+	if c.HTTP3.ListenAddr == "" {
+		c.HTTP3.ListenAddr = c.HTTPListenAddr
+	}
+
+	network, _, err := util.ParseListenAddress(c.HTTP3.ListenAddr)
+	if err != nil {
+		return multierr.Append(errs, fmt.Errorf("invalid http3.listenAddr '%s': %w", c.HTTP3.ListenAddr, err))
+	}
+
+	if network != "tcp" {
+		errs = multierr.Append(errs, fmt.Errorf("invalid http3.listenAddr '%s': must be a host and port", c.HTTP3.ListenAddr))
+	}
+
+	return errs
+}
+
+func (sc SPIFFEConf) validate(tlsConf *TLSConf) (errs error) {
+	if tlsConf == nil || tlsConf.CACert == "" {
+		errs = multierr.Append(errs, errors.New("tls.caCert is required when SPIFFE authorization is enabled"))
+	}
+
+	errs = multierr.Append(errs, validateSPIFFEPatterns("spiffe.allowedIDs.api", sc.AllowedIDs.API))
+	errs = multierr.Append(errs, validateSPIFFEPatterns("spiffe.allowedIDs.admin", sc.AllowedIDs.Admin))
+	errs = multierr.Append(errs, validateSPIFFEPatterns("spiffe.allowedIDs.playground", sc.AllowedIDs.Playground))
+	for tenant, patterns := range sc.Tenants {
+		errs = multierr.Append(errs, validateSPIFFEPatterns("spiffe.tenants."+tenant, patterns))
+	}
+
+	return errs
+}
+
+func (rc RateLimitsConf) validate(apiKeys APIKeysConf) (errs error) {
+	if err := rc.Key.validate(); err != nil {
+		errs = multierr.Append(errs, err)
+	}
+
+	if rc.Key == RateLimitKeyAPIKey && !apiKeys.Enabled {
+		errs = multierr.Append(errs, errors.New("apiKeys must be enabled when rateLimits.key is apiKey"))
+	}
+
+	if rc.RequestsPerSecond <= 0 || rc.Burst < 1 {
+		errs = multierr.Append(errs, errors.New("rateLimits.requestsPerSecond and rateLimits.burst must be greater than zero"))
+	}
+
+	seen := make(map[string]struct{}, len(rc.Overrides))
+	for i, o := range rc.Overrides {
+		if o.Client == "" {
+			errs = multierr.Append(errs, fmt.Errorf("rateLimits.overrides[%d].client is required", i))
+		}
+
+		if _, ok := seen[o.Client]; ok {
+			errs = multierr.Append(errs, fmt.Errorf("rateLimits.overrides[%d]: duplicate client %q", i, o.Client))
+		}
+		seen[o.Client] = struct{}{}
+
+		if o.RequestsPerSecond <= 0 || o.Burst < 1 {
+			errs = multierr.Append(errs, fmt.Errorf("rateLimits.overrides[%d]: requestsPerSecond and burst must be greater than zero", i))
+		}
+	}
+
 	return errs
 }
 
@@ -120,6 +120,213 @@ func TestConfigValidate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "maxAttributeBytesPerRequest is zero",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr": ":6666",
+					"grpcListenAddr": ":6667",
+					"requestLimits": map[string]any{
+						"maxAttributeBytesPerRequest": "0",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "maxAuxDataBytesPerRequest is zero",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr": ":6666",
+					"grpcListenAddr": ":6667",
+					"requestLimits": map[string]any{
+						"maxAuxDataBytesPerRequest": "0",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "decisionCache ttl is zero",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr": ":6666",
+					"grpcListenAddr": ":6667",
+					"decisionCache": map[string]any{
+						"enabled": true,
+						"ttl":     "0s",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "apiKeys without keys",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr": ":6666",
+					"grpcListenAddr": ":6667",
+					"apiKeys": map[string]any{
+						"enabled": true,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "apiKeys with invalid hash",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr": ":6666",
+					"grpcListenAddr": ":6667",
+					"apiKeys": map[string]any{
+						"enabled": true,
+						"keys": []map[string]any{
+							{"id": "app", "hash": "not-a-hash"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "adminAPI oidc without issuer",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr": ":6666",
+					"grpcListenAddr": ":6667",
+					"adminAPI": map[string]any{
+						"enabled": true,
+						"oidc": map[string]any{
+							"audience": []string{"cerbos"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "http3 without tls",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr": ":6666",
+					"grpcListenAddr": ":6667",
+					"http3": map[string]any{
+						"enabled": true,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "httpCompression without maxRequestBytes",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr": ":6666",
+					"grpcListenAddr": ":6667",
+					"httpCompression": map[string]any{
+						"enabled":         true,
+						"maxRequestBytes": 0,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cors allowCredentials with all origins",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr": ":6666",
+					"grpcListenAddr": ":6667",
+					"cors": map[string]any{
+						"allowCredentials": true,
+						"allowedOrigins":   []string{"*"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cors invalid allowedMethods",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr": ":6666",
+					"grpcListenAddr": ":6667",
+					"cors": map[string]any{
+						"allowedMethods": []string{"GET, POST"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown logLevel",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr": ":6666",
+					"grpcListenAddr": ":6667",
+					"logLevel":       "verbose",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "drain timeout is zero",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr": ":6666",
+					"grpcListenAddr": ":6667",
+					"drain": map[string]any{
+						"timeout": "0s",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rateLimits with unknown key",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr": ":6666",
+					"grpcListenAddr": ":6667",
+					"rateLimits": map[string]any{
+						"enabled": true,
+						"key":     "userAgent",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rateLimits by apiKey without apiKeys",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr": ":6666",
+					"grpcListenAddr": ":6667",
+					"rateLimits": map[string]any{
+						"enabled": true,
+						"key":     "apiKey",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rateLimits override without burst",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr": ":6666",
+					"grpcListenAddr": ":6667",
+					"rateLimits": map[string]any{
+						"enabled": true,
+						"overrides": []map[string]any{
+							{"client": "10.0.0.1", "requestsPerSecond": 1000},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
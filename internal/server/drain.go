@@ -0,0 +1,132 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// requestTracker counts the requests in progress so that the server can wait for them to complete when it shuts down.
+// Requests forwarded by the HTTP gateway are only counted by the HTTP server, and health checks are not counted.
+type requestTracker struct {
+	gatewayToken string
+	inFlight     atomic.Int64
+	drained      atomic.Int64
+	aborted      atomic.Int64
+	draining     atomic.Bool
+}
+
+func newRequestTracker(gatewayToken string) *requestTracker {
+	return &requestTracker{gatewayToken: gatewayToken}
+}
+
+// track marks the start of a request and returns the function to call when it completes.
+func (rt *requestTracker) track() func() {
+	rt.inFlight.Add(1)
+	return func() {
+		rt.inFlight.Add(-1)
+		if rt.draining.Load() {
+			rt.drained.Add(1)
+		}
+	}
+}
+
+func (rt *requestTracker) trackGRPC(ctx context.Context, fullMethod string) bool {
+	return !strings.HasPrefix(fullMethod, "/grpc.") && !fromGateway(ctx, rt.gatewayToken)
+}
+
+func (rt *requestTracker) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if rt.trackGRPC(ctx, info.FullMethod) {
+			defer rt.track()()
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (rt *requestTracker) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if rt.trackGRPC(stream.Context(), info.FullMethod) {
+			defer rt.track()()
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+// httpHandler counts the HTTP requests. gRPC requests sent to the HTTP port are counted by the gRPC interceptors instead.
+func (rt *requestTracker) httpHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != healthEndpoint && !isGRPCRequest(r) {
+			defer rt.track()()
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+func isGRPCRequest(r *http.Request) bool {
+	return r.ProtoMajor == 2 && strings.Contains(r.Header.Get("Content-Type"), "application/grpc")
+}
+
+// drain shuts down the servers gracefully. The server starts failing readiness probes straight away and keeps accepting
+// requests for the drain delay, so that load balancers have time to stop routing traffic to it. It then stops accepting
+// requests and waits for the requests in progress to complete until the drain timeout, after which they are aborted.
+func (s *Server) drain(grpcServer *grpc.Server, httpServer *http.Server) {
+	log := zap.L().Named("server")
+
+	s.tracker.draining.Store(true)
+	// mark this service as NOT_SERVING in the gRPC health check.
+	s.health.Shutdown()
+
+	if delay := s.conf.Drain.Delay; delay > 0 {
+		log.Info("Waiting before draining requests", zap.Duration("delay", delay))
+		time.Sleep(delay)
+	}
+
+	log.Info("Draining requests", zap.Int64("in_flight", s.tracker.inFlight.Load()), zap.Duration("timeout", s.conf.Drain.Timeout))
+	ctx, cancelFunc := context.WithTimeout(context.Background(), s.conf.Drain.Timeout)
+	defer cancelFunc()
+
+	// The HTTP server is shut down first because the HTTP gateway forwards requests to the gRPC server.
+	log.Debug("Shutting down HTTP server")
+	if err := httpServer.Shutdown(ctx); err != nil {
+		log.Debug("HTTP server did not shut down cleanly", zap.Error(err))
+	}
+
+	log.Debug("Shutting down gRPC server")
+	grpcStopped := make(chan struct{})
+	go func() {
+		defer close(grpcStopped)
+		grpcServer.GracefulStop()
+	}()
+
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+	}
+
+	if ctx.Err() != nil {
+		// requests that complete from now on are aborted rather than drained
+		s.tracker.draining.Store(false)
+		s.tracker.aborted.Store(s.tracker.inFlight.Load())
+		log.Warn("Drain timeout reached: aborting requests in progress", zap.Int64("in_flight", s.tracker.aborted.Load()))
+
+		if err := httpServer.Close(); err != nil {
+			log.Error("Failed to close HTTP server", zap.Error(err))
+		}
+		grpcServer.Stop()
+		<-grpcStopped
+	}
+
+	log.Info("Drain complete", zap.Int64("drained", s.tracker.drained.Load()), zap.Int64("aborted", s.tracker.aborted.Load()))
+}
@@ -0,0 +1,112 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestDrain(t *testing.T) {
+	testCases := []struct {
+		name         string
+		release      time.Duration
+		wantDrained  int64
+		wantAborted  int64
+		drainTimeout time.Duration
+	}{
+		{name: "completes", release: 50 * time.Millisecond, drainTimeout: 5 * time.Second, wantDrained: 1},
+		{name: "times_out", release: 5 * time.Second, drainTimeout: 50 * time.Millisecond, wantAborted: 1},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			conf := defaultConf()
+			conf.Drain = DrainConf{Timeout: tc.drainTimeout}
+
+			s := NewServer(conf)
+			s.tracker = newRequestTracker("")
+
+			grpcL, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+
+			grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(s.tracker.UnaryServerInterceptor()))
+			healthpb.RegisterHealthServer(grpcServer, health.NewServer())
+			go func() { _ = grpcServer.Serve(grpcL) }()
+
+			httpL, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+
+			started := make(chan struct{})
+			httpServer := &http.Server{ //nolint:gosec
+				Handler: s.tracker.httpHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					close(started)
+					select {
+					case <-time.After(tc.release):
+						w.WriteHeader(http.StatusOK)
+					case <-r.Context().Done():
+					}
+				})),
+			}
+			go func() { _ = httpServer.Serve(httpL) }()
+
+			go func() {
+				resp, err := http.Get("http://" + httpL.Addr().String() + "/api/check/resources") //nolint:noctx
+				if err == nil {
+					resp.Body.Close()
+				}
+			}()
+
+			<-started
+			require.Equal(t, int64(1), s.tracker.inFlight.Load())
+
+			s.drain(grpcServer, httpServer)
+
+			require.Equal(t, tc.wantDrained, s.tracker.drained.Load())
+			require.Equal(t, tc.wantAborted, s.tracker.aborted.Load())
+
+			// readiness probes fail as soon as the drain starts
+			resp, err := s.health.Check(context.Background(), &healthpb.HealthCheckRequest{})
+			require.NoError(t, err)
+			require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.Status)
+		})
+	}
+}
+
+func TestRequestTracker(t *testing.T) {
+	rt := newRequestTracker("token")
+	interceptor := rt.UnaryServerInterceptor()
+
+	invoke := func(method string) {
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, any) (any, error) {
+			require.Equal(t, int64(1), rt.inFlight.Load(), method)
+			return nil, nil
+		})
+		require.NoError(t, err)
+	}
+
+	invoke("/cerbos.svc.v1.CerbosService/CheckResources")
+	require.Equal(t, int64(0), rt.inFlight.Load())
+	require.Equal(t, int64(0), rt.drained.Load())
+
+	rt.draining.Store(true)
+	invoke("/cerbos.svc.v1.CerbosService/CheckResources")
+	require.Equal(t, int64(1), rt.drained.Load())
+
+	// health checks are not counted
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}, func(context.Context, any) (any, error) {
+		require.Equal(t, int64(0), rt.inFlight.Load())
+		return nil, nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,107 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net/textproto"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cerbos/cerbos/internal/svc"
+)
+
+const (
+	// gatewayTokenKey is the metadata key used to mark the requests that the HTTP gateway forwards to the gRPC server.
+	// Those requests have already been rate limited and authorized by the HTTP server.
+	gatewayTokenKey = "cerbos-gateway-token"
+	// gatewayTenantKey is the metadata key used by the HTTP gateway to forward the tenant that the HTTP server bound
+	// the request to. It's only trusted in requests that carry the gateway token.
+	gatewayTenantKey = "cerbos-gateway-tenant"
+	gatewayTokenLen  = 16
+)
+
+// newGatewayToken generates a random token that only the HTTP gateway of this process knows.
+func newGatewayToken() (string, error) {
+	token := make([]byte, gatewayTokenLen)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("failed to generate gateway token: %w", err)
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+// fromGateway returns true if the gRPC request was forwarded by the HTTP gateway.
+func fromGateway(ctx context.Context, gatewayToken string) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	tokens := md.Get(gatewayTokenKey)
+	return len(tokens) > 0 && subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(gatewayToken)) == 1
+}
+
+// gatewayTenant returns the tenant forwarded by the HTTP gateway. Callers must check that the request came from the gateway.
+func gatewayTenant(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if tenants := md.Get(gatewayTenantKey); len(tenants) > 0 {
+		return tenants[0]
+	}
+
+	return ""
+}
+
+// gatewayDialOptions mark the requests made by the HTTP gateway so that they aren't rate limited or authorized twice.
+func gatewayDialOptions(gatewayToken string) []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(gatewayContext(ctx, gatewayToken), method, req, reply, cc, opts...)
+		}),
+		grpc.WithChainStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return streamer(gatewayContext(ctx, gatewayToken), desc, cc, method, opts...)
+		}),
+	}
+}
+
+// gatewayContext adds the gateway token and the tenant that the HTTP request is bound to to the outgoing metadata.
+// The values sent by the client in Grpc-Metadata headers are replaced so that they can't be forged.
+func gatewayContext(ctx context.Context, gatewayToken string) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set(gatewayTokenKey, gatewayToken)
+	delete(md, gatewayTenantKey)
+	if tenant, ok := svc.TenantFromContext(ctx); ok {
+		md.Set(gatewayTenantKey, tenant)
+	}
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// incomingHeaderMatcher forwards the store revision and tenant headers of HTTP requests to the gRPC server
+// in addition to the headers forwarded by next.
+func incomingHeaderMatcher(next runtime.HeaderMatcherFunc) runtime.HeaderMatcherFunc {
+	return func(key string) (string, bool) {
+		switch {
+		case strings.EqualFold(key, svc.StoreRevisionHeader):
+			return svc.StoreRevisionHeader, true
+		case strings.EqualFold(key, svc.TenantHeader):
+			return svc.TenantHeader, true
+		}
+
+		return next(key)
+	}
+}
+
+// outgoingHeaderMatcher returns the store revision header of gRPC responses as is instead of prefixing it like other metadata.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == svc.StoreRevisionHeader {
+		return textproto.CanonicalMIMEHeaderKey(key), true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
+}
@@ -0,0 +1,59 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cerbos/cerbos/internal/svc"
+)
+
+func TestHeaderMatchers(t *testing.T) {
+	t.Run("incoming", func(t *testing.T) {
+		matcher := incomingHeaderMatcher(runtime.DefaultHeaderMatcher)
+
+		key, ok := matcher("Cerbos-Store-Revision")
+		require.True(t, ok)
+		require.Equal(t, "cerbos-store-revision", key)
+
+		key, ok = matcher("Cerbos-Tenant")
+		require.True(t, ok)
+		require.Equal(t, "cerbos-tenant", key)
+
+		key, ok = matcher("User-Agent")
+		require.True(t, ok)
+		require.Equal(t, "grpcgateway-User-Agent", key)
+
+		_, ok = matcher("X-Custom")
+		require.False(t, ok)
+	})
+
+	t.Run("outgoing", func(t *testing.T) {
+		key, ok := outgoingHeaderMatcher("cerbos-store-revision")
+		require.True(t, ok)
+		require.Equal(t, "Cerbos-Store-Revision", key)
+
+		key, ok = outgoingHeaderMatcher("cerbos-version")
+		require.True(t, ok)
+		require.Equal(t, "Grpc-Metadata-cerbos-version", key)
+	})
+}
+
+func TestGatewayContext(t *testing.T) {
+	// values sent by the client in Grpc-Metadata headers are replaced
+	forged := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(gatewayTokenKey, "forged", gatewayTenantKey, "globex", "foo", "bar"))
+
+	md, _ := metadata.FromOutgoingContext(gatewayContext(forged, "token"))
+	require.Equal(t, []string{"token"}, md.Get(gatewayTokenKey))
+	require.Empty(t, md.Get(gatewayTenantKey))
+	require.Equal(t, []string{"bar"}, md.Get("foo"))
+
+	md, _ = metadata.FromOutgoingContext(gatewayContext(svc.ContextWithTenant(forged, "acme"), "token"))
+	require.Equal(t, []string{"acme"}, md.Get(gatewayTenantKey))
+}
@@ -0,0 +1,82 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/quic-go/quic-go/http3"
+	"go.uber.org/zap"
+
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+// startHTTP3Server serves the handler over QUIC on the HTTP/3 listen address.
+// The server is closed when the HTTP server shuts down.
+func (s *Server) startHTTP3Server(httpSrv *http.Server, handler http.Handler) (*http3.Server, error) {
+	log := zap.S().Named("http3")
+
+	tlsConf, err := s.getTLSConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create TLS config: %w", err)
+	}
+
+	if tlsConf == nil {
+		return nil, errors.New("HTTP/3 requires TLS")
+	}
+
+	_, addr, err := util.ParseListenAddress(s.conf.HTTP3.ListenAddr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid http3.listenAddr '%s': %w", s.conf.HTTP3.ListenAddr, err)
+	}
+
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP/3 listener at '%s': %w", s.conf.HTTP3.ListenAddr, err)
+	}
+
+	h3 := &http3.Server{
+		Handler:   handler,
+		TLSConfig: tlsConf,
+	}
+
+	httpSrv.RegisterOnShutdown(func() {
+		log.Debug("Shutting down HTTP/3 server")
+		if err := h3.Close(); err != nil {
+			log.Warnw("Failed to cleanly shutdown HTTP/3 server", "error", err)
+		}
+
+		if err := conn.Close(); err != nil {
+			log.Warnw("Failed to close HTTP/3 listener", "error", err)
+		}
+	})
+
+	s.group.Go(func() error {
+		log.Infof("Starting HTTP/3 server at %s", s.conf.HTTP3.ListenAddr)
+		err := h3.Serve(conn)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorw("HTTP/3 server failed", "error", err)
+			return err
+		}
+
+		log.Info("HTTP/3 server stopped")
+		return nil
+	})
+
+	return h3, nil
+}
+
+// withAltSvc advertises the HTTP/3 server in the responses to HTTP/1.1 and HTTP/2 requests,
+// so that clients that support HTTP/3 can switch to it for subsequent requests.
+func withAltSvc(h3 *http3.Server, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The header can't be set until the server starts listening, in which case it's simply omitted.
+		_ = h3.SetQuicHeaders(w.Header())
+
+		handler.ServeHTTP(w, r)
+	})
+}
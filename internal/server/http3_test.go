@@ -0,0 +1,71 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/quic-go/quic-go/http3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+func TestHTTP3(t *testing.T) {
+	testdataDir := test.PathToDir(t, "server")
+	s := NewServer(&Conf{
+		TLS: &TLSConf{
+			Cert: filepath.Join(testdataDir, "tls.crt"),
+			Key:  filepath.Join(testdataDir, "tls.key"),
+		},
+		HTTP3: HTTP3Conf{Enabled: true, ListenAddr: "127.0.0.1:0"},
+	})
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, r.Proto)
+	})
+
+	httpSrv := &http.Server{} //nolint:gosec
+	h3, err := s.startHTTP3Server(httpSrv, handler)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, httpSrv.Shutdown(context.Background()))
+		require.NoError(t, s.group.Wait())
+	})
+
+	// The Alt-Svc header advertises the port that the server is listening on.
+	altSvc := regexp.MustCompile(`h3=":(\d+)"`)
+	var port string
+	require.Eventually(t, func() bool {
+		rec := httptest.NewRecorder()
+		withAltSvc(h3, handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+		if m := altSvc.FindStringSubmatch(rec.Header().Get("Alt-Svc")); m != nil {
+			port = m[1]
+			return true
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+
+	rt := &http3.RoundTripper{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}} //nolint:gosec
+	t.Cleanup(func() { _ = rt.Close() })
+
+	client := &http.Client{Transport: rt, Timeout: 5 * time.Second}
+	resp, err := client.Get(fmt.Sprintf("https://127.0.0.1:%s/", port))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, "HTTP/3.0", string(body))
+}
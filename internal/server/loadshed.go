@@ -0,0 +1,269 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/svc"
+)
+
+// priorityClass determines the order in which waiting requests are admitted. Lower values have higher priority.
+type priorityClass int
+
+const (
+	// priorityExempt requests are never queued or rejected. This includes health checks and long-lived streams.
+	priorityExempt priorityClass = iota
+	priorityCheck
+	priorityPlan
+	priorityAdmin
+	numPriorityClasses
+)
+
+func (pc priorityClass) String() string {
+	switch pc {
+	case priorityExempt:
+		return "exempt"
+	case priorityCheck:
+		return "check"
+	case priorityPlan:
+		return "plan"
+	case priorityAdmin:
+		return "admin"
+	default:
+		return "unknown"
+	}
+}
+
+func priorityClassOf(fullMethod string) priorityClass {
+	parts := strings.Split(fullMethod, "/")
+	if len(parts) < 3 { //nolint:gomnd
+		return priorityAdmin
+	}
+
+	service, method := parts[1], parts[2]
+	switch service {
+	case svcv1.CerbosService_ServiceDesc.ServiceName:
+		switch method {
+		case "CheckResources", "CheckResourceSet", "CheckResourceBatch":
+			return priorityCheck
+		case "PlanResources", "PlanResourcesStream":
+			return priorityPlan
+		case "ListResourceKinds":
+			// Listing resource kinds compiles every policy in the store so it's treated like an admin request.
+			return priorityAdmin
+		default:
+			// ServerInfo is as cheap as a health check, while WatchDecisions and CheckResourcesStream are long-lived streams that would hold on to a slot indefinitely.
+			return priorityExempt
+		}
+	case svcv1.CerbosAdminService_ServiceDesc.ServiceName, svcv1.CerbosPlaygroundService_ServiceDesc.ServiceName:
+		return priorityAdmin
+	case "grpc.health.v1.Health", "grpc.reflection.v1alpha.ServerReflection", "grpc.reflection.v1.ServerReflection":
+		return priorityExempt
+	default:
+		return priorityAdmin
+	}
+}
+
+// loadShedder limits the number of requests processed concurrently.
+// When the limits are reached, requests wait in per-class queues and are admitted in priority order as capacity becomes available.
+// Requests that can't be admitted before the queue timeout expires are rejected.
+type loadShedder struct {
+	waiters       [numPriorityClasses][]*waiter
+	inFlight      [numPriorityClasses]uint
+	limits        [numPriorityClasses]uint
+	maxConcurrent uint
+	total         uint
+	queueTimeout  time.Duration
+	retryAfter    time.Duration
+	mu            sync.Mutex
+}
+
+type waiter struct {
+	ready   chan struct{}
+	granted bool
+}
+
+func newLoadShedder(conf LoadSheddingConf) *loadShedder {
+	ls := &loadShedder{
+		maxConcurrent: conf.MaxConcurrentRequests,
+		queueTimeout:  conf.QueueTimeout,
+		retryAfter:    conf.RetryAfter,
+	}
+
+	limitOrDefault := func(limit, divisor uint) uint {
+		if limit == 0 {
+			limit = conf.MaxConcurrentRequests / divisor
+		}
+
+		if limit == 0 {
+			return 1
+		}
+
+		return limit
+	}
+
+	ls.limits[priorityCheck] = limitOrDefault(conf.Limits.Check, 1)
+	ls.limits[priorityPlan] = limitOrDefault(conf.Limits.Plan, 2)   //nolint:gomnd
+	ls.limits[priorityAdmin] = limitOrDefault(conf.Limits.Admin, 4) //nolint:gomnd
+
+	return ls
+}
+
+// acquire blocks until the request can be processed and returns a function that must be called when the request is done.
+// It returns an error if the request could not be admitted before the queue timeout expired.
+func (ls *loadShedder) acquire(ctx context.Context, class priorityClass) (func(), error) {
+	if class == priorityExempt {
+		return func() {}, nil
+	}
+
+	release := func() { ls.release(class) }
+
+	ls.mu.Lock()
+	if !ls.mustWait(class) && ls.canAdmit(class) {
+		ls.admit(class)
+		ls.mu.Unlock()
+		return release, nil
+	}
+
+	w := &waiter{ready: make(chan struct{})}
+	ls.waiters[class] = append(ls.waiters[class], w)
+	ls.mu.Unlock()
+
+	timer := time.NewTimer(ls.queueTimeout)
+	defer timer.Stop()
+
+	var err error
+	select {
+	case <-w.ready:
+		return release, nil
+	case <-timer.C:
+		err = ls.overloadedError(class)
+	case <-ctx.Done():
+		err = status.FromContextError(ctx.Err()).Err()
+	}
+
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	// the request might have been admitted just as the wait ended
+	if w.granted {
+		return release, nil
+	}
+
+	ls.removeWaiter(class, w)
+	return nil, err
+}
+
+func (ls *loadShedder) release(class priorityClass) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	ls.inFlight[class]--
+	ls.total--
+
+	for c := priorityCheck; c < numPriorityClasses; c++ {
+		for len(ls.waiters[c]) > 0 && ls.canAdmit(c) {
+			w := ls.waiters[c][0]
+			ls.waiters[c] = ls.waiters[c][1:]
+			ls.admit(c)
+			w.granted = true
+			close(w.ready)
+		}
+	}
+}
+
+// canAdmit must be called with mu held.
+func (ls *loadShedder) canAdmit(class priorityClass) bool {
+	return ls.total < ls.maxConcurrent && ls.inFlight[class] < ls.limits[class]
+}
+
+// admit must be called with mu held.
+func (ls *loadShedder) admit(class priorityClass) {
+	ls.inFlight[class]++
+	ls.total++
+}
+
+// mustWait returns true if the request should queue behind requests that are already waiting.
+// That's the case if there are waiting requests of the same class or waiting requests of a higher priority class that
+// are only blocked by the shared limit. Higher priority requests that are blocked by the limit of their own class don't
+// compete for capacity with the given class. It must be called with mu held.
+func (ls *loadShedder) mustWait(class priorityClass) bool {
+	if len(ls.waiters[class]) > 0 {
+		return true
+	}
+
+	for c := priorityCheck; c < class; c++ {
+		if len(ls.waiters[c]) > 0 && ls.inFlight[c] < ls.limits[c] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// removeWaiter must be called with mu held.
+func (ls *loadShedder) removeWaiter(class priorityClass, w *waiter) {
+	queue := ls.waiters[class]
+	for i, qw := range queue {
+		if qw == w {
+			ls.waiters[class] = append(queue[:i], queue[i+1:]...)
+			return
+		}
+	}
+}
+
+func (ls *loadShedder) overloadedError(class priorityClass) error {
+	_ = stats.RecordWithTags(context.Background(),
+		[]tag.Mutator{tag.Upsert(metrics.KeyPriorityClass, class.String())},
+		metrics.LoadSheddingRejectedCount.M(1),
+	)
+
+	st := status.New(codes.ResourceExhausted, "Server is overloaded: retry later")
+	if withDetails, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: string(svc.ErrCodeServerOverloaded), Domain: svc.ErrorDomain},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(ls.retryAfter)},
+	); err == nil {
+		st = withDetails
+	}
+
+	return st.Err()
+}
+
+func (ls *loadShedder) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		done, err := ls.acquire(ctx, priorityClassOf(info.FullMethod))
+		if err != nil {
+			return nil, err
+		}
+		defer done()
+
+		return handler(ctx, req)
+	}
+}
+
+func (ls *loadShedder) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		done, err := ls.acquire(stream.Context(), priorityClassOf(info.FullMethod))
+		if err != nil {
+			return err
+		}
+		defer done()
+
+		return handler(srv, stream)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cerbos/cerbos/internal/svc"
+)
+
+func TestPriorityClassOf(t *testing.T) {
+	testCases := []struct {
+		method string
+		want   priorityClass
+	}{
+		{method: "/grpc.health.v1.Health/Check", want: priorityExempt},
+		{method: "/cerbos.svc.v1.CerbosService/ServerInfo", want: priorityExempt},
+		{method: "/cerbos.svc.v1.CerbosService/WatchDecisions", want: priorityExempt},
+		{method: "/cerbos.svc.v1.CerbosService/CheckResourcesStream", want: priorityExempt},
+		{method: "/cerbos.svc.v1.CerbosService/CheckResources", want: priorityCheck},
+		{method: "/cerbos.svc.v1.CerbosService/CheckResourceSet", want: priorityCheck},
+		{method: "/cerbos.svc.v1.CerbosService/CheckResourceBatch", want: priorityCheck},
+		{method: "/cerbos.svc.v1.CerbosService/PlanResources", want: priorityPlan},
+		{method: "/cerbos.svc.v1.CerbosService/PlanResourcesStream", want: priorityPlan},
+		{method: "/cerbos.svc.v1.CerbosService/ListResourceKinds", want: priorityAdmin},
+		{method: "/cerbos.svc.v1.CerbosAdminService/ListPolicies", want: priorityAdmin},
+		{method: "/cerbos.svc.v1.CerbosPlaygroundService/PlaygroundTest", want: priorityAdmin},
+		{method: "/wibble.Wobble/Foo", want: priorityAdmin},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.method, func(t *testing.T) {
+			require.Equal(t, tc.want, priorityClassOf(tc.method))
+		})
+	}
+}
+
+func TestLoadShedder(t *testing.T) {
+	mkShedder := func(maxConcurrent uint, limits LoadSheddingLimitsConf) *loadShedder {
+		return newLoadShedder(LoadSheddingConf{
+			Enabled:               true,
+			MaxConcurrentRequests: maxConcurrent,
+			Limits:                limits,
+			QueueTimeout:          50 * time.Millisecond,
+			RetryAfter:            2 * time.Second,
+		})
+	}
+
+	t.Run("default_limits", func(t *testing.T) {
+		ls := mkShedder(8, LoadSheddingLimitsConf{Plan: 6})
+		require.Equal(t, uint(8), ls.limits[priorityCheck])
+		require.Equal(t, uint(6), ls.limits[priorityPlan])
+		require.Equal(t, uint(2), ls.limits[priorityAdmin])
+	})
+
+	t.Run("rejects_after_queue_timeout", func(t *testing.T) {
+		ls := mkShedder(1, LoadSheddingLimitsConf{})
+
+		done, err := ls.acquire(context.Background(), priorityCheck)
+		require.NoError(t, err)
+		defer done()
+
+		_, err = ls.acquire(context.Background(), priorityCheck)
+		require.Error(t, err)
+
+		st := status.Convert(err)
+		require.Equal(t, codes.ResourceExhausted, st.Code())
+		require.Equal(t, svc.ErrCodeServerOverloaded, svc.ErrorCodeOf(st))
+
+		var retryInfo *errdetails.RetryInfo
+		for _, d := range st.Details() {
+			if ri, ok := d.(*errdetails.RetryInfo); ok {
+				retryInfo = ri
+			}
+		}
+		require.NotNil(t, retryInfo)
+		require.Equal(t, 2*time.Second, retryInfo.RetryDelay.AsDuration())
+
+		require.Empty(t, ls.waiters[priorityCheck])
+	})
+
+	t.Run("exempt_requests_are_never_rejected", func(t *testing.T) {
+		ls := mkShedder(1, LoadSheddingLimitsConf{})
+
+		done, err := ls.acquire(context.Background(), priorityCheck)
+		require.NoError(t, err)
+		defer done()
+
+		exemptDone, err := ls.acquire(context.Background(), priorityExempt)
+		require.NoError(t, err)
+		exemptDone()
+	})
+
+	t.Run("class_limits_do_not_affect_other_classes", func(t *testing.T) {
+		ls := mkShedder(10, LoadSheddingLimitsConf{Plan: 1})
+
+		planDone, err := ls.acquire(context.Background(), priorityPlan)
+		require.NoError(t, err)
+		defer planDone()
+
+		_, err = ls.acquire(context.Background(), priorityPlan)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		checkDone, err := ls.acquire(context.Background(), priorityCheck)
+		require.NoError(t, err)
+		checkDone()
+	})
+
+	t.Run("waiters_blocked_by_class_limit_do_not_block_other_classes", func(t *testing.T) {
+		ls := newLoadShedder(LoadSheddingConf{
+			Enabled:               true,
+			MaxConcurrentRequests: 10,
+			Limits:                LoadSheddingLimitsConf{Check: 1},
+			QueueTimeout:          time.Minute,
+		})
+
+		done, err := ls.acquire(context.Background(), priorityCheck)
+		require.NoError(t, err)
+		defer done()
+
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		defer cancelFunc()
+
+		go func() { _, _ = ls.acquire(ctx, priorityCheck) }()
+		require.Eventually(t, func() bool { return queueLen(ls, priorityCheck) == 1 }, time.Second, time.Millisecond)
+
+		planDone, err := ls.acquire(context.Background(), priorityPlan)
+		require.NoError(t, err)
+		planDone()
+	})
+
+	t.Run("higher_priority_waiters_are_admitted_first", func(t *testing.T) {
+		ls := newLoadShedder(LoadSheddingConf{
+			Enabled:               true,
+			MaxConcurrentRequests: 1,
+			QueueTimeout:          time.Minute,
+		})
+
+		done, err := ls.acquire(context.Background(), priorityCheck)
+		require.NoError(t, err)
+
+		admitted := make(chan priorityClass, 2)
+		waitFor := func(class priorityClass) {
+			release, err := ls.acquire(context.Background(), class)
+			if err != nil {
+				return
+			}
+			admitted <- class
+			release()
+		}
+
+		go waitFor(priorityAdmin)
+		require.Eventually(t, func() bool { return queueLen(ls, priorityAdmin) == 1 }, time.Second, time.Millisecond)
+
+		go waitFor(priorityCheck)
+		require.Eventually(t, func() bool { return queueLen(ls, priorityCheck) == 1 }, time.Second, time.Millisecond)
+
+		done()
+
+		require.Equal(t, priorityCheck, <-admitted)
+		require.Equal(t, priorityAdmin, <-admitted)
+	})
+
+	t.Run("cancelled_waiters_are_removed", func(t *testing.T) {
+		ls := newLoadShedder(LoadSheddingConf{
+			Enabled:               true,
+			MaxConcurrentRequests: 1,
+			QueueTimeout:          time.Minute,
+		})
+
+		done, err := ls.acquire(context.Background(), priorityCheck)
+		require.NoError(t, err)
+		defer done()
+
+		ctx, cancelFunc := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancelFunc()
+
+		_, err = ls.acquire(ctx, priorityPlan)
+		require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+		require.Zero(t, queueLen(ls, priorityPlan))
+	})
+}
+
+func queueLen(ls *loadShedder, class priorityClass) int {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	return len(ls.waiters[class])
+}
@@ -5,7 +5,10 @@ package server
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"os"
 	"strconv"
@@ -18,6 +21,7 @@ import (
 	"github.com/rs/cors"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -25,6 +29,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
+	"github.com/cerbos/cerbos/internal/svc"
 	"github.com/cerbos/cerbos/internal/util"
 )
 
@@ -32,6 +37,7 @@ const (
 	adminSvcDisabled      = "Admin service is disabled by the configuration"
 	playgroundSvcDisabled = "Playground service is disabled by the configuration"
 	unknownSvc            = "Unknown service"
+	problemJSONMIMEType   = "application/problem+json"
 )
 
 func XForwardedHostUnaryServerInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
@@ -123,16 +129,23 @@ func withCORS(conf *Conf, handler http.Handler) http.Handler {
 	}
 
 	opts := cors.Options{
-		AllowedOrigins: conf.CORS.AllowedOrigins,
-		AllowedHeaders: conf.CORS.AllowedHeaders,
-		AllowedMethods: []string{
+		AllowedOrigins:   conf.CORS.AllowedOrigins,
+		AllowedHeaders:   conf.CORS.AllowedHeaders,
+		AllowedMethods:   conf.CORS.AllowedMethods,
+		ExposedHeaders:   conf.CORS.ExposedHeaders,
+		MaxAge:           int(conf.CORS.MaxAge.Seconds()),
+		AllowCredentials: conf.CORS.AllowCredentials,
+	}
+
+	if len(opts.AllowedMethods) == 0 {
+		opts.AllowedMethods = []string{
 			http.MethodHead,
 			http.MethodGet,
 			http.MethodPost,
 			http.MethodPut,
 			http.MethodPatch,
 			http.MethodDelete,
-		},
+		}
 	}
 
 	var logger cors.Logger
@@ -154,7 +167,11 @@ func withCORS(conf *Conf, handler http.Handler) http.Handler {
 
 func handleUnknownServices(_ any, stream grpc.ServerStream) error {
 	errFn := func(msg string) error {
-		return status.Errorf(codes.Unimplemented, msg)
+		errCode := svc.ErrCodeUnsupportedOperation
+		if msg != unknownSvc {
+			errCode = svc.ErrCodeFeatureDisabled
+		}
+		return svc.NewError(codes.Unimplemented, errCode, msg)
 	}
 
 	method, ok := grpc.MethodFromServerStream(stream)
@@ -182,9 +199,9 @@ func handleRoutingError(ctx context.Context, mux *runtime.ServeMux, marshaler ru
 		errHandler := func(msg string) {
 			err := &runtime.HTTPStatusError{
 				HTTPStatus: httpStatus,
-				Err:        status.Errorf(codes.Unimplemented, msg),
+				Err:        svc.NewError(codes.Unimplemented, svc.ErrCodeFeatureDisabled, msg),
 			}
-			runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+			handleHTTPError(ctx, mux, marshaler, w, r, err)
 		}
 
 		switch {
@@ -204,3 +221,64 @@ func cerbosVersionUnaryServerInterceptor(ctx context.Context, req any, _ *grpc.U
 	_ = grpc.SetHeader(ctx, metadata.Pairs("cerbos-version", util.Version))
 	return handler(ctx, req)
 }
+
+// errorInfoUnaryServerInterceptor makes sure that every error returned by the API carries a machine-readable error code.
+func errorInfoUnaryServerInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, svc.WithErrorInfo(err)
+	}
+
+	return resp, nil
+}
+
+func errorInfoStreamServerInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return svc.WithErrorInfo(handler(srv, stream))
+}
+
+type problemDetails struct {
+	Type   string        `json:"type"`
+	Title  string        `json:"title"`
+	Detail string        `json:"detail,omitempty"`
+	Code   svc.ErrorCode `json:"code"`
+	Status int           `json:"status"`
+}
+
+// handleHTTPError writes an RFC 7807 problem response if the client accepts application/problem+json.
+// It also sets the Retry-After header if the error includes a retry delay.
+// Otherwise, it falls back to the default gRPC gateway error response, which includes the error code in the details.
+func handleHTTPError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	st := status.Convert(err)
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+	if se := new(runtime.HTTPStatusError); errors.As(err, &se) {
+		httpStatus = se.HTTPStatus
+		st = status.Convert(se.Err)
+	}
+
+	for _, d := range st.Details() {
+		if ri, ok := d.(*errdetails.RetryInfo); ok && ri.RetryDelay != nil {
+			w.Header().Set("Retry-After", strconv.FormatInt(int64(math.Ceil(ri.RetryDelay.AsDuration().Seconds())), 10))
+		}
+	}
+
+	if r == nil || !strings.Contains(r.Header.Get("Accept"), problemJSONMIMEType) {
+		runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+		return
+	}
+
+	problem := problemDetails{
+		Type:   "about:blank",
+		Title:  http.StatusText(httpStatus),
+		Status: httpStatus,
+		Detail: st.Message(),
+		Code:   svc.ErrorCodeOf(st),
+	}
+
+	w.Header().Del("Trailer")
+	w.Header().Del("Transfer-Encoding")
+	w.Header().Set("Content-Type", problemJSONMIMEType)
+	w.WriteHeader(httpStatus)
+	if err := json.NewEncoder(w).Encode(problem); err != nil {
+		ctxzap.Extract(ctx).Warn("Failed to write error response", zap.Error(err))
+	}
+}
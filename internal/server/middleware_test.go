@@ -0,0 +1,174 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cerbos/cerbos/internal/svc"
+)
+
+func TestErrorInfoUnaryServerInterceptor(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want svc.ErrorCode
+	}{
+		{name: "plain_status", err: status.Error(codes.InvalidArgument, "bad request"), want: svc.ErrCodeInvalidRequest},
+		{name: "with_error_info", err: svc.NewError(codes.InvalidArgument, svc.ErrCodeInvalidAuxData, "bad token"), want: svc.ErrCodeInvalidAuxData},
+		{name: "unknown", err: status.Error(codes.Unknown, "oops"), want: svc.ErrCodeInternal},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			handler := func(context.Context, any) (any, error) { return nil, tc.err }
+			_, err := errorInfoUnaryServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+
+			st := status.Convert(err)
+			require.Equal(t, status.Code(tc.err), st.Code())
+			require.Equal(t, tc.want, svc.ErrorCodeOf(st))
+
+			var infos []*errdetails.ErrorInfo
+			for _, d := range st.Details() {
+				if info, ok := d.(*errdetails.ErrorInfo); ok {
+					infos = append(infos, info)
+				}
+			}
+			require.Len(t, infos, 1)
+			require.Equal(t, svc.ErrorDomain, infos[0].Domain)
+		})
+	}
+}
+
+func TestHandleHTTPError(t *testing.T) {
+	mux := runtime.NewServeMux()
+	marshaler := &runtime.JSONPb{}
+	err := svc.NewError(codes.InvalidArgument, svc.ErrCodeSchemaValidationError, "invalid resource attributes")
+
+	t.Run("problem_json", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/check/resources", nil)
+		req.Header.Set("Accept", problemJSONMIMEType)
+		rec := httptest.NewRecorder()
+
+		handleHTTPError(context.Background(), mux, marshaler, rec, req, err)
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Equal(t, problemJSONMIMEType, rec.Header().Get("Content-Type"))
+
+		var have problemDetails
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &have))
+		require.Equal(t, problemDetails{
+			Type:   "about:blank",
+			Title:  http.StatusText(http.StatusBadRequest),
+			Detail: "invalid resource attributes",
+			Code:   svc.ErrCodeSchemaValidationError,
+			Status: http.StatusBadRequest,
+		}, have)
+	})
+
+	t.Run("http_status_error", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/admin/policies", nil)
+		req.Header.Set("Accept", problemJSONMIMEType)
+		rec := httptest.NewRecorder()
+
+		handleRoutingError(context.Background(), mux, marshaler, rec, req, http.StatusNotFound)
+
+		require.Equal(t, http.StatusNotFound, rec.Code)
+
+		var have problemDetails
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &have))
+		require.Equal(t, svc.ErrCodeFeatureDisabled, have.Code)
+		require.Equal(t, adminSvcDisabled, have.Detail)
+	})
+
+	t.Run("retry_after", func(t *testing.T) {
+		ls := newLoadShedder(LoadSheddingConf{MaxConcurrentRequests: 1, RetryAfter: 1500 * time.Millisecond})
+		req := httptest.NewRequest(http.MethodPost, "/api/plan/resources", nil)
+		rec := httptest.NewRecorder()
+
+		handleHTTPError(context.Background(), mux, marshaler, rec, req, ls.overloadedError(priorityPlan))
+
+		require.Equal(t, http.StatusTooManyRequests, rec.Code)
+		require.Equal(t, "2", rec.Header().Get("Retry-After"))
+		require.Contains(t, rec.Body.String(), string(svc.ErrCodeServerOverloaded))
+	})
+
+	t.Run("default", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/check/resources", nil)
+		rec := httptest.NewRecorder()
+
+		handleHTTPError(context.Background(), mux, marshaler, rec, req, err)
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		require.Contains(t, rec.Body.String(), string(svc.ErrCodeSchemaValidationError))
+	})
+}
+
+func TestWithCORS(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	preflight := func(h http.Handler, origin, method string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodOptions, "/api/check/resources", nil)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", method)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		h := withCORS(&Conf{}, handler)
+
+		rec := preflight(h, "https://cerbos.dev", http.MethodDelete)
+		require.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+		require.Equal(t, http.MethodDelete, rec.Header().Get("Access-Control-Allow-Methods"))
+		require.Empty(t, rec.Header().Get("Access-Control-Allow-Credentials"))
+		require.Empty(t, rec.Header().Get("Access-Control-Max-Age"))
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		h := withCORS(&Conf{CORS: CORSConf{
+			AllowedOrigins:   []string{"https://app.example.com"},
+			AllowedMethods:   []string{http.MethodPost},
+			ExposedHeaders:   []string{"Cerbos-Store-Revision"},
+			MaxAge:           10 * time.Minute,
+			AllowCredentials: true,
+		}}, handler)
+
+		rec := preflight(h, "https://app.example.com", http.MethodPost)
+		require.Equal(t, "https://app.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+		require.Equal(t, http.MethodPost, rec.Header().Get("Access-Control-Allow-Methods"))
+		require.Equal(t, "true", rec.Header().Get("Access-Control-Allow-Credentials"))
+		require.Equal(t, "600", rec.Header().Get("Access-Control-Max-Age"))
+
+		rec = preflight(h, "https://app.example.com", http.MethodDelete)
+		require.Empty(t, rec.Header().Get("Access-Control-Allow-Methods"))
+
+		rec = preflight(h, "https://evil.example.com", http.MethodPost)
+		require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+
+		req := httptest.NewRequest(http.MethodPost, "/api/check/resources", nil)
+		req.Header.Set("Origin", "https://app.example.com")
+		rec = httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "Cerbos-Store-Revision", rec.Header().Get("Access-Control-Expose-Headers"))
+	})
+}
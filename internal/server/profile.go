@@ -0,0 +1,59 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/cerbos/cerbos/internal/engine"
+)
+
+type conditionProfileResponse struct {
+	Conditions []engine.ConditionProfile `json:"conditions"`
+}
+
+// conditionProfileHandler serves the condition evaluation statistics recorded by the engine.
+// GET returns the statistics sorted by total evaluation time and accepts an optional `limit` query parameter.
+// DELETE discards the statistics recorded so far.
+func conditionProfileHandler(eng *engine.Engine) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			profile, _ := eng.ConditionProfile()
+			if l := r.URL.Query().Get("limit"); l != "" {
+				limit, err := strconv.Atoi(l)
+				if err != nil || limit < 0 {
+					http.Error(w, "limit must be a non-negative integer", http.StatusBadRequest)
+					return
+				}
+
+				if limit < len(profile) {
+					profile = profile[:limit]
+				}
+			}
+
+			enc := json.NewEncoder(w)
+			if _, ok := r.URL.Query()["pretty"]; ok {
+				enc.SetIndent("", "  ")
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			if err := enc.Encode(conditionProfileResponse{Conditions: profile}); err != nil {
+				zap.L().Named("http").Warn("Failed to write condition profile", zap.Error(err))
+			}
+
+		case http.MethodDelete:
+			eng.ResetConditionProfile()
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			w.Header().Set("Allow", "GET, DELETE")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		}
+	})
+}
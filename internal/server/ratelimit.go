@@ -0,0 +1,323 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opencensus.io/stats"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/svc"
+)
+
+const rateLimitPruneEvery = 1 * time.Minute
+
+// RateLimitKey identifies what the requests of a client have in common.
+type RateLimitKey string
+
+const (
+	RateLimitKeySourceIP   RateLimitKey = "sourceIP"
+	RateLimitKeyClientCert RateLimitKey = "clientCert"
+	RateLimitKeyAPIKey     RateLimitKey = "apiKey"
+)
+
+func (k RateLimitKey) validate() error {
+	switch k {
+	case RateLimitKeySourceIP, RateLimitKeyClientCert, RateLimitKeyAPIKey:
+		return nil
+	default:
+		return fmt.Errorf("unknown rateLimits.key [%s]: must be one of %s, %s or %s", k, RateLimitKeySourceIP, RateLimitKeyClientCert, RateLimitKeyAPIKey)
+	}
+}
+
+// rateLimiter limits the rate of requests made by each client using token buckets.
+// Clients are identified by the configured key. Requests that don't have a verified client certificate or a valid
+// API key are limited by their source IP address instead.
+type rateLimiter struct {
+	buckets   map[string]*tokenBucket
+	overrides map[string]bucketLimits
+	nowFunc   func() time.Time
+	lastPrune time.Time
+	// apiKeys verifies the API keys of HTTP requests. It's nil if API keys are not enabled.
+	apiKeys      *apiKeyAuth
+	key          RateLimitKey
+	gatewayToken string
+	defaults     bucketLimits
+	mu           sync.Mutex
+}
+
+type bucketLimits struct {
+	rate  float64
+	burst float64
+}
+
+// tokenBucket holds up to burst tokens and gains rate tokens per second. Each request or streamed message consumes a token.
+type tokenBucket struct {
+	last   time.Time
+	limits bucketLimits
+	tokens float64
+}
+
+func newRateLimiter(conf RateLimitsConf, gatewayToken string, apiKeys *apiKeyAuth) *rateLimiter {
+	return &rateLimiter{
+		buckets:      make(map[string]*tokenBucket),
+		overrides:    limitOverrides(conf),
+		nowFunc:      time.Now,
+		apiKeys:      apiKeys,
+		key:          conf.Key,
+		gatewayToken: gatewayToken,
+		defaults:     bucketLimits{rate: conf.RequestsPerSecond, burst: float64(conf.Burst)},
+	}
+}
+
+func limitOverrides(conf RateLimitsConf) map[string]bucketLimits {
+	overrides := make(map[string]bucketLimits, len(conf.Overrides))
+	for _, o := range conf.Overrides {
+		client := o.Client
+		if conf.Key == RateLimitKeyAPIKey {
+			// hashes are hex-encoded in lowercase by apiKeySet
+			client = strings.ToLower(client)
+		}
+		overrides[clientID(conf.Key, client)] = bucketLimits{rate: o.RequestsPerSecond, burst: float64(o.Burst)}
+	}
+
+	return overrides
+}
+
+// update changes the limits of all clients, including the clients that have already made requests.
+// The key that identifies the clients can't be changed without restarting the server.
+func (rl *rateLimiter) update(conf RateLimitsConf) {
+	conf.Key = rl.key
+	overrides := limitOverrides(conf)
+	now := rl.nowFunc()
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.defaults = bucketLimits{rate: conf.RequestsPerSecond, burst: float64(conf.Burst)}
+	rl.overrides = overrides
+
+	for client, b := range rl.buckets {
+		// account for the tokens gained at the old rate before switching to the new limits
+		b.refill(now)
+		b.limits = rl.limitsOf(client)
+		b.tokens = math.Min(b.limits.burst, b.tokens)
+	}
+}
+
+// limitsOf returns the limits that apply to the client. It must be called with mu held.
+func (rl *rateLimiter) limitsOf(client string) bucketLimits {
+	if limits, ok := rl.overrides[client]; ok {
+		return limits
+	}
+
+	return rl.defaults
+}
+
+// clientID namespaces the identifier by its kind so that the source IP address fallback can't collide with an API key.
+func clientID(key RateLimitKey, id string) string {
+	return string(key) + ":" + id
+}
+
+// take consumes a token from the bucket of the client.
+// If the bucket is empty, it returns false and how long the client should wait before retrying.
+func (rl *rateLimiter) take(client string) (bool, time.Duration) {
+	now := rl.nowFunc()
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.prune(now)
+
+	b, ok := rl.buckets[client]
+	if !ok {
+		limits := rl.limitsOf(client)
+		b = &tokenBucket{limits: limits, tokens: limits.burst, last: now}
+		rl.buckets[client] = b
+	}
+
+	b.refill(now)
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+
+	return false, time.Duration((1 - b.tokens) / b.limits.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.limits.burst, b.tokens+elapsed.Seconds()*b.limits.rate)
+		b.last = now
+	}
+}
+
+// prune removes the buckets that have been refilled completely so that clients that are no longer active don't accumulate.
+// A full bucket is indistinguishable from a new one so removing it doesn't change the outcome of later requests.
+// It must be called with mu held.
+func (rl *rateLimiter) prune(now time.Time) {
+	if now.Sub(rl.lastPrune) < rateLimitPruneEvery {
+		return
+	}
+
+	for client, b := range rl.buckets {
+		b.refill(now)
+		if b.tokens >= b.limits.burst {
+			delete(rl.buckets, client)
+		}
+	}
+
+	rl.lastPrune = now
+}
+
+func (rl *rateLimiter) rateLimitedError(retryAfter time.Duration) error {
+	_ = stats.RecordWithTags(context.Background(), nil, metrics.RateLimitedCount.M(1))
+
+	st := status.New(codes.ResourceExhausted, "Rate limit exceeded: retry later")
+	if withDetails, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: string(svc.ErrCodeRateLimited), Domain: svc.ErrorDomain},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)},
+	); err == nil {
+		st = withDetails
+	}
+
+	return st.Err()
+}
+
+// grpcClient identifies the client that made the gRPC request. It returns false if the request came from the HTTP gateway.
+// API keys are only used to identify the client if they have been verified, so the interceptors must run after the
+// API key interceptors.
+func (rl *rateLimiter) grpcClient(ctx context.Context) (string, bool) {
+	if fromGateway(ctx, rl.gatewayToken) {
+		return "", false
+	}
+
+	p, _ := peer.FromContext(ctx)
+
+	switch rl.key {
+	case RateLimitKeyClientCert:
+		if p != nil {
+			if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 && len(tlsInfo.State.VerifiedChains[0]) > 0 {
+				return clientID(RateLimitKeyClientCert, tlsInfo.State.VerifiedChains[0][0].Subject.String()), true
+			}
+		}
+	case RateLimitKeyAPIKey:
+		if key, ok := authenticatedAPIKey(ctx); ok {
+			return clientID(RateLimitKeyAPIKey, key.hash), true
+		}
+	}
+
+	if p == nil || p.Addr == nil {
+		return clientID(RateLimitKeySourceIP, ""), true
+	}
+
+	return clientID(RateLimitKeySourceIP, hostOf(p.Addr.String())), true
+}
+
+// httpClient identifies the client that made the HTTP request. HTTP requests are limited before they reach the API key
+// interceptors, so the API key is verified here and requests with unknown keys are limited by their source IP address.
+func (rl *rateLimiter) httpClient(r *http.Request) string {
+	switch rl.key {
+	case RateLimitKeyClientCert:
+		if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+			return clientID(RateLimitKeyClientCert, r.TLS.VerifiedChains[0][0].Subject.String())
+		}
+	case RateLimitKeyAPIKey:
+		if rl.apiKeys != nil {
+			if key, ok := rl.apiKeys.lookup(r.Header.Get(rl.apiKeys.conf.Header)); ok {
+				return clientID(RateLimitKeyAPIKey, key.hash)
+			}
+		}
+	}
+
+	return clientID(RateLimitKeySourceIP, hostOf(r.RemoteAddr))
+}
+
+func hostOf(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+
+	return host
+}
+
+// rateLimitExempt returns true for the health check and reflection methods.
+func rateLimitExempt(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/grpc.")
+}
+
+func (rl *rateLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if client, ok := rl.grpcClient(ctx); ok && !rateLimitExempt(info.FullMethod) {
+			if allowed, retryAfter := rl.take(client); !allowed {
+				return nil, rl.rateLimitedError(retryAfter)
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor limits the messages received on streams rather than the streams themselves,
+// so that a long-lived stream can't be used to make an unlimited number of requests.
+func (rl *rateLimiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if client, ok := rl.grpcClient(stream.Context()); ok && !rateLimitExempt(info.FullMethod) {
+			return handler(srv, &rateLimitedStream{ServerStream: stream, rl: rl, client: client})
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+// rateLimitedStream consumes a token for every message received on the stream.
+// The stream is terminated with the rate limited error when the bucket of the client is empty.
+type rateLimitedStream struct {
+	grpc.ServerStream
+	rl     *rateLimiter
+	client string
+}
+
+func (s *rateLimitedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if allowed, retryAfter := s.rl.take(s.client); !allowed {
+		return s.rl.rateLimitedError(retryAfter)
+	}
+
+	return nil
+}
+
+// httpHandler rate limits the requests before passing them to the handler. Rejected requests receive the same error
+// response as the API, including the Retry-After header.
+func (rl *rateLimiter) httpHandler(gwmux *runtime.ServeMux, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if allowed, retryAfter := rl.take(rl.httpClient(r)); !allowed {
+			_, marshaler := runtime.MarshalerForRequest(gwmux, r)
+			handleHTTPError(r.Context(), gwmux, marshaler, w, r, rl.rateLimitedError(retryAfter))
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
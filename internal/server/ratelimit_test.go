@@ -0,0 +1,285 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/cerbos/cerbos/internal/svc"
+)
+
+func TestRateLimiter(t *testing.T) {
+	mkLimiter := func(t *testing.T, key RateLimitKey, overrides ...*RateLimitOverride) (*rateLimiter, *time.Time) {
+		t.Helper()
+
+		gatewayToken, err := newGatewayToken()
+		require.NoError(t, err)
+
+		apiKeys, err := newAPIKeyAuth(context.Background(), APIKeysConf{
+			Enabled: true,
+			Header:  "X-API-Key",
+			Keys:    []*APIKeyConf{{ID: "alice", Key: "alice-key"}, {ID: "bob", Key: "bob-key"}},
+		})
+		require.NoError(t, err)
+
+		rl := newRateLimiter(RateLimitsConf{
+			Enabled:           true,
+			Key:               key,
+			RequestsPerSecond: 2,
+			Burst:             2,
+			Overrides:         overrides,
+		}, gatewayToken, apiKeys)
+
+		now := time.Unix(0, 0)
+		rl.nowFunc = func() time.Time { return now }
+		return rl, &now
+	}
+
+	t.Run("refills_at_rate", func(t *testing.T) {
+		rl, now := mkLimiter(t, RateLimitKeySourceIP)
+
+		for i := 0; i < 2; i++ {
+			allowed, _ := rl.take("a")
+			require.True(t, allowed)
+		}
+
+		allowed, retryAfter := rl.take("a")
+		require.False(t, allowed)
+		require.Equal(t, 500*time.Millisecond, retryAfter)
+
+		// other clients have their own buckets
+		allowed, _ = rl.take("b")
+		require.True(t, allowed)
+
+		*now = now.Add(500 * time.Millisecond)
+		allowed, _ = rl.take("a")
+		require.True(t, allowed)
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		rl, _ := mkLimiter(t, RateLimitKeyAPIKey, &RateLimitOverride{Client: "premium", RequestsPerSecond: 10, Burst: 5})
+
+		for i := 0; i < 5; i++ {
+			allowed, _ := rl.take(clientID(RateLimitKeyAPIKey, "premium"))
+			require.True(t, allowed)
+		}
+
+		allowed, retryAfter := rl.take(clientID(RateLimitKeyAPIKey, "premium"))
+		require.False(t, allowed)
+		require.Equal(t, 100*time.Millisecond, retryAfter)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		rl, now := mkLimiter(t, RateLimitKeySourceIP)
+
+		for i := 0; i < 2; i++ {
+			allowed, _ := rl.take("a")
+			require.True(t, allowed)
+		}
+
+		rl.update(RateLimitsConf{
+			Key:               RateLimitKeyAPIKey,
+			RequestsPerSecond: 10,
+			Burst:             1,
+			Overrides:         []*RateLimitOverride{{Client: "b", RequestsPerSecond: 1, Burst: 1}},
+		})
+
+		// existing buckets switch to the new rate
+		allowed, retryAfter := rl.take("a")
+		require.False(t, allowed)
+		require.Equal(t, 100*time.Millisecond, retryAfter)
+
+		*now = now.Add(100 * time.Millisecond)
+		allowed, _ = rl.take("a")
+		require.True(t, allowed)
+
+		// the key can't be changed, so overrides still apply to source IP addresses
+		allowed, _ = rl.take(clientID(RateLimitKeySourceIP, "b"))
+		require.True(t, allowed)
+		allowed, retryAfter = rl.take(clientID(RateLimitKeySourceIP, "b"))
+		require.False(t, allowed)
+		require.Equal(t, time.Second, retryAfter)
+	})
+
+	t.Run("prunes_full_buckets", func(t *testing.T) {
+		rl, now := mkLimiter(t, RateLimitKeySourceIP)
+
+		rl.take("a")
+		require.Len(t, rl.buckets, 1)
+
+		*now = now.Add(rateLimitPruneEvery)
+		rl.take("b")
+		require.Len(t, rl.buckets, 1)
+		require.Contains(t, rl.buckets, "b")
+	})
+
+	t.Run("grpc", func(t *testing.T) {
+		rl, _ := mkLimiter(t, RateLimitKeyAPIKey)
+		interceptor := rl.UnaryServerInterceptor()
+		handler := func(context.Context, any) (any, error) { return "ok", nil }
+
+		// the API key interceptor runs first, so the limiter only sees verified keys
+		mkCtx := func(md ...string) context.Context {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 1234}})
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(md...))
+			if authCtx, err := rl.apiKeys.authenticate(ctx); err == nil {
+				return authCtx
+			}
+			return ctx
+		}
+
+		invoke := func(ctx context.Context, method string) error {
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+			return err
+		}
+
+		const method = "/cerbos.svc.v1.CerbosService/CheckResources"
+		for i := 0; i < 2; i++ {
+			require.NoError(t, invoke(mkCtx("x-api-key", "alice-key"), method))
+		}
+
+		err := invoke(mkCtx("x-api-key", "alice-key"), method)
+		st := status.Convert(err)
+		require.Equal(t, codes.ResourceExhausted, st.Code())
+		require.Equal(t, svc.ErrCodeRateLimited, svc.ErrorCodeOf(st))
+
+		var retryInfo *errdetails.RetryInfo
+		for _, d := range st.Details() {
+			if ri, ok := d.(*errdetails.RetryInfo); ok {
+				retryInfo = ri
+			}
+		}
+		require.NotNil(t, retryInfo)
+		require.Equal(t, 500*time.Millisecond, retryInfo.RetryDelay.AsDuration())
+
+		// health checks and requests forwarded by the gateway are not limited
+		require.NoError(t, invoke(mkCtx("x-api-key", "alice-key"), "/grpc.health.v1.Health/Check"))
+		require.NoError(t, invoke(mkCtx("x-api-key", "alice-key", gatewayTokenKey, rl.gatewayToken), method))
+		require.Error(t, invoke(mkCtx("x-api-key", "alice-key", gatewayTokenKey, "forged"), method))
+
+		// other keys have their own buckets
+		require.NoError(t, invoke(mkCtx("x-api-key", "bob-key"), method))
+
+		// requests without a valid API key are limited by source IP, regardless of the value of the header
+		require.NoError(t, invoke(mkCtx(), method))
+		require.NoError(t, invoke(mkCtx("x-api-key", "random-1"), method))
+		require.Error(t, invoke(mkCtx("x-api-key", "random-2"), method))
+		require.NotContains(t, rl.buckets, clientID(RateLimitKeyAPIKey, "random-1"))
+	})
+
+	t.Run("grpc_stream", func(t *testing.T) {
+		rl, _ := mkLimiter(t, RateLimitKeySourceIP)
+		interceptor := rl.StreamServerInterceptor()
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 1234}})
+
+		var received int
+		handler := func(_ any, stream grpc.ServerStream) error {
+			for {
+				if err := stream.RecvMsg(nil); err != nil {
+					return err
+				}
+				received++
+			}
+		}
+
+		// each message consumes a token, so the stream is terminated after the burst
+		err := interceptor(nil, &recvStream{ctx: ctx, messages: 5}, &grpc.StreamServerInfo{FullMethod: "/cerbos.svc.v1.CerbosService/CheckResourcesStream"}, handler)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+		require.Equal(t, 2, received)
+
+		// streams of exempt methods are not limited
+		received = 0
+		err = interceptor(nil, &recvStream{ctx: ctx, messages: 5}, &grpc.StreamServerInfo{FullMethod: "/grpc.health.v1.Health/Watch"}, handler)
+		require.ErrorIs(t, err, io.EOF)
+		require.Equal(t, 5, received)
+	})
+
+	t.Run("http_api_key", func(t *testing.T) {
+		rl, _ := mkLimiter(t, RateLimitKeyAPIKey, &RateLimitOverride{Client: fmt.Sprintf("%X", sha256.Sum256([]byte("bob-key"))), RequestsPerSecond: 10, Burst: 3})
+		h := rl.httpHandler(runtime.NewServeMux(), http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		do := func(apiKey string) int {
+			req := httptest.NewRequest(http.MethodPost, "/api/check/resources", nil)
+			req.RemoteAddr = "10.0.0.1:1234"
+			req.Header.Set("X-API-Key", apiKey)
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			return rec.Code
+		}
+
+		for i := 0; i < 3; i++ {
+			require.Equal(t, http.StatusOK, do("bob-key"))
+		}
+		require.Equal(t, http.StatusTooManyRequests, do("bob-key"))
+
+		// unknown keys share the bucket of the source IP address
+		require.Equal(t, http.StatusOK, do("random-1"))
+		require.Equal(t, http.StatusOK, do("random-2"))
+		require.Equal(t, http.StatusTooManyRequests, do("random-3"))
+		require.Len(t, rl.buckets, 2)
+	})
+
+	t.Run("http", func(t *testing.T) {
+		rl, _ := mkLimiter(t, RateLimitKeySourceIP)
+		h := rl.httpHandler(runtime.NewServeMux(), http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		do := func(remoteAddr string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPost, "/api/check/resources", nil)
+			req.RemoteAddr = remoteAddr
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			return rec
+		}
+
+		require.Equal(t, http.StatusOK, do("10.0.0.1:1234").Code)
+		require.Equal(t, http.StatusOK, do("10.0.0.1:5678").Code)
+
+		rec := do("10.0.0.1:1234")
+		require.Equal(t, http.StatusTooManyRequests, rec.Code)
+		require.Equal(t, "1", rec.Header().Get("Retry-After"))
+
+		require.Equal(t, http.StatusOK, do("10.0.0.2:1234").Code)
+	})
+}
+
+// recvStream is a server stream that receives the given number of messages before returning io.EOF.
+type recvStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	messages int
+}
+
+func (s *recvStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recvStream) RecvMsg(any) error {
+	if s.messages == 0 {
+		return io.EOF
+	}
+
+	s.messages--
+	return nil
+}
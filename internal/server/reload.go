@@ -0,0 +1,57 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/observability/logging"
+)
+
+// reloadConfig reads the configuration again and applies the settings that can be changed without restarting the server:
+// the TLS certificates, the log level, the rate limits and the audit log settings. Nothing is applied if the new
+// configuration is invalid. The tenancy settings are not reloaded because the stores of the tenants are created at startup.
+func (s *Server) reloadConfig(auditLog audit.Log) error {
+	confW, err := config.Global().Reload()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	conf := new(Conf)
+	if err := confW.GetSection(conf); err != nil {
+		return fmt.Errorf("failed to read server configuration: %w", err)
+	}
+
+	auditConf := new(audit.Conf)
+	if err := confW.GetSection(auditConf); err != nil {
+		return fmt.Errorf("failed to read audit configuration: %w", err)
+	}
+
+	if s.certs != nil {
+		if err := s.certs.reload(); err != nil {
+			return fmt.Errorf("failed to reload TLS certificates: %w", err)
+		}
+	}
+
+	if conf.LogLevel != "" {
+		setLogLevel(conf.LogLevel)
+	}
+
+	// rate limiting can't be enabled or disabled without a restart because the interceptors are installed at startup
+	if s.rateLimiter != nil && conf.RateLimits.Enabled {
+		s.rateLimiter.update(conf.RateLimits)
+	}
+
+	audit.Reload(auditLog, auditConf)
+	return nil
+}
+
+// setLogLevel sets the level of the global logger. The level is validated when the configuration is loaded.
+func setLogLevel(level string) {
+	if l, err := logging.ParseLevel(level); err == nil {
+		logging.SetLevel(l)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/config"
+)
+
+func TestReloadConfig(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	confFile := filepath.Join(dir, "config.yaml")
+
+	writeConf := func(t *testing.T, requestsPerSec int, logLevel string) {
+		t.Helper()
+
+		conf := fmt.Sprintf(`
+server:
+  httpListenAddr: ":3592"
+  grpcListenAddr: ":3593"
+  logLevel: %s
+  tls:
+    cert: %s
+    key: %s
+  rateLimits:
+    enabled: true
+    requestsPerSecond: %d
+`, logLevel, certFile, keyFile, requestsPerSec)
+		require.NoError(t, os.WriteFile(confFile, []byte(conf), 0o600))
+	}
+
+	servedCert := func(t *testing.T, s *Server) []byte {
+		t.Helper()
+
+		tlsConf, err := s.getTLSConfig()
+		require.NoError(t, err)
+
+		served, err := tlsConf.GetConfigForClient(&tls.ClientHelloInfo{})
+		require.NoError(t, err)
+		return served.Certificates[0].Certificate[0]
+	}
+
+	certA := writeTestCert(t, certFile, keyFile, "a")
+	writeConf(t, 100, "info")
+	require.NoError(t, config.Load(confFile, nil))
+
+	conf, err := GetConf()
+	require.NoError(t, err)
+
+	s := NewServer(conf)
+	s.rateLimiter = newRateLimiter(conf.RateLimits, "", nil)
+	require.Equal(t, certA, servedCert(t, s))
+
+	t.Run("applies_changes", func(t *testing.T) {
+		certB := writeTestCert(t, certFile, keyFile, "b")
+		writeConf(t, 10, "info")
+
+		require.NoError(t, s.reloadConfig(audit.NewNopLog()))
+		require.Equal(t, certB, servedCert(t, s))
+		require.Equal(t, 10.0, s.rateLimiter.defaults.rate)
+	})
+
+	t.Run("keeps_current_config_if_invalid", func(t *testing.T) {
+		current := servedCert(t, s)
+		writeTestCert(t, certFile, keyFile, "c")
+		writeConf(t, 20, "verbose")
+
+		require.Error(t, s.reloadConfig(audit.NewNopLog()))
+		require.Equal(t, current, servedCert(t, s))
+		require.Equal(t, 10.0, s.rateLimiter.defaults.rate)
+	})
+}
+
+// writeTestCert writes a self-signed certificate with the given common name and returns its DER encoding.
+func writeTestCert(t *testing.T, certFile, keyFile, commonName string) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	return der
+}
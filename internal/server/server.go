@@ -6,7 +6,6 @@ package server
 import (
 	"context"
 	"crypto/tls"
-	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
@@ -14,7 +13,6 @@ import (
 	"net/http"
 	"os"
 	"strconv"
-	"strings"
 	"time"
 
 	"contrib.go.opencensus.io/exporter/prometheus"
@@ -59,21 +57,34 @@ import (
 	// Import to register the kafka audit log backend.
 	_ "github.com/cerbos/cerbos/internal/audit/kafka"
 	"github.com/cerbos/cerbos/internal/auxdata"
+	"github.com/cerbos/cerbos/internal/canary"
 	"github.com/cerbos/cerbos/internal/compile"
 	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/graphql"
 	"github.com/cerbos/cerbos/internal/observability/metrics"
 	"github.com/cerbos/cerbos/internal/observability/tracing"
 	internalSchema "github.com/cerbos/cerbos/internal/schema"
 	"github.com/cerbos/cerbos/internal/storage"
-
-	// Import blob to register the storage driver.
-	_ "github.com/cerbos/cerbos/internal/storage/blob"
+	"github.com/cerbos/cerbos/internal/storage/blob"
 	"github.com/cerbos/cerbos/internal/storage/overlay"
+	"github.com/cerbos/cerbos/internal/storage/snapshot"
 
 	// Import bundle to register the storage driver.
 	_ "github.com/cerbos/cerbos/internal/storage/bundle"
+	// Import cockroachdb to register the storage driver.
+	_ "github.com/cerbos/cerbos/internal/storage/db/cockroachdb"
+	// Import consul to register the storage driver.
+	_ "github.com/cerbos/cerbos/internal/storage/db/consul"
+	// Import etcd to register the storage driver.
+	_ "github.com/cerbos/cerbos/internal/storage/db/etcd"
+	// Import memory to register the storage driver.
+	_ "github.com/cerbos/cerbos/internal/storage/db/memory"
+	// Import mongodb to register the storage driver.
+	_ "github.com/cerbos/cerbos/internal/storage/db/mongodb"
 	// Import mysql to register the storage driver.
 	_ "github.com/cerbos/cerbos/internal/storage/db/mysql"
+	// Import nats to register the storage driver.
+	_ "github.com/cerbos/cerbos/internal/storage/db/nats"
 	// Import postgres to register the storage driver.
 	_ "github.com/cerbos/cerbos/internal/storage/db/postgres"
 	// Import sqlite3 to register the storage driver.
@@ -82,25 +93,35 @@ import (
 	_ "github.com/cerbos/cerbos/internal/storage/db/sqlserver"
 	// Import disk to register the storage driver.
 	_ "github.com/cerbos/cerbos/internal/storage/disk"
+	// Import federated to register the storage driver.
+	_ "github.com/cerbos/cerbos/internal/storage/federated"
 	// Import git to register the storage driver.
 	_ "github.com/cerbos/cerbos/internal/storage/git"
+	// Import kafka to register the storage driver.
+	_ "github.com/cerbos/cerbos/internal/storage/kafka"
 	"github.com/cerbos/cerbos/internal/svc"
+	"github.com/cerbos/cerbos/internal/tenancy"
 	"github.com/cerbos/cerbos/internal/util"
 	"github.com/cerbos/cerbos/schema"
 )
 
 const (
-	defaultTimeout           = 30 * time.Second
 	metricsReportingInterval = 15 * time.Second
 	minGRPCConnectTimeout    = 20 * time.Second
 
 	adminEndpoint      = "/admin"
 	apiEndpoint        = "/api"
+	conditionsEndpoint = "/_cerbos/debug/conditions"
+	graphqlEndpoint    = "/api/graphql"
 	healthEndpoint     = "/_cerbos/health"
 	metricsEndpoint    = "/_cerbos/metrics"
 	playgroundEndpoint = "/api/playground"
 	schemaEndpoint     = "/schema/swagger.json"
+	storeSyncEndpoint  = "/admin/store/sync"
 	zpagesEndpoint     = "/_cerbos/debug"
+
+	// storageHealthService is the name under which the health of the store is reported by the health endpoint.
+	storageHealthService = "cerbos.storage"
 )
 
 var ErrInvalidStore = errors.New("store does not implement either SourceStore or BinaryStore interfaces")
@@ -112,6 +133,10 @@ func Start(ctx context.Context, zpagesEnabled bool) error {
 		return fmt.Errorf("failed to read server configuration: %w", err)
 	}
 
+	if conf.LogLevel != "" {
+		setLogLevel(conf.LogLevel)
+	}
+
 	// create Prom exporter.
 	// this is done early to prevent metrics from other components from being discarded because there's no exporter registered.
 	ocExporter, err := initOCPromExporter(conf)
@@ -130,40 +155,35 @@ func Start(ctx context.Context, zpagesEnabled bool) error {
 		return fmt.Errorf("failed to create metadata extractor: %w", err)
 	}
 
+	// enable custom CEL functions before any policies are compiled
+	if err := engine.EnableFunctionPlugins(ctx); err != nil {
+		return fmt.Errorf("failed to enable CEL function plugins: %w", err)
+	}
+
 	// create store
 	store, err := storage.New(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to create store: %w", err)
 	}
 
+	if r, ok := store.(storage.Revisioned); ok {
+		audit.AttachRevisionSource(auditLog, r)
+	}
+
 	// create schema manager
 	schemaMgr, err := internalSchema.New(ctx, store)
 	if err != nil {
 		return fmt.Errorf("failed to create schema manager: %w", err)
 	}
 
-	var policyLoader engine.PolicyLoader
-	switch st := store.(type) {
-	// Overlay needs to take precedence over BinaryStore in this type switch,
-	// as our overlay store implements BinaryStore also
-	case overlay.Overlay:
-		// create wrapped policy loader
-		pl, err := st.GetOverlayPolicyLoader(ctx, schemaMgr)
-		if err != nil {
-			return fmt.Errorf("failed to create overlay policy loader: %w", err)
-		}
-		policyLoader = pl
-	case storage.BinaryStore:
-		policyLoader = st
-	case storage.SourceStore:
-		// create compile manager
-		compileMgr, err := compile.NewManager(ctx, st, schemaMgr)
-		if err != nil {
-			return fmt.Errorf("failed to create compile manager: %w", err)
-		}
-		policyLoader = compileMgr
-	default:
-		return ErrInvalidStore
+	policyLoader, err := newPolicyLoader(ctx, store, schemaMgr)
+	if err != nil {
+		return err
+	}
+
+	var decisionCache *engine.DecisionCache
+	if conf.DecisionCache.Enabled {
+		decisionCache = engine.NewDecisionCache(conf.DecisionCache.MaxEntries, conf.DecisionCache.TTL, store)
 	}
 
 	// create engine
@@ -172,11 +192,28 @@ func Start(ctx context.Context, zpagesEnabled bool) error {
 		SchemaMgr:         schemaMgr,
 		AuditLog:          auditLog,
 		MetadataExtractor: mdExtractor,
+		DecisionCache:     decisionCache,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create engine: %w", err)
 	}
 
+	// create the stores and engines of the tenants
+	tenancyConf, err := tenancy.GetConf()
+	if err != nil {
+		return fmt.Errorf("failed to read tenancy configuration: %w", err)
+	}
+
+	tenants, err := newTenants(ctx, conf, tenancyConf, auditLog, mdExtractor)
+	if err != nil {
+		return err
+	}
+
+	// start canary checks
+	if err := canary.Start(ctx, eng); err != nil {
+		return fmt.Errorf("failed to start canary checks: %w", err)
+	}
+
 	// initialize aux data
 	auxData, err := auxdata.New(ctx)
 	if err != nil {
@@ -189,23 +226,74 @@ func Start(ctx context.Context, zpagesEnabled bool) error {
 	telemetry.Start(ctx, store)
 	defer telemetry.Stop()
 
-	return s.Start(ctx, Param{AuditLog: auditLog, AuxData: auxData, Engine: eng, Store: store, ZPagesEnabled: zpagesEnabled})
+	return s.Start(ctx, Param{
+		AuditLog:      auditLog,
+		AuxData:       auxData,
+		Engine:        eng,
+		Store:         store,
+		PolicyLoader:  policyLoader,
+		SchemaMgr:     schemaMgr,
+		Tenants:       tenants,
+		RequireTenant: tenancyConf.RequireTenant,
+		ZPagesEnabled: zpagesEnabled,
+	})
+}
+
+// newPolicyLoader creates the policy loader that serves the policies in the store.
+func newPolicyLoader(ctx context.Context, store storage.Store, schemaMgr internalSchema.Manager) (engine.PolicyLoader, error) {
+	switch st := store.(type) {
+	// Overlay needs to take precedence over BinaryStore in this type switch,
+	// as our overlay store implements BinaryStore also
+	case overlay.Overlay:
+		// create wrapped policy loader
+		pl, err := st.GetOverlayPolicyLoader(ctx, schemaMgr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create overlay policy loader: %w", err)
+		}
+		return pl, nil
+	case storage.BinaryStore:
+		return st, nil
+	case storage.SourceStore:
+		// create compile manager
+		compileMgr, err := compile.NewManager(ctx, st, schemaMgr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create compile manager: %w", err)
+		}
+		return compileMgr, nil
+	default:
+		return nil, ErrInvalidStore
+	}
 }
 
 type Param struct {
-	AuditLog      audit.Log
-	AuxData       *auxdata.AuxData
-	Engine        *engine.Engine
-	Store         storage.Store
+	AuditLog     audit.Log
+	AuxData      *auxdata.AuxData
+	Engine       *engine.Engine
+	Store        storage.Store
+	PolicyLoader engine.PolicyLoader
+	SchemaMgr    internalSchema.Manager
+	// Tenants are served in addition to the policies in Store to the requests that identify them.
+	Tenants []*Tenant
+	// RequireTenant rejects the requests that don't identify one of the Tenants.
+	RequireTenant bool
 	ZPagesEnabled bool
 }
 
 type Server struct {
-	conf       *Conf
-	cancelFunc context.CancelFunc
-	group      *errgroup.Group
-	health     *health.Server
-	ocExporter *prometheus.Exporter
+	conf        *Conf
+	cancelFunc  context.CancelFunc
+	group       *errgroup.Group
+	health      *health.Server
+	ocExporter  *prometheus.Exporter
+	rateLimiter *rateLimiter
+	apiKeyAuth  *apiKeyAuth
+	spiffeAuthz *spiffeAuthz
+	tracker     *requestTracker
+	// gatewayToken marks the requests forwarded by the HTTP gateway.
+	gatewayToken string
+	adminAuth    svc.AdminAuth
+	// certs holds the TLS certificates. It's created when the first listener is created.
+	certs *certReloader
 }
 
 func NewServer(conf *Conf) *Server {
@@ -233,6 +321,40 @@ func (s *Server) Start(ctx context.Context, param Param) error {
 	// This is why we have two dedicated ports for HTTP and gRPC traffic. However, if gRPC traffic is sent to the HTTP port, it
 	// will still be handled correctly.
 
+	token, err := newGatewayToken()
+	if err != nil {
+		log.Error("Failed to create gateway token", zap.Error(err))
+		return err
+	}
+	s.gatewayToken = token
+	s.tracker = newRequestTracker(s.gatewayToken)
+
+	if s.conf.SPIFFE.Enabled {
+		s.spiffeAuthz = newSPIFFEAuthz(s.conf.SPIFFE, s.gatewayToken)
+	}
+
+	if s.conf.APIKeys.Enabled {
+		a, err := newAPIKeyAuth(ctx, s.conf.APIKeys)
+		if err != nil {
+			log.Error("Failed to load API keys", zap.Error(err))
+			return err
+		}
+		s.apiKeyAuth = a
+	}
+
+	if s.conf.RateLimits.Enabled {
+		s.rateLimiter = newRateLimiter(s.conf.RateLimits, s.gatewayToken, s.apiKeyAuth)
+	}
+
+	if s.conf.AdminAPI.Enabled {
+		a, err := newAdminAuth(ctx, s.conf.AdminAPI)
+		if err != nil {
+			log.Error("Failed to get admin API credentials", zap.Error(err))
+			return err
+		}
+		s.adminAuth = a
+	}
+
 	grpcL, err := s.createListener(s.conf.GRPCListenAddr)
 	if err != nil {
 		log.Error("Failed to create gRPC listener", zap.Error(err))
@@ -246,36 +368,25 @@ func (s *Server) Start(ctx context.Context, param Param) error {
 	}
 
 	// start servers
-	grpcServer, err := s.startGRPCServer(grpcL, param)
+	grpcServer, err := s.startGRPCServer(ctx, grpcL, param)
 	if err != nil {
 		log.Error("Failed to start GRPC server", zap.Error(err))
 		return err
 	}
 
-	httpServer, err := s.startHTTPServer(ctx, httpL, grpcServer, param.ZPagesEnabled)
+	httpServer, err := s.startHTTPServer(ctx, httpL, grpcServer, param)
 	if err != nil {
 		log.Error("Failed to start HTTP server", zap.Error(err))
 		return err
 	}
 
+	s.handleReloadSignal(ctx, param.AuditLog)
+
 	s.group.Go(func() error {
 		<-ctx.Done()
 		log.Info("Shutting down")
 
-		// mark this service as NOT_SERVING in the gRPC health check.
-		s.health.Shutdown()
-
-		log.Debug("Shutting down gRPC server")
-		grpcServer.GracefulStop()
-
-		log.Debug("Shutting down HTTP server")
-		shutdownCtx, cancelFunc := context.WithTimeout(context.Background(), defaultTimeout)
-		defer cancelFunc()
-
-		if err := httpServer.Shutdown(shutdownCtx); err != nil {
-			log.Error("Failed to cleanly shutdown HTTP server", zap.Error(err))
-		}
-
+		s.drain(grpcServer, httpServer)
 		return nil
 	})
 
@@ -297,6 +408,15 @@ func (s *Server) Start(ctx context.Context, param Param) error {
 		}
 	}
 
+	for _, tenant := range param.Tenants {
+		if closer, ok := tenant.Store.(io.Closer); ok {
+			log.Debug("Shutting down store of tenant", zap.String("tenant", tenant.ID))
+			if err := closer.Close(); err != nil {
+				log.Error("Store of tenant didn't shutdown correctly", zap.String("tenant", tenant.ID), zap.Error(err))
+			}
+		}
+	}
+
 	log.Info("Shutdown complete")
 	return nil
 }
@@ -323,43 +443,19 @@ func (s *Server) getTLSConfig() (*tls.Config, error) {
 	if s.conf.TLS == nil || (s.conf.TLS.Cert == "" || s.conf.TLS.Key == "") {
 		return nil, nil
 	}
-	// TODO (cell) Configure TLS with reloadable certificates
-
-	conf := s.conf.TLS
-
-	certificate, err := tls.LoadX509KeyPair(conf.Cert, conf.Key)
-	if err != nil {
-		return nil, fmt.Errorf("failed to load certificate and key: %w", err)
-	}
-
-	tlsConfig := util.DefaultTLSConfig()
-	tlsConfig.Certificates = []tls.Certificate{certificate}
 
-	if conf.CACert != "" {
-		if _, err := os.Stat(conf.CACert); err != nil {
-			//nolint:nilerr
-			return tlsConfig, nil
-		}
-
-		certPool := x509.NewCertPool()
-		bs, err := os.ReadFile(conf.CACert)
+	if s.certs == nil {
+		certs, err := newCertReloader(s.conf.TLS)
 		if err != nil {
-			return nil, fmt.Errorf("failed to load CA certificate: %w", err)
-		}
-
-		ok := certPool.AppendCertsFromPEM(bs)
-		if !ok {
-			return nil, errors.New("failed to append certificates to the pool")
+			return nil, err
 		}
-
-		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
-		tlsConfig.ClientCAs = certPool
+		s.certs = certs
 	}
 
-	return tlsConfig, nil
+	return s.certs.tlsConfig(), nil
 }
 
-func (s *Server) startGRPCServer(l net.Listener, param Param) (*grpc.Server, error) {
+func (s *Server) startGRPCServer(ctx context.Context, l net.Listener, param Param) (*grpc.Server, error) {
 	log := zap.L().Named("grpc")
 	server, err := s.mkGRPCServer(log, param.AuditLog)
 	if err != nil {
@@ -370,33 +466,70 @@ func (s *Server) startGRPCServer(l net.Listener, param Param) (*grpc.Server, err
 	reflection.Register(server)
 
 	reqLimits := svc.RequestLimits{
-		MaxActionsPerResource:  s.conf.RequestLimits.MaxActionsPerResource,
-		MaxResourcesPerRequest: s.conf.RequestLimits.MaxResourcesPerRequest,
+		MaxActionsPerResource:       s.conf.RequestLimits.MaxActionsPerResource,
+		MaxResourcesPerRequest:      s.conf.RequestLimits.MaxResourcesPerRequest,
+		MaxPlanEntriesPerRequest:    s.conf.RequestLimits.MaxPlanEntriesPerRequest,
+		MaxPoliciesPerRequest:       s.conf.RequestLimits.MaxPoliciesPerRequest,
+		MaxPolicyBytesPerRequest:    s.conf.RequestLimits.MaxPolicyBytesPerRequest,
+		MaxAttributeBytesPerRequest: s.conf.RequestLimits.MaxAttributeBytesPerRequest,
+		MaxAuxDataBytesPerRequest:   s.conf.RequestLimits.MaxAuxDataBytesPerRequest,
+	}
+
+	compileConf, err := compile.GetConf()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read compile configuration: %w", err)
+	}
+
+	if s.conf.RequestPoliciesEnabled {
+		log.Info("Ad-hoc request policies are enabled")
+	}
+
+	var tenants *svc.Tenants
+	if len(param.Tenants) > 0 {
+		tenants = svc.NewTenants(param.RequireTenant)
+		for _, tenant := range param.Tenants {
+			log.Info("Serving tenant", zap.String("tenant", tenant.ID), zap.String("driver", tenant.Store.Driver()))
+			tenantSvc, err := s.mkCerbosService(param.AuxData, reqLimits, compileConf.Limits, tenant.Engine, tenant.Store, tenant.PolicyLoader, tenant.SchemaMgr, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create service of tenant %q: %w", tenant.ID, err)
+			}
+			tenants.Add(tenant.ID, tenantSvc)
+		}
+	}
+
+	cerbosSvc, err := s.mkCerbosService(param.AuxData, reqLimits, compileConf.Limits, param.Engine, param.Store, param.PolicyLoader, param.SchemaMgr, tenants)
+	if err != nil {
+		return nil, err
 	}
 
-	cerbosSvc := svc.NewCerbosService(param.Engine, param.AuxData, reqLimits)
 	svcv1.RegisterCerbosServiceServer(server, cerbosSvc)
 	s.health.SetServingStatus(svcv1.CerbosService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
 
+	if sy, ok := param.Store.(storage.Syncable); ok && sy.SyncStatus() != nil {
+		s.reportSyncStatus(log, sy.SyncStatus())
+	}
+
 	if s.conf.AdminAPI.Enabled {
 		log.Info("Starting admin service")
-		creds := s.conf.AdminAPI.AdminCredentials
-
-		adminUser, adminPasswdHash, err := creds.usernameAndPasswordHash()
-		if err != nil {
-			log.Error("Failed to get admin API credentials", zap.Error(err))
-			return nil, err
+		if !s.adminAuth.DisableBasicAuth {
+			go checkForUnsafeAdminCredentials(log, s.adminAuth.PasswordHash)
 		}
 
-		go checkForUnsafeAdminCredentials(log, adminPasswdHash)
+		var snapshots snapshot.Bucket
+		if sc := s.conf.AdminAPI.Snapshots; sc != nil {
+			if snapshots, err = blob.NewSnapshotBucket(ctx, sc.Bucket, sc.Prefix, sc.RequestTimeout, sc.S3, sc.GCS, sc.Azure); err != nil {
+				log.Error("Failed to open the snapshots bucket", zap.Error(err))
+				return nil, err
+			}
+		}
 
-		svcv1.RegisterCerbosAdminServiceServer(server, svc.NewCerbosAdminService(param.Store, param.AuditLog, adminUser, adminPasswdHash))
+		svcv1.RegisterCerbosAdminServiceServer(server, svc.NewCerbosAdminService(param.Store, param.PolicyLoader, cerbosSvc, param.AuditLog, snapshots, s.adminAuth))
 		s.health.SetServingStatus(svcv1.CerbosAdminService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
 	}
 
 	if s.conf.PlaygroundEnabled {
 		log.Info("Starting playground service")
-		svcv1.RegisterCerbosPlaygroundServiceServer(server, svc.NewCerbosPlaygroundService(reqLimits))
+		svcv1.RegisterCerbosPlaygroundServiceServer(server, svc.NewCerbosPlaygroundService(reqLimits, compileConf.Limits))
 		s.health.SetServingStatus(svcv1.CerbosPlaygroundService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
 	}
 
@@ -422,6 +555,67 @@ func (s *Server) startGRPCServer(l net.Listener, param Param) (*grpc.Server, err
 	return server, nil
 }
 
+// mkCerbosService creates the service that evaluates requests using the policies in the store.
+func (s *Server) mkCerbosService(auxData *auxdata.AuxData, reqLimits svc.RequestLimits, compileLimits compile.Limits, eng *engine.Engine, store storage.Store, policyLoader engine.PolicyLoader, schemaMgr internalSchema.Manager, tenants *svc.Tenants) (*svc.CerbosService, error) {
+	var requestPolicies *svc.RequestPolicies
+	if s.conf.RequestPoliciesEnabled {
+		ss, ok := store.(storage.SourceStore)
+		if !ok {
+			return nil, fmt.Errorf("ad-hoc request policies are not supported by the %q storage driver", store.Driver())
+		}
+
+		requestPolicies = svc.NewRequestPolicies(ss, policyLoader, schemaMgr, compileLimits)
+	}
+
+	resourceKinds := svc.NewResourceKinds(store, policyLoader)
+	return svc.NewCerbosService(eng, auxData, reqLimits, s.conf.WatchDecisionsEnabled, requestPolicies, resourceKinds, svc.NewStoreRevisions(store), tenants), nil
+}
+
+// reportSyncStatus reports the store as not serving while it is degraded. The Cerbos service is still reported as
+// serving because the policies that were synced before the store became degraded can still be used.
+func (s *Server) reportSyncStatus(log *zap.Logger, status *storage.SyncStatus) {
+	setStatus := func(degraded bool) {
+		if degraded {
+			log.Warn("Store is degraded", zap.Uint("consecutiveFailures", status.ConsecutiveFailures()), zap.Time("lastSync", status.LastSync()))
+			s.health.SetServingStatus(storageHealthService, healthpb.HealthCheckResponse_NOT_SERVING)
+			return
+		}
+
+		s.health.SetServingStatus(storageHealthService, healthpb.HealthCheckResponse_SERVING)
+	}
+
+	status.OnChange(func(degraded bool) {
+		if !degraded {
+			log.Info("Store recovered")
+		}
+		setStatus(degraded)
+	})
+	setStatus(status.Degraded())
+}
+
+// newAdminAuth returns the authentication settings shared by the admin API and the store sync endpoint.
+func newAdminAuth(ctx context.Context, conf AdminAPIConf) (svc.AdminAuth, error) {
+	username, passwordHash, err := conf.AdminCredentials.usernameAndPasswordHash()
+	if err != nil {
+		return svc.AdminAuth{}, err
+	}
+
+	auth := svc.AdminAuth{Username: username, PasswordHash: passwordHash}
+	if oc := conf.OIDC; oc != nil {
+		auth.DisableBasicAuth = oc.DisableBasicAuth
+		auth.OIDC = svc.NewOIDCVerifier(ctx, svc.OIDCSettings{
+			Issuer:    oc.Issuer,
+			JWKSURL:   oc.JWKSURL,
+			RoleClaim: oc.RoleClaim,
+			UserClaim: oc.UserClaim,
+			Audience:  oc.Audience,
+			Roles:     oc.Roles,
+		})
+	}
+
+	return auth, nil
+}
+
 func checkForUnsafeAdminCredentials(log *zap.Logger, passwordHash []byte) {
 	unsafe, err := adminCredentialsAreUnsafe(passwordHash)
 	if err != nil {
@@ -440,8 +634,55 @@ func (s *Server) mkGRPCServer(log *zap.Logger, auditLog audit.Log) (*grpc.Server
 		return nil, fmt.Errorf("failed to create audit unary interceptor: %w", err)
 	}
 
+	loadSheddingStreamInt := func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, stream)
+	}
+	loadSheddingUnaryInt := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(ctx, req)
+	}
+	if s.conf.LoadShedding.Enabled {
+		shedder := newLoadShedder(s.conf.LoadShedding)
+		loadSheddingStreamInt = shedder.StreamServerInterceptor()
+		loadSheddingUnaryInt = shedder.UnaryServerInterceptor()
+	}
+
+	rateLimitStreamInt := func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, stream)
+	}
+	rateLimitUnaryInt := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(ctx, req)
+	}
+	if s.rateLimiter != nil {
+		rateLimitStreamInt = s.rateLimiter.StreamServerInterceptor()
+		rateLimitUnaryInt = s.rateLimiter.UnaryServerInterceptor()
+	}
+
+	spiffeStreamInt := func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, stream)
+	}
+	spiffeUnaryInt := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(ctx, req)
+	}
+	if s.spiffeAuthz != nil {
+		spiffeStreamInt = s.spiffeAuthz.StreamServerInterceptor()
+		spiffeUnaryInt = s.spiffeAuthz.UnaryServerInterceptor()
+	}
+
+	apiKeyStreamInt := func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, stream)
+	}
+	apiKeyUnaryInt := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(ctx, req)
+	}
+	if s.apiKeyAuth != nil {
+		apiKeyStreamInt = s.apiKeyAuth.StreamServerInterceptor()
+		apiKeyUnaryInt = s.apiKeyAuth.UnaryServerInterceptor()
+	}
+
 	opts := []grpc.ServerOption{
 		grpc.ChainStreamInterceptor(
+			s.tracker.StreamServerInterceptor(),
+			errorInfoStreamServerInterceptor,
 			grpc_recovery.StreamServerInterceptor(),
 			telemetryInt.StreamServerInterceptor(),
 			otelgrpc.StreamServerInterceptor(),
@@ -451,9 +692,15 @@ func (s *Server) mkGRPCServer(log *zap.Logger, auditLog audit.Log) (*grpc.Server
 				grpc_zap.WithDecider(loggingDecider),
 				grpc_zap.WithMessageProducer(messageProducer),
 			),
+			spiffeStreamInt,
+			apiKeyStreamInt,
+			rateLimitStreamInt,
+			loadSheddingStreamInt,
 			grpc_zap.PayloadStreamServerInterceptor(payloadLog, payloadLoggingDecider(s.conf)),
 		),
 		grpc.ChainUnaryInterceptor(
+			s.tracker.UnaryServerInterceptor(),
+			errorInfoUnaryServerInterceptor,
 			grpc_recovery.UnaryServerInterceptor(),
 			telemetryInt.UnaryServerInterceptor(),
 			otelgrpc.UnaryServerInterceptor(),
@@ -464,6 +711,10 @@ func (s *Server) mkGRPCServer(log *zap.Logger, auditLog audit.Log) (*grpc.Server
 				grpc_zap.WithDecider(loggingDecider),
 				grpc_zap.WithMessageProducer(messageProducer),
 			),
+			spiffeUnaryInt,
+			apiKeyUnaryInt,
+			rateLimitUnaryInt,
+			loadSheddingUnaryInt,
 			grpc_zap.PayloadUnaryServerInterceptor(payloadLog, payloadLoggingDecider(s.conf)),
 			auditInterceptor,
 			cerbosVersionUnaryServerInterceptor,
@@ -478,7 +729,7 @@ func (s *Server) mkGRPCServer(log *zap.Logger, auditLog audit.Log) (*grpc.Server
 	return grpc.NewServer(opts...), nil
 }
 
-func (s *Server) startHTTPServer(ctx context.Context, l net.Listener, grpcSrv *grpc.Server, zpagesEnabled bool) (*http.Server, error) {
+func (s *Server) startHTTPServer(ctx context.Context, l net.Listener, grpcSrv *grpc.Server, param Param) (*http.Server, error) {
 	log := zap.S().Named("http")
 
 	grpcConn, err := s.mkGRPCConn(ctx)
@@ -486,7 +737,7 @@ func (s *Server) startHTTPServer(ctx context.Context, l net.Listener, grpcSrv *g
 		return nil, err
 	}
 
-	gwmux := runtime.NewServeMux(
+	gwmuxOpts := []runtime.ServeMuxOption{
 		runtime.WithForwardResponseOption(customHTTPResponseCode),
 		runtime.WithMarshalerOption("application/json+pretty", &runtime.JSONPb{
 			MarshalOptions:   protojson.MarshalOptions{Indent: "  "},
@@ -496,9 +747,21 @@ func (s *Server) startHTTPServer(ctx context.Context, l net.Listener, grpcSrv *g
 			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: false},
 		}),
 		runtime.WithRoutingErrorHandler(handleRoutingError),
+		runtime.WithErrorHandler(handleHTTPError),
 		runtime.WithHealthEndpointAt(healthpb.NewHealthClient(grpcConn), healthEndpoint),
+	}
+
+	headerMatcher := runtime.DefaultHeaderMatcher
+	if s.apiKeyAuth != nil {
+		headerMatcher = s.apiKeyAuth.headerMatcher()
+	}
+	gwmuxOpts = append(gwmuxOpts,
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher(headerMatcher)),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
 	)
 
+	gwmux := runtime.NewServeMux(gwmuxOpts...)
+
 	if err := svcv1.RegisterCerbosServiceHandler(ctx, gwmux, grpcConn); err != nil {
 		log.Errorw("Failed to register Cerbos HTTP service", "error", err)
 		return nil, fmt.Errorf("failed to register Cerbos HTTP service: %w", err)
@@ -520,11 +783,30 @@ func (s *Server) startHTTPServer(ctx context.Context, l net.Listener, grpcSrv *g
 	cerbosMux := mux.NewRouter()
 	// handle gRPC requests that come over http
 	cerbosMux.MatcherFunc(func(r *http.Request, _ *mux.RouteMatch) bool {
-		return r.ProtoMajor == 2 && strings.Contains(r.Header.Get("Content-Type"), "application/grpc")
+		return isGRPCRequest(r)
 	}).Handler(tracing.HTTPHandler(grpcSrv, "grpc"))
 
-	cerbosMux.PathPrefix(adminEndpoint).Handler(tracing.HTTPHandler(prettyJSON(gwmux), adminEndpoint))
-	cerbosMux.PathPrefix(apiEndpoint).Handler(tracing.HTTPHandler(prettyJSON(gwmux), apiEndpoint))
+	if s.conf.AdminAPI.Enabled {
+		if syncer, ok := param.Store.(storage.Syncer); ok {
+			syncHandler := newStoreSyncHandler(syncer, s.adminAuth, s.conf.AdminAPI.StoreSyncWebhookSecret)
+			go syncHandler.run(ctx)
+
+			cerbosMux.Path(storeSyncEndpoint).Handler(tracing.HTTPHandler(s.withSPIFFE(gwmux, syncHandler), storeSyncEndpoint))
+		}
+	}
+
+	if s.conf.GraphQLEnabled {
+		graphqlHandler, err := graphql.NewHandler(grpcConn, gwmux, int64(s.conf.Advanced.GRPC.MaxRecvMsgSizeBytes))
+		if err != nil {
+			log.Errorw("Failed to create GraphQL handler", "error", err)
+			return nil, fmt.Errorf("failed to create GraphQL handler: %w", err)
+		}
+
+		cerbosMux.Path(graphqlEndpoint).Handler(tracing.HTTPHandler(s.withRateLimit(gwmux, s.withSPIFFE(gwmux, s.withCompression(gwmux, graphqlHandler))), graphqlEndpoint))
+	}
+
+	cerbosMux.PathPrefix(adminEndpoint).Handler(tracing.HTTPHandler(s.withRateLimit(gwmux, s.withSPIFFE(gwmux, s.withCompression(gwmux, prettyJSON(gwmux)))), adminEndpoint))
+	cerbosMux.PathPrefix(apiEndpoint).Handler(tracing.HTTPHandler(s.withRateLimit(gwmux, s.withSPIFFE(gwmux, s.withCompression(gwmux, prettyJSON(gwmux)))), apiEndpoint))
 	cerbosMux.Path(healthEndpoint).Handler(prettyJSON(gwmux))
 	cerbosMux.Path(schemaEndpoint).HandlerFunc(schema.ServeSvcSwagger)
 
@@ -532,7 +814,13 @@ func (s *Server) startHTTPServer(ctx context.Context, l net.Listener, grpcSrv *g
 		cerbosMux.Path(metricsEndpoint).Handler(s.ocExporter)
 	}
 
-	if zpagesEnabled {
+	if param.Engine != nil {
+		if _, ok := param.Engine.ConditionProfile(); ok {
+			cerbosMux.Path(conditionsEndpoint).Handler(conditionProfileHandler(param.Engine))
+		}
+	}
+
+	if param.ZPagesEnabled {
 		hm := http.NewServeMux()
 		zpages.Handle(hm, zpagesEndpoint)
 
@@ -541,7 +829,7 @@ func (s *Server) startHTTPServer(ctx context.Context, l net.Listener, grpcSrv *g
 
 	cerbosMux.HandleFunc("/", schema.ServeUI)
 
-	httpHandler := withCORS(s.conf, cerbosMux)
+	httpHandler := s.tracker.httpHandler(withCORS(s.conf, cerbosMux))
 
 	h := &http.Server{
 		ErrorLog:          zap.NewStdLog(zap.L().Named("http.error")),
@@ -552,6 +840,16 @@ func (s *Server) startHTTPServer(ctx context.Context, l net.Listener, grpcSrv *g
 		IdleTimeout:       s.conf.Advanced.HTTP.IdleTimeout,
 	}
 
+	if s.conf.HTTP3.Enabled {
+		h3, err := s.startHTTP3Server(h, httpHandler)
+		if err != nil {
+			log.Errorw("Failed to start HTTP/3 server", "error", err)
+			return nil, err
+		}
+
+		h.Handler = h2c.NewHandler(withAltSvc(h3, httpHandler), &http2.Server{})
+	}
+
 	s.group.Go(func() error {
 		log.Infof("Starting HTTP server at %s", s.conf.HTTPListenAddr)
 		err := h.Serve(l)
@@ -567,6 +865,24 @@ func (s *Server) startHTTPServer(ctx context.Context, l net.Listener, grpcSrv *g
 	return h, nil
 }
 
+// withRateLimit rate limits the API requests received by the HTTP server if rate limiting is enabled.
+func (s *Server) withRateLimit(gwmux *runtime.ServeMux, h http.Handler) http.Handler {
+	if s.rateLimiter == nil {
+		return h
+	}
+
+	return s.rateLimiter.httpHandler(gwmux, h)
+}
+
+// withSPIFFE authorizes the API requests received by the HTTP server if SPIFFE authorization is enabled.
+func (s *Server) withSPIFFE(gwmux *runtime.ServeMux, h http.Handler) http.Handler {
+	if s.spiffeAuthz == nil {
+		return h
+	}
+
+	return s.spiffeAuthz.httpHandler(gwmux, h)
+}
+
 func defaultGRPCDialOpts() []grpc.DialOption {
 	// see https://github.com/grpc/grpc/blob/master/doc/connection-backoff.md
 	return []grpc.DialOption{
@@ -591,6 +907,10 @@ func (s *Server) mkGRPCConn(ctx context.Context) (*grpc.ClientConn, error) {
 		opts = append(opts, grpc.WithTransportCredentials(local.NewCredentials()))
 	}
 
+	if s.gatewayToken != "" {
+		opts = append(opts, gatewayDialOptions(s.gatewayToken)...)
+	}
+
 	grpcConn, err := grpc.DialContext(ctx, s.conf.GRPCListenAddr, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to dial gRPC: %w", err)
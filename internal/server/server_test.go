@@ -270,6 +270,37 @@ func TestAdminService(t *testing.T) {
 	t.Run("http", tr.RunHTTPTests(fmt.Sprintf("https://%s", conf.HTTPListenAddr), creds))
 }
 
+func TestRequestPolicies(t *testing.T) {
+	tpg := func(t *testing.T) testParam {
+		t.Helper()
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		t.Cleanup(cancelFunc)
+
+		store, err := disk.NewStore(ctx, &disk.Conf{Directory: test.PathToDir(t, "store")})
+		require.NoError(t, err)
+
+		schemaMgr := schema.NewFromConf(ctx, store, schema.NewConf(schema.EnforcementReject))
+		policyLoader := compile.NewManagerFromDefaultConf(ctx, store, schemaMgr)
+
+		return testParam{
+			store:        store,
+			policyLoader: policyLoader,
+			schemaMgr:    schemaMgr,
+		}
+	}
+
+	conf := defaultConf()
+	conf.HTTPListenAddr = getFreeListenAddr(t)
+	conf.GRPCListenAddr = getFreeListenAddr(t)
+	conf.RequestPoliciesEnabled = true
+
+	startServer(t, conf, tpg)
+
+	tr := LoadTestCases(t, "request_policies")
+	t.Run("grpc", tr.RunGRPCTests(conf.GRPCListenAddr, grpc.WithTransportCredentials(local.NewCredentials())))
+	t.Run("http", tr.RunHTTPTests(fmt.Sprintf("http://%s", conf.HTTPListenAddr), nil))
+}
+
 func getFreeListenAddr(t *testing.T) string {
 	t.Helper()
 
@@ -308,7 +339,7 @@ func startServer(t *testing.T, conf *Conf, tpg testParamGen) {
 	})
 	require.NoError(t, err, "Failed to create engine")
 
-	param := Param{AuditLog: auditLog, AuxData: auxData, Store: tp.store, Engine: eng}
+	param := Param{AuditLog: auditLog, AuxData: auxData, Store: tp.store, PolicyLoader: tp.policyLoader, SchemaMgr: tp.schemaMgr, Engine: eng}
 
 	s := NewServer(conf)
 	go func() {
@@ -324,8 +355,13 @@ func defaultConf() *Conf {
 	conf.SetDefaults()
 
 	conf.RequestLimits = RequestLimitsConf{
-		MaxActionsPerResource:  5,
-		MaxResourcesPerRequest: 5,
+		MaxActionsPerResource:       5,
+		MaxResourcesPerRequest:      5,
+		MaxPlanEntriesPerRequest:    5,
+		MaxPoliciesPerRequest:       5,
+		MaxPolicyBytesPerRequest:    64 * 1024,
+		MaxAttributeBytesPerRequest: 64 * 1024,
+		MaxAuxDataBytesPerRequest:   16 * 1024,
 	}
 	conf.PlaygroundEnabled = true
 
@@ -0,0 +1,42 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows
+
+package server
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"go.uber.org/zap"
+
+	"github.com/cerbos/cerbos/internal/audit"
+)
+
+// handleReloadSignal reloads the configuration when a SIGHUP signal is received.
+func (s *Server) handleReloadSignal(ctx context.Context, auditLog audit.Log) {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+
+	s.group.Go(func() error {
+		defer signal.Stop(sighup)
+
+		log := zap.L().Named("server")
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-sighup:
+				log.Info("Reloading configuration")
+				if err := s.reloadConfig(auditLog); err != nil {
+					log.Error("Failed to reload configuration", zap.Error(err))
+					continue
+				}
+				log.Info("Configuration reloaded")
+			}
+		}
+	})
+}
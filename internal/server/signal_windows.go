@@ -0,0 +1,14 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package server
+
+import (
+	"context"
+
+	"github.com/cerbos/cerbos/internal/audit"
+)
+
+func (s *Server) handleReloadSignal(_ context.Context, _ audit.Log) {}
@@ -0,0 +1,242 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/multierr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
+	"github.com/cerbos/cerbos/internal/svc"
+)
+
+const spiffeScheme = "spiffe"
+
+var errSPIFFEIDRequired = svc.NewError(codes.Unauthenticated, svc.ErrCodeAuthenticationFailed, "client certificate with a SPIFFE ID required")
+
+// spiffeAPI identifies the API that a request is made to, for the purpose of SPIFFE authorization.
+type spiffeAPI string
+
+const (
+	spiffeAPICerbos     spiffeAPI = "api"
+	spiffeAPIAdmin      spiffeAPI = "admin"
+	spiffeAPIPlayground spiffeAPI = "playground"
+)
+
+// spiffeAuthz authorizes callers by the SPIFFE ID in the URI SAN of their verified TLS client certificate.
+type spiffeAuthz struct {
+	allowed      map[spiffeAPI][]string
+	tenants      map[string][]string
+	gatewayToken string
+}
+
+func newSPIFFEAuthz(conf SPIFFEConf, gatewayToken string) *spiffeAuthz {
+	return &spiffeAuthz{
+		allowed: map[spiffeAPI][]string{
+			spiffeAPICerbos:     conf.AllowedIDs.API,
+			spiffeAPIAdmin:      conf.AllowedIDs.Admin,
+			spiffeAPIPlayground: conf.AllowedIDs.Playground,
+		},
+		tenants:      conf.Tenants,
+		gatewayToken: gatewayToken,
+	}
+}
+
+// validateSPIFFEPatterns checks that the patterns of the field are SPIFFE IDs with valid path.Match syntax.
+func validateSPIFFEPatterns(field string, patterns []string) (errs error) {
+	for i, p := range patterns {
+		if !strings.HasPrefix(p, spiffeScheme+"://") {
+			errs = multierr.Append(errs, fmt.Errorf("%s[%d]: %q is not a SPIFFE ID", field, i, p))
+			continue
+		}
+
+		if _, err := path.Match(p, ""); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("%s[%d]: invalid pattern %q: %w", field, i, p, err))
+		}
+	}
+
+	return errs
+}
+
+// matchesAny returns true if the SPIFFE ID matches one of the patterns, which are validated when the configuration is loaded.
+func matchesAny(patterns []string, id string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, id); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// authorize checks that the SPIFFE ID of the client certificate matches one of the patterns allowed for the API
+// and returns the tenant that the SPIFFE ID is mapped to, or an empty string if it's not mapped to a tenant.
+func (sa *spiffeAuthz) authorize(api spiffeAPI, state *tls.ConnectionState) (string, error) {
+	id, ok := spiffeID(state)
+	if !ok {
+		return "", errSPIFFEIDRequired
+	}
+
+	if !matchesAny(sa.allowed[api], id) {
+		return "", svc.NewErrorf(codes.PermissionDenied, svc.ErrCodePermissionDenied, "SPIFFE ID %q is not allowed to use the %s API", id, api)
+	}
+
+	return sa.tenant(id)
+}
+
+// tenant returns the tenant whose patterns match the SPIFFE ID. A SPIFFE ID that matches the patterns of several tenants
+// is rejected rather than bound to an arbitrary one of them.
+func (sa *spiffeAuthz) tenant(id string) (string, error) {
+	var tenant string
+	for t, patterns := range sa.tenants {
+		if !matchesAny(patterns, id) {
+			continue
+		}
+
+		if tenant != "" {
+			return "", svc.NewErrorf(codes.PermissionDenied, svc.ErrCodePermissionDenied, "SPIFFE ID %q is mapped to more than one tenant", id)
+		}
+		tenant = t
+	}
+
+	return tenant, nil
+}
+
+// spiffeID returns the SPIFFE ID of the verified client certificate.
+// An X.509 SVID has exactly one URI SAN, which holds the SPIFFE ID.
+func spiffeID(state *tls.ConnectionState) (string, bool) {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	return certSPIFFEID(state.VerifiedChains[0][0])
+}
+
+func certSPIFFEID(cert *x509.Certificate) (string, bool) {
+	if len(cert.URIs) != 1 || cert.URIs[0].Scheme != spiffeScheme || cert.URIs[0].Host == "" {
+		return "", false
+	}
+
+	return cert.URIs[0].String(), true
+}
+
+// grpcAPI returns the API that the gRPC method belongs to. Health checks and reflection are not authorized.
+func grpcAPI(fullMethod string) (spiffeAPI, bool) {
+	service, _, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return "", false
+	}
+
+	switch service {
+	case svcv1.CerbosService_ServiceDesc.ServiceName:
+		return spiffeAPICerbos, true
+	case svcv1.CerbosAdminService_ServiceDesc.ServiceName:
+		return spiffeAPIAdmin, true
+	case svcv1.CerbosPlaygroundService_ServiceDesc.ServiceName:
+		return spiffeAPIPlayground, true
+	default:
+		return "", false
+	}
+}
+
+// httpAPI returns the API that the HTTP request is made to.
+func httpAPI(r *http.Request) spiffeAPI {
+	switch {
+	case strings.HasPrefix(r.URL.Path, playgroundEndpoint):
+		return spiffeAPIPlayground
+	case strings.HasPrefix(r.URL.Path, adminEndpoint):
+		return spiffeAPIAdmin
+	default:
+		return spiffeAPICerbos
+	}
+}
+
+// authorizeGRPC authorizes the request and returns a context that is bound to the tenant of the SPIFFE ID, if any.
+// Requests forwarded by the HTTP gateway have already been authorized, so they are bound to the tenant that the
+// gateway found.
+func (sa *spiffeAuthz) authorizeGRPC(ctx context.Context, fullMethod string) (context.Context, error) {
+	api, ok := grpcAPI(fullMethod)
+	if !ok {
+		return ctx, nil
+	}
+
+	var tenant string
+	if fromGateway(ctx, sa.gatewayToken) {
+		tenant = gatewayTenant(ctx)
+	} else {
+		var state *tls.ConnectionState
+		if p, ok := peer.FromContext(ctx); ok {
+			if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+				state = &tlsInfo.State
+			}
+		}
+
+		var err error
+		if tenant, err = sa.authorize(api, state); err != nil {
+			return nil, err
+		}
+	}
+
+	if tenant != "" {
+		ctx = svc.ContextWithTenant(ctx, tenant)
+	}
+
+	return ctx, nil
+}
+
+func (sa *spiffeAuthz) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := sa.authorizeGRPC(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (sa *spiffeAuthz) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := sa.authorizeGRPC(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+// httpHandler authorizes the requests before passing them to the handler. Rejected requests receive the same error
+// response as the API. Requests from SPIFFE IDs that are mapped to a tenant are bound to the tenant.
+func (sa *spiffeAuthz) httpHandler(gwmux *runtime.ServeMux, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenant, err := sa.authorize(httpAPI(r), r.TLS)
+		if err != nil {
+			_, marshaler := runtime.MarshalerForRequest(gwmux, r)
+			handleHTTPError(r.Context(), gwmux, marshaler, w, r, err)
+			return
+		}
+
+		if tenant != "" {
+			r = r.WithContext(svc.ContextWithTenant(r.Context(), tenant))
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,225 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
+	"github.com/cerbos/cerbos/internal/svc"
+)
+
+func TestSPIFFEAuthz(t *testing.T) {
+	gatewayToken, err := newGatewayToken()
+	require.NoError(t, err)
+
+	sa := newSPIFFEAuthz(SPIFFEConf{
+		Enabled: true,
+		AllowedIDs: SPIFFEAllowedIDsConf{
+			API:   []string{"spiffe://example.org/ns/*/sa/app"},
+			Admin: []string{"spiffe://example.org/ns/cerbos/sa/admin"},
+		},
+		Tenants: map[string][]string{
+			"acme":   {"spiffe://example.org/ns/acme/sa/*", "spiffe://example.org/ns/shared/sa/app"},
+			"globex": {"spiffe://example.org/ns/globex/sa/*", "spiffe://example.org/ns/shared/sa/*"},
+		},
+	}, gatewayToken)
+
+	mkState := func(ids ...string) *tls.ConnectionState {
+		cert := &x509.Certificate{}
+		for _, id := range ids {
+			u, err := url.Parse(id)
+			require.NoError(t, err)
+			cert.URIs = append(cert.URIs, u)
+		}
+
+		return &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+	}
+
+	requireCode := func(t *testing.T, want svc.ErrorCode, err error) {
+		t.Helper()
+
+		require.Error(t, err)
+		require.Equal(t, want, svc.ErrorCodeOf(status.Convert(err)))
+	}
+
+	authorize := func(api spiffeAPI, state *tls.ConnectionState) error {
+		_, err := sa.authorize(api, state)
+		return err
+	}
+
+	t.Run("authorize", func(t *testing.T) {
+		require.NoError(t, authorize(spiffeAPICerbos, mkState("spiffe://example.org/ns/billing/sa/app")))
+		require.NoError(t, authorize(spiffeAPIAdmin, mkState("spiffe://example.org/ns/cerbos/sa/admin")))
+
+		// * doesn't match more than one path segment
+		requireCode(t, svc.ErrCodePermissionDenied, authorize(spiffeAPICerbos, mkState("spiffe://example.org/ns/billing/team/sa/app")))
+		requireCode(t, svc.ErrCodePermissionDenied, authorize(spiffeAPIAdmin, mkState("spiffe://example.org/ns/billing/sa/app")))
+		// APIs without patterns can't be used by any caller
+		requireCode(t, svc.ErrCodePermissionDenied, authorize(spiffeAPIPlayground, mkState("spiffe://example.org/ns/cerbos/sa/admin")))
+
+		requireCode(t, svc.ErrCodeAuthenticationFailed, authorize(spiffeAPICerbos, nil))
+		requireCode(t, svc.ErrCodeAuthenticationFailed, authorize(spiffeAPICerbos, &tls.ConnectionState{}))
+		requireCode(t, svc.ErrCodeAuthenticationFailed, authorize(spiffeAPICerbos, mkState("https://example.org/ns/billing/sa/app")))
+		requireCode(t, svc.ErrCodeAuthenticationFailed, authorize(spiffeAPICerbos, mkState("spiffe://example.org/ns/billing/sa/app", "spiffe://example.org/other")))
+	})
+
+	t.Run("tenant", func(t *testing.T) {
+		tenant, err := sa.authorize(spiffeAPICerbos, mkState("spiffe://example.org/ns/acme/sa/app"))
+		require.NoError(t, err)
+		require.Equal(t, "acme", tenant)
+
+		tenant, err = sa.authorize(spiffeAPICerbos, mkState("spiffe://example.org/ns/billing/sa/app"))
+		require.NoError(t, err)
+		require.Empty(t, tenant)
+
+		// SPIFFE IDs that match the patterns of several tenants are rejected
+		_, err = sa.authorize(spiffeAPICerbos, mkState("spiffe://example.org/ns/shared/sa/app"))
+		requireCode(t, svc.ErrCodePermissionDenied, err)
+	})
+
+	t.Run("grpc", func(t *testing.T) {
+		interceptor := sa.UnaryServerInterceptor()
+		var handlerCtx context.Context
+		invoke := func(ctx context.Context, method string) error {
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, _ any) (any, error) {
+				handlerCtx = ctx
+				return nil, nil
+			})
+			return err
+		}
+
+		mkCtx := func(ids ...string) context.Context {
+			return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: *mkState(ids...)}})
+		}
+
+		const checkMethod = "/cerbos.svc.v1.CerbosService/CheckResources"
+		require.NoError(t, invoke(mkCtx("spiffe://example.org/ns/billing/sa/app"), checkMethod))
+		require.Equal(t, codes.PermissionDenied, status.Code(invoke(mkCtx("spiffe://example.org/ns/billing/sa/app"), "/cerbos.svc.v1.CerbosAdminService/ListPolicies")))
+		require.Equal(t, codes.PermissionDenied, status.Code(invoke(mkCtx("spiffe://example.org/ns/billing/sa/app"), "/cerbos.svc.v1.CerbosPlaygroundService/PlaygroundValidate")))
+		require.Equal(t, codes.Unauthenticated, status.Code(invoke(context.Background(), checkMethod)))
+
+		// health checks and requests forwarded by the HTTP gateway are not authorized again
+		require.NoError(t, invoke(context.Background(), "/grpc.health.v1.Health/Check"))
+		require.NoError(t, invoke(metadata.NewIncomingContext(context.Background(), metadata.Pairs(gatewayTokenKey, gatewayToken)), checkMethod))
+		require.Error(t, invoke(metadata.NewIncomingContext(context.Background(), metadata.Pairs(gatewayTokenKey, "forged")), checkMethod))
+
+		// requests are bound to the tenant of the SPIFFE ID or the tenant forwarded by the HTTP gateway
+		require.NoError(t, invoke(mkCtx("spiffe://example.org/ns/acme/sa/app"), checkMethod))
+		tenant, ok := svc.TenantFromContext(handlerCtx)
+		require.True(t, ok)
+		require.Equal(t, "acme", tenant)
+
+		require.NoError(t, invoke(metadata.NewIncomingContext(context.Background(), metadata.Pairs(gatewayTokenKey, gatewayToken, gatewayTenantKey, "globex")), checkMethod))
+		tenant, ok = svc.TenantFromContext(handlerCtx)
+		require.True(t, ok)
+		require.Equal(t, "globex", tenant)
+
+		require.NoError(t, invoke(mkCtx("spiffe://example.org/ns/billing/sa/app"), checkMethod))
+		_, ok = svc.TenantFromContext(handlerCtx)
+		require.False(t, ok)
+	})
+
+	t.Run("http", func(t *testing.T) {
+		gwmux := runtime.NewServeMux()
+		h := sa.httpHandler(gwmux, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tenant, _ := svc.TenantFromContext(r.Context())
+			w.Header().Set(svc.TenantHeader, tenant)
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		testCases := []struct {
+			state *tls.ConnectionState
+			path  string
+			want  int
+		}{
+			{path: "/api/check/resources", state: mkState("spiffe://example.org/ns/billing/sa/app"), want: http.StatusOK},
+			{path: graphqlEndpoint, state: mkState("spiffe://example.org/ns/billing/sa/app"), want: http.StatusOK},
+			{path: "/admin/policies", state: mkState("spiffe://example.org/ns/cerbos/sa/admin"), want: http.StatusOK},
+			{path: storeSyncEndpoint, state: mkState("spiffe://example.org/ns/billing/sa/app"), want: http.StatusForbidden},
+			{path: "/admin/policies", state: mkState("spiffe://example.org/ns/billing/sa/app"), want: http.StatusForbidden},
+			{path: "/api/playground/validate", state: mkState("spiffe://example.org/ns/billing/sa/app"), want: http.StatusForbidden},
+			{path: "/api/check/resources", want: http.StatusUnauthorized},
+		}
+
+		for _, tc := range testCases {
+			req := httptest.NewRequest(http.MethodPost, tc.path, http.NoBody)
+			req.TLS = tc.state
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			require.Equal(t, tc.want, rec.Code, tc.path)
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/check/resources", http.NoBody)
+		req.TLS = mkState("spiffe://example.org/ns/acme/sa/app")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "acme", rec.Header().Get(svc.TenantHeader))
+	})
+}
+
+func TestSPIFFEConfValidate(t *testing.T) {
+	tlsConf := &TLSConf{Cert: "cert.pem", Key: "key.pem", CACert: "ca.pem"}
+
+	testCases := []struct {
+		tls     *TLSConf
+		tenants map[string][]string
+		name    string
+		ids     SPIFFEAllowedIDsConf
+		wantErr bool
+	}{
+		{name: "valid", tls: tlsConf, ids: SPIFFEAllowedIDsConf{API: []string{"spiffe://example.org/ns/*/sa/*"}}},
+		{name: "without_ca_cert", tls: &TLSConf{Cert: "cert.pem", Key: "key.pem"}, wantErr: true},
+		{name: "without_tls", wantErr: true},
+		{name: "not_a_spiffe_id", tls: tlsConf, ids: SPIFFEAllowedIDsConf{Admin: []string{"example.org/admin"}}, wantErr: true},
+		{name: "invalid_pattern", tls: tlsConf, ids: SPIFFEAllowedIDsConf{API: []string{"spiffe://example.org/[app"}}, wantErr: true},
+		{name: "tenants", tls: tlsConf, tenants: map[string][]string{"acme": {"spiffe://example.org/ns/acme/sa/*"}}},
+		{name: "invalid_tenant_pattern", tls: tlsConf, tenants: map[string][]string{"acme": {"example.org/ns/acme"}}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := SPIFFEConf{Enabled: true, AllowedIDs: tc.ids, Tenants: tc.tenants}.validate(tc.tls)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGRPCAPI(t *testing.T) {
+	for method, want := range map[string]spiffeAPI{
+		svcv1.CerbosService_CheckResources_FullMethodName:           spiffeAPICerbos,
+		svcv1.CerbosService_ServerInfo_FullMethodName:               spiffeAPICerbos,
+		svcv1.CerbosAdminService_AddOrUpdatePolicy_FullMethodName:   spiffeAPIAdmin,
+		svcv1.CerbosPlaygroundService_PlaygroundTest_FullMethodName: spiffeAPIPlayground,
+	} {
+		have, ok := grpcAPI(method)
+		require.True(t, ok, method)
+		require.Equal(t, want, have, method)
+	}
+
+	_, ok := grpcAPI("/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo")
+	require.False(t, ok)
+}
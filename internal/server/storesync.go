@@ -0,0 +1,126 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/svc"
+)
+
+const (
+	// maxWebhookPayloadSize is the maximum size of the webhook payloads sent by GitHub.
+	maxWebhookPayloadSize = 25 << 20
+
+	githubSignatureHeader = "X-Hub-Signature-256"
+	githubSignaturePrefix = "sha256="
+	gitlabTokenHeader     = "X-Gitlab-Token"
+)
+
+// storeSyncHandler makes the store pull the changes from its remote source when it's called by a webhook.
+// Syncs run in the background, one at a time. Requests received while a sync is running cause another sync
+// once it completes, so that bursts of requests result in at most two syncs.
+type storeSyncHandler struct {
+	log           *zap.Logger
+	store         storage.Syncer
+	trigger       chan struct{}
+	webhookSecret []byte
+	adminAuth     svc.AdminAuth
+}
+
+func newStoreSyncHandler(store storage.Syncer, adminAuth svc.AdminAuth, webhookSecret string) *storeSyncHandler {
+	h := &storeSyncHandler{
+		log:       zap.L().Named("store-sync"),
+		store:     store,
+		trigger:   make(chan struct{}, 1),
+		adminAuth: adminAuth,
+	}
+
+	if webhookSecret != "" {
+		h.webhookSecret = []byte(webhookSecret)
+	}
+
+	return h
+}
+
+// run syncs the store whenever it's triggered, until the context is cancelled.
+func (h *storeSyncHandler) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.trigger:
+			h.log.Info("Syncing store")
+			if err := h.store.Sync(ctx); err != nil {
+				h.log.Error("Failed to sync store", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (h *storeSyncHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookPayloadSize))
+	if err != nil {
+		http.Error(w, "failed to read request body", http.StatusBadRequest)
+		return
+	}
+
+	if !h.authenticated(r, body) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="cerbos"`)
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	select {
+	case h.trigger <- struct{}{}:
+	default: // a sync is already pending
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// authenticated returns true if the request carries the admin credentials or bearer token, a GitHub signature or a GitLab token.
+func (h *storeSyncHandler) authenticated(r *http.Request, body []byte) bool {
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		_, err := h.adminAuth.Authenticate(r.Context(), authorization)
+		return err == nil
+	}
+
+	if h.webhookSecret == nil {
+		return false
+	}
+
+	if sig := r.Header.Get(githubSignatureHeader); sig != "" {
+		want, err := hex.DecodeString(strings.TrimPrefix(sig, githubSignaturePrefix))
+		if err != nil {
+			return false
+		}
+
+		mac := hmac.New(sha256.New, h.webhookSecret)
+		mac.Write(body)
+		return hmac.Equal(mac.Sum(nil), want)
+	}
+
+	if token := r.Header.Get(gitlabTokenHeader); token != "" {
+		return subtle.ConstantTimeCompare([]byte(token), h.webhookSecret) == 1
+	}
+
+	return false
+}
@@ -0,0 +1,136 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/cerbos/cerbos/internal/svc"
+)
+
+type countingSyncer struct {
+	count atomic.Int32
+}
+
+func (cs *countingSyncer) Sync(context.Context) error {
+	cs.count.Add(1)
+	return nil
+}
+
+func TestStoreSyncHandler(t *testing.T) {
+	const (
+		secret  = "s3cr3t"
+		payload = `{"ref":"refs/heads/main"}`
+	)
+
+	pwdHash, err := bcrypt.GenerateFromPassword([]byte("cerbosAdmin"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	signature := githubSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+
+	testCases := []struct {
+		name   string
+		method string
+		secret string
+		setup  func(*http.Request)
+		want   int
+	}{
+		{
+			name:   "admin_credentials",
+			method: http.MethodPost,
+			setup:  func(r *http.Request) { r.SetBasicAuth("cerbos", "cerbosAdmin") },
+			want:   http.StatusAccepted,
+		},
+		{
+			name:   "wrong_admin_credentials",
+			method: http.MethodPost,
+			secret: secret,
+			setup:  func(r *http.Request) { r.SetBasicAuth("cerbos", "wrong") },
+			want:   http.StatusUnauthorized,
+		},
+		{
+			name:   "github_signature",
+			method: http.MethodPost,
+			secret: secret,
+			setup:  func(r *http.Request) { r.Header.Set(githubSignatureHeader, signature) },
+			want:   http.StatusAccepted,
+		},
+		{
+			name:   "wrong_github_signature",
+			method: http.MethodPost,
+			secret: "other",
+			setup:  func(r *http.Request) { r.Header.Set(githubSignatureHeader, signature) },
+			want:   http.StatusUnauthorized,
+		},
+		{
+			name:   "gitlab_token",
+			method: http.MethodPost,
+			secret: secret,
+			setup:  func(r *http.Request) { r.Header.Set(gitlabTokenHeader, secret) },
+			want:   http.StatusAccepted,
+		},
+		{
+			name:   "webhook_secret_not_configured",
+			method: http.MethodPost,
+			setup:  func(r *http.Request) { r.Header.Set(gitlabTokenHeader, "") },
+			want:   http.StatusUnauthorized,
+		},
+		{
+			name:   "wrong_method",
+			method: http.MethodGet,
+			secret: secret,
+			setup:  func(r *http.Request) { r.Header.Set(gitlabTokenHeader, secret) },
+			want:   http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			h := newStoreSyncHandler(&countingSyncer{}, svc.AdminAuth{Username: "cerbos", PasswordHash: pwdHash}, tc.secret)
+
+			req := httptest.NewRequest(tc.method, storeSyncEndpoint, strings.NewReader(payload))
+			tc.setup(req)
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			require.Equal(t, tc.want, rec.Code)
+		})
+	}
+
+	t.Run("coalesces_requests", func(t *testing.T) {
+		syncer := &countingSyncer{}
+		h := newStoreSyncHandler(syncer, svc.AdminAuth{Username: "cerbos", PasswordHash: pwdHash}, secret)
+
+		for i := 0; i < 5; i++ {
+			req := httptest.NewRequest(http.MethodPost, storeSyncEndpoint, strings.NewReader(payload))
+			req.Header.Set(gitlabTokenHeader, secret)
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			require.Equal(t, http.StatusAccepted, rec.Code)
+		}
+
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		t.Cleanup(cancelFunc)
+		go h.run(ctx)
+
+		require.Eventually(t, func() bool { return syncer.count.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+		require.Never(t, func() bool { return syncer.count.Load() > 1 }, 100*time.Millisecond, 10*time.Millisecond)
+	})
+}
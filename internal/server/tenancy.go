@@ -0,0 +1,72 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/engine"
+	internalSchema "github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/tenancy"
+)
+
+// Tenant holds the components that evaluate the requests of a tenant.
+// They are separate from the components of other tenants, so the policies, compiled policies and cached decisions
+// of a tenant are never used to evaluate the requests of another tenant.
+type Tenant struct {
+	Engine       *engine.Engine
+	Store        storage.Store
+	PolicyLoader engine.PolicyLoader
+	SchemaMgr    internalSchema.Manager
+	ID           string
+}
+
+// newTenants creates the components of the configured tenants. The tenants share the audit log and the metadata extractor of the server.
+func newTenants(ctx context.Context, conf *Conf, tenancyConf *tenancy.Conf, auditLog audit.Log, mdExtractor audit.MetadataExtractor) ([]*Tenant, error) {
+	if len(tenancyConf.Tenants) == 0 {
+		return nil, nil
+	}
+
+	tenants := make([]*Tenant, len(tenancyConf.Tenants))
+	for i, tc := range tenancyConf.Tenants {
+		store, err := tenancy.NewStore(ctx, config.Global(), tc)
+		if err != nil {
+			return nil, err
+		}
+
+		schemaMgr, err := internalSchema.New(ctx, store)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create schema manager of tenant %q: %w", tc.ID, err)
+		}
+
+		policyLoader, err := newPolicyLoader(ctx, store, schemaMgr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create policy loader of tenant %q: %w", tc.ID, err)
+		}
+
+		var decisionCache *engine.DecisionCache
+		if conf.DecisionCache.Enabled {
+			decisionCache = engine.NewDecisionCache(conf.DecisionCache.MaxEntries, conf.DecisionCache.TTL, store)
+		}
+
+		eng, err := engine.New(ctx, engine.Components{
+			PolicyLoader:      policyLoader,
+			SchemaMgr:         schemaMgr,
+			AuditLog:          auditLog,
+			MetadataExtractor: mdExtractor,
+			DecisionCache:     decisionCache,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create engine of tenant %q: %w", tc.ID, err)
+		}
+
+		tenants[i] = &Tenant{ID: tc.ID, Store: store, PolicyLoader: policyLoader, SchemaMgr: schemaMgr, Engine: eng}
+	}
+
+	return tenants, nil
+}
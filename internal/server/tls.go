@@ -0,0 +1,89 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+// certReloader serves the certificates from the files in the TLS configuration.
+// The files are read again by reload, so that rotated certificates are used by new connections without restarting the server.
+type certReloader struct {
+	conf    *TLSConf
+	current atomic.Pointer[tls.Config]
+}
+
+func newCertReloader(conf *TLSConf) (*certReloader, error) {
+	cr := &certReloader{conf: conf}
+	if err := cr.reload(); err != nil {
+		return nil, err
+	}
+
+	return cr, nil
+}
+
+// reload reads the certificate files. The previous certificates continue to be used if the files can't be read.
+func (cr *certReloader) reload() error {
+	tlsConfig, err := loadTLSConfig(cr.conf)
+	if err != nil {
+		return err
+	}
+
+	cr.current.Store(tlsConfig)
+	return nil
+}
+
+// tlsConfig returns a TLS configuration that uses the most recently loaded certificates for each new connection.
+func (cr *certReloader) tlsConfig() *tls.Config {
+	tlsConfig := util.DefaultTLSConfig()
+	tlsConfig.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		return cr.current.Load(), nil
+	}
+	// the HTTP gateway uses the same configuration to connect to the gRPC server
+	tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		return &cr.current.Load().Certificates[0], nil
+	}
+
+	return tlsConfig
+}
+
+func loadTLSConfig(conf *TLSConf) (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(conf.Cert, conf.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load certificate and key: %w", err)
+	}
+
+	tlsConfig := util.DefaultTLSConfig()
+	tlsConfig.Certificates = []tls.Certificate{certificate}
+
+	if conf.CACert != "" {
+		if _, err := os.Stat(conf.CACert); err != nil {
+			//nolint:nilerr
+			return tlsConfig, nil
+		}
+
+		certPool := x509.NewCertPool()
+		bs, err := os.ReadFile(conf.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load CA certificate: %w", err)
+		}
+
+		ok := certPool.AppendCertsFromPEM(bs)
+		if !ok {
+			return nil, errors.New("failed to append certificates to the pool")
+		}
+
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		tlsConfig.ClientCAs = certPool
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,299 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package signature signs and verifies policy bundles and archives.
+// Signatures are compatible with `cosign sign-blob` and `cosign verify-blob` using a key pair: they are the base64 encoding
+// of an ECDSA (ASN.1), RSA (PKCS #1 v1.5) or Ed25519 signature, and the digest of the data is computed with SHA-256.
+package signature
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// Extension is the extension of the detached signature files.
+	Extension = ".sig"
+
+	encryptedKeyPEMType       = "ENCRYPTED SIGSTORE PRIVATE KEY"
+	legacyEncryptedKeyPEMType = "ENCRYPTED COSIGN PRIVATE KEY"
+	scryptKeyLen              = 32
+	secretboxNonceLen         = 24
+)
+
+var (
+	ErrVerificationFailed = errors.New("signature verification failed")
+	ErrUnsupportedKey     = errors.New("unsupported key type: only ECDSA, RSA and Ed25519 keys are supported")
+)
+
+// VerificationConf holds the configuration for verifying signatures.
+type VerificationConf struct {
+	// PublicKey is the path to the PEM-encoded public key used to verify the signatures.
+	PublicKey string `yaml:"publicKey" conf:"required,example=/path/to/cosign.pub"`
+}
+
+// Verifier verifies signatures made with the private key corresponding to a public key.
+type Verifier struct {
+	key crypto.PublicKey
+}
+
+// NewVerifierFromConf creates a verifier from the configuration, which can be nil if verification is disabled.
+func NewVerifierFromConf(conf *VerificationConf) (*Verifier, error) {
+	if conf == nil {
+		return nil, nil
+	}
+
+	return LoadVerifier(conf.PublicKey)
+}
+
+// LoadVerifier creates a verifier from the PEM-encoded public key stored at the given path.
+func LoadVerifier(path string) (*Verifier, error) {
+	keyPEM, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key from %q: %w", path, err)
+	}
+
+	return NewVerifier(keyPEM)
+}
+
+// NewVerifier creates a verifier from a PEM-encoded public key.
+func NewVerifier(keyPEM []byte) (*Verifier, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("failed to decode public key: no PEM data found")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+
+	switch key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return &Verifier{key: key}, nil
+	default:
+		return nil, ErrUnsupportedKey
+	}
+}
+
+// Verify checks the base64-encoded signature against the data.
+func (v *Verifier) Verify(data io.Reader, sig []byte) error {
+	rawSig, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(sig)))
+	if err != nil {
+		return fmt.Errorf("%w: signature is not valid base64: %v", ErrVerificationFailed, err) //nolint:errorlint
+	}
+
+	var ok bool
+	switch key := v.key.(type) {
+	case ed25519.PublicKey:
+		msg, err := io.ReadAll(data)
+		if err != nil {
+			return fmt.Errorf("failed to read data: %w", err)
+		}
+
+		ok = ed25519.Verify(key, msg, rawSig)
+	case *ecdsa.PublicKey:
+		digest, err := sha256Digest(data)
+		if err != nil {
+			return err
+		}
+
+		ok = ecdsa.VerifyASN1(key, digest, rawSig)
+	case *rsa.PublicKey:
+		digest, err := sha256Digest(data)
+		if err != nil {
+			return err
+		}
+
+		ok = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest, rawSig) == nil
+	}
+
+	if !ok {
+		return ErrVerificationFailed
+	}
+
+	return nil
+}
+
+// VerifyFile checks the file against the detached signature stored next to it in a file with the same name and the .sig extension.
+func (v *Verifier) VerifyFile(path string) error {
+	sig, err := os.ReadFile(path + Extension)
+	if err != nil {
+		return fmt.Errorf("%w: failed to read signature: %v", ErrVerificationFailed, err) //nolint:errorlint
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %q: %w", path, err)
+	}
+	defer f.Close()
+
+	if err := v.Verify(f, sig); err != nil {
+		return fmt.Errorf("failed to verify %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// Signer signs data with a private key.
+type Signer struct {
+	key crypto.Signer
+}
+
+// LoadSigner creates a signer from the PEM-encoded private key stored at the given path.
+// Keys encrypted by `cosign generate-key-pair` are decrypted using the password.
+func LoadSigner(path string, password []byte) (*Signer, error) {
+	keyPEM, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key from %q: %w", path, err)
+	}
+
+	return NewSigner(keyPEM, password)
+}
+
+// NewSigner creates a signer from a PEM-encoded private key.
+func NewSigner(keyPEM, password []byte) (*Signer, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("failed to decode private key: no PEM data found")
+	}
+
+	var key any
+	var err error
+	switch block.Type {
+	case encryptedKeyPEMType, legacyEncryptedKeyPEMType:
+		der, decErr := decryptKey(block.Bytes, password)
+		if decErr != nil {
+			return nil, decErr
+		}
+		key, err = x509.ParsePKCS8PrivateKey(der)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		return &Signer{key: k}, nil
+	case *rsa.PrivateKey:
+		return &Signer{key: k}, nil
+	case ed25519.PrivateKey:
+		return &Signer{key: k}, nil
+	default:
+		return nil, ErrUnsupportedKey
+	}
+}
+
+// Sign returns the base64-encoded signature of the data.
+func (s *Signer) Sign(data io.Reader) ([]byte, error) {
+	var sig []byte
+	var err error
+	if _, ok := s.key.(ed25519.PrivateKey); ok {
+		msg, readErr := io.ReadAll(data)
+		if readErr != nil {
+			return nil, fmt.Errorf("failed to read data: %w", readErr)
+		}
+
+		sig, err = s.key.Sign(rand.Reader, msg, crypto.Hash(0))
+	} else {
+		digest, digestErr := sha256Digest(data)
+		if digestErr != nil {
+			return nil, digestErr
+		}
+
+		sig, err = s.key.Sign(rand.Reader, digest, crypto.SHA256)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign data: %w", err)
+	}
+
+	out := make([]byte, base64.StdEncoding.EncodedLen(len(sig)))
+	base64.StdEncoding.Encode(out, sig)
+	return out, nil
+}
+
+// Public returns the public key of the signer.
+func (s *Signer) Public() crypto.PublicKey {
+	return s.key.Public()
+}
+
+// encryptedKey is the format used by cosign to store encrypted private keys.
+type encryptedKey struct {
+	KDF struct {
+		Name   string `json:"name"`
+		Params struct {
+			N int `json:"N"`
+			R int `json:"r"`
+			P int `json:"p"`
+		} `json:"params"`
+		Salt []byte `json:"salt"`
+	} `json:"kdf"`
+	Cipher struct {
+		Name  string `json:"name"`
+		Nonce []byte `json:"nonce"`
+	} `json:"cipher"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+func decryptKey(data, password []byte) ([]byte, error) {
+	var ek encryptedKey
+	if err := json.Unmarshal(data, &ek); err != nil {
+		return nil, fmt.Errorf("failed to decode encrypted private key: %w", err)
+	}
+
+	if ek.KDF.Name != "scrypt" || ek.Cipher.Name != "nacl/secretbox" {
+		return nil, fmt.Errorf("unsupported private key encryption: %s with %s", ek.KDF.Name, ek.Cipher.Name)
+	}
+
+	if len(ek.Cipher.Nonce) != secretboxNonceLen {
+		return nil, errors.New("invalid nonce length in encrypted private key")
+	}
+
+	key, err := scrypt.Key(password, ek.KDF.Salt, ek.KDF.Params.N, ek.KDF.Params.R, ek.KDF.Params.P, scryptKeyLen)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive decryption key: %w", err)
+	}
+
+	var boxKey [scryptKeyLen]byte
+	var nonce [secretboxNonceLen]byte
+	copy(boxKey[:], key)
+	copy(nonce[:], ek.Cipher.Nonce)
+
+	der, ok := secretbox.Open(nil, ek.Ciphertext, &nonce, &boxKey)
+	if !ok {
+		return nil, errors.New("failed to decrypt private key: wrong password")
+	}
+
+	return der, nil
+}
+
+func sha256Digest(data io.Reader) ([]byte, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, data); err != nil {
+		return nil, fmt.Errorf("failed to read data: %w", err)
+	}
+
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package signature_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/cerbos/cerbos/internal/signature"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		keyPEM []byte
+		pubKey crypto.PublicKey
+	}{
+		{name: "ecdsa", keyPEM: pkcs8PEM(t, ecKey), pubKey: ecKey.Public()},
+		{name: "rsa", keyPEM: pkcs8PEM(t, rsaKey), pubKey: rsaKey.Public()},
+		{name: "ed25519", keyPEM: pkcs8PEM(t, edKey), pubKey: edKey.Public()},
+		{name: "cosign", keyPEM: cosignPEM(t, ecKey, []byte("secret")), pubKey: ecKey.Public()},
+	}
+
+	data := []byte("policies")
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			signer, err := signature.NewSigner(tc.keyPEM, []byte("secret"))
+			require.NoError(t, err)
+
+			sig, err := signer.Sign(bytes.NewReader(data))
+			require.NoError(t, err)
+
+			verifier, err := signature.NewVerifier(publicPEM(t, tc.pubKey))
+			require.NoError(t, err)
+
+			require.NoError(t, verifier.Verify(bytes.NewReader(data), sig))
+			require.ErrorIs(t, verifier.Verify(bytes.NewReader([]byte("tampered")), sig), signature.ErrVerificationFailed)
+		})
+	}
+
+	t.Run("wrong_password", func(t *testing.T) {
+		_, err := signature.NewSigner(cosignPEM(t, ecKey, []byte("secret")), []byte("wrong"))
+		require.Error(t, err)
+	})
+
+	t.Run("wrong_key", func(t *testing.T) {
+		signer, err := signature.NewSigner(pkcs8PEM(t, ecKey), nil)
+		require.NoError(t, err)
+
+		sig, err := signer.Sign(bytes.NewReader(data))
+		require.NoError(t, err)
+
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		verifier, err := signature.NewVerifier(publicPEM(t, otherKey.Public()))
+		require.NoError(t, err)
+
+		require.ErrorIs(t, verifier.Verify(bytes.NewReader(data), sig), signature.ErrVerificationFailed)
+	})
+}
+
+func TestVerifyFile(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	signer, err := signature.NewSigner(pkcs8PEM(t, key), nil)
+	require.NoError(t, err)
+
+	verifier, err := signature.NewVerifier(publicPEM(t, key.Public()))
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "policies.zip")
+	require.NoError(t, os.WriteFile(file, []byte("policies"), 0o600))
+
+	require.ErrorIs(t, verifier.VerifyFile(file), signature.ErrVerificationFailed, "Missing signature should fail verification")
+
+	sig, err := signer.Sign(bytes.NewReader([]byte("policies")))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(file+signature.Extension, sig, 0o600))
+
+	require.NoError(t, verifier.VerifyFile(file))
+}
+
+func pkcs8PEM(t *testing.T, key any) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func publicPEM(t *testing.T, key crypto.PublicKey) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+// cosignPEM encrypts the key in the same way as `cosign generate-key-pair`, using cheaper scrypt parameters.
+func cosignPEM(t *testing.T, key any, password []byte) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	salt := make([]byte, 32)
+	_, err = rand.Read(salt)
+	require.NoError(t, err)
+
+	var nonce [24]byte
+	_, err = rand.Read(nonce[:])
+	require.NoError(t, err)
+
+	derived, err := scrypt.Key(password, salt, 1024, 8, 1, 32)
+	require.NoError(t, err)
+
+	var boxKey [32]byte
+	copy(boxKey[:], derived)
+
+	encrypted := map[string]any{
+		"kdf": map[string]any{
+			"name":   "scrypt",
+			"params": map[string]int{"N": 1024, "r": 8, "p": 1},
+			"salt":   salt,
+		},
+		"cipher": map[string]any{
+			"name":  "nacl/secretbox",
+			"nonce": nonce[:],
+		},
+		"ciphertext": secretbox.Seal(nil, der, &nonce, &boxKey),
+	}
+
+	body, err := json.Marshal(encrypted)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED SIGSTORE PRIVATE KEY", Bytes: body})
+}
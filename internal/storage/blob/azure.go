@@ -0,0 +1,107 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package blob
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/azureblob"
+)
+
+func openAzureBucket(ctx context.Context, conf *Conf, bucketURL *url.URL) (*blob.Bucket, error) {
+	azConf := conf.Azure
+	if azConf == nil {
+		azConf = &AzureConf{}
+	}
+
+	clientOpts := &container.ClientOptions{}
+	clientOpts.Transport = &http.Client{Timeout: *conf.RequestTimeout}
+
+	if azConf.ConnectionString != "" {
+		client, err := container.NewClientFromConnectionString(azConf.ConnectionString, bucketURL.Host, clientOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Azure client from connection string: %w", err)
+		}
+		return azureblob.OpenBucket(ctx, client, nil)
+	}
+
+	svcOpts := azureblob.NewDefaultServiceURLOptions()
+	if azConf.AccountName != "" {
+		svcOpts.AccountName = azConf.AccountName
+	}
+	// the SAS token from the AZURE_STORAGE_SAS_TOKEN environment variable is only used if no other method is configured
+	switch {
+	case azConf.SASToken != "":
+		svcOpts.SASToken = strings.TrimPrefix(azConf.SASToken, "?")
+	case azConf.ManagedIdentity != nil:
+		svcOpts.SASToken = ""
+	}
+
+	opener := azureblob.URLOpener{
+		MakeClient:        azureClientMaker(azConf, svcOpts.SASToken != "", clientOpts),
+		ServiceURLOptions: *svcOpts,
+	}
+	// The following query parameters are supported:
+	//
+	//   - domain: the storage domain, such as blob.core.usgovcloudapi.net for Azure Government
+	//   - protocol: http or https
+	//   - cdn: whether the domain is a CDN endpoint for the storage account
+	//   - localemu: whether the domain is a local emulator such as Azurite
+	//   - storage_account: the name of the storage account
+	return opener.OpenBucketURL(ctx, bucketURL)
+}
+
+// azureClientMaker returns a function that creates the client for a container using the configured authentication method.
+func azureClientMaker(conf *AzureConf, useSAS bool, clientOpts *container.ClientOptions) func(azureblob.ServiceURL, azureblob.ContainerName) (*container.Client, error) {
+	return func(svcURL azureblob.ServiceURL, containerName azureblob.ContainerName) (*container.Client, error) {
+		containerURL, err := url.JoinPath(string(svcURL), string(containerName))
+		if err != nil {
+			return nil, fmt.Errorf("failed to build Azure container URL: %w", err)
+		}
+
+		// the SAS token is part of the service URL
+		if useSAS {
+			return container.NewClientWithNoCredential(containerURL, clientOpts)
+		}
+
+		cred, err := azureCredential(conf, clientOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		return container.NewClient(containerURL, cred, clientOpts)
+	}
+}
+
+// azureCredential returns the credential to access the container with. Unless a managed identity is configured,
+// the credential is determined from the environment, which includes workload identity and managed identity.
+func azureCredential(conf *AzureConf, clientOpts *container.ClientOptions) (azcore.TokenCredential, error) {
+	credOpts := clientOpts.ClientOptions
+	if mi := conf.ManagedIdentity; mi != nil {
+		opts := &azidentity.ManagedIdentityCredentialOptions{ClientOptions: credOpts}
+		if mi.ClientID != "" {
+			opts.ID = azidentity.ClientID(mi.ClientID)
+		}
+
+		cred, err := azidentity.NewManagedIdentityCredential(opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Azure managed identity credential: %w", err)
+		}
+		return cred, nil
+	}
+
+	cred, err := azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{ClientOptions: credOpts})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create default Azure credential: %w", err)
+	}
+	return cred, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build tests
+// +build tests
+
+package blob
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAzureBucket(t *testing.T) {
+	t.Setenv("AZURE_STORAGE_ACCOUNT", "")
+	t.Setenv("AZURE_STORAGE_SAS_TOKEN", "")
+
+	requestTimeout := 10 * time.Second
+	testCases := []struct {
+		name    string
+		conf    *AzureConf
+		bucket  string
+		wantURL string
+	}{
+		{
+			name:    "sas_token",
+			conf:    &AzureConf{AccountName: "cerbos", SASToken: "?sv=2022-11-02&sig=secret"},
+			bucket:  "azblob://policies",
+			wantURL: "https://cerbos.blob.core.windows.net/policies?sv=2022-11-02&sig=secret",
+		},
+		{
+			name:    "managed_identity",
+			conf:    &AzureConf{AccountName: "cerbos", ManagedIdentity: &AzureManagedIdentityConf{ClientID: "00000000-0000-0000-0000-000000000000"}},
+			bucket:  "azblob://policies",
+			wantURL: "https://cerbos.blob.core.windows.net/policies",
+		},
+		{
+			name:    "account_from_url",
+			conf:    &AzureConf{ManagedIdentity: &AzureManagedIdentityConf{}},
+			bucket:  "azblob://policies?storage_account=cerbos&domain=blob.core.usgovcloudapi.net",
+			wantURL: "https://cerbos.blob.core.usgovcloudapi.net/policies",
+		},
+		{
+			name:    "connection_string",
+			conf:    &AzureConf{ConnectionString: "DefaultEndpointsProtocol=https;AccountName=cerbos;AccountKey=c2VjcmV0;EndpointSuffix=core.windows.net"},
+			bucket:  "azblob://policies",
+			wantURL: "https://cerbos.blob.core.windows.net/policies",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			u, err := url.Parse(tc.bucket)
+			require.NoError(t, err)
+
+			bucket, err := openAzureBucket(context.Background(), &Conf{RequestTimeout: &requestTimeout, Azure: tc.conf}, u)
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = bucket.Close() })
+
+			var client *container.Client
+			require.True(t, bucket.As(&client))
+			require.Equal(t, tc.wantURL, client.URL())
+		})
+	}
+
+	t.Run("missing_account", func(t *testing.T) {
+		u, err := url.Parse("azblob://policies")
+		require.NoError(t, err)
+
+		_, err = openAzureBucket(context.Background(), &Conf{RequestTimeout: &requestTimeout}, u)
+		require.Error(t, err)
+	})
+}
+
+func TestAzureConfValidate(t *testing.T) {
+	require.NoError(t, (*AzureConf)(nil).Validate())
+	require.NoError(t, (&AzureConf{AccountName: "cerbos", SASToken: "sv=2022-11-02&sig=secret"}).Validate())
+	require.NoError(t, (&AzureConf{ConnectionString: "AccountName=cerbos"}).Validate())
+	require.Error(t, (&AzureConf{SASToken: "sv=2022-11-02&sig=secret", ManagedIdentity: &AzureManagedIdentityConf{}}).Validate())
+	require.Error(t, (&AzureConf{SASToken: "sv=2022-11-02&sig=secret", ConnectionString: "AccountName=cerbos"}).Validate())
+	require.Error(t, (&AzureConf{AccountName: "cerbos", ConnectionString: "AccountName=cerbos"}).Validate())
+}
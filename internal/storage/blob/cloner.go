@@ -6,17 +6,21 @@ package blob
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
 	"io/fs"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"gocloud.dev/blob"
 
+	"github.com/cerbos/cerbos/internal/encryption"
+	"github.com/cerbos/cerbos/internal/signature"
 	"github.com/cerbos/cerbos/internal/util"
 )
 
@@ -31,10 +35,16 @@ type clonerFS interface {
 type infoType map[string][]byte
 
 type Cloner struct {
-	log    *zap.SugaredLogger
-	bucket *blob.Bucket
-	fsys   clonerFS
-	info   infoType // map[path]eTag
+	log      *zap.SugaredLogger
+	bucket   *blob.Bucket
+	fsys     clonerFS
+	verifier *signature.Verifier
+	cipher   *encryption.Cipher
+	info     infoType // map[path]eTag
+	// manifestKey is the key of the manifest listing the hashes of the files in the bucket. When set, the files to
+	// download are determined from the manifest instead of by listing the bucket, and info holds their SHA-256 hashes.
+	manifestKey string
+	manifestMD5 []byte
 }
 
 // NewCloner creates an object to clone the bucket and saves
@@ -74,6 +84,10 @@ func (cr *CloneResult) failures() int {
 }
 
 func (c *Cloner) Clone(ctx context.Context) (*CloneResult, error) {
+	if c.manifestKey != "" {
+		return c.cloneFromManifest(ctx)
+	}
+
 	iter := c.bucket.List(nil)
 	info := make(infoType, len(c.info))
 	cr := new(CloneResult)
@@ -95,7 +109,7 @@ func (c *Cloner) Clone(ctx context.Context) (*CloneResult, error) {
 		if eTag != nil && bytes.Equal(eTag, c.info[file]) {
 			continue
 		}
-		if err = c.downloadToFile(ctx, obj.Key, file); err != nil {
+		if err = c.downloadToFile(ctx, obj.Key, file, nil); err != nil {
 			c.log.Errorw("Failed to download file", "error", err, "file", file)
 			cr.failuresCount++
 		} else {
@@ -117,13 +131,123 @@ func (c *Cloner) Clone(ctx context.Context) (*CloneResult, error) {
 	return cr, nil
 }
 
-func (c *Cloner) downloadToFile(ctx context.Context, key, file string) (err error) {
+// cloneFromManifest downloads the files whose hashes in the manifest differ from the hashes of the local copies,
+// and removes the local files that are no longer in the manifest. Nothing is downloaded if the manifest hasn't changed
+// since the last successful clone.
+func (c *Cloner) cloneFromManifest(ctx context.Context) (*CloneResult, error) {
+	attrs, err := c.bucket.Attributes(ctx, c.manifestKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the attributes of the manifest %q: %w", c.manifestKey, err)
+	}
+
+	cr := new(CloneResult)
+	if attrs.MD5 != nil && bytes.Equal(attrs.MD5, c.manifestMD5) {
+		return cr, nil
+	}
+
+	data, err := c.bucket.ReadAll(ctx, c.manifestKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the manifest %q: %w", c.manifestKey, err)
+	}
+
+	// the manifest decides which files are deleted, so it must be verified like the files themselves
+	if c.verifier != nil {
+		sig, err := c.bucket.ReadAll(ctx, c.manifestKey+signature.Extension)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the signature of the manifest %q: %w", c.manifestKey, err)
+		}
+
+		if err := c.verifier.Verify(bytes.NewReader(data), sig); err != nil {
+			return nil, fmt.Errorf("failed to verify the manifest %q: %w", c.manifestKey, err)
+		}
+	}
+
+	m, err := parseManifest(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the manifest %q: %w", c.manifestKey, err)
+	}
+
+	files := make([]string, 0, len(m))
+	for file := range m {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	info := make(infoType, len(m))
+	for _, file := range files {
+		hash := m[file]
+		if bytes.Equal(hash, c.localHash(file)) {
+			info[file] = hash
+			continue
+		}
+
+		if err := c.downloadToFile(ctx, file, file, hash); err != nil {
+			c.log.Errorw("Failed to download file", "error", err, "file", file)
+			cr.failuresCount++
+			// keep the existing copy, if any, until the download succeeds
+			if prev, ok := c.info[file]; ok {
+				info[file] = prev
+			}
+			continue
+		}
+
+		info[file] = hash
+		cr.updateOrAdd = append(cr.updateOrAdd, file)
+	}
+
+	for key := range c.info {
+		if _, ok := info[key]; !ok {
+			c.log.Debugw("Removing file", "file", key)
+			if err := c.fsys.Remove(key); err != nil {
+				return nil, err
+			}
+			cr.delete = append(cr.delete, key)
+		}
+	}
+
+	c.info = info
+	// retry the failed downloads on the next clone even if the manifest doesn't change
+	if cr.failuresCount == 0 {
+		c.manifestMD5 = attrs.MD5
+	}
+
+	return cr, nil
+}
+
+// localHash returns the SHA-256 hash of the local copy of the file, or nil if there isn't one.
+// The hashes of the files that were already in the checkout directory when the cloner was created are calculated on demand,
+// so that they are not downloaded again after a restart unless they have changed.
+func (c *Cloner) localHash(file string) []byte {
+	hash, ok := c.info[file]
+	if !ok || hash != nil {
+		return hash
+	}
+
+	f, err := c.fsys.Open(file)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	hash, err = sha256Sum(f)
+	if err != nil {
+		return nil
+	}
+
+	return hash
+}
+
+func (c *Cloner) downloadToFile(ctx context.Context, key, file string, wantHash []byte) (err error) {
 	// Create the directories in the path
 	dir := filepath.Dir(file)
 	if err = c.fsys.MkdirAll(dir, 0o775); err != nil { //nolint:gomnd
 		return fmt.Errorf("failed to make dir %q: %w", dir, err)
 	}
 
+	if c.verifier != nil || c.cipher != nil || wantHash != nil {
+		return c.downloadBufferedToFile(ctx, key, file, wantHash)
+	}
+
 	// Set up the local file
 	fd, err := c.fsys.Create(file)
 	if err != nil {
@@ -145,6 +269,87 @@ func (c *Cloner) downloadToFile(ctx context.Context, key, file string) (err erro
 	return nil
 }
 
+// downloadBufferedToFile downloads the object into memory and writes it to the file only if it matches the signature
+// stored in the object with the same key and the .sig extension, it has the expected hash and it can be decrypted.
+func (c *Cloner) downloadBufferedToFile(ctx context.Context, key, file string, wantHash []byte) (err error) {
+	data, err := c.readObject(ctx, key, wantHash)
+	if err != nil {
+		return err
+	}
+
+	fd, err := c.fsys.Create(file)
+	if err != nil {
+		return fmt.Errorf("failed to create a file %q: %w", file, err)
+	}
+	defer multierr.AppendInvoke(&err, multierr.Close(fd))
+
+	if _, err = fd.Write(data); err != nil {
+		return fmt.Errorf("failed to write the file %q: %w", file, err)
+	}
+
+	return nil
+}
+
+// readObject reads the object into memory, verifying its signature and decrypting it if the cloner is configured to.
+// If wantHash is not nil, the SHA-256 hash of the object must match it.
+func (c *Cloner) readObject(ctx context.Context, key string, wantHash []byte) ([]byte, error) {
+	data, err := c.bucket.ReadAll(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the object %q: %w", key, err)
+	}
+
+	if wantHash != nil {
+		if hash := sha256.Sum256(data); !bytes.Equal(hash[:], wantHash) {
+			return nil, fmt.Errorf("hash of the object %q does not match the manifest", key)
+		}
+	}
+
+	if c.verifier != nil {
+		sig, err := c.bucket.ReadAll(ctx, key+signature.Extension)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the signature of the object %q: %w", key, err)
+		}
+
+		if err := c.verifier.Verify(bytes.NewReader(data), sig); err != nil {
+			return nil, fmt.Errorf("failed to verify the object %q: %w", key, err)
+		}
+	}
+
+	if c.cipher != nil {
+		if data, err = c.cipher.Decrypt(data); err != nil {
+			return nil, fmt.Errorf("failed to decrypt the object %q: %w", key, err)
+		}
+	}
+
+	return data, nil
+}
+
+// CloneArchive downloads the policy archive stored in the object with the given key and opens it in memory.
+// It returns a nil filesystem if the object hasn't changed since it was last downloaded.
+func (c *Cloner) CloneArchive(ctx context.Context, key string) (fs.FS, error) {
+	attrs, err := c.bucket.Attributes(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the attributes of the object %q: %w", key, err)
+	}
+
+	if attrs.MD5 != nil && bytes.Equal(attrs.MD5, c.info[key]) {
+		return nil, nil
+	}
+
+	data, err := c.readObject(ctx, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	fsys, err := util.OpenArchiveFS(key, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the archive %q: %w", key, err)
+	}
+
+	c.info = infoType{key: attrs.MD5}
+	return fsys, nil
+}
+
 func (c *Cloner) calculateInfo() (infoType, error) {
 	result := make(infoType)
 	err := fs.WalkDir(c.fsys, ".", func(path string, d fs.DirEntry, err error) error {
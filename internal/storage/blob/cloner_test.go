@@ -5,9 +5,19 @@ package blob
 
 import (
 	"context"
+	"crypto/sha256"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"gocloud.dev/blob"
+
+	"github.com/cerbos/cerbos/internal/test"
+	"github.com/cerbos/cerbos/internal/util"
 )
 
 func TestCloneResult(t *testing.T) {
@@ -57,3 +67,104 @@ func TestCloneResult(t *testing.T) {
 		"resource_policies/policy_10.yaml",
 	}, result.updateOrAdd)
 }
+
+func TestCloneFromManifest(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	is := require.New(t)
+	ctx := context.Background()
+	dir := t.TempDir()
+	bucket := newMinioBucket(ctx, t, "policies")
+
+	const (
+		manifestKey = "SHA256SUMS"
+		updatedFile = "resource_policies/policy_01.yaml"
+		deletedFile = "derived_roles/common_roles.yaml"
+	)
+
+	files := readStoreFiles(t)
+	writeManifest(t, ctx, bucket, manifestKey, files)
+
+	cloner, err := NewCloner(bucket, storeFS{dir})
+	is.NoError(err)
+	cloner.manifestKey = manifestKey
+
+	result, err := cloner.Clone(ctx)
+	is.NoError(err)
+	is.Len(result.updateOrAdd, countIndexed(files))
+	is.Empty(result.delete)
+
+	result, err = cloner.Clone(ctx)
+	is.NoError(err)
+	is.True(result.isEmpty())
+
+	files[updatedFile] = append(files[updatedFile], []byte("\n# updated\n")...)
+	is.NoError(bucket.WriteAll(ctx, updatedFile, files[updatedFile], nil))
+	delete(files, deletedFile)
+	writeManifest(t, ctx, bucket, manifestKey, files)
+
+	result, err = cloner.Clone(ctx)
+	is.NoError(err)
+	is.Equal([]string{updatedFile}, result.updateOrAdd)
+	is.Equal([]string{deletedFile}, result.delete)
+
+	// a new cloner for the same directory only downloads the files that changed since the last clone
+	cloner, err = NewCloner(bucket, storeFS{dir})
+	is.NoError(err)
+	cloner.manifestKey = manifestKey
+
+	result, err = cloner.Clone(ctx)
+	is.NoError(err)
+	is.True(result.isEmpty())
+}
+
+func readStoreFiles(t *testing.T) map[string][]byte {
+	t.Helper()
+
+	dir := test.PathToDir(t, "store")
+	files := make(map[string][]byte)
+	require.NoError(t, filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		key, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		files[filepath.ToSlash(key)] = data
+		return nil
+	}))
+
+	return files
+}
+
+func countIndexed(files map[string][]byte) int {
+	n := 0
+	for file := range files {
+		if util.FileType(file) != util.FileTypeNotIndexed {
+			n++
+		}
+	}
+
+	return n
+}
+
+//nolint:revive
+func writeManifest(t *testing.T, ctx context.Context, bucket *blob.Bucket, key string, files map[string][]byte) {
+	t.Helper()
+
+	var sb strings.Builder
+	for file, data := range files {
+		fmt.Fprintf(&sb, "%x  %s\n", sha256.Sum256(data), file)
+	}
+
+	require.NoError(t, bucket.WriteAll(ctx, key, []byte(sb.String()), nil))
+}
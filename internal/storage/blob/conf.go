@@ -9,11 +9,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"time"
 
 	"go.uber.org/multierr"
 
 	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/encryption"
+	"github.com/cerbos/cerbos/internal/signature"
 	"github.com/cerbos/cerbos/internal/storage"
 	"github.com/cerbos/cerbos/internal/util"
 )
@@ -22,8 +25,12 @@ const (
 	confKey                = storage.ConfKey + "." + DriverName
 	defaultDownloadTimeout = 60 * time.Second
 	defaultRequestTimeout  = 5 * time.Second
+	minAssumeRoleDuration  = 15 * time.Minute
+	maxAssumeRoleDuration  = 12 * time.Hour
 )
 
+var kmsKeyNameRegex = regexp.MustCompile(`^projects/[^/]+/locations/[^/]+/keyRings/[^/]+/cryptoKeys/[^/]+$`)
+
 // Conf is required (if driver is set to 'blob') configuration for cloud storage driver.
 // +desc=This section is required only if storage.driver is blob.
 type Conf struct {
@@ -39,6 +46,144 @@ type Conf struct {
 	WorkDir string `yaml:"workDir" conf:",example=${HOME}/tmp/cerbos/work"`
 	// UpdatePollInterval specifies the interval to poll the cloud storage. Set to 0 to disable.
 	UpdatePollInterval time.Duration `yaml:"updatePollInterval" conf:",example=15s"`
+	// Sync configures the backoff for retrying after failures to poll the cloud storage and when the store is reported as degraded.
+	Sync *storage.SyncConf `yaml:"sync,omitempty" conf:",example=\n  initialInterval: 10s\n  maxInterval: 5m\n  degradedAfter: 3"`
+	// Encryption holds the key to decrypt the downloaded files with. Every file in the bucket must be encrypted by `cerbos encrypt`.
+	Encryption *encryption.Conf `yaml:"encryption,omitempty" conf:",example=\n  keyFile: /path/to/key"`
+	// Verification enables verifying the signature of each downloaded file against the object with the same name and the .sig extension.
+	Verification *signature.VerificationConf `yaml:"verification,omitempty" conf:",example=\n  publicKey: /path/to/cosign.pub"`
+	// Archive is the key of a policy archive in the bucket, such as a bundle created by `cerbosctl store export --format=bundle`. When set, policies and schemas are loaded from the archive instead of from the individual objects in the bucket.
+	Archive string `yaml:"archive,omitempty" conf:",example=policies.tar.gz"`
+	// Manifest is the key of an object in the bucket listing the SHA-256 hash of each file, in the format produced by `sha256sum`. When set, only the files whose hashes have changed are downloaded on each sync, instead of listing the whole bucket.
+	Manifest string `yaml:"manifest,omitempty" conf:",example=SHA256SUMS"`
+	// S3 holds the settings that only apply to S3 buckets.
+	S3 *S3Conf `yaml:"s3,omitempty"`
+	// GCS holds the settings that only apply to Google Cloud Storage buckets.
+	GCS *GCSConf `yaml:"gcs,omitempty"`
+	// Azure holds the settings that only apply to Azure Blob Storage containers.
+	Azure *AzureConf `yaml:"azure,omitempty"`
+}
+
+// S3Conf holds the settings for accessing S3 buckets.
+type S3Conf struct {
+	// AssumeRole is the IAM role to assume to access the bucket. The credentials found in the environment are used to assume the role unless WebIdentityTokenFile is set.
+	AssumeRole *S3AssumeRoleConf `yaml:"assumeRole,omitempty"`
+	// KMSKeyID is the ID, ARN or alias of the KMS key to encrypt the objects written to the bucket with (SSE-KMS). Objects encrypted with KMS keys are decrypted by S3 when they are read, regardless of this setting.
+	KMSKeyID string `yaml:"kmsKeyID,omitempty" conf:",example=arn:aws:kms:us-east-2:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab"`
+	// RequesterPays acknowledges that the requester pays for the requests to the bucket. Required for accessing requester pays buckets owned by another account.
+	RequesterPays bool `yaml:"requesterPays,omitempty" conf:",example=false"`
+}
+
+// S3AssumeRoleConf holds the IAM role to assume to access an S3 bucket.
+type S3AssumeRoleConf struct {
+	// RoleARN is the ARN of the role to assume.
+	RoleARN string `yaml:"roleARN" conf:"required,example=arn:aws:iam::111122223333:role/cerbos"`
+	// SessionName is the name of the role session. Defaults to cerbos.
+	SessionName string `yaml:"sessionName,omitempty" conf:",example=cerbos"`
+	// ExternalID is the external ID required by the trust policy of the role. Not used with WebIdentityTokenFile.
+	ExternalID string `yaml:"externalID,omitempty" conf:",example=${CERBOS_S3_EXTERNAL_ID}"`
+	// Duration is how long the role session lasts before the credentials are refreshed. Defaults to 15m.
+	Duration time.Duration `yaml:"duration,omitempty" conf:",example=15m"`
+	// WebIdentityTokenFile is the path to an OIDC token to assume the role with web identity, such as a Kubernetes service account token.
+	WebIdentityTokenFile string `yaml:"webIdentityTokenFile,omitempty" conf:",example=/var/run/secrets/eks.amazonaws.com/serviceaccount/token"`
+}
+
+func (conf *S3Conf) Validate() error {
+	if conf == nil || conf.AssumeRole == nil {
+		return nil
+	}
+
+	var errs []error
+	ar := conf.AssumeRole
+	if ar.RoleARN == "" {
+		errs = append(errs, errors.New("s3.assumeRole.roleARN is required"))
+	}
+
+	if ar.Duration != 0 && (ar.Duration < minAssumeRoleDuration || ar.Duration > maxAssumeRoleDuration) {
+		errs = append(errs, fmt.Errorf("s3.assumeRole.duration must be between %s and %s", minAssumeRoleDuration, maxAssumeRoleDuration))
+	}
+
+	if ar.WebIdentityTokenFile != "" && ar.ExternalID != "" {
+		errs = append(errs, errors.New("s3.assumeRole.externalID cannot be used with s3.assumeRole.webIdentityTokenFile"))
+	}
+
+	return multierr.Combine(errs...)
+}
+
+// GCSConf holds the settings for accessing Google Cloud Storage buckets.
+type GCSConf struct {
+	// WorkloadIdentity configures workload identity federation to access the bucket without a service account key. Application Default Credentials are used if it's not set.
+	WorkloadIdentity *GCSWorkloadIdentityConf `yaml:"workloadIdentity,omitempty"`
+	// KMSKeyName is the resource name of the Cloud KMS key to encrypt the objects written to the bucket with (CMEK). Objects encrypted with customer-managed keys are decrypted by GCS when they are read, regardless of this setting.
+	KMSKeyName string `yaml:"kmsKeyName,omitempty" conf:",example=projects/my-project/locations/us-east1/keyRings/cerbos/cryptoKeys/cerbos"`
+}
+
+// GCSWorkloadIdentityConf holds the workload identity federation settings for accessing a GCS bucket.
+type GCSWorkloadIdentityConf struct {
+	// CredentialsFile is the path to the credential configuration file of the workload identity pool provider, as created by `gcloud iam workload-identity-pools create-cred-config`.
+	CredentialsFile string `yaml:"credentialsFile" conf:"required,example=/etc/cerbos/gcp-credentials.json"`
+	// ServiceAccount is the email of the service account to impersonate. Overrides the service account set in the credential configuration file.
+	ServiceAccount string `yaml:"serviceAccount,omitempty" conf:",example=cerbos@my-project.iam.gserviceaccount.com"`
+}
+
+func (conf *GCSConf) Validate() error {
+	if conf == nil {
+		return nil
+	}
+
+	var errs []error
+	if wi := conf.WorkloadIdentity; wi != nil && wi.CredentialsFile == "" {
+		errs = append(errs, errors.New("gcs.workloadIdentity.credentialsFile is required"))
+	}
+
+	if conf.KMSKeyName != "" && !kmsKeyNameRegex.MatchString(conf.KMSKeyName) {
+		errs = append(errs, fmt.Errorf("gcs.kmsKeyName must be in the form projects/<project>/locations/<location>/keyRings/<keyRing>/cryptoKeys/<key>: %s", conf.KMSKeyName))
+	}
+
+	return multierr.Combine(errs...)
+}
+
+// AzureConf holds the settings for accessing Azure Blob Storage containers.
+// Only one of SASToken, ConnectionString and ManagedIdentity can be set. If none of them are set, the credentials are obtained
+// from the environment as described in https://learn.microsoft.com/en-us/azure/developer/go/azure-sdk-authentication.
+type AzureConf struct {
+	// AccountName is the name of the storage account. Defaults to the value of the AZURE_STORAGE_ACCOUNT environment variable. Not used with ConnectionString.
+	AccountName string `yaml:"accountName,omitempty" conf:",example=cerbos"`
+	// SASToken is a shared access signature token granting access to the container.
+	SASToken string `yaml:"sasToken,omitempty" conf:",example=${AZURE_STORAGE_SAS_TOKEN}"`
+	// ConnectionString is the connection string of the storage account.
+	ConnectionString string `yaml:"connectionString,omitempty" conf:",example=${AZURE_STORAGE_CONNECTION_STRING}"`
+	// ManagedIdentity authenticates with the managed identity of the Azure host that Cerbos is running on.
+	ManagedIdentity *AzureManagedIdentityConf `yaml:"managedIdentity,omitempty"`
+}
+
+// AzureManagedIdentityConf holds the managed identity to access an Azure Blob Storage container with.
+type AzureManagedIdentityConf struct {
+	// ClientID is the client ID of a user-assigned managed identity. The system-assigned managed identity is used if it's not set.
+	ClientID string `yaml:"clientID,omitempty" conf:",example=00000000-0000-0000-0000-000000000000"`
+}
+
+func (conf *AzureConf) Validate() error {
+	if conf == nil {
+		return nil
+	}
+
+	n := 0
+	for _, set := range []bool{conf.SASToken != "", conf.ConnectionString != "", conf.ManagedIdentity != nil} {
+		if set {
+			n++
+		}
+	}
+
+	if n > 1 {
+		return errors.New("only one of azure.sasToken, azure.connectionString and azure.managedIdentity can be set")
+	}
+
+	if conf.ConnectionString != "" && conf.AccountName != "" {
+		return errors.New("azure.accountName cannot be used with azure.connectionString")
+	}
+
+	return nil
 }
 
 func (conf *Conf) Key() string {
@@ -52,6 +197,14 @@ func (conf *Conf) Validate() error {
 		errs = append(errs, errors.New("bucket is required"))
 	}
 
+	if conf.Archive != "" && !util.IsArchiveFile(conf.Archive) {
+		errs = append(errs, fmt.Errorf("archive must be a .zip, .tar, .tgz or .tar.gz file: %s", conf.Archive))
+	}
+
+	if conf.Archive != "" && conf.Manifest != "" {
+		errs = append(errs, errors.New("archive and manifest cannot be used together"))
+	}
+
 	if conf.WorkDir == "" {
 		cacheDir, err := os.UserCacheDir()
 		if err != nil {
@@ -65,6 +218,22 @@ func (conf *Conf) Validate() error {
 		errs = append(errs, fmt.Errorf("request timeout (%.0fs) is greater than download timeout (%.0fs)", conf.RequestTimeout.Seconds(), conf.DownloadTimeout.Seconds()))
 	}
 
+	if err := conf.Sync.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := conf.S3.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := conf.GCS.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := conf.Azure.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
 	if len(errs) > 0 {
 		return multierr.Combine(errs...)
 	}
@@ -0,0 +1,80 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package blob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"cloud.google.com/go/storage"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcp"
+	"golang.org/x/oauth2/google"
+)
+
+const (
+	externalAccountCredentialsType = "external_account"
+	gcsScope                       = "https://www.googleapis.com/auth/cloud-platform"
+	impersonationURLTemplate       = "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/%s:generateAccessToken"
+)
+
+// gcsCredentials returns the credentials used to access GCS buckets.
+// Application Default Credentials are used unless workload identity federation is configured.
+func gcsCredentials(ctx context.Context, conf *GCSConf) (*google.Credentials, error) {
+	if conf == nil || conf.WorkloadIdentity == nil {
+		creds, err := gcp.DefaultCredentials(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get default GCP credentials: %w", err)
+		}
+		return creds, nil
+	}
+
+	wi := conf.WorkloadIdentity
+	data, err := os.ReadFile(wi.CredentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read GCP credential configuration file %s: %w", wi.CredentialsFile, err)
+	}
+
+	var credConf map[string]any
+	if err := json.Unmarshal(data, &credConf); err != nil {
+		return nil, fmt.Errorf("failed to parse GCP credential configuration file %s: %w", wi.CredentialsFile, err)
+	}
+
+	if credConf["type"] != externalAccountCredentialsType {
+		return nil, fmt.Errorf("GCP credential configuration file %s is not a workload identity federation configuration: type must be %q", wi.CredentialsFile, externalAccountCredentialsType)
+	}
+
+	if wi.ServiceAccount != "" {
+		credConf["service_account_impersonation_url"] = fmt.Sprintf(impersonationURLTemplate, wi.ServiceAccount)
+		if data, err = json.Marshal(credConf); err != nil {
+			return nil, fmt.Errorf("failed to encode GCP credential configuration: %w", err)
+		}
+	}
+
+	creds, err := google.CredentialsFromJSON(ctx, data, gcsScope)
+	if err != nil {
+		return nil, fmt.Errorf("could not get GCP credentials from %s: %w", wi.CredentialsFile, err)
+	}
+
+	return creds, nil
+}
+
+// gcsWriterOptions returns the options for writing objects to GCS buckets, or nil if the defaults apply.
+func gcsWriterOptions(conf *GCSConf) *blob.WriterOptions {
+	if conf == nil || conf.KMSKeyName == "" {
+		return nil
+	}
+
+	return &blob.WriterOptions{
+		BeforeWrite: func(asFunc func(any) bool) error {
+			var w *storage.Writer
+			if asFunc(&w) {
+				w.KMSKeyName = conf.KMSKeyName
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build tests
+// +build tests
+
+package blob
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/stretchr/testify/require"
+)
+
+const workloadIdentityCredentials = `{
+  "type": "external_account",
+  "audience": "//iam.googleapis.com/projects/123456789/locations/global/workloadIdentityPools/cerbos/providers/cerbos",
+  "subject_token_type": "urn:ietf:params:oauth:token-type:jwt",
+  "token_url": "https://sts.googleapis.com/v1/token",
+  "credential_source": {
+    "file": "/var/run/secrets/tokens/gcp-ksa/token"
+  }
+}`
+
+func TestGCSWriterOptions(t *testing.T) {
+	const kmsKeyName = "projects/my-project/locations/us-east1/keyRings/cerbos/cryptoKeys/cerbos"
+
+	t.Run("kms", func(t *testing.T) {
+		opts := gcsWriterOptions(&GCSConf{KMSKeyName: kmsKeyName})
+		require.NotNil(t, opts)
+
+		w := &storage.Writer{}
+		require.NoError(t, opts.BeforeWrite(func(i any) bool {
+			p, ok := i.(**storage.Writer)
+			if ok {
+				*p = w
+			}
+			return ok
+		}))
+		require.Equal(t, kmsKeyName, w.KMSKeyName)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		require.Nil(t, gcsWriterOptions(nil))
+		require.Nil(t, gcsWriterOptions(&GCSConf{}))
+	})
+}
+
+func TestGCSCredentials(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(t *testing.T, name, contents string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+		return path
+	}
+
+	t.Run("workload_identity", func(t *testing.T) {
+		path := writeFile(t, "wif.json", workloadIdentityCredentials)
+		creds, err := gcsCredentials(context.Background(), &GCSConf{WorkloadIdentity: &GCSWorkloadIdentityConf{CredentialsFile: path}})
+		require.NoError(t, err)
+		require.NotNil(t, creds.TokenSource)
+	})
+
+	t.Run("workload_identity_with_service_account", func(t *testing.T) {
+		path := writeFile(t, "wif.json", workloadIdentityCredentials)
+		creds, err := gcsCredentials(context.Background(), &GCSConf{WorkloadIdentity: &GCSWorkloadIdentityConf{
+			CredentialsFile: path,
+			ServiceAccount:  "cerbos@my-project.iam.gserviceaccount.com",
+		}})
+		require.NoError(t, err)
+		require.Contains(t, string(creds.JSON), "serviceAccounts/cerbos@my-project.iam.gserviceaccount.com:generateAccessToken")
+	})
+
+	t.Run("service_account_key", func(t *testing.T) {
+		path := writeFile(t, "key.json", `{"type": "service_account"}`)
+		_, err := gcsCredentials(context.Background(), &GCSConf{WorkloadIdentity: &GCSWorkloadIdentityConf{CredentialsFile: path}})
+		require.Error(t, err)
+	})
+
+	t.Run("missing_file", func(t *testing.T) {
+		_, err := gcsCredentials(context.Background(), &GCSConf{WorkloadIdentity: &GCSWorkloadIdentityConf{CredentialsFile: filepath.Join(dir, "missing.json")}})
+		require.Error(t, err)
+	})
+}
+
+func TestGCSConfValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		conf    *GCSConf
+		wantErr bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "valid",
+			conf: &GCSConf{
+				WorkloadIdentity: &GCSWorkloadIdentityConf{CredentialsFile: "/etc/cerbos/gcp-credentials.json"},
+				KMSKeyName:       "projects/my-project/locations/us-east1/keyRings/cerbos/cryptoKeys/cerbos",
+			},
+		},
+		{
+			name:    "missing_credentials_file",
+			conf:    &GCSConf{WorkloadIdentity: &GCSWorkloadIdentityConf{}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid_kms_key_name",
+			conf:    &GCSConf{KMSKeyName: "cerbos"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.conf.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package blob
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+// manifest maps the paths of the policy and schema files in the bucket to their SHA-256 hashes.
+type manifest map[string][]byte
+
+// parseManifest parses a manifest in the format produced by `sha256sum`: each line consists of the hex-encoded hash,
+// a space, a space or an asterisk, and the path of the file relative to the bucket prefix.
+// Files that are not indexed by Cerbos are ignored, so the manifest can be generated for the whole policy repository.
+func parseManifest(data []byte) (manifest, error) {
+	m := make(manifest)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+
+		hexHash, file, ok := strings.Cut(line, " ")
+		if !ok || len(file) < 2 || (file[0] != ' ' && file[0] != '*') {
+			return nil, fmt.Errorf("invalid manifest entry on line %d", lineNum)
+		}
+
+		hash, err := hex.DecodeString(hexHash)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("invalid SHA-256 hash on line %d", lineNum)
+		}
+
+		file = strings.TrimPrefix(strings.TrimPrefix(file[1:], "./"), "/")
+		if !fs.ValidPath(file) {
+			return nil, fmt.Errorf("invalid file path on line %d: %s", lineNum, file)
+		}
+
+		if util.FileType(file) == util.FileTypeNotIndexed {
+			continue
+		}
+
+		m[file] = hash
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	return m, nil
+}
+
+func sha256Sum(r io.Reader) ([]byte, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
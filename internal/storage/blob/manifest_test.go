@@ -0,0 +1,46 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package blob
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseManifest(t *testing.T) {
+	hash := sha256.Sum256([]byte("policy"))
+	hexHash := hex.EncodeToString(hash[:])
+
+	t.Run("valid", func(t *testing.T) {
+		data := fmt.Sprintf("%[1]s  ./resource_policies/policy_01.yaml\n%[1]s *_schemas/principal.json\r\n\n%[1]s  README.md\n%[1]s  .hidden/policy.yaml\n", hexHash)
+		m, err := parseManifest([]byte(data))
+		require.NoError(t, err)
+		require.Equal(t, manifest{
+			"resource_policies/policy_01.yaml": hash[:],
+			"_schemas/principal.json":          hash[:],
+		}, m)
+	})
+
+	testCases := []struct {
+		name string
+		data string
+	}{
+		{name: "missing_path", data: hexHash},
+		{name: "single_space", data: hexHash + " policy.yaml"},
+		{name: "invalid_hash", data: "abc  policy.yaml"},
+		{name: "parent_dir", data: hexHash + "  ../policy.yaml"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseManifest([]byte(tc.data))
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package blob
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	defaultRoleSessionName = "cerbos"
+	s3OptionsHandlerName   = "cerbos.S3Options"
+)
+
+// newS3Session creates the AWS session used to access S3 buckets, applying the S3 specific settings from the configuration.
+func newS3Session(requestTimeout time.Duration, conf *S3Conf) (*session.Session, error) {
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{HTTPClient: &http.Client{Timeout: requestTimeout}},
+		// Force enable Shared Config support
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if conf == nil {
+		return sess, nil
+	}
+
+	if ar := conf.AssumeRole; ar != nil {
+		sess = sess.Copy(&aws.Config{Credentials: assumeRoleCredentials(sess, ar)})
+	}
+
+	if conf.KMSKeyID != "" || conf.RequesterPays {
+		sess.Handlers.Build.PushFrontNamed(request.NamedHandler{Name: s3OptionsHandlerName, Fn: s3OptionsHandler(conf)})
+	}
+
+	return sess, nil
+}
+
+func assumeRoleCredentials(sess *session.Session, conf *S3AssumeRoleConf) *credentials.Credentials {
+	sessionName := conf.SessionName
+	if sessionName == "" {
+		sessionName = defaultRoleSessionName
+	}
+
+	if conf.WebIdentityTokenFile != "" {
+		return stscreds.NewWebIdentityCredentials(sess, conf.RoleARN, sessionName, conf.WebIdentityTokenFile)
+	}
+
+	return stscreds.NewCredentials(sess, conf.RoleARN, func(p *stscreds.AssumeRoleProvider) {
+		p.RoleSessionName = sessionName
+		if conf.ExternalID != "" {
+			p.ExternalID = aws.String(conf.ExternalID)
+		}
+		if conf.Duration > 0 {
+			p.Duration = conf.Duration
+		}
+	})
+}
+
+// s3OptionsHandler returns a request handler that sets the encryption and payer options on the S3 requests that support them.
+// It runs before the request is built so that the options are serialised along with the rest of the input.
+func s3OptionsHandler(conf *S3Conf) func(*request.Request) {
+	setSSE := func(sse, kmsKeyID **string) {
+		if conf.KMSKeyID != "" {
+			*sse = aws.String(s3.ServerSideEncryptionAwsKms)
+			*kmsKeyID = aws.String(conf.KMSKeyID)
+		}
+	}
+
+	setPayer := func(payer **string) {
+		if conf.RequesterPays {
+			*payer = aws.String(s3.RequestPayerRequester)
+		}
+	}
+
+	return func(r *request.Request) {
+		switch in := r.Params.(type) {
+		case *s3.PutObjectInput:
+			setSSE(&in.ServerSideEncryption, &in.SSEKMSKeyId)
+			setPayer(&in.RequestPayer)
+		case *s3.CreateMultipartUploadInput:
+			setSSE(&in.ServerSideEncryption, &in.SSEKMSKeyId)
+			setPayer(&in.RequestPayer)
+		case *s3.CopyObjectInput:
+			setSSE(&in.ServerSideEncryption, &in.SSEKMSKeyId)
+			setPayer(&in.RequestPayer)
+		case *s3.UploadPartInput:
+			setPayer(&in.RequestPayer)
+		case *s3.CompleteMultipartUploadInput:
+			setPayer(&in.RequestPayer)
+		case *s3.AbortMultipartUploadInput:
+			setPayer(&in.RequestPayer)
+		case *s3.GetObjectInput:
+			setPayer(&in.RequestPayer)
+		case *s3.HeadObjectInput:
+			setPayer(&in.RequestPayer)
+		case *s3.ListObjectsInput:
+			setPayer(&in.RequestPayer)
+		case *s3.ListObjectsV2Input:
+			setPayer(&in.RequestPayer)
+		case *s3.DeleteObjectInput:
+			setPayer(&in.RequestPayer)
+		}
+	}
+}
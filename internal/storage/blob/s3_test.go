@@ -0,0 +1,108 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build tests
+// +build tests
+
+package blob
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3OptionsHandler(t *testing.T) {
+	const kmsKeyID = "alias/cerbos"
+
+	t.Run("kms_and_requester_pays", func(t *testing.T) {
+		handler := s3OptionsHandler(&S3Conf{KMSKeyID: kmsKeyID, RequesterPays: true})
+
+		put := &s3.PutObjectInput{}
+		handler(&request.Request{Params: put})
+		require.Equal(t, s3.ServerSideEncryptionAwsKms, aws.StringValue(put.ServerSideEncryption))
+		require.Equal(t, kmsKeyID, aws.StringValue(put.SSEKMSKeyId))
+		require.Equal(t, s3.RequestPayerRequester, aws.StringValue(put.RequestPayer))
+
+		upload := &s3.CreateMultipartUploadInput{}
+		handler(&request.Request{Params: upload})
+		require.Equal(t, s3.ServerSideEncryptionAwsKms, aws.StringValue(upload.ServerSideEncryption))
+		require.Equal(t, kmsKeyID, aws.StringValue(upload.SSEKMSKeyId))
+
+		get := &s3.GetObjectInput{}
+		handler(&request.Request{Params: get})
+		require.Equal(t, s3.RequestPayerRequester, aws.StringValue(get.RequestPayer))
+
+		list := &s3.ListObjectsV2Input{}
+		handler(&request.Request{Params: list})
+		require.Equal(t, s3.RequestPayerRequester, aws.StringValue(list.RequestPayer))
+	})
+
+	t.Run("kms_only", func(t *testing.T) {
+		handler := s3OptionsHandler(&S3Conf{KMSKeyID: kmsKeyID})
+
+		put := &s3.PutObjectInput{}
+		handler(&request.Request{Params: put})
+		require.Equal(t, kmsKeyID, aws.StringValue(put.SSEKMSKeyId))
+		require.Nil(t, put.RequestPayer)
+
+		get := &s3.GetObjectInput{}
+		handler(&request.Request{Params: get})
+		require.Nil(t, get.RequestPayer)
+	})
+}
+
+func TestS3ConfValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		conf    *S3Conf
+		wantErr bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "kms_and_requester_pays",
+			conf: &S3Conf{KMSKeyID: "alias/cerbos", RequesterPays: true},
+		},
+		{
+			name: "assume_role",
+			conf: &S3Conf{AssumeRole: &S3AssumeRoleConf{RoleARN: "arn:aws:iam::111122223333:role/cerbos", ExternalID: "x", Duration: time.Hour}},
+		},
+		{
+			name: "web_identity",
+			conf: &S3Conf{AssumeRole: &S3AssumeRoleConf{RoleARN: "arn:aws:iam::111122223333:role/cerbos", WebIdentityTokenFile: "/var/run/token"}},
+		},
+		{
+			name:    "missing_role_arn",
+			conf:    &S3Conf{AssumeRole: &S3AssumeRoleConf{}},
+			wantErr: true,
+		},
+		{
+			name:    "duration_too_short",
+			conf:    &S3Conf{AssumeRole: &S3AssumeRoleConf{RoleARN: "arn:aws:iam::111122223333:role/cerbos", Duration: time.Minute}},
+			wantErr: true,
+		},
+		{
+			name:    "external_id_with_web_identity",
+			conf:    &S3Conf{AssumeRole: &S3AssumeRoleConf{RoleARN: "arn:aws:iam::111122223333:role/cerbos", ExternalID: "x", WebIdentityTokenFile: "/var/run/token"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.conf.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package blob
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+
+	"github.com/cerbos/cerbos/internal/storage/snapshot"
+)
+
+// NewSnapshotBucket opens the bucket that the Admin API writes store snapshots to and restores them from.
+// It supports the same bucket URLs, S3 and GCS settings as the blob storage driver.
+func NewSnapshotBucket(ctx context.Context, bucketURL, prefix string, requestTimeout time.Duration, s3Conf *S3Conf, gcsConf *GCSConf, azureConf *AzureConf) (snapshot.Bucket, error) {
+	bucket, err := newBucket(ctx, &Conf{Bucket: bucketURL, Prefix: prefix, RequestTimeout: &requestTimeout, S3: s3Conf, GCS: gcsConf, Azure: azureConf})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshotBucket{bucket: bucket, writerOpts: gcsWriterOptions(gcsConf)}, nil
+}
+
+type snapshotBucket struct {
+	bucket     *blob.Bucket
+	writerOpts *blob.WriterOptions
+}
+
+func (sb snapshotBucket) NewWriter(ctx context.Context, key string) (io.WriteCloser, error) {
+	return sb.bucket.NewWriter(ctx, key, sb.writerOpts)
+}
+
+func (sb snapshotBucket) NewReader(ctx context.Context, key string) (io.ReadCloser, error) {
+	r, err := sb.bucket.NewReader(ctx, key, nil)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, snapshot.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r, nil
+}
@@ -9,18 +9,17 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
-	"net/http"
 	"net/url"
 	"os"
+	"sync"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"go.opencensus.io/stats"
 	"go.opencensus.io/tag"
 	"go.uber.org/zap"
 	"gocloud.dev/blob"
+	"gocloud.dev/blob/azureblob"
 
 	// Import gcsblob package to register GCS driver.
 	"gocloud.dev/blob/gcsblob"
@@ -29,22 +28,30 @@ import (
 
 	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/encryption"
 	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/observability/metrics"
 	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/signature"
 	"github.com/cerbos/cerbos/internal/storage"
 	"github.com/cerbos/cerbos/internal/storage/index"
 	"github.com/cerbos/cerbos/internal/util"
 )
 
-const DriverName = "blob"
+const (
+	DriverName = "blob"
+	// maxSyncRetryInterval is the default maximum time to wait before retrying a failed poll.
+	maxSyncRetryInterval = 5 * time.Minute
+)
 
 var (
 	_ storage.SourceStore = (*Store)(nil)
 	_ storage.Reloadable  = (*Store)(nil)
+	_ storage.Syncable    = (*Store)(nil)
+	_ storage.Syncer      = (*Store)(nil)
 )
 
-var ErrUnsupportedBucketScheme = errors.New("currently only \"s3\" and \"gs\" bucket URL schemes are supported")
+var ErrUnsupportedBucketScheme = errors.New("currently only \"s3\", \"gs\" and \"azblob\" bucket URL schemes are supported")
 
 func init() {
 	storage.RegisterDriver(DriverName, func(ctx context.Context, confW *config.Wrapper) (storage.Store, error) {
@@ -67,6 +74,15 @@ func init() {
 			return nil, err
 		}
 
+		if c.verifier, err = signature.NewVerifierFromConf(conf.Verification); err != nil {
+			return nil, err
+		}
+
+		if c.cipher, err = encryption.NewCipherFromConf(conf.Encryption); err != nil {
+			return nil, err
+		}
+		c.manifestKey = conf.Manifest
+
 		return NewStore(ctx, conf, c)
 	})
 }
@@ -82,6 +98,8 @@ func newBucket(ctx context.Context, conf *Conf) (*blob.Bucket, error) {
 		bucket, err = openS3Bucket(ctx, conf, u)
 	case "gs":
 		bucket, err = openGSBucket(ctx, conf, u)
+	case azureblob.Scheme:
+		bucket, err = openAzureBucket(ctx, conf, u)
 	default:
 		err = ErrUnsupportedBucketScheme
 	}
@@ -97,9 +115,9 @@ func newBucket(ctx context.Context, conf *Conf) (*blob.Bucket, error) {
 }
 
 func openGSBucket(ctx context.Context, conf *Conf, bucketURL *url.URL) (*blob.Bucket, error) {
-	creds, err := gcp.DefaultCredentials(ctx)
+	creds, err := gcsCredentials(ctx, conf.GCS)
 	if err != nil {
-		return nil, fmt.Errorf("could not get default GCP credentials: %w", err)
+		return nil, err
 	}
 	client, err := gcp.NewHTTPClient(gcp.DefaultTransport(), creds.TokenSource)
 	if err != nil {
@@ -117,12 +135,7 @@ func openGSBucket(ctx context.Context, conf *Conf, bucketURL *url.URL) (*blob.Bu
 }
 
 func openS3Bucket(ctx context.Context, conf *Conf, bucketURL *url.URL) (*blob.Bucket, error) {
-	client := &http.Client{Timeout: *conf.RequestTimeout}
-	sess, err := session.NewSessionWithOptions(session.Options{
-		Config: aws.Config{HTTPClient: client},
-		// Force enable Shared Config support
-		SharedConfigState: session.SharedConfigEnable,
-	})
+	sess, err := newS3Session(*conf.RequestTimeout, conf.S3)
 	if err != nil {
 		return nil, err
 	}
@@ -153,13 +166,19 @@ type bucketCloner interface {
 	Clone(ctx context.Context) (*CloneResult, error)
 }
 
+type archiveCloner interface {
+	CloneArchive(ctx context.Context, key string) (fs.FS, error)
+}
+
 type Store struct {
 	*storage.SubscriptionManager
-	log    *zap.SugaredLogger
-	conf   *Conf
-	idx    index.Index
-	cloner bucketCloner
-	fsys   fs.FS
+	log        *zap.SugaredLogger
+	conf       *Conf
+	idx        index.Index
+	cloner     bucketCloner
+	fsys       fs.FS
+	syncStatus *storage.SyncStatus
+	syncMu     sync.Mutex
 }
 
 func (s *Store) Subscribe(sub storage.Subscriber) {
@@ -171,6 +190,7 @@ func NewStore(ctx context.Context, conf *Conf, cloner bucketCloner) (*Store, err
 		log:                 zap.S().Named(DriverName).With("bucket", conf.Bucket, "workDir", conf.WorkDir),
 		conf:                conf,
 		cloner:              cloner,
+		syncStatus:          storage.NewSyncStatus(DriverName, conf.Sync),
 		SubscriptionManager: storage.NewSubscriptionManager(ctx),
 	}
 
@@ -185,6 +205,10 @@ func NewStore(ctx context.Context, conf *Conf, cloner bucketCloner) (*Store, err
 var ErrPartialFailureToDownloadOnInit = errors.New("failed to download some files from the bucket")
 
 func (s *Store) init(ctx context.Context) error {
+	if s.conf.Archive != "" {
+		return s.initFromArchive(ctx)
+	}
+
 	s.fsys = os.DirFS(s.conf.WorkDir)
 
 	if cr, err := s.clone(ctx); err != nil {
@@ -202,11 +226,45 @@ func (s *Store) init(ctx context.Context) error {
 		return err
 	}
 
+	s.syncStatus.Succeeded()
+	go s.pollForUpdates(ctx)
+
+	return nil
+}
+
+func (s *Store) initFromArchive(ctx context.Context) error {
+	fsys, err := s.cloneArchive(ctx)
+	if err != nil {
+		s.log.Errorw("Failed to download archive", "error", err, "archive", s.conf.Archive)
+		return err
+	}
+	s.fsys = fsys
+
+	s.idx, err = index.Build(ctx, s.fsys, index.WithRootDir("."))
+	if err != nil {
+		s.log.Errorw("Failed to build index", "error", err)
+		return err
+	}
+
+	s.syncStatus.Succeeded()
 	go s.pollForUpdates(ctx)
 
 	return nil
 }
 
+// cloneArchive downloads the archive if it has changed since the last download, otherwise it returns a nil filesystem.
+func (s *Store) cloneArchive(ctx context.Context) (fs.FS, error) {
+	ac, ok := s.cloner.(archiveCloner)
+	if !ok {
+		return nil, errors.New("cloner does not support archives")
+	}
+
+	ctx, cancelFunc := s.conf.getCloneCtx(ctx)
+	defer cancelFunc()
+
+	return ac.CloneArchive(ctx, s.conf.Archive)
+}
+
 func (s *Store) clone(ctx context.Context) (*CloneResult, error) {
 	ctx, cancelFunc := s.conf.getCloneCtx(ctx)
 	defer cancelFunc()
@@ -215,6 +273,10 @@ func (s *Store) clone(ctx context.Context) (*CloneResult, error) {
 }
 
 func (s *Store) updateIndex(ctx context.Context) error {
+	if s.conf.Archive != "" {
+		return s.updateIndexFromArchive(ctx)
+	}
+
 	s.log.Debug("Checking for updates")
 
 	changes, err := s.clone(ctx)
@@ -271,6 +333,51 @@ func (s *Store) updateIndex(ctx context.Context) error {
 	return nil
 }
 
+// updateIndexFromArchive replaces the whole index when the archive changes, so that the policies are never served
+// from a mix of two versions of the archive.
+func (s *Store) updateIndexFromArchive(ctx context.Context) error {
+	s.log.Debug("Checking for updates")
+
+	fsys, err := s.cloneArchive(ctx)
+	if err != nil {
+		return err
+	}
+
+	if fsys == nil {
+		s.log.Debug("No changes")
+		return nil
+	}
+
+	s.log.Info("Detected changes to the archive")
+
+	evts, err := s.idx.ReloadFrom(ctx, fsys)
+	if err != nil {
+		return err
+	}
+	s.fsys = fsys
+
+	s.NotifySubscribers(evts...)
+	s.log.Info("Index updated")
+	return nil
+}
+
+// Sync checks for updates immediately, without waiting for the next poll.
+func (s *Store) Sync(ctx context.Context) error {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	if err := s.updateIndex(ctx); err != nil {
+		s.syncStatus.Failed()
+		_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
+			tag.Upsert(metrics.KeyStoreDriver, DriverName),
+		}, metrics.StoreSyncErrorCount.M(1))
+		return err
+	}
+
+	s.syncStatus.Succeeded()
+	return nil
+}
+
 func (s *Store) pollForUpdates(ctx context.Context) {
 	if s.conf.UpdatePollInterval <= 0 {
 		s.log.Info("Polling disabled: new updates will not be pulled automatically")
@@ -279,33 +386,47 @@ func (s *Store) pollForUpdates(ctx context.Context) {
 
 	s.log.Infof("Polling for updates every %s", s.conf.UpdatePollInterval)
 
-	ticker := time.NewTicker(s.conf.UpdatePollInterval)
-	defer ticker.Stop()
+	// failures are retried with backoff rather than at the next poll
+	retry := s.conf.Sync.NewBackOff(s.conf.UpdatePollInterval, maxSyncRetryInterval)
+
+	timer := time.NewTimer(s.conf.UpdatePollInterval)
+	defer timer.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
 			s.log.Info("Stopped polling for updates")
 			return
-		case <-ticker.C:
-			if err := s.updateIndex(ctx); err != nil {
-				s.log.Errorw("Failed to check for updates", "error", err)
-				_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
-					tag.Upsert(metrics.KeyStoreDriver, DriverName),
-				}, metrics.StoreSyncErrorCount.M(1))
+		case <-timer.C:
+			wait := s.conf.UpdatePollInterval
+			if err := s.Sync(ctx); err != nil {
+				wait = retry.NextBackOff()
+				s.log.Errorw("Failed to check for updates", "error", err, "retryIn", wait)
+			} else {
+				retry.Reset()
 			}
 
 			_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
 				tag.Upsert(metrics.KeyStoreDriver, DriverName),
 			}, metrics.StorePollCount.M(1))
+
+			timer.Reset(wait)
 		}
 	}
 }
 
+func (s *Store) SyncStatus() *storage.SyncStatus {
+	return s.syncStatus
+}
+
 func (s *Store) Driver() string {
 	return DriverName
 }
 
+func (s *Store) WatchesForChanges() bool {
+	return s.conf.UpdatePollInterval > 0
+}
+
 func (s *Store) GetFirstMatch(_ context.Context, candidates []namer.ModuleID) (*policy.CompilationUnit, error) {
 	return s.idx.GetFirstMatch(candidates)
 }
@@ -339,6 +460,17 @@ func (s *Store) RepoStats(ctx context.Context) storage.RepoStats {
 }
 
 func (s *Store) Reload(ctx context.Context) error {
+	if s.conf.Archive != "" {
+		s.syncMu.Lock()
+		defer s.syncMu.Unlock()
+
+		if err := s.updateIndexFromArchive(ctx); err != nil {
+			return fmt.Errorf("failed to reload the archive: %w", err)
+		}
+
+		return nil
+	}
+
 	changes, err := s.clone(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to clone: %w", err)
@@ -4,16 +4,21 @@
 package blob
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io/fs"
 	"path/filepath"
 	"testing"
+	"testing/fstest"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"gocloud.dev/blob"
 
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/policy"
 	"github.com/cerbos/cerbos/internal/schema"
 	"github.com/cerbos/cerbos/internal/storage"
 	"github.com/cerbos/cerbos/internal/storage/index"
@@ -223,6 +228,56 @@ func TestStore_updateIndex(t *testing.T) {
 	)
 }
 
+type archiveClonerFunc func(ctx context.Context, key string) (fs.FS, error)
+
+func (r archiveClonerFunc) Clone(_ context.Context) (*CloneResult, error) {
+	return nil, errors.New("unexpected call to Clone")
+}
+
+func (r archiveClonerFunc) CloneArchive(ctx context.Context, key string) (fs.FS, error) {
+	return r(ctx, key)
+}
+
+func TestStore_updateIndexFromArchive(t *testing.T) {
+	ctx := context.Background()
+
+	conf := &Conf{WorkDir: t.TempDir(), Archive: "policies.tar.gz"}
+	conf.SetDefaults()
+
+	must := require.New(t)
+
+	archives := []fs.FS{
+		mkArchiveFS(t, "policy_1.yaml", test.GenExportVariables(test.NoMod())),
+		nil, // unchanged
+		mkArchiveFS(t, "policy_2.yaml", test.GenExportVariables(test.PrefixAndSuffix("x", "x"))),
+	}
+	store, err := NewStore(ctx, conf, archiveClonerFunc(func(_ context.Context, key string) (fs.FS, error) {
+		must.Equal(conf.Archive, key)
+		fsys := archives[0]
+		archives = archives[1:]
+		return fsys, nil
+	}))
+	must.NoError(err)
+	must.ElementsMatch([]string{"policy_1.yaml"}, store.idx.GetFiles())
+
+	must.NoError(store.updateIndex(ctx))
+	must.ElementsMatch([]string{"policy_1.yaml"}, store.idx.GetFiles())
+
+	mustBeNotified := storage.TestSubscription(store)
+	must.NoError(store.updateIndex(ctx))
+	must.ElementsMatch([]string{"policy_2.yaml"}, store.idx.GetFiles())
+	mustBeNotified(t, 1*time.Second, storage.NewReloadEvent())
+}
+
+func mkArchiveFS(t *testing.T, file string, p *policyv1.Policy) fs.FS {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, policy.WritePolicy(&buf, p))
+
+	return fstest.MapFS{file: &fstest.MapFile{Data: buf.Bytes()}}
+}
+
 func TestStore_AWSS3(t *testing.T) {
 	t.Skip("Skip test with real S3 bucket")
 
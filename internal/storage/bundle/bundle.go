@@ -171,6 +171,15 @@ func loadManifest(bundleFS afero.Fs) (*bundlev1.Manifest, error) {
 	return manifest, nil
 }
 
+// identifier returns the identifier from the bundle manifest. It is safe to call on a nil bundle.
+func (b *Bundle) identifier() string {
+	if b == nil || b.manifest == nil || b.manifest.Meta == nil {
+		return ""
+	}
+
+	return b.manifest.Meta.Identifier
+}
+
 func (b *Bundle) GetFirstMatch(_ context.Context, candidates []namer.ModuleID) (*runtimev1.RunnablePolicySet, error) {
 	for _, id := range candidates {
 		idHex := id.HexStr()
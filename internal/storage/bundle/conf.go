@@ -6,13 +6,16 @@ package bundle
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/signature"
 	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/bundle/oci"
 	"github.com/cerbos/cloud-api/credentials"
 	"go.uber.org/multierr"
 )
@@ -33,10 +36,15 @@ const (
 	defaultMaxRetryWait      = 120 * time.Second
 	defaultMinRetryWait      = 1 * time.Second
 	defaultNumRetries        = 5
+	defaultPollInterval      = 60 * time.Second
 	minHeartbeatInterval     = 30 * time.Second
+	minPollInterval          = 10 * time.Second
 )
 
-var ErrNoSource = errors.New("at least one of local or remote sources must be defined")
+var (
+	ErrNoSource          = errors.New("at least one of local, remote, oci or http sources must be defined")
+	ErrConflictingSource = errors.New("only one of remote, oci or http sources can be defined")
+)
 
 // Conf is required (if driver is set to 'bundle') configuration for bundle storage driver.
 // +desc=This section is required only if storage.driver is bundle.
@@ -45,6 +53,10 @@ type Conf struct {
 	Remote *RemoteSourceConf `yaml:"remote"`
 	// Local holds configuration for local bundle source.
 	Local *LocalSourceConf `yaml:"local"`
+	// OCI holds configuration for pulling bundles from an OCI registry. Takes precedence over local if both are defined.
+	OCI *OCISourceConf `yaml:"oci"`
+	// HTTP holds configuration for polling a bundle from an HTTP server. Takes precedence over local if both are defined.
+	HTTP *HTTPSourceConf `yaml:"http"`
 	// Credentials holds bundle source credentials.
 	Credentials CredentialsConf `yaml:"credentials"`
 }
@@ -87,6 +99,56 @@ type RemoteSourceConf struct {
 	DisableAutoUpdate bool `yaml:"disableAutoUpdate"`
 }
 
+// OCISourceConf holds configuration for the OCI registry bundle source.
+type OCISourceConf struct {
+	// Reference is the reference to the bundle artifact in the registry. Pin it to a digest to always use the same bundle.
+	Reference string `yaml:"reference" conf:"required,example=ghcr.io/example/policies:latest"`
+	// Username to authenticate to the registry with. The registry is accessed anonymously if the username and password are empty.
+	Username string `yaml:"username" conf:",example=${REGISTRY_USERNAME}"`
+	// Password or access token to authenticate to the registry with.
+	Password string `yaml:"password" conf:",example=${REGISTRY_PASSWORD}"`
+	// CACert is the path to the CA certificate chain to use for verifying the registry certificate.
+	CACert string `yaml:"caCert" conf:",example=/path/to/CA_certificate"`
+	// CacheDir is the directory to use for caching downloaded bundles.
+	CacheDir string `yaml:"cacheDir" conf:",example=${XDG_CACHE_DIR}"`
+	// TempDir is the directory to use for temporary files.
+	TempDir string `yaml:"tempDir" conf:",example=${TEMP}"`
+	// UpdatePollInterval is how often the tag is resolved again to check whether it points to a new bundle. Not used if the reference is pinned to a digest.
+	UpdatePollInterval time.Duration `yaml:"updatePollInterval" conf:",example=60s"`
+	// PlainHTTP connects to the registry over HTTP instead of HTTPS.
+	PlainHTTP bool `yaml:"plainHTTP" conf:",example=false"`
+	// Verification enables verifying the signature of the bundle, which is stored in the dev.cerbos.bundle.signature annotation of the bundle layer.
+	Verification *signature.VerificationConf `yaml:"verification,omitempty" conf:",example=\n  publicKey: /path/to/cosign.pub"`
+	// DisableAutoUpdate sets whether the tag should be periodically resolved again to pick up new bundles.
+	DisableAutoUpdate bool `yaml:"disableAutoUpdate"`
+}
+
+// HTTPSourceConf holds configuration for the HTTP bundle source.
+type HTTPSourceConf struct {
+	// TLS defines settings for TLS connections to the server.
+	TLS *HTTPSourceTLSConf `yaml:"tls"`
+	// URL is the address of the bundle.
+	URL string `yaml:"url" conf:"required,example=https://policies.example.com/bundle.crbp"`
+	// BearerToken is sent in the Authorization header of the requests for the bundle.
+	BearerToken string `yaml:"bearerToken" conf:",example=${BUNDLE_TOKEN}"`
+	// TempDir is the directory to use for temporary files.
+	TempDir string `yaml:"tempDir" conf:",example=${TEMP}"`
+	// UpdatePollInterval is how often the server is checked for a new bundle.
+	UpdatePollInterval time.Duration `yaml:"updatePollInterval" conf:",example=60s"`
+	// DisableAutoUpdate sets whether the server should be periodically checked for new bundles.
+	DisableAutoUpdate bool `yaml:"disableAutoUpdate"`
+}
+
+// HTTPSourceTLSConf holds TLS configuration for the HTTP bundle source.
+type HTTPSourceTLSConf struct {
+	// CACert is the path to the CA certificate chain to use for verifying the server certificate.
+	CACert string `yaml:"caCert" conf:",example=/path/to/CA_certificate"`
+	// Cert is the path to the client certificate to present to the server.
+	Cert string `yaml:"cert" conf:",example=/path/to/certificate"`
+	// Key is the path to the private key of the client certificate.
+	Key string `yaml:"key" conf:",example=/path/to/private_key"`
+}
+
 // ConnectionConf holds configuration for the remote connection.
 type ConnectionConf struct {
 	// TLS defines settings for TLS connections.
@@ -127,10 +189,21 @@ func (conf *Conf) SetDefaults() {
 }
 
 func (conf *Conf) Validate() (outErr error) {
-	if conf.Local == nil && conf.Remote == nil {
+	if conf.Local == nil && conf.Remote == nil && conf.OCI == nil && conf.HTTP == nil {
 		return ErrNoSource
 	}
 
+	numSources := 0
+	for _, defined := range []bool{conf.Remote != nil, conf.OCI != nil, conf.HTTP != nil} {
+		if defined {
+			numSources++
+		}
+	}
+
+	if numSources > 1 {
+		return ErrConflictingSource
+	}
+
 	if err := conf.Local.validate(); err != nil {
 		outErr = multierr.Append(outErr, err)
 	}
@@ -139,6 +212,14 @@ func (conf *Conf) Validate() (outErr error) {
 		outErr = multierr.Append(outErr, err)
 	}
 
+	if err := conf.OCI.validate(); err != nil {
+		outErr = multierr.Append(outErr, err)
+	}
+
+	if err := conf.HTTP.validate(); err != nil {
+		outErr = multierr.Append(outErr, err)
+	}
+
 	return outErr
 }
 
@@ -251,6 +332,101 @@ func (rc *RemoteSourceConf) setDefaults() error {
 	return nil
 }
 
+func (oc *OCISourceConf) validate() error {
+	if oc == nil {
+		return nil
+	}
+
+	if _, err := oci.ParseReference(oc.Reference); err != nil {
+		return fmt.Errorf("invalid oci.reference: %w", err)
+	}
+
+	return nil
+}
+
+func (oc *OCISourceConf) setDefaults() error {
+	if oc == nil {
+		return errors.New("configuration is undefined")
+	}
+
+	if oc.TempDir == "" {
+		dir, err := os.MkdirTemp("", "cerbos-oci-*")
+		if err != nil {
+			return fmt.Errorf("failed to create temporary directory: %w", err)
+		}
+		oc.TempDir = dir
+	}
+
+	if oc.CacheDir == "" {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return fmt.Errorf("failed to determine cache directory: %w", err)
+		}
+
+		dir := filepath.Join(cacheDir, "cerbos-oci")
+		//nolint:gomnd
+		if err := os.MkdirAll(dir, 0o764); err != nil {
+			return fmt.Errorf("failed to create cache dir %q: %w", dir, err)
+		}
+
+		oc.CacheDir = dir
+	}
+
+	oc.UpdatePollInterval = pollInterval(oc.UpdatePollInterval)
+
+	return nil
+}
+
+func (hc *HTTPSourceConf) validate() error {
+	if hc == nil {
+		return nil
+	}
+
+	u, err := url.Parse(hc.URL)
+	if err != nil {
+		return fmt.Errorf("invalid http.url: %w", err)
+	}
+
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("http.url %q must be an HTTPS or HTTP URL", hc.URL)
+	}
+
+	if hc.TLS != nil && (hc.TLS.Cert == "") != (hc.TLS.Key == "") {
+		return errors.New("both http.tls.cert and http.tls.key must be provided for TLS client authentication")
+	}
+
+	return nil
+}
+
+func (hc *HTTPSourceConf) setDefaults() error {
+	if hc == nil {
+		return errors.New("configuration is undefined")
+	}
+
+	if hc.TempDir == "" {
+		dir, err := os.MkdirTemp("", "cerbos-http-*")
+		if err != nil {
+			return fmt.Errorf("failed to create temporary directory: %w", err)
+		}
+		hc.TempDir = dir
+	}
+
+	hc.UpdatePollInterval = pollInterval(hc.UpdatePollInterval)
+
+	return nil
+}
+
+func pollInterval(interval time.Duration) time.Duration {
+	switch {
+	case interval <= 0:
+		return defaultPollInterval
+	case interval < minPollInterval:
+		return minPollInterval
+	default:
+		return interval
+	}
+}
+
 func GetConf() (*Conf, error) {
 	conf := &Conf{}
 	err := config.GetSection(conf)
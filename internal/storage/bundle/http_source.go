@@ -0,0 +1,337 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cloud-api/credentials"
+	"github.com/spf13/afero"
+	"go.opencensus.io/stats"
+	"go.uber.org/zap"
+)
+
+const httpRequestTimeout = 5 * time.Minute
+
+var (
+	_ storage.BinaryStore = (*HTTPSource)(nil)
+	_ storage.Reloadable  = (*HTTPSource)(nil)
+)
+
+// HTTPSource implements a bundle store that polls a bundle from an HTTP server.
+// Requests are conditional on the ETag and Last-Modified values of the active bundle so that unchanged bundles are not downloaded again.
+type HTTPSource struct {
+	credentials *credentials.Credentials
+	log         *zap.Logger
+	conf        *HTTPSourceConf
+	client      *http.Client
+	bundle      *Bundle
+	scratchFS   afero.Fs
+	// etag and lastModified are the validators returned by the server for the active bundle.
+	etag         string
+	lastModified string
+	mu           sync.RWMutex
+	fetchMu      sync.Mutex
+	healthy      bool
+}
+
+func NewHTTPSource(conf *Conf) (*HTTPSource, error) {
+	if err := conf.HTTP.setDefaults(); err != nil {
+		return nil, err
+	}
+
+	var creds *credentials.Credentials
+	if conf.Credentials.SecretKey != "" {
+		var err error
+		creds, err = credentials.New("unknown", "unknown", conf.Credentials.SecretKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create credentials: %w", err)
+		}
+	}
+
+	tlsConf, err := mkHTTPSourceTLSConfig(conf.HTTP.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert
+	transport.TLSClientConfig = tlsConf
+
+	return &HTTPSource{
+		credentials: creds,
+		log:         zap.L().Named("bundle").With(zap.String("url", conf.HTTP.URL)),
+		conf:        conf.HTTP,
+		client:      &http.Client{Transport: transport, Timeout: httpRequestTimeout},
+		scratchFS:   afero.NewBasePathFs(afero.NewOsFs(), conf.HTTP.TempDir),
+	}, nil
+}
+
+func mkHTTPSourceTLSConfig(conf *HTTPSourceTLSConf) (*tls.Config, error) {
+	tlsConf := &tls.Config{MinVersion: tls.VersionTLS12}
+	if conf == nil {
+		return tlsConf, nil
+	}
+
+	if conf.CACert != "" {
+		caCert, err := os.ReadFile(conf.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA cert from %q: %w", conf.CACert, err)
+		}
+
+		tlsConf.RootCAs = x509.NewCertPool()
+		if !tlsConf.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("failed to parse CA certs")
+		}
+	}
+
+	if conf.Cert != "" {
+		cert, err := tls.LoadX509KeyPair(conf.Cert, conf.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+
+		tlsConf.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConf, nil
+}
+
+func (s *HTTPSource) Init(ctx context.Context) error {
+	// fail fast if the server is unreachable
+	if err := s.fetchBundle(ctx); err != nil {
+		return err
+	}
+
+	if !s.conf.DisableAutoUpdate {
+		go s.startPollLoop(ctx)
+	}
+
+	return nil
+}
+
+func (s *HTTPSource) startPollLoop(ctx context.Context) {
+	s.log.Info(fmt.Sprintf("Checking for new bundles every %s", s.conf.UpdatePollInterval))
+	ticker := time.NewTicker(s.conf.UpdatePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.log.Info("Terminating bundle polling due to context cancellation")
+			return
+		case <-ticker.C:
+			if err := s.fetchBundle(ctx); err != nil {
+				s.log.Warn("Failed to check for new bundle", zap.Error(err))
+			}
+		}
+	}
+}
+
+// fetchBundle downloads the bundle and swaps the active bundle if the server has a newer version.
+func (s *HTTPSource) fetchBundle(ctx context.Context) error {
+	s.fetchMu.Lock()
+	defer s.fetchMu.Unlock()
+
+	bundlePath, etag, lastModified, err := s.download(ctx)
+	if err != nil {
+		s.setHealthy(false)
+		stats.Record(ctx, metrics.BundleFetchErrorsCount.M(1))
+		return err
+	}
+
+	if bundlePath == "" {
+		s.log.Debug("Bundle is up to date")
+		s.setHealthy(true)
+		return nil
+	}
+
+	defer func() {
+		if err := os.Remove(bundlePath); err != nil {
+			s.log.Warn("Failed to remove downloaded bundle", zap.Error(err))
+		}
+	}()
+
+	return s.swapBundle(bundlePath, etag, lastModified)
+}
+
+// download writes the bundle to a temporary file and returns its path along with the validators sent by the server.
+// The returned path is empty if the server responds that the active bundle has not been modified.
+func (s *HTTPSource) download(ctx context.Context) (bundlePath, etag, lastModified string, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.conf.URL, http.NoBody)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if s.conf.BearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+s.conf.BearerToken)
+	}
+
+	s.mu.RLock()
+	if s.bundle != nil {
+		if s.etag != "" {
+			req.Header.Set("If-None-Match", s.etag)
+		}
+
+		if s.lastModified != "" {
+			req.Header.Set("If-Modified-Since", s.lastModified)
+		}
+	}
+	s.mu.RUnlock()
+
+	s.log.Debug("Checking for new bundle")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to get bundle: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified:
+		return "", "", "", nil
+	default:
+		return "", "", "", fmt.Errorf("failed to get bundle: unexpected response status %q", resp.Status)
+	}
+
+	s.log.Info("Downloading bundle", zap.Int64("size", resp.ContentLength))
+	tmpFile, err := os.CreateTemp(s.conf.TempDir, "download-*.crbp")
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	bundlePath = tmpFile.Name()
+	if _, err := io.Copy(tmpFile, resp.Body); err != nil {
+		_ = tmpFile.Close()
+		_ = os.Remove(bundlePath)
+		return "", "", "", fmt.Errorf("failed to download bundle: %w", err)
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		_ = os.Remove(bundlePath)
+		return "", "", "", fmt.Errorf("failed to write bundle: %w", err)
+	}
+
+	return bundlePath, resp.Header.Get("ETag"), resp.Header.Get("Last-Modified"), nil
+}
+
+func (s *HTTPSource) swapBundle(bundlePath, etag, lastModified string) error {
+	s.log.Debug("Swapping bundle", zap.String("path", bundlePath), zap.String("etag", etag))
+
+	bundle, err := Open(OpenOpts{BundlePath: bundlePath, ScratchFS: s.scratchFS, Credentials: s.credentials})
+	if err != nil {
+		s.log.Error("Failed to open bundle", zap.Error(err))
+		return fmt.Errorf("failed to open bundle: %w", err)
+	}
+
+	s.mu.Lock()
+	oldBundle := s.bundle
+	s.bundle = bundle
+	s.etag = etag
+	s.lastModified = lastModified
+	s.healthy = true
+	s.mu.Unlock()
+
+	if oldBundle != nil {
+		if err := oldBundle.Release(); err != nil {
+			s.log.Warn("Failed to release old bundle", zap.Error(err))
+		}
+	}
+
+	stats.Record(context.Background(), metrics.BundleStoreUpdatesCount.M(1))
+
+	return nil
+}
+
+func (s *HTTPSource) setHealthy(healthy bool) {
+	s.mu.Lock()
+	s.healthy = healthy
+	s.mu.Unlock()
+}
+
+// Revision returns the identifier of the active bundle.
+func (s *HTTPSource) Revision() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.bundle.identifier()
+}
+
+func (s *HTTPSource) Driver() string {
+	return DriverName
+}
+
+func (s *HTTPSource) IsHealthy() bool {
+	if s == nil {
+		return false
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.healthy
+}
+
+func (s *HTTPSource) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID) (*runtimev1.RunnablePolicySet, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.bundle == nil {
+		return nil, ErrBundleNotLoaded
+	}
+
+	return s.bundle.GetFirstMatch(ctx, candidates)
+}
+
+func (s *HTTPSource) ListPolicyIDs(ctx context.Context, params storage.ListPolicyIDsParams) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.bundle == nil {
+		return nil, ErrBundleNotLoaded
+	}
+
+	return s.bundle.ListPolicyIDs(ctx, params)
+}
+
+func (s *HTTPSource) ListSchemaIDs(ctx context.Context) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.bundle == nil {
+		return nil, ErrBundleNotLoaded
+	}
+
+	return s.bundle.ListSchemaIDs(ctx)
+}
+
+func (s *HTTPSource) LoadSchema(ctx context.Context, id string) (io.ReadCloser, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.bundle == nil {
+		return nil, ErrBundleNotLoaded
+	}
+
+	return s.bundle.LoadSchema(ctx, id)
+}
+
+func (s *HTTPSource) Reload(ctx context.Context) error {
+	return s.fetchBundle(ctx)
+}
+
+func (s *HTTPSource) SourceKind() string {
+	return "http"
+}
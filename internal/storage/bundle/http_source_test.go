@@ -0,0 +1,157 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/bundle"
+	"github.com/cerbos/cerbos/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPSource(t *testing.T) {
+	const token = "let-me-in"
+
+	bundleBytes, err := os.ReadFile(filepath.Join(test.PathToDir(t, "bundle"), "bundle_unencrypted.crbp"))
+	require.NoError(t, err)
+
+	srv := newTestBundleServer(t, token, bundleBytes)
+
+	mkConf := func(t *testing.T, path, bearerToken string) *bundle.Conf {
+		t.Helper()
+
+		return &bundle.Conf{
+			HTTP: &bundle.HTTPSourceConf{
+				URL:               srv.server.URL + path,
+				BearerToken:       bearerToken,
+				TempDir:           t.TempDir(),
+				DisableAutoUpdate: true,
+			},
+		}
+	}
+
+	t.Run("conditional_requests", func(t *testing.T) {
+		srv.reset()
+
+		src, err := bundle.NewHTTPSource(mkConf(t, "/bundle.crbp", token))
+		require.NoError(t, err, "Failed to create HTTP source")
+		require.NoError(t, src.Init(context.Background()), "Failed to init")
+		require.True(t, src.IsHealthy(), "Source should be healthy")
+
+		ids, err := src.ListPolicyIDs(context.Background(), storage.ListPolicyIDsParams{IncludeDisabled: true})
+		require.NoError(t, err, "Failed to call ListPolicyIDs")
+		require.NotEmpty(t, ids, "Policy IDs are empty")
+
+		revision := src.Revision()
+		require.NotEmpty(t, revision, "Revision is empty")
+
+		require.NoError(t, src.Reload(context.Background()), "Failed to reload")
+		require.Equal(t, revision, src.Revision(), "Revision changed after reload")
+		require.Equal(t, 1, srv.downloads(), "Unchanged bundle should not be downloaded again")
+
+		srv.touch()
+		require.NoError(t, src.Reload(context.Background()), "Failed to reload")
+		require.Equal(t, 2, srv.downloads(), "Modified bundle should be downloaded")
+		require.True(t, src.IsHealthy(), "Source should be healthy")
+	})
+
+	t.Run("wrong_token", func(t *testing.T) {
+		src, err := bundle.NewHTTPSource(mkConf(t, "/bundle.crbp", "wrong"))
+		require.NoError(t, err, "Failed to create HTTP source")
+		require.Error(t, src.Init(context.Background()), "Expected error")
+		require.False(t, src.IsHealthy(), "Source should be unhealthy")
+
+		_, err = src.ListPolicyIDs(context.Background(), storage.ListPolicyIDsParams{IncludeDisabled: true})
+		require.ErrorIs(t, err, bundle.ErrBundleNotLoaded)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		src, err := bundle.NewHTTPSource(mkConf(t, "/unknown.crbp", token))
+		require.NoError(t, err, "Failed to create HTTP source")
+		require.Error(t, src.Init(context.Background()), "Expected error")
+	})
+}
+
+// testBundleServer serves a single bundle using the standard library's support for conditional requests.
+type testBundleServer struct {
+	server       *httptest.Server
+	lastModified time.Time
+	mu           sync.Mutex
+	numDownloads int
+}
+
+func newTestBundleServer(t *testing.T, token string, bundleBytes []byte) *testBundleServer {
+	t.Helper()
+
+	srv := &testBundleServer{}
+	srv.reset()
+
+	srv.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.URL.Path != "/bundle.crbp" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		srv.mu.Lock()
+		lastModified := srv.lastModified
+		srv.mu.Unlock()
+
+		w.Header().Set("ETag", `"`+lastModified.Format("20060102150405")+`"`)
+		counter := &countingWriter{ResponseWriter: w, srv: srv}
+		http.ServeContent(counter, r, "bundle.crbp", lastModified, bytes.NewReader(bundleBytes))
+	}))
+	t.Cleanup(srv.server.Close)
+
+	return srv
+}
+
+func (srv *testBundleServer) reset() {
+	srv.mu.Lock()
+	srv.lastModified = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	srv.numDownloads = 0
+	srv.mu.Unlock()
+}
+
+func (srv *testBundleServer) touch() {
+	srv.mu.Lock()
+	srv.lastModified = srv.lastModified.Add(time.Hour)
+	srv.mu.Unlock()
+}
+
+func (srv *testBundleServer) downloads() int {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	return srv.numDownloads
+}
+
+type countingWriter struct {
+	http.ResponseWriter
+	srv *testBundleServer
+}
+
+func (cw *countingWriter) WriteHeader(status int) {
+	if status == http.StatusOK {
+		cw.srv.mu.Lock()
+		cw.srv.numDownloads++
+		cw.srv.mu.Unlock()
+	}
+
+	cw.ResponseWriter.WriteHeader(status)
+}
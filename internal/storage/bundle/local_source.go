@@ -115,6 +115,14 @@ func (ls *LocalSource) loadBundle() error {
 	return nil
 }
 
+// Revision returns the identifier of the bundle.
+func (ls *LocalSource) Revision() string {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+
+	return ls.bundle.identifier()
+}
+
 func (ls *LocalSource) Driver() string {
 	return DriverName
 }
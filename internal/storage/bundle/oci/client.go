@@ -0,0 +1,361 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	// BundleMediaType is the media type of the layer containing the bundle.
+	BundleMediaType = "application/vnd.cerbos.bundle.v1"
+	// SignatureAnnotation is the annotation of the bundle layer that holds the base64-encoded signature of the bundle.
+	SignatureAnnotation = "dev.cerbos.bundle.signature"
+
+	dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+	dockerManifestListType  = "application/vnd.docker.distribution.manifest.list.v2+json"
+	contentDigestHeader     = "Docker-Content-Digest"
+
+	maxManifestSize = 4 * 1024 * 1024
+	maxTokenSize    = 1024 * 1024
+	defaultTimeout  = 5 * time.Minute
+)
+
+var (
+	ErrNoBundleLayer    = errors.New("artifact does not contain a bundle layer")
+	ErrDigestMismatch   = errors.New("content does not match the expected digest")
+	ErrUnsupportedIndex = errors.New("image indexes are not supported: push the bundle as a single artifact")
+)
+
+// Options configure the registry client.
+type Options struct {
+	// TLS is the TLS configuration to use for HTTPS connections.
+	TLS *tls.Config
+	// Username to authenticate with. Authentication is anonymous if empty.
+	Username string
+	// Password (or access token) to authenticate with.
+	Password string
+	// PlainHTTP makes the client use HTTP instead of HTTPS.
+	PlainHTTP bool
+}
+
+// Client pulls artifacts from a registry implementing the OCI distribution specification.
+type Client struct {
+	httpClient *http.Client
+	opts       Options
+	ref        Reference
+	baseURL    string
+	mu         sync.Mutex
+	// authorization is the value of the Authorization header obtained from the last authentication challenge.
+	authorization string
+}
+
+func NewClient(ref Reference, opts Options) *Client {
+	scheme := "https"
+	if opts.PlainHTTP {
+		scheme = "http"
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert
+	if opts.TLS != nil {
+		transport.TLSClientConfig = opts.TLS
+	}
+
+	return &Client{
+		httpClient: &http.Client{Transport: transport, Timeout: defaultTimeout},
+		opts:       opts,
+		ref:        ref,
+		baseURL:    fmt.Sprintf("%s://%s/v2/%s", scheme, ref.apiHost(), ref.Repository),
+	}
+}
+
+// Manifest is an artifact manifest along with its digest.
+type Manifest struct {
+	ocispec.Manifest
+	Digest digest.Digest
+}
+
+// BundleLayer returns the descriptor of the layer containing the bundle.
+// Artifacts pushed without a media type for the bundle are accepted if they have a single layer.
+func (m Manifest) BundleLayer() (ocispec.Descriptor, error) {
+	for _, l := range m.Layers {
+		if l.MediaType == BundleMediaType {
+			return l, nil
+		}
+	}
+
+	if len(m.Layers) == 1 {
+		return m.Layers[0], nil
+	}
+
+	return ocispec.Descriptor{}, ErrNoBundleLayer
+}
+
+// Resolve gets the manifest of the artifact that the reference currently points to.
+// The manifest is verified against the reference digest if the reference is pinned.
+func (c *Client) Resolve(ctx context.Context) (Manifest, error) {
+	resp, err := c.get(ctx, "/manifests/"+c.ref.manifestRef(),
+		ocispec.MediaTypeImageManifest, dockerManifestMediaType, ocispec.MediaTypeImageIndex, dockerManifestListType)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("failed to get manifest for %s: %w", c.ref, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize+1))
+	if err != nil {
+		return Manifest{}, fmt.Errorf("failed to read manifest for %s: %w", c.ref, err)
+	}
+
+	if len(body) > maxManifestSize {
+		return Manifest{}, fmt.Errorf("manifest for %s exceeds the maximum size of %d bytes", c.ref, maxManifestSize)
+	}
+
+	expected := c.ref.Digest
+	if expected == "" {
+		expected = digest.Digest(resp.Header.Get(contentDigestHeader))
+	}
+
+	algorithm := digest.Canonical
+	if expected != "" {
+		if err := expected.Validate(); err != nil {
+			return Manifest{}, fmt.Errorf("invalid digest for %s: %w", c.ref, err)
+		}
+		algorithm = expected.Algorithm()
+	}
+
+	actual := algorithm.FromBytes(body)
+	if expected != "" && actual != expected {
+		return Manifest{}, fmt.Errorf("manifest for %s: %w", c.ref, ErrDigestMismatch)
+	}
+
+	var m ocispec.Manifest
+	if err := json.Unmarshal(body, &m); err != nil {
+		return Manifest{}, fmt.Errorf("failed to parse manifest for %s: %w", c.ref, err)
+	}
+
+	mediaType := m.MediaType
+	if mediaType == "" {
+		mediaType = strings.TrimSpace(strings.Split(resp.Header.Get("Content-Type"), ";")[0])
+	}
+
+	if mediaType == ocispec.MediaTypeImageIndex || mediaType == dockerManifestListType {
+		return Manifest{}, ErrUnsupportedIndex
+	}
+
+	return Manifest{Manifest: m, Digest: actual}, nil
+}
+
+// Fetch writes the content of the blob described by the descriptor to the writer, verifying its size and digest.
+func (c *Client) Fetch(ctx context.Context, desc ocispec.Descriptor, w io.Writer) error {
+	if err := desc.Digest.Validate(); err != nil {
+		return fmt.Errorf("invalid blob digest: %w", err)
+	}
+
+	resp, err := c.get(ctx, "/blobs/"+desc.Digest.String())
+	if err != nil {
+		return fmt.Errorf("failed to get blob %s: %w", desc.Digest, err)
+	}
+	defer resp.Body.Close()
+
+	verifier := desc.Digest.Verifier()
+	n, err := io.Copy(io.MultiWriter(w, verifier), io.LimitReader(resp.Body, desc.Size+1))
+	if err != nil {
+		return fmt.Errorf("failed to read blob %s: %w", desc.Digest, err)
+	}
+
+	if n != desc.Size || !verifier.Verified() {
+		return fmt.Errorf("blob %s: %w", desc.Digest, ErrDigestMismatch)
+	}
+
+	return nil
+}
+
+// get performs a GET request against the repository, authenticating if the registry asks for it.
+func (c *Client) get(ctx context.Context, path string, accept ...string) (*http.Response, error) {
+	resp, err := c.do(ctx, path, accept)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		drain(resp)
+
+		if err := c.authenticate(ctx, challenge); err != nil {
+			return nil, err
+		}
+
+		if resp, err = c.do(ctx, path, accept); err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		drain(resp)
+		return nil, fmt.Errorf("unexpected response status %q", resp.Status)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) do(ctx context.Context, path string, accept []string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(accept) > 0 {
+		req.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+
+	c.mu.Lock()
+	authorization := c.authorization
+	c.mu.Unlock()
+
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	return c.httpClient.Do(req)
+}
+
+// authenticate responds to an authentication challenge from the registry.
+// See https://distribution.github.io/distribution/spec/auth/token/
+func (c *Client) authenticate(ctx context.Context, challenge string) error {
+	scheme, params := parseChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if c.opts.Username == "" && c.opts.Password == "" {
+			return errors.New("registry requires credentials")
+		}
+
+		c.setAuthorization("Basic " + base64.StdEncoding.EncodeToString([]byte(c.opts.Username+":"+c.opts.Password)))
+		return nil
+
+	case "bearer":
+		token, err := c.fetchToken(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to get token from registry: %w", err)
+		}
+
+		c.setAuthorization("Bearer " + token)
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+}
+
+func (c *Client) setAuthorization(authorization string) {
+	c.mu.Lock()
+	c.authorization = authorization
+	c.mu.Unlock()
+}
+
+func (c *Client) fetchToken(ctx context.Context, params map[string]string) (string, error) {
+	realm, ok := params["realm"]
+	if !ok {
+		return "", errors.New("challenge does not have a realm")
+	}
+
+	u, err := url.Parse(realm)
+	if err != nil {
+		return "", fmt.Errorf("invalid realm %q: %w", realm, err)
+	}
+
+	q := u.Query()
+	if service, ok := params["service"]; ok {
+		q.Set("service", service)
+	}
+
+	scope, ok := params["scope"]
+	if !ok {
+		scope = fmt.Sprintf("repository:%s:pull", c.ref.Repository)
+	}
+	q.Set("scope", scope)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+	if err != nil {
+		return "", err
+	}
+
+	if c.opts.Username != "" || c.opts.Password != "" {
+		req.SetBasicAuth(c.opts.Username, c.opts.Password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected response status %q", resp.Status)
+	}
+
+	var tokenResp struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxTokenSize)).Decode(&tokenResp); err != nil {
+		return "", fmt.Errorf("failed to decode token response: %w", err)
+	}
+
+	if tokenResp.Token != "" {
+		return tokenResp.Token, nil
+	}
+
+	if tokenResp.AccessToken != "" {
+		return tokenResp.AccessToken, nil
+	}
+
+	return "", errors.New("token response is empty")
+}
+
+// parseChallenge parses a WWW-Authenticate header value such as `Bearer realm="https://auth.example.com/token",service="registry"`.
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := make(map[string]string)
+
+	for rest = strings.TrimSpace(rest); rest != ""; rest = strings.TrimLeft(rest, ", ") {
+		key, after, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+
+		key = strings.ToLower(strings.TrimSpace(key))
+		if strings.HasPrefix(after, `"`) {
+			value, remaining, _ := strings.Cut(after[1:], `"`)
+			params[key] = value
+			rest = remaining
+			continue
+		}
+
+		value, remaining, _ := strings.Cut(after, ",")
+		params[key] = strings.TrimSpace(value)
+		rest = remaining
+	}
+
+	return scheme, params
+}
+
+func drain(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxManifestSize))
+	_ = resp.Body.Close()
+}
@@ -0,0 +1,232 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package oci_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/storage/bundle/oci"
+)
+
+func TestParseReference(t *testing.T) {
+	const dgst = "sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b"
+
+	testCases := []struct {
+		input   string
+		want    oci.Reference
+		wantErr bool
+	}{
+		{
+			input: "ghcr.io/cerbos/policies:v1",
+			want:  oci.Reference{Registry: "ghcr.io", Repository: "cerbos/policies", Tag: "v1"},
+		},
+		{
+			input: "ghcr.io/cerbos/policies",
+			want:  oci.Reference{Registry: "ghcr.io", Repository: "cerbos/policies", Tag: "latest"},
+		},
+		{
+			input: "localhost:5000/policies",
+			want:  oci.Reference{Registry: "localhost:5000", Repository: "policies", Tag: "latest"},
+		},
+		{
+			input: "123456789012.dkr.ecr.eu-west-2.amazonaws.com/policies@" + dgst,
+			want:  oci.Reference{Registry: "123456789012.dkr.ecr.eu-west-2.amazonaws.com", Repository: "policies", Digest: dgst},
+		},
+		{
+			input: "ghcr.io/cerbos/policies:v1@" + dgst,
+			want:  oci.Reference{Registry: "ghcr.io", Repository: "cerbos/policies", Digest: dgst},
+		},
+		{
+			input: "policies:v1",
+			want:  oci.Reference{Registry: "docker.io", Repository: "library/policies", Tag: "v1"},
+		},
+		{
+			input: "cerbos/policies",
+			want:  oci.Reference{Registry: "docker.io", Repository: "cerbos/policies", Tag: "latest"},
+		},
+		{input: "ghcr.io/Cerbos/policies", wantErr: true},
+		{input: "ghcr.io/cerbos/policies:-v1", wantErr: true},
+		{input: "ghcr.io/cerbos/policies@sha256:abc", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.input, func(t *testing.T) {
+			have, err := oci.ParseReference(tc.input)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func TestClient(t *testing.T) {
+	bundle := []byte("bundle contents")
+	bundleDesc := ocispec.Descriptor{MediaType: oci.BundleMediaType, Digest: digest.FromBytes(bundle), Size: int64(len(bundle))}
+
+	reg := newRegistry(t, bundle, bundleDesc)
+	manifestDigest := digest.FromBytes(reg.manifest)
+
+	mkClient := func(t *testing.T, ref string, opts oci.Options) *oci.Client {
+		t.Helper()
+
+		r, err := oci.ParseReference(strings.TrimPrefix(reg.server.URL, "http://") + "/" + ref)
+		require.NoError(t, err)
+
+		opts.PlainHTTP = true
+		return oci.NewClient(r, opts)
+	}
+
+	t.Run("tag", func(t *testing.T) {
+		client := mkClient(t, "cerbos/policies:latest", oci.Options{Username: "user", Password: "pass"})
+
+		m, err := client.Resolve(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, manifestDigest, m.Digest)
+
+		layer, err := m.BundleLayer()
+		require.NoError(t, err)
+		require.Equal(t, bundleDesc.Digest, layer.Digest)
+
+		var buf bytes.Buffer
+		require.NoError(t, client.Fetch(context.Background(), layer, &buf))
+		require.Equal(t, bundle, buf.Bytes())
+	})
+
+	t.Run("digest", func(t *testing.T) {
+		client := mkClient(t, "cerbos/policies@"+manifestDigest.String(), oci.Options{Username: "user", Password: "pass"})
+
+		m, err := client.Resolve(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, manifestDigest, m.Digest)
+	})
+
+	t.Run("digest_mismatch", func(t *testing.T) {
+		wrong := digest.FromString("something else")
+		reg.manifests[wrong.String()] = reg.manifest
+
+		client := mkClient(t, "cerbos/policies@"+wrong.String(), oci.Options{Username: "user", Password: "pass"})
+
+		_, err := client.Resolve(context.Background())
+		require.ErrorIs(t, err, oci.ErrDigestMismatch)
+	})
+
+	t.Run("blob_mismatch", func(t *testing.T) {
+		client := mkClient(t, "cerbos/policies:latest", oci.Options{Username: "user", Password: "pass"})
+
+		tampered := bundleDesc
+		tampered.Size--
+		require.ErrorIs(t, client.Fetch(context.Background(), tampered, &bytes.Buffer{}), oci.ErrDigestMismatch)
+	})
+
+	t.Run("wrong_credentials", func(t *testing.T) {
+		client := mkClient(t, "cerbos/policies:latest", oci.Options{Username: "user", Password: "wrong"})
+
+		_, err := client.Resolve(context.Background())
+		require.Error(t, err)
+	})
+
+	t.Run("unknown_tag", func(t *testing.T) {
+		client := mkClient(t, "cerbos/policies:unknown", oci.Options{Username: "user", Password: "pass"})
+
+		_, err := client.Resolve(context.Background())
+		require.Error(t, err)
+	})
+}
+
+// registry is a minimal registry that serves a single repository and issues bearer tokens for it.
+type registry struct {
+	server    *httptest.Server
+	manifests map[string][]byte
+	blobs     map[string][]byte
+	manifest  []byte
+}
+
+func newRegistry(t *testing.T, bundle []byte, bundleDesc ocispec.Descriptor) *registry {
+	t.Helper()
+
+	const token = "let-me-in"
+
+	manifest, err := json.Marshal(ocispec.Manifest{
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    ocispec.Descriptor{MediaType: "application/vnd.oci.empty.v1+json", Digest: digest.FromString("{}"), Size: 2},
+		Layers:    []ocispec.Descriptor{bundleDesc},
+	})
+	require.NoError(t, err)
+
+	reg := &registry{
+		manifest:  manifest,
+		manifests: map[string][]byte{"latest": manifest, digest.FromBytes(manifest).String(): manifest},
+		blobs:     map[string][]byte{bundleDesc.Digest.String(): bundle},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.URL.Query().Get("scope") != "repository:cerbos/policies:pull" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": token})
+	})
+
+	mux.HandleFunc("/v2/cerbos/policies/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test",scope="repository:cerbos/policies:pull"`, reg.server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		kind, ref, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/v2/cerbos/policies/"), "/")
+		switch kind {
+		case "manifests":
+			m, ok := reg.manifests[ref]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+			_, _ = w.Write(m)
+
+		case "blobs":
+			b, ok := reg.blobs[ref]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			_, _ = w.Write(b)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	reg.server = httptest.NewServer(mux)
+	t.Cleanup(reg.server.Close)
+
+	return reg
+}
@@ -0,0 +1,120 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+)
+
+const (
+	defaultTag         = "latest"
+	dockerHubRegistry  = "docker.io"
+	dockerHubAPIHost   = "registry-1.docker.io"
+	dockerHubNamespace = "library/"
+)
+
+var (
+	repositoryRegexp = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*$`)
+	tagRegexp        = regexp.MustCompile(`^\w[\w.-]{0,127}$`)
+)
+
+// Reference identifies an artifact in a registry, either by tag or by digest.
+type Reference struct {
+	// Registry is the host (and optional port) of the registry.
+	Registry string
+	// Repository is the path of the repository in the registry.
+	Repository string
+	// Tag is the tag of the artifact. Empty if the reference is pinned to a digest.
+	Tag string
+	// Digest is the digest of the artifact manifest. Empty if the reference is a tag.
+	Digest digest.Digest
+}
+
+// ParseReference parses references of the form `registry/repository[:tag|@digest]`.
+// As with Docker, the registry defaults to Docker Hub and the tag defaults to `latest`.
+func ParseReference(ref string) (Reference, error) {
+	var r Reference
+
+	rest := ref
+	if i := strings.Index(rest, "@"); i >= 0 {
+		d, err := digest.Parse(rest[i+1:])
+		if err != nil {
+			return r, fmt.Errorf("invalid digest in reference %q: %w", ref, err)
+		}
+
+		r.Digest = d
+		rest = rest[:i]
+	}
+
+	if i := strings.LastIndex(rest, ":"); i > strings.LastIndex(rest, "/") {
+		r.Tag = rest[i+1:]
+		rest = rest[:i]
+
+		if !tagRegexp.MatchString(r.Tag) {
+			return r, fmt.Errorf("invalid tag in reference %q", ref)
+		}
+	}
+
+	r.Registry = dockerHubRegistry
+	if i := strings.Index(rest, "/"); i >= 0 {
+		if host := rest[:i]; strings.ContainsAny(host, ".:") || host == "localhost" {
+			r.Registry = host
+			rest = rest[i+1:]
+		}
+	}
+
+	r.Repository = rest
+	if r.Registry == dockerHubRegistry && !strings.Contains(r.Repository, "/") {
+		r.Repository = dockerHubNamespace + r.Repository
+	}
+
+	if !repositoryRegexp.MatchString(r.Repository) {
+		return r, fmt.Errorf("invalid repository in reference %q", ref)
+	}
+
+	// The digest takes precedence over the tag because it identifies the content unambiguously.
+	if r.Digest != "" {
+		r.Tag = ""
+	} else if r.Tag == "" {
+		r.Tag = defaultTag
+	}
+
+	return r, nil
+}
+
+// Pinned returns true if the reference identifies the artifact by digest.
+func (r Reference) Pinned() bool {
+	return r.Digest != ""
+}
+
+// String returns the reference in its canonical form.
+func (r Reference) String() string {
+	if r.Pinned() {
+		return r.Registry + "/" + r.Repository + "@" + r.Digest.String()
+	}
+
+	return r.Registry + "/" + r.Repository + ":" + r.Tag
+}
+
+// apiHost returns the host serving the registry API.
+func (r Reference) apiHost() string {
+	if r.Registry == dockerHubRegistry {
+		return dockerHubAPIHost
+	}
+
+	return r.Registry
+}
+
+// manifestRef returns the tag or digest to use when getting the manifest.
+func (r Reference) manifestRef() string {
+	if r.Pinned() {
+		return r.Digest.String()
+	}
+
+	return r.Tag
+}
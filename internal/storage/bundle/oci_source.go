@@ -0,0 +1,353 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/signature"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/bundle/oci"
+	"github.com/cerbos/cloud-api/credentials"
+	"github.com/opencontainers/go-digest"
+	"github.com/spf13/afero"
+	"go.opencensus.io/stats"
+	"go.uber.org/zap"
+)
+
+var (
+	_ storage.BinaryStore = (*OCISource)(nil)
+	_ storage.Reloadable  = (*OCISource)(nil)
+)
+
+// OCISource implements a bundle store that pulls bundles pushed as OCI artifacts to a container registry.
+type OCISource struct {
+	credentials *credentials.Credentials
+	log         *zap.Logger
+	conf        *OCISourceConf
+	client      *oci.Client
+	verifier    *signature.Verifier
+	bundle      *Bundle
+	scratchFS   afero.Fs
+	ref         oci.Reference
+	// digest is the digest of the manifest of the active bundle.
+	digest  digest.Digest
+	mu      sync.RWMutex
+	fetchMu sync.Mutex
+	healthy bool
+}
+
+func NewOCISource(conf *Conf) (*OCISource, error) {
+	if err := conf.OCI.setDefaults(); err != nil {
+		return nil, err
+	}
+
+	ref, err := oci.ParseReference(conf.OCI.Reference)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reference: %w", err)
+	}
+
+	var creds *credentials.Credentials
+	if conf.Credentials.SecretKey != "" {
+		creds, err = credentials.New("unknown", "unknown", conf.Credentials.SecretKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create credentials: %w", err)
+		}
+	}
+
+	opts := oci.Options{
+		Username:  conf.OCI.Username,
+		Password:  conf.OCI.Password,
+		PlainHTTP: conf.OCI.PlainHTTP,
+	}
+
+	if caCertPath := conf.OCI.CACert; caCertPath != "" {
+		caCert, err := os.ReadFile(caCertPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA cert from %q: %w", caCertPath, err)
+		}
+
+		opts.TLS = &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: x509.NewCertPool()}
+		if !opts.TLS.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("failed to parse CA certs")
+		}
+	}
+
+	verifier, err := signature.NewVerifierFromConf(conf.OCI.Verification)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OCISource{
+		credentials: creds,
+		log:         zap.L().Named("bundle").With(zap.Stringer("reference", ref)),
+		conf:        conf.OCI,
+		client:      oci.NewClient(ref, opts),
+		verifier:    verifier,
+		scratchFS:   afero.NewBasePathFs(afero.NewOsFs(), conf.OCI.TempDir),
+		ref:         ref,
+	}, nil
+}
+
+func (s *OCISource) Init(ctx context.Context) error {
+	// fail fast if the registry is unreachable, unless a pinned bundle has been cached before
+	if err := s.fetchBundle(ctx); err != nil {
+		if !s.ref.Pinned() {
+			return err
+		}
+
+		s.log.Warn("Failed to pull pinned bundle: looking for cached copy", zap.Error(err))
+		if err := s.swapBundle(s.cachePath(s.ref.Digest), s.ref.Digest); err != nil {
+			return fmt.Errorf("failed to find cached bundle: %w", err)
+		}
+	}
+
+	// a pinned reference always resolves to the same bundle, so there's nothing to poll for
+	if !s.ref.Pinned() && !s.conf.DisableAutoUpdate {
+		go s.startPollLoop(ctx)
+	}
+
+	return nil
+}
+
+func (s *OCISource) startPollLoop(ctx context.Context) {
+	s.log.Info(fmt.Sprintf("Checking for new bundles every %s", s.conf.UpdatePollInterval))
+	ticker := time.NewTicker(s.conf.UpdatePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.log.Info("Terminating bundle polling due to context cancellation")
+			return
+		case <-ticker.C:
+			if err := s.fetchBundle(ctx); err != nil {
+				s.log.Warn("Failed to check for new bundle", zap.Error(err))
+			}
+		}
+	}
+}
+
+// fetchBundle resolves the reference and swaps the active bundle if the reference points to a different artifact.
+func (s *OCISource) fetchBundle(ctx context.Context) error {
+	s.fetchMu.Lock()
+	defer s.fetchMu.Unlock()
+
+	s.log.Debug("Resolving reference")
+	manifest, err := s.client.Resolve(ctx)
+	if err != nil {
+		s.setHealthy(false)
+		stats.Record(ctx, metrics.BundleFetchErrorsCount.M(1))
+		return fmt.Errorf("failed to resolve reference: %w", err)
+	}
+
+	s.mu.RLock()
+	unchanged := s.bundle != nil && s.digest == manifest.Digest
+	s.mu.RUnlock()
+
+	if unchanged {
+		s.log.Debug("Bundle is up to date", zap.Stringer("digest", manifest.Digest))
+		s.setHealthy(true)
+		return nil
+	}
+
+	bundlePath, err := s.download(ctx, manifest)
+	if err != nil {
+		s.setHealthy(false)
+		stats.Record(ctx, metrics.BundleFetchErrorsCount.M(1))
+		return err
+	}
+
+	return s.swapBundle(bundlePath, manifest.Digest)
+}
+
+// download pulls the bundle layer of the artifact into the cache directory, unless it's already there.
+func (s *OCISource) download(ctx context.Context, manifest oci.Manifest) (string, error) {
+	bundlePath := s.cachePath(manifest.Digest)
+	if _, err := os.Stat(bundlePath); err == nil {
+		s.log.Debug("Using cached bundle", zap.String("path", bundlePath))
+		return bundlePath, nil
+	}
+
+	layer, err := manifest.BundleLayer()
+	if err != nil {
+		return "", err
+	}
+
+	s.log.Info("Pulling bundle", zap.Stringer("digest", manifest.Digest), zap.Int64("size", layer.Size))
+	tmpFile, err := os.CreateTemp(s.conf.CacheDir, "bundle-*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	tmpPath := tmpFile.Name()
+	if err := s.client.Fetch(ctx, layer, tmpFile); err != nil {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpPath)
+		return "", fmt.Errorf("failed to pull bundle: %w", err)
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return "", fmt.Errorf("failed to write bundle: %w", err)
+	}
+
+	// the signature is cached alongside the bundle so that a cached copy can be verified when the registry is unreachable
+	if sig, ok := layer.Annotations[oci.SignatureAnnotation]; ok {
+		if err := os.WriteFile(bundlePath+signature.Extension, []byte(sig), 0o600); err != nil { //nolint:gomnd
+			_ = os.Remove(tmpPath)
+			return "", fmt.Errorf("failed to cache bundle signature: %w", err)
+		}
+	}
+
+	if err := os.Rename(tmpPath, bundlePath); err != nil {
+		_ = os.Remove(tmpPath)
+		return "", fmt.Errorf("failed to cache bundle: %w", err)
+	}
+
+	return bundlePath, nil
+}
+
+func (s *OCISource) cachePath(manifestDigest digest.Digest) string {
+	return filepath.Join(s.conf.CacheDir, fmt.Sprintf("%s-%s.crbp", manifestDigest.Algorithm(), manifestDigest.Encoded()))
+}
+
+func (s *OCISource) swapBundle(bundlePath string, manifestDigest digest.Digest) error {
+	s.log.Debug("Swapping bundle", zap.String("path", bundlePath), zap.Stringer("digest", manifestDigest))
+
+	if s.verifier != nil {
+		if err := s.verifier.VerifyFile(bundlePath); err != nil {
+			s.log.Error("Refusing to load bundle that failed signature verification", zap.Error(err))
+			return err
+		}
+	}
+
+	bundle, err := Open(OpenOpts{BundlePath: bundlePath, ScratchFS: s.scratchFS, Credentials: s.credentials})
+	if err != nil {
+		s.log.Error("Failed to open bundle", zap.Error(err))
+		return fmt.Errorf("failed to open bundle: %w", err)
+	}
+
+	s.mu.Lock()
+	oldBundle := s.bundle
+	oldDigest := s.digest
+	s.bundle = bundle
+	s.digest = manifestDigest
+	s.healthy = true
+	s.mu.Unlock()
+
+	if oldBundle != nil {
+		if err := oldBundle.Release(); err != nil {
+			s.log.Warn("Failed to release old bundle", zap.Error(err))
+		}
+	}
+
+	// only the active bundle is kept in the cache
+	if oldDigest != "" && oldDigest != manifestDigest {
+		oldPath := s.cachePath(oldDigest)
+		if err := os.Remove(oldPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			s.log.Warn("Failed to remove old bundle from cache", zap.Error(err))
+		}
+		if err := os.Remove(oldPath + signature.Extension); err != nil && !errors.Is(err, os.ErrNotExist) {
+			s.log.Warn("Failed to remove old bundle signature from cache", zap.Error(err))
+		}
+	}
+
+	stats.Record(context.Background(), metrics.BundleStoreUpdatesCount.M(1))
+
+	return nil
+}
+
+func (s *OCISource) setHealthy(healthy bool) {
+	s.mu.Lock()
+	s.healthy = healthy
+	s.mu.Unlock()
+}
+
+// Revision returns the identifier of the active bundle.
+func (s *OCISource) Revision() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.bundle.identifier()
+}
+
+func (s *OCISource) Driver() string {
+	return DriverName
+}
+
+func (s *OCISource) IsHealthy() bool {
+	if s == nil {
+		return false
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.healthy
+}
+
+func (s *OCISource) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID) (*runtimev1.RunnablePolicySet, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.bundle == nil {
+		return nil, ErrBundleNotLoaded
+	}
+
+	return s.bundle.GetFirstMatch(ctx, candidates)
+}
+
+func (s *OCISource) ListPolicyIDs(ctx context.Context, params storage.ListPolicyIDsParams) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.bundle == nil {
+		return nil, ErrBundleNotLoaded
+	}
+
+	return s.bundle.ListPolicyIDs(ctx, params)
+}
+
+func (s *OCISource) ListSchemaIDs(ctx context.Context) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.bundle == nil {
+		return nil, ErrBundleNotLoaded
+	}
+
+	return s.bundle.ListSchemaIDs(ctx)
+}
+
+func (s *OCISource) LoadSchema(ctx context.Context, id string) (io.ReadCloser, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.bundle == nil {
+		return nil, ErrBundleNotLoaded
+	}
+
+	return s.bundle.LoadSchema(ctx, id)
+}
+
+func (s *OCISource) Reload(ctx context.Context) error {
+	return s.fetchBundle(ctx)
+}
+
+func (s *OCISource) SourceKind() string {
+	return "oci"
+}
@@ -0,0 +1,164 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/bundle"
+	"github.com/cerbos/cerbos/internal/storage/bundle/oci"
+	"github.com/cerbos/cerbos/internal/test"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOCISource(t *testing.T) {
+	bundleBytes, err := os.ReadFile(filepath.Join(test.PathToDir(t, "bundle"), "bundle_unencrypted.crbp"))
+	require.NoError(t, err)
+
+	reg := newTestRegistry(t)
+	manifestDigest := reg.push(t, "latest", bundleBytes)
+	host := strings.TrimPrefix(reg.server.URL, "http://")
+
+	mkConf := func(t *testing.T, ref, cacheDir string) *bundle.Conf {
+		t.Helper()
+
+		return &bundle.Conf{
+			OCI: &bundle.OCISourceConf{
+				Reference:         host + "/cerbos/policies" + ref,
+				PlainHTTP:         true,
+				CacheDir:          cacheDir,
+				TempDir:           t.TempDir(),
+				DisableAutoUpdate: true,
+			},
+		}
+	}
+
+	t.Run("tag", func(t *testing.T) {
+		src, err := bundle.NewOCISource(mkConf(t, ":latest", t.TempDir()))
+		require.NoError(t, err, "Failed to create OCI source")
+		require.NoError(t, src.Init(context.Background()), "Failed to init")
+		require.True(t, src.IsHealthy(), "Source should be healthy")
+
+		ids, err := src.ListPolicyIDs(context.Background(), storage.ListPolicyIDsParams{IncludeDisabled: true})
+		require.NoError(t, err, "Failed to call ListPolicyIDs")
+		require.NotEmpty(t, ids, "Policy IDs are empty")
+
+		revision := src.Revision()
+		require.NotEmpty(t, revision, "Revision is empty")
+
+		require.NoError(t, src.Reload(context.Background()), "Failed to reload")
+		require.Equal(t, revision, src.Revision(), "Revision changed after reload")
+	})
+
+	t.Run("unknown_tag", func(t *testing.T) {
+		src, err := bundle.NewOCISource(mkConf(t, ":unknown", t.TempDir()))
+		require.NoError(t, err, "Failed to create OCI source")
+		require.Error(t, src.Init(context.Background()), "Expected error")
+		require.False(t, src.IsHealthy(), "Source should be unhealthy")
+
+		_, err = src.ListPolicyIDs(context.Background(), storage.ListPolicyIDsParams{IncludeDisabled: true})
+		require.ErrorIs(t, err, bundle.ErrBundleNotLoaded)
+	})
+
+	t.Run("pinned_from_cache", func(t *testing.T) {
+		cacheDir := t.TempDir()
+
+		src, err := bundle.NewOCISource(mkConf(t, "@"+manifestDigest.String(), cacheDir))
+		require.NoError(t, err, "Failed to create OCI source")
+		require.NoError(t, src.Init(context.Background()), "Failed to init")
+
+		reg.setOffline(true)
+		t.Cleanup(func() { reg.setOffline(false) })
+
+		cached, err := bundle.NewOCISource(mkConf(t, "@"+manifestDigest.String(), cacheDir))
+		require.NoError(t, err, "Failed to create OCI source")
+		require.NoError(t, cached.Init(context.Background()), "Failed to init from cache")
+		require.Equal(t, src.Revision(), cached.Revision())
+	})
+}
+
+// testRegistry is a minimal registry that serves artifacts from the cerbos/policies repository.
+type testRegistry struct {
+	server    *httptest.Server
+	manifests map[string][]byte
+	blobs     map[string][]byte
+	mu        sync.RWMutex
+	offline   bool
+}
+
+func newTestRegistry(t *testing.T) *testRegistry {
+	t.Helper()
+
+	reg := &testRegistry{manifests: make(map[string][]byte), blobs: make(map[string][]byte)}
+	reg.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reg.mu.RLock()
+		defer reg.mu.RUnlock()
+
+		if reg.offline {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		kind, ref, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/v2/cerbos/policies/"), "/")
+		var content []byte
+		var ok bool
+		switch kind {
+		case "manifests":
+			content, ok = reg.manifests[ref]
+			w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+		case "blobs":
+			content, ok = reg.blobs[ref]
+		}
+
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write(content)
+	}))
+	t.Cleanup(reg.server.Close)
+
+	return reg
+}
+
+func (reg *testRegistry) push(t *testing.T, tag string, bundleBytes []byte) digest.Digest {
+	t.Helper()
+
+	layer := ocispec.Descriptor{MediaType: oci.BundleMediaType, Digest: digest.FromBytes(bundleBytes), Size: int64(len(bundleBytes))}
+	manifest, err := json.Marshal(ocispec.Manifest{
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    ocispec.Descriptor{MediaType: "application/vnd.oci.empty.v1+json", Digest: digest.FromString("{}"), Size: 2},
+		Layers:    []ocispec.Descriptor{layer},
+	})
+	require.NoError(t, err)
+
+	manifestDigest := digest.FromBytes(manifest)
+
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	reg.blobs[layer.Digest.String()] = bundleBytes
+	reg.manifests[tag] = manifest
+	reg.manifests[manifestDigest.String()] = manifest
+
+	return manifestDigest
+}
+
+func (reg *testRegistry) setOffline(offline bool) {
+	reg.mu.Lock()
+	reg.offline = offline
+	reg.mu.Unlock()
+}
@@ -240,11 +240,16 @@ func (s *RemoteSource) activeBundleVersion() string {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	if s.bundle == nil || s.bundle.manifest == nil || s.bundle.manifest.Meta == nil {
-		return cloudapi.BundleIDUnknown
+	if id := s.bundle.identifier(); id != "" {
+		return id
 	}
 
-	return s.bundle.manifest.Meta.Identifier
+	return cloudapi.BundleIDUnknown
+}
+
+// Revision returns the identifier of the active bundle.
+func (s *RemoteSource) Revision() string {
+	return s.activeBundleVersion()
 }
 
 func (s *RemoteSource) startWatchLoop(ctx context.Context, noBundleBackoff backoff.BackOff) {
@@ -71,6 +71,14 @@ func (is instrumentedSource) Close() error {
 	return nil
 }
 
+func (is instrumentedSource) Revision() string {
+	if r, ok := is.source.(storage.Revisioned); ok {
+		return r.Revision()
+	}
+
+	return ""
+}
+
 func (is instrumentedSource) SourceKind() string {
 	if s, ok := is.source.(Source); ok {
 		return s.SourceKind()
@@ -38,6 +38,8 @@ func NewStore(ctx context.Context, conf *Conf) (storage.BinaryStore, error) {
 
 	var local *LocalSource
 	var remote *RemoteSource
+	var ociSource *OCISource
+	var httpSource *HTTPSource
 	var err error
 
 	if conf.Local != nil {
@@ -63,17 +65,60 @@ func NewStore(ctx context.Context, conf *Conf) (storage.BinaryStore, error) {
 		}
 	}
 
+	if conf.OCI != nil {
+		log.Info("Configuring OCI bundle source")
+		ociSource, err = NewOCISource(conf)
+		if err != nil {
+			log.Error("Failed to configure OCI bundle source", zap.Error(err))
+			return nil, err
+		}
+
+		if err := ociSource.Init(ctx); err != nil {
+			log.Error("Failed to initialize OCI bundle source", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	if conf.HTTP != nil {
+		log.Info("Configuring HTTP bundle source")
+		httpSource, err = NewHTTPSource(conf)
+		if err != nil {
+			log.Error("Failed to configure HTTP bundle source", zap.Error(err))
+			return nil, err
+		}
+
+		if err := httpSource.Init(ctx); err != nil {
+			log.Error("Failed to initialize HTTP bundle source", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	// remote, OCI and HTTP sources are mutually exclusive and all fall back to the local source when they are unhealthy.
+	var primary storage.BinaryStore
+	var primaryIsHealthy func() bool
 	switch {
-	case local != nil && remote != nil:
+	case remote != nil:
+		primary = instrument("remote", remote)
+		primaryIsHealthy = remote.IsHealthy
+	case ociSource != nil:
+		primary = instrument("oci", ociSource)
+		primaryIsHealthy = ociSource.IsHealthy
+	case httpSource != nil:
+		primary = instrument("http", httpSource)
+		primaryIsHealthy = httpSource.IsHealthy
+	}
+
+	switch {
+	case local != nil && primary != nil:
 		return &HybridStore{
 			log:             log,
 			local:           instrument("local", local),
-			remote:          instrument("remote", remote),
-			remoteIsHealthy: remote.IsHealthy,
+			remote:          primary,
+			remoteIsHealthy: primaryIsHealthy,
 		}, nil
-	case local == nil && remote != nil:
-		return instrument("remote", remote), nil
-	case local != nil && remote == nil:
+	case local == nil && primary != nil:
+		return primary, nil
+	case local != nil && primary == nil:
 		return instrument("local", local), nil
 	default:
 		return nil, ErrNoSource
@@ -120,6 +165,20 @@ func (hs *HybridStore) GetFirstMatch(ctx context.Context, candidates []namer.Mod
 	return hs.withActiveSource().GetFirstMatch(ctx, candidates)
 }
 
+func (hs *HybridStore) Revision() string {
+	// avoid withActiveSource because this is called frequently and shouldn't produce warnings
+	active := hs.local
+	if hs.remoteIsHealthy() {
+		active = hs.remote
+	}
+
+	if r, ok := active.(storage.Revisioned); ok {
+		return r.Revision()
+	}
+
+	return ""
+}
+
 func (hs *HybridStore) SourceKind() string {
 	return "hybrid"
 }
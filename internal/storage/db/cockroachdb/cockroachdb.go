@@ -0,0 +1,106 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package cockroachdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+
+	// Import the postgres dialect.
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	pgxzap "github.com/jackc/pgx-zap"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/jackc/pgx/v5/tracelog"
+	"go.uber.org/zap"
+
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/observability/logging"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/db/internal"
+)
+
+const (
+	DriverName      = "cockroachdb"
+	urlToSchemaDocs = "https://docs.cerbos.dev/cerbos/latest/configuration/storage.html#cockroachdb-schema"
+
+	// serializationFailureCode is the SQLSTATE of the errors returned when CockroachDB aborts a transaction due to contention.
+	// See https://www.cockroachlabs.com/docs/stable/transaction-retry-error-reference
+	serializationFailureCode = "40001"
+)
+
+var (
+	_ storage.SourceStore  = (*Store)(nil)
+	_ storage.MutableStore = (*Store)(nil)
+	_ storage.Historical   = (*Store)(nil)
+	_ storage.Versioned    = (*Store)(nil)
+	_ storage.Restorable   = (*Store)(nil)
+	_ storage.Revisioned   = (*Store)(nil)
+	_ storage.Audited      = (*Store)(nil)
+)
+
+func init() {
+	storage.RegisterDriver(DriverName, func(ctx context.Context, confW *config.Wrapper) (storage.Store, error) {
+		conf := new(Conf)
+		if err := confW.GetSection(conf); err != nil {
+			return nil, err
+		}
+
+		return NewStore(ctx, conf)
+	})
+}
+
+func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
+	log := logging.FromContext(ctx).Named("cockroachdb")
+
+	pgConf, err := pgx.ParseConfig(conf.URL)
+	if err != nil {
+		log.Error("Failed to parse CockroachDB connection URL", zap.Error(err))
+		return nil, err
+	}
+	pgConf.Tracer = &tracelog.TraceLog{Logger: pgxzap.NewLogger(log), LogLevel: tracelog.LogLevelWarn}
+
+	log.Info("Initializing CockroachDB storage", zap.String("host", pgConf.Host), zap.String("database", pgConf.Database))
+
+	// CockroachDB speaks the Postgres wire protocol, so the pgx driver and the postgres dialect are used to talk to it.
+	connStr := stdlib.RegisterConnConfig(pgConf)
+	db, err := internal.ConnectWithRetries("pgx", connStr, internal.DBConnectionRetries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	conf.ConnPool.Configure(db)
+
+	// Transactions run with serializable isolation and are aborted when they conflict with each other,
+	// in which case CockroachDB expects the client to retry them.
+	s, err := internal.NewDBStorage(ctx, goqu.New("postgres", db), internal.WithTxRetries(isRetryable), internal.WithDeletedPolicyRetention(conf.DeletedPolicyRetention))
+	if err != nil {
+		return nil, err
+	}
+
+	if !conf.SkipSchemaCheck {
+		if err := s.CheckSchema(ctx); err != nil {
+			return nil, fmt.Errorf("schema check failed. Ensure that the schema is correctly defined as documented at %s: %w", urlToSchemaDocs, err)
+		}
+	}
+
+	return &Store{DBStorage: s}, nil
+}
+
+func isRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == serializationFailureCode
+}
+
+type Store struct {
+	internal.DBStorage
+}
+
+func (s *Store) Driver() string {
+	return DriverName
+}
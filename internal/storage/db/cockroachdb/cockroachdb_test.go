@@ -0,0 +1,112 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package cockroachdb_test
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/storage/db/cockroachdb"
+	"github.com/cerbos/cerbos/internal/storage/db/internal"
+)
+
+//go:embed schema.sql
+var schemaSQL string
+
+func TestCockroachDB(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	pool, err := dockertest.NewPool("")
+	require.NoError(t, err, "Failed to connect to Docker")
+
+	resource, err := pool.RunWithOptions(&dockertest.RunOptions{
+		Repository: "cockroachdb/cockroach",
+		Tag:        "v24.3.0",
+		Cmd:        []string{"start-single-node", "--insecure"},
+	}, func(config *docker.HostConfig) {
+		config.AutoRemove = true
+	})
+	require.NoError(t, err, "Failed to start container")
+
+	t.Cleanup(func() {
+		if err := pool.Purge(resource); err != nil {
+			t.Errorf("Failed to cleanup resources: %v", err)
+		}
+	})
+
+	deadline, ok := t.Deadline()
+	if !ok {
+		deadline = time.Now().Add(5 * time.Minute)
+	}
+
+	ctx, cancelFunc := context.WithDeadline(context.Background(), deadline)
+	defer cancelFunc()
+
+	rootURL := fmt.Sprintf("postgresql://root@localhost:%s/defaultdb?sslmode=disable", resource.GetPort("26257/tcp"))
+	require.NoError(t, pool.Retry(func() error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		conn, err := pgx.Connect(ctx, rootURL)
+		if err != nil {
+			return err
+		}
+
+		return conn.Close(ctx)
+	}), "Failed to connect to CockroachDB")
+
+	createSchema(ctx, t, rootURL)
+
+	store, err := cockroachdb.NewStore(ctx, &cockroachdb.Conf{
+		URL: fmt.Sprintf("postgresql://root@localhost:%s/cerbos?sslmode=disable", resource.GetPort("26257/tcp")),
+		ConnPool: &internal.ConnPoolConf{
+			MaxLifetime: 1 * time.Minute,
+			MaxIdleTime: 45 * time.Second,
+			MaxOpen:     4,
+			MaxIdle:     1,
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("check schema", func(t *testing.T) {
+		internal.TestCheckSchema(ctx, t, store)
+	})
+
+	t.Run("suite", internal.TestSuite(store))
+}
+
+func createSchema(ctx context.Context, t *testing.T, url string) {
+	t.Helper()
+
+	conn, err := pgx.Connect(ctx, url)
+	require.NoError(t, err)
+
+	defer conn.Close(ctx)
+
+	// Users can't have passwords in insecure mode, so the statements that create the user and grant privileges are skipped.
+	tables, _, _ := strings.Cut(schemaSQL, "CREATE USER")
+	for _, stmt := range strings.Split(tables, ";\n\n") {
+		if strings.TrimSpace(stmt) == "" {
+			continue
+		}
+
+		_, err = conn.Exec(ctx, stmt)
+		require.NoError(t, err, "Failed to execute %q", stmt)
+	}
+}
@@ -0,0 +1,87 @@
+CREATE DATABASE IF NOT EXISTS cerbos;
+
+SET DATABASE = cerbos;
+
+CREATE TABLE IF NOT EXISTS policy (
+    id BIGINT NOT NULL PRIMARY KEY,
+    kind VARCHAR(128) NOT NULL,
+    name VARCHAR(1024) NOT NULL,
+    version VARCHAR(128) NOT NULL,
+    scope VARCHAR(512),
+    description TEXT,
+    disabled BOOLEAN DEFAULT false,
+    definition BYTES
+);
+
+CREATE TABLE IF NOT EXISTS policy_dependency (
+    policy_id BIGINT,
+    dependency_id BIGINT,
+    PRIMARY KEY (policy_id, dependency_id),
+    FOREIGN KEY (policy_id) REFERENCES policy(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS policy_ancestor (
+    policy_id BIGINT,
+    ancestor_id BIGINT,
+    PRIMARY KEY (policy_id, ancestor_id),
+    FOREIGN KEY (policy_id) REFERENCES policy(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS policy_revision (
+    revision_id BIGINT PRIMARY KEY DEFAULT unique_rowid(),
+    action VARCHAR(64),
+    id BIGINT,
+    kind VARCHAR(128),
+    name VARCHAR(1024),
+    version VARCHAR(128),
+    scope VARCHAR(512),
+    description TEXT,
+    disabled BOOLEAN,
+    definition BYTES,
+    update_timestamp TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS attr_schema_defs (
+    id VARCHAR(255) PRIMARY KEY,
+    definition JSONB
+);
+
+CREATE TABLE IF NOT EXISTS store_event (
+    id BIGINT PRIMARY KEY DEFAULT unique_rowid(),
+    action VARCHAR(64),
+    target VARCHAR(1024),
+    revision_id BIGINT,
+    actor VARCHAR(255),
+    client_cert VARCHAR(1024),
+    event_timestamp TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE OR REPLACE FUNCTION process_policy_audit() RETURNS TRIGGER AS $policy_audit$
+    BEGIN
+        IF (TG_OP = 'DELETE') THEN
+            INSERT INTO policy_revision(action, id, kind, name, version, scope, description, disabled, definition)
+            VALUES('DELETE', OLD.id, OLD.kind, OLD.name, OLD.version, OLD.scope, OLD.description, OLD.disabled, OLD.definition);
+        ELSIF (TG_OP = 'UPDATE') THEN
+            INSERT INTO policy_revision(action, id, kind, name, version, scope, description, disabled, definition)
+            VALUES('UPDATE', NEW.id, NEW.kind, NEW.name, NEW.version, NEW.scope, NEW.description, NEW.disabled, NEW.definition);
+        ELSIF (TG_OP = 'INSERT') THEN
+            INSERT INTO policy_revision(action, id, kind, name, version, scope, description, disabled, definition)
+            VALUES('INSERT', NEW.id, NEW.kind, NEW.name, NEW.version, NEW.scope, NEW.description, NEW.disabled, NEW.definition);
+        END IF;
+        RETURN NULL;
+    END;
+$policy_audit$ LANGUAGE plpgsql;
+
+CREATE TRIGGER policy_audit
+AFTER INSERT OR UPDATE OR DELETE ON policy
+FOR EACH ROW EXECUTE FUNCTION process_policy_audit();
+
+CREATE USER IF NOT EXISTS cerbos_user WITH PASSWORD 'changeme';
+
+GRANT CONNECT ON DATABASE cerbos TO cerbos_user;
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE policy, policy_dependency, policy_ancestor, attr_schema_defs TO cerbos_user;
+
+GRANT SELECT,INSERT,DELETE ON TABLE policy_revision TO cerbos_user;
+
+GRANT SELECT,INSERT ON TABLE store_event TO cerbos_user;
@@ -0,0 +1,84 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package consul
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+const (
+	confKey         = storage.ConfKey + ".consul"
+	defaultPrefix   = "cerbos"
+	defaultWaitTime = 5 * time.Minute
+	// maxWaitTime is the longest a blocking query is allowed to wait by Consul.
+	maxWaitTime = 10 * time.Minute
+)
+
+// Conf is required (if driver is set to 'consul') configuration for Consul driver.
+// +desc=This section is required only if storage.driver is consul.
+type Conf struct {
+	// TLS holds the TLS configuration to use when connecting to Consul.
+	TLS *TLSConf `yaml:"tls" conf:",example=\n  caCert: /path/to/CA_certificate\n  cert: /path/to/certificate\n  key: /path/to/private_key"`
+	// Address is the address of the Consul agent to connect to. Defaults to the value of the CONSUL_HTTP_ADDR environment variable or 127.0.0.1:8500.
+	Address string `yaml:"address" conf:",example=https://127.0.0.1:8501"`
+	// Token is the ACL token to authenticate with. Defaults to the value of the CONSUL_HTTP_TOKEN environment variable.
+	Token string `yaml:"token" conf:",example=${CONSUL_HTTP_TOKEN}"`
+	// Datacenter is the datacenter to store the policies in. Defaults to the datacenter of the agent.
+	Datacenter string `yaml:"datacenter" conf:",example=dc1"`
+	// Namespace is the namespace to store the policies in (Consul Enterprise only). Defaults to the value of the CONSUL_NAMESPACE environment variable.
+	Namespace string `yaml:"namespace" conf:",example=cerbos"`
+	// Prefix is the key prefix under which the policies and schemas are stored. Defaults to cerbos.
+	Prefix string `yaml:"prefix" conf:",example=cerbos"`
+	// WaitTime is the maximum time a blocking query waits for changes before it's reissued. Must not be longer than 10m.
+	WaitTime time.Duration `yaml:"waitTime" conf:",example=5m"`
+	// DisableWatch disables watching the prefix for changes made by other Cerbos instances.
+	DisableWatch bool `yaml:"disableWatch" conf:",example=false"`
+	// Sync configures the backoff for retrying after failures to watch the prefix and when the store is reported as degraded.
+	Sync *storage.SyncConf `yaml:"sync,omitempty" conf:",example=\n  initialInterval: 1s\n  maxInterval: 30s\n  degradedAfter: 3"`
+}
+
+type TLSConf struct {
+	// CACert is the path to the CA certificate used to verify the Consul server certificates.
+	CACert string `yaml:"caCert"`
+	// Cert is the path to the client certificate.
+	Cert string `yaml:"cert"`
+	// Key is the path to the client certificate key.
+	Key string `yaml:"key"`
+}
+
+func (c *Conf) Key() string {
+	return confKey
+}
+
+func (c *Conf) SetDefaults() {
+	c.Prefix = defaultPrefix
+	c.WaitTime = defaultWaitTime
+}
+
+func (c *Conf) Validate() (errs error) {
+	if c.keyPrefix() == "" {
+		errs = multierr.Append(errs, errors.New("prefix must not be empty"))
+	}
+
+	if c.WaitTime <= 0 || c.WaitTime > maxWaitTime {
+		errs = multierr.Append(errs, errors.New("waitTime must be positive and not longer than 10m"))
+	}
+
+	if c.TLS != nil && (c.TLS.Cert == "") != (c.TLS.Key == "") {
+		errs = multierr.Append(errs, errors.New("both cert and key must be provided for TLS client authentication"))
+	}
+
+	return multierr.Append(errs, c.Sync.Validate())
+}
+
+// keyPrefix returns the prefix without leading and trailing slashes, because Consul keys don't start with a slash.
+func (c *Conf) keyPrefix() string {
+	return strings.Trim(c.Prefix, "/ ")
+}
@@ -0,0 +1,909 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package consul
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/consul/api"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+
+	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/observability/logging"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/db"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const (
+	DriverName = "consul"
+
+	connectRetries = 3
+	requestTimeout = 10 * time.Second
+	// maxTxnOps is the limit on the number of operations in a Consul transaction in older Consul versions.
+	maxTxnOps = 64
+	// maxTxnBytes keeps the size of a transaction request below the default limit of 512KiB.
+	maxTxnBytes = 384 * 1024
+	// maxWatchRetryInterval is the default maximum time to wait before re-establishing a watch that failed.
+	maxWatchRetryInterval = 30 * time.Second
+)
+
+var errTxnRolledBack = errors.New("transaction was rolled back")
+
+var (
+	_ storage.SourceStore  = (*Store)(nil)
+	_ storage.MutableStore = (*Store)(nil)
+	_ storage.Instrumented = (*Store)(nil)
+	_ storage.Reloadable   = (*Store)(nil)
+	_ storage.Syncable     = (*Store)(nil)
+	_ io.Closer            = (*Store)(nil)
+)
+
+func init() {
+	storage.RegisterDriver(DriverName, func(ctx context.Context, confW *config.Wrapper) (storage.Store, error) {
+		conf := new(Conf)
+		if err := confW.GetSection(conf); err != nil {
+			return nil, err
+		}
+
+		return NewStore(ctx, conf)
+	})
+}
+
+// Store keeps the policies and schemas in the Consul KV store.
+type Store struct {
+	client      *api.Client
+	keys        keys
+	regexpCache *util.RegexpCache
+	log         *zap.Logger
+	cancel      context.CancelFunc
+	done        chan struct{}
+	syncStatus  *storage.SyncStatus
+	*storage.SubscriptionManager
+}
+
+func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
+	log := logging.FromContext(ctx).Named("consul")
+
+	// The defaults are read from the standard Consul environment variables such as CONSUL_HTTP_ADDR.
+	clientConf := api.DefaultConfig()
+	if conf.Address != "" {
+		clientConf.Address = conf.Address
+	}
+
+	if conf.Token != "" {
+		clientConf.Token = conf.Token
+	}
+
+	if conf.Datacenter != "" {
+		clientConf.Datacenter = conf.Datacenter
+	}
+
+	if conf.Namespace != "" {
+		clientConf.Namespace = conf.Namespace
+	}
+
+	if conf.TLS != nil {
+		// A scheme included in the address takes precedence.
+		clientConf.Scheme = "https"
+		clientConf.TLSConfig.CAFile = conf.TLS.CACert
+		clientConf.TLSConfig.CertFile = conf.TLS.Cert
+		clientConf.TLSConfig.KeyFile = conf.TLS.Key
+	}
+
+	log.Info("Initializing Consul storage", zap.String("address", clientConf.Address), zap.String("prefix", conf.keyPrefix()))
+
+	client, err := api.NewClient(clientConf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Consul client: %w", err)
+	}
+
+	s := &Store{
+		client:              client,
+		keys:                keys{prefix: conf.keyPrefix()},
+		regexpCache:         util.NewRegexpCache(),
+		log:                 log,
+		done:                make(chan struct{}),
+		SubscriptionManager: storage.NewSubscriptionManager(ctx),
+	}
+
+	// The initial listing is what the watch compares the changes against, so that no changes are missed in between.
+	var (
+		pairs api.KVPairs
+		index uint64
+	)
+	pingFn := func() error {
+		pingCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+
+		kvs, meta, err := client.KV().List(s.keys.prefix+"/", queryOptions(pingCtx))
+		if err != nil {
+			return err
+		}
+
+		pairs, index = kvs, meta.LastIndex
+		return nil
+	}
+
+	if err := backoff.Retry(pingFn, backoff.WithContext(backoff.WithMaxRetries(backoff.NewExponentialBackOff(), connectRetries), ctx)); err != nil {
+		return nil, fmt.Errorf("failed to connect to Consul: %w", err)
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	if conf.DisableWatch {
+		close(s.done)
+	} else {
+		s.syncStatus = storage.NewSyncStatus(DriverName, conf.Sync)
+		cw := newChangeWatcher(s, conf.WaitTime, pairs, index)
+		go s.watch(watchCtx, cw, conf.Sync.NewBackOff(backoff.DefaultInitialInterval, maxWatchRetryInterval))
+	}
+
+	return s, nil
+}
+
+// SyncStatus returns the status of the watch, or nil if watching for changes is disabled.
+func (s *Store) SyncStatus() *storage.SyncStatus {
+	return s.syncStatus
+}
+
+func (s *Store) Driver() string {
+	return DriverName
+}
+
+// Close stops watching for changes.
+func (s *Store) Close() error {
+	s.cancel()
+	<-s.done
+
+	return nil
+}
+
+func (s *Store) AddOrUpdate(ctx context.Context, policies ...policy.Wrapper) error {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	ops := make(api.TxnOps, len(policies))
+	events := make([]storage.Event, len(policies))
+	for i, p := range policies {
+		def, err := p.Policy.MarshalVT()
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s: %w", p.FQN, err)
+		}
+
+		value, err := json.Marshal(policyRecord{
+			Kind:         p.Kind.String(),
+			Name:         p.Name,
+			Version:      p.Version,
+			Scope:        p.Scope,
+			Description:  p.Description,
+			Disabled:     p.Disabled,
+			Definition:   def,
+			Dependencies: rawIDs(p.Dependencies()),
+			Ancestors:    rawIDs(policy.Ancestors(p.Policy)),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", p.FQN, err)
+		}
+
+		ops[i] = kvOp(api.KVSet, s.keys.policy(rawID(p.ID)), value)
+		events[i] = storage.Event{Kind: storage.EventAddOrUpdatePolicy, PolicyID: p.ID}
+	}
+
+	if _, err := s.txn(ctx, ops); err != nil {
+		return fmt.Errorf("failed to upsert policies: %w", err)
+	}
+
+	_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
+		tag.Upsert(metrics.KeyIndexCRUDKind, "upsert"),
+	}, metrics.IndexCRUDCount.M(int64(len(policies))))
+
+	s.NotifySubscribers(events...)
+	return nil
+}
+
+func (s *Store) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID) (*policy.CompilationUnit, error) {
+	results, err := s.GetCompilationUnits(ctx, candidates...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range candidates {
+		if cu, ok := results[id]; ok {
+			return cu, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Store) GetCompilationUnits(ctx context.Context, ids ...namer.ModuleID) (map[namer.ModuleID]*policy.CompilationUnit, error) {
+	// Policies are loaded level by level: the requested policies, then their ancestors and finally the
+	// dependencies of all of them until there's nothing new to load. Disabled policies are left out.
+	loaded := make(map[uint64]*policyRecord)
+	load := func(toLoad []uint64) error {
+		var missing []uint64
+		for _, id := range toLoad {
+			if _, ok := loaded[id]; !ok {
+				missing = append(missing, id)
+			}
+		}
+
+		if len(missing) == 0 {
+			return nil
+		}
+
+		records, err := s.getPolicies(ctx, missing)
+		if err != nil {
+			return err
+		}
+
+		// Remember the policies that don't exist or are disabled to avoid looking for them again.
+		for _, id := range missing {
+			if r, ok := records[id]; ok && !r.Disabled {
+				loaded[id] = r
+			} else {
+				loaded[id] = nil
+			}
+		}
+
+		return nil
+	}
+
+	rootIDs := rawIDs(ids)
+	if err := load(rootIDs); err != nil {
+		return nil, fmt.Errorf("failed to get policies: %w", err)
+	}
+
+	var ancestorIDs []uint64
+	for _, id := range rootIDs {
+		if r := loaded[id]; r != nil {
+			ancestorIDs = append(ancestorIDs, r.Ancestors...)
+		}
+	}
+
+	if err := load(ancestorIDs); err != nil {
+		return nil, fmt.Errorf("failed to get ancestors: %w", err)
+	}
+
+	for {
+		var depIDs []uint64
+		for _, r := range loaded {
+			if r == nil {
+				continue
+			}
+
+			for _, dep := range r.Dependencies {
+				if _, ok := loaded[dep]; !ok {
+					depIDs = append(depIDs, dep)
+				}
+			}
+		}
+
+		if len(depIDs) == 0 {
+			break
+		}
+
+		if err := load(depIDs); err != nil {
+			return nil, fmt.Errorf("failed to get dependencies: %w", err)
+		}
+	}
+
+	units := make(map[namer.ModuleID]*policy.CompilationUnit)
+	for _, id := range rootIDs {
+		root := loaded[id]
+		if root == nil {
+			continue
+		}
+
+		unit := &policy.CompilationUnit{ModID: moduleID(id)}
+		var add func(uint64, *policyRecord) error
+		add = func(id uint64, r *policyRecord) error {
+			modID := moduleID(id)
+			if _, ok := unit.Definitions[modID]; ok {
+				return nil
+			}
+
+			p, err := r.policy()
+			if err != nil {
+				return err
+			}
+
+			unit.AddDefinition(modID, p)
+			for _, dep := range r.Dependencies {
+				if depRecord := loaded[dep]; depRecord != nil {
+					if err := add(dep, depRecord); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		}
+
+		if err := add(id, root); err != nil {
+			return nil, err
+		}
+
+		for _, ancestor := range root.Ancestors {
+			if ancestorRecord := loaded[ancestor]; ancestorRecord != nil {
+				if err := add(ancestor, ancestorRecord); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		units[unit.ModID] = unit
+	}
+
+	return units, nil
+}
+
+func (s *Store) GetDependents(ctx context.Context, ids ...namer.ModuleID) (map[namer.ModuleID][]namer.ModuleID, error) {
+	records, err := s.listPolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dependents: %w", err)
+	}
+
+	dependents := make(map[uint64][]uint64)
+	for id, r := range records {
+		for _, dep := range r.Dependencies {
+			dependents[dep] = append(dependents[dep], id)
+		}
+	}
+
+	// There's a maximum of two levels of dependency (resourcePolicy -> derivedRoles -> exportVariables),
+	// so the dependents are the direct dependents and the dependents of the direct dependents.
+	out := make(map[namer.ModuleID][]namer.ModuleID, len(ids))
+	for _, id := range ids {
+		seen := make(map[uint64]struct{})
+		for _, direct := range dependents[rawID(id)] {
+			seen[direct] = struct{}{}
+			for _, indirect := range dependents[direct] {
+				seen[indirect] = struct{}{}
+			}
+		}
+
+		if len(seen) == 0 {
+			continue
+		}
+
+		deps := make([]namer.ModuleID, 0, len(seen))
+		for dep := range seen {
+			deps = append(deps, moduleID(dep))
+		}
+
+		out[id] = deps
+	}
+
+	return out, nil
+}
+
+func (s *Store) LoadPolicy(ctx context.Context, policyKey ...string) ([]*policy.Wrapper, error) {
+	ids := make([]uint64, len(policyKey))
+	for i, pk := range policyKey {
+		ids[i] = rawID(namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk)))
+	}
+
+	records, err := s.getPolicies(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get policies: %w", err)
+	}
+
+	policies := make([]*policy.Wrapper, 0, len(records))
+	for _, id := range ids {
+		r, ok := records[id]
+		if !ok {
+			continue
+		}
+
+		p, err := r.policy()
+		if err != nil {
+			return nil, err
+		}
+
+		pk := namer.PolicyKey(p)
+		wp := policy.Wrap(policy.WithMetadata(p, "", nil, pk))
+		wp.Disabled = r.Disabled
+		policies = append(policies, &wp)
+	}
+
+	return policies, nil
+}
+
+func (s *Store) ListPolicyIDs(ctx context.Context, params storage.ListPolicyIDsParams) ([]string, error) {
+	var filters []regexpFilter
+	for _, f := range []struct {
+		pattern string
+		get     func(namer.PolicyCoords) string
+	}{
+		{pattern: params.NameRegexp, get: func(pc namer.PolicyCoords) string { return pc.Name }},
+		{pattern: params.ScopeRegexp, get: func(pc namer.PolicyCoords) string { return pc.Scope }},
+		{pattern: params.VersionRegexp, get: func(pc namer.PolicyCoords) string { return pc.Version }},
+	} {
+		if f.pattern == "" {
+			continue
+		}
+
+		re, err := s.regexpCache.GetCompiledExpr(f.pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, regexpFilter{match: re.MatchString, get: f.get})
+	}
+
+	records, err := s.listPolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not execute %q query: %w", "ListPolicyIDs", err)
+	}
+
+	coords := make([]namer.PolicyCoords, 0, len(records))
+outer:
+	for _, r := range records {
+		if r.Disabled && !params.IncludeDisabled {
+			continue
+		}
+
+		pc := r.coords()
+		for _, f := range filters {
+			if !f.match(f.get(pc)) {
+				continue outer
+			}
+		}
+
+		coords = append(coords, pc)
+	}
+
+	sort.Slice(coords, func(i, j int) bool {
+		a, b := coords[i], coords[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+
+		if a.Version != b.Version {
+			return a.Version < b.Version
+		}
+
+		return a.Scope < b.Scope
+	})
+
+	policyIDs := make([]string, len(coords))
+	for i, pc := range coords {
+		policyIDs[i] = pc.PolicyKey()
+	}
+
+	return policyIDs, nil
+}
+
+func (s *Store) Disable(ctx context.Context, policyKey ...string) (uint32, error) {
+	ids := make([]uint64, len(policyKey))
+	events := make([]storage.Event, len(policyKey))
+	for i, pk := range policyKey {
+		modID := namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk))
+		ids[i] = rawID(modID)
+		events[i] = storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, modID)
+	}
+
+	// Disabling a scoped policy that has enabled descendants would break the scope chain.
+	records, err := s.listPolicies(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get descendants for policies: %w", err)
+	}
+
+	hasDescendants := make(map[uint64]struct{})
+	for _, r := range records {
+		if r.Disabled {
+			continue
+		}
+
+		for _, a := range r.Ancestors {
+			hasDescendants[a] = struct{}{}
+		}
+	}
+
+	var brokenChainPolicies []string
+	for i, pk := range policyKey {
+		if _, ok := hasDescendants[ids[i]]; ok {
+			brokenChainPolicies = append(brokenChainPolicies, pk)
+		}
+	}
+
+	if len(brokenChainPolicies) > 0 {
+		return 0, db.ErrBreaksScopeChain{PolicyKeys: brokenChainPolicies}
+	}
+
+	count, err := s.setDisabled(ctx, ids, true)
+	if err != nil {
+		return 0, fmt.Errorf("failed to disable policies: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return count, nil
+}
+
+func (s *Store) Enable(ctx context.Context, policyKey ...string) (uint32, error) {
+	ids := make([]uint64, len(policyKey))
+	events := make([]storage.Event, len(policyKey))
+	for i, pk := range policyKey {
+		modID := namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk))
+		ids[i] = rawID(modID)
+		events[i] = storage.NewPolicyEvent(storage.EventAddOrUpdatePolicy, modID)
+	}
+
+	count, err := s.setDisabled(ctx, ids, false)
+	if err != nil {
+		return 0, fmt.Errorf("failed to enable policies: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return count, nil
+}
+
+// setDisabled updates the disabled flag of the given policies and returns the number of policies that exist.
+func (s *Store) setDisabled(ctx context.Context, ids []uint64, disabled bool) (uint32, error) {
+	var count uint32
+	for start := 0; start < len(ids); start += maxTxnOps {
+		end := start + maxTxnOps
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		n, err := s.updateDisabled(ctx, ids[start:end], disabled)
+		if err != nil {
+			return count, err
+		}
+
+		count += n
+	}
+
+	return count, nil
+}
+
+// updateDisabled updates the disabled flag of the given policies and returns the number of policies that exist.
+// The updates are conditional on the modify indexes of the records that were read so that concurrent changes to the
+// same policies aren't lost.
+func (s *Store) updateDisabled(ctx context.Context, ids []uint64, disabled bool) (uint32, error) {
+	policyKeys := make([]string, len(ids))
+	for i, id := range ids {
+		policyKeys[i] = s.keys.policy(id)
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		pairs, err := s.getPairs(ctx, policyKeys)
+		if err != nil {
+			return 0, err
+		}
+
+		ops := make(api.TxnOps, len(pairs))
+		for i, kv := range pairs {
+			r, err := decodePolicyRecord(kv.Value)
+			if err != nil {
+				return 0, err
+			}
+
+			r.Disabled = disabled
+			updated, err := json.Marshal(r)
+			if err != nil {
+				return 0, err
+			}
+
+			ops[i] = kvOp(api.KVCAS, kv.Key, updated)
+			ops[i].KV.Index = kv.ModifyIndex
+		}
+
+		if _, err := s.txn(ctx, ops); err != nil {
+			if errors.Is(err, errTxnRolledBack) {
+				// A record was modified after it was read.
+				continue
+			}
+
+			return 0, err
+		}
+
+		return uint32(len(pairs)), nil
+	}
+}
+
+func (s *Store) Delete(ctx context.Context, ids ...namer.ModuleID) error {
+	ops := make(api.TxnOps, len(ids))
+	events := make([]storage.Event, len(ids))
+	for i, id := range ids {
+		ops[i] = kvOp(api.KVDelete, s.keys.policy(rawID(id)), nil)
+		events[i] = storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, id)
+	}
+
+	if _, err := s.txn(ctx, ops); err != nil {
+		return fmt.Errorf("failed to delete policies: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return nil
+}
+
+func (s *Store) AddOrUpdateSchema(ctx context.Context, schemas ...*schemav1.Schema) error {
+	if len(schemas) == 0 {
+		return nil
+	}
+
+	ops := make(api.TxnOps, len(schemas))
+	events := make([]storage.Event, len(schemas))
+	for i, sch := range schemas {
+		var def json.RawMessage
+		if err := json.Unmarshal(sch.Definition, &def); err != nil {
+			return storage.NewInvalidSchemaError(err, "schema definition with ID %q is not valid", sch.Id)
+		}
+
+		ops[i] = kvOp(api.KVSet, s.keys.schema(sch.Id), sch.Definition)
+		events[i] = storage.NewSchemaEvent(storage.EventAddOrUpdateSchema, sch.Id)
+	}
+
+	if _, err := s.txn(ctx, ops); err != nil {
+		return fmt.Errorf("failed to upsert schemas: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return nil
+}
+
+func (s *Store) DeleteSchema(ctx context.Context, ids ...string) (uint32, error) {
+	schemaKeys := make([]string, len(ids))
+	ops := make(api.TxnOps, len(ids))
+	events := make([]storage.Event, len(ids))
+	for i, id := range ids {
+		schemaKeys[i] = s.keys.schema(id)
+		ops[i] = kvOp(api.KVDelete, schemaKeys[i], nil)
+		events[i] = storage.NewSchemaEvent(storage.EventDeleteSchema, id)
+	}
+
+	// Consul doesn't report whether a deleted key existed, so the schemas are looked up first to count them.
+	existing, err := s.getPairs(ctx, schemaKeys)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get schema(s): %w", err)
+	}
+
+	if _, err := s.txn(ctx, ops); err != nil {
+		return 0, fmt.Errorf("failed to delete schema(s): %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return uint32(len(existing)), nil
+}
+
+func (s *Store) ListSchemaIDs(ctx context.Context) ([]string, error) {
+	schemaKeys, _, err := s.client.KV().Keys(s.keys.schemas(), "", queryOptions(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("could not execute %q query: %w", "ListSchemaIDs", err)
+	}
+
+	schemaIDs := make([]string, 0, len(schemaKeys))
+	for _, key := range schemaKeys {
+		if id, ok := s.keys.schemaID(key); ok {
+			schemaIDs = append(schemaIDs, id)
+		}
+	}
+
+	sort.Strings(schemaIDs)
+	return schemaIDs, nil
+}
+
+func (s *Store) LoadSchema(ctx context.Context, urlVar string) (io.ReadCloser, error) {
+	u, err := url.Parse(urlVar)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != "" && u.Scheme != schema.URLScheme {
+		return nil, fmt.Errorf("invalid url scheme %q", u.Scheme)
+	}
+
+	kv, _, err := s.client.KV().Get(s.keys.schema(strings.TrimPrefix(u.Path, "/")), queryOptions(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+
+	if kv == nil {
+		return nil, fmt.Errorf("failed to find schema")
+	}
+
+	return io.NopCloser(strings.NewReader(string(kv.Value))), nil
+}
+
+func (s *Store) RepoStats(ctx context.Context) storage.RepoStats {
+	stats := storage.RepoStats{}
+
+	records, err := s.listPolicies(ctx)
+	if err != nil {
+		return stats
+	}
+
+	stats.PolicyCount = make(map[policy.Kind]int)
+	for _, r := range records {
+		switch r.Kind {
+		case policy.DerivedRolesKindStr:
+			stats.PolicyCount[policy.DerivedRolesKind]++
+		case policy.ExportVariablesKindStr:
+			stats.PolicyCount[policy.ExportVariablesKind]++
+		case policy.PrincipalKindStr:
+			stats.PolicyCount[policy.PrincipalKind]++
+		case policy.ResourceKindStr:
+			stats.PolicyCount[policy.ResourceKind]++
+		}
+	}
+
+	if schemaKeys, _, err := s.client.KV().Keys(s.keys.schemas(), "", queryOptions(ctx)); err == nil {
+		stats.SchemaCount = len(schemaKeys)
+	}
+
+	return stats
+}
+
+func (s *Store) Reload(context.Context) error {
+	s.NotifySubscribers(storage.NewReloadEvent())
+	return nil
+}
+
+// txn executes the operations in transactions that are within the limits on the number of operations and the size of a
+// transaction. If an operation fails, the transaction it's part of is rolled back and errTxnRolledBack is returned.
+func (s *Store) txn(ctx context.Context, ops api.TxnOps) (api.TxnResults, error) {
+	results := make(api.TxnResults, 0, len(ops))
+	for _, chunk := range txnChunks(ops) {
+		ok, resp, _, err := s.client.Txn().Txn(chunk, queryOptions(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			reasons := make([]string, len(resp.Errors))
+			for i, txnErr := range resp.Errors {
+				reasons[i] = txnErr.What
+			}
+
+			return nil, fmt.Errorf("%w: %s", errTxnRolledBack, strings.Join(reasons, "; "))
+		}
+
+		results = append(results, resp.Results...)
+	}
+
+	return results, nil
+}
+
+// txnChunks splits the operations into chunks of at most maxTxnOps operations and approximately maxTxnBytes bytes.
+func txnChunks(ops api.TxnOps) []api.TxnOps {
+	var chunks []api.TxnOps
+	start, size := 0, 0
+	for i, op := range ops {
+		// values are base64-encoded in the request
+		opSize := len(op.KV.Key) + base64.StdEncoding.EncodedLen(len(op.KV.Value))
+		if i > start && (i-start == maxTxnOps || size+opSize > maxTxnBytes) {
+			chunks = append(chunks, ops[start:i])
+			start, size = i, 0
+		}
+
+		size += opSize
+	}
+
+	if start < len(ops) {
+		chunks = append(chunks, ops[start:])
+	}
+
+	return chunks
+}
+
+// getPairs returns the key-value pairs that exist among the given keys.
+func (s *Store) getPairs(ctx context.Context, keys []string) ([]*api.KVPair, error) {
+	ops := make(api.TxnOps, len(keys))
+	for i, key := range keys {
+		ops[i] = kvOp(api.KVGetOrEmpty, key, nil)
+	}
+
+	results, err := s.txn(ctx, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make([]*api.KVPair, 0, len(results))
+	for _, res := range results {
+		// A key that doesn't exist is returned without a modify index.
+		if res.KV != nil && res.KV.ModifyIndex != 0 {
+			pairs = append(pairs, res.KV)
+		}
+	}
+
+	return pairs, nil
+}
+
+// getPolicies returns the records of the policies that exist among the given IDs.
+func (s *Store) getPolicies(ctx context.Context, ids []uint64) (map[uint64]*policyRecord, error) {
+	policyKeys := make([]string, len(ids))
+	for i, id := range ids {
+		policyKeys[i] = s.keys.policy(id)
+	}
+
+	pairs, err := s.getPairs(ctx, policyKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(map[uint64]*policyRecord, len(pairs))
+	for _, kv := range pairs {
+		if err := s.addRecord(records, kv.Key, kv.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+// listPolicies returns the records of all the policies in the store.
+func (s *Store) listPolicies(ctx context.Context) (map[uint64]*policyRecord, error) {
+	pairs, _, err := s.client.KV().List(s.keys.policies(), queryOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(map[uint64]*policyRecord, len(pairs))
+	for _, kv := range pairs {
+		if err := s.addRecord(records, kv.Key, kv.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+func (s *Store) addRecord(records map[uint64]*policyRecord, key string, value []byte) error {
+	id, ok := s.keys.policyID(key)
+	if !ok {
+		s.log.Warn("Ignoring unexpected key under the policies prefix", zap.String("key", key))
+		return nil
+	}
+
+	r, err := decodePolicyRecord(value)
+	if err != nil {
+		return fmt.Errorf("invalid value for key %q: %w", key, err)
+	}
+
+	records[id] = r
+	return nil
+}
+
+func kvOp(verb api.KVOp, key string, value []byte) *api.TxnOp {
+	return &api.TxnOp{KV: &api.KVTxnOp{Verb: verb, Key: key, Value: value}}
+}
+
+func queryOptions(ctx context.Context) *api.QueryOptions {
+	return (&api.QueryOptions{}).WithContext(ctx)
+}
+
+type regexpFilter struct {
+	match func(string) bool
+	get   func(namer.PolicyCoords) string
+}
@@ -0,0 +1,166 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package consul_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+	"github.com/stretchr/testify/require"
+
+	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/db/consul"
+	"github.com/cerbos/cerbos/internal/storage/db/internal"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+const watchTimeout = 10 * time.Second
+
+func TestConsul(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	pool, err := dockertest.NewPool("")
+	require.NoError(t, err, "Failed to connect to Docker")
+
+	resource, err := pool.RunWithOptions(&dockertest.RunOptions{
+		Repository: "hashicorp/consul",
+		Tag:        "1.16",
+		Cmd:        []string{"agent", "-dev", "-client", "0.0.0.0"},
+	}, func(config *docker.HostConfig) {
+		config.AutoRemove = true
+	})
+	require.NoError(t, err, "Failed to start container")
+
+	t.Cleanup(func() {
+		if err := pool.Purge(resource); err != nil {
+			t.Errorf("Failed to cleanup resources: %v", err)
+		}
+	})
+
+	deadline, ok := t.Deadline()
+	if !ok {
+		deadline = time.Now().Add(5 * time.Minute)
+	}
+
+	ctx, cancelFunc := context.WithDeadline(context.Background(), deadline)
+	defer cancelFunc()
+
+	address := "localhost:" + resource.GetPort("8500/tcp")
+	require.NoError(t, pool.Retry(func() error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		client, err := api.NewClient(&api.Config{Address: address})
+		if err != nil {
+			return err
+		}
+
+		_, err = client.Status().Leader()
+		return err
+	}), "Failed to connect to Consul")
+
+	mkConf := func(disableWatch bool) *consul.Conf {
+		// A short wait time exercises reissuing the blocking queries that time out.
+		return &consul.Conf{Address: address, Prefix: "cerbos", WaitTime: 2 * time.Second, DisableWatch: disableWatch}
+	}
+
+	store, err := consul.NewStore(ctx, mkConf(true))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	t.Run("suite", internal.TestSuite(store))
+
+	t.Run("watch", func(t *testing.T) {
+		watcher, err := consul.NewStore(ctx, mkConf(false))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = watcher.Close() })
+
+		sub := &eventCollector{events: make(chan storage.Event, 32)}
+		watcher.Subscribe(sub)
+		t.Cleanup(func() { watcher.Unsubscribe(sub) })
+
+		p := policy.Wrap(test.GenResourcePolicy(test.PrefixAndSuffix("w", "w")))
+		sch := &schemav1.Schema{Id: "watched", Definition: []byte(`{"type": "object"}`)}
+
+		t.Run("add", func(t *testing.T) {
+			require.NoError(t, store.AddOrUpdate(ctx, p))
+			require.True(t, sub.waitFor(storage.NewPolicyEvent(storage.EventAddOrUpdatePolicy, p.ID), watchTimeout))
+		})
+
+		t.Run("disable", func(t *testing.T) {
+			_, err := store.Disable(ctx, namer.PolicyKeyFromFQN(p.FQN))
+			require.NoError(t, err)
+			require.True(t, sub.waitFor(storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, p.ID), watchTimeout))
+		})
+
+		t.Run("enable", func(t *testing.T) {
+			_, err := store.Enable(ctx, namer.PolicyKeyFromFQN(p.FQN))
+			require.NoError(t, err)
+			require.True(t, sub.waitFor(storage.NewPolicyEvent(storage.EventAddOrUpdatePolicy, p.ID), watchTimeout))
+		})
+
+		t.Run("delete", func(t *testing.T) {
+			require.NoError(t, store.Delete(ctx, p.ID))
+			require.True(t, sub.waitFor(storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, p.ID), watchTimeout))
+		})
+
+		t.Run("add_schema", func(t *testing.T) {
+			require.NoError(t, store.AddOrUpdateSchema(ctx, sch))
+			require.True(t, sub.waitFor(storage.NewSchemaEvent(storage.EventAddOrUpdateSchema, sch.Id), watchTimeout))
+		})
+
+		t.Run("delete_schema", func(t *testing.T) {
+			_, err := store.DeleteSchema(ctx, sch.Id)
+			require.NoError(t, err)
+			require.True(t, sub.waitFor(storage.NewSchemaEvent(storage.EventDeleteSchema, sch.Id), watchTimeout))
+		})
+	})
+}
+
+type eventCollector struct {
+	events chan storage.Event
+}
+
+func (ec *eventCollector) SubscriberID() string {
+	return "consul_test"
+}
+
+func (ec *eventCollector) OnStorageEvent(events ...storage.Event) {
+	for _, evt := range events {
+		select {
+		case ec.events <- evt:
+		default:
+		}
+	}
+}
+
+// waitFor returns true if the event is received before the timeout. Other events received in the meantime are discarded.
+func (ec *eventCollector) waitFor(want storage.Event, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case evt := <-ec.events:
+			if evt.Kind == want.Kind && evt.PolicyID == want.PolicyID && evt.SchemaFile == want.SchemaFile {
+				return true
+			}
+		case <-timer.C:
+			return false
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package consul
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/consul/api"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+// changeWatcher notifies the subscribers of the store about the changes made by other Cerbos instances.
+// Consul has no change feed, so the prefix is listed with blocking queries that return when anything under it changes,
+// and the modify index of each key is compared with the previous listing to work out what changed.
+// Changes made through this instance are notified twice: once when they are made and once when they're detected by the
+// watch. The second notification is harmless because it only causes the affected policies to be recompiled.
+type changeWatcher struct {
+	store    *Store
+	waitTime time.Duration
+	// index is the index of the previous listing, which the next blocking query waits to be exceeded.
+	index uint64
+	// modifyIndexes holds the modify index of each key in the previous listing.
+	modifyIndexes map[string]uint64
+}
+
+func newChangeWatcher(s *Store, waitTime time.Duration, pairs api.KVPairs, index uint64) *changeWatcher {
+	cw := &changeWatcher{
+		store:         s,
+		waitTime:      waitTime,
+		index:         nextIndex(index),
+		modifyIndexes: make(map[string]uint64, len(pairs)),
+	}
+
+	for _, kv := range pairs {
+		cw.modifyIndexes[kv.Key] = kv.ModifyIndex
+	}
+
+	return cw
+}
+
+func (s *Store) watch(ctx context.Context, cw *changeWatcher, retry backoff.BackOff) {
+	defer close(s.done)
+
+	s.log.Info("Watching for changes", zap.String("prefix", s.keys.prefix), zap.Uint64("index", cw.index))
+	for {
+		err := cw.poll(ctx, retry)
+		if ctx.Err() != nil {
+			s.log.Info("Stopped watching for changes")
+			return
+		}
+
+		s.log.Warn("Watch failed", zap.Error(err))
+		s.syncStatus.Failed()
+		_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
+			tag.Upsert(metrics.KeyStoreDriver, DriverName),
+		}, metrics.StoreSyncErrorCount.M(1))
+
+		select {
+		case <-ctx.Done():
+			s.log.Info("Stopped watching for changes")
+			return
+		case <-time.After(retry.NextBackOff()):
+		}
+	}
+}
+
+// poll lists the prefix with blocking queries and notifies the subscribers of the changes until a query fails.
+func (cw *changeWatcher) poll(ctx context.Context, retry backoff.BackOff) error {
+	for {
+		q := (&api.QueryOptions{WaitIndex: cw.index, WaitTime: cw.waitTime}).WithContext(ctx)
+		pairs, meta, err := cw.store.client.KV().List(cw.store.keys.prefix+"/", q)
+		if err != nil {
+			return err
+		}
+
+		retry.Reset()
+		cw.store.syncStatus.Live()
+
+		index := nextIndex(meta.LastIndex)
+		if index == cw.index {
+			// The query timed out without any changes.
+			continue
+		}
+
+		events := cw.storageEvents(pairs)
+		if index < cw.index {
+			// The index goes backwards when the Consul state is restored from a snapshot, in which case comparing the
+			// modify indexes with the previous listing doesn't reliably detect the changes.
+			cw.store.log.Warn("Index went backwards", zap.Uint64("index", cw.index), zap.Uint64("lastIndex", index))
+			events = []storage.Event{storage.NewReloadEvent()}
+		}
+
+		if len(events) > 0 {
+			cw.store.NotifySubscribers(events...)
+		}
+
+		cw.index = index
+	}
+}
+
+// storageEvents compares the listing with the previous one and returns the events for the keys that changed.
+func (cw *changeWatcher) storageEvents(pairs api.KVPairs) []storage.Event {
+	var events []storage.Event
+	modifyIndexes := make(map[string]uint64, len(pairs))
+	for _, kv := range pairs {
+		modifyIndexes[kv.Key] = kv.ModifyIndex
+		if prev, ok := cw.modifyIndexes[kv.Key]; ok && prev == kv.ModifyIndex {
+			continue
+		}
+
+		if evt, ok := cw.storageEvent(kv.Key, kv.Value, false); ok {
+			events = append(events, evt)
+		}
+	}
+
+	var deleted []string
+	for key := range cw.modifyIndexes {
+		if _, ok := modifyIndexes[key]; !ok {
+			deleted = append(deleted, key)
+		}
+	}
+
+	sort.Strings(deleted)
+	for _, key := range deleted {
+		if evt, ok := cw.storageEvent(key, nil, true); ok {
+			events = append(events, evt)
+		}
+	}
+
+	cw.modifyIndexes = modifyIndexes
+	return events
+}
+
+func (cw *changeWatcher) storageEvent(key string, value []byte, deleted bool) (storage.Event, bool) {
+	if id, ok := cw.store.keys.policyID(key); ok {
+		kind := storage.EventAddOrUpdatePolicy
+		if deleted || cw.isDisabled(value) {
+			kind = storage.EventDeleteOrDisablePolicy
+		}
+
+		return storage.NewPolicyEvent(kind, moduleID(id)), true
+	}
+
+	if id, ok := cw.store.keys.schemaID(key); ok {
+		kind := storage.EventAddOrUpdateSchema
+		if deleted {
+			kind = storage.EventDeleteSchema
+		}
+
+		return storage.NewSchemaEvent(kind, id), true
+	}
+
+	return storage.Event{}, false
+}
+
+func (cw *changeWatcher) isDisabled(value []byte) bool {
+	r, err := decodePolicyRecord(value)
+	if err != nil {
+		cw.store.log.Warn("Failed to decode policy record from watched key", zap.Error(err))
+		return false
+	}
+
+	return r.Disabled
+}
+
+// nextIndex returns the index to wait on after a listing with the given index. A wait index of zero doesn't block,
+// so it's never used.
+func nextIndex(index uint64) uint64 {
+	if index == 0 {
+		return 1
+	}
+
+	return index
+}
@@ -0,0 +1,84 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+const (
+	confKey            = storage.ConfKey + ".etcd"
+	defaultPrefix      = "/cerbos"
+	defaultDialTimeout = 5 * time.Second
+)
+
+// Conf is required (if driver is set to 'etcd') configuration for etcd driver.
+// +desc=This section is required only if storage.driver is etcd.
+type Conf struct {
+	// TLS holds the TLS configuration to use when connecting to etcd.
+	TLS *TLSConf `yaml:"tls" conf:",example=\n  caCert: /path/to/CA_certificate\n  cert: /path/to/certificate\n  key: /path/to/private_key"`
+	// Endpoints is the list of etcd cluster members to connect to.
+	Endpoints []string `yaml:"endpoints" conf:"required,example=[\"http://localhost:2379\"]"`
+	// Prefix is the key prefix under which the policies and schemas are stored. Defaults to /cerbos.
+	Prefix string `yaml:"prefix" conf:",example=/cerbos"`
+	// Username is the user name to authenticate with.
+	Username string `yaml:"username" conf:",example=cerbos"`
+	// Password is the password of the user to authenticate with.
+	Password string `yaml:"password" conf:",example=${ETCD_PASSWORD}"`
+	// DialTimeout is the maximum time to wait for a connection to the cluster to be established.
+	DialTimeout time.Duration `yaml:"dialTimeout" conf:",example=5s"`
+	// DisableWatch disables watching the prefix for changes made by other Cerbos instances.
+	DisableWatch bool `yaml:"disableWatch" conf:",example=false"`
+	// Sync configures the backoff for retrying after failures to watch the prefix and when the store is reported as degraded.
+	Sync *storage.SyncConf `yaml:"sync,omitempty" conf:",example=\n  initialInterval: 1s\n  maxInterval: 30s\n  degradedAfter: 3"`
+}
+
+type TLSConf struct {
+	// CACert is the path to the CA certificate used to verify the etcd server certificates.
+	CACert string `yaml:"caCert"`
+	// Cert is the path to the client certificate.
+	Cert string `yaml:"cert"`
+	// Key is the path to the client certificate key.
+	Key string `yaml:"key"`
+}
+
+func (c *Conf) Key() string {
+	return confKey
+}
+
+func (c *Conf) SetDefaults() {
+	c.Prefix = defaultPrefix
+	c.DialTimeout = defaultDialTimeout
+}
+
+func (c *Conf) Validate() (errs error) {
+	if len(c.Endpoints) == 0 {
+		errs = multierr.Append(errs, errors.New("at least one endpoint is required"))
+	}
+
+	if strings.Trim(c.Prefix, "/ ") == "" {
+		errs = multierr.Append(errs, errors.New("prefix must not be empty"))
+	}
+
+	if c.DialTimeout <= 0 {
+		errs = multierr.Append(errs, errors.New("dialTimeout must be positive"))
+	}
+
+	if c.TLS != nil && (c.TLS.Cert == "") != (c.TLS.Key == "") {
+		errs = multierr.Append(errs, errors.New("both cert and key must be provided for TLS client authentication"))
+	}
+
+	return multierr.Append(errs, c.Sync.Validate())
+}
+
+// keyPrefix returns the prefix normalised to start with a slash and not end with one.
+func (c *Conf) keyPrefix() string {
+	return "/" + strings.Trim(c.Prefix, "/")
+}
@@ -0,0 +1,829 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+
+	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/observability/logging"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/db"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const (
+	DriverName = "etcd"
+
+	connectRetries = 3
+	requestTimeout = 10 * time.Second
+	// maxTxnOps is the default limit on the number of operations in an etcd transaction.
+	maxTxnOps = 128
+	// maxWatchRetryInterval is the default maximum time to wait before re-establishing a watch that failed.
+	maxWatchRetryInterval = 30 * time.Second
+)
+
+var (
+	_ storage.SourceStore  = (*Store)(nil)
+	_ storage.MutableStore = (*Store)(nil)
+	_ storage.Instrumented = (*Store)(nil)
+	_ storage.Reloadable   = (*Store)(nil)
+	_ storage.Syncable     = (*Store)(nil)
+	_ io.Closer            = (*Store)(nil)
+)
+
+func init() {
+	storage.RegisterDriver(DriverName, func(ctx context.Context, confW *config.Wrapper) (storage.Store, error) {
+		conf := new(Conf)
+		if err := confW.GetSection(conf); err != nil {
+			return nil, err
+		}
+
+		return NewStore(ctx, conf)
+	})
+}
+
+type Store struct {
+	client      *clientv3.Client
+	keys        keys
+	regexpCache *util.RegexpCache
+	log         *zap.Logger
+	cancel      context.CancelFunc
+	done        chan struct{}
+	syncStatus  *storage.SyncStatus
+	*storage.SubscriptionManager
+}
+
+func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
+	log := logging.FromContext(ctx).Named("etcd")
+	log.Info("Initializing etcd storage", zap.Strings("endpoints", conf.Endpoints), zap.String("prefix", conf.keyPrefix()))
+
+	tlsConf, err := mkTLSConfig(conf.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   conf.Endpoints,
+		DialTimeout: conf.DialTimeout,
+		Username:    conf.Username,
+		Password:    conf.Password,
+		TLS:         tlsConf,
+		Context:     ctx,
+		Logger:      log.Named("client"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	s := &Store{
+		client:              client,
+		keys:                keys{prefix: conf.keyPrefix()},
+		regexpCache:         util.NewRegexpCache(),
+		log:                 log,
+		done:                make(chan struct{}),
+		SubscriptionManager: storage.NewSubscriptionManager(ctx),
+	}
+
+	// The revision of the initial read is where the watch starts from, so that no changes are missed in between.
+	var revision int64
+	pingFn := func() error {
+		pingCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+
+		resp, err := client.Get(pingCtx, s.keys.prefix+"/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+		if err != nil {
+			return err
+		}
+
+		revision = resp.Header.Revision
+		return nil
+	}
+
+	if err := backoff.Retry(pingFn, backoff.WithContext(backoff.WithMaxRetries(backoff.NewExponentialBackOff(), connectRetries), ctx)); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to connect to etcd: %w", err)
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	if conf.DisableWatch {
+		close(s.done)
+	} else {
+		s.syncStatus = storage.NewSyncStatus(DriverName, conf.Sync)
+		go s.watch(watchCtx, revision+1, conf.Sync.NewBackOff(backoff.DefaultInitialInterval, maxWatchRetryInterval))
+	}
+
+	return s, nil
+}
+
+func mkTLSConfig(conf *TLSConf) (*tls.Config, error) {
+	if conf == nil {
+		return nil, nil
+	}
+
+	tlsConf := util.DefaultTLSConfig()
+	if conf.Cert != "" {
+		cert, err := tls.LoadX509KeyPair(conf.Cert, conf.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+		}
+
+		tlsConf.Certificates = []tls.Certificate{cert}
+	}
+
+	if conf.CACert != "" {
+		caPEM, err := os.ReadFile(conf.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load CA certificate: %w", err)
+		}
+
+		certPool := x509.NewCertPool()
+		if ok := certPool.AppendCertsFromPEM(caPEM); !ok {
+			return nil, errors.New("failed to add CA certificate to pool")
+		}
+
+		tlsConf.RootCAs = certPool
+	}
+
+	return tlsConf, nil
+}
+
+// SyncStatus returns the status of the watch, or nil if watching for changes is disabled.
+func (s *Store) SyncStatus() *storage.SyncStatus {
+	return s.syncStatus
+}
+
+func (s *Store) Driver() string {
+	return DriverName
+}
+
+// Close stops watching for changes and closes the connection to etcd.
+func (s *Store) Close() error {
+	s.cancel()
+	<-s.done
+
+	return s.client.Close()
+}
+
+func (s *Store) AddOrUpdate(ctx context.Context, policies ...policy.Wrapper) error {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	ops := make([]clientv3.Op, len(policies))
+	events := make([]storage.Event, len(policies))
+	for i, p := range policies {
+		def, err := p.Policy.MarshalVT()
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s: %w", p.FQN, err)
+		}
+
+		value, err := json.Marshal(policyRecord{
+			Kind:         p.Kind.String(),
+			Name:         p.Name,
+			Version:      p.Version,
+			Scope:        p.Scope,
+			Description:  p.Description,
+			Disabled:     p.Disabled,
+			Definition:   def,
+			Dependencies: rawIDs(p.Dependencies()),
+			Ancestors:    rawIDs(policy.Ancestors(p.Policy)),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", p.FQN, err)
+		}
+
+		ops[i] = clientv3.OpPut(s.keys.policy(rawID(p.ID)), string(value))
+		events[i] = storage.Event{Kind: storage.EventAddOrUpdatePolicy, PolicyID: p.ID}
+	}
+
+	if _, err := s.txn(ctx, ops); err != nil {
+		return fmt.Errorf("failed to upsert policies: %w", err)
+	}
+
+	_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
+		tag.Upsert(metrics.KeyIndexCRUDKind, "upsert"),
+	}, metrics.IndexCRUDCount.M(int64(len(policies))))
+
+	s.NotifySubscribers(events...)
+	return nil
+}
+
+func (s *Store) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID) (*policy.CompilationUnit, error) {
+	results, err := s.GetCompilationUnits(ctx, candidates...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range candidates {
+		if cu, ok := results[id]; ok {
+			return cu, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Store) GetCompilationUnits(ctx context.Context, ids ...namer.ModuleID) (map[namer.ModuleID]*policy.CompilationUnit, error) {
+	// Policies are loaded level by level: the requested policies, then their ancestors and finally the
+	// dependencies of all of them until there's nothing new to load. Disabled policies are left out.
+	loaded := make(map[uint64]*policyRecord)
+	load := func(toLoad []uint64) error {
+		var missing []uint64
+		for _, id := range toLoad {
+			if _, ok := loaded[id]; !ok {
+				missing = append(missing, id)
+			}
+		}
+
+		if len(missing) == 0 {
+			return nil
+		}
+
+		records, err := s.getPolicies(ctx, missing)
+		if err != nil {
+			return err
+		}
+
+		// Remember the policies that don't exist or are disabled to avoid looking for them again.
+		for _, id := range missing {
+			if r, ok := records[id]; ok && !r.Disabled {
+				loaded[id] = r
+			} else {
+				loaded[id] = nil
+			}
+		}
+
+		return nil
+	}
+
+	rootIDs := rawIDs(ids)
+	if err := load(rootIDs); err != nil {
+		return nil, fmt.Errorf("failed to get policies: %w", err)
+	}
+
+	var ancestorIDs []uint64
+	for _, id := range rootIDs {
+		if r := loaded[id]; r != nil {
+			ancestorIDs = append(ancestorIDs, r.Ancestors...)
+		}
+	}
+
+	if err := load(ancestorIDs); err != nil {
+		return nil, fmt.Errorf("failed to get ancestors: %w", err)
+	}
+
+	for {
+		var depIDs []uint64
+		for _, r := range loaded {
+			if r == nil {
+				continue
+			}
+
+			for _, dep := range r.Dependencies {
+				if _, ok := loaded[dep]; !ok {
+					depIDs = append(depIDs, dep)
+				}
+			}
+		}
+
+		if len(depIDs) == 0 {
+			break
+		}
+
+		if err := load(depIDs); err != nil {
+			return nil, fmt.Errorf("failed to get dependencies: %w", err)
+		}
+	}
+
+	units := make(map[namer.ModuleID]*policy.CompilationUnit)
+	for _, id := range rootIDs {
+		root := loaded[id]
+		if root == nil {
+			continue
+		}
+
+		unit := &policy.CompilationUnit{ModID: moduleID(id)}
+		var add func(uint64, *policyRecord) error
+		add = func(id uint64, r *policyRecord) error {
+			modID := moduleID(id)
+			if _, ok := unit.Definitions[modID]; ok {
+				return nil
+			}
+
+			p, err := r.policy()
+			if err != nil {
+				return err
+			}
+
+			unit.AddDefinition(modID, p)
+			for _, dep := range r.Dependencies {
+				if depRecord := loaded[dep]; depRecord != nil {
+					if err := add(dep, depRecord); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		}
+
+		if err := add(id, root); err != nil {
+			return nil, err
+		}
+
+		for _, ancestor := range root.Ancestors {
+			if ancestorRecord := loaded[ancestor]; ancestorRecord != nil {
+				if err := add(ancestor, ancestorRecord); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		units[unit.ModID] = unit
+	}
+
+	return units, nil
+}
+
+func (s *Store) GetDependents(ctx context.Context, ids ...namer.ModuleID) (map[namer.ModuleID][]namer.ModuleID, error) {
+	records, err := s.listPolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dependents: %w", err)
+	}
+
+	dependents := make(map[uint64][]uint64)
+	for id, r := range records {
+		for _, dep := range r.Dependencies {
+			dependents[dep] = append(dependents[dep], id)
+		}
+	}
+
+	// There's a maximum of two levels of dependency (resourcePolicy -> derivedRoles -> exportVariables),
+	// so the dependents are the direct dependents and the dependents of the direct dependents.
+	out := make(map[namer.ModuleID][]namer.ModuleID, len(ids))
+	for _, id := range ids {
+		seen := make(map[uint64]struct{})
+		for _, direct := range dependents[rawID(id)] {
+			seen[direct] = struct{}{}
+			for _, indirect := range dependents[direct] {
+				seen[indirect] = struct{}{}
+			}
+		}
+
+		if len(seen) == 0 {
+			continue
+		}
+
+		deps := make([]namer.ModuleID, 0, len(seen))
+		for dep := range seen {
+			deps = append(deps, moduleID(dep))
+		}
+
+		out[id] = deps
+	}
+
+	return out, nil
+}
+
+func (s *Store) LoadPolicy(ctx context.Context, policyKey ...string) ([]*policy.Wrapper, error) {
+	ids := make([]uint64, len(policyKey))
+	for i, pk := range policyKey {
+		ids[i] = rawID(namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk)))
+	}
+
+	records, err := s.getPolicies(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get policies: %w", err)
+	}
+
+	policies := make([]*policy.Wrapper, 0, len(records))
+	for _, id := range ids {
+		r, ok := records[id]
+		if !ok {
+			continue
+		}
+
+		p, err := r.policy()
+		if err != nil {
+			return nil, err
+		}
+
+		pk := namer.PolicyKey(p)
+		wp := policy.Wrap(policy.WithMetadata(p, "", nil, pk))
+		wp.Disabled = r.Disabled
+		policies = append(policies, &wp)
+	}
+
+	return policies, nil
+}
+
+func (s *Store) ListPolicyIDs(ctx context.Context, params storage.ListPolicyIDsParams) ([]string, error) {
+	var filters []regexpFilter
+	for _, f := range []struct {
+		pattern string
+		get     func(namer.PolicyCoords) string
+	}{
+		{pattern: params.NameRegexp, get: func(pc namer.PolicyCoords) string { return pc.Name }},
+		{pattern: params.ScopeRegexp, get: func(pc namer.PolicyCoords) string { return pc.Scope }},
+		{pattern: params.VersionRegexp, get: func(pc namer.PolicyCoords) string { return pc.Version }},
+	} {
+		if f.pattern == "" {
+			continue
+		}
+
+		re, err := s.regexpCache.GetCompiledExpr(f.pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, regexpFilter{match: re.MatchString, get: f.get})
+	}
+
+	records, err := s.listPolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not execute %q query: %w", "ListPolicyIDs", err)
+	}
+
+	coords := make([]namer.PolicyCoords, 0, len(records))
+outer:
+	for _, r := range records {
+		if r.Disabled && !params.IncludeDisabled {
+			continue
+		}
+
+		pc := r.coords()
+		for _, f := range filters {
+			if !f.match(f.get(pc)) {
+				continue outer
+			}
+		}
+
+		coords = append(coords, pc)
+	}
+
+	sort.Slice(coords, func(i, j int) bool {
+		a, b := coords[i], coords[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+
+		if a.Version != b.Version {
+			return a.Version < b.Version
+		}
+
+		return a.Scope < b.Scope
+	})
+
+	policyIDs := make([]string, len(coords))
+	for i, pc := range coords {
+		policyIDs[i] = pc.PolicyKey()
+	}
+
+	return policyIDs, nil
+}
+
+func (s *Store) Disable(ctx context.Context, policyKey ...string) (uint32, error) {
+	ids := make([]uint64, len(policyKey))
+	events := make([]storage.Event, len(policyKey))
+	for i, pk := range policyKey {
+		modID := namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk))
+		ids[i] = rawID(modID)
+		events[i] = storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, modID)
+	}
+
+	// Disabling a scoped policy that has enabled descendants would break the scope chain.
+	records, err := s.listPolicies(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get descendants for policies: %w", err)
+	}
+
+	hasDescendants := make(map[uint64]struct{})
+	for _, r := range records {
+		if r.Disabled {
+			continue
+		}
+
+		for _, a := range r.Ancestors {
+			hasDescendants[a] = struct{}{}
+		}
+	}
+
+	var brokenChainPolicies []string
+	for i, pk := range policyKey {
+		if _, ok := hasDescendants[ids[i]]; ok {
+			brokenChainPolicies = append(brokenChainPolicies, pk)
+		}
+	}
+
+	if len(brokenChainPolicies) > 0 {
+		return 0, db.ErrBreaksScopeChain{PolicyKeys: brokenChainPolicies}
+	}
+
+	count, err := s.setDisabled(ctx, ids, true)
+	if err != nil {
+		return 0, fmt.Errorf("failed to disable policies: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return count, nil
+}
+
+func (s *Store) Enable(ctx context.Context, policyKey ...string) (uint32, error) {
+	ids := make([]uint64, len(policyKey))
+	events := make([]storage.Event, len(policyKey))
+	for i, pk := range policyKey {
+		modID := namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk))
+		ids[i] = rawID(modID)
+		events[i] = storage.NewPolicyEvent(storage.EventAddOrUpdatePolicy, modID)
+	}
+
+	count, err := s.setDisabled(ctx, ids, false)
+	if err != nil {
+		return 0, fmt.Errorf("failed to enable policies: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return count, nil
+}
+
+// setDisabled updates the disabled flag of the given policies and returns the number of policies that exist.
+// The records are updated in a software transaction so that concurrent changes to the same policies aren't lost.
+func (s *Store) setDisabled(ctx context.Context, ids []uint64, disabled bool) (uint32, error) {
+	var count uint32
+	_, err := concurrency.NewSTM(s.client, func(stm concurrency.STM) error {
+		count = 0
+		for _, id := range ids {
+			key := s.keys.policy(id)
+			value := stm.Get(key)
+			if value == "" {
+				continue
+			}
+
+			r, err := decodePolicyRecord([]byte(value))
+			if err != nil {
+				return err
+			}
+
+			count++
+			r.Disabled = disabled
+			updated, err := json.Marshal(r)
+			if err != nil {
+				return err
+			}
+
+			stm.Put(key, string(updated))
+		}
+
+		return nil
+	}, concurrency.WithAbortContext(ctx))
+
+	return count, err
+}
+
+func (s *Store) Delete(ctx context.Context, ids ...namer.ModuleID) error {
+	ops := make([]clientv3.Op, len(ids))
+	events := make([]storage.Event, len(ids))
+	for i, id := range ids {
+		ops[i] = clientv3.OpDelete(s.keys.policy(rawID(id)))
+		events[i] = storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, id)
+	}
+
+	if _, err := s.txn(ctx, ops); err != nil {
+		return fmt.Errorf("failed to delete policies: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return nil
+}
+
+func (s *Store) AddOrUpdateSchema(ctx context.Context, schemas ...*schemav1.Schema) error {
+	if len(schemas) == 0 {
+		return nil
+	}
+
+	ops := make([]clientv3.Op, len(schemas))
+	events := make([]storage.Event, len(schemas))
+	for i, sch := range schemas {
+		var def json.RawMessage
+		if err := json.Unmarshal(sch.Definition, &def); err != nil {
+			return storage.NewInvalidSchemaError(err, "schema definition with ID %q is not valid", sch.Id)
+		}
+
+		ops[i] = clientv3.OpPut(s.keys.schema(sch.Id), string(sch.Definition))
+		events[i] = storage.NewSchemaEvent(storage.EventAddOrUpdateSchema, sch.Id)
+	}
+
+	if _, err := s.txn(ctx, ops); err != nil {
+		return fmt.Errorf("failed to upsert schemas: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return nil
+}
+
+func (s *Store) DeleteSchema(ctx context.Context, ids ...string) (uint32, error) {
+	ops := make([]clientv3.Op, len(ids))
+	events := make([]storage.Event, len(ids))
+	for i, id := range ids {
+		ops[i] = clientv3.OpDelete(s.keys.schema(id))
+		events[i] = storage.NewSchemaEvent(storage.EventDeleteSchema, id)
+	}
+
+	responses, err := s.txn(ctx, ops)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete schema(s): %w", err)
+	}
+
+	var deleted int64
+	for _, resp := range responses {
+		deleted += resp.GetResponseDeleteRange().GetDeleted()
+	}
+
+	s.NotifySubscribers(events...)
+	return uint32(deleted), nil
+}
+
+func (s *Store) ListSchemaIDs(ctx context.Context) ([]string, error) {
+	resp, err := s.client.Get(ctx, s.keys.schemas(), clientv3.WithPrefix(), clientv3.WithKeysOnly(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		return nil, fmt.Errorf("could not execute %q query: %w", "ListSchemaIDs", err)
+	}
+
+	schemaIDs := make([]string, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		if id, ok := s.keys.schemaID(kv.Key); ok {
+			schemaIDs = append(schemaIDs, id)
+		}
+	}
+
+	return schemaIDs, nil
+}
+
+func (s *Store) LoadSchema(ctx context.Context, urlVar string) (io.ReadCloser, error) {
+	u, err := url.Parse(urlVar)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != "" && u.Scheme != schema.URLScheme {
+		return nil, fmt.Errorf("invalid url scheme %q", u.Scheme)
+	}
+
+	resp, err := s.client.Get(ctx, s.keys.schema(strings.TrimPrefix(u.Path, "/")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+
+	if len(resp.Kvs) == 0 {
+		return nil, fmt.Errorf("failed to find schema")
+	}
+
+	return io.NopCloser(strings.NewReader(string(resp.Kvs[0].Value))), nil
+}
+
+func (s *Store) RepoStats(ctx context.Context) storage.RepoStats {
+	stats := storage.RepoStats{}
+
+	records, err := s.listPolicies(ctx)
+	if err != nil {
+		return stats
+	}
+
+	stats.PolicyCount = make(map[policy.Kind]int)
+	for _, r := range records {
+		switch r.Kind {
+		case policy.DerivedRolesKindStr:
+			stats.PolicyCount[policy.DerivedRolesKind]++
+		case policy.ExportVariablesKindStr:
+			stats.PolicyCount[policy.ExportVariablesKind]++
+		case policy.PrincipalKindStr:
+			stats.PolicyCount[policy.PrincipalKind]++
+		case policy.ResourceKindStr:
+			stats.PolicyCount[policy.ResourceKind]++
+		}
+	}
+
+	if resp, err := s.client.Get(ctx, s.keys.schemas(), clientv3.WithPrefix(), clientv3.WithCountOnly()); err == nil {
+		stats.SchemaCount = int(resp.Count)
+	}
+
+	return stats
+}
+
+func (s *Store) Reload(context.Context) error {
+	s.NotifySubscribers(storage.NewReloadEvent())
+	return nil
+}
+
+// txn executes the operations in transactions of at most maxTxnOps operations each.
+func (s *Store) txn(ctx context.Context, ops []clientv3.Op) ([]*etcdserverpb.ResponseOp, error) {
+	responses := make([]*etcdserverpb.ResponseOp, 0, len(ops))
+	for start := 0; start < len(ops); start += maxTxnOps {
+		end := start + maxTxnOps
+		if end > len(ops) {
+			end = len(ops)
+		}
+
+		resp, err := s.client.Txn(ctx).Then(ops[start:end]...).Commit()
+		if err != nil {
+			return nil, err
+		}
+
+		responses = append(responses, resp.Responses...)
+	}
+
+	return responses, nil
+}
+
+// getPolicies returns the records of the policies that exist among the given IDs.
+func (s *Store) getPolicies(ctx context.Context, ids []uint64) (map[uint64]*policyRecord, error) {
+	ops := make([]clientv3.Op, len(ids))
+	for i, id := range ids {
+		ops[i] = clientv3.OpGet(s.keys.policy(id))
+	}
+
+	responses, err := s.txn(ctx, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(map[uint64]*policyRecord, len(ids))
+	for _, resp := range responses {
+		for _, kv := range resp.GetResponseRange().GetKvs() {
+			if err := s.addRecord(records, kv.Key, kv.Value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return records, nil
+}
+
+// listPolicies returns the records of all the policies in the store.
+func (s *Store) listPolicies(ctx context.Context) (map[uint64]*policyRecord, error) {
+	resp, err := s.client.Get(ctx, s.keys.policies(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(map[uint64]*policyRecord, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		if err := s.addRecord(records, kv.Key, kv.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+func (s *Store) addRecord(records map[uint64]*policyRecord, key, value []byte) error {
+	id, ok := s.keys.policyID(key)
+	if !ok {
+		s.log.Warn("Ignoring unexpected key under the policies prefix", zap.ByteString("key", key))
+		return nil
+	}
+
+	r, err := decodePolicyRecord(value)
+	if err != nil {
+		return fmt.Errorf("invalid value for key %q: %w", key, err)
+	}
+
+	records[id] = r
+	return nil
+}
+
+type regexpFilter struct {
+	match func(string) bool
+	get   func(namer.PolicyCoords) string
+}
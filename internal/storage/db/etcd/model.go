@@ -0,0 +1,120 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+)
+
+const (
+	policiesDir = "/policies/"
+	schemasDir  = "/schemas/"
+)
+
+// policyRecord is the value stored under the policy key, which is the module ID of the policy.
+type policyRecord struct {
+	Kind         string   `json:"kind"`
+	Name         string   `json:"name"`
+	Version      string   `json:"version"`
+	Scope        string   `json:"scope"`
+	Description  string   `json:"description,omitempty"`
+	Definition   []byte   `json:"definition"`
+	Dependencies []uint64 `json:"dependencies,omitempty"`
+	Ancestors    []uint64 `json:"ancestors,omitempty"`
+	Disabled     bool     `json:"disabled"`
+}
+
+func (r *policyRecord) policy() (*policyv1.Policy, error) {
+	p := &policyv1.Policy{}
+	if err := p.UnmarshalVT(r.Definition); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal policy definition: %w", err)
+	}
+
+	return p, nil
+}
+
+func (r *policyRecord) coords() namer.PolicyCoords {
+	return namer.PolicyCoords{Kind: r.Kind, Name: r.Name, Version: r.Version, Scope: r.Scope}
+}
+
+func decodePolicyRecord(value []byte) (*policyRecord, error) {
+	r := &policyRecord{}
+	if err := json.Unmarshal(value, r); err != nil {
+		return nil, fmt.Errorf("failed to decode policy record: %w", err)
+	}
+
+	return r, nil
+}
+
+// keys builds the etcd keys for the policies and schemas stored under a prefix.
+type keys struct {
+	prefix string
+}
+
+func (k keys) policies() string {
+	return k.prefix + policiesDir
+}
+
+func (k keys) policy(id uint64) string {
+	return k.policies() + strconv.FormatUint(id, 10)
+}
+
+func (k keys) schemas() string {
+	return k.prefix + schemasDir
+}
+
+func (k keys) schema(id string) string {
+	return k.schemas() + id
+}
+
+// policyID extracts the module ID from a policy key.
+func (k keys) policyID(key []byte) (uint64, bool) {
+	s := string(key)
+	if !strings.HasPrefix(s, k.policies()) {
+		return 0, false
+	}
+
+	id, err := strconv.ParseUint(strings.TrimPrefix(s, k.policies()), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return id, true
+}
+
+// schemaID extracts the schema ID from a schema key.
+func (k keys) schemaID(key []byte) (string, bool) {
+	s := string(key)
+	if !strings.HasPrefix(s, k.schemas()) || len(s) == len(k.schemas()) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(s, k.schemas()), true
+}
+
+func rawID(id namer.ModuleID) uint64 {
+	v, _ := id.Value()
+	return v.(uint64) //nolint:forcetypeassert
+}
+
+func rawIDs(ids []namer.ModuleID) []uint64 {
+	out := make([]uint64, len(ids))
+	for i, id := range ids {
+		out[i] = rawID(id)
+	}
+
+	return out
+}
+
+func moduleID(id uint64) namer.ModuleID {
+	var m namer.ModuleID
+	_ = m.Scan(id)
+	return m
+}
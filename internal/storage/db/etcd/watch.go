@@ -0,0 +1,138 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+var errWatchClosed = errors.New("watch channel was closed")
+
+// changeWatcher notifies the subscribers of the store about the changes made by other Cerbos instances.
+// Changes made through this instance are notified twice: once when they are made and once when they arrive on the
+// watch channel. The second notification is harmless because it only causes the affected policies to be recompiled.
+type changeWatcher struct {
+	store *Store
+	// revision is the revision to resume watching from.
+	revision int64
+}
+
+func (s *Store) watch(ctx context.Context, revision int64, retry backoff.BackOff) {
+	defer close(s.done)
+
+	cw := &changeWatcher{store: s, revision: revision}
+
+	s.log.Info("Watching for changes", zap.String("prefix", s.keys.prefix), zap.Int64("revision", revision))
+	for {
+		err := cw.stream(ctx, retry)
+		if ctx.Err() != nil {
+			s.log.Info("Stopped watching for changes")
+			return
+		}
+
+		s.log.Warn("Watch failed", zap.Error(err))
+		s.syncStatus.Failed()
+		_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
+			tag.Upsert(metrics.KeyStoreDriver, DriverName),
+		}, metrics.StoreSyncErrorCount.M(1))
+
+		select {
+		case <-ctx.Done():
+			s.log.Info("Stopped watching for changes")
+			return
+		case <-time.After(retry.NextBackOff()):
+		}
+	}
+}
+
+// stream watches the prefix and notifies the subscribers of the changes until the watch fails.
+func (cw *changeWatcher) stream(ctx context.Context, retry backoff.BackOff) error {
+	// Cancelling the context is the only way to release the resources held by a watch.
+	watchCtx, cancel := context.WithCancel(clientv3.WithRequireLeader(ctx))
+	defer cancel()
+
+	wch := cw.store.client.Watch(watchCtx, cw.store.keys.prefix+"/", clientv3.WithPrefix(), clientv3.WithRev(cw.revision), clientv3.WithCreatedNotify())
+	for resp := range wch {
+		if resp.CompactRevision != 0 {
+			// The revision to resume from has been compacted away, so the changes made in between are lost.
+			cw.store.log.Warn("Missed changes due to compaction", zap.Int64("revision", cw.revision), zap.Int64("compactRevision", resp.CompactRevision))
+			cw.revision = resp.CompactRevision
+			cw.store.NotifySubscribers(storage.NewReloadEvent())
+		}
+
+		if err := resp.Err(); err != nil {
+			return err
+		}
+
+		retry.Reset()
+		cw.store.syncStatus.Live()
+		if resp.Created {
+			// The header of the creation notice has the current revision, but the changes since the revision to resume
+			// from are still to come.
+			continue
+		}
+
+		if events := cw.storageEvents(resp.Events); len(events) > 0 {
+			cw.store.NotifySubscribers(events...)
+		}
+
+		if resp.Header.Revision >= cw.revision {
+			cw.revision = resp.Header.Revision + 1
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return errWatchClosed
+}
+
+func (cw *changeWatcher) storageEvents(watchEvents []*clientv3.Event) []storage.Event {
+	events := make([]storage.Event, 0, len(watchEvents))
+	for _, evt := range watchEvents {
+		if id, ok := cw.store.keys.policyID(evt.Kv.Key); ok {
+			kind := storage.EventAddOrUpdatePolicy
+			if evt.Type == mvccpb.DELETE || cw.isDisabled(evt.Kv.Value) {
+				kind = storage.EventDeleteOrDisablePolicy
+			}
+
+			events = append(events, storage.NewPolicyEvent(kind, moduleID(id)))
+			continue
+		}
+
+		if id, ok := cw.store.keys.schemaID(evt.Kv.Key); ok {
+			kind := storage.EventAddOrUpdateSchema
+			if evt.Type == mvccpb.DELETE {
+				kind = storage.EventDeleteSchema
+			}
+
+			events = append(events, storage.NewSchemaEvent(kind, id))
+		}
+	}
+
+	return events
+}
+
+func (cw *changeWatcher) isDisabled(value []byte) bool {
+	r, err := decodePolicyRecord(value)
+	if err != nil {
+		cw.store.log.Warn("Failed to decode policy record from watch event", zap.Error(err))
+		return false
+	}
+
+	return r.Disabled
+}
@@ -6,21 +6,29 @@ package internal
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/url"
 	"os"
+	"path"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/jackc/pgtype"
+	"github.com/spf13/afero"
 	"go.opencensus.io/stats"
 	"go.opencensus.io/tag"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
 
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
 	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/observability/metrics"
@@ -28,15 +36,28 @@ import (
 	"github.com/cerbos/cerbos/internal/schema"
 	"github.com/cerbos/cerbos/internal/storage"
 	"github.com/cerbos/cerbos/internal/storage/db"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/storage/index"
 )
 
-const tableLogKey = "table"
+const (
+	tableLogKey = "table"
+	// sqliteTimestampFormat is the format of CURRENT_TIMESTAMP in SQLite.
+	sqliteTimestampFormat = "2006-01-02 15:04:05"
+	// maxTxAttempts is the maximum number of times a transaction is attempted if the driver considers the errors retryable.
+	maxTxAttempts = 5
+	txRetryDelay  = 50 * time.Millisecond
+	// deletedPolicyPurgeInterval is how often the policies deleted before the retention period are purged.
+	deletedPolicyPurgeInterval = 1 * time.Hour
+)
 
 type DBStorage interface {
 	storage.Subscribable
 	storage.Instrumented
 	storage.Reloadable
 	storage.Verifiable
+	storage.Revisioned
+	storage.Audited
 	AddOrUpdate(ctx context.Context, policies ...policy.Wrapper) error
 	GetFirstMatch(ctx context.Context, candidates []namer.ModuleID) (*policy.CompilationUnit, error)
 	GetCompilationUnits(ctx context.Context, ids ...namer.ModuleID) (map[namer.ModuleID]*policy.CompilationUnit, error)
@@ -51,6 +72,13 @@ type DBStorage interface {
 	DeleteSchema(ctx context.Context, ids ...string) (uint32, error)
 	LoadSchema(ctx context.Context, url string) (io.ReadCloser, error)
 	LoadPolicy(ctx context.Context, policyKey ...string) ([]*policy.Wrapper, error)
+	AsOf(ctx context.Context, point storage.HistoryPoint) (storage.SourceStore, string, error)
+	ListPolicyVersions(ctx context.Context, policyKey string) ([]storage.PolicyVersion, error)
+	GetPolicyVersion(ctx context.Context, policyKey string, version uint64) (*policyv1.Policy, error)
+	RollbackPolicy(ctx context.Context, policyKey string, version uint64) (uint64, error)
+	DeletePolicy(ctx context.Context, policyKey ...string) (uint32, error)
+	RestorePolicy(ctx context.Context, policyKey ...string) (uint32, error)
+	PurgeDeletedPolicies(ctx context.Context, before time.Time) (uint32, error)
 }
 
 func NewDBStorage(ctx context.Context, db *goqu.Database, dbOpts ...DBOpt) (DBStorage, error) {
@@ -65,24 +93,56 @@ func NewDBStorage(ctx context.Context, db *goqu.Database, dbOpts ...DBOpt) (DBSt
 		}
 
 		db.Logger(log)
+		if opts.replica != nil {
+			opts.replica.Logger(log)
+		}
 	}
 
-	return &dbStorage{
+	s := &dbStorage{
 		opts:                opts,
 		db:                  db,
 		SubscriptionManager: storage.NewSubscriptionManager(ctx),
-	}, nil
+	}
+	// the tables might not exist yet if the schema check is disabled, so the revision is only reported once they do.
+	if err := s.loadRevision(ctx); err != nil {
+		zap.L().Named("db").Warn("Failed to read the latest policy revision", zap.Error(err))
+	}
+	go s.purgeDeletedPolicies(ctx)
+
+	return s, nil
 }
 
 type dbStorage struct {
 	opts *dbOpt
 	db   *goqu.Database
 	*storage.SubscriptionManager
+	revision atomic.Int64
+	// lastChange is when the last change was made through the store, in nanoseconds since the Unix epoch.
+	lastChange atomic.Int64
+}
+
+// NotifySubscribers records the time of the change before notifying the subscribers, so that the lookups made in
+// response to the notifications aren't served by a read replica that hasn't caught up yet.
+func (s *dbStorage) NotifySubscribers(events ...storage.Event) {
+	s.lastChange.Store(time.Now().UnixNano())
+	s.SubscriptionManager.NotifySubscribers(events...)
+}
+
+// lookupDB returns the database to serve the policy and schema lookups of the engine from.
+func (s *dbStorage) lookupDB() *goqu.Database {
+	if s.opts.replica == nil || time.Since(time.Unix(0, s.lastChange.Load())) < s.opts.replicaReadDelay {
+		return s.db
+	}
+
+	return s.opts.replica
 }
 
 func (s *dbStorage) AddOrUpdateSchema(ctx context.Context, schemas ...*schemav1.Schema) error {
+	actor := storage.ActorFromContext(ctx)
 	events := make([]storage.Event, 0, len(schemas))
-	err := s.db.WithTx(func(tx *goqu.TxDatabase) error {
+	err := s.withTx(ctx, func(tx *goqu.TxDatabase) error {
+		events = events[:0]
+		storeEvents := make([]any, 0, len(schemas))
 		for _, sch := range schemas {
 			var def json.RawMessage
 			if err := json.Unmarshal(sch.Definition, &def); err != nil {
@@ -114,8 +174,10 @@ func (s *dbStorage) AddOrUpdateSchema(ctx context.Context, schemas ...*schemav1.
 			}
 
 			events = append(events, storage.NewSchemaEvent(storage.EventAddOrUpdateSchema, sch.Id))
+			storeEvents = append(storeEvents, newStoreEvent(actor, StoreEventActionAddOrUpdateSchema, sch.Id))
 		}
-		return nil
+
+		return insertStoreEvents(ctx, tx, storeEvents)
 	})
 	if err != nil {
 		return err
@@ -131,18 +193,41 @@ func (s *dbStorage) DeleteSchema(ctx context.Context, ids ...string) (uint32, er
 		events = append(events, storage.NewSchemaEvent(storage.EventDeleteSchema, id))
 	}
 
-	res, err := s.db.Delete(SchemaTbl).
-		Prepared(true).
-		Where(goqu.Ex{SchemaTblIDCol: ids}).
-		Executor().
-		ExecContext(ctx)
-	if err != nil {
-		return 0, fmt.Errorf("failed to delete schema(s): %w", err)
-	}
+	actor := storage.ActorFromContext(ctx)
+	var affected int64
+	err := s.withTx(ctx, func(tx *goqu.TxDatabase) error {
+		// only the schemas that exist are recorded as deleted.
+		var existing []string
+		if err := tx.From(SchemaTbl).
+			Select(goqu.C(SchemaTblIDCol)).
+			Where(goqu.Ex{SchemaTblIDCol: ids}).
+			ScanValsContext(ctx, &existing); err != nil {
+			return fmt.Errorf("failed to get schema(s): %w", err)
+		}
+
+		res, err := tx.Delete(SchemaTbl).
+			Prepared(true).
+			Where(goqu.Ex{SchemaTblIDCol: ids}).
+			Executor().
+			ExecContext(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete schema(s): %w", err)
+		}
 
-	affected, err := res.RowsAffected()
+		affected, err = res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to discover whether the schema(s) got deleted or not: %w", err)
+		}
+
+		storeEvents := make([]any, len(existing))
+		for i, id := range existing {
+			storeEvents[i] = newStoreEvent(actor, StoreEventActionDeleteSchema, id)
+		}
+
+		return insertStoreEvents(ctx, tx, storeEvents)
+	})
 	if err != nil {
-		return 0, fmt.Errorf("failed to discover whether the schema(s) got deleted or not: %w", err)
+		return 0, err
 	}
 
 	s.NotifySubscribers(events...)
@@ -195,7 +280,7 @@ func (s *dbStorage) LoadSchema(ctx context.Context, urlVar string) (io.ReadClose
 	}
 
 	var sch Schema
-	_, err = s.db.From(SchemaTbl).
+	_, err = s.lookupDB().From(SchemaTbl).
 		Where(goqu.Ex{SchemaTblIDCol: strings.TrimPrefix(u.Path, "/")}).
 		ScanStructContext(ctx, &sch)
 	if err != nil {
@@ -215,8 +300,18 @@ func (s *dbStorage) LoadSchema(ctx context.Context, urlVar string) (io.ReadClose
 }
 
 func (s *dbStorage) AddOrUpdate(ctx context.Context, policies ...policy.Wrapper) error {
+	return s.addOrUpdate(ctx, StoreEventActionAddOrUpdatePolicy, policies...)
+}
+
+// addOrUpdate upserts the policies and records the change in the store event table with the given action.
+func (s *dbStorage) addOrUpdate(ctx context.Context, action string, policies ...policy.Wrapper) error {
+	ids := make([]namer.ModuleID, len(policies))
+	for i, p := range policies {
+		ids[i] = p.ID
+	}
+
 	events := make([]storage.Event, len(policies))
-	err := s.db.WithTx(func(tx *goqu.TxDatabase) error {
+	err := s.withStoreEvents(ctx, action, ids, func(tx *goqu.TxDatabase) error {
 		for i, p := range policies {
 			policyRecord := Policy{
 				ID:          p.ID,
@@ -389,7 +484,7 @@ type getCompilationUnitsQueryBuilder struct {
 func (s *dbStorage) newGetCompilationUnitsQueryBuilder(ids []namer.ModuleID) getCompilationUnitsQueryBuilder {
 	q := getCompilationUnitsQueryBuilder{}
 
-	q.query = s.db.
+	q.query = s.lookupDB().
 		From(goqu.T(PolicyTbl).As(q.p(0))).
 		Where(
 			goqu.And(
@@ -470,7 +565,8 @@ func (s *dbStorage) GetDependents(ctx context.Context, ids ...namer.ModuleID) (m
 	// SELECT dependency_id AS policy_id, policy_id AS dependent_id
 	// FROM policy_dependency
 	// WHERE policy_dependency.dependency_id IN (?)
-	directDependentsQuery := s.db.
+	db := s.lookupDB()
+	directDependentsQuery := db.
 		Select(
 			goqu.C(PolicyDepTblDepIDCol).As("policy_id"),
 			goqu.C(PolicyDepTblPolicyIDCol).As("dependent_id"),
@@ -482,7 +578,7 @@ func (s *dbStorage) GetDependents(ctx context.Context, ids ...namer.ModuleID) (m
 	// FROM policy_dependency AS parent
 	// JOIN policy_dependency AS child ON child.policy_id = parent.dependency_id
 	// WHERE child.dependency_id IN (?)
-	transitiveDependentsQuery := s.db.
+	transitiveDependentsQuery := db.
 		Select(
 			goqu.T("child").Col(PolicyDepTblDepIDCol).As("policy_id"),
 			goqu.T("parent").Col(PolicyDepTblPolicyIDCol).As("dependent_id"),
@@ -575,42 +671,59 @@ func (s *dbStorage) Disable(ctx context.Context, policyKey ...string) (uint32, e
 		mIDs[idx] = namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk))
 	}
 
-	hasDescendants, err := s.HasDescendants(ctx, mIDs...)
-	if err != nil {
-		return 0, fmt.Errorf("failed to get descendants for policies: %w", err)
-	}
-
-	var brokenChainPolicies []string
-	for _, pk := range policyKey {
-		mID := namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk))
-		if has, ok := hasDescendants[mID]; ok && has {
-			brokenChainPolicies = append(brokenChainPolicies, pk)
-		}
-	}
-
-	if len(brokenChainPolicies) > 0 {
-		return 0, db.ErrBreaksScopeChain{PolicyKeys: brokenChainPolicies}
+	if err := s.checkScopeChains(ctx, policyKey, mIDs); err != nil {
+		return 0, err
 	}
 
 	events := make([]storage.Event, len(policyKey))
 	for i, pk := range policyKey {
 		events[i] = storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk)))
 	}
-	res, err := s.db.Update(PolicyTbl).Prepared(true).
-		Set(goqu.Record{PolicyTblDisabledCol: true}).
-		Where(goqu.C(PolicyTblIDCol).In(mIDs)).
-		Executor().ExecContext(ctx)
+
+	var affected int64
+	err := s.withStoreEvents(ctx, StoreEventActionDisablePolicy, mIDs, func(tx *goqu.TxDatabase) error {
+		res, err := tx.Update(PolicyTbl).Prepared(true).
+			Set(goqu.Record{PolicyTblDisabledCol: true}).
+			Where(goqu.C(PolicyTblIDCol).In(mIDs)).
+			Executor().ExecContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		affected, err = res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to discover whether the policies got disabled or not: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
 		return 0, err
 	}
 
-	affected, err := res.RowsAffected()
+	s.NotifySubscribers(events...)
+	return uint32(affected), nil
+}
+
+// checkScopeChains returns an error if any of the given policies has enabled descendants that would be left without an ancestor.
+func (s *dbStorage) checkScopeChains(ctx context.Context, policyKey []string, mIDs []namer.ModuleID) error {
+	hasDescendants, err := s.HasDescendants(ctx, mIDs...)
 	if err != nil {
-		return 0, fmt.Errorf("failed to discover whether the policies got disabled or not: %w", err)
+		return fmt.Errorf("failed to get descendants for policies: %w", err)
 	}
 
-	s.NotifySubscribers(events...)
-	return uint32(affected), nil
+	var brokenChainPolicies []string
+	for i, pk := range policyKey {
+		if has, ok := hasDescendants[mIDs[i]]; ok && has {
+			brokenChainPolicies = append(brokenChainPolicies, pk)
+		}
+	}
+
+	if len(brokenChainPolicies) > 0 {
+		return db.ErrBreaksScopeChain{PolicyKeys: brokenChainPolicies}
+	}
+
+	return nil
 }
 
 func (s *dbStorage) Enable(ctx context.Context, policyKey ...string) (uint32, error) {
@@ -621,47 +734,43 @@ func (s *dbStorage) Enable(ctx context.Context, policyKey ...string) (uint32, er
 		events[idx] = storage.NewPolicyEvent(storage.EventAddOrUpdatePolicy, namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk)))
 	}
 
-	res, err := s.db.Update(PolicyTbl).Prepared(true).
-		Set(goqu.Record{PolicyTblDisabledCol: false}).
-		Where(goqu.C(PolicyTblIDCol).In(mIDs)).
-		Executor().ExecContext(ctx)
-	if err != nil {
-		return 0, err
-	}
-
-	affected, err := res.RowsAffected()
-	if err != nil {
-		return 0, fmt.Errorf("failed to discover whether the policies got enabled or not: %w", err)
-	}
-
-	s.NotifySubscribers(events...)
-	return uint32(affected), nil
-}
-
-func (s *dbStorage) Delete(ctx context.Context, ids ...namer.ModuleID) error {
-	if len(ids) == 1 {
-		_, err := s.db.Delete(PolicyTbl).Prepared(true).
-			Where(goqu.C(PolicyTblIDCol).Eq(ids[0])).
+	var affected int64
+	err := s.withStoreEvents(ctx, StoreEventActionEnablePolicy, mIDs, func(tx *goqu.TxDatabase) error {
+		res, err := tx.Update(PolicyTbl).Prepared(true).
+			Set(goqu.Record{PolicyTblDisabledCol: false}).
+			Where(goqu.C(PolicyTblIDCol).In(mIDs)).
 			Executor().ExecContext(ctx)
 		if err != nil {
 			return err
 		}
 
-		s.NotifySubscribers(storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, ids[0]))
+		affected, err = res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to discover whether the policies got enabled or not: %w", err)
+		}
 
 		return nil
+	})
+	if err != nil {
+		return 0, err
 	}
 
-	idList := make([]any, len(ids))
-	events := make([]storage.Event, len(ids))
+	s.NotifySubscribers(events...)
+	return uint32(affected), nil
+}
 
+func (s *dbStorage) Delete(ctx context.Context, ids ...namer.ModuleID) error {
+	events := make([]storage.Event, len(ids))
 	for i, id := range ids {
-		idList[i] = id
-		events[i] = storage.Event{Kind: storage.EventDeleteOrDisablePolicy, PolicyID: id}
+		events[i] = storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, id)
 	}
-	_, err := s.db.Delete(PolicyTbl).Prepared(true).
-		Where(goqu.C(PolicyTblIDCol).In(idList...)).
-		Executor().ExecContext(ctx)
+
+	err := s.withStoreEvents(ctx, StoreEventActionDeletePolicy, ids, func(tx *goqu.TxDatabase) error {
+		_, err := tx.Delete(PolicyTbl).Prepared(true).
+			Where(goqu.C(PolicyTblIDCol).In(ids)).
+			Executor().ExecContext(ctx)
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -753,6 +862,32 @@ func (s *dbStorage) updateRegexpFilters(namePattern, col string, whereExprs *[]e
 	return nil
 }
 
+// withTx runs the function in a transaction, which is retried if it fails with an error that the driver considers retryable.
+func (s *dbStorage) withTx(ctx context.Context, fn func(*goqu.TxDatabase) error) error {
+	for attempt := 1; ; attempt++ {
+		err := s.db.WithTx(fn)
+		if err == nil || s.opts.isRetryable == nil || !s.opts.isRetryable(err) || attempt == maxTxAttempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(time.Duration(attempt) * txRetryDelay):
+		}
+	}
+}
+
+// timestampValue converts the time to a value that can be compared with the timestamps set by the database.
+func (s *dbStorage) timestampValue(t time.Time) any {
+	// SQLite stores CURRENT_TIMESTAMP as UTC text, which doesn't compare correctly with the RFC3339 format used by goqu.
+	if s.db.Dialect() == "sqlite3" {
+		return t.UTC().Format(sqliteTimestampFormat)
+	}
+
+	return t.UTC()
+}
+
 func (s *dbStorage) regexpEnabled() bool {
 	// TODO(saml) link this to the `dialect` arg passed to `goqu` in the sqlserver package, or rethink how to indicate regexp support
 	return s.db.Dialect() != "sqlserver"
@@ -836,11 +971,552 @@ func (s *dbStorage) RepoStats(ctx context.Context) storage.RepoStats {
 	return stats
 }
 
-func (s *dbStorage) Reload(context.Context) error {
+func (s *dbStorage) Reload(ctx context.Context) error {
+	// pick up the changes made by other instances sharing the database.
+	if err := s.loadRevision(ctx); err != nil {
+		return err
+	}
+
 	s.NotifySubscribers(storage.NewReloadEvent())
 	return nil
 }
 
+// AsOf returns the state of the policies at the given revision ID or time.
+// Schemas are not versioned, so the current schemas are used.
+func (s *dbStorage) AsOf(ctx context.Context, point storage.HistoryPoint) (storage.SourceStore, string, error) {
+	var cond exp.Expression
+	if point.Revision != "" {
+		revID, err := strconv.ParseInt(point.Revision, 10, 64)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: invalid revision ID %q", storage.ErrRevisionNotFound, point.Revision)
+		}
+		cond = goqu.C(PolicyRevisionTblRevisionIDCol).Lte(revID)
+	} else {
+		cond = goqu.C(PolicyRevisionTblUpdateTimestamp).Lte(s.timestampValue(point.Time))
+	}
+
+	// the latest revision of each policy at the requested point is the state of that policy.
+	latestRevs := s.db.From(PolicyRevisionTbl).
+		Select(goqu.MAX(PolicyRevisionTblRevisionIDCol)).
+		Where(cond).
+		GroupBy(goqu.C(PolicyTblIDCol))
+
+	var revs []PolicyRevision
+	if err := s.db.From(PolicyRevisionTbl).
+		Select(
+			goqu.C(PolicyRevisionTblRevisionIDCol),
+			goqu.C(PolicyRevisionTblActionCol),
+			goqu.C(PolicyTblIDCol),
+			goqu.C(PolicyTblDisabledCol),
+			goqu.C(PolicyTblDefinitionCol),
+		).
+		Where(goqu.C(PolicyRevisionTblRevisionIDCol).In(latestRevs)).
+		ScanStructsContext(ctx, &revs); err != nil {
+		return nil, "", fmt.Errorf("could not execute %q query: %w", "AsOf", err)
+	}
+
+	var lastRevID int64
+	for _, rev := range revs {
+		if rev.RevisionID > lastRevID {
+			lastRevID = rev.RevisionID
+		}
+	}
+
+	if lastRevID == 0 {
+		return nil, "", fmt.Errorf("%w: no policy revisions found", storage.ErrRevisionNotFound)
+	}
+
+	fsys := afero.NewMemMapFs()
+	for _, rev := range revs {
+		if rev.Action == PolicyRevisionActionDelete || rev.Disabled || rev.Definition.Policy == nil {
+			continue
+		}
+
+		def, err := protojson.Marshal(rev.Definition.Policy)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to marshal policy %s: %w", namer.PolicyKey(rev.Definition.Policy), err)
+		}
+
+		if err := afero.WriteFile(fsys, namer.PolicyKey(rev.Definition.Policy)+".json", def, 0o644); err != nil { //nolint:gomnd
+			return nil, "", err
+		}
+	}
+
+	var schemas []Schema
+	if err := s.db.From(SchemaTbl).ScanStructsContext(ctx, &schemas); err != nil {
+		return nil, "", fmt.Errorf("failed to get schemas: %w", err)
+	}
+
+	for _, sch := range schemas {
+		def, err := json.Marshal(sch.Definition)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to marshal schema %s: %w", sch.ID, err)
+		}
+
+		if err := afero.WriteFile(fsys, path.Join(schema.Directory, sch.ID), def, 0o644); err != nil { //nolint:gomnd
+			return nil, "", err
+		}
+	}
+
+	idx, err := index.Build(ctx, afero.NewIOFS(fsys), index.WithMissingImportsAllowed())
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to build index for revision %d: %w", lastRevID, err)
+	}
+
+	return disk.NewFromIndexWithConf(idx, &disk.Conf{}), strconv.FormatInt(lastRevID, 10), nil
+}
+
+// ListPolicyVersions returns the revisions of the policy recorded by the database triggers, oldest first.
+func (s *dbStorage) ListPolicyVersions(ctx context.Context, policyKey string) ([]storage.PolicyVersion, error) {
+	var revs []PolicyRevision
+	if err := s.db.From(PolicyRevisionTbl).
+		Select(
+			goqu.C(PolicyRevisionTblRevisionIDCol),
+			goqu.C(PolicyRevisionTblActionCol),
+			goqu.C(PolicyRevisionTblUpdateTimestamp),
+			goqu.C(PolicyTblDisabledCol),
+		).
+		Where(goqu.C(PolicyTblIDCol).Eq(namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(policyKey)))).
+		Order(goqu.C(PolicyRevisionTblRevisionIDCol).Asc()).
+		ScanStructsContext(ctx, &revs); err != nil {
+		return nil, fmt.Errorf("could not execute %q query: %w", "ListPolicyVersions", err)
+	}
+
+	versions := make([]storage.PolicyVersion, len(revs))
+	for i, rev := range revs {
+		versions[i] = storage.PolicyVersion{
+			Timestamp: rev.Timestamp,
+			Version:   uint64(rev.RevisionID),
+			Change:    policyChange(rev.Action),
+			Disabled:  rev.Disabled,
+		}
+	}
+
+	return versions, nil
+}
+
+// GetPolicyVersion returns the definition of the policy recorded in the given revision.
+// For deletions, that is the definition of the policy that was deleted.
+func (s *dbStorage) GetPolicyVersion(ctx context.Context, policyKey string, version uint64) (*policyv1.Policy, error) {
+	rev, err := s.policyRevision(ctx, policyKey, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return rev.Definition.Policy, nil
+}
+
+// RollbackPolicy restores the definition and the disabled state that the policy had in the given revision.
+// The restored policy is recorded as a new revision, so rollbacks can be undone like any other change.
+func (s *dbStorage) RollbackPolicy(ctx context.Context, policyKey string, version uint64) (uint64, error) {
+	rev, err := s.policyRevision(ctx, policyKey, version)
+	if err != nil {
+		return 0, err
+	}
+
+	p := policy.Wrap(rev.Definition.Policy)
+	p.Disabled = rev.Disabled
+	if err := s.addOrUpdate(ctx, StoreEventActionRollbackPolicy, p); err != nil {
+		return 0, err
+	}
+
+	var latest int64
+	if _, err := s.db.From(PolicyRevisionTbl).
+		Select(goqu.MAX(PolicyRevisionTblRevisionIDCol)).
+		Where(goqu.C(PolicyTblIDCol).Eq(p.ID)).
+		ScanValContext(ctx, &latest); err != nil {
+		return 0, fmt.Errorf("could not execute %q query: %w", "RollbackPolicy", err)
+	}
+
+	return uint64(latest), nil
+}
+
+func (s *dbStorage) policyRevision(ctx context.Context, policyKey string, version uint64) (PolicyRevision, error) {
+	var rev PolicyRevision
+	found, err := s.db.From(PolicyRevisionTbl).
+		Select(
+			goqu.C(PolicyRevisionTblRevisionIDCol),
+			goqu.C(PolicyRevisionTblActionCol),
+			goqu.C(PolicyTblDisabledCol),
+			goqu.C(PolicyTblDefinitionCol),
+		).
+		Where(
+			goqu.C(PolicyTblIDCol).Eq(namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(policyKey))),
+			goqu.C(PolicyRevisionTblRevisionIDCol).Eq(int64(version)),
+		).
+		ScanStructContext(ctx, &rev)
+	if err != nil {
+		return rev, fmt.Errorf("could not execute %q query: %w", "GetPolicyVersion", err)
+	}
+
+	if !found || rev.Definition.Policy == nil {
+		return rev, fmt.Errorf("%w: policy %s has no version %d", storage.ErrRevisionNotFound, policyKey, version)
+	}
+
+	return rev, nil
+}
+
+// DeletePolicy deletes the given policies. Their definitions remain in the revision history recorded by the database
+// triggers, so they can be restored with RestorePolicy until they are purged at the end of the retention period.
+func (s *dbStorage) DeletePolicy(ctx context.Context, policyKey ...string) (uint32, error) {
+	mIDs := make([]namer.ModuleID, len(policyKey))
+	events := make([]storage.Event, len(policyKey))
+	for idx, pk := range policyKey {
+		mIDs[idx] = namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk))
+		events[idx] = storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, mIDs[idx])
+	}
+
+	if err := s.checkScopeChains(ctx, policyKey, mIDs); err != nil {
+		return 0, err
+	}
+
+	var affected int64
+	err := s.withStoreEvents(ctx, StoreEventActionDeletePolicy, mIDs, func(tx *goqu.TxDatabase) error {
+		res, err := tx.Delete(PolicyTbl).Prepared(true).
+			Where(goqu.C(PolicyTblIDCol).In(mIDs)).
+			Executor().ExecContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		affected, err = res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to discover whether the policies got deleted or not: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	s.NotifySubscribers(events...)
+	return uint32(affected), nil
+}
+
+// RestorePolicy adds back the given policies if they were deleted within the retention period, with the definition
+// and the disabled state they had when they were deleted. Policies that exist or were deleted earlier are ignored.
+func (s *dbStorage) RestorePolicy(ctx context.Context, policyKey ...string) (uint32, error) {
+	mIDs := make([]namer.ModuleID, len(policyKey))
+	for idx, pk := range policyKey {
+		mIDs[idx] = namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk))
+	}
+
+	cutoff := time.Now().Add(-s.opts.deletedPolicyRetention)
+	revs, err := s.deletedPolicies(ctx, goqu.C(PolicyTblIDCol).In(mIDs), goqu.C(PolicyRevisionTblUpdateTimestamp).Gte(s.timestampValue(cutoff)))
+	if err != nil {
+		return 0, err
+	}
+
+	policies := make([]policy.Wrapper, 0, len(revs))
+	for _, rev := range revs {
+		if rev.Definition.Policy == nil {
+			continue
+		}
+
+		p := policy.Wrap(rev.Definition.Policy)
+		p.Disabled = rev.Disabled
+		policies = append(policies, p)
+	}
+
+	if len(policies) == 0 {
+		return 0, nil
+	}
+
+	if err := s.addOrUpdate(ctx, StoreEventActionRestorePolicy, policies...); err != nil {
+		return 0, err
+	}
+
+	return uint32(len(policies)), nil
+}
+
+// PurgeDeletedPolicies removes the revision history of the policies that were deleted before the given time,
+// after which they can no longer be restored. It returns the number of policies that were purged.
+func (s *dbStorage) PurgeDeletedPolicies(ctx context.Context, before time.Time) (uint32, error) {
+	revs, err := s.deletedPolicies(ctx, goqu.C(PolicyRevisionTblUpdateTimestamp).Lt(s.timestampValue(before)))
+	if err != nil {
+		return 0, err
+	}
+
+	var purged uint32
+	for _, rev := range revs {
+		// revisions recorded after the deletion belong to a policy that was added again with the same ID.
+		if _, err := s.db.Delete(PolicyRevisionTbl).Prepared(true).
+			Where(
+				goqu.C(PolicyTblIDCol).Eq(rev.ID),
+				goqu.C(PolicyRevisionTblRevisionIDCol).Lte(rev.RevisionID),
+			).
+			Executor().ExecContext(ctx); err != nil {
+			return purged, fmt.Errorf("failed to purge policy %s: %w", rev.ID.String(), err)
+		}
+		purged++
+	}
+
+	return purged, nil
+}
+
+func (s *dbStorage) purgeDeletedPolicies(ctx context.Context) {
+	log := zap.L().Named("db")
+	ticker := time.NewTicker(deletedPolicyPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := s.PurgeDeletedPolicies(ctx, time.Now().Add(-s.opts.deletedPolicyRetention))
+		if err != nil {
+			log.Warn("Failed to purge deleted policies", zap.Error(err))
+		} else if purged > 0 {
+			log.Info("Purged deleted policies past their retention period", zap.Uint32("count", purged))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deletedPolicies returns the latest revisions of the policies matching the conditions, if those revisions are deletions.
+func (s *dbStorage) deletedPolicies(ctx context.Context, conds ...exp.Expression) ([]PolicyRevision, error) {
+	latestRevs := s.db.From(PolicyRevisionTbl).
+		Select(goqu.MAX(PolicyRevisionTblRevisionIDCol)).
+		GroupBy(goqu.C(PolicyTblIDCol))
+
+	var revs []PolicyRevision
+	if err := s.db.From(PolicyRevisionTbl).
+		Select(
+			goqu.C(PolicyRevisionTblRevisionIDCol),
+			goqu.C(PolicyTblIDCol),
+			goqu.C(PolicyTblDisabledCol),
+			goqu.C(PolicyTblDefinitionCol),
+		).
+		Where(append(conds,
+			goqu.C(PolicyRevisionTblRevisionIDCol).In(latestRevs),
+			goqu.C(PolicyRevisionTblActionCol).Eq(PolicyRevisionActionDelete),
+		)...).
+		ScanStructsContext(ctx, &revs); err != nil {
+		return nil, fmt.Errorf("could not execute %q query: %w", "DeletedPolicies", err)
+	}
+
+	return revs, nil
+}
+
+func policyChange(action string) storage.PolicyChange {
+	switch action {
+	case PolicyRevisionActionInsert:
+		return storage.PolicyCreated
+	case PolicyRevisionActionDelete:
+		return storage.PolicyDeleted
+	default:
+		return storage.PolicyUpdated
+	}
+}
+
+// withStoreEvents runs the function in a transaction and records an event in the store event table for each of the
+// given policies that the function changed, attributed to the actor in the context. The changes are detected from
+// the revisions recorded by the database triggers, so policies that the function didn't touch are not recorded.
+func (s *dbStorage) withStoreEvents(ctx context.Context, action string, ids []namer.ModuleID, fn func(*goqu.TxDatabase) error) error {
+	actor := storage.ActorFromContext(ctx)
+	var latest int64
+	err := s.withTx(ctx, func(tx *goqu.TxDatabase) error {
+		latest = 0
+		before, err := latestRevisionIDs(ctx, tx, ids)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(tx); err != nil {
+			return err
+		}
+
+		after, err := latestRevisionIDs(ctx, tx, ids)
+		if err != nil {
+			return err
+		}
+
+		var changed []int64
+		for id, revID := range after {
+			if revID > before[id] {
+				changed = append(changed, revID)
+			}
+		}
+
+		if len(changed) == 0 {
+			return nil
+		}
+
+		var revs []PolicyRevision
+		if err := tx.From(PolicyRevisionTbl).
+			Select(
+				goqu.C(PolicyRevisionTblRevisionIDCol),
+				goqu.C(PolicyTblIDCol),
+				goqu.C(PolicyTblDefinitionCol),
+			).
+			Where(goqu.C(PolicyRevisionTblRevisionIDCol).In(changed)).
+			Order(goqu.C(PolicyRevisionTblRevisionIDCol).Asc()).
+			ScanStructsContext(ctx, &revs); err != nil {
+			return fmt.Errorf("could not execute %q query: %w", "StoreEventRevisions", err)
+		}
+
+		storeEvents := make([]any, len(revs))
+		for i, rev := range revs {
+			target := rev.ID.String()
+			if rev.Definition.Policy != nil {
+				target = namer.PolicyKey(rev.Definition.Policy)
+			}
+
+			event := newStoreEvent(actor, action, target)
+			event.RevisionID = sql.NullInt64{Int64: rev.RevisionID, Valid: true}
+			storeEvents[i] = event
+
+			if rev.RevisionID > latest {
+				latest = rev.RevisionID
+			}
+		}
+
+		return insertStoreEvents(ctx, tx, storeEvents)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.advanceRevision(latest)
+	return nil
+}
+
+// latestRevisionIDs returns the ID of the latest revision recorded for each of the policies that have one.
+func latestRevisionIDs(ctx context.Context, tx *goqu.TxDatabase, ids []namer.ModuleID) (map[namer.ModuleID]int64, error) {
+	var rows []struct {
+		ID         namer.ModuleID `db:"id"`
+		RevisionID int64          `db:"revision_id"`
+	}
+	if err := tx.From(PolicyRevisionTbl).
+		Select(
+			goqu.C(PolicyTblIDCol),
+			goqu.MAX(PolicyRevisionTblRevisionIDCol).As(PolicyRevisionTblRevisionIDCol),
+		).
+		Where(goqu.C(PolicyTblIDCol).In(ids)).
+		GroupBy(goqu.C(PolicyTblIDCol)).
+		ScanStructsContext(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("could not execute %q query: %w", "LatestRevisionIDs", err)
+	}
+
+	out := make(map[namer.ModuleID]int64, len(rows))
+	for _, row := range rows {
+		out[row.ID] = row.RevisionID
+	}
+
+	return out, nil
+}
+
+func newStoreEvent(actor storage.Actor, action, target string) StoreEvent {
+	return StoreEvent{
+		Action:     action,
+		Target:     target,
+		Actor:      actor.User,
+		ClientCert: actor.ClientCert,
+	}
+}
+
+func insertStoreEvents(ctx context.Context, tx *goqu.TxDatabase, events []any) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	if _, err := tx.Insert(StoreEventTbl).
+		Prepared(true).
+		Rows(events...).
+		Executor().ExecContext(ctx); err != nil {
+		return fmt.Errorf("failed to record store events: %w", err)
+	}
+
+	return nil
+}
+
+// ListStoreEvents returns the changes recorded in the store event table, newest first.
+func (s *dbStorage) ListStoreEvents(ctx context.Context, params storage.ListStoreEventsParams) ([]storage.StoreEvent, error) {
+	query := s.db.From(StoreEventTbl).Order(goqu.C(StoreEventTblIDCol).Desc())
+	if params.Target != "" {
+		query = query.Where(goqu.C(StoreEventTblTargetCol).Eq(params.Target))
+	}
+
+	if params.Limit > 0 {
+		query = query.Limit(params.Limit)
+	}
+
+	var recs []StoreEvent
+	if err := query.ScanStructsContext(ctx, &recs); err != nil {
+		return nil, fmt.Errorf("could not execute %q query: %w", "ListStoreEvents", err)
+	}
+
+	events := make([]storage.StoreEvent, len(recs))
+	for i, rec := range recs {
+		events[i] = storage.StoreEvent{
+			Timestamp: rec.Timestamp,
+			Actor:     storage.Actor{User: rec.Actor, ClientCert: rec.ClientCert},
+			Target:    rec.Target,
+			ID:        uint64(rec.ID),
+			Revision:  uint64(rec.RevisionID.Int64),
+			Kind:      storeEventKind(rec.Action),
+		}
+	}
+
+	return events, nil
+}
+
+func storeEventKind(action string) storage.StoreEventKind {
+	switch action {
+	case StoreEventActionDisablePolicy:
+		return storage.StoreEventDisablePolicy
+	case StoreEventActionEnablePolicy:
+		return storage.StoreEventEnablePolicy
+	case StoreEventActionDeletePolicy:
+		return storage.StoreEventDeletePolicy
+	case StoreEventActionRestorePolicy:
+		return storage.StoreEventRestorePolicy
+	case StoreEventActionRollbackPolicy:
+		return storage.StoreEventRollbackPolicy
+	case StoreEventActionAddOrUpdateSchema:
+		return storage.StoreEventAddOrUpdateSchema
+	case StoreEventActionDeleteSchema:
+		return storage.StoreEventDeleteSchema
+	default:
+		return storage.StoreEventAddOrUpdatePolicy
+	}
+}
+
+// Revision returns the ID of the latest policy revision made or observed by this instance.
+// Decisions can be correlated with the store events that produced the policies through it.
+func (s *dbStorage) Revision() string {
+	if rev := s.revision.Load(); rev > 0 {
+		return strconv.FormatInt(rev, 10)
+	}
+
+	return ""
+}
+
+func (s *dbStorage) loadRevision(ctx context.Context) error {
+	var latest sql.NullInt64
+	if _, err := s.db.From(PolicyRevisionTbl).
+		Select(goqu.MAX(PolicyRevisionTblRevisionIDCol)).
+		ScanValContext(ctx, &latest); err != nil {
+		return fmt.Errorf("could not execute %q query: %w", "LatestRevision", err)
+	}
+
+	s.advanceRevision(latest.Int64)
+	return nil
+}
+
+// advanceRevision updates the revision unless a later one was recorded concurrently.
+func (s *dbStorage) advanceRevision(rev int64) {
+	for {
+		current := s.revision.Load()
+		if rev <= current || s.revision.CompareAndSwap(current, rev) {
+			return
+		}
+	}
+}
+
 // CheckSchema verifies the tables required by cerbos are available.
 func (s *dbStorage) CheckSchema(ctx context.Context) error {
 	logger := zap.L().Named("db")
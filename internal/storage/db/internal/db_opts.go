@@ -5,6 +5,7 @@ package internal
 
 import (
 	"context"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 
@@ -12,23 +13,39 @@ import (
 	"github.com/cerbos/cerbos/internal/util"
 )
 
+const (
+	// DefaultDeletedPolicyRetention is how long deleted policies can be restored if the retention period is not configured.
+	DefaultDeletedPolicyRetention = 30 * 24 * time.Hour
+	// DefaultReplicaReadDelay is how long lookups keep going to the primary after a change, to give the read replica time to catch up.
+	DefaultReplicaReadDelay = 5 * time.Second
+)
+
 // DBOpt defines database driver options.
 type DBOpt func(*dbOpt)
 
 type (
 	upsertPolicyFunc func(ctx context.Context, tx *goqu.TxDatabase, p policy.Wrapper) error
 	upsertSchemaFunc func(ctx context.Context, tx *goqu.TxDatabase, schema Schema) error
+	retryableErrFunc func(err error) bool
 )
 
 type dbOpt struct {
 	upsertPolicy upsertPolicyFunc
 	upsertSchema upsertSchemaFunc
+	isRetryable  retryableErrFunc
 	regexpCache  *util.RegexpCache
+	// replica is the database that serves the policy lookups of the engine, if there is one.
+	replica *goqu.Database
+	// deletedPolicyRetention is how long deleted policies are kept before they are purged.
+	deletedPolicyRetention time.Duration
+	// replicaReadDelay is how long lookups go to the primary after a change.
+	replicaReadDelay time.Duration
 }
 
 func newDbOpt() *dbOpt {
 	return &dbOpt{
-		regexpCache: util.NewRegexpCache(),
+		regexpCache:            util.NewRegexpCache(),
+		deletedPolicyRetention: DefaultDeletedPolicyRetention,
 	}
 }
 
@@ -46,6 +63,14 @@ func WithUpsertPolicy(f upsertPolicyFunc) DBOpt {
 	}
 }
 
+// WithTxRetries retries the transactions that fail with an error for which isRetryable returns true.
+// This is required for databases that abort conflicting transactions and expect the client to retry them.
+func WithTxRetries(isRetryable retryableErrFunc) DBOpt {
+	return func(opt *dbOpt) {
+		opt.isRetryable = isRetryable
+	}
+}
+
 // WithRegexpCacheOverride overrides the default regexp cache for DB queries requiring compiled expressions.
 // This is only required for DB drivers that require access to the cache (e.g. The SQLite driver retrieves the compiled
 // expressions for the application-defined function).
@@ -54,3 +79,23 @@ func WithRegexpCacheOverride(c *util.RegexpCache) DBOpt {
 		opt.regexpCache = c
 	}
 }
+
+// WithDeletedPolicyRetention sets how long deleted policies can be restored before they are purged.
+// The default retention period is used if d is not positive.
+func WithDeletedPolicyRetention(d time.Duration) DBOpt {
+	return func(opt *dbOpt) {
+		if d > 0 {
+			opt.deletedPolicyRetention = d
+		}
+	}
+}
+
+// WithReadReplica serves the policy and schema lookups of the engine from a read replica. Everything else, including all
+// writes and the reads of the Admin API, goes to the primary. Lookups also go to the primary for readDelay after a change
+// is made through the store, so that the engine doesn't cache policies that haven't been replicated yet.
+func WithReadReplica(replica *goqu.Database, readDelay time.Duration) DBOpt {
+	return func(opt *dbOpt) {
+		opt.replica = replica
+		opt.replicaReadDelay = readDelay
+	}
+}
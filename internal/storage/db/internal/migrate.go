@@ -0,0 +1,117 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+
+	migrate "github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+// MigrationsDir is the directory of the embedded filesystem that contains the migrations of a driver.
+const MigrationsDir = "migrations"
+
+// Migration is a versioned change to the database schema.
+type Migration struct {
+	Name    string
+	SQL     string
+	Version uint
+}
+
+func (m Migration) String() string {
+	return fmt.Sprintf("%d_%s", m.Version, m.Name)
+}
+
+// Migrate applies the migrations in the filesystem that haven't been applied to the database yet, and writes the
+// ones it applies to out. If dryRun is true, it writes the SQL of the pending migrations to out instead of applying them.
+func Migrate(fsys fs.FS, dbName string, db database.Driver, out io.Writer, dryRun bool) error {
+	src, err := iofs.New(fsys, MigrationsDir)
+	if err != nil {
+		return fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	pending, err := pendingMigrations(src, db)
+	if err != nil {
+		return err
+	}
+
+	if len(pending) == 0 {
+		fmt.Fprintln(out, "Database schema is up to date")
+		return nil
+	}
+
+	if dryRun {
+		for _, mig := range pending {
+			fmt.Fprintf(out, "-- %s\n%s\n", mig, mig.SQL)
+		}
+		return nil
+	}
+
+	m, err := migrate.NewWithInstance("iofs", src, dbName, db)
+	if err != nil {
+		return fmt.Errorf("failed to initialize migrations: %w", err)
+	}
+
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
+	for _, mig := range pending {
+		fmt.Fprintf(out, "Applied migration %s\n", mig)
+	}
+
+	return nil
+}
+
+func pendingMigrations(src source.Driver, db database.Driver) ([]Migration, error) {
+	current, dirty, err := db.Version()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema version: %w", err)
+	}
+
+	if dirty {
+		return nil, fmt.Errorf("migration %d failed previously and left the database in an inconsistent state: fix the schema manually before retrying", current)
+	}
+
+	var pending []Migration
+	version, err := src.First()
+	for err == nil {
+		if int(version) > current {
+			mig, err := readMigration(src, version)
+			if err != nil {
+				return nil, err
+			}
+			pending = append(pending, mig)
+		}
+
+		version, err = src.Next(version)
+	}
+
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
+	}
+
+	return pending, nil
+}
+
+func readMigration(src source.Driver, version uint) (Migration, error) {
+	r, name, err := src.ReadUp(version)
+	if err != nil {
+		return Migration{}, fmt.Errorf("failed to read migration %d: %w", version, err)
+	}
+	defer r.Close()
+
+	sql, err := io.ReadAll(r)
+	if err != nil {
+		return Migration{}, fmt.Errorf("failed to read migration %d: %w", version, err)
+	}
+
+	return Migration{Version: version, Name: name, SQL: string(sql)}, nil
+}
@@ -4,6 +4,7 @@
 package internal
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"fmt"
 	"time"
@@ -33,14 +34,35 @@ const (
 	PolicyAncestorTblPolicyIDCol   = "policy_id"
 	PolicyAncestorTblAncestorIDCol = "ancestor_id"
 
-	PolicyRevisionTbl = "policy_revision"
+	PolicyRevisionTbl                = "policy_revision"
+	PolicyRevisionTblRevisionIDCol   = "revision_id"
+	PolicyRevisionTblActionCol       = "action"
+	PolicyRevisionTblUpdateTimestamp = "update_timestamp"
+
+	PolicyRevisionActionInsert = "INSERT"
+	PolicyRevisionActionDelete = "DELETE"
 
 	SchemaTbl              = "attr_schema_defs"
 	SchemaTblIDCol         = "id"
 	SchemaTblDefinitionCol = "definition"
+
+	StoreEventTbl             = "store_event"
+	StoreEventTblIDCol        = "id"
+	StoreEventTblTargetCol    = "target"
+	StoreEventTblRevisionCol  = "revision_id"
+	StoreEventTblTimestampCol = "event_timestamp"
+
+	StoreEventActionAddOrUpdatePolicy = "ADD_OR_UPDATE_POLICY"
+	StoreEventActionDisablePolicy     = "DISABLE_POLICY"
+	StoreEventActionEnablePolicy      = "ENABLE_POLICY"
+	StoreEventActionDeletePolicy      = "DELETE_POLICY"
+	StoreEventActionRestorePolicy     = "RESTORE_POLICY"
+	StoreEventActionRollbackPolicy    = "ROLLBACK_POLICY"
+	StoreEventActionAddOrUpdateSchema = "ADD_OR_UPDATE_SCHEMA"
+	StoreEventActionDeleteSchema      = "DELETE_SCHEMA"
 )
 
-var requiredTables = []string{PolicyTbl, PolicyDepTbl, PolicyAncestorTbl, PolicyRevisionTbl, SchemaTbl}
+var requiredTables = []string{PolicyTbl, PolicyDepTbl, PolicyAncestorTbl, PolicyRevisionTbl, SchemaTbl, StoreEventTbl}
 
 type Schema struct {
 	Definition *pgtype.JSON
@@ -115,3 +137,13 @@ type PolicyCount struct {
 	Kind  string
 	Count int
 }
+
+type StoreEvent struct {
+	Timestamp  time.Time `db:"event_timestamp" goqu:"skipinsert"`
+	Action     string
+	Target     string
+	Actor      string
+	ClientCert string        `db:"client_cert"`
+	RevisionID sql.NullInt64 `db:"revision_id"`
+	ID         int64         `goqu:"skipinsert"`
+}
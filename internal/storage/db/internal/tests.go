@@ -9,6 +9,7 @@ package internal
 import (
 	"context"
 	"io"
+	"strconv"
 	"testing"
 	"time"
 
@@ -21,13 +22,21 @@ import (
 	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/policy"
 	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/db"
 	"github.com/cerbos/cerbos/internal/test"
 )
 
 const timeout = 2 * time.Second
 
+// SuiteStore is the set of operations exercised by TestSuite.
+type SuiteStore interface {
+	storage.SourceStore
+	storage.MutableStore
+	storage.Instrumented
+}
+
 //nolint:gomnd
-func TestSuite(store DBStorage) func(*testing.T) {
+func TestSuite(store SuiteStore) func(*testing.T) {
 	//nolint:thelper
 	return func(t *testing.T) {
 		ctx, cancelFunc := context.WithCancel(context.Background())
@@ -275,6 +284,156 @@ func TestSuite(store DBStorage) func(*testing.T) {
 			checkEvents(t, timeout, storage.Event{Kind: storage.EventDeleteOrDisablePolicy, PolicyID: rpx.ID})
 		})
 
+		t.Run("as_of", func(t *testing.T) {
+			historical, ok := store.(storage.Historical)
+			if !ok {
+				t.Skip("Store does not keep a history of its contents")
+			}
+
+			requirePolicies := func(t *testing.T, snapshot storage.SourceStore, present bool, policies ...policy.Wrapper) {
+				t.Helper()
+
+				for _, p := range policies {
+					have, err := snapshot.GetCompilationUnits(ctx, p.ID)
+					require.NoError(t, err)
+					if present {
+						require.Len(t, have, 1)
+					} else {
+						require.Empty(t, have)
+					}
+				}
+			}
+
+			snapshot, revision, err := historical.AsOf(ctx, storage.HistoryPoint{Time: time.Now().Add(1 * time.Minute)})
+			require.NoError(t, err)
+			requirePolicies(t, snapshot, true, rp, pp, rpAcmeHRUK)
+			requirePolicies(t, snapshot, false, rpx)
+
+			revID, err := strconv.ParseInt(revision, 10, 64)
+			require.NoError(t, err)
+
+			snapshot, prevRevision, err := historical.AsOf(ctx, storage.HistoryPoint{Revision: strconv.FormatInt(revID-1, 10)})
+			require.NoError(t, err)
+			require.Equal(t, strconv.FormatInt(revID-1, 10), prevRevision)
+			requirePolicies(t, snapshot, true, rp, pp, rpAcmeHRUK, rpx)
+
+			_, _, err = historical.AsOf(ctx, storage.HistoryPoint{Time: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)})
+			require.ErrorIs(t, err, storage.ErrRevisionNotFound)
+
+			// all revisions were created by this test, so none of them existed a minute ago
+			_, _, err = historical.AsOf(ctx, storage.HistoryPoint{Time: time.Now().Add(-1 * time.Minute)})
+			require.ErrorIs(t, err, storage.ErrRevisionNotFound)
+
+			_, _, err = historical.AsOf(ctx, storage.HistoryPoint{Revision: "not_a_number"})
+			require.ErrorIs(t, err, storage.ErrRevisionNotFound)
+		})
+
+		t.Run("policy_versions", func(t *testing.T) {
+			versioned, ok := store.(storage.Versioned)
+			if !ok {
+				t.Skip("Store does not keep the versions of policies")
+			}
+
+			rpxKey := namer.PolicyKey(rpx.Policy)
+			versions, err := versioned.ListPolicyVersions(ctx, rpxKey)
+			require.NoError(t, err)
+			require.Len(t, versions, 2)
+			require.Equal(t, storage.PolicyCreated, versions[0].Change)
+			require.Equal(t, storage.PolicyDeleted, versions[1].Change)
+			require.Less(t, versions[0].Version, versions[1].Version)
+			require.False(t, versions[0].Timestamp.IsZero())
+
+			have, err := versioned.GetPolicyVersion(ctx, rpxKey, versions[0].Version)
+			require.NoError(t, err)
+			require.Empty(t, cmp.Diff(rpx.Policy, have, protocmp.Transform()))
+
+			_, err = versioned.GetPolicyVersion(ctx, namer.PolicyKey(rp.Policy), versions[0].Version)
+			require.ErrorIs(t, err, storage.ErrRevisionNotFound)
+
+			checkEvents := storage.TestSubscription(store)
+			newVersion, err := versioned.RollbackPolicy(ctx, rpxKey, versions[0].Version)
+			require.NoError(t, err)
+			require.Greater(t, newVersion, versions[1].Version)
+			checkEvents(t, timeout, storage.Event{Kind: storage.EventAddOrUpdatePolicy, PolicyID: rpx.ID})
+
+			units, err := store.GetCompilationUnits(ctx, rpx.ID)
+			require.NoError(t, err)
+			require.Len(t, units, 1)
+
+			versions, err = versioned.ListPolicyVersions(ctx, rpxKey)
+			require.NoError(t, err)
+			require.Len(t, versions, 3)
+			require.Equal(t, newVersion, versions[2].Version)
+
+			_, err = versioned.RollbackPolicy(ctx, rpxKey, newVersion+1)
+			require.ErrorIs(t, err, storage.ErrRevisionNotFound)
+		})
+
+		t.Run("soft_delete", func(t *testing.T) {
+			restorable, ok := store.(storage.Restorable)
+			if !ok {
+				t.Skip("Store does not support restoring deleted policies")
+			}
+
+			rpxKey := namer.PolicyKey(rpx.Policy)
+			checkEvents := storage.TestSubscription(store)
+			deleted, err := restorable.DeletePolicy(ctx, rpxKey)
+			require.NoError(t, err)
+			require.Equal(t, uint32(1), deleted)
+			checkEvents(t, timeout, storage.Event{Kind: storage.EventDeleteOrDisablePolicy, PolicyID: rpx.ID})
+
+			units, err := store.GetCompilationUnits(ctx, rpx.ID)
+			require.NoError(t, err)
+			require.Empty(t, units)
+
+			checkEvents = storage.TestSubscription(store)
+			restored, err := restorable.RestorePolicy(ctx, rpxKey)
+			require.NoError(t, err)
+			require.Equal(t, uint32(1), restored)
+			checkEvents(t, timeout, storage.Event{Kind: storage.EventAddOrUpdatePolicy, PolicyID: rpx.ID})
+
+			units, err = store.GetCompilationUnits(ctx, rpx.ID)
+			require.NoError(t, err)
+			require.Len(t, units, 1)
+
+			restored, err = restorable.RestorePolicy(ctx, rpxKey)
+			require.NoError(t, err)
+			require.Equal(t, uint32(0), restored, "Policies that exist should not be restored")
+
+			_, err = restorable.DeletePolicy(ctx, namer.PolicyKey(rpAcme.Policy))
+			require.ErrorAs(t, err, &db.ErrBreaksScopeChain{})
+
+			purger, ok := store.(interface {
+				PurgeDeletedPolicies(context.Context, time.Time) (uint32, error)
+			})
+			if !ok {
+				return
+			}
+
+			checkEvents = storage.TestSubscription(store)
+			_, err = restorable.DeletePolicy(ctx, rpxKey)
+			require.NoError(t, err)
+			checkEvents(t, timeout, storage.Event{Kind: storage.EventDeleteOrDisablePolicy, PolicyID: rpx.ID})
+
+			purged, err := purger.PurgeDeletedPolicies(ctx, time.Now().Add(-time.Hour))
+			require.NoError(t, err)
+			require.Equal(t, uint32(0), purged, "Policies deleted within the retention period should not be purged")
+
+			purged, err = purger.PurgeDeletedPolicies(ctx, time.Now().Add(time.Hour))
+			require.NoError(t, err)
+			require.Equal(t, uint32(1), purged)
+
+			restored, err = restorable.RestorePolicy(ctx, rpxKey)
+			require.NoError(t, err)
+			require.Equal(t, uint32(0), restored, "Purged policies should not be restored")
+
+			if versioned, ok := store.(storage.Versioned); ok {
+				versions, err := versioned.ListPolicyVersions(ctx, rpxKey)
+				require.NoError(t, err)
+				require.Empty(t, versions)
+			}
+		})
+
 		t.Run("add_schema", func(t *testing.T) {
 			checkEvents := storage.TestSubscription(store)
 			require.NoError(t, store.AddOrUpdateSchema(ctx, &schemav1.Schema{Id: schID, Definition: sch}))
@@ -310,6 +469,58 @@ func TestSuite(store DBStorage) func(*testing.T) {
 
 			checkEvents(t, timeout, storage.NewSchemaEvent(storage.EventDeleteSchema, schID))
 		})
+
+		t.Run("store_events", func(t *testing.T) {
+			audited, ok := store.(storage.Audited)
+			if !ok {
+				t.Skip("Store does not record the changes made to it")
+			}
+
+			events, err := audited.ListStoreEvents(ctx, storage.ListStoreEventsParams{Limit: 2})
+			require.NoError(t, err)
+			require.Len(t, events, 2)
+			require.Equal(t, storage.StoreEventDeleteSchema, events[0].Kind)
+			require.Equal(t, schID, events[0].Target)
+			require.Zero(t, events[0].Revision)
+			require.Equal(t, storage.StoreEventAddOrUpdateSchema, events[1].Kind)
+			require.Greater(t, events[0].ID, events[1].ID)
+			require.False(t, events[0].Timestamp.IsZero())
+
+			actor := storage.Actor{User: "cerbos", ClientCert: "CN=admin"}
+			actorCtx := storage.NewContextWithActor(ctx, actor)
+			key := namer.PolicyKey(rpAcmeHRUK.Policy)
+
+			_, err = store.Disable(actorCtx, key)
+			require.NoError(t, err)
+			_, err = store.Enable(actorCtx, key)
+			require.NoError(t, err)
+
+			events, err = audited.ListStoreEvents(ctx, storage.ListStoreEventsParams{Target: key})
+			require.NoError(t, err)
+			require.Len(t, events, 3)
+			require.Equal(t, storage.StoreEventEnablePolicy, events[0].Kind)
+			require.Equal(t, storage.StoreEventDisablePolicy, events[1].Kind)
+			require.Equal(t, storage.StoreEventAddOrUpdatePolicy, events[2].Kind)
+			require.Equal(t, actor, events[0].Actor)
+			require.Equal(t, actor, events[1].Actor)
+			require.Equal(t, storage.Actor{}, events[2].Actor)
+			require.Greater(t, events[0].Revision, events[1].Revision)
+			for _, event := range events {
+				require.Equal(t, key, event.Target)
+			}
+
+			if revisioned, ok := store.(storage.Revisioned); ok {
+				require.Equal(t, strconv.FormatUint(events[0].Revision, 10), revisioned.Revision())
+			}
+
+			// only the policies that exist are changed, so nothing is recorded for the others.
+			_, err = store.Disable(actorCtx, "resource.does_not_exist.vdefault")
+			require.NoError(t, err)
+
+			events, err = audited.ListStoreEvents(ctx, storage.ListStoreEventsParams{Target: "resource.does_not_exist.vdefault"})
+			require.NoError(t, err)
+			require.Empty(t, events)
+		})
 	}
 }
 
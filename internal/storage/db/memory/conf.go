@@ -0,0 +1,46 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"errors"
+
+	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+const (
+	confKey             = storage.ConfKey + ".memory"
+	defaultCompactAfter = 1000
+)
+
+// Conf is required (if driver is set to 'memory') configuration for the in-memory driver.
+// +desc=This section is required only if storage.driver is memory.
+type Conf struct {
+	// Path is the path to the write-ahead log file that the changes are persisted to. The file is created if it doesn't exist and replayed on start.
+	Path string `yaml:"path" conf:"required,example=/var/lib/cerbos/store.wal"`
+	// CompactAfter is the number of changes recorded in the log after which it's rewritten to contain only the current contents of the store. Defaults to 1000.
+	CompactAfter int `yaml:"compactAfter" conf:",example=1000"`
+}
+
+func (c *Conf) Key() string {
+	return confKey
+}
+
+func (c *Conf) SetDefaults() {
+	c.CompactAfter = defaultCompactAfter
+}
+
+func (c *Conf) Validate() (errs error) {
+	if c.Path == "" {
+		errs = multierr.Append(errs, errors.New("path is required"))
+	}
+
+	if c.CompactAfter <= 0 {
+		errs = multierr.Append(errs, errors.New("compactAfter must be positive"))
+	}
+
+	return errs
+}
@@ -0,0 +1,633 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+
+	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/observability/logging"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/db"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const DriverName = "memory"
+
+var (
+	_ storage.SourceStore  = (*Store)(nil)
+	_ storage.MutableStore = (*Store)(nil)
+	_ storage.Instrumented = (*Store)(nil)
+	_ storage.Reloadable   = (*Store)(nil)
+	_ io.Closer            = (*Store)(nil)
+)
+
+func init() {
+	storage.RegisterDriver(DriverName, func(ctx context.Context, confW *config.Wrapper) (storage.Store, error) {
+		conf := new(Conf)
+		if err := confW.GetSection(conf); err != nil {
+			return nil, err
+		}
+
+		return NewStore(ctx, conf)
+	})
+}
+
+// Store keeps the policies and schemas in memory and persists the changes made to them to a write-ahead log,
+// which is replayed when the store is created.
+type Store struct {
+	wal          *wal
+	policies     map[uint64]*policyRecord
+	schemas      map[string]json.RawMessage
+	regexpCache  *util.RegexpCache
+	log          *zap.Logger
+	compactAfter int
+	mu           sync.RWMutex
+	*storage.SubscriptionManager
+}
+
+func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
+	log := logging.FromContext(ctx).Named("memory")
+	log.Info("Initializing in-memory storage", zap.String("path", conf.Path))
+
+	s := &Store{
+		policies:            make(map[uint64]*policyRecord),
+		schemas:             make(map[string]json.RawMessage),
+		regexpCache:         util.NewRegexpCache(),
+		log:                 log,
+		compactAfter:        conf.CompactAfter,
+		SubscriptionManager: storage.NewSubscriptionManager(ctx),
+	}
+
+	w, err := openWAL(conf.Path, log, s.apply)
+	if err != nil {
+		return nil, err
+	}
+
+	s.wal = w
+	log.Info("Replayed write-ahead log", zap.Int("entries", w.entries), zap.Int("policies", len(s.policies)), zap.Int("schemas", len(s.schemas)))
+	s.compactIfNeeded()
+
+	return s, nil
+}
+
+func (s *Store) Driver() string {
+	return DriverName
+}
+
+// Close closes the write-ahead log.
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.wal.close()
+}
+
+func (s *Store) AddOrUpdate(_ context.Context, policies ...policy.Wrapper) error {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	entry := &logEntry{PutPolicies: make(map[uint64]*policyRecord, len(policies))}
+	events := make([]storage.Event, len(policies))
+	for i, p := range policies {
+		def, err := p.Policy.MarshalVT()
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s: %w", p.FQN, err)
+		}
+
+		entry.PutPolicies[rawID(p.ID)] = &policyRecord{
+			Kind:         p.Kind.String(),
+			Name:         p.Name,
+			Version:      p.Version,
+			Scope:        p.Scope,
+			Description:  p.Description,
+			Disabled:     p.Disabled,
+			Definition:   def,
+			Dependencies: rawIDs(p.Dependencies()),
+			Ancestors:    rawIDs(policy.Ancestors(p.Policy)),
+		}
+		events[i] = storage.Event{Kind: storage.EventAddOrUpdatePolicy, PolicyID: p.ID}
+	}
+
+	s.mu.Lock()
+	err := s.commit(entry)
+	s.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to upsert policies: %w", err)
+	}
+
+	_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
+		tag.Upsert(metrics.KeyIndexCRUDKind, "upsert"),
+	}, metrics.IndexCRUDCount.M(int64(len(policies))))
+
+	s.NotifySubscribers(events...)
+	return nil
+}
+
+func (s *Store) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID) (*policy.CompilationUnit, error) {
+	results, err := s.GetCompilationUnits(ctx, candidates...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range candidates {
+		if cu, ok := results[id]; ok {
+			return cu, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Store) GetCompilationUnits(_ context.Context, ids ...namer.ModuleID) (map[namer.ModuleID]*policy.CompilationUnit, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	units := make(map[namer.ModuleID]*policy.CompilationUnit)
+	for _, id := range ids {
+		root := s.enabledPolicy(rawID(id))
+		if root == nil {
+			continue
+		}
+
+		unit := &policy.CompilationUnit{ModID: id}
+		var add func(uint64, *policyRecord) error
+		add = func(id uint64, r *policyRecord) error {
+			modID := moduleID(id)
+			if _, ok := unit.Definitions[modID]; ok {
+				return nil
+			}
+
+			p, err := r.policy()
+			if err != nil {
+				return err
+			}
+
+			unit.AddDefinition(modID, p)
+			for _, dep := range r.Dependencies {
+				if depRecord := s.enabledPolicy(dep); depRecord != nil {
+					if err := add(dep, depRecord); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		}
+
+		if err := add(rawID(id), root); err != nil {
+			return nil, err
+		}
+
+		for _, ancestor := range root.Ancestors {
+			if ancestorRecord := s.enabledPolicy(ancestor); ancestorRecord != nil {
+				if err := add(ancestor, ancestorRecord); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		units[id] = unit
+	}
+
+	return units, nil
+}
+
+func (s *Store) GetDependents(_ context.Context, ids ...namer.ModuleID) (map[namer.ModuleID][]namer.ModuleID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	dependents := make(map[uint64][]uint64)
+	for id, r := range s.policies {
+		for _, dep := range r.Dependencies {
+			dependents[dep] = append(dependents[dep], id)
+		}
+	}
+
+	// There's a maximum of two levels of dependency (resourcePolicy -> derivedRoles -> exportVariables),
+	// so the dependents are the direct dependents and the dependents of the direct dependents.
+	out := make(map[namer.ModuleID][]namer.ModuleID, len(ids))
+	for _, id := range ids {
+		seen := make(map[uint64]struct{})
+		for _, direct := range dependents[rawID(id)] {
+			seen[direct] = struct{}{}
+			for _, indirect := range dependents[direct] {
+				seen[indirect] = struct{}{}
+			}
+		}
+
+		if len(seen) == 0 {
+			continue
+		}
+
+		deps := make([]namer.ModuleID, 0, len(seen))
+		for dep := range seen {
+			deps = append(deps, moduleID(dep))
+		}
+
+		out[id] = deps
+	}
+
+	return out, nil
+}
+
+func (s *Store) LoadPolicy(_ context.Context, policyKey ...string) ([]*policy.Wrapper, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	policies := make([]*policy.Wrapper, 0, len(policyKey))
+	for _, pk := range policyKey {
+		r, ok := s.policies[rawID(namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk)))]
+		if !ok {
+			continue
+		}
+
+		p, err := r.policy()
+		if err != nil {
+			return nil, err
+		}
+
+		wp := policy.Wrap(policy.WithMetadata(p, "", nil, namer.PolicyKey(p)))
+		wp.Disabled = r.Disabled
+		policies = append(policies, &wp)
+	}
+
+	return policies, nil
+}
+
+func (s *Store) ListPolicyIDs(_ context.Context, params storage.ListPolicyIDsParams) ([]string, error) {
+	var filters []regexpFilter
+	for _, f := range []struct {
+		pattern string
+		get     func(namer.PolicyCoords) string
+	}{
+		{pattern: params.NameRegexp, get: func(pc namer.PolicyCoords) string { return pc.Name }},
+		{pattern: params.ScopeRegexp, get: func(pc namer.PolicyCoords) string { return pc.Scope }},
+		{pattern: params.VersionRegexp, get: func(pc namer.PolicyCoords) string { return pc.Version }},
+	} {
+		if f.pattern == "" {
+			continue
+		}
+
+		re, err := s.regexpCache.GetCompiledExpr(f.pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, regexpFilter{match: re.MatchString, get: f.get})
+	}
+
+	s.mu.RLock()
+	coords := make([]namer.PolicyCoords, 0, len(s.policies))
+outer:
+	for _, r := range s.policies {
+		if r.Disabled && !params.IncludeDisabled {
+			continue
+		}
+
+		pc := r.coords()
+		for _, f := range filters {
+			if !f.match(f.get(pc)) {
+				continue outer
+			}
+		}
+
+		coords = append(coords, pc)
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(coords, func(i, j int) bool {
+		a, b := coords[i], coords[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+
+		if a.Version != b.Version {
+			return a.Version < b.Version
+		}
+
+		return a.Scope < b.Scope
+	})
+
+	policyIDs := make([]string, len(coords))
+	for i, pc := range coords {
+		policyIDs[i] = pc.PolicyKey()
+	}
+
+	return policyIDs, nil
+}
+
+func (s *Store) Disable(_ context.Context, policyKey ...string) (uint32, error) {
+	ids := make([]uint64, len(policyKey))
+	events := make([]storage.Event, len(policyKey))
+	for i, pk := range policyKey {
+		modID := namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk))
+		ids[i] = rawID(modID)
+		events[i] = storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, modID)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Disabling a scoped policy that has enabled descendants would break the scope chain.
+	hasDescendants := make(map[uint64]struct{})
+	for _, r := range s.policies {
+		if r.Disabled {
+			continue
+		}
+
+		for _, a := range r.Ancestors {
+			hasDescendants[a] = struct{}{}
+		}
+	}
+
+	var brokenChainPolicies []string
+	for i, pk := range policyKey {
+		if _, ok := hasDescendants[ids[i]]; ok {
+			brokenChainPolicies = append(brokenChainPolicies, pk)
+		}
+	}
+
+	if len(brokenChainPolicies) > 0 {
+		return 0, db.ErrBreaksScopeChain{PolicyKeys: brokenChainPolicies}
+	}
+
+	count, err := s.setDisabled(ids, true)
+	if err != nil {
+		return 0, fmt.Errorf("failed to disable policies: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return count, nil
+}
+
+func (s *Store) Enable(_ context.Context, policyKey ...string) (uint32, error) {
+	ids := make([]uint64, len(policyKey))
+	events := make([]storage.Event, len(policyKey))
+	for i, pk := range policyKey {
+		modID := namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk))
+		ids[i] = rawID(modID)
+		events[i] = storage.NewPolicyEvent(storage.EventAddOrUpdatePolicy, modID)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count, err := s.setDisabled(ids, false)
+	if err != nil {
+		return 0, fmt.Errorf("failed to enable policies: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return count, nil
+}
+
+// setDisabled updates the disabled flag of the given policies and returns the number of policies that exist.
+// It must be called with the lock held.
+func (s *Store) setDisabled(ids []uint64, disabled bool) (uint32, error) {
+	entry := &logEntry{PutPolicies: make(map[uint64]*policyRecord, len(ids))}
+	for _, id := range ids {
+		r, ok := s.policies[id]
+		if !ok {
+			continue
+		}
+
+		// the record is copied so that the in-memory state only changes once the entry is persisted
+		updated := *r
+		updated.Disabled = disabled
+		entry.PutPolicies[id] = &updated
+	}
+
+	if len(entry.PutPolicies) == 0 {
+		return 0, nil
+	}
+
+	if err := s.commit(entry); err != nil {
+		return 0, err
+	}
+
+	return uint32(len(entry.PutPolicies)), nil
+}
+
+func (s *Store) Delete(_ context.Context, ids ...namer.ModuleID) error {
+	events := make([]storage.Event, len(ids))
+	for i, id := range ids {
+		events[i] = storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, id)
+	}
+
+	s.mu.Lock()
+	entry := &logEntry{}
+	for _, id := range rawIDs(ids) {
+		if _, ok := s.policies[id]; ok {
+			entry.DeletePolicies = append(entry.DeletePolicies, id)
+		}
+	}
+
+	var err error
+	if len(entry.DeletePolicies) > 0 {
+		err = s.commit(entry)
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		return fmt.Errorf("failed to delete policies: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return nil
+}
+
+func (s *Store) AddOrUpdateSchema(_ context.Context, schemas ...*schemav1.Schema) error {
+	if len(schemas) == 0 {
+		return nil
+	}
+
+	entry := &logEntry{PutSchemas: make(map[string]json.RawMessage, len(schemas))}
+	events := make([]storage.Event, len(schemas))
+	for i, sch := range schemas {
+		var def json.RawMessage
+		if err := json.Unmarshal(sch.Definition, &def); err != nil {
+			return storage.NewInvalidSchemaError(err, "schema definition with ID %q is not valid", sch.Id)
+		}
+
+		entry.PutSchemas[sch.Id] = def
+		events[i] = storage.NewSchemaEvent(storage.EventAddOrUpdateSchema, sch.Id)
+	}
+
+	s.mu.Lock()
+	err := s.commit(entry)
+	s.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to upsert schemas: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return nil
+}
+
+func (s *Store) DeleteSchema(_ context.Context, ids ...string) (uint32, error) {
+	events := make([]storage.Event, len(ids))
+	for i, id := range ids {
+		events[i] = storage.NewSchemaEvent(storage.EventDeleteSchema, id)
+	}
+
+	s.mu.Lock()
+	entry := &logEntry{}
+	for _, id := range ids {
+		if _, ok := s.schemas[id]; ok {
+			entry.DeleteSchemas = append(entry.DeleteSchemas, id)
+		}
+	}
+
+	var err error
+	if len(entry.DeleteSchemas) > 0 {
+		err = s.commit(entry)
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete schema(s): %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return uint32(len(entry.DeleteSchemas)), nil
+}
+
+func (s *Store) ListSchemaIDs(_ context.Context) ([]string, error) {
+	s.mu.RLock()
+	schemaIDs := make([]string, 0, len(s.schemas))
+	for id := range s.schemas {
+		schemaIDs = append(schemaIDs, id)
+	}
+	s.mu.RUnlock()
+
+	sort.Strings(schemaIDs)
+	return schemaIDs, nil
+}
+
+func (s *Store) LoadSchema(_ context.Context, urlVar string) (io.ReadCloser, error) {
+	u, err := url.Parse(urlVar)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != "" && u.Scheme != schema.URLScheme {
+		return nil, fmt.Errorf("invalid url scheme %q", u.Scheme)
+	}
+
+	s.mu.RLock()
+	def, ok := s.schemas[strings.TrimPrefix(u.Path, "/")]
+	s.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("failed to find schema")
+	}
+
+	return io.NopCloser(strings.NewReader(string(def))), nil
+}
+
+func (s *Store) RepoStats(context.Context) storage.RepoStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats := storage.RepoStats{PolicyCount: make(map[policy.Kind]int), SchemaCount: len(s.schemas)}
+	for _, r := range s.policies {
+		switch r.Kind {
+		case policy.DerivedRolesKindStr:
+			stats.PolicyCount[policy.DerivedRolesKind]++
+		case policy.ExportVariablesKindStr:
+			stats.PolicyCount[policy.ExportVariablesKind]++
+		case policy.PrincipalKindStr:
+			stats.PolicyCount[policy.PrincipalKind]++
+		case policy.ResourceKindStr:
+			stats.PolicyCount[policy.ResourceKind]++
+		}
+	}
+
+	return stats
+}
+
+func (s *Store) Reload(context.Context) error {
+	s.NotifySubscribers(storage.NewReloadEvent())
+	return nil
+}
+
+// commit appends the entry to the write-ahead log and applies it to the in-memory state once it's persisted.
+// It must be called with the lock held.
+func (s *Store) commit(entry *logEntry) error {
+	if err := s.wal.append(entry); err != nil {
+		return err
+	}
+
+	s.apply(entry)
+	s.compactIfNeeded()
+	return nil
+}
+
+func (s *Store) apply(entry *logEntry) {
+	for id, r := range entry.PutPolicies {
+		s.policies[id] = r
+	}
+
+	for _, id := range entry.DeletePolicies {
+		delete(s.policies, id)
+	}
+
+	for id, def := range entry.PutSchemas {
+		s.schemas[id] = def
+	}
+
+	for _, id := range entry.DeleteSchemas {
+		delete(s.schemas, id)
+	}
+}
+
+// compactIfNeeded rewrites the write-ahead log once it has more entries than the configured limit.
+// A failure to compact is not fatal because the log still holds all the changes.
+func (s *Store) compactIfNeeded() {
+	if s.wal.entries <= s.compactAfter {
+		return
+	}
+
+	if err := s.wal.compact(&logEntry{PutPolicies: s.policies, PutSchemas: s.schemas}); err != nil {
+		s.log.Warn("Failed to compact the write-ahead log", zap.Error(err))
+		return
+	}
+
+	s.log.Debug("Compacted the write-ahead log", zap.Int("policies", len(s.policies)), zap.Int("schemas", len(s.schemas)))
+}
+
+func (s *Store) enabledPolicy(id uint64) *policyRecord {
+	if r, ok := s.policies[id]; ok && !r.Disabled {
+		return r
+	}
+
+	return nil
+}
+
+type regexpFilter struct {
+	match func(string) bool
+	get   func(namer.PolicyCoords) string
+}
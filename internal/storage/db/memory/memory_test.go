@@ -0,0 +1,149 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/db/internal"
+	"github.com/cerbos/cerbos/internal/storage/db/memory"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+func TestMemory(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+
+	store, err := memory.NewStore(ctx, &memory.Conf{Path: filepath.Join(t.TempDir(), "store.wal"), CompactAfter: 10})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	t.Run("suite", internal.TestSuite(store))
+}
+
+func TestReplay(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+
+	rp := policy.Wrap(test.GenResourcePolicy(test.NoMod()))
+	pp := policy.Wrap(test.GenPrincipalPolicy(test.NoMod()))
+	dr := policy.Wrap(test.GenDerivedRoles(test.NoMod()))
+	sch := &schemav1.Schema{Id: "test.json", Definition: test.ReadSchemaFromFile(t, test.PathToDir(t, "store/_schemas/resources/leave_request.json"))}
+
+	open := func(t *testing.T, conf *memory.Conf) *memory.Store {
+		t.Helper()
+
+		store, err := memory.NewStore(ctx, conf)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = store.Close() })
+
+		return store
+	}
+
+	requireContents := func(t *testing.T, store *memory.Store, wantPolicyIDs, wantSchemaIDs []string) {
+		t.Helper()
+
+		policyIDs, err := store.ListPolicyIDs(ctx, storage.ListPolicyIDsParams{IncludeDisabled: true})
+		require.NoError(t, err)
+		require.ElementsMatch(t, wantPolicyIDs, policyIDs)
+
+		schemaIDs, err := store.ListSchemaIDs(ctx)
+		require.NoError(t, err)
+		require.ElementsMatch(t, wantSchemaIDs, schemaIDs)
+	}
+
+	populate := func(t *testing.T, store *memory.Store) {
+		t.Helper()
+
+		require.NoError(t, store.AddOrUpdate(ctx, rp, pp, dr))
+		require.NoError(t, store.AddOrUpdateSchema(ctx, sch))
+		require.NoError(t, store.Delete(ctx, pp.ID))
+
+		n, err := store.Disable(ctx, namer.PolicyKeyFromFQN(rp.FQN))
+		require.NoError(t, err)
+		require.Equal(t, uint32(1), n)
+	}
+
+	requireReplayed := func(t *testing.T, store *memory.Store) {
+		t.Helper()
+
+		requireContents(t, store, []string{namer.PolicyKeyFromFQN(rp.FQN), namer.PolicyKeyFromFQN(dr.FQN)}, []string{sch.Id})
+
+		have, err := store.LoadPolicy(ctx, namer.PolicyKeyFromFQN(rp.FQN))
+		require.NoError(t, err)
+		require.Len(t, have, 1)
+		require.True(t, have[0].Disabled)
+
+		cu, err := store.GetFirstMatch(ctx, []namer.ModuleID{rp.ID})
+		require.NoError(t, err)
+		require.Nil(t, cu, "disabled policy should not be returned")
+	}
+
+	t.Run("reopen", func(t *testing.T) {
+		conf := &memory.Conf{Path: filepath.Join(t.TempDir(), "nested", "store.wal"), CompactAfter: 100}
+		populate(t, open(t, conf))
+		requireReplayed(t, open(t, conf))
+	})
+
+	t.Run("compaction", func(t *testing.T) {
+		conf := &memory.Conf{Path: filepath.Join(t.TempDir(), "store.wal"), CompactAfter: 2}
+		populate(t, open(t, conf))
+
+		data, err := os.ReadFile(conf.Path)
+		require.NoError(t, err)
+		require.LessOrEqual(t, strings.Count(string(data), "\n"), conf.CompactAfter)
+
+		requireReplayed(t, open(t, conf))
+	})
+
+	t.Run("incomplete_entry", func(t *testing.T) {
+		conf := &memory.Conf{Path: filepath.Join(t.TempDir(), "store.wal"), CompactAfter: 100}
+		populate(t, open(t, conf))
+
+		f, err := os.OpenFile(conf.Path, os.O_WRONLY|os.O_APPEND, 0o600)
+		require.NoError(t, err)
+		_, err = f.WriteString(`{"deletePolicies":[`)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		store := open(t, conf)
+		requireReplayed(t, store)
+
+		// the incomplete entry must have been discarded so that new entries are readable
+		require.NoError(t, store.AddOrUpdate(ctx, pp))
+		require.NoError(t, store.Close())
+
+		requireContents(t, open(t, conf), []string{namer.PolicyKeyFromFQN(rp.FQN), namer.PolicyKeyFromFQN(pp.FQN), namer.PolicyKeyFromFQN(dr.FQN)}, []string{sch.Id})
+	})
+
+	t.Run("corrupt_entry", func(t *testing.T) {
+		conf := &memory.Conf{Path: filepath.Join(t.TempDir(), "store.wal"), CompactAfter: 100}
+		require.NoError(t, os.WriteFile(conf.Path, []byte("not json\n"), 0o600))
+
+		_, err := memory.NewStore(ctx, conf)
+		require.Error(t, err)
+	})
+}
+
+func TestConf(t *testing.T) {
+	conf := &memory.Conf{}
+	conf.SetDefaults()
+	require.Error(t, conf.Validate())
+
+	conf.Path = "/tmp/store.wal"
+	require.NoError(t, conf.Validate())
+
+	conf.CompactAfter = 0
+	require.Error(t, conf.Validate())
+}
@@ -0,0 +1,67 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+)
+
+// policyRecord is the state of a policy, keyed by its module ID.
+type policyRecord struct {
+	Kind         string   `json:"kind"`
+	Name         string   `json:"name"`
+	Version      string   `json:"version"`
+	Scope        string   `json:"scope"`
+	Description  string   `json:"description,omitempty"`
+	Definition   []byte   `json:"definition"`
+	Dependencies []uint64 `json:"dependencies,omitempty"`
+	Ancestors    []uint64 `json:"ancestors,omitempty"`
+	Disabled     bool     `json:"disabled"`
+}
+
+func (r *policyRecord) policy() (*policyv1.Policy, error) {
+	p := &policyv1.Policy{}
+	if err := p.UnmarshalVT(r.Definition); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal policy definition: %w", err)
+	}
+
+	return p, nil
+}
+
+func (r *policyRecord) coords() namer.PolicyCoords {
+	return namer.PolicyCoords{Kind: r.Kind, Name: r.Name, Version: r.Version, Scope: r.Scope}
+}
+
+// logEntry is a line of the write-ahead log. Each entry holds all the changes made by a single mutation,
+// so that a mutation is either replayed in full or not at all.
+type logEntry struct {
+	PutPolicies    map[uint64]*policyRecord   `json:"putPolicies,omitempty"`
+	DeletePolicies []uint64                   `json:"deletePolicies,omitempty"`
+	PutSchemas     map[string]json.RawMessage `json:"putSchemas,omitempty"`
+	DeleteSchemas  []string                   `json:"deleteSchemas,omitempty"`
+}
+
+func rawID(id namer.ModuleID) uint64 {
+	v, _ := id.Value()
+	return v.(uint64) //nolint:forcetypeassert
+}
+
+func rawIDs(ids []namer.ModuleID) []uint64 {
+	out := make([]uint64, len(ids))
+	for i, id := range ids {
+		out[i] = rawID(id)
+	}
+
+	return out
+}
+
+func moduleID(id uint64) namer.ModuleID {
+	var m namer.ModuleID
+	_ = m.Scan(id)
+	return m
+}
@@ -0,0 +1,163 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+)
+
+// wal is the write-ahead log that the changes made to the store are appended to, one JSON-encoded entry per line.
+type wal struct {
+	file    *os.File
+	path    string
+	size    int64
+	entries int
+}
+
+// openWAL opens the log at the given path, creating it if it doesn't exist, and calls apply with each entry in it.
+// An incomplete entry at the end of the log, left behind by a crash while it was being written, is discarded.
+func openWAL(path string, log *zap.Logger, apply func(*logEntry)) (*wal, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil { //nolint:gomnd
+		return nil, fmt.Errorf("failed to create directory for the write-ahead log: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600) //nolint:gomnd
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the write-ahead log: %w", err)
+	}
+
+	w := &wal{file: f, path: path}
+	if err := w.replay(log, apply); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (w *wal) replay(log *zap.Logger, apply func(*logEntry)) error {
+	r := bufio.NewReader(w.file)
+	for {
+		line, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(line) > 0 {
+				log.Warn("Discarding incomplete entry at the end of the write-ahead log", zap.Int64("offset", w.size))
+				if err := w.file.Truncate(w.size); err != nil {
+					return fmt.Errorf("failed to truncate the write-ahead log: %w", err)
+				}
+			}
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to read the write-ahead log: %w", err)
+		}
+
+		entry := &logEntry{}
+		if err := json.Unmarshal(line, entry); err != nil {
+			return fmt.Errorf("invalid entry at offset %d of the write-ahead log: %w", w.size, err)
+		}
+
+		apply(entry)
+		w.size += int64(len(line))
+		w.entries++
+	}
+}
+
+// append writes the entry to the log and waits for it to be flushed to disk.
+func (w *wal) append(entry *logEntry) error {
+	line, err := encodeEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.file.Write(line); err != nil {
+		// remove the partially written entry so that the next one starts on a new line
+		_ = w.file.Truncate(w.size)
+		return fmt.Errorf("failed to write to the write-ahead log: %w", err)
+	}
+
+	if err := w.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync the write-ahead log: %w", err)
+	}
+
+	w.size += int64(len(line))
+	w.entries++
+	return nil
+}
+
+// compact replaces the log with a log containing just the given entry, which holds the current contents of the store.
+// The new log is written to a temporary file that's renamed over the old one, so a crash leaves one or the other intact.
+func (w *wal) compact(entry *logEntry) (err error) {
+	line, err := encodeEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := w.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC|os.O_APPEND, 0o600) //nolint:gomnd
+	if err != nil {
+		return fmt.Errorf("failed to create compacted write-ahead log: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmpPath)
+		}
+	}()
+
+	if _, err := tmp.Write(line); err != nil {
+		return fmt.Errorf("failed to write compacted write-ahead log: %w", err)
+	}
+
+	if err := tmp.Sync(); err != nil {
+		return fmt.Errorf("failed to sync compacted write-ahead log: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, w.path); err != nil {
+		return fmt.Errorf("failed to replace the write-ahead log: %w", err)
+	}
+
+	syncDir(filepath.Dir(w.path))
+
+	// the handle of the temporary file now refers to the log, so it's used for the subsequent appends
+	_ = w.file.Close()
+	w.file = tmp
+	w.size = int64(len(line))
+	w.entries = 1
+	return nil
+}
+
+func (w *wal) close() error {
+	return w.file.Close()
+}
+
+func encodeEntry(entry *logEntry) ([]byte, error) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode write-ahead log entry: %w", err)
+	}
+
+	return append(line, '\n'), nil
+}
+
+// syncDir flushes the directory entry of a renamed file to disk. It's best effort because not all platforms support it.
+func syncDir(dir string) {
+	d, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+
+	_ = d.Sync()
+	_ = d.Close()
+}
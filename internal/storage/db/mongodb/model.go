@@ -0,0 +1,65 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package mongodb
+
+import (
+	"github.com/cerbos/cerbos/internal/namer"
+)
+
+const (
+	PolicyColl = "policies"
+	SchemaColl = "schemas"
+
+	idFld         = "_id"
+	kindFld       = "kind"
+	nameFld       = "name"
+	versionFld    = "version"
+	scopeFld      = "scope"
+	disabledFld   = "disabled"
+	depsFld       = "dependencies"
+	ancestorsFld  = "ancestors"
+	definitionFld = "definition"
+)
+
+// policyDoc is the document stored in the policies collection.
+// Module IDs are stored as int64 values because BSON doesn't have an unsigned 64-bit integer type.
+type policyDoc struct {
+	Kind         string  `bson:"kind"`
+	Name         string  `bson:"name"`
+	Version      string  `bson:"version"`
+	Scope        string  `bson:"scope"`
+	Description  string  `bson:"description"`
+	Definition   []byte  `bson:"definition"`
+	Dependencies []int64 `bson:"dependencies"`
+	Ancestors    []int64 `bson:"ancestors"`
+	ID           int64   `bson:"_id"`
+	Disabled     bool    `bson:"disabled"`
+}
+
+// schemaDoc is the document stored in the schemas collection.
+// The definition is kept as a JSON string because JSON schemas contain keys such as `$ref` that are reserved in MongoDB.
+type schemaDoc struct {
+	ID         string `bson:"_id"`
+	Definition string `bson:"definition"`
+}
+
+func docID(id namer.ModuleID) int64 {
+	v, _ := id.Value()
+	return int64(v.(uint64)) //nolint:forcetypeassert
+}
+
+func docIDs(ids []namer.ModuleID) []int64 {
+	out := make([]int64, len(ids))
+	for i, id := range ids {
+		out[i] = docID(id)
+	}
+
+	return out
+}
+
+func moduleID(id int64) namer.ModuleID {
+	var m namer.ModuleID
+	_ = m.Scan(id)
+	return m
+}
@@ -0,0 +1,719 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/observability/logging"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/db"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const (
+	DriverName = "mongodb"
+
+	connectRetries = 3
+	connectTimeout = 10 * time.Second
+	closeTimeout   = 5 * time.Second
+	// maxWatchRetryInterval is the default maximum time to wait before reopening a change stream that failed.
+	maxWatchRetryInterval = 30 * time.Second
+)
+
+var (
+	_ storage.SourceStore  = (*Store)(nil)
+	_ storage.MutableStore = (*Store)(nil)
+	_ storage.Instrumented = (*Store)(nil)
+	_ storage.Reloadable   = (*Store)(nil)
+	_ storage.Syncable     = (*Store)(nil)
+	_ io.Closer            = (*Store)(nil)
+)
+
+func init() {
+	storage.RegisterDriver(DriverName, func(ctx context.Context, confW *config.Wrapper) (storage.Store, error) {
+		conf := new(Conf)
+		if err := confW.GetSection(conf); err != nil {
+			return nil, err
+		}
+
+		return NewStore(ctx, conf)
+	})
+}
+
+type Store struct {
+	client      *mongo.Client
+	policies    *mongo.Collection
+	schemas     *mongo.Collection
+	regexpCache *util.RegexpCache
+	log         *zap.Logger
+	cancel      context.CancelFunc
+	done        chan struct{}
+	syncStatus  *storage.SyncStatus
+	*storage.SubscriptionManager
+}
+
+func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
+	log := logging.FromContext(ctx).Named("mongodb")
+
+	clientOpts := options.Client().ApplyURI(conf.URL).SetConnectTimeout(connectTimeout)
+	if err := clientOpts.Validate(); err != nil {
+		log.Error("Failed to parse MongoDB connection URL", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("Initializing MongoDB storage", zap.Strings("hosts", clientOpts.Hosts), zap.String("database", conf.Database))
+
+	client, err := mongo.Connect(ctx, clientOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create MongoDB client: %w", err)
+	}
+
+	pingFn := func() error {
+		pingCtx, cancel := context.WithTimeout(ctx, connectTimeout)
+		defer cancel()
+
+		return client.Ping(pingCtx, readpref.Primary())
+	}
+
+	if err := backoff.Retry(pingFn, backoff.WithContext(backoff.WithMaxRetries(backoff.NewExponentialBackOff(), connectRetries), ctx)); err != nil {
+		_ = client.Disconnect(context.Background())
+		return nil, fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+
+	database := client.Database(conf.Database)
+	s := &Store{
+		client:              client,
+		policies:            database.Collection(PolicyColl),
+		schemas:             database.Collection(SchemaColl),
+		regexpCache:         util.NewRegexpCache(),
+		log:                 log,
+		done:                make(chan struct{}),
+		SubscriptionManager: storage.NewSubscriptionManager(ctx),
+	}
+
+	if !conf.SkipIndexCreation {
+		if err := s.createIndexes(ctx); err != nil {
+			_ = client.Disconnect(context.Background())
+			return nil, err
+		}
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	if conf.WatchForChanges {
+		s.syncStatus = storage.NewSyncStatus(DriverName, conf.Sync)
+		go s.watch(watchCtx, database, conf.Sync.NewBackOff(backoff.DefaultInitialInterval, maxWatchRetryInterval))
+	} else {
+		close(s.done)
+	}
+
+	return s, nil
+}
+
+func (s *Store) createIndexes(ctx context.Context) error {
+	_, err := s.policies.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: depsFld, Value: 1}}},
+		{Keys: bson.D{{Key: ancestorsFld, Value: 1}}},
+		{Keys: bson.D{{Key: kindFld, Value: 1}, {Key: nameFld, Value: 1}, {Key: versionFld, Value: 1}, {Key: scopeFld, Value: 1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create indexes on the %s collection: %w", PolicyColl, err)
+	}
+
+	return nil
+}
+
+// SyncStatus returns the status of the watch, or nil if watching for changes is disabled.
+func (s *Store) SyncStatus() *storage.SyncStatus {
+	return s.syncStatus
+}
+
+func (s *Store) Driver() string {
+	return DriverName
+}
+
+// Close stops watching for changes and disconnects from MongoDB.
+func (s *Store) Close() error {
+	s.cancel()
+	<-s.done
+
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+
+	return s.client.Disconnect(ctx)
+}
+
+func (s *Store) AddOrUpdate(ctx context.Context, policies ...policy.Wrapper) error {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, len(policies))
+	events := make([]storage.Event, len(policies))
+	for i, p := range policies {
+		def, err := p.Policy.MarshalVT()
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s: %w", p.FQN, err)
+		}
+
+		doc := policyDoc{
+			ID:           docID(p.ID),
+			Kind:         p.Kind.String(),
+			Name:         p.Name,
+			Version:      p.Version,
+			Scope:        p.Scope,
+			Description:  p.Description,
+			Disabled:     p.Disabled,
+			Definition:   def,
+			Dependencies: docIDs(p.Dependencies()),
+			Ancestors:    docIDs(policy.Ancestors(p.Policy)),
+		}
+
+		models[i] = mongo.NewReplaceOneModel().SetFilter(bson.D{{Key: idFld, Value: doc.ID}}).SetReplacement(doc).SetUpsert(true)
+		events[i] = storage.Event{Kind: storage.EventAddOrUpdatePolicy, PolicyID: p.ID}
+	}
+
+	if _, err := s.policies.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(true)); err != nil {
+		return fmt.Errorf("failed to upsert policies: %w", err)
+	}
+
+	_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
+		tag.Upsert(metrics.KeyIndexCRUDKind, "upsert"),
+	}, metrics.IndexCRUDCount.M(int64(len(policies))))
+
+	s.NotifySubscribers(events...)
+	return nil
+}
+
+func (s *Store) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID) (*policy.CompilationUnit, error) {
+	results, err := s.GetCompilationUnits(ctx, candidates...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range candidates {
+		if cu, ok := results[id]; ok {
+			return cu, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Store) GetCompilationUnits(ctx context.Context, ids ...namer.ModuleID) (map[namer.ModuleID]*policy.CompilationUnit, error) {
+	// Policies are loaded level by level: the requested policies, then their ancestors and finally the
+	// dependencies of all of them until there's nothing new to load. Disabled policies are left out.
+	loaded := make(map[int64]*policyDoc)
+	load := func(toLoad []int64) error {
+		var missing []int64
+		for _, id := range toLoad {
+			if _, ok := loaded[id]; !ok {
+				missing = append(missing, id)
+			}
+		}
+
+		if len(missing) == 0 {
+			return nil
+		}
+
+		docs, err := s.findPolicies(ctx, bson.D{
+			{Key: idFld, Value: bson.D{{Key: "$in", Value: missing}}},
+			{Key: disabledFld, Value: false},
+		})
+		if err != nil {
+			return err
+		}
+
+		for i := range docs {
+			loaded[docs[i].ID] = &docs[i]
+		}
+
+		// Remember the policies that don't exist or are disabled to avoid looking for them again.
+		for _, id := range missing {
+			if _, ok := loaded[id]; !ok {
+				loaded[id] = nil
+			}
+		}
+
+		return nil
+	}
+
+	rootIDs := docIDs(ids)
+	if err := load(rootIDs); err != nil {
+		return nil, fmt.Errorf("failed to get policies: %w", err)
+	}
+
+	var ancestorIDs []int64
+	for _, id := range rootIDs {
+		if doc := loaded[id]; doc != nil {
+			ancestorIDs = append(ancestorIDs, doc.Ancestors...)
+		}
+	}
+
+	if err := load(ancestorIDs); err != nil {
+		return nil, fmt.Errorf("failed to get ancestors: %w", err)
+	}
+
+	for {
+		var depIDs []int64
+		for _, doc := range loaded {
+			if doc == nil {
+				continue
+			}
+
+			for _, dep := range doc.Dependencies {
+				if _, ok := loaded[dep]; !ok {
+					depIDs = append(depIDs, dep)
+				}
+			}
+		}
+
+		if len(depIDs) == 0 {
+			break
+		}
+
+		if err := load(depIDs); err != nil {
+			return nil, fmt.Errorf("failed to get dependencies: %w", err)
+		}
+	}
+
+	units := make(map[namer.ModuleID]*policy.CompilationUnit)
+	for _, id := range rootIDs {
+		root := loaded[id]
+		if root == nil {
+			continue
+		}
+
+		unit := &policy.CompilationUnit{ModID: moduleID(id)}
+		var add func(*policyDoc) error
+		add = func(doc *policyDoc) error {
+			modID := moduleID(doc.ID)
+			if _, ok := unit.Definitions[modID]; ok {
+				return nil
+			}
+
+			p, err := doc.policy()
+			if err != nil {
+				return err
+			}
+
+			unit.AddDefinition(modID, p)
+			for _, dep := range doc.Dependencies {
+				if depDoc := loaded[dep]; depDoc != nil {
+					if err := add(depDoc); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		}
+
+		if err := add(root); err != nil {
+			return nil, err
+		}
+
+		for _, ancestor := range root.Ancestors {
+			if ancestorDoc := loaded[ancestor]; ancestorDoc != nil {
+				if err := add(ancestorDoc); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		units[unit.ModID] = unit
+	}
+
+	return units, nil
+}
+
+func (s *Store) GetDependents(ctx context.Context, ids ...namer.ModuleID) (map[namer.ModuleID][]namer.ModuleID, error) {
+	// There's a maximum of two levels of dependency (resourcePolicy -> derivedRoles -> exportVariables),
+	// so the dependents are the direct dependents and the dependents of the direct dependents.
+	out := make(map[namer.ModuleID][]namer.ModuleID, len(ids))
+	seen := make(map[[2]int64]struct{})
+	addDependent := func(id, dependent int64) {
+		key := [2]int64{id, dependent}
+		if _, ok := seen[key]; ok {
+			return
+		}
+
+		seen[key] = struct{}{}
+		modID := moduleID(id)
+		out[modID] = append(out[modID], moduleID(dependent))
+	}
+
+	// requested maps each policy whose dependents are being looked for to the requested IDs it depends on.
+	requested := make(map[int64][]int64, len(ids))
+	for _, id := range docIDs(ids) {
+		requested[id] = []int64{id}
+	}
+
+	for level := 0; level < 2 && len(requested) > 0; level++ {
+		keys := make([]int64, 0, len(requested))
+		for id := range requested {
+			keys = append(keys, id)
+		}
+
+		docs, err := s.findPolicies(ctx, bson.D{{Key: depsFld, Value: bson.D{{Key: "$in", Value: keys}}}}, options.Find().SetProjection(bson.D{{Key: depsFld, Value: 1}}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dependents: %w", err)
+		}
+
+		next := make(map[int64][]int64)
+		for _, doc := range docs {
+			for _, dep := range doc.Dependencies {
+				for _, id := range requested[dep] {
+					addDependent(id, doc.ID)
+					next[doc.ID] = append(next[doc.ID], id)
+				}
+			}
+		}
+
+		requested = next
+	}
+
+	return out, nil
+}
+
+func (s *Store) LoadPolicy(ctx context.Context, policyKey ...string) ([]*policy.Wrapper, error) {
+	ids := make([]int64, len(policyKey))
+	for i, pk := range policyKey {
+		ids[i] = docID(namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk)))
+	}
+
+	docs, err := s.findPolicies(ctx, bson.D{{Key: idFld, Value: bson.D{{Key: "$in", Value: ids}}}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get policies: %w", err)
+	}
+
+	policies := make([]*policy.Wrapper, len(docs))
+	for i, doc := range docs {
+		p, err := doc.policy()
+		if err != nil {
+			return nil, err
+		}
+
+		pk := namer.PolicyKey(p)
+		wp := policy.Wrap(policy.WithMetadata(p, "", nil, pk))
+		wp.Disabled = doc.Disabled
+		policies[i] = &wp
+	}
+
+	return policies, nil
+}
+
+func (s *Store) ListPolicyIDs(ctx context.Context, params storage.ListPolicyIDsParams) ([]string, error) {
+	filter := bson.D{}
+	if !params.IncludeDisabled {
+		filter = append(filter, bson.E{Key: disabledFld, Value: bson.D{{Key: "$ne", Value: true}}})
+	}
+
+	// Regular expressions are matched by Cerbos rather than the database to keep the Go syntax.
+	var filters []regexpFilter
+	for _, f := range []struct {
+		pattern string
+		get     func(namer.PolicyCoords) string
+	}{
+		{pattern: params.NameRegexp, get: func(pc namer.PolicyCoords) string { return pc.Name }},
+		{pattern: params.ScopeRegexp, get: func(pc namer.PolicyCoords) string { return pc.Scope }},
+		{pattern: params.VersionRegexp, get: func(pc namer.PolicyCoords) string { return pc.Version }},
+	} {
+		if f.pattern == "" {
+			continue
+		}
+
+		re, err := s.regexpCache.GetCompiledExpr(f.pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, regexpFilter{match: re.MatchString, get: f.get})
+	}
+
+	opts := options.Find().
+		SetProjection(bson.D{{Key: kindFld, Value: 1}, {Key: nameFld, Value: 1}, {Key: versionFld, Value: 1}, {Key: scopeFld, Value: 1}}).
+		SetSort(bson.D{{Key: kindFld, Value: 1}, {Key: nameFld, Value: 1}, {Key: versionFld, Value: 1}, {Key: scopeFld, Value: 1}})
+
+	docs, err := s.findPolicies(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not execute %q query: %w", "ListPolicyIDs", err)
+	}
+
+	policyIDs := make([]string, 0, len(docs))
+outer:
+	for _, doc := range docs {
+		pc := namer.PolicyCoords{Kind: doc.Kind, Name: doc.Name, Version: doc.Version, Scope: doc.Scope}
+		for _, f := range filters {
+			if !f.match(f.get(pc)) {
+				continue outer
+			}
+		}
+
+		policyIDs = append(policyIDs, pc.PolicyKey())
+	}
+
+	return policyIDs, nil
+}
+
+func (s *Store) Disable(ctx context.Context, policyKey ...string) (uint32, error) {
+	ids := make([]int64, len(policyKey))
+	events := make([]storage.Event, len(policyKey))
+	for i, pk := range policyKey {
+		modID := namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk))
+		ids[i] = docID(modID)
+		events[i] = storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, modID)
+	}
+
+	// Disabling a scoped policy that has enabled descendants would break the scope chain.
+	descendants, err := s.findPolicies(ctx,
+		bson.D{{Key: ancestorsFld, Value: bson.D{{Key: "$in", Value: ids}}}, {Key: disabledFld, Value: false}},
+		options.Find().SetProjection(bson.D{{Key: ancestorsFld, Value: 1}}),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get descendants for policies: %w", err)
+	}
+
+	hasDescendants := make(map[int64]struct{})
+	for _, doc := range descendants {
+		for _, a := range doc.Ancestors {
+			hasDescendants[a] = struct{}{}
+		}
+	}
+
+	var brokenChainPolicies []string
+	for i, pk := range policyKey {
+		if _, ok := hasDescendants[ids[i]]; ok {
+			brokenChainPolicies = append(brokenChainPolicies, pk)
+		}
+	}
+
+	if len(brokenChainPolicies) > 0 {
+		return 0, db.ErrBreaksScopeChain{PolicyKeys: brokenChainPolicies}
+	}
+
+	res, err := s.policies.UpdateMany(ctx,
+		bson.D{{Key: idFld, Value: bson.D{{Key: "$in", Value: ids}}}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: disabledFld, Value: true}}}},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to disable policies: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return uint32(res.MatchedCount), nil
+}
+
+func (s *Store) Enable(ctx context.Context, policyKey ...string) (uint32, error) {
+	ids := make([]int64, len(policyKey))
+	events := make([]storage.Event, len(policyKey))
+	for i, pk := range policyKey {
+		modID := namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk))
+		ids[i] = docID(modID)
+		events[i] = storage.NewPolicyEvent(storage.EventAddOrUpdatePolicy, modID)
+	}
+
+	res, err := s.policies.UpdateMany(ctx,
+		bson.D{{Key: idFld, Value: bson.D{{Key: "$in", Value: ids}}}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: disabledFld, Value: false}}}},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to enable policies: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return uint32(res.MatchedCount), nil
+}
+
+func (s *Store) Delete(ctx context.Context, ids ...namer.ModuleID) error {
+	events := make([]storage.Event, len(ids))
+	for i, id := range ids {
+		events[i] = storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, id)
+	}
+
+	if _, err := s.policies.DeleteMany(ctx, bson.D{{Key: idFld, Value: bson.D{{Key: "$in", Value: docIDs(ids)}}}}); err != nil {
+		return fmt.Errorf("failed to delete policies: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return nil
+}
+
+func (s *Store) AddOrUpdateSchema(ctx context.Context, schemas ...*schemav1.Schema) error {
+	if len(schemas) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, len(schemas))
+	events := make([]storage.Event, len(schemas))
+	for i, sch := range schemas {
+		var def json.RawMessage
+		if err := json.Unmarshal(sch.Definition, &def); err != nil {
+			return storage.NewInvalidSchemaError(err, "schema definition with ID %q is not valid", sch.Id)
+		}
+
+		doc := schemaDoc{ID: sch.Id, Definition: string(def)}
+		models[i] = mongo.NewReplaceOneModel().SetFilter(bson.D{{Key: idFld, Value: sch.Id}}).SetReplacement(doc).SetUpsert(true)
+		events[i] = storage.NewSchemaEvent(storage.EventAddOrUpdateSchema, sch.Id)
+	}
+
+	if _, err := s.schemas.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(true)); err != nil {
+		return fmt.Errorf("failed to upsert schemas: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return nil
+}
+
+func (s *Store) DeleteSchema(ctx context.Context, ids ...string) (uint32, error) {
+	events := make([]storage.Event, len(ids))
+	for i, id := range ids {
+		events[i] = storage.NewSchemaEvent(storage.EventDeleteSchema, id)
+	}
+
+	res, err := s.schemas.DeleteMany(ctx, bson.D{{Key: idFld, Value: bson.D{{Key: "$in", Value: ids}}}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete schema(s): %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return uint32(res.DeletedCount), nil
+}
+
+func (s *Store) ListSchemaIDs(ctx context.Context) ([]string, error) {
+	cursor, err := s.schemas.Find(ctx, bson.D{}, options.Find().SetProjection(bson.D{{Key: idFld, Value: 1}}).SetSort(bson.D{{Key: idFld, Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("could not execute %q query: %w", "ListSchemaIDs", err)
+	}
+
+	var docs []schemaDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("could not read schema IDs: %w", err)
+	}
+
+	schemaIDs := make([]string, len(docs))
+	for i, doc := range docs {
+		schemaIDs[i] = doc.ID
+	}
+
+	return schemaIDs, nil
+}
+
+func (s *Store) LoadSchema(ctx context.Context, urlVar string) (io.ReadCloser, error) {
+	u, err := url.Parse(urlVar)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != "" && u.Scheme != schema.URLScheme {
+		return nil, fmt.Errorf("invalid url scheme %q", u.Scheme)
+	}
+
+	var doc schemaDoc
+	if err := s.schemas.FindOne(ctx, bson.D{{Key: idFld, Value: strings.TrimPrefix(u.Path, "/")}}).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("failed to find schema")
+		}
+
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+
+	return io.NopCloser(strings.NewReader(doc.Definition)), nil
+}
+
+func (s *Store) RepoStats(ctx context.Context) storage.RepoStats {
+	stats := storage.RepoStats{}
+
+	cursor, err := s.policies.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$group", Value: bson.D{{Key: idFld, Value: "$" + kindFld}, {Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}}}}},
+	})
+	if err != nil {
+		return stats
+	}
+
+	var results []struct {
+		Kind  string `bson:"_id"`
+		Count int    `bson:"count"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return stats
+	}
+
+	stats.PolicyCount = make(map[policy.Kind]int, len(results))
+	for _, r := range results {
+		switch r.Kind {
+		case policy.DerivedRolesKindStr:
+			stats.PolicyCount[policy.DerivedRolesKind] = r.Count
+		case policy.ExportVariablesKindStr:
+			stats.PolicyCount[policy.ExportVariablesKind] = r.Count
+		case policy.PrincipalKindStr:
+			stats.PolicyCount[policy.PrincipalKind] = r.Count
+		case policy.ResourceKindStr:
+			stats.PolicyCount[policy.ResourceKind] = r.Count
+		}
+	}
+
+	if n, err := s.schemas.CountDocuments(ctx, bson.D{}); err == nil {
+		stats.SchemaCount = int(n)
+	}
+
+	return stats
+}
+
+func (s *Store) Reload(context.Context) error {
+	s.NotifySubscribers(storage.NewReloadEvent())
+	return nil
+}
+
+func (s *Store) findPolicies(ctx context.Context, filter bson.D, opts ...*options.FindOptions) ([]policyDoc, error) {
+	cursor, err := s.policies.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []policyDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	return docs, nil
+}
+
+func (doc *policyDoc) policy() (*policyv1.Policy, error) {
+	p := &policyv1.Policy{}
+	if err := p.UnmarshalVT(doc.Definition); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal policy definition: %w", err)
+	}
+
+	return p, nil
+}
+
+type regexpFilter struct {
+	match func(string) bool
+	get   func(namer.PolicyCoords) string
+}
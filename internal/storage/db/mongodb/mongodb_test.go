@@ -0,0 +1,188 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/db/internal"
+	"github.com/cerbos/cerbos/internal/storage/db/mongodb"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+const changeStreamTimeout = 10 * time.Second
+
+func TestMongoDB(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	pool, err := dockertest.NewPool("")
+	require.NoError(t, err, "Failed to connect to Docker")
+
+	// Change streams are only available on replica sets, so start a single node replica set.
+	resource, err := pool.RunWithOptions(&dockertest.RunOptions{
+		Repository: "mongo",
+		Tag:        "6",
+		Cmd:        []string{"--replSet", "rs0", "--bind_ip_all"},
+	}, func(config *docker.HostConfig) {
+		config.AutoRemove = true
+	})
+	require.NoError(t, err, "Failed to start container")
+
+	t.Cleanup(func() {
+		if err := pool.Purge(resource); err != nil {
+			t.Errorf("Failed to cleanup resources: %v", err)
+		}
+	})
+
+	deadline, ok := t.Deadline()
+	if !ok {
+		deadline = time.Now().Add(5 * time.Minute)
+	}
+
+	ctx, cancelFunc := context.WithDeadline(context.Background(), deadline)
+	defer cancelFunc()
+
+	url := fmt.Sprintf("mongodb://localhost:%s/?directConnection=true", resource.GetPort("27017/tcp"))
+	require.NoError(t, pool.Retry(func() error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		return initReplicaSet(ctx, url)
+	}), "Failed to initialize replica set")
+
+	store, err := mongodb.NewStore(ctx, &mongodb.Conf{URL: url, Database: "cerbos"})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	t.Run("suite", internal.TestSuite(store))
+
+	t.Run("watch_for_changes", func(t *testing.T) {
+		watcher, err := mongodb.NewStore(ctx, &mongodb.Conf{URL: url, Database: "cerbos", WatchForChanges: true})
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = watcher.Close() })
+
+		// The change stream is opened in the background, so wait until it delivers the changes.
+		sub := &eventCollector{events: make(chan storage.Event, 32)}
+		watcher.Subscribe(sub)
+		t.Cleanup(func() { watcher.Unsubscribe(sub) })
+
+		warmUp := &schemav1.Schema{Id: "warm_up", Definition: []byte(`{"type": "object"}`)}
+		require.Eventually(t, func() bool {
+			require.NoError(t, store.AddOrUpdateSchema(ctx, warmUp))
+			return sub.waitFor(storage.NewSchemaEvent(storage.EventAddOrUpdateSchema, warmUp.Id), time.Second)
+		}, changeStreamTimeout, 100*time.Millisecond)
+
+		p := policy.Wrap(test.GenResourcePolicy(test.PrefixAndSuffix("w", "w")))
+
+		t.Run("add", func(t *testing.T) {
+			require.NoError(t, store.AddOrUpdate(ctx, p))
+			require.True(t, sub.waitFor(storage.NewPolicyEvent(storage.EventAddOrUpdatePolicy, p.ID), changeStreamTimeout))
+		})
+
+		t.Run("disable", func(t *testing.T) {
+			_, err := store.Disable(ctx, namer.PolicyKeyFromFQN(p.FQN))
+			require.NoError(t, err)
+			require.True(t, sub.waitFor(storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, p.ID), changeStreamTimeout))
+		})
+
+		t.Run("enable", func(t *testing.T) {
+			_, err := store.Enable(ctx, namer.PolicyKeyFromFQN(p.FQN))
+			require.NoError(t, err)
+			require.True(t, sub.waitFor(storage.NewPolicyEvent(storage.EventAddOrUpdatePolicy, p.ID), changeStreamTimeout))
+		})
+
+		t.Run("delete", func(t *testing.T) {
+			require.NoError(t, store.Delete(ctx, p.ID))
+			require.True(t, sub.waitFor(storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, p.ID), changeStreamTimeout))
+		})
+
+		t.Run("delete_schema", func(t *testing.T) {
+			_, err := store.DeleteSchema(ctx, warmUp.Id)
+			require.NoError(t, err)
+			require.True(t, sub.waitFor(storage.NewSchemaEvent(storage.EventDeleteSchema, warmUp.Id), changeStreamTimeout))
+		})
+	})
+}
+
+func initReplicaSet(ctx context.Context, url string) error {
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(url))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Disconnect(ctx) }()
+
+	admin := client.Database("admin")
+	if err := admin.RunCommand(ctx, bson.D{{Key: "replSetInitiate", Value: bson.D{}}}).Err(); err != nil && !strings.Contains(err.Error(), "already initialized") {
+		return err
+	}
+
+	var hello struct {
+		IsWritablePrimary bool `bson:"isWritablePrimary"`
+	}
+	if err := admin.RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err != nil {
+		return err
+	}
+
+	if !hello.IsWritablePrimary {
+		return errors.New("replica set does not have a primary yet")
+	}
+
+	return nil
+}
+
+type eventCollector struct {
+	events chan storage.Event
+}
+
+func (ec *eventCollector) SubscriberID() string {
+	return "mongodb_test"
+}
+
+func (ec *eventCollector) OnStorageEvent(events ...storage.Event) {
+	for _, evt := range events {
+		select {
+		case ec.events <- evt:
+		default:
+		}
+	}
+}
+
+// waitFor returns true if the event is received before the timeout. Other events received in the meantime are discarded.
+func (ec *eventCollector) waitFor(want storage.Event, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case evt := <-ec.events:
+			if evt.Kind == want.Kind && evt.PolicyID == want.PolicyID && evt.SchemaFile == want.SchemaFile {
+				return true
+			}
+		case <-timer.C:
+			return false
+		}
+	}
+}
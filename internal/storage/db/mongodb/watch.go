@@ -0,0 +1,191 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+const (
+	opInsert     = "insert"
+	opUpdate     = "update"
+	opReplace    = "replace"
+	opDelete     = "delete"
+	opDrop       = "drop"
+	opRename     = "rename"
+	opInvalidate = "invalidate"
+)
+
+var errChangeStreamInvalidated = errors.New("change stream was invalidated")
+
+// changeStreamPipeline limits the change stream to the Cerbos collections and leaves out the policy definitions,
+// which are not needed to notify the subscribers.
+var changeStreamPipeline = mongo.Pipeline{
+	{{Key: "$match", Value: bson.D{{Key: "ns.coll", Value: bson.D{{Key: "$in", Value: bson.A{PolicyColl, SchemaColl}}}}}}},
+	{{Key: "$project", Value: bson.D{
+		{Key: "operationType", Value: 1},
+		{Key: "ns", Value: 1},
+		{Key: "documentKey", Value: 1},
+		{Key: "fullDocument." + disabledFld, Value: 1},
+	}}},
+}
+
+// changeEvent is the part of a change stream event that is used to notify the subscribers.
+type changeEvent struct {
+	FullDocument *struct {
+		Disabled bool `bson:"disabled"`
+	} `bson:"fullDocument"`
+	DocumentKey struct {
+		ID bson.RawValue `bson:"_id"`
+	} `bson:"documentKey"`
+	Namespace struct {
+		Coll string `bson:"coll"`
+	} `bson:"ns"`
+	OperationType string `bson:"operationType"`
+}
+
+func (evt changeEvent) storageEvent() (storage.Event, bool) {
+	switch evt.OperationType {
+	case opDrop, opRename:
+		return storage.NewReloadEvent(), true
+	case opInsert, opUpdate, opReplace, opDelete:
+	default:
+		return storage.Event{}, false
+	}
+
+	switch evt.Namespace.Coll {
+	case PolicyColl:
+		id, ok := evt.DocumentKey.ID.Int64OK()
+		if !ok {
+			return storage.Event{}, false
+		}
+
+		// The full document is looked up after the change, so it's missing if the policy has been deleted since.
+		if evt.OperationType == opDelete || evt.FullDocument == nil || evt.FullDocument.Disabled {
+			return storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, moduleID(id)), true
+		}
+
+		return storage.NewPolicyEvent(storage.EventAddOrUpdatePolicy, moduleID(id)), true
+
+	case SchemaColl:
+		id, ok := evt.DocumentKey.ID.StringValueOK()
+		if !ok {
+			return storage.Event{}, false
+		}
+
+		if evt.OperationType == opDelete {
+			return storage.NewSchemaEvent(storage.EventDeleteSchema, id), true
+		}
+
+		return storage.NewSchemaEvent(storage.EventAddOrUpdateSchema, id), true
+
+	default:
+		return storage.Event{}, false
+	}
+}
+
+// changeWatcher notifies the subscribers of the store about the changes made by other Cerbos instances.
+// Changes made through this instance are notified twice: once when they are made and once when they arrive on the
+// change stream. The second notification is harmless because it only causes the affected policies to be recompiled.
+type changeWatcher struct {
+	store       *Store
+	database    *mongo.Database
+	resumeToken bson.Raw
+	// missedChanges is set when the watcher can't resume from where it stopped, so the store has to be reloaded.
+	missedChanges bool
+}
+
+func (s *Store) watch(ctx context.Context, database *mongo.Database, retry backoff.BackOff) {
+	defer close(s.done)
+
+	cw := &changeWatcher{store: s, database: database}
+
+	s.log.Info("Watching for changes using change streams")
+	for {
+		err := cw.stream(ctx, retry)
+		if ctx.Err() != nil {
+			s.log.Info("Stopped watching for changes")
+			return
+		}
+
+		s.log.Warn("Change stream failed", zap.Error(err))
+		s.syncStatus.Failed()
+		_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
+			tag.Upsert(metrics.KeyStoreDriver, DriverName),
+		}, metrics.StoreSyncErrorCount.M(1))
+
+		select {
+		case <-ctx.Done():
+			s.log.Info("Stopped watching for changes")
+			return
+		case <-time.After(retry.NextBackOff()):
+		}
+	}
+}
+
+// stream opens a change stream and notifies the subscribers of the changes until the stream fails.
+func (cw *changeWatcher) stream(ctx context.Context, retry backoff.BackOff) error {
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if cw.resumeToken != nil {
+		opts.SetResumeAfter(cw.resumeToken)
+	}
+
+	cs, err := cw.database.Watch(ctx, changeStreamPipeline, opts)
+	if err != nil {
+		if cw.resumeToken != nil {
+			// The point to resume from might no longer be in the oplog, so start afresh on the next attempt.
+			cw.resumeToken = nil
+			cw.missedChanges = true
+		}
+
+		return fmt.Errorf("failed to open change stream: %w", err)
+	}
+	defer cs.Close(context.Background())
+
+	retry.Reset()
+	cw.store.syncStatus.Live()
+	if cw.missedChanges {
+		cw.missedChanges = false
+		cw.store.NotifySubscribers(storage.NewReloadEvent())
+	}
+
+	for cs.Next(ctx) {
+		cw.resumeToken = cs.ResumeToken()
+
+		var evt changeEvent
+		if err := cs.Decode(&evt); err != nil {
+			return fmt.Errorf("failed to decode change event: %w", err)
+		}
+
+		if evt.OperationType == opInvalidate {
+			cw.resumeToken = nil
+			cw.store.NotifySubscribers(storage.NewReloadEvent())
+			return errChangeStreamInvalidated
+		}
+
+		if e, ok := evt.storageEvent(); ok {
+			cw.store.NotifySubscribers(e)
+		}
+	}
+
+	if token := cs.ResumeToken(); token != nil {
+		cw.resumeToken = token
+	}
+
+	return cs.Err()
+}
@@ -4,6 +4,8 @@
 package mysql
 
 import (
+	"time"
+
 	"github.com/cerbos/cerbos/internal/storage"
 	"github.com/cerbos/cerbos/internal/storage/db/internal"
 )
@@ -18,6 +20,10 @@ type Conf struct {
 	ServerPubKey map[string]string      `yaml:"serverPubKey" conf:",example=\n  mykey: testdata/server_public_key.pem"`
 	// DSN is the data source connection string.
 	DSN string `yaml:"dsn" conf:"required,example=\"user:password@tcp(localhost:3306)/db?interpolateParams=true\""`
+	// ReadReplicaDSN is the data source connection string of a read replica to serve the policy lookups of the engine from. Changes are always written to the primary database. The connection pool settings apply to both databases.
+	ReadReplicaDSN string `yaml:"readReplicaDSN" conf:",example=\"user:password@tcp(replica:3306)/db?interpolateParams=true\""`
+	// DeletedPolicyRetention is how long deleted policies are kept so that they can be restored through the Admin API. Defaults to 720h (30 days).
+	DeletedPolicyRetention time.Duration `yaml:"deletedPolicyRetention" conf:",example=720h"`
 	// SkipSchemaCheck skips checking for required database tables on startup.
 	SkipSchemaCheck bool `yaml:"skipSchemaCheck" conf:",example=false"`
 }
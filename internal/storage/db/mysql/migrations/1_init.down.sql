@@ -0,0 +1,15 @@
+DROP TRIGGER IF EXISTS policy_on_delete;
+
+DROP TRIGGER IF EXISTS policy_on_update;
+
+DROP TRIGGER IF EXISTS policy_on_insert;
+
+DROP TABLE IF EXISTS attr_schema_defs;
+
+DROP TABLE IF EXISTS policy_revision;
+
+DROP TABLE IF EXISTS policy_ancestor;
+
+DROP TABLE IF EXISTS policy_dependency;
+
+DROP TABLE IF EXISTS policy;
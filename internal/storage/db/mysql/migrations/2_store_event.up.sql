@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS store_event (
+    id INTEGER AUTO_INCREMENT PRIMARY KEY,
+    action VARCHAR(64),
+    target VARCHAR(1024),
+    revision_id INTEGER,
+    actor VARCHAR(255),
+    client_cert VARCHAR(1024),
+    event_timestamp TIMESTAMP DEFAULT CURRENT_TIMESTAMP);
@@ -8,9 +8,12 @@ import (
 	"crypto/rsa"
 	"crypto/tls"
 	"crypto/x509"
+	"database/sql"
+	"embed"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/doug-martin/goqu/v9"
@@ -18,6 +21,7 @@ import (
 	// Import the MySQL dialect.
 	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
 	"github.com/go-sql-driver/mysql"
+	migratemysql "github.com/golang-migrate/migrate/v4/database/mysql"
 
 	"github.com/cerbos/cerbos/internal/config"
 	"github.com/cerbos/cerbos/internal/observability/logging"
@@ -30,9 +34,17 @@ const (
 	urlToSchemaDocs = "https://docs.cerbos.dev/cerbos/latest/configuration/storage.html#mysql-schema"
 )
 
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
 var (
 	_ storage.SourceStore  = (*Store)(nil)
 	_ storage.MutableStore = (*Store)(nil)
+	_ storage.Historical   = (*Store)(nil)
+	_ storage.Versioned    = (*Store)(nil)
+	_ storage.Restorable   = (*Store)(nil)
+	_ storage.Revisioned   = (*Store)(nil)
+	_ storage.Audited      = (*Store)(nil)
 )
 
 func init() {
@@ -62,20 +74,72 @@ func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
 
 	conf.ConnPool.Configure(db)
 
-	s, err := internal.NewDBStorage(ctx, goqu.New("mysql", db))
+	opts := []internal.DBOpt{internal.WithDeletedPolicyRetention(conf.DeletedPolicyRetention)}
+	if conf.ReadReplicaDSN != "" {
+		log.Info("Connecting to MySQL read replica")
+
+		// the TLS configurations and server public keys registered by buildDSN can be used by the replica too
+		replicaDSN, err := formatDSN(conf.ReadReplicaDSN)
+		if err != nil {
+			return nil, err
+		}
+
+		replica, err := internal.ConnectWithRetries("mysql", replicaDSN, internal.DBConnectionRetries)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to read replica: %w", err)
+		}
+
+		conf.ConnPool.Configure(replica)
+		opts = append(opts, internal.WithReadReplica(goqu.New("mysql", replica), internal.DefaultReplicaReadDelay))
+	}
+
+	s, err := internal.NewDBStorage(ctx, goqu.New("mysql", db), opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	if !conf.SkipSchemaCheck {
 		if err := s.CheckSchema(ctx); err != nil {
-			return nil, fmt.Errorf("schema check failed. Ensure that the schema is correctly defined as documented at %s: %w", urlToSchemaDocs, err)
+			return nil, fmt.Errorf("schema check failed. Ensure that the schema is correctly defined as documented at %s or run `cerbos migrate` to create it: %w", urlToSchemaDocs, err)
 		}
 	}
 
 	return &Store{DBStorage: s}, nil
 }
 
+// Migrate creates the tables required by Cerbos and applies the pending schema migrations to the database named in the DSN.
+// If dryRun is true, the SQL of the pending migrations is written to out instead of being executed.
+func Migrate(ctx context.Context, conf *Conf, out io.Writer, dryRun bool) error {
+	dsn, err := buildDSN(conf)
+	if err != nil {
+		return err
+	}
+
+	// migrations contain several statements each
+	dbConf, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return fmt.Errorf("failed to parse DSN: %w", err)
+	}
+	dbConf.MultiStatements = true
+
+	db, err := sql.Open("mysql", dbConf.FormatDSN())
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	if err := db.PingContext(ctx); err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	d, err := migratemysql.WithInstance(db, &migratemysql.Config{})
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	return internal.Migrate(migrationsFS, DriverName, d, out, dryRun)
+}
+
 func buildDSN(conf *Conf) (string, error) {
 	if err := registerTLS(conf); err != nil {
 		return "", err
@@ -85,7 +149,11 @@ func buildDSN(conf *Conf) (string, error) {
 		return "", err
 	}
 
-	dbConf, err := mysql.ParseDSN(conf.DSN)
+	return formatDSN(conf.DSN)
+}
+
+func formatDSN(dsn string) (string, error) {
+	dbConf, err := mysql.ParseDSN(dsn)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse DSN: %w", err)
 	}
@@ -41,6 +41,15 @@ CREATE TABLE IF NOT EXISTS attr_schema_defs (
     id VARCHAR(255) PRIMARY KEY,
     definition JSON);
 
+CREATE TABLE IF NOT EXISTS store_event (
+    id INTEGER AUTO_INCREMENT PRIMARY KEY,
+    action VARCHAR(64),
+    target VARCHAR(1024),
+    revision_id INTEGER,
+    actor VARCHAR(255),
+    client_cert VARCHAR(1024),
+    event_timestamp TIMESTAMP DEFAULT CURRENT_TIMESTAMP);
+
 DROP TRIGGER IF EXISTS policy_on_insert;
 
 CREATE TRIGGER policy_on_insert AFTER INSERT ON policy 
@@ -67,4 +76,5 @@ GRANT SELECT,INSERT,UPDATE,DELETE ON cerbos.policy TO cerbos_user;
 GRANT SELECT,INSERT,UPDATE,DELETE ON cerbos.attr_schema_defs TO cerbos_user;
 GRANT SELECT,INSERT,UPDATE,DELETE ON cerbos.policy_dependency TO cerbos_user;
 GRANT SELECT,INSERT,UPDATE,DELETE ON cerbos.policy_ancestor TO cerbos_user;
-GRANT SELECT,INSERT ON cerbos.policy_revision TO cerbos_user; 
+GRANT SELECT,INSERT,DELETE ON cerbos.policy_revision TO cerbos_user; 
+GRANT SELECT,INSERT ON cerbos.store_event TO cerbos_user;
@@ -0,0 +1,99 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package nats
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+const (
+	confKey               = storage.ConfKey + ".nats"
+	defaultBucket         = "cerbos"
+	defaultReplicas       = 1
+	defaultConnectTimeout = 5 * time.Second
+	maxReplicas           = 5
+)
+
+var validBucketRe = regexp.MustCompile(`\A[a-zA-Z0-9_-]+\z`)
+
+// Conf is required (if driver is set to 'nats') configuration for nats driver.
+// +desc=This section is required only if storage.driver is nats.
+type Conf struct {
+	// TLS holds the TLS configuration to use when connecting to the NATS servers.
+	TLS *TLSConf `yaml:"tls" conf:",example=\n  caCert: /path/to/CA_certificate\n  cert: /path/to/certificate\n  key: /path/to/private_key"`
+	// URL is the comma-separated list of NATS servers to connect to.
+	URL string `yaml:"url" conf:"required,example=\"nats://localhost:4222\""`
+	// Bucket is the name of the JetStream key-value bucket that holds the policies and schemas. Defaults to cerbos.
+	Bucket string `yaml:"bucket" conf:",example=cerbos"`
+	// CredentialsFile is the path to a NATS user credentials file.
+	CredentialsFile string `yaml:"credentialsFile" conf:",example=/path/to/user.creds"`
+	// Token is the token to authenticate with.
+	Token string `yaml:"token" conf:",example=${NATS_TOKEN}"`
+	// Username is the user name to authenticate with.
+	Username string `yaml:"username" conf:",example=cerbos"`
+	// Password is the password of the user to authenticate with.
+	Password string `yaml:"password" conf:",example=${NATS_PASSWORD}"`
+	// Replicas is the number of replicas of the bucket to create if it doesn't exist. Defaults to 1.
+	Replicas int `yaml:"replicas" conf:",example=3"`
+	// ConnectTimeout is the maximum time to wait for a connection to a server to be established.
+	ConnectTimeout time.Duration `yaml:"connectTimeout" conf:",example=5s"`
+	// DisableWatch disables watching the bucket for changes made by other Cerbos instances.
+	DisableWatch bool `yaml:"disableWatch" conf:",example=false"`
+	// Sync configures the backoff for retrying after failures to watch the bucket and when the store is reported as degraded.
+	Sync *storage.SyncConf `yaml:"sync,omitempty" conf:",example=\n  initialInterval: 1s\n  maxInterval: 30s\n  degradedAfter: 3"`
+}
+
+type TLSConf struct {
+	// CACert is the path to the CA certificate used to verify the NATS server certificates.
+	CACert string `yaml:"caCert"`
+	// Cert is the path to the client certificate.
+	Cert string `yaml:"cert"`
+	// Key is the path to the client certificate key.
+	Key string `yaml:"key"`
+}
+
+func (c *Conf) Key() string {
+	return confKey
+}
+
+func (c *Conf) SetDefaults() {
+	c.Bucket = defaultBucket
+	c.Replicas = defaultReplicas
+	c.ConnectTimeout = defaultConnectTimeout
+}
+
+func (c *Conf) Validate() (errs error) {
+	if strings.TrimSpace(c.URL) == "" {
+		errs = multierr.Append(errs, errors.New("url is required"))
+	}
+
+	if !validBucketRe.MatchString(c.Bucket) {
+		errs = multierr.Append(errs, errors.New("bucket name must only contain letters, numbers, dashes and underscores"))
+	}
+
+	if c.Replicas < 1 || c.Replicas > maxReplicas {
+		errs = multierr.Append(errs, errors.New("replicas must be between 1 and 5"))
+	}
+
+	if c.ConnectTimeout <= 0 {
+		errs = multierr.Append(errs, errors.New("connectTimeout must be positive"))
+	}
+
+	if c.Token != "" && (c.Username != "" || c.CredentialsFile != "") {
+		errs = multierr.Append(errs, errors.New("token cannot be combined with username or credentialsFile"))
+	}
+
+	if c.TLS != nil && (c.TLS.Cert == "") != (c.TLS.Key == "") {
+		errs = multierr.Append(errs, errors.New("both cert and key must be provided for TLS client authentication"))
+	}
+
+	return multierr.Append(errs, c.Sync.Validate())
+}
@@ -0,0 +1,118 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package nats
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+)
+
+const (
+	policiesPrefix = "policies."
+	schemasPrefix  = "schemas."
+
+	// policiesFilter and schemasFilter are the subject filters matching all the keys with the respective prefix.
+	policiesFilter = policiesPrefix + ">"
+	schemasFilter  = schemasPrefix + ">"
+)
+
+// validKeyRe matches the characters allowed in a JetStream key-value store key.
+var validKeyRe = regexp.MustCompile(`\A[-/_=\.a-zA-Z0-9]+\z`)
+
+// policyRecord is the value stored under the policy key, which is the module ID of the policy.
+type policyRecord struct {
+	Kind         string   `json:"kind"`
+	Name         string   `json:"name"`
+	Version      string   `json:"version"`
+	Scope        string   `json:"scope"`
+	Description  string   `json:"description,omitempty"`
+	Definition   []byte   `json:"definition"`
+	Dependencies []uint64 `json:"dependencies,omitempty"`
+	Ancestors    []uint64 `json:"ancestors,omitempty"`
+	Disabled     bool     `json:"disabled"`
+}
+
+func (r *policyRecord) policy() (*policyv1.Policy, error) {
+	p := &policyv1.Policy{}
+	if err := p.UnmarshalVT(r.Definition); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal policy definition: %w", err)
+	}
+
+	return p, nil
+}
+
+func (r *policyRecord) coords() namer.PolicyCoords {
+	return namer.PolicyCoords{Kind: r.Kind, Name: r.Name, Version: r.Version, Scope: r.Scope}
+}
+
+func decodePolicyRecord(value []byte) (*policyRecord, error) {
+	r := &policyRecord{}
+	if err := json.Unmarshal(value, r); err != nil {
+		return nil, fmt.Errorf("failed to decode policy record: %w", err)
+	}
+
+	return r, nil
+}
+
+func policyKey(id uint64) string {
+	return policiesPrefix + strconv.FormatUint(id, 10)
+}
+
+func schemaKey(id string) string {
+	return schemasPrefix + id
+}
+
+// policyID extracts the module ID from a policy key.
+func policyID(key string) (uint64, bool) {
+	if !strings.HasPrefix(key, policiesPrefix) {
+		return 0, false
+	}
+
+	id, err := strconv.ParseUint(strings.TrimPrefix(key, policiesPrefix), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return id, true
+}
+
+// schemaID extracts the schema ID from a schema key.
+func schemaID(key string) (string, bool) {
+	if !strings.HasPrefix(key, schemasPrefix) || len(key) == len(schemasPrefix) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(key, schemasPrefix), true
+}
+
+// isValidSchemaID returns true if the schema ID can be used as part of a key.
+func isValidSchemaID(id string) bool {
+	return validKeyRe.MatchString(id) && !strings.HasSuffix(id, ".")
+}
+
+func rawID(id namer.ModuleID) uint64 {
+	v, _ := id.Value()
+	return v.(uint64) //nolint:forcetypeassert
+}
+
+func rawIDs(ids []namer.ModuleID) []uint64 {
+	out := make([]uint64, len(ids))
+	for i, id := range ids {
+		out[i] = rawID(id)
+	}
+
+	return out
+}
+
+func moduleID(id uint64) namer.ModuleID {
+	var m namer.ModuleID
+	_ = m.Scan(id)
+	return m
+}
@@ -0,0 +1,953 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package nats
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	natsgo "github.com/nats-io/nats.go"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+
+	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/observability/logging"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/db"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const (
+	DriverName = "nats"
+
+	connectRetries = 3
+	requestTimeout = 10 * time.Second
+	// maxWatchRetryInterval is the default maximum time to wait before re-establishing a watch that failed.
+	maxWatchRetryInterval = 30 * time.Second
+)
+
+var (
+	_ storage.SourceStore  = (*Store)(nil)
+	_ storage.MutableStore = (*Store)(nil)
+	_ storage.Instrumented = (*Store)(nil)
+	_ storage.Reloadable   = (*Store)(nil)
+	_ storage.Syncable     = (*Store)(nil)
+	_ io.Closer            = (*Store)(nil)
+)
+
+func init() {
+	storage.RegisterDriver(DriverName, func(ctx context.Context, confW *config.Wrapper) (storage.Store, error) {
+		conf := new(Conf)
+		if err := confW.GetSection(conf); err != nil {
+			return nil, err
+		}
+
+		return NewStore(ctx, conf)
+	})
+}
+
+// Store keeps the policies and schemas in a JetStream key-value bucket.
+// JetStream has no multi-key transactions, so a batch of changes is applied one key at a time.
+type Store struct {
+	conn        *natsgo.Conn
+	kv          natsgo.KeyValue
+	regexpCache *util.RegexpCache
+	log         *zap.Logger
+	cancel      context.CancelFunc
+	done        chan struct{}
+	syncStatus  *storage.SyncStatus
+	*storage.SubscriptionManager
+}
+
+func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
+	log := logging.FromContext(ctx).Named("nats")
+	log.Info("Initializing NATS storage", zap.String("url", conf.URL), zap.String("bucket", conf.Bucket))
+
+	opts, err := mkConnectOptions(conf, log)
+	if err != nil {
+		return nil, err
+	}
+
+	var conn *natsgo.Conn
+	connectFn := func() (err error) {
+		conn, err = natsgo.Connect(conf.URL, opts...)
+		return err
+	}
+
+	if err := backoff.Retry(connectFn, backoff.WithContext(backoff.WithMaxRetries(backoff.NewExponentialBackOff(), connectRetries), ctx)); err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	kv, err := getOrCreateBucket(conn, conf)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	s := &Store{
+		conn:                conn,
+		kv:                  kv,
+		regexpCache:         util.NewRegexpCache(),
+		log:                 log,
+		done:                make(chan struct{}),
+		SubscriptionManager: storage.NewSubscriptionManager(ctx),
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	if conf.DisableWatch {
+		close(s.done)
+		return s, nil
+	}
+
+	// The watcher is created before returning so that no changes are missed after the store is created.
+	watcher, err := kv.WatchAll(natsgo.UpdatesOnly())
+	if err != nil {
+		cancel()
+		conn.Close()
+		return nil, fmt.Errorf("failed to watch bucket: %w", err)
+	}
+
+	s.syncStatus = storage.NewSyncStatus(DriverName, conf.Sync)
+	go s.watch(watchCtx, watcher, conf.Sync.NewBackOff(backoff.DefaultInitialInterval, maxWatchRetryInterval))
+
+	return s, nil
+}
+
+func mkConnectOptions(conf *Conf, log *zap.Logger) ([]natsgo.Option, error) {
+	opts := []natsgo.Option{
+		natsgo.Name("cerbos"),
+		natsgo.Timeout(conf.ConnectTimeout),
+		natsgo.MaxReconnects(-1),
+		natsgo.DisconnectErrHandler(func(_ *natsgo.Conn, err error) {
+			if err != nil {
+				log.Warn("Disconnected from NATS", zap.Error(err))
+			}
+		}),
+		natsgo.ReconnectHandler(func(c *natsgo.Conn) {
+			log.Info("Reconnected to NATS", zap.String("server", c.ConnectedUrlRedacted()))
+		}),
+	}
+
+	switch {
+	case conf.CredentialsFile != "":
+		opts = append(opts, natsgo.UserCredentials(conf.CredentialsFile))
+	case conf.Token != "":
+		opts = append(opts, natsgo.Token(conf.Token))
+	}
+
+	if conf.Username != "" {
+		opts = append(opts, natsgo.UserInfo(conf.Username, conf.Password))
+	}
+
+	tlsConf, err := mkTLSConfig(conf.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	if tlsConf != nil {
+		opts = append(opts, natsgo.Secure(tlsConf))
+	}
+
+	return opts, nil
+}
+
+func getOrCreateBucket(conn *natsgo.Conn, conf *Conf) (natsgo.KeyValue, error) {
+	js, err := conn.JetStream(natsgo.MaxWait(requestTimeout))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+
+	kv, err := js.KeyValue(conf.Bucket)
+	if err == nil {
+		return kv, nil
+	}
+
+	if !errors.Is(err, natsgo.ErrBucketNotFound) {
+		return nil, fmt.Errorf("failed to get bucket %q: %w", conf.Bucket, err)
+	}
+
+	// Only the latest value of each key is required, so the bucket doesn't keep any history.
+	kv, err = js.CreateKeyValue(&natsgo.KeyValueConfig{
+		Bucket:      conf.Bucket,
+		Description: "Cerbos policies and schemas",
+		History:     1,
+		Replicas:    conf.Replicas,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bucket %q: %w", conf.Bucket, err)
+	}
+
+	return kv, nil
+}
+
+func mkTLSConfig(conf *TLSConf) (*tls.Config, error) {
+	if conf == nil {
+		return nil, nil
+	}
+
+	tlsConf := util.DefaultTLSConfig()
+	if conf.Cert != "" {
+		cert, err := tls.LoadX509KeyPair(conf.Cert, conf.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+		}
+
+		tlsConf.Certificates = []tls.Certificate{cert}
+	}
+
+	if conf.CACert != "" {
+		caPEM, err := os.ReadFile(conf.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load CA certificate: %w", err)
+		}
+
+		certPool := x509.NewCertPool()
+		if ok := certPool.AppendCertsFromPEM(caPEM); !ok {
+			return nil, errors.New("failed to add CA certificate to pool")
+		}
+
+		tlsConf.RootCAs = certPool
+	}
+
+	return tlsConf, nil
+}
+
+// SyncStatus returns the status of the watch, or nil if watching for changes is disabled.
+func (s *Store) SyncStatus() *storage.SyncStatus {
+	return s.syncStatus
+}
+
+func (s *Store) Driver() string {
+	return DriverName
+}
+
+// Close stops watching for changes and closes the connection to NATS.
+func (s *Store) Close() error {
+	s.cancel()
+	<-s.done
+
+	s.conn.Close()
+	return nil
+}
+
+func (s *Store) AddOrUpdate(ctx context.Context, policies ...policy.Wrapper) error {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	// Encode all the policies before writing any of them so that an invalid policy doesn't leave a partial update behind.
+	values := make([][]byte, len(policies))
+	events := make([]storage.Event, len(policies))
+	for i, p := range policies {
+		def, err := p.Policy.MarshalVT()
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s: %w", p.FQN, err)
+		}
+
+		values[i], err = json.Marshal(policyRecord{
+			Kind:         p.Kind.String(),
+			Name:         p.Name,
+			Version:      p.Version,
+			Scope:        p.Scope,
+			Description:  p.Description,
+			Disabled:     p.Disabled,
+			Definition:   def,
+			Dependencies: rawIDs(p.Dependencies()),
+			Ancestors:    rawIDs(policy.Ancestors(p.Policy)),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", p.FQN, err)
+		}
+
+		events[i] = storage.Event{Kind: storage.EventAddOrUpdatePolicy, PolicyID: p.ID}
+	}
+
+	for i, p := range policies {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if _, err := s.kv.Put(policyKey(rawID(p.ID)), values[i]); err != nil {
+			return fmt.Errorf("failed to upsert policies: %w", err)
+		}
+	}
+
+	_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
+		tag.Upsert(metrics.KeyIndexCRUDKind, "upsert"),
+	}, metrics.IndexCRUDCount.M(int64(len(policies))))
+
+	s.NotifySubscribers(events...)
+	return nil
+}
+
+func (s *Store) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID) (*policy.CompilationUnit, error) {
+	results, err := s.GetCompilationUnits(ctx, candidates...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range candidates {
+		if cu, ok := results[id]; ok {
+			return cu, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Store) GetCompilationUnits(ctx context.Context, ids ...namer.ModuleID) (map[namer.ModuleID]*policy.CompilationUnit, error) {
+	// Policies are loaded level by level: the requested policies, then their ancestors and finally the
+	// dependencies of all of them until there's nothing new to load. Disabled policies are left out.
+	loaded := make(map[uint64]*policyRecord)
+	load := func(toLoad []uint64) error {
+		var missing []uint64
+		for _, id := range toLoad {
+			if _, ok := loaded[id]; !ok {
+				missing = append(missing, id)
+			}
+		}
+
+		if len(missing) == 0 {
+			return nil
+		}
+
+		records, err := s.getPolicies(ctx, missing)
+		if err != nil {
+			return err
+		}
+
+		// Remember the policies that don't exist or are disabled to avoid looking for them again.
+		for _, id := range missing {
+			if r, ok := records[id]; ok && !r.Disabled {
+				loaded[id] = r
+			} else {
+				loaded[id] = nil
+			}
+		}
+
+		return nil
+	}
+
+	rootIDs := rawIDs(ids)
+	if err := load(rootIDs); err != nil {
+		return nil, fmt.Errorf("failed to get policies: %w", err)
+	}
+
+	var ancestorIDs []uint64
+	for _, id := range rootIDs {
+		if r := loaded[id]; r != nil {
+			ancestorIDs = append(ancestorIDs, r.Ancestors...)
+		}
+	}
+
+	if err := load(ancestorIDs); err != nil {
+		return nil, fmt.Errorf("failed to get ancestors: %w", err)
+	}
+
+	for {
+		var depIDs []uint64
+		for _, r := range loaded {
+			if r == nil {
+				continue
+			}
+
+			for _, dep := range r.Dependencies {
+				if _, ok := loaded[dep]; !ok {
+					depIDs = append(depIDs, dep)
+				}
+			}
+		}
+
+		if len(depIDs) == 0 {
+			break
+		}
+
+		if err := load(depIDs); err != nil {
+			return nil, fmt.Errorf("failed to get dependencies: %w", err)
+		}
+	}
+
+	units := make(map[namer.ModuleID]*policy.CompilationUnit)
+	for _, id := range rootIDs {
+		root := loaded[id]
+		if root == nil {
+			continue
+		}
+
+		unit := &policy.CompilationUnit{ModID: moduleID(id)}
+		var add func(uint64, *policyRecord) error
+		add = func(id uint64, r *policyRecord) error {
+			modID := moduleID(id)
+			if _, ok := unit.Definitions[modID]; ok {
+				return nil
+			}
+
+			p, err := r.policy()
+			if err != nil {
+				return err
+			}
+
+			unit.AddDefinition(modID, p)
+			for _, dep := range r.Dependencies {
+				if depRecord := loaded[dep]; depRecord != nil {
+					if err := add(dep, depRecord); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		}
+
+		if err := add(id, root); err != nil {
+			return nil, err
+		}
+
+		for _, ancestor := range root.Ancestors {
+			if ancestorRecord := loaded[ancestor]; ancestorRecord != nil {
+				if err := add(ancestor, ancestorRecord); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		units[unit.ModID] = unit
+	}
+
+	return units, nil
+}
+
+func (s *Store) GetDependents(ctx context.Context, ids ...namer.ModuleID) (map[namer.ModuleID][]namer.ModuleID, error) {
+	records, err := s.listPolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dependents: %w", err)
+	}
+
+	dependents := make(map[uint64][]uint64)
+	for id, r := range records {
+		for _, dep := range r.Dependencies {
+			dependents[dep] = append(dependents[dep], id)
+		}
+	}
+
+	// There's a maximum of two levels of dependency (resourcePolicy -> derivedRoles -> exportVariables),
+	// so the dependents are the direct dependents and the dependents of the direct dependents.
+	out := make(map[namer.ModuleID][]namer.ModuleID, len(ids))
+	for _, id := range ids {
+		seen := make(map[uint64]struct{})
+		for _, direct := range dependents[rawID(id)] {
+			seen[direct] = struct{}{}
+			for _, indirect := range dependents[direct] {
+				seen[indirect] = struct{}{}
+			}
+		}
+
+		if len(seen) == 0 {
+			continue
+		}
+
+		deps := make([]namer.ModuleID, 0, len(seen))
+		for dep := range seen {
+			deps = append(deps, moduleID(dep))
+		}
+
+		out[id] = deps
+	}
+
+	return out, nil
+}
+
+func (s *Store) LoadPolicy(ctx context.Context, policyKey ...string) ([]*policy.Wrapper, error) {
+	ids := make([]uint64, len(policyKey))
+	for i, pk := range policyKey {
+		ids[i] = rawID(namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk)))
+	}
+
+	records, err := s.getPolicies(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get policies: %w", err)
+	}
+
+	policies := make([]*policy.Wrapper, 0, len(records))
+	for _, id := range ids {
+		r, ok := records[id]
+		if !ok {
+			continue
+		}
+
+		p, err := r.policy()
+		if err != nil {
+			return nil, err
+		}
+
+		pk := namer.PolicyKey(p)
+		wp := policy.Wrap(policy.WithMetadata(p, "", nil, pk))
+		wp.Disabled = r.Disabled
+		policies = append(policies, &wp)
+	}
+
+	return policies, nil
+}
+
+func (s *Store) ListPolicyIDs(ctx context.Context, params storage.ListPolicyIDsParams) ([]string, error) {
+	var filters []regexpFilter
+	for _, f := range []struct {
+		pattern string
+		get     func(namer.PolicyCoords) string
+	}{
+		{pattern: params.NameRegexp, get: func(pc namer.PolicyCoords) string { return pc.Name }},
+		{pattern: params.ScopeRegexp, get: func(pc namer.PolicyCoords) string { return pc.Scope }},
+		{pattern: params.VersionRegexp, get: func(pc namer.PolicyCoords) string { return pc.Version }},
+	} {
+		if f.pattern == "" {
+			continue
+		}
+
+		re, err := s.regexpCache.GetCompiledExpr(f.pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, regexpFilter{match: re.MatchString, get: f.get})
+	}
+
+	records, err := s.listPolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not execute %q query: %w", "ListPolicyIDs", err)
+	}
+
+	coords := make([]namer.PolicyCoords, 0, len(records))
+outer:
+	for _, r := range records {
+		if r.Disabled && !params.IncludeDisabled {
+			continue
+		}
+
+		pc := r.coords()
+		for _, f := range filters {
+			if !f.match(f.get(pc)) {
+				continue outer
+			}
+		}
+
+		coords = append(coords, pc)
+	}
+
+	sort.Slice(coords, func(i, j int) bool {
+		a, b := coords[i], coords[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+
+		if a.Version != b.Version {
+			return a.Version < b.Version
+		}
+
+		return a.Scope < b.Scope
+	})
+
+	policyIDs := make([]string, len(coords))
+	for i, pc := range coords {
+		policyIDs[i] = pc.PolicyKey()
+	}
+
+	return policyIDs, nil
+}
+
+func (s *Store) Disable(ctx context.Context, policyKey ...string) (uint32, error) {
+	ids := make([]uint64, len(policyKey))
+	events := make([]storage.Event, len(policyKey))
+	for i, pk := range policyKey {
+		modID := namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk))
+		ids[i] = rawID(modID)
+		events[i] = storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, modID)
+	}
+
+	// Disabling a scoped policy that has enabled descendants would break the scope chain.
+	records, err := s.listPolicies(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get descendants for policies: %w", err)
+	}
+
+	hasDescendants := make(map[uint64]struct{})
+	for _, r := range records {
+		if r.Disabled {
+			continue
+		}
+
+		for _, a := range r.Ancestors {
+			hasDescendants[a] = struct{}{}
+		}
+	}
+
+	var brokenChainPolicies []string
+	for i, pk := range policyKey {
+		if _, ok := hasDescendants[ids[i]]; ok {
+			brokenChainPolicies = append(brokenChainPolicies, pk)
+		}
+	}
+
+	if len(brokenChainPolicies) > 0 {
+		return 0, db.ErrBreaksScopeChain{PolicyKeys: brokenChainPolicies}
+	}
+
+	count, err := s.setDisabled(ctx, ids, true)
+	if err != nil {
+		return 0, fmt.Errorf("failed to disable policies: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return count, nil
+}
+
+func (s *Store) Enable(ctx context.Context, policyKey ...string) (uint32, error) {
+	ids := make([]uint64, len(policyKey))
+	events := make([]storage.Event, len(policyKey))
+	for i, pk := range policyKey {
+		modID := namer.GenModuleIDFromFQN(namer.FQNFromPolicyKey(pk))
+		ids[i] = rawID(modID)
+		events[i] = storage.NewPolicyEvent(storage.EventAddOrUpdatePolicy, modID)
+	}
+
+	count, err := s.setDisabled(ctx, ids, false)
+	if err != nil {
+		return 0, fmt.Errorf("failed to enable policies: %w", err)
+	}
+
+	s.NotifySubscribers(events...)
+	return count, nil
+}
+
+// setDisabled updates the disabled flag of the given policies and returns the number of policies that exist.
+func (s *Store) setDisabled(ctx context.Context, ids []uint64, disabled bool) (uint32, error) {
+	var count uint32
+	for _, id := range ids {
+		exists, err := s.updateDisabled(ctx, policyKey(id), disabled)
+		if err != nil {
+			return count, err
+		}
+
+		if exists {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// updateDisabled updates the disabled flag of a policy record and returns false if the record doesn't exist.
+// The update is conditional on the revision that was read so that concurrent changes to the same policy aren't lost.
+func (s *Store) updateDisabled(ctx context.Context, key string, disabled bool) (bool, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+
+		entry, err := s.kv.Get(key)
+		if err != nil {
+			if errors.Is(err, natsgo.ErrKeyNotFound) {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		r, err := decodePolicyRecord(entry.Value())
+		if err != nil {
+			return false, err
+		}
+
+		r.Disabled = disabled
+		updated, err := json.Marshal(r)
+		if err != nil {
+			return false, err
+		}
+
+		if _, err := s.kv.Update(key, updated, entry.Revision()); err != nil {
+			if errors.Is(err, natsgo.ErrKeyExists) {
+				// The record was modified after it was read.
+				continue
+			}
+
+			return false, err
+		}
+
+		return true, nil
+	}
+}
+
+func (s *Store) Delete(ctx context.Context, ids ...namer.ModuleID) error {
+	events := make([]storage.Event, len(ids))
+	for i, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := s.kv.Delete(policyKey(rawID(id))); err != nil {
+			return fmt.Errorf("failed to delete policies: %w", err)
+		}
+
+		events[i] = storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, id)
+	}
+
+	s.NotifySubscribers(events...)
+	return nil
+}
+
+func (s *Store) AddOrUpdateSchema(ctx context.Context, schemas ...*schemav1.Schema) error {
+	if len(schemas) == 0 {
+		return nil
+	}
+
+	events := make([]storage.Event, len(schemas))
+	for i, sch := range schemas {
+		if !isValidSchemaID(sch.Id) {
+			return storage.NewInvalidSchemaError(errors.New("invalid characters in ID"), "schema ID %q is not a valid key", sch.Id)
+		}
+
+		var def json.RawMessage
+		if err := json.Unmarshal(sch.Definition, &def); err != nil {
+			return storage.NewInvalidSchemaError(err, "schema definition with ID %q is not valid", sch.Id)
+		}
+
+		events[i] = storage.NewSchemaEvent(storage.EventAddOrUpdateSchema, sch.Id)
+	}
+
+	for _, sch := range schemas {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if _, err := s.kv.Put(schemaKey(sch.Id), sch.Definition); err != nil {
+			return fmt.Errorf("failed to upsert schemas: %w", err)
+		}
+	}
+
+	s.NotifySubscribers(events...)
+	return nil
+}
+
+func (s *Store) DeleteSchema(ctx context.Context, ids ...string) (uint32, error) {
+	var deleted uint32
+	events := make([]storage.Event, len(ids))
+	for i, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return deleted, err
+		}
+
+		events[i] = storage.NewSchemaEvent(storage.EventDeleteSchema, id)
+		if !isValidSchemaID(id) {
+			continue
+		}
+
+		if _, err := s.kv.Get(schemaKey(id)); err != nil {
+			if errors.Is(err, natsgo.ErrKeyNotFound) {
+				continue
+			}
+
+			return deleted, fmt.Errorf("failed to delete schema(s): %w", err)
+		}
+
+		if err := s.kv.Delete(schemaKey(id)); err != nil {
+			return deleted, fmt.Errorf("failed to delete schema(s): %w", err)
+		}
+
+		deleted++
+	}
+
+	s.NotifySubscribers(events...)
+	return deleted, nil
+}
+
+func (s *Store) ListSchemaIDs(ctx context.Context) ([]string, error) {
+	entries, err := s.entries(ctx, schemasFilter, natsgo.MetaOnly())
+	if err != nil {
+		return nil, fmt.Errorf("could not execute %q query: %w", "ListSchemaIDs", err)
+	}
+
+	schemaIDs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if id, ok := schemaID(entry.Key()); ok {
+			schemaIDs = append(schemaIDs, id)
+		}
+	}
+
+	sort.Strings(schemaIDs)
+	return schemaIDs, nil
+}
+
+func (s *Store) LoadSchema(_ context.Context, urlVar string) (io.ReadCloser, error) {
+	u, err := url.Parse(urlVar)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != "" && u.Scheme != schema.URLScheme {
+		return nil, fmt.Errorf("invalid url scheme %q", u.Scheme)
+	}
+
+	id := strings.TrimPrefix(u.Path, "/")
+	if !isValidSchemaID(id) {
+		return nil, fmt.Errorf("failed to find schema")
+	}
+
+	entry, err := s.kv.Get(schemaKey(id))
+	if err != nil {
+		if errors.Is(err, natsgo.ErrKeyNotFound) {
+			return nil, fmt.Errorf("failed to find schema")
+		}
+
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+
+	return io.NopCloser(strings.NewReader(string(entry.Value()))), nil
+}
+
+func (s *Store) RepoStats(ctx context.Context) storage.RepoStats {
+	stats := storage.RepoStats{}
+
+	records, err := s.listPolicies(ctx)
+	if err != nil {
+		return stats
+	}
+
+	stats.PolicyCount = make(map[policy.Kind]int)
+	for _, r := range records {
+		switch r.Kind {
+		case policy.DerivedRolesKindStr:
+			stats.PolicyCount[policy.DerivedRolesKind]++
+		case policy.ExportVariablesKindStr:
+			stats.PolicyCount[policy.ExportVariablesKind]++
+		case policy.PrincipalKindStr:
+			stats.PolicyCount[policy.PrincipalKind]++
+		case policy.ResourceKindStr:
+			stats.PolicyCount[policy.ResourceKind]++
+		}
+	}
+
+	if entries, err := s.entries(ctx, schemasFilter, natsgo.MetaOnly()); err == nil {
+		stats.SchemaCount = len(entries)
+	}
+
+	return stats
+}
+
+func (s *Store) Reload(context.Context) error {
+	s.NotifySubscribers(storage.NewReloadEvent())
+	return nil
+}
+
+// entries returns the current entries of the keys matching the filter, excluding deleted keys.
+func (s *Store) entries(ctx context.Context, filter string, opts ...natsgo.WatchOpt) ([]natsgo.KeyValueEntry, error) {
+	// A watcher delivers the latest value of each key followed by a nil entry, which makes it the most efficient way to
+	// read a range of keys.
+	watcher, err := s.kv.Watch(filter, append(opts, natsgo.IgnoreDeletes(), natsgo.Context(ctx))...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	var entries []natsgo.KeyValueEntry
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case entry, ok := <-watcher.Updates():
+			if !ok {
+				return nil, errWatchClosed
+			}
+
+			if entry == nil {
+				return entries, nil
+			}
+
+			entries = append(entries, entry)
+		}
+	}
+}
+
+// getPolicies returns the records of the policies that exist among the given IDs.
+func (s *Store) getPolicies(ctx context.Context, ids []uint64) (map[uint64]*policyRecord, error) {
+	records := make(map[uint64]*policyRecord, len(ids))
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		entry, err := s.kv.Get(policyKey(id))
+		if err != nil {
+			if errors.Is(err, natsgo.ErrKeyNotFound) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		if err := s.addRecord(records, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+// listPolicies returns the records of all the policies in the store.
+func (s *Store) listPolicies(ctx context.Context) (map[uint64]*policyRecord, error) {
+	entries, err := s.entries(ctx, policiesFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(map[uint64]*policyRecord, len(entries))
+	for _, entry := range entries {
+		if err := s.addRecord(records, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+func (s *Store) addRecord(records map[uint64]*policyRecord, entry natsgo.KeyValueEntry) error {
+	id, ok := policyID(entry.Key())
+	if !ok {
+		s.log.Warn("Ignoring unexpected key under the policies prefix", zap.String("key", entry.Key()))
+		return nil
+	}
+
+	r, err := decodePolicyRecord(entry.Value())
+	if err != nil {
+		return fmt.Errorf("invalid value for key %q: %w", entry.Key(), err)
+	}
+
+	records[id] = r
+	return nil
+}
+
+type regexpFilter struct {
+	match func(string) bool
+	get   func(namer.PolicyCoords) string
+}
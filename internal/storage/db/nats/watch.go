@@ -0,0 +1,130 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	natsgo "github.com/nats-io/nats.go"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+var errWatchClosed = errors.New("watch channel was closed")
+
+// watch notifies the subscribers of the store about the changes made by other Cerbos instances.
+// Changes made through this instance are notified twice: once when they are made and once when they arrive on the
+// watch channel. The second notification is harmless because it only causes the affected policies to be recompiled.
+// The watcher survives reconnections to the server, so it only needs to be re-established if the subscription is closed.
+func (s *Store) watch(ctx context.Context, watcher natsgo.KeyWatcher, retry backoff.BackOff) {
+	defer close(s.done)
+
+	s.log.Info("Watching for changes", zap.String("bucket", s.kv.Bucket()))
+	s.syncStatus.Live()
+	for {
+		if watcher == nil {
+			select {
+			case <-ctx.Done():
+				s.log.Info("Stopped watching for changes")
+				return
+			case <-time.After(retry.NextBackOff()):
+			}
+
+			var err error
+			if watcher, err = s.kv.WatchAll(natsgo.UpdatesOnly()); err != nil {
+				s.watchFailed(err)
+				continue
+			}
+
+			// The changes made while the watch was down are lost, so everything has to be reloaded.
+			s.log.Info("Resumed watching for changes")
+			s.syncStatus.Live()
+			s.NotifySubscribers(storage.NewReloadEvent())
+		}
+
+		err := s.stream(ctx, watcher, retry)
+		_ = watcher.Stop()
+		watcher = nil
+
+		if ctx.Err() != nil {
+			s.log.Info("Stopped watching for changes")
+			return
+		}
+
+		s.watchFailed(err)
+	}
+}
+
+func (s *Store) watchFailed(err error) {
+	s.log.Warn("Watch failed", zap.Error(err))
+	s.syncStatus.Failed()
+	_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
+		tag.Upsert(metrics.KeyStoreDriver, DriverName),
+	}, metrics.StoreSyncErrorCount.M(1))
+}
+
+// stream notifies the subscribers of the changes delivered by the watcher until the watcher is closed.
+func (s *Store) stream(ctx context.Context, watcher natsgo.KeyWatcher, retry backoff.BackOff) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case entry, ok := <-watcher.Updates():
+			if !ok {
+				return errWatchClosed
+			}
+
+			// A nil entry marks the end of the initial values, which there aren't any of when watching for updates only.
+			if entry == nil {
+				continue
+			}
+
+			retry.Reset()
+			if evt, ok := s.storageEvent(entry); ok {
+				s.NotifySubscribers(evt)
+			}
+		}
+	}
+}
+
+func (s *Store) storageEvent(entry natsgo.KeyValueEntry) (storage.Event, bool) {
+	deleted := entry.Operation() == natsgo.KeyValueDelete || entry.Operation() == natsgo.KeyValuePurge
+
+	if id, ok := policyID(entry.Key()); ok {
+		kind := storage.EventAddOrUpdatePolicy
+		if deleted || s.isDisabled(entry.Value()) {
+			kind = storage.EventDeleteOrDisablePolicy
+		}
+
+		return storage.NewPolicyEvent(kind, moduleID(id)), true
+	}
+
+	if id, ok := schemaID(entry.Key()); ok {
+		kind := storage.EventAddOrUpdateSchema
+		if deleted {
+			kind = storage.EventDeleteSchema
+		}
+
+		return storage.NewSchemaEvent(kind, id), true
+	}
+
+	return storage.Event{}, false
+}
+
+func (s *Store) isDisabled(value []byte) bool {
+	r, err := decodePolicyRecord(value)
+	if err != nil {
+		s.log.Warn("Failed to decode policy record from watch event", zap.Error(err))
+		return false
+	}
+
+	return r.Disabled
+}
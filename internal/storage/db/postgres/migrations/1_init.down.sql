@@ -0,0 +1,13 @@
+DROP TRIGGER IF EXISTS policy_audit ON policy;
+
+DROP FUNCTION IF EXISTS process_policy_audit;
+
+DROP TABLE IF EXISTS attr_schema_defs;
+
+DROP TABLE IF EXISTS policy_revision;
+
+DROP TABLE IF EXISTS policy_ancestor;
+
+DROP TABLE IF EXISTS policy_dependency;
+
+DROP TABLE IF EXISTS policy;
@@ -5,16 +5,21 @@ package postgres
 
 import (
 	"context"
+	"embed"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/doug-martin/goqu/v9"
 
 	// Import the postgres dialect.
 	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	migratepostgres "github.com/golang-migrate/migrate/v4/database/postgres"
 	pgxzap "github.com/jackc/pgx-zap"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/jackc/pgx/v5/tracelog"
+	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
 
 	"github.com/cerbos/cerbos/internal/config"
@@ -28,9 +33,17 @@ const (
 	urlToSchemaDocs = "https://docs.cerbos.dev/cerbos/latest/configuration/storage.html#postgres-schema"
 )
 
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
 var (
 	_ storage.SourceStore  = (*Store)(nil)
 	_ storage.MutableStore = (*Store)(nil)
+	_ storage.Historical   = (*Store)(nil)
+	_ storage.Versioned    = (*Store)(nil)
+	_ storage.Restorable   = (*Store)(nil)
+	_ storage.Revisioned   = (*Store)(nil)
+	_ storage.Audited      = (*Store)(nil)
 )
 
 func init() {
@@ -47,14 +60,47 @@ func init() {
 func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
 	log := logging.FromContext(ctx).Named("postgres")
 
-	pgConf, err := pgx.ParseConfig(conf.URL)
+	db, err := connect(log, conf.URL, "Initializing Postgres storage")
+	if err != nil {
+		return nil, err
+	}
+
+	conf.ConnPool.Configure(db)
+
+	opts := []internal.DBOpt{internal.WithDeletedPolicyRetention(conf.DeletedPolicyRetention)}
+	if conf.ReadReplicaURL != "" {
+		replica, err := connect(log, conf.ReadReplicaURL, "Connecting to Postgres read replica")
+		if err != nil {
+			return nil, err
+		}
+
+		conf.ConnPool.Configure(replica)
+		opts = append(opts, internal.WithReadReplica(goqu.New("postgres", replica), internal.DefaultReplicaReadDelay))
+	}
+
+	s, err := internal.NewDBStorage(ctx, goqu.New("postgres", db), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !conf.SkipSchemaCheck {
+		if err := s.CheckSchema(ctx); err != nil {
+			return nil, fmt.Errorf("schema check failed. Ensure that the schema is correctly defined as documented at %s or run `cerbos migrate` to create it: %w", urlToSchemaDocs, err)
+		}
+	}
+
+	return &Store{DBStorage: s}, nil
+}
+
+func connect(log *zap.Logger, url, msg string) (*sqlx.DB, error) {
+	pgConf, err := pgx.ParseConfig(url)
 	if err != nil {
 		log.Error("Failed to parse Postgres connection URL", zap.Error(err))
 		return nil, err
 	}
 	pgConf.Tracer = &tracelog.TraceLog{Logger: pgxzap.NewLogger(log), LogLevel: tracelog.LogLevelWarn}
 
-	log.Info("Initializing Postgres storage", zap.String("host", pgConf.Host), zap.String("database", pgConf.Database))
+	log.Info(msg, zap.String("host", pgConf.Host), zap.String("database", pgConf.Database))
 
 	connStr := stdlib.RegisterConnConfig(pgConf)
 	db, err := internal.ConnectWithRetries("pgx", connStr, internal.DBConnectionRetries)
@@ -62,20 +108,34 @@ func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
-	conf.ConnPool.Configure(db)
+	return db, nil
+}
 
-	s, err := internal.NewDBStorage(ctx, goqu.New("postgres", db))
+// Migrate creates the tables required by Cerbos and applies the pending schema migrations to the database.
+// If the connection URL has a search_path parameter, the first schema in the path is created if it doesn't exist.
+// If dryRun is true, the SQL of the pending migrations is written to out instead of being executed.
+func Migrate(ctx context.Context, conf *Conf, out io.Writer, dryRun bool) error {
+	pgConf, err := pgx.ParseConfig(conf.URL)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("failed to parse Postgres connection URL: %w", err)
 	}
 
-	if !conf.SkipSchemaCheck {
-		if err := s.CheckSchema(ctx); err != nil {
-			return nil, fmt.Errorf("schema check failed. Ensure that the schema is correctly defined as documented at %s: %w", urlToSchemaDocs, err)
+	db := stdlib.OpenDB(*pgConf)
+	defer db.Close()
+
+	if searchPath := pgConf.RuntimeParams["search_path"]; searchPath != "" && !dryRun {
+		schemaName := strings.TrimSpace(strings.Split(searchPath, ",")[0])
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", pgx.Identifier{schemaName}.Sanitize())); err != nil {
+			return fmt.Errorf("failed to create schema %q: %w", schemaName, err)
 		}
 	}
 
-	return &Store{DBStorage: s}, nil
+	d, err := migratepostgres.WithInstance(db, &migratepostgres.Config{})
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	return internal.Migrate(migrationsFS, DriverName, d, out, dryRun)
 }
 
 type Store struct {
@@ -46,6 +46,16 @@ CREATE TABLE IF NOT EXISTS attr_schema_defs (
     definition JSON
 );
 
+CREATE TABLE IF NOT EXISTS store_event (
+    id SERIAL PRIMARY KEY,
+    action VARCHAR(64),
+    target VARCHAR(1024),
+    revision_id INTEGER,
+    actor VARCHAR(255),
+    client_cert VARCHAR(1024),
+    event_timestamp TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
 CREATE OR REPLACE FUNCTION process_policy_audit() RETURNS TRIGGER AS $policy_audit$
     BEGIN
         IF (TG_OP = 'DELETE') THEN
@@ -70,5 +80,7 @@ CREATE USER cerbos_user WITH PASSWORD 'changeme';
 GRANT CONNECT ON DATABASE postgres TO cerbos_user;
 GRANT USAGE ON SCHEMA cerbos TO cerbos_user;
 GRANT SELECT,INSERT,UPDATE,DELETE ON cerbos.policy, cerbos.policy_dependency, cerbos.policy_ancestor, cerbos.attr_schema_defs TO cerbos_user; 
-GRANT SELECT,INSERT ON cerbos.policy_revision TO cerbos_user;
+GRANT SELECT,INSERT,DELETE ON cerbos.policy_revision TO cerbos_user;
 GRANT USAGE,SELECT ON cerbos.policy_revision_revision_id_seq TO cerbos_user;
+GRANT SELECT,INSERT ON cerbos.store_event TO cerbos_user;
+GRANT USAGE,SELECT ON cerbos.store_event_id_seq TO cerbos_user;
@@ -3,7 +3,11 @@
 
 package sqlite3
 
-import "github.com/cerbos/cerbos/internal/storage"
+import (
+	"time"
+
+	"github.com/cerbos/cerbos/internal/storage"
+)
 
 const confKey = storage.ConfKey + ".sqlite3"
 
@@ -12,6 +16,8 @@ const confKey = storage.ConfKey + ".sqlite3"
 type Conf struct {
 	// Data source name
 	DSN string `yaml:"dsn" conf:"required,example=\":memory:?_fk=true\""`
+	// DeletedPolicyRetention is how long deleted policies are kept so that they can be restored through the Admin API. Defaults to 720h (30 days).
+	DeletedPolicyRetention time.Duration `yaml:"deletedPolicyRetention" conf:",example=720h"`
 }
 
 func (c *Conf) Key() string {
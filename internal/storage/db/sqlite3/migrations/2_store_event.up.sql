@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS store_event (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    action VARCHAR(64),
+    target VARCHAR(1024),
+    revision_id INTEGER,
+    actor VARCHAR(255),
+    client_cert VARCHAR(1024),
+    event_timestamp DATETIME DEFAULT CURRENT_TIMESTAMP
+);
@@ -5,19 +5,17 @@ package sqlite3
 
 import (
 	"context"
-	"database/sql"
 	"database/sql/driver"
 	"embed"
-	"errors"
 	"fmt"
+	"io"
 
 	"github.com/doug-martin/goqu/v9"
 
 	// import sqlite3 dialect.
 	_ "github.com/doug-martin/goqu/v9/dialect/sqlite3"
-	migrate "github.com/golang-migrate/migrate/v4"
 	migratesqlite3 "github.com/golang-migrate/migrate/v4/database/sqlite3"
-	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
 	gosqlite3 "modernc.org/sqlite"
 
@@ -39,6 +37,11 @@ var migrationsFS embed.FS
 var (
 	_ storage.SourceStore  = (*Store)(nil)
 	_ storage.MutableStore = (*Store)(nil)
+	_ storage.Historical   = (*Store)(nil)
+	_ storage.Versioned    = (*Store)(nil)
+	_ storage.Restorable   = (*Store)(nil)
+	_ storage.Revisioned   = (*Store)(nil)
+	_ storage.Audited      = (*Store)(nil)
 )
 
 const nRegexpFnArgs = 2
@@ -91,15 +94,11 @@ func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
-	if _, err := db.ExecContext(ctx, schema, nil); err != nil {
-		return nil, fmt.Errorf("failed to create schema: %w", err)
-	}
-
-	if err := runMigrations(db.DB); err != nil {
-		return nil, fmt.Errorf("failed to migrate schema: %w", err)
+	if err := migrateSchema(ctx, db, io.Discard, false); err != nil {
+		return nil, err
 	}
 
-	s, err := internal.NewDBStorage(ctx, goqu.New("sqlite3", db), internal.WithRegexpCacheOverride(&nameRegexpCache))
+	s, err := internal.NewDBStorage(ctx, goqu.New("sqlite3", db), internal.WithRegexpCacheOverride(&nameRegexpCache), internal.WithDeletedPolicyRetention(conf.DeletedPolicyRetention))
 	if err != nil {
 		return nil, err
 	}
@@ -107,24 +106,40 @@ func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
 	return &Store{DBStorage: s}, nil
 }
 
-func runMigrations(db *sql.DB) error {
-	f, err := iofs.New(migrationsFS, "migrations")
+// Migrate creates the schema and applies the pending migrations to the database. The schema is also migrated
+// automatically when the store is created, so this is only needed to prepare a database in advance.
+// If dryRun is true, the SQL is written to out instead of being executed.
+func Migrate(ctx context.Context, conf *Conf, out io.Writer, dryRun bool) error {
+	db, err := internal.ConnectWithRetries("sqlite", conf.DSN, internal.DBConnectionRetries)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to open database: %w", err)
 	}
+	defer db.Close()
 
-	d, err := migratesqlite3.WithInstance(db, &migratesqlite3.Config{})
-	if err != nil {
-		return err
+	return migrateSchema(ctx, db, out, dryRun)
+}
+
+func migrateSchema(ctx context.Context, db *sqlx.DB, out io.Writer, dryRun bool) error {
+	if dryRun {
+		var n int
+		if err := db.GetContext(ctx, &n, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'policy'"); err != nil {
+			return fmt.Errorf("failed to check schema: %w", err)
+		}
+
+		if n == 0 {
+			fmt.Fprintf(out, "-- schema\n%s\n", schema)
+		}
+	} else if _, err := db.ExecContext(ctx, schema, nil); err != nil {
+		return fmt.Errorf("failed to create schema: %w", err)
 	}
 
-	m, err := migrate.NewWithInstance("iofs", f, "sqlite3", d)
+	d, err := migratesqlite3.WithInstance(db.DB, &migratesqlite3.Config{})
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to migrate schema: %w", err)
 	}
 
-	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
-		return err
+	if err := internal.Migrate(migrationsFS, DriverName, d, out, dryRun); err != nil {
+		return fmt.Errorf("failed to migrate schema: %w", err)
 	}
 
 	return nil
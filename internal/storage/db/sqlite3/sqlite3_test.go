@@ -5,12 +5,22 @@ package sqlite3_test
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/doug-martin/goqu/v9"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/storage"
 	"github.com/cerbos/cerbos/internal/storage/db/internal"
 	"github.com/cerbos/cerbos/internal/storage/db/sqlite3"
+	"github.com/cerbos/cerbos/internal/test"
 )
 
 func TestSQLite(t *testing.T) {
@@ -26,3 +36,79 @@ func TestSQLite(t *testing.T) {
 
 	t.Run("suite", internal.TestSuite(store))
 }
+
+func TestMigrate(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+
+	conf := &sqlite3.Conf{DSN: fmt.Sprintf("%s?_fk=true", filepath.Join(t.TempDir(), "cerbos.db"))}
+
+	var out strings.Builder
+	require.NoError(t, sqlite3.Migrate(ctx, conf, &out, true))
+	require.Contains(t, out.String(), "CREATE TABLE IF NOT EXISTS policy")
+	require.Contains(t, out.String(), "-- 1_scopecol")
+	require.Contains(t, out.String(), "-- 2_store_event")
+
+	out.Reset()
+	require.NoError(t, sqlite3.Migrate(ctx, conf, &out, false))
+	require.Equal(t, "Applied migration 1_scopecol\nApplied migration 2_store_event\n", out.String())
+
+	out.Reset()
+	require.NoError(t, sqlite3.Migrate(ctx, conf, &out, true))
+	require.Equal(t, "Database schema is up to date\n", out.String())
+
+	store, err := sqlite3.NewStore(ctx, conf)
+	require.NoError(t, err)
+	internal.TestCheckSchema(ctx, t, store)
+}
+
+func TestReadReplica(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+
+	dir := t.TempDir()
+	openDB := func(name string) *goqu.Database {
+		t.Helper()
+
+		conf := &sqlite3.Conf{DSN: fmt.Sprintf("%s?_fk=true", filepath.Join(dir, name))}
+		require.NoError(t, sqlite3.Migrate(ctx, conf, io.Discard, false))
+
+		db, err := internal.ConnectWithRetries("sqlite", conf.DSN, 1)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+
+		return goqu.New("sqlite3", db)
+	}
+
+	// the replica never catches up, so that it's possible to tell which database served a query
+	primary := openDB("primary.db")
+	replica := openDB("replica.db")
+
+	t.Run("lookups_use_replica", func(t *testing.T) {
+		store, err := internal.NewDBStorage(ctx, primary, internal.WithReadReplica(replica, 0))
+		require.NoError(t, err)
+
+		p := policy.Wrap(test.GenExportVariables(test.PrefixAndSuffix("a", "a")))
+		require.NoError(t, store.AddOrUpdate(ctx, p))
+
+		units, err := store.GetCompilationUnits(ctx, p.ID)
+		require.NoError(t, err)
+		require.Empty(t, units)
+
+		ids, err := store.ListPolicyIDs(ctx, storage.ListPolicyIDsParams{})
+		require.NoError(t, err)
+		require.Contains(t, ids, namer.PolicyKey(p.Policy))
+	})
+
+	t.Run("lookups_use_primary_after_change", func(t *testing.T) {
+		store, err := internal.NewDBStorage(ctx, primary, internal.WithReadReplica(replica, time.Hour))
+		require.NoError(t, err)
+
+		p := policy.Wrap(test.GenExportVariables(test.PrefixAndSuffix("b", "b")))
+		require.NoError(t, store.AddOrUpdate(ctx, p))
+
+		units, err := store.GetCompilationUnits(ctx, p.ID)
+		require.NoError(t, err)
+		require.Contains(t, units, p.ID)
+	})
+}
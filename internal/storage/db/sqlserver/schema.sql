@@ -57,6 +57,16 @@ CREATE TABLE [dbo].[attr_schema_defs] (
     id VARCHAR(255) NOT NULL PRIMARY KEY,
     definition VARBINARY(MAX));
 
+IF OBJECT_ID('[dbo].[store_event]', 'U') IS NULL
+CREATE TABLE [dbo].[store_event] (
+    id INT NOT NULL IDENTITY PRIMARY KEY,
+    action VARCHAR(64),
+    target VARCHAR(1024),
+    revision_id INT,
+    actor VARCHAR(255),
+    client_cert VARCHAR(1024),
+    event_timestamp DATETIME DEFAULT CURRENT_TIMESTAMP);
+
 DROP TRIGGER IF EXISTS dbo.policy_on_insert;
 DROP TRIGGER IF EXISTS dbo.policy_on_update;
 DROP TRIGGER IF EXISTS dbo.policy_on_delete;
@@ -72,7 +82,8 @@ GRANT SELECT,INSERT,UPDATE,DELETE ON [dbo].[policy] TO cerbos_user;
 GRANT SELECT,INSERT,UPDATE,DELETE ON dbo.attr_schema_defs TO cerbos_user;
 GRANT SELECT,INSERT,UPDATE,DELETE ON dbo.policy_dependency TO cerbos_user;
 GRANT SELECT,INSERT,UPDATE,DELETE ON dbo.policy_ancestor TO cerbos_user;
-GRANT SELECT,INSERT ON dbo.policy_revision TO cerbos_user;
+GRANT SELECT,INSERT,DELETE ON dbo.policy_revision TO cerbos_user;
+GRANT SELECT,INSERT ON dbo.store_event TO cerbos_user;
 
 GO
 
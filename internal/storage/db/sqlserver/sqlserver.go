@@ -38,6 +38,11 @@ const (
 var (
 	_ storage.SourceStore  = (*Store)(nil)
 	_ storage.MutableStore = (*Store)(nil)
+	_ storage.Historical   = (*Store)(nil)
+	_ storage.Versioned    = (*Store)(nil)
+	_ storage.Restorable   = (*Store)(nil)
+	_ storage.Revisioned   = (*Store)(nil)
+	_ storage.Audited      = (*Store)(nil)
 )
 
 func init() {
@@ -62,7 +67,7 @@ func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
 
 	conf.ConnPool.Configure(db)
 
-	s, err := internal.NewDBStorage(ctx, goqu.New("sqlserver", db), internal.WithUpsertPolicy(upsertPolicy), internal.WithUpsertSchema(upsertSchema))
+	s, err := internal.NewDBStorage(ctx, goqu.New("sqlserver", db), internal.WithUpsertPolicy(upsertPolicy), internal.WithUpsertSchema(upsertSchema), internal.WithDeletedPolicyRetention(conf.DeletedPolicyRetention))
 	if err != nil {
 		return nil, err
 	}
@@ -5,6 +5,8 @@ package disk
 
 import (
 	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/encryption"
+	"github.com/cerbos/cerbos/internal/signature"
 	"github.com/cerbos/cerbos/internal/storage"
 )
 
@@ -15,6 +17,10 @@ const confKey = storage.ConfKey + ".disk"
 type Conf struct {
 	// Directory is the path on disk where policies are stored.
 	Directory string `yaml:"directory" conf:"required,example=pkg/test/testdata/store"`
+	// Encryption holds the key to decrypt the archive with. Requires directory to be an archive encrypted by `cerbos encrypt`.
+	Encryption *encryption.Conf `yaml:"encryption,omitempty" conf:",example=\n  keyFile: /path/to/key"`
+	// Verification enables verifying the signature of the archive, which must be stored next to it in a file with the same name and the .sig extension. Requires directory to be an archive.
+	Verification *signature.VerificationConf `yaml:"verification,omitempty" conf:",example=\n  publicKey: /path/to/cosign.pub"`
 	// [DEPRECATED] ScratchDir is the directory to use for holding temporary data.
 	ScratchDir string `yaml:"scratchDir" conf:",ignore"`
 	// WatchForChanges enables watching the directory for changes.
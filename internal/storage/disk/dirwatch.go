@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"sync"
@@ -32,14 +33,47 @@ const (
 	defaultCooldownPeriod = 2 * time.Second
 )
 
+// dataLink is the symlink through which Kubernetes atomically publishes the contents of ConfigMap, Secret and projected
+// volumes. The files at the top level of the volume are themselves links into the directory that it points to.
+const dataLink = "..data"
+
+// resolveRoot returns the directory that the store should be read from. When the directory is published by retargeting
+// a symlink -- either the directory itself or its ..data link -- that's the current target of the link. Reading from the
+// target rather than through the link guarantees that the index is never built from a mix of two versions of the directory.
+func resolveRoot(dir string) (string, error) {
+	link := filepath.Join(dir, dataLink)
+	if fi, err := os.Lstat(link); err == nil && fi.Mode()&fs.ModeSymlink != 0 {
+		root, err := filepath.EvalSymlinks(link)
+		if err != nil {
+			return "", fmt.Errorf("could not resolve %s: %w", link, err)
+		}
+
+		return root, nil
+	}
+
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", fmt.Errorf("could not resolve %s: %w", dir, err)
+	}
+
+	return root, nil
+}
+
 func watchDir(ctx context.Context, dir string, idx index.Index, sub *storage.SubscriptionManager, cooldownPeriod time.Duration) error {
 	resolved, err := filepath.EvalSymlinks(dir)
 	if err != nil {
 		return fmt.Errorf("could not resolve %s: %w", dir, err)
 	}
 
+	root, err := resolveRoot(dir)
+	if err != nil {
+		return err
+	}
+
 	dw := &dirWatch{
 		dir:                 resolved,
+		linkDir:             dir,
+		root:                root,
 		log:                 zap.S().Named("dir.watch").With("dir", dir),
 		idx:                 idx,
 		SubscriptionManager: sub,
@@ -65,6 +99,9 @@ type dirWatch struct {
 	eventBatch    map[string]struct{}
 	*storage.SubscriptionManager
 	dir            string
+	linkDir        string
+	root           string
+	failedRoot     string
 	cooldownPeriod time.Duration
 	mu             sync.RWMutex
 }
@@ -85,9 +122,15 @@ func (dw *dirWatch) handleEvents(ctx context.Context) {
 			dw.log.Info("Stopped watching directory for changes")
 			return
 		case evtInfo := <-dw.watchChan:
-			dw.processEvent(evtInfo)
+			// Swapping the directory generates events too, so check for it straight away instead of waiting
+			// for the next tick. Otherwise, lookups would be served from the old directory until then.
+			if !dw.reloadIfSwapped(ctx) {
+				dw.processEvent(evtInfo)
+			}
 		case <-ticker.C:
-			dw.triggerUpdate()
+			if !dw.reloadIfSwapped(ctx) {
+				dw.triggerUpdate()
+			}
 			_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
 				tag.Upsert(metrics.KeyStoreDriver, DriverName),
 			}, metrics.StorePollCount.M(1))
@@ -95,6 +138,63 @@ func (dw *dirWatch) handleEvents(ctx context.Context) {
 	}
 }
 
+// reloadIfSwapped rebuilds the whole index from the new target if a symlink has been retargeted to swap the directory.
+// It returns true if the index was rebuilt.
+func (dw *dirWatch) reloadIfSwapped(ctx context.Context) bool {
+	root, err := resolveRoot(dw.linkDir)
+	if err != nil {
+		// The link could be in the middle of being replaced, so try again later.
+		dw.log.Debugw("Failed to resolve directory", "error", err)
+		return false
+	}
+
+	if root == dw.root || root == dw.failedRoot {
+		return false
+	}
+
+	dw.log.Infow("Detected directory swap", "from", dw.root, "to", root)
+
+	evts, err := dw.reloadFrom(ctx, root)
+	if err != nil {
+		// Keep serving the previous version instead of retrying the broken one on every tick.
+		dw.log.Warnw("Failed to reload index from swapped directory", "root", root, "error", err)
+		dw.failedRoot = root
+		_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
+			tag.Upsert(metrics.KeyStoreDriver, DriverName),
+		}, metrics.StoreSyncErrorCount.M(1))
+		return false
+	}
+
+	dw.root = root
+	dw.failedRoot = ""
+
+	// The batched file events are part of the swap, so the reload has already taken them into account.
+	dw.mu.Lock()
+	dw.eventBatch = make(map[string]struct{})
+	dw.mu.Unlock()
+
+	// If the watched directory is itself a link, the watch is still on the previous target.
+	if resolved, err := filepath.EvalSymlinks(dw.linkDir); err == nil && resolved != dw.dir {
+		notify.Stop(dw.watchChan)
+		dw.dir = resolved
+		if err := notify.Watch(filepath.Join(resolved, "..."), dw.watchChan, notify.All); err != nil {
+			dw.log.Warnw("Failed to watch swapped directory", "error", err)
+		}
+	}
+
+	dw.NotifySubscribers(evts...)
+	return true
+}
+
+func (dw *dirWatch) reloadFrom(ctx context.Context, root string) ([]storage.Event, error) {
+	fsys, err := util.OpenDirectoryFS(root)
+	if err != nil {
+		return nil, err
+	}
+
+	return dw.idx.ReloadFrom(ctx, fsys)
+}
+
 func (dw *dirWatch) processEvent(evtInfo notify.EventInfo) {
 	path, err := filepath.Rel(dw.dir, evtInfo.Path())
 	if err != nil {
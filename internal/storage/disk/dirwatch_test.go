@@ -156,6 +156,91 @@ func TestDirWatch(t *testing.T) {
 		wantEvent := storage.Event{Kind: storage.EventDeleteSchema, SchemaFile: "test.json"}
 		checkEvents(t, timeOut, wantEvent)
 	})
+
+	t.Run("swap_data_link", func(t *testing.T) {
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		defer cancelFunc()
+
+		// Lay out the directory like Kubernetes does for ConfigMap volumes.
+		dir := t.TempDir()
+		writePolicy(t, filepath.Join(mkdir(t, dir, "..v1"), "policy_1.yaml"), test.GenExportVariables(test.NoMod()))
+		require.NoError(t, os.Symlink("..v1", filepath.Join(dir, dataLink)))
+		require.NoError(t, os.Symlink(filepath.Join(dataLink, "policy_1.yaml"), filepath.Join(dir, "policy_1.yaml")))
+
+		subMgr := storage.NewSubscriptionManager(ctx)
+		idx := buildIndex(ctx, t, dir)
+		require.NoError(t, watchDir(ctx, dir, idx, subMgr, cooldownPeriod))
+
+		checkEvents := storage.TestSubscription(subMgr)
+
+		writePolicy(t, filepath.Join(mkdir(t, dir, "..v2"), "policy_2.yaml"), test.GenExportVariables(test.PrefixAndSuffix("x", "x")))
+		swapLink(t, filepath.Join(dir, dataLink), "..v2")
+		require.NoError(t, os.Symlink(filepath.Join(dataLink, "policy_2.yaml"), filepath.Join(dir, "policy_2.yaml")))
+		require.NoError(t, os.Remove(filepath.Join(dir, "policy_1.yaml")))
+		require.NoError(t, os.RemoveAll(filepath.Join(dir, "..v1")))
+
+		checkEvents(t, timeOut, storage.NewReloadEvent())
+		require.ElementsMatch(t, []string{"policy_2.yaml"}, idx.GetFiles())
+	})
+
+	t.Run("swap_dir_link", func(t *testing.T) {
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		defer cancelFunc()
+
+		base := t.TempDir()
+		writePolicy(t, filepath.Join(mkdir(t, base, "v1"), "policy_1.yaml"), test.GenExportVariables(test.NoMod()))
+		dir := filepath.Join(base, "current")
+		require.NoError(t, os.Symlink("v1", dir))
+
+		subMgr := storage.NewSubscriptionManager(ctx)
+		idx := buildIndex(ctx, t, dir)
+		require.NoError(t, watchDir(ctx, dir, idx, subMgr, cooldownPeriod))
+
+		checkEvents := storage.TestSubscription(subMgr)
+
+		writePolicy(t, filepath.Join(mkdir(t, base, "v2"), "policy_2.yaml"), test.GenExportVariables(test.PrefixAndSuffix("x", "x")))
+		swapLink(t, dir, "v2")
+
+		checkEvents(t, timeOut, storage.NewReloadEvent())
+		require.ElementsMatch(t, []string{"policy_2.yaml"}, idx.GetFiles())
+
+		// Changes to the new target are picked up as usual.
+		checkEvents = storage.TestSubscription(subMgr)
+		rp := policy.Wrap(test.GenExportVariables(test.PrefixAndSuffix("y", "y")))
+		writePolicy(t, filepath.Join(base, "v2", "policy_3.yaml"), rp.Policy)
+
+		checkEvents(t, timeOut, storage.Event{Kind: storage.EventAddOrUpdatePolicy, PolicyID: rp.ID})
+	})
+}
+
+func buildIndex(ctx context.Context, t *testing.T, dir string) index.Index {
+	t.Helper()
+
+	root, err := resolveRoot(dir)
+	require.NoError(t, err)
+
+	idx, err := index.Build(ctx, os.DirFS(root))
+	require.NoError(t, err)
+
+	return idx
+}
+
+// swapLink atomically retargets the symlink, the same way that Kubernetes does.
+func swapLink(t *testing.T, link, target string) {
+	t.Helper()
+
+	tmp := link + "_tmp"
+	require.NoError(t, os.Symlink(target, tmp))
+	require.NoError(t, os.Rename(tmp, link))
+}
+
+func mkdir(t *testing.T, parent, name string) string {
+	t.Helper()
+
+	dir := filepath.Join(parent, name)
+	require.NoError(t, os.Mkdir(dir, 0o744))
+
+	return dir
 }
 
 func writePolicy(t *testing.T, fileName string, p *policyv1.Policy) {
@@ -7,11 +7,14 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/fs"
 	"path/filepath"
 
 	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/encryption"
 	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/signature"
 	"github.com/cerbos/cerbos/internal/storage"
 	"github.com/cerbos/cerbos/internal/storage/index"
 	"github.com/cerbos/cerbos/internal/util"
@@ -40,6 +43,7 @@ type Store struct {
 	conf *Conf
 	idx  index.Index
 	*storage.SubscriptionManager
+	watching bool
 }
 
 func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
@@ -50,7 +54,24 @@ func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
 
 	zap.S().Named("disk.store").Infof("Initializing disk store from %s", dir)
 
-	fsys, err := util.OpenDirectoryFS(dir)
+	if conf.Verification != nil {
+		if err := verifyArchive(dir, conf.Verification); err != nil {
+			return nil, err
+		}
+	}
+
+	watch := conf.WatchForChanges && !util.IsArchiveFile(dir)
+
+	// When watching, read from the target of the directory's symlinks so that the watcher can switch the whole
+	// index over to the new target when the links are swapped.
+	root := dir
+	if watch {
+		if root, err = resolveRoot(dir); err != nil {
+			return nil, err
+		}
+	}
+
+	fsys, err := openDirectory(root, conf.Encryption)
 	if err != nil {
 		return nil, err
 	}
@@ -65,15 +86,53 @@ func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
 		idx:                 idx,
 		SubscriptionManager: storage.NewSubscriptionManager(ctx),
 	}
-	if conf.WatchForChanges && !util.IsArchiveFile(dir) {
+	if watch {
 		if err := watchDir(ctx, dir, s.idx, s.SubscriptionManager, defaultCooldownPeriod); err != nil {
 			return nil, err
 		}
+		s.watching = true
 	}
 
 	return s, nil
 }
 
+// verifyArchive checks the signature of the archive, because a directory can't be signed as a whole.
+func verifyArchive(path string, conf *signature.VerificationConf) error {
+	if !util.IsArchiveFile(path) {
+		return fmt.Errorf("signature verification requires directory [%s] to be an archive", path)
+	}
+
+	verifier, err := signature.NewVerifierFromConf(conf)
+	if err != nil {
+		return err
+	}
+
+	return verifier.VerifyFile(path)
+}
+
+// openDirectory opens the directory or archive, decrypting the archive first if encryption is configured.
+func openDirectory(path string, conf *encryption.Conf) (fs.FS, error) {
+	if conf == nil {
+		return util.OpenDirectoryFS(path)
+	}
+
+	if !util.IsArchiveFile(path) {
+		return nil, fmt.Errorf("encryption requires directory [%s] to be an archive", path)
+	}
+
+	cipher, err := encryption.NewCipherFromConf(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := cipher.DecryptFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.OpenArchiveFS(path, data)
+}
+
 func NewFromIndex(idx index.Index) (*Store, error) {
 	conf := &Conf{}
 	if err := config.GetSection(conf); err != nil {
@@ -91,6 +150,10 @@ func (s *Store) Driver() string {
 	return DriverName
 }
 
+func (s *Store) WatchesForChanges() bool {
+	return s.watching
+}
+
 func (s *Store) GetFirstMatch(_ context.Context, candidates []namer.ModuleID) (*policy.CompilationUnit, error) {
 	return s.idx.GetFirstMatch(candidates)
 }
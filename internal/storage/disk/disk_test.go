@@ -4,6 +4,8 @@
 package disk
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -14,6 +16,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cerbos/cerbos/internal/encryption"
+	"github.com/cerbos/cerbos/internal/storage"
 	"github.com/cerbos/cerbos/internal/storage/internal"
 	"github.com/cerbos/cerbos/internal/test"
 )
@@ -25,6 +29,59 @@ func TestReloadable(t *testing.T) {
 	internal.TestSuiteReloadable(store, nil, mkAddFn(t, storeDir), mkDeleteFn(t, storeDir))(t)
 }
 
+func TestEncryptedArchive(t *testing.T) {
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	w, err := zw.Create("resource_policies/leave_request.yaml")
+	require.NoError(t, err)
+	_, err = io.WriteString(w, `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: leave_request
+  rules:
+    - actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["user"]
+`)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	key, err := encryption.GenerateKey()
+	require.NoError(t, err)
+
+	cipher, err := encryption.NewCipherFromString(key)
+	require.NoError(t, err)
+
+	encrypted, err := cipher.Encrypt(archive.Bytes())
+	require.NoError(t, err)
+
+	archivePath := filepath.Join(t.TempDir(), "policies.zip")
+	require.NoError(t, os.WriteFile(archivePath, encrypted, 0o600))
+
+	t.Run("valid_key", func(t *testing.T) {
+		store, err := NewStore(context.Background(), &Conf{Directory: archivePath, Encryption: &encryption.Conf{Key: key}})
+		require.NoError(t, err)
+
+		ids, err := store.ListPolicyIDs(context.Background(), storage.ListPolicyIDsParams{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"resource_policies/leave_request.yaml"}, ids)
+	})
+
+	t.Run("wrong_key", func(t *testing.T) {
+		otherKey, err := encryption.GenerateKey()
+		require.NoError(t, err)
+
+		_, err = NewStore(context.Background(), &Conf{Directory: archivePath, Encryption: &encryption.Conf{Key: otherKey}})
+		require.ErrorIs(t, err, encryption.ErrDecryptionFailed)
+	})
+
+	t.Run("not_encrypted", func(t *testing.T) {
+		_, err := NewStore(context.Background(), &Conf{Directory: archivePath})
+		require.Error(t, err)
+	})
+}
+
 func mkStore(t *testing.T, dir string) *Store {
 	t.Helper()
 
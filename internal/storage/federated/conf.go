@@ -0,0 +1,75 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package federated
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+const (
+	confKey = storage.ConfKey + "." + DriverName
+
+	// OnConflictPrecedence uses the definition from the store with the highest precedence.
+	OnConflictPrecedence = "precedence"
+	// OnConflictError refuses to load policies that are defined in more than one store.
+	OnConflictError = "error"
+)
+
+// Conf is required (if driver is set to 'federated') configuration for federated storage driver.
+// +desc=This section is required only if storage.driver is federated.
+type Conf struct {
+	// Drivers is the list of storage drivers to combine, from the highest to the lowest precedence. Each driver is configured in its own section.
+	Drivers []string `yaml:"drivers" conf:"required,example=[\"postgres\", \"git\"]"`
+	// OnConflict determines what happens when a policy with the same ID is defined in more than one store: precedence uses the definition from the store with the highest precedence and error refuses to load the policy. Defaults to precedence.
+	OnConflict string `yaml:"onConflict" conf:",example=precedence"`
+}
+
+func (conf *Conf) Key() string {
+	return confKey
+}
+
+func (conf *Conf) SetDefaults() {
+	conf.OnConflict = OnConflictPrecedence
+}
+
+func (conf *Conf) Validate() (outErr error) {
+	if len(conf.Drivers) < 2 { //nolint:gomnd
+		outErr = multierr.Append(outErr, errors.New("at least two drivers are required"))
+	}
+
+	seen := make(map[string]struct{}, len(conf.Drivers))
+	for _, d := range conf.Drivers {
+		switch d {
+		case "":
+			outErr = multierr.Append(outErr, errors.New("driver names cannot be empty"))
+			continue
+		case DriverName, "overlay":
+			outErr = multierr.Append(outErr, fmt.Errorf("the %s driver cannot be federated", d))
+		}
+
+		if _, ok := seen[d]; ok {
+			outErr = multierr.Append(outErr, fmt.Errorf("driver %s is listed more than once", d))
+		}
+		seen[d] = struct{}{}
+	}
+
+	if conf.OnConflict != OnConflictPrecedence && conf.OnConflict != OnConflictError {
+		outErr = multierr.Append(outErr, fmt.Errorf("onConflict must be one of %s or %s", OnConflictPrecedence, OnConflictError))
+	}
+
+	return outErr
+}
+
+func GetConf() (*Conf, error) {
+	conf := &Conf{}
+	err := config.GetSection(conf)
+
+	return conf, err
+}
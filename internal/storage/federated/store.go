@@ -0,0 +1,349 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package federated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/sourcegraph/conc/pool"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+const DriverName = "federated"
+
+var (
+	_ storage.SourceStore = (*Store)(nil)
+	_ storage.Reloadable  = (*Store)(nil)
+	_ storage.Subscriber  = (*Store)(nil)
+)
+
+// ErrConflict is returned when a policy is defined in more than one store and conflicts are not allowed.
+var ErrConflict = errors.New("policy is defined in more than one store")
+
+func init() {
+	storage.RegisterDriver(DriverName, func(ctx context.Context, confW *config.Wrapper) (storage.Store, error) {
+		conf := new(Conf)
+		if err := confW.GetSection(conf); err != nil {
+			return nil, fmt.Errorf("failed to read federated configuration: %w", err)
+		}
+
+		return NewStore(ctx, conf, confW)
+	})
+}
+
+// Store combines several source stores into one. Each policy is loaded from the store with the highest precedence that
+// contains it, and so are its ancestors and the derived roles and variables it imports. This lets policies in one store
+// override or extend the policies in another, for example with scoped policies for tenants stored in a database on top
+// of the base policies stored in git.
+type Store struct {
+	log    *zap.SugaredLogger
+	conf   *Conf
+	stores []storage.SourceStore
+	*storage.SubscriptionManager
+}
+
+// NewStore creates the stores for the configured drivers and combines them.
+func NewStore(ctx context.Context, conf *Conf, confW *config.Wrapper) (*Store, error) {
+	stores := make([]storage.SourceStore, len(conf.Drivers))
+
+	p := pool.New().WithContext(ctx).WithCancelOnError().WithFirstError()
+	for i, driver := range conf.Drivers {
+		i, driver := i, driver
+		p.Go(func(ctx context.Context) error {
+			cons, err := storage.GetDriverConstructor(driver)
+			if err != nil {
+				return err
+			}
+
+			store, err := cons(ctx, confW)
+			if err != nil {
+				return fmt.Errorf("failed to create %s store: %w", driver, err)
+			}
+
+			ss, ok := store.(storage.SourceStore)
+			if !ok {
+				return fmt.Errorf("the %s driver cannot be federated because it does not serve policies in source format", driver)
+			}
+
+			stores[i] = ss
+			return nil
+		})
+	}
+
+	if err := p.Wait(); err != nil {
+		return nil, err
+	}
+
+	return New(ctx, conf, stores...), nil
+}
+
+// New combines the stores, which must be given from the highest to the lowest precedence.
+func New(ctx context.Context, conf *Conf, stores ...storage.SourceStore) *Store {
+	drivers := make([]string, len(stores))
+	for i, st := range stores {
+		drivers[i] = st.Driver()
+	}
+
+	s := &Store{
+		log:                 zap.S().Named(confKey+".store").With("drivers", drivers),
+		conf:                conf,
+		stores:              stores,
+		SubscriptionManager: storage.NewSubscriptionManager(ctx),
+	}
+
+	for _, st := range stores {
+		st.Subscribe(s)
+	}
+
+	return s
+}
+
+func (s *Store) Driver() string {
+	return DriverName
+}
+
+func (s *Store) SubscriberID() string {
+	return confKey
+}
+
+// OnStorageEvent passes on the events from the federated stores. The affected policies are loaded from the store with
+// the highest precedence when they are recompiled, so an event from a store with a lower precedence is harmless even if
+// the policy is overridden.
+func (s *Store) OnStorageEvent(events ...storage.Event) {
+	s.NotifySubscribers(events...)
+}
+
+func (s *Store) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID) (*policy.CompilationUnit, error) {
+	for _, id := range candidates {
+		cu, err := s.compilationUnit(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if cu != nil {
+			return cu, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Store) GetCompilationUnits(ctx context.Context, ids ...namer.ModuleID) (map[namer.ModuleID]*policy.CompilationUnit, error) {
+	result := make(map[namer.ModuleID]*policy.CompilationUnit, len(ids))
+	for _, id := range ids {
+		cu, err := s.compilationUnit(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if cu != nil {
+			result[id] = cu
+		}
+	}
+
+	return result, nil
+}
+
+// compilationUnit builds the compilation unit of the policy from the definitions in all the stores.
+func (s *Store) compilationUnit(ctx context.Context, id namer.ModuleID) (*policy.CompilationUnit, error) {
+	p, err := s.lookup(ctx, id)
+	if err != nil || p == nil {
+		return nil, err
+	}
+
+	cu := &policy.CompilationUnit{ModID: id}
+	cu.AddDefinition(id, p)
+
+	// missing ancestors and dependencies are left out, so that they are reported by the compiler
+	toLoad := append(dependencies(p), cu.Ancestors()...)
+	for len(toLoad) > 0 {
+		depID := toLoad[0]
+		toLoad = toLoad[1:]
+
+		if _, ok := cu.Definitions[depID]; ok {
+			continue
+		}
+
+		dep, err := s.lookup(ctx, depID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load dependency of %s: %w", namer.PolicyKey(p), err)
+		}
+
+		if dep == nil {
+			continue
+		}
+
+		cu.AddDefinition(depID, dep)
+		toLoad = append(toLoad, dependencies(dep)...)
+	}
+
+	return cu, nil
+}
+
+// lookup finds the policy in the store with the highest precedence that contains an enabled policy with the ID.
+func (s *Store) lookup(ctx context.Context, id namer.ModuleID) (*policyv1.Policy, error) {
+	var found *policyv1.Policy
+	var foundIn string
+	for _, st := range s.stores {
+		units, err := st.GetCompilationUnits(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get policy from %s store: %w", st.Driver(), err)
+		}
+
+		cu, ok := units[id]
+		if !ok || cu.MainPolicy() == nil || cu.MainPolicy().Disabled {
+			continue
+		}
+
+		if found == nil {
+			found, foundIn = cu.MainPolicy(), st.Driver()
+			if s.conf.OnConflict == OnConflictPrecedence {
+				return found, nil
+			}
+			continue
+		}
+
+		s.log.Warnw("Policy is defined in more than one store", "policy", namer.PolicyKey(found), "stores", []string{foundIn, st.Driver()})
+		return nil, fmt.Errorf("%w: %s is defined in the %s and %s stores", ErrConflict, namer.PolicyKey(found), foundIn, st.Driver())
+	}
+
+	return found, nil
+}
+
+func dependencies(p *policyv1.Policy) []namer.ModuleID {
+	fqns := policy.Dependencies(p)
+	ids := make([]namer.ModuleID, len(fqns))
+	for i, fqn := range fqns {
+		ids[i] = namer.GenModuleIDFromFQN(fqn)
+	}
+
+	return ids
+}
+
+func (s *Store) GetDependents(ctx context.Context, ids ...namer.ModuleID) (map[namer.ModuleID][]namer.ModuleID, error) {
+	seen := make(map[namer.ModuleID]map[namer.ModuleID]struct{}, len(ids))
+	for _, st := range s.stores {
+		dependents, err := st.GetDependents(ctx, ids...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dependents from %s store: %w", st.Driver(), err)
+		}
+
+		for id, deps := range dependents {
+			if _, ok := seen[id]; !ok {
+				seen[id] = make(map[namer.ModuleID]struct{}, len(deps))
+			}
+
+			for _, dep := range deps {
+				seen[id][dep] = struct{}{}
+			}
+		}
+	}
+
+	result := make(map[namer.ModuleID][]namer.ModuleID, len(seen))
+	for id, deps := range seen {
+		result[id] = make([]namer.ModuleID, 0, len(deps))
+		for dep := range deps {
+			result[id] = append(result[id], dep)
+		}
+	}
+
+	return result, nil
+}
+
+// LoadPolicy loads each policy from the store with the highest precedence that contains it.
+func (s *Store) LoadPolicy(ctx context.Context, file ...string) ([]*policy.Wrapper, error) {
+	result := make([]*policy.Wrapper, 0, len(file))
+	for _, f := range file {
+		var errs error
+		var found bool
+		for _, st := range s.stores {
+			wrappers, err := st.LoadPolicy(ctx, f)
+			if err != nil {
+				errs = multierr.Append(errs, fmt.Errorf("%s store: %w", st.Driver(), err))
+				continue
+			}
+
+			if len(wrappers) > 0 {
+				result = append(result, wrappers...)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			if errs != nil {
+				return nil, fmt.Errorf("failed to load policy %q: %w", f, errs)
+			}
+			return nil, fmt.Errorf("policy %q does not exist in any store", f)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Store) ListPolicyIDs(ctx context.Context, params storage.ListPolicyIDsParams) ([]string, error) {
+	return union(s.stores, func(st storage.SourceStore) ([]string, error) { return st.ListPolicyIDs(ctx, params) })
+}
+
+func (s *Store) ListSchemaIDs(ctx context.Context) ([]string, error) {
+	return union(s.stores, func(st storage.SourceStore) ([]string, error) { return st.ListSchemaIDs(ctx) })
+}
+
+func union(stores []storage.SourceStore, list func(storage.SourceStore) ([]string, error)) ([]string, error) {
+	seen := make(map[string]struct{})
+	var result []string
+	for _, st := range stores {
+		ids, err := list(st)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s store: %w", st.Driver(), err)
+		}
+
+		for _, id := range ids {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				result = append(result, id)
+			}
+		}
+	}
+
+	sort.Strings(result)
+	return result, nil
+}
+
+// LoadSchema loads the schema from the store with the highest precedence that contains it.
+func (s *Store) LoadSchema(ctx context.Context, url string) (io.ReadCloser, error) {
+	var errs error
+	for _, st := range s.stores {
+		schema, err := st.LoadSchema(ctx, url)
+		if err == nil {
+			return schema, nil
+		}
+
+		errs = multierr.Append(errs, fmt.Errorf("%s store: %w", st.Driver(), err))
+	}
+
+	return nil, fmt.Errorf("failed to load schema %q: %w", url, errs)
+}
+
+func (s *Store) Reload(ctx context.Context) error {
+	p := pool.New().WithContext(ctx).WithCancelOnError().WithFirstError()
+	for _, st := range s.stores {
+		if rs, ok := st.(storage.Reloadable); ok {
+			p.Go(func(ctx context.Context) error { return rs.Reload(ctx) })
+		}
+	}
+
+	return p.Wait()
+}
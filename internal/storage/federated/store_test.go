@@ -0,0 +1,109 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package federated_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/db/sqlite3"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/storage/federated"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+func TestFederatedStore(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	base, err := disk.NewStore(ctx, &disk.Conf{Directory: test.PathToDir(t, "store")})
+	require.NoError(t, err)
+
+	overrides, err := sqlite3.NewStore(ctx, &sqlite3.Conf{DSN: fmt.Sprintf("%s?_fk=true", filepath.Join(t.TempDir(), "cerbos.db"))})
+	require.NoError(t, err)
+
+	// a tenant policy that extends the scoped policies in the base store and imports derived roles from it
+	tenantPolicy := test.LoadPolicy(t, filepath.Join(test.PathToDir(t, "store"), "resource_policies", "policy_05_acme.hr.uk.yaml"))
+	tenantPolicy.GetResourcePolicy().Scope = "acme.hr.uk.tenant"
+	tenantID := namer.GenModuleID(tenantPolicy)
+
+	// a policy that overrides a policy in the base store
+	overridePolicy := test.LoadPolicy(t, filepath.Join(test.PathToDir(t, "store"), "resource_policies", "policy_05_acme.hr.yaml"))
+	overridePolicy.Description = "override"
+	overrideID := namer.GenModuleID(overridePolicy)
+
+	require.NoError(t, overrides.AddOrUpdate(ctx, policy.Wrap(tenantPolicy), policy.Wrap(overridePolicy)))
+
+	t.Run("precedence", func(t *testing.T) {
+		store := federated.New(ctx, &federated.Conf{OnConflict: federated.OnConflictPrecedence}, overrides, base)
+
+		cu, err := store.GetFirstMatch(ctx, []namer.ModuleID{tenantID, namer.GenModuleIDFromFQN(namer.ResourcePolicyFQN("leave_request", "default", "acme.hr.uk"))})
+		require.NoError(t, err)
+		require.NotNil(t, cu)
+		require.Equal(t, tenantID, cu.ModID)
+
+		for _, ancestor := range cu.Ancestors() {
+			require.Contains(t, cu.Definitions, ancestor, "Ancestor %s is missing", ancestor)
+		}
+		require.Contains(t, cu.Definitions, namer.GenModuleIDFromFQN(namer.DerivedRolesFQN("alpha")))
+		require.Equal(t, "override", cu.Definitions[overrideID].Description)
+
+		_, err = compile.Compile(cu, schema.NewNopManager())
+		require.NoError(t, err)
+
+		units, err := store.GetCompilationUnits(ctx, overrideID)
+		require.NoError(t, err)
+		require.Contains(t, units, overrideID)
+		require.Equal(t, "override", units[overrideID].MainPolicy().Description)
+
+		policyIDs, err := store.ListPolicyIDs(ctx, storage.ListPolicyIDsParams{})
+		require.NoError(t, err)
+		require.Contains(t, policyIDs, namer.PolicyKey(tenantPolicy))
+		require.Contains(t, policyIDs, filepath.Join("resource_policies", "policy_01.yaml"))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		store := federated.New(ctx, &federated.Conf{OnConflict: federated.OnConflictError}, overrides, base)
+
+		_, err := store.GetCompilationUnits(ctx, overrideID)
+		require.ErrorIs(t, err, federated.ErrConflict)
+
+		_, err = store.GetFirstMatch(ctx, []namer.ModuleID{tenantID})
+		require.ErrorIs(t, err, federated.ErrConflict, "Conflicts in ancestors should be reported")
+
+		rootID := namer.GenModuleIDFromFQN(namer.ResourcePolicyFQN("leave_request", "default", ""))
+		units, err := store.GetCompilationUnits(ctx, rootID)
+		require.NoError(t, err)
+		require.Contains(t, units, rootID)
+	})
+}
+
+func TestConf(t *testing.T) {
+	conf := &federated.Conf{}
+	conf.SetDefaults()
+	conf.Drivers = []string{"postgres", "git"}
+	require.NoError(t, conf.Validate())
+
+	conf.Drivers = []string{"git"}
+	require.Error(t, conf.Validate())
+
+	conf.Drivers = []string{"git", "git"}
+	require.Error(t, conf.Validate())
+
+	conf.Drivers = []string{"git", "overlay"}
+	require.Error(t, conf.Validate())
+
+	conf.Drivers = []string{"postgres", "git"}
+	conf.OnConflict = "merge"
+	require.Error(t, conf.Validate())
+}
@@ -43,12 +43,20 @@ type Conf struct {
 	Branch string `yaml:"branch" conf:",example=policies"`
 	// SubDir is the path under the checked-out Git repo where the policies are stored.
 	SubDir string `yaml:"subDir,omitempty" conf:",example=policies"`
+	// Include is the list of glob patterns matching the policy files to load, relative to subDir. All policy files are loaded if it's empty. Use ** to match any number of directories.
+	Include []string `yaml:"include,omitempty" conf:",example=[\"common/**\", \"team_a/**\"]"`
+	// Exclude is the list of glob patterns matching the policy files to skip, relative to subDir. Takes precedence over include.
+	Exclude []string `yaml:"exclude,omitempty" conf:",example=[\"**/drafts/**\"]"`
+	// Submodules enables checking out the submodules of the Git repo recursively, so that they can contain policies.
+	Submodules bool `yaml:"submodules" conf:",example=false"`
 	// CheckoutDir is the local path to checkout the Git repo to.
 	CheckoutDir string `yaml:"checkoutDir" conf:",example=${HOME}/tmp/cerbos/work"`
 	// [DEPRECATED] ScratchDir is the directory to use for holding temporary data.
 	ScratchDir string `yaml:"scratchDir" conf:",ignore"`
 	// UpdatePollInterval specifies the interval to poll the Git repository for changes. Set to 0 to disable.
 	UpdatePollInterval time.Duration `yaml:"updatePollInterval" conf:",example=60s"`
+	// Sync configures the backoff for retrying after failures to poll the Git repository and when the store is reported as degraded.
+	Sync *storage.SyncConf `yaml:"sync,omitempty" conf:",example=\n  initialInterval: 10s\n  maxInterval: 5m\n  degradedAfter: 3"`
 }
 
 // SSHAuth holds auth details for the SSH protocol.
@@ -116,6 +124,14 @@ func (conf *Conf) Validate() error {
 		}
 	}
 
+	if err := conf.Sync.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if _, err := newPathFilter(conf.Include, conf.Exclude); err != nil {
+		errs = append(errs, err)
+	}
+
 	if len(errs) > 0 {
 		return multierr.Combine(errs...)
 	}
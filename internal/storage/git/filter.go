@@ -0,0 +1,73 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"fmt"
+
+	"github.com/gobwas/glob"
+)
+
+// pathFilter selects the policy files to load by matching their paths against glob patterns.
+type pathFilter struct {
+	include []glob.Glob
+	exclude []glob.Glob
+}
+
+func newPathFilter(include, exclude []string) (*pathFilter, error) {
+	if len(include) == 0 && len(exclude) == 0 {
+		return nil, nil
+	}
+
+	f := &pathFilter{}
+	var err error
+	if f.include, err = compileGlobs("include", include); err != nil {
+		return nil, err
+	}
+
+	if f.exclude, err = compileGlobs("exclude", exclude); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func compileGlobs(field string, patterns []string) ([]glob.Glob, error) {
+	globs := make([]glob.Glob, len(patterns))
+	for i, p := range patterns {
+		g, err := glob.Compile(p, '/')
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s pattern %q: %w", field, p, err)
+		}
+		globs[i] = g
+	}
+
+	return globs, nil
+}
+
+// matches returns true if the path matches one of the include patterns (or there are none) and none of the exclude patterns.
+// A nil filter matches all paths.
+func (f *pathFilter) matches(path string) bool {
+	if f == nil {
+		return true
+	}
+
+	for _, g := range f.exclude {
+		if g.Match(path) {
+			return false
+		}
+	}
+
+	if len(f.include) == 0 {
+		return true
+	}
+
+	for _, g := range f.include {
+		if g.Match(path) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -11,11 +11,15 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
 	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/spf13/afero"
 	"go.opencensus.io/stats"
 	"go.opencensus.io/tag"
 	"go.uber.org/zap"
@@ -26,16 +30,25 @@ import (
 	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/observability/metrics"
 	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
 	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/disk"
 	"github.com/cerbos/cerbos/internal/storage/index"
 	"github.com/cerbos/cerbos/internal/util"
 )
 
-const DriverName = "git"
+const (
+	DriverName = "git"
+	// maxSyncRetryInterval is the default maximum time to wait before retrying a failed poll.
+	maxSyncRetryInterval = 5 * time.Minute
+)
 
 var (
 	_ storage.SourceStore = (*Store)(nil)
 	_ storage.Reloadable  = (*Store)(nil)
+	_ storage.Historical  = (*Store)(nil)
+	_ storage.Syncable    = (*Store)(nil)
+	_ storage.Syncer      = (*Store)(nil)
 )
 
 func init() {
@@ -50,18 +63,29 @@ func init() {
 }
 
 type Store struct {
-	log  *zap.SugaredLogger
-	conf *Conf
-	idx  index.Index
-	repo *git.Repository
-	sf   singleflight.Group
+	log        *zap.SugaredLogger
+	conf       *Conf
+	idx        index.Index
+	repo       *git.Repository
+	sf         singleflight.Group
+	revision   atomic.Pointer[string]
+	syncStatus *storage.SyncStatus
+	syncMu     sync.Mutex
+	filter     *pathFilter
 	*storage.SubscriptionManager
 }
 
 func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
+	filter, err := newPathFilter(conf.Include, conf.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
 	s := &Store{
 		log:                 zap.S().Named("git.store").With("dir", conf.CheckoutDir),
 		conf:                conf,
+		syncStatus:          storage.NewSyncStatus(DriverName, conf.Sync),
+		filter:              filter,
 		SubscriptionManager: storage.NewSubscriptionManager(ctx),
 	}
 
@@ -89,6 +113,7 @@ func (s *Store) init(ctx context.Context) error {
 			return err
 		}
 
+		s.syncStatus.Succeeded()
 		go s.pollForUpdates(ctx)
 
 		return nil
@@ -127,13 +152,26 @@ func (s *Store) init(ctx context.Context) error {
 		return err
 	}
 
+	// pulling doesn't update the submodules if the repo is already up to date
+	if err := s.updateSubmodules(ctx); err != nil {
+		return err
+	}
+
 	return loadAndStartPoller()
 }
 
+func (s *Store) SyncStatus() *storage.SyncStatus {
+	return s.syncStatus
+}
+
 func (s *Store) Driver() string {
 	return DriverName
 }
 
+func (s *Store) WatchesForChanges() bool {
+	return s.conf.UpdatePollInterval > 0
+}
+
 func (s *Store) GetFirstMatch(_ context.Context, candidates []namer.ModuleID) (*policy.CompilationUnit, error) {
 	return s.idx.GetFirstMatch(candidates)
 }
@@ -162,6 +200,30 @@ func (s *Store) LoadPolicy(ctx context.Context, file ...string) ([]*policy.Wrapp
 	return s.idx.LoadPolicy(ctx, file...)
 }
 
+// Revision returns the hash of the commit the policies were loaded from.
+func (s *Store) Revision() string {
+	if rev := s.revision.Load(); rev != nil {
+		return *rev
+	}
+
+	return ""
+}
+
+func (s *Store) recordRevision() {
+	if s.repo == nil {
+		return
+	}
+
+	head, err := s.repo.Head()
+	if err != nil {
+		s.log.Warnw("Failed to determine repo HEAD", "error", err)
+		return
+	}
+
+	rev := head.Hash().String()
+	s.revision.Store(&rev)
+}
+
 func (s *Store) RepoStats(ctx context.Context) storage.RepoStats {
 	return s.idx.RepoStats(ctx)
 }
@@ -177,10 +239,86 @@ func (s *Store) Reload(ctx context.Context) error {
 		return fmt.Errorf("failed to reload index: %w", err)
 	}
 
+	s.recordRevision()
+
 	s.NotifySubscribers(evts...)
 	return nil
 }
 
+// AsOf returns the policies from the commit that was at the head of the branch at the given time, or from the given revision.
+func (s *Store) AsOf(ctx context.Context, point storage.HistoryPoint) (storage.SourceStore, string, error) {
+	commit, err := s.findCommit(point)
+	if err != nil {
+		return nil, "", err
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get tree for commit %s: %w", commit.Hash, err)
+	}
+
+	fsys := afero.NewMemMapFs()
+	err = tree.Files().ForEach(func(f *object.File) error {
+		path, fileType := s.normalizePath(f.Name)
+		if fileType == util.FileTypeNotIndexed {
+			return nil
+		}
+
+		if fileType == util.FileTypeSchema {
+			path = filepath.Join(schema.Directory, path)
+		}
+
+		contents, err := f.Contents()
+		if err != nil {
+			return fmt.Errorf("failed to read %s from commit %s: %w", f.Name, commit.Hash, err)
+		}
+
+		return afero.WriteFile(fsys, path, []byte(contents), 0o644) //nolint:gomnd
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	idx, err := index.Build(ctx, afero.NewIOFS(fsys))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to build index for commit %s: %w", commit.Hash, err)
+	}
+
+	return disk.NewFromIndexWithConf(idx, &disk.Conf{}), commit.Hash.String(), nil
+}
+
+func (s *Store) findCommit(point storage.HistoryPoint) (*object.Commit, error) {
+	if point.Revision != "" {
+		hash, err := s.repo.ResolveRevision(plumbing.Revision(point.Revision))
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to resolve %q: %v", storage.ErrRevisionNotFound, point.Revision, err)
+		}
+
+		return s.repo.CommitObject(*hash)
+	}
+
+	head, err := s.repo.Reference(plumbing.NewBranchReferenceName(s.conf.getBranch()), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch %s: %w", s.conf.getBranch(), err)
+	}
+
+	iter, err := s.repo.Log(&git.LogOptions{From: head.Hash(), Order: git.LogOrderCommitterTime, Until: &point.Time})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit log: %w", err)
+	}
+	defer iter.Close()
+
+	commit, err := iter.Next()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: no commits before %s", storage.ErrRevisionNotFound, point.Time)
+		}
+		return nil, fmt.Errorf("failed to read commit log: %w", err)
+	}
+
+	return commit, nil
+}
+
 func isEmptyDir(dir string) (bool, error) {
 	d, err := os.Open(dir)
 	if err != nil {
@@ -214,6 +352,10 @@ func (s *Store) cloneRepo(ctx context.Context) error {
 		SingleBranch:  true,
 	}
 
+	if s.conf.Submodules {
+		opts.RecurseSubmodules = git.DefaultSubmoduleRecursionDepth
+	}
+
 	s.log.Infof("Cloning git repo from %s", s.conf.URL)
 
 	ctx, cancelFunc := s.conf.getOpCtx(ctx)
@@ -232,12 +374,53 @@ func (s *Store) loadAll(ctx context.Context) error {
 		policyDir = s.conf.SubDir
 	}
 
-	idx, err := index.Build(ctx, os.DirFS(s.conf.CheckoutDir), index.WithRootDir(policyDir))
+	opts := []index.BuildOpt{index.WithRootDir(policyDir)}
+	if s.filter != nil {
+		opts = append(opts, index.WithFileFilter(s.filter.matches))
+	}
+
+	idx, err := index.Build(ctx, os.DirFS(s.conf.CheckoutDir), opts...)
 	if err != nil {
 		return err
 	}
 
 	s.idx = idx
+	s.recordRevision()
+
+	return nil
+}
+
+func (s *Store) updateSubmodules(ctx context.Context) error {
+	if !s.conf.Submodules {
+		return nil
+	}
+
+	wt, err := s.repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("failed to get work tree: %w", err)
+	}
+
+	submodules, err := wt.Submodules()
+	if err != nil {
+		return fmt.Errorf("failed to get submodules: %w", err)
+	}
+
+	auth, err := s.conf.getAuth()
+	if err != nil {
+		return fmt.Errorf("failed to create git auth credentials: %w", err)
+	}
+
+	ctx, cancelFunc := s.conf.getOpCtx(ctx)
+	defer cancelFunc()
+
+	s.log.Debugw("Updating submodules", "count", len(submodules))
+	if err := submodules.UpdateContext(ctx, &git.SubmoduleUpdateOptions{
+		Init:              true,
+		Auth:              auth,
+		RecurseSubmodules: git.DefaultSubmoduleRecursionDepth,
+	}); err != nil {
+		return fmt.Errorf("failed to update submodules: %w", err)
+	}
 
 	return nil
 }
@@ -276,6 +459,10 @@ func (s *Store) pullAndCompare(ctx context.Context) (object.Changes, error) {
 			SingleBranch:  true,
 		}
 
+		if s.conf.Submodules {
+			opts.RecurseSubmodules = git.DefaultSubmoduleRecursionDepth
+		}
+
 		pullCtx, pullCancel := s.conf.getOpCtx(ctx)
 		defer pullCancel()
 
@@ -400,6 +587,19 @@ func (s *Store) updateIndex(ctx context.Context) error {
 
 	s.log.Infow("Detected repository changes")
 
+	if s.conf.Submodules && changesSubmodules(changes) {
+		// the changes in the submodules are not part of the diff, so the whole index has to be rebuilt
+		s.log.Info("Submodules changed: reloading all policies")
+		evts, err := s.idx.Reload(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to reload index: %w", err)
+		}
+
+		s.recordRevision()
+		s.NotifySubscribers(evts...)
+		return nil
+	}
+
 	for _, c := range changes {
 		s.log.Debugw("Processing change", "change", c)
 
@@ -439,10 +639,21 @@ func (s *Store) updateIndex(ctx context.Context) error {
 		}
 	}
 
+	s.recordRevision()
 	s.log.Info("Index updated")
 	return nil
 }
 
+func changesSubmodules(changes object.Changes) bool {
+	for _, c := range changes {
+		if c.From.TreeEntry.Mode == filemode.Submodule || c.To.TreeEntry.Mode == filemode.Submodule {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (s *Store) normalizePath(path string) (string, util.IndexedFileType) {
 	if path == "" {
 		return path, util.FileTypeNotIndexed
@@ -458,8 +669,14 @@ func (s *Store) normalizePath(path string) (string, util.IndexedFileType) {
 	}
 
 	fileType := util.FileType(path)
-	if fileType == util.FileTypeSchema {
+	switch fileType {
+	case util.FileTypeSchema:
 		path, _ = util.RelativeSchemaPath(path)
+	case util.FileTypePolicy:
+		if !s.filter.matches(path) {
+			return path, util.FileTypeNotIndexed
+		}
+	default:
 	}
 
 	return path, fileType
@@ -511,6 +728,23 @@ func (s *Store) readPolicyFromBlob(hash plumbing.Hash) (*policyv1.Policy, error)
 	return p, nil
 }
 
+// Sync checks for updates immediately, without waiting for the next poll.
+func (s *Store) Sync(ctx context.Context) error {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	if err := s.updateIndex(ctx); err != nil {
+		s.syncStatus.Failed()
+		_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
+			tag.Upsert(metrics.KeyStoreDriver, DriverName),
+		}, metrics.StoreSyncErrorCount.M(1))
+		return err
+	}
+
+	s.syncStatus.Succeeded()
+	return nil
+}
+
 func (s *Store) pollForUpdates(ctx context.Context) {
 	if s.conf.UpdatePollInterval <= 0 {
 		s.log.Info("Polling disabled: new updates will not be pulled automatically")
@@ -519,25 +753,31 @@ func (s *Store) pollForUpdates(ctx context.Context) {
 
 	s.log.Infof("Polling for updates every %s", s.conf.UpdatePollInterval)
 
-	ticker := time.NewTicker(s.conf.UpdatePollInterval)
-	defer ticker.Stop()
+	// failures are retried with backoff rather than at the next poll
+	retry := s.conf.Sync.NewBackOff(s.conf.UpdatePollInterval, maxSyncRetryInterval)
+
+	timer := time.NewTimer(s.conf.UpdatePollInterval)
+	defer timer.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
 			s.log.Info("Stopped polling for updates")
 			return
-		case <-ticker.C:
-			if err := s.updateIndex(ctx); err != nil {
-				s.log.Errorw("Failed to check for updates", "error", err)
-				_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
-					tag.Upsert(metrics.KeyStoreDriver, DriverName),
-				}, metrics.StoreSyncErrorCount.M(1))
+		case <-timer.C:
+			wait := s.conf.UpdatePollInterval
+			if err := s.Sync(ctx); err != nil {
+				wait = retry.NextBackOff()
+				s.log.Errorw("Failed to check for updates", "error", err, "retryIn", wait)
+			} else {
+				retry.Reset()
 			}
 
 			_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
 				tag.Upsert(metrics.KeyStoreDriver, DriverName),
 			}, metrics.StorePollCount.M(1))
+
+			timer.Reset(wait)
 		}
 	}
 }
@@ -58,6 +58,7 @@ func TestNewStore(t *testing.T) {
 		require.NoError(t, err)
 
 		requireIndexContains(t, store, wantFiles)
+		requireRevision(t, store, sourceGitDir)
 	})
 
 	// the checkout directory is empty so the remote repo will be cloned.
@@ -182,6 +183,7 @@ func TestUpdateStore(t *testing.T) {
 		require.NoError(t, param.store.updateIndex(context.Background()))
 		param.mockIdx.AssertExpectations(t)
 		param.mockIdx.AssertNumberOfCalls(t, "AddOrUpdate", len(pset))
+		requireRevision(t, param.store, param.sourceGitDir)
 
 		wantEvents := make([]storage.Event, 0, len(pset))
 		for _, p := range pset {
@@ -570,6 +572,181 @@ func TestUpdateStore(t *testing.T) {
 	})
 }
 
+func TestPathFilters(t *testing.T) {
+	tempDir := t.TempDir()
+	sourceGitDir := filepath.Join(tempDir, "source")
+	checkoutDir := filepath.Join(tempDir, "checkout")
+
+	numPolicySets := 5
+	allFiles := createGitRepo(t, sourceGitDir, numPolicySets)
+
+	excludedSet := genPolicySet(0)
+	conf := mkConf(t, sourceGitDir, checkoutDir)
+	conf.Include = []string{"*.yaml"}
+	for f := range excludedSet {
+		conf.Exclude = append(conf.Exclude, f)
+	}
+
+	var wantFiles []string
+	for _, f := range allFiles {
+		if _, ok := excludedSet[strings.TrimPrefix(f, policyDir+"/")]; !ok {
+			wantFiles = append(wantFiles, f)
+		}
+	}
+
+	store, err := NewStore(context.Background(), conf)
+	require.NoError(t, err)
+	requireIndexContains(t, store, wantFiles)
+
+	checkEvents := storage.TestSubscription(store)
+
+	includedSet := genPolicySet(1)
+	nestedSet := genPolicySet(numPolicySets)
+	require.NoError(t, commitToGitRepo(sourceGitDir, "Modify policies", func(wt *git.Worktree) error {
+		for _, pset := range []policySet{excludedSet, includedSet} {
+			for _, p := range pset {
+				modifyPolicy(p)
+			}
+
+			if err := writePolicySet(filepath.Join(sourceGitDir, policyDir), pset); err != nil {
+				return err
+			}
+		}
+
+		// "*" does not match the directory separator, so the nested policies are not included
+		nestedDir := filepath.Join(sourceGitDir, policyDir, "nested")
+		if err := os.MkdirAll(nestedDir, 0o744); err != nil {
+			return err
+		}
+
+		if err := writePolicySet(nestedDir, nestedSet); err != nil {
+			return err
+		}
+
+		_, err := wt.Add(".")
+		return err
+	}))
+
+	require.NoError(t, store.updateIndex(context.Background()))
+	requireIndexContains(t, store, wantFiles)
+
+	wantEvents := make([]storage.Event, 0, len(includedSet))
+	for _, p := range includedSet {
+		wantEvents = append(wantEvents, storage.Event{Kind: storage.EventAddOrUpdatePolicy, PolicyID: namer.GenModuleID(p)})
+	}
+
+	checkEvents(t, timeout, wantEvents...)
+}
+
+func TestAsOf(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping git store tests")
+	}
+
+	tempDir := t.TempDir()
+	sourceGitDir := filepath.Join(tempDir, "source")
+	checkoutDir := filepath.Join(tempDir, "checkout")
+
+	_ = createGitRepo(t, sourceGitDir, 2)
+
+	repo, err := git.PlainOpen(sourceGitDir)
+	require.NoError(t, err)
+
+	head, err := repo.Head()
+	require.NoError(t, err)
+	firstRevision := head.Hash().String()
+
+	// commit the deletion an hour in the future so that it can be distinguished from the initial commit by time.
+	deletedAt := time.Now().Add(1 * time.Hour)
+	pset := genPolicySet(1)
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+	for file := range pset {
+		fp := filepath.Join(policyDir, file)
+		require.NoError(t, os.Remove(filepath.Join(sourceGitDir, fp)))
+		_, err := wt.Remove(fp)
+		require.NoError(t, err)
+	}
+
+	secondHash, err := wt.Commit("Delete policies", &git.CommitOptions{
+		Author: &object.Signature{Name: "Daffy Duck", Email: "daffy@mallard.dev", When: deletedAt},
+	})
+	require.NoError(t, err)
+	secondRevision := secondHash.String()
+
+	store, err := NewStore(context.Background(), mkConf(t, sourceGitDir, checkoutDir))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		point        storage.HistoryPoint
+		wantRevision string
+		wantDeleted  bool
+	}{
+		{
+			name:         "revision_before_deletion",
+			point:        storage.HistoryPoint{Revision: firstRevision},
+			wantRevision: firstRevision,
+		},
+		{
+			name:         "revision_after_deletion",
+			point:        storage.HistoryPoint{Revision: secondRevision},
+			wantRevision: secondRevision,
+			wantDeleted:  true,
+		},
+		{
+			name:         "time_before_deletion",
+			point:        storage.HistoryPoint{Time: deletedAt.Add(-1 * time.Minute)},
+			wantRevision: firstRevision,
+		},
+		{
+			name:         "time_after_deletion",
+			point:        storage.HistoryPoint{Time: deletedAt.Add(1 * time.Minute)},
+			wantRevision: secondRevision,
+			wantDeleted:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			snapshot, haveRevision, err := store.AsOf(context.Background(), tc.point)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantRevision, haveRevision)
+
+			for _, p := range genPolicySet(0) {
+				have, err := snapshot.GetCompilationUnits(context.Background(), namer.GenModuleID(p))
+				require.NoError(t, err)
+				require.Len(t, have, 1)
+			}
+
+			for _, p := range pset {
+				have, err := snapshot.GetCompilationUnits(context.Background(), namer.GenModuleID(p))
+				require.NoError(t, err)
+				if tc.wantDeleted {
+					require.Empty(t, have)
+				} else {
+					require.Len(t, have, 1)
+				}
+			}
+
+			schemas, err := snapshot.ListSchemaIDs(context.Background())
+			require.NoError(t, err)
+			require.NotEmpty(t, schemas)
+		})
+	}
+
+	t.Run("unknown_revision", func(t *testing.T) {
+		_, _, err := store.AsOf(context.Background(), storage.HistoryPoint{Revision: "no-such-revision"})
+		require.ErrorIs(t, err, storage.ErrRevisionNotFound)
+	})
+
+	t.Run("time_before_first_commit", func(t *testing.T) {
+		_, _, err := store.AsOf(context.Background(), storage.HistoryPoint{Time: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)})
+		require.ErrorIs(t, err, storage.ErrRevisionNotFound)
+	})
+}
+
 func TestReloadable(t *testing.T) {
 	ps := genPolicySet(1)
 	sourceGitDir := t.TempDir()
@@ -685,6 +862,18 @@ func requireIndexContains(t *testing.T, store *Store, wantFiles []string) {
 	require.ElementsMatch(t, wantFiles, haveFiles)
 }
 
+func requireRevision(t *testing.T, store *Store, sourceGitDir string) {
+	t.Helper()
+
+	repo, err := git.PlainOpen(sourceGitDir)
+	require.NoError(t, err)
+
+	head, err := repo.Head()
+	require.NoError(t, err)
+
+	require.Equal(t, head.Hash().String(), store.Revision())
+}
+
 func mkConf(t *testing.T, gitRepo, checkoutDir string) *Conf {
 	t.Helper()
 
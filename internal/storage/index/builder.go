@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io/fs"
 	"path"
+	"strings"
 
 	"go.opencensus.io/stats"
 	"go.uber.org/zap"
@@ -40,8 +41,10 @@ func (ibe *BuildError) Error() string {
 }
 
 type buildOptions struct {
+	fileFilter           func(string) bool
 	rootDir              string
 	buildFailureLogLevel zapcore.Level
+	allowMissingImports  bool
 }
 
 type BuildOpt func(*buildOptions)
@@ -58,6 +61,22 @@ func WithRootDir(rootDir string) BuildOpt {
 	}
 }
 
+// WithMissingImportsAllowed builds the index even if some imported derived roles or variables are missing.
+// The missing imports are reported by the compiler when the importing policies are compiled.
+func WithMissingImportsAllowed() BuildOpt {
+	return func(o *buildOptions) {
+		o.allowMissingImports = true
+	}
+}
+
+// WithFileFilter only loads the policy files for which the filter returns true.
+// The filter is called with the path of the file relative to the root directory.
+func WithFileFilter(filter func(string) bool) BuildOpt {
+	return func(o *buildOptions) {
+		o.fileFilter = filter
+	}
+}
+
 func mkBuildOpts(opts ...BuildOpt) buildOptions {
 	o := buildOptions{
 		buildFailureLogLevel: zap.ErrorLevel,
@@ -81,8 +100,9 @@ func build(ctx context.Context, fsys fs.FS, opts buildOptions) (Index, error) {
 		return nil, err
 	}
 
-	ib := newIndexBuilder()
+	ib := newIndexBuilder(fsys, opts.rootDir)
 
+	var loaded []loadedPolicy
 	err := fs.WalkDir(fsys, opts.rootDir, func(filePath string, d fs.DirEntry, err error) error {
 		if err := ctx.Err(); err != nil {
 			return err
@@ -111,6 +131,10 @@ func build(ctx context.Context, fsys fs.FS, opts buildOptions) (Index, error) {
 			return nil
 		}
 
+		if opts.fileFilter != nil && !opts.fileFilter(relativePath(opts.rootDir, filePath)) {
+			return nil
+		}
+
 		if err := internaljsonschema.ValidatePolicy(fsys, filePath); err != nil {
 			ib.addLoadFailure(filePath, err)
 			return nil
@@ -132,18 +156,53 @@ func build(ctx context.Context, fsys fs.FS, opts buildOptions) (Index, error) {
 			return nil
 		}
 
-		ib.addPolicy(filePath, policy.Wrap(policy.WithMetadata(p, filePath, nil, filePath)))
+		pkg, err := ib.packages.forFile(filePath)
+		if err != nil {
+			ib.addLoadFailure(filePath, err)
+			return nil
+		}
 
+		if modID, def, ok := definePackage(pkg, p); ok {
+			ib.definitions[modID] = def
+		}
+
+		loaded = append(loaded, loadedPolicy{file: filePath, pkg: pkg, policy: p})
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	// imports can only be resolved after all the definitions in the packages are known
+	for _, lp := range loaded {
+		if err := resolveImports(lp.pkg, lp.policy, ib.definitions); err != nil {
+			ib.addLoadFailure(lp.file, err)
+			continue
+		}
+
+		ib.addPolicy(lp.file, policy.Wrap(policy.WithMetadata(lp.policy, lp.file, nil, lp.file)))
+	}
+
 	return ib.build(fsys, opts)
 }
 
+func relativePath(rootDir, filePath string) string {
+	if rootDir == "." {
+		return filePath
+	}
+
+	return strings.TrimPrefix(filePath, strings.TrimSuffix(rootDir, "/")+"/")
+}
+
+type loadedPolicy struct {
+	pkg    *packageManifest
+	policy *policyv1.Policy
+	file   string
+}
+
 type indexBuilder struct {
+	packages      *packageSet
+	definitions   map[namer.ModuleID]packageDef
 	executables   map[namer.ModuleID]struct{}
 	modIDToFile   map[namer.ModuleID]string
 	fileToModID   map[string]namer.ModuleID
@@ -157,8 +216,10 @@ type indexBuilder struct {
 	disabled      []string
 }
 
-func newIndexBuilder() *indexBuilder {
+func newIndexBuilder(fsys fs.FS, rootDir string) *indexBuilder {
 	return &indexBuilder{
+		packages:      newPackageSet(fsys, rootDir),
+		definitions:   make(map[namer.ModuleID]packageDef),
 		executables:   make(map[namer.ModuleID]struct{}),
 		modIDToFile:   make(map[namer.ModuleID]string),
 		fileToModID:   make(map[string]namer.ModuleID),
@@ -254,6 +315,10 @@ func (idx *indexBuilder) addDep(child, parent namer.ModuleID) {
 func (idx *indexBuilder) build(fsys fs.FS, opts buildOptions) (*index, error) {
 	logger := zap.L().Named("index")
 
+	if opts.allowMissingImports {
+		idx.missing = nil
+	}
+
 	nErr := len(idx.missing) + len(idx.duplicates) + len(idx.loadFailures) + len(idx.missingScopes)
 	if nErr > 0 {
 		err := &BuildError{
@@ -289,6 +354,8 @@ func (idx *indexBuilder) build(fsys fs.FS, opts buildOptions) (*index, error) {
 		fileToModID:  idx.fileToModID,
 		dependents:   idx.dependents,
 		dependencies: idx.dependencies,
+		packages:     idx.packages,
+		definitions:  idx.definitions,
 		buildOpts:    opts,
 		schemaLoader: NewSchemaLoader(fsys, opts.rootDir),
 		stats:        idx.stats.collate(),
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"io"
 	"io/fs"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -227,6 +228,20 @@ func TestBuildIndex(t *testing.T) {
 	}
 }
 
+func TestBuildIndexWithMissingImportsAllowed(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join(test.PathToDir(t, "index"), "missing_derived_roles_import.yaml"))
+	require.NoError(t, err)
+
+	idx, err := Build(context.Background(), toFS(t, readTestCase(t, data)), WithMissingImportsAllowed())
+	require.NoError(t, err)
+
+	modID := namer.ResourcePolicyModuleID("leave_request", "20210210", "")
+	cus, err := idx.GetCompilationUnits(modID)
+	require.NoError(t, err)
+	require.Contains(t, cus, modID)
+	require.Len(t, cus[modID].Definitions, 1)
+}
+
 func readTestCase(t *testing.T, data []byte) *privatev1.IndexBuilderTestCase {
 	t.Helper()
 
@@ -54,6 +54,7 @@ type Index interface {
 	LoadSchema(context.Context, string) (io.ReadCloser, error)
 	LoadPolicy(context.Context, ...string) ([]*policy.Wrapper, error)
 	Reload(ctx context.Context) ([]storage.Event, error)
+	ReloadFrom(ctx context.Context, fsys fs.FS) ([]storage.Event, error)
 }
 
 type index struct {
@@ -63,10 +64,13 @@ type index struct {
 	dependents   map[namer.ModuleID]map[namer.ModuleID]struct{}
 	dependencies map[namer.ModuleID]map[namer.ModuleID]struct{}
 	modIDToFile  map[namer.ModuleID]string
+	packages     *packageSet
+	definitions  map[namer.ModuleID]packageDef
 	schemaLoader *SchemaLoader
 	sfGroup      singleflight.Group
 	stats        storage.RepoStats
 	buildOpts    buildOptions
+	fsysVersion  uint64
 	mu           sync.RWMutex
 }
 
@@ -186,6 +190,9 @@ func (idx *index) addDepsToCompilationUnit(cu *policy.CompilationUnit, id namer.
 		if !ok {
 			p, err := idx.loadPolicy(dep)
 			if err != nil {
+				if idx.buildOpts.allowMissingImports && errors.Is(err, ErrPolicyNotFound) {
+					continue
+				}
 				return err
 			}
 
@@ -219,6 +226,16 @@ func (idx *index) loadPolicy(id namer.ModuleID) (*policyv1.Policy, error) {
 		return nil, err
 	}
 
+	pkg, err := idx.packages.forFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	definePackage(pkg, p)
+	if err := resolveImports(pkg, p, idx.definitions); err != nil {
+		return nil, err
+	}
+
 	return policy.WithMetadata(p, fileName, nil, fileName), nil
 }
 
@@ -260,13 +277,24 @@ func (idx *index) AddOrUpdate(entry Entry) (evt storage.Event, err error) {
 		return storage.Event{Kind: storage.EventNop}, ErrInvalidEntry
 	}
 
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	pkg, err := idx.packages.forFile(entry.File)
+	if err != nil {
+		return storage.Event{Kind: storage.EventNop}, err
+	}
+
+	defModID, def, isDef := definePackage(pkg, entry.Policy.Policy)
+	if err := resolveImports(pkg, entry.Policy.Policy, idx.definitions); err != nil {
+		return storage.Event{Kind: storage.EventNop}, err
+	}
+	entry.Policy = policy.Wrap(entry.Policy.Policy)
+
 	modID := entry.Policy.ID
 	evt = storage.NewPolicyEvent(storage.EventAddOrUpdatePolicy, modID)
 	crudKind := "create"
 
-	idx.mu.Lock()
-	defer idx.mu.Unlock()
-
 	// Is this is a duplicate of another file?
 	if otherFile, ok := idx.modIDToFile[modID]; ok && otherFile != entry.File {
 		return evt, fmt.Errorf("policy is already defined in %s: %w", otherFile, ErrDuplicatePolicy)
@@ -286,6 +314,7 @@ func (idx *index) AddOrUpdate(entry Entry) (evt storage.Event, err error) {
 		delete(idx.dependencies, oldModID)
 		delete(idx.modIDToFile, oldModID)
 		delete(idx.executables, oldModID)
+		delete(idx.definitions, oldModID)
 		delete(idx.fileToModID, entry.File)
 
 		if oldModID != modID {
@@ -297,6 +326,9 @@ func (idx *index) AddOrUpdate(entry Entry) (evt storage.Event, err error) {
 	// add to index
 	idx.fileToModID[entry.File] = modID
 	idx.modIDToFile[modID] = entry.File
+	if isDef {
+		idx.definitions[defModID] = def
+	}
 
 	if entry.Policy.Kind != policy.DerivedRolesKind {
 		idx.executables[modID] = struct{}{}
@@ -351,6 +383,7 @@ func (idx *index) Delete(entry Entry) (storage.Event, error) {
 	delete(idx.modIDToFile, modID)
 	delete(idx.dependencies, modID)
 	delete(idx.executables, modID)
+	delete(idx.definitions, modID)
 
 	statsCtx := context.Background()
 	stats.Record(statsCtx, metrics.IndexEntryCount.M(int64(len(idx.modIDToFile))))
@@ -409,6 +442,8 @@ func (idx *index) Clear() error {
 	idx.fileToModID = nil
 	idx.dependents = nil
 	idx.dependencies = nil
+	idx.packages = nil
+	idx.definitions = nil
 
 	return nil
 }
@@ -486,28 +521,34 @@ func (idx *index) Reload(ctx context.Context) ([]storage.Event, error) {
 	log := ctxzap.Extract(ctx)
 	log.Info("Start index reload")
 	_, err, _ := idx.sfGroup.Do("reload", func() (any, error) {
-		idxIface, err := build(ctx, idx.fsys, idx.buildOpts)
-		if err != nil {
-			log.Error("Failed to build index while re-indexing")
-			return nil, err
-		}
+		idx.mu.RLock()
+		fsys, version := idx.fsys, idx.fsysVersion
+		idx.mu.RUnlock()
+
+		return nil, idx.rebuild(ctx, fsys, func() bool {
+			// Don't overwrite the contents of a filesystem that was switched to while this one was being indexed.
+			return idx.fsysVersion == version
+		})
+	})
+	if err != nil {
+		log.Warn("Index reload failed", zap.Error(err))
+		return nil, err
+	}
+	log.Info("Index reload successful")
 
-		newIdx, ok := idxIface.(*index)
-		if !ok {
-			return nil, err
-		}
+	return []storage.Event{storage.NewReloadEvent()}, nil
+}
 
-		idx.mu.Lock()
-		defer idx.mu.Unlock()
-		idx.fileToModID = newIdx.fileToModID
-		idx.executables = newIdx.executables
-		idx.dependents = newIdx.dependents
-		idx.dependencies = newIdx.dependencies
-		idx.modIDToFile = newIdx.modIDToFile
-		idx.schemaLoader = newIdx.schemaLoader
-		idx.stats = newIdx.stats
-
-		return nil, nil
+// ReloadFrom rebuilds the index from fsys and switches to it for all subsequent reads.
+// The previous contents keep being served until the new index is completely built, so that callers never observe
+// a mix of policies from the old and the new filesystems.
+func (idx *index) ReloadFrom(ctx context.Context, fsys fs.FS) ([]storage.Event, error) {
+	log := ctxzap.Extract(ctx)
+	log.Info("Start index reload from new filesystem")
+	err := idx.rebuild(ctx, fsys, func() bool {
+		idx.fsys = fsys
+		idx.fsysVersion++
+		return true
 	})
 	if err != nil {
 		log.Warn("Index reload failed", zap.Error(err))
@@ -517,3 +558,37 @@ func (idx *index) Reload(ctx context.Context) ([]storage.Event, error) {
 
 	return []storage.Event{storage.NewReloadEvent()}, nil
 }
+
+// rebuild builds a new index from fsys and swaps it in, if the swap function returns true.
+// The swap function is called while holding the write lock.
+func (idx *index) rebuild(ctx context.Context, fsys fs.FS, swap func() bool) error {
+	idxIface, err := build(ctx, fsys, idx.buildOpts)
+	if err != nil {
+		ctxzap.Extract(ctx).Error("Failed to build index while re-indexing")
+		return err
+	}
+
+	newIdx, ok := idxIface.(*index)
+	if !ok {
+		return nil
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if !swap() {
+		return nil
+	}
+
+	idx.fileToModID = newIdx.fileToModID
+	idx.executables = newIdx.executables
+	idx.dependents = newIdx.dependents
+	idx.dependencies = newIdx.dependencies
+	idx.modIDToFile = newIdx.modIDToFile
+	idx.packages = newIdx.packages
+	idx.definitions = newIdx.definitions
+	idx.schemaLoader = newIdx.schemaLoader
+	idx.stats = newIdx.stats
+
+	return nil
+}
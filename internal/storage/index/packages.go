@@ -0,0 +1,210 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package index
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+)
+
+// PackageManifestFileName is the name of the file that turns the directory containing it, and its subdirectories, into a policy package.
+const PackageManifestFileName = ".cerbos-package.yaml"
+
+var packageNameRegex = regexp.MustCompile(`^[[:word:]\-]+$`)
+
+// packageManifest declares a policy package.
+// The derived roles and exported variables defined in a package are named `<package>.<name>` and are private to the package unless they are listed in the exports.
+type packageManifest struct {
+	exportedDerivedRoles map[string]struct{}
+	exportedVariables    map[string]struct{}
+	Name                 string         `yaml:"name"`
+	Exports              packageExports `yaml:"exports"`
+}
+
+type packageExports struct {
+	DerivedRoles []string `yaml:"derivedRoles"`
+	Variables    []string `yaml:"variables"`
+}
+
+func (m *packageManifest) validate() error {
+	if !packageNameRegex.MatchString(m.Name) {
+		return fmt.Errorf("invalid package name %q: must only contain letters, digits, underscores and hyphens", m.Name)
+	}
+
+	m.exportedDerivedRoles = make(map[string]struct{}, len(m.Exports.DerivedRoles))
+	for _, name := range m.Exports.DerivedRoles {
+		m.exportedDerivedRoles[name] = struct{}{}
+	}
+
+	m.exportedVariables = make(map[string]struct{}, len(m.Exports.Variables))
+	for _, name := range m.Exports.Variables {
+		m.exportedVariables[name] = struct{}{}
+	}
+
+	return nil
+}
+
+func (m *packageManifest) qualify(name string) string {
+	return m.Name + "." + name
+}
+
+// packageDef records the package that a derived roles or exported variables definition belongs to.
+// Definitions that are not in a package have an empty package name and are visible everywhere.
+type packageDef struct {
+	pkg      string
+	exported bool
+}
+
+// packageSet finds the packages that policy files belong to.
+// Manifests are cached, so changes to them are only picked up when the index is rebuilt.
+type packageSet struct {
+	fsys      fs.FS
+	manifests map[string]*packageManifest
+	rootDir   string
+	mu        sync.Mutex
+}
+
+func newPackageSet(fsys fs.FS, rootDir string) *packageSet {
+	return &packageSet{fsys: fsys, rootDir: rootDir, manifests: make(map[string]*packageManifest)}
+}
+
+// forFile returns the manifest of the package the file belongs to or nil if the file is not in a package.
+// If there are several manifests in the ancestor directories of the file, the closest one applies.
+func (ps *packageSet) forFile(file string) (*packageManifest, error) {
+	if ps == nil {
+		return nil, nil
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	for dir := path.Dir(file); ; dir = path.Dir(dir) {
+		m, err := ps.load(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		if m != nil {
+			return m, nil
+		}
+
+		if dir == ps.rootDir || dir == "." || dir == "/" {
+			return nil, nil
+		}
+	}
+}
+
+func (ps *packageSet) load(dir string) (*packageManifest, error) {
+	if m, ok := ps.manifests[dir]; ok {
+		return m, nil
+	}
+
+	manifestFile := path.Join(dir, PackageManifestFileName)
+	contents, err := fs.ReadFile(ps.fsys, manifestFile)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			ps.manifests[dir] = nil
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to read package manifest %s: %w", manifestFile, err)
+	}
+
+	m := &packageManifest{}
+	dec := yaml.NewDecoder(bytes.NewReader(contents))
+	dec.KnownFields(true)
+	if err := dec.Decode(m); err != nil {
+		return nil, fmt.Errorf("failed to parse package manifest %s: %w", manifestFile, err)
+	}
+
+	if err := m.validate(); err != nil {
+		return nil, fmt.Errorf("invalid package manifest %s: %w", manifestFile, err)
+	}
+
+	ps.manifests[dir] = m
+	return m, nil
+}
+
+// definePackage qualifies the name of the derived roles or exported variables defined by the policy with the package name.
+// It returns the module ID of the definition and the package it belongs to. The last return value is false if the policy is not a definition.
+func definePackage(m *packageManifest, p *policyv1.Policy) (namer.ModuleID, packageDef, bool) {
+	var def packageDef
+	if m != nil {
+		def.pkg = m.Name
+	}
+
+	switch pt := p.PolicyType.(type) {
+	case *policyv1.Policy_DerivedRoles:
+		if m != nil {
+			_, def.exported = m.exportedDerivedRoles[pt.DerivedRoles.Name]
+			pt.DerivedRoles.Name = m.qualify(pt.DerivedRoles.Name)
+		}
+		return namer.DerivedRolesModuleID(pt.DerivedRoles.Name), def, true
+
+	case *policyv1.Policy_ExportVariables:
+		if m != nil {
+			_, def.exported = m.exportedVariables[pt.ExportVariables.Name]
+			pt.ExportVariables.Name = m.qualify(pt.ExportVariables.Name)
+		}
+		return namer.ExportVariablesModuleID(pt.ExportVariables.Name), def, true
+
+	default:
+		return namer.ModuleID{}, def, false
+	}
+}
+
+// resolveImports rewrites the imports of the policy that refer to definitions in its own package to their qualified names
+// and checks that the policy is allowed to import the definitions. Imports of definitions that don't exist are left alone.
+func resolveImports(m *packageManifest, p *policyv1.Policy, defs map[namer.ModuleID]packageDef) error {
+	pkg := ""
+	if m != nil {
+		pkg = m.Name
+	}
+
+	resolve := func(names []string, desc string, modIDFn func(string) namer.ModuleID) error {
+		for i, name := range names {
+			if m != nil {
+				if _, ok := defs[modIDFn(m.qualify(name))]; ok {
+					name = m.qualify(name)
+					names[i] = name
+				}
+			}
+
+			if def, ok := defs[modIDFn(name)]; ok && def.pkg != "" && def.pkg != pkg && !def.exported {
+				return fmt.Errorf("%s '%s' cannot be imported because they are private to package '%s'", desc, name, def.pkg)
+			}
+		}
+
+		return nil
+	}
+
+	var importDerivedRoles, importVariables []string
+	switch pt := p.PolicyType.(type) {
+	case *policyv1.Policy_ResourcePolicy:
+		importDerivedRoles = pt.ResourcePolicy.ImportDerivedRoles
+		importVariables = pt.ResourcePolicy.Variables.GetImport()
+
+	case *policyv1.Policy_PrincipalPolicy:
+		importVariables = pt.PrincipalPolicy.Variables.GetImport()
+
+	case *policyv1.Policy_DerivedRoles:
+		importVariables = pt.DerivedRoles.Variables.GetImport()
+	}
+
+	if err := resolve(importDerivedRoles, "Derived roles", namer.DerivedRolesModuleID); err != nil {
+		return err
+	}
+
+	return resolve(importVariables, "Variables", namer.ExportVariablesModuleID)
+}
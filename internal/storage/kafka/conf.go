@@ -0,0 +1,81 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+const (
+	confKey                   = storage.ConfKey + ".kafka"
+	defaultClientID           = "cerbos"
+	defaultInitialSyncTimeout = 60 * time.Second
+)
+
+// Conf is required (if driver is set to 'kafka') configuration for the Kafka driver.
+// +desc=This section is required only if storage.driver is kafka.
+type Conf struct {
+	// Authentication holds the settings for authenticating to the brokers.
+	Authentication Authentication `yaml:"authentication"`
+	// Topic to consume policy events from. Records are keyed by the path of the policy or schema file and have the file contents as the value.
+	Topic string `yaml:"topic" conf:"required,example=cerbos.policies"`
+	// ClientID reported in Kafka connections.
+	ClientID string `yaml:"clientID" conf:",example=cerbos"`
+	// Brokers list to seed the Kafka client.
+	Brokers []string `yaml:"brokers" conf:"required,example=['localhost:9092']"`
+	// InitialSyncTimeout is the maximum time to wait on startup for the records already in the topic to be consumed.
+	InitialSyncTimeout time.Duration `yaml:"initialSyncTimeout" conf:",example=60s"`
+}
+
+type Authentication struct {
+	TLS *TLS `yaml:"tls"`
+}
+
+type TLS struct {
+	// CAPath is the path to the CA certificate.
+	CAPath string `yaml:"caPath" conf:"required,example=/path/to/ca.crt"`
+	// CertPath is the path to the client certificate.
+	CertPath string `yaml:"certPath" conf:",example=/path/to/tls.cert"`
+	// KeyPath is the path to the client key.
+	KeyPath string `yaml:"keyPath" conf:",example=/path/to/tls.key"`
+	// ReloadInterval is the interval at which the TLS certificates are reloaded. The default is 0 (no reload).
+	ReloadInterval time.Duration `yaml:"reloadInterval" conf:",example=5m"`
+	// InsecureSkipVerify controls whether the server's certificate chain and host name are verified. Default is false.
+	InsecureSkipVerify bool `yaml:"insecureSkipVerify" conf:",example=true"`
+}
+
+func (c *Conf) Key() string {
+	return confKey
+}
+
+func (c *Conf) SetDefaults() {
+	c.ClientID = defaultClientID
+	c.InitialSyncTimeout = defaultInitialSyncTimeout
+}
+
+func (c *Conf) Validate() (errs error) {
+	if strings.TrimSpace(c.Topic) == "" {
+		errs = multierr.Append(errs, errors.New("invalid topic"))
+	}
+
+	if strings.TrimSpace(c.ClientID) == "" {
+		errs = multierr.Append(errs, errors.New("invalid client ID"))
+	}
+
+	if len(c.Brokers) == 0 {
+		errs = multierr.Append(errs, errors.New("empty brokers"))
+	}
+
+	if c.InitialSyncTimeout <= 0 {
+		errs = multierr.Append(errs, errors.New("initialSyncTimeout must be positive"))
+	}
+
+	return errs
+}
@@ -0,0 +1,206 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/spf13/afero"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/index"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const filePerm = 0o600
+
+var errInvalidKey = errors.New("record key is not the path of a policy or schema file")
+
+// syncExisting writes the records that are in the topic at startup to the in-memory filesystem.
+func (s *Store) syncExisting(ctx context.Context) error {
+	pending, err := s.listPendingOffsets(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.log.Info("Consuming existing records", zap.Int("partitions", len(pending)))
+	for len(pending) > 0 {
+		fetches := s.client.PollFetches(ctx)
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("failed to consume existing records within %s: %w", s.conf.InitialSyncTimeout, err)
+		}
+
+		if fetches.IsClientClosed() {
+			return errors.New("client closed while consuming existing records")
+		}
+
+		fetches.EachError(func(topic string, partition int32, err error) {
+			s.log.Warn("Failed to fetch records", zap.Int32("partition", partition), zap.Error(err))
+		})
+
+		fetches.EachRecord(func(r *kgo.Record) {
+			if _, _, err := s.update(r); err != nil {
+				s.log.Warn("Ignoring record", zap.Int32("partition", r.Partition), zap.Int64("offset", r.Offset), zap.Error(err))
+			}
+
+			if end, ok := pending[r.Partition]; ok && r.Offset+1 >= end {
+				delete(pending, r.Partition)
+			}
+		})
+	}
+
+	return nil
+}
+
+// listPendingOffsets returns the end offsets of the partitions that have records to consume.
+func (s *Store) listPendingOffsets(ctx context.Context) (map[int32]int64, error) {
+	adm := kadm.NewClient(s.client)
+
+	startOffsets, err := adm.ListStartOffsets(ctx, s.conf.Topic)
+	if err == nil {
+		err = startOffsets.Error()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list start offsets of topic %q: %w", s.conf.Topic, err)
+	}
+
+	endOffsets, err := adm.ListEndOffsets(ctx, s.conf.Topic)
+	if err == nil {
+		err = endOffsets.Error()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list end offsets of topic %q: %w", s.conf.Topic, err)
+	}
+
+	pending := make(map[int32]int64)
+	for partition, end := range endOffsets[s.conf.Topic] {
+		if start, ok := startOffsets[s.conf.Topic][partition]; !ok || start.Offset < end.Offset {
+			pending[partition] = end.Offset
+		}
+	}
+
+	return pending, nil
+}
+
+// consume applies the records published after startup to the index and notifies the subscribers.
+func (s *Store) consume(ctx context.Context) {
+	defer s.client.Close()
+
+	s.log.Info("Consuming policy events")
+	for {
+		fetches := s.client.PollFetches(ctx)
+		if ctx.Err() != nil || fetches.IsClientClosed() {
+			s.log.Info("Stopped consuming policy events")
+			return
+		}
+
+		errCount := 0
+		fetches.EachError(func(topic string, partition int32, err error) {
+			s.log.Warn("Failed to fetch records", zap.Int32("partition", partition), zap.Error(err))
+			errCount++
+		})
+
+		fetches.EachRecord(func(r *kgo.Record) {
+			if err := s.apply(r); err != nil {
+				s.log.Warn("Failed to apply record", zap.Int32("partition", r.Partition), zap.Int64("offset", r.Offset), zap.Error(err))
+				errCount++
+			}
+		})
+
+		if errCount > 0 {
+			_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
+				tag.Upsert(metrics.KeyStoreDriver, DriverName),
+			}, metrics.StoreSyncErrorCount.M(int64(errCount)))
+		}
+	}
+}
+
+// apply updates the in-memory filesystem and the index with the contents of the record and notifies the subscribers.
+func (s *Store) apply(r *kgo.Record) error {
+	file, p, err := s.update(r)
+	if err != nil {
+		return err
+	}
+
+	if sf, ok := util.RelativeSchemaPath(file); ok {
+		kind := storage.EventAddOrUpdateSchema
+		if len(r.Value) == 0 {
+			kind = storage.EventDeleteSchema
+		}
+
+		s.NotifySubscribers(storage.NewSchemaEvent(kind, sf))
+		return nil
+	}
+
+	if p == nil {
+		evt, err := s.idx.Delete(index.Entry{File: file})
+		if err != nil {
+			return fmt.Errorf("failed to remove %s from index: %w", file, err)
+		}
+
+		s.NotifySubscribers(evt)
+		return nil
+	}
+
+	evt, err := s.idx.AddOrUpdate(index.Entry{File: file, Policy: policy.Wrap(p)})
+	if err != nil {
+		return fmt.Errorf("failed to add %s to index: %w", file, err)
+	}
+
+	s.NotifySubscribers(evt)
+	return nil
+}
+
+// update writes the value of the record to the file named by its key, or deletes the file if the value is empty (a tombstone).
+// Policies are read before the file is written so that an invalid record doesn't replace the previous version of the file.
+func (s *Store) update(r *kgo.Record) (file string, p *policyv1.Policy, err error) {
+	file, err = fileFromKey(r.Key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(r.Value) == 0 {
+		s.log.Debug("Deleting file", zap.String("file", file))
+		if err := s.fsys.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return "", nil, fmt.Errorf("failed to delete %s: %w", file, err)
+		}
+
+		return file, nil, nil
+	}
+
+	if util.FileType(file) == util.FileTypePolicy {
+		if p, err = policy.ReadPolicy(bytes.NewReader(r.Value)); err != nil {
+			return "", nil, fmt.Errorf("failed to read policy from %s: %w", file, err)
+		}
+	}
+
+	s.log.Debug("Updating file", zap.String("file", file))
+	if err := afero.WriteFile(s.fsys, file, r.Value, filePerm); err != nil {
+		return "", nil, fmt.Errorf("failed to write %s: %w", file, err)
+	}
+
+	return file, p, nil
+}
+
+func fileFromKey(key []byte) (string, error) {
+	file := string(key)
+	if !fs.ValidPath(file) || util.FileType(file) == util.FileTypeNotIndexed {
+		return "", fmt.Errorf("%w: %q", errInvalidKey, file)
+	}
+
+	return file, nil
+}
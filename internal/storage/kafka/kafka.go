@@ -0,0 +1,173 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/afero"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/plugin/kzap"
+	"go.uber.org/zap"
+
+	auditkafka "github.com/cerbos/cerbos/internal/audit/kafka"
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/index"
+)
+
+const DriverName = "kafka"
+
+var (
+	_ storage.SourceStore = (*Store)(nil)
+	_ storage.Reloadable  = (*Store)(nil)
+)
+
+func init() {
+	storage.RegisterDriver(DriverName, func(ctx context.Context, confW *config.Wrapper) (storage.Store, error) {
+		conf := new(Conf)
+		if err := confW.GetSection(conf); err != nil {
+			return nil, fmt.Errorf("failed to read kafka configuration: %w", err)
+		}
+
+		return NewStore(ctx, conf)
+	})
+}
+
+// Store materialises the policies and schemas published to a Kafka topic in memory.
+// The topic is consumed from the beginning by every instance, so it should be compacted to keep only the latest
+// version of each file. Records with the same key are always delivered in order because they belong to the same partition.
+type Store struct {
+	log    *zap.Logger
+	conf   *Conf
+	client *kgo.Client
+	fsys   afero.Fs
+	idx    index.Index
+	*storage.SubscriptionManager
+}
+
+func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
+	clientOpts := []kgo.Opt{
+		kgo.ClientID(conf.ClientID),
+		kgo.SeedBrokers(conf.Brokers...),
+		kgo.ConsumeTopics(conf.Topic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+	}
+
+	if _, ok := os.LookupEnv("CERBOS_DEBUG_KAFKA"); ok {
+		clientOpts = append(clientOpts, kgo.WithLogger(
+			kzap.New(zap.L().Named("kafka"), kzap.Level(kgo.LogLevelDebug)),
+		))
+	}
+
+	if conf.Authentication.TLS != nil {
+		tlsConfig, err := auditkafka.NewTLSConfig(ctx,
+			conf.Authentication.TLS.ReloadInterval,
+			conf.Authentication.TLS.InsecureSkipVerify,
+			conf.Authentication.TLS.CAPath,
+			conf.Authentication.TLS.CertPath,
+			conf.Authentication.TLS.KeyPath)
+		if err != nil {
+			return nil, err
+		}
+
+		clientOpts = append(clientOpts, kgo.DialTLSConfig(tlsConfig))
+	}
+
+	client, err := kgo.NewClient(clientOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kafka client: %w", err)
+	}
+
+	s := &Store{
+		log:                 zap.L().Named("kafka.store").With(zap.String("topic", conf.Topic)),
+		conf:                conf,
+		client:              client,
+		fsys:                afero.NewMemMapFs(),
+		SubscriptionManager: storage.NewSubscriptionManager(ctx),
+	}
+
+	if err := s.init(ctx); err != nil {
+		s.log.Error("Failed to initialize Kafka store", zap.Error(err))
+		client.Close()
+		return nil, err
+	}
+
+	go s.consume(ctx)
+
+	return s, nil
+}
+
+// init consumes the records that are already in the topic and builds the index from them.
+func (s *Store) init(ctx context.Context) error {
+	syncCtx, cancel := context.WithTimeout(ctx, s.conf.InitialSyncTimeout)
+	defer cancel()
+
+	if err := s.syncExisting(syncCtx); err != nil {
+		return err
+	}
+
+	idx, err := index.Build(ctx, afero.NewIOFS(s.fsys))
+	if err != nil {
+		return err
+	}
+
+	s.idx = idx
+	return nil
+}
+
+func (s *Store) Driver() string {
+	return DriverName
+}
+
+func (s *Store) WatchesForChanges() bool {
+	return true
+}
+
+func (s *Store) GetFirstMatch(_ context.Context, candidates []namer.ModuleID) (*policy.CompilationUnit, error) {
+	return s.idx.GetFirstMatch(candidates)
+}
+
+func (s *Store) GetCompilationUnits(_ context.Context, ids ...namer.ModuleID) (map[namer.ModuleID]*policy.CompilationUnit, error) {
+	return s.idx.GetCompilationUnits(ids...)
+}
+
+func (s *Store) GetDependents(_ context.Context, ids ...namer.ModuleID) (map[namer.ModuleID][]namer.ModuleID, error) {
+	return s.idx.GetDependents(ids...)
+}
+
+func (s *Store) ListPolicyIDs(ctx context.Context, _ storage.ListPolicyIDsParams) ([]string, error) {
+	return s.idx.ListPolicyIDs(ctx)
+}
+
+func (s *Store) ListSchemaIDs(ctx context.Context) ([]string, error) {
+	return s.idx.ListSchemaIDs(ctx)
+}
+
+func (s *Store) LoadSchema(ctx context.Context, url string) (io.ReadCloser, error) {
+	return s.idx.LoadSchema(ctx, url)
+}
+
+func (s *Store) LoadPolicy(ctx context.Context, file ...string) ([]*policy.Wrapper, error) {
+	return s.idx.LoadPolicy(ctx, file...)
+}
+
+func (s *Store) RepoStats(ctx context.Context) storage.RepoStats {
+	return s.idx.RepoStats(ctx)
+}
+
+func (s *Store) Reload(ctx context.Context) error {
+	evts, err := s.idx.Reload(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to reload the index: %w", err)
+	}
+	s.NotifySubscribers(evts...)
+
+	return nil
+}
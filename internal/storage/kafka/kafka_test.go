@@ -0,0 +1,244 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package kafka_test
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/index"
+	"github.com/cerbos/cerbos/internal/storage/kafka"
+	"github.com/cerbos/cerbos/internal/test"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const (
+	redpandaImage   = "redpandadata/redpanda"
+	redpandaVersion = "v23.1.5"
+
+	topic         = "cerbos.policies"
+	numPartitions = 3
+	watchTimeout  = 10 * time.Second
+)
+
+func TestKafkaStore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	dir := test.PathToDir(t, "store")
+	fsys := os.DirFS(dir)
+
+	brokerDSN := newKafkaBroker(t)
+	// records are assigned to partitions explicitly to control the order in which they are consumed
+	producer, err := kgo.NewClient(kgo.SeedBrokers(brokerDSN), kgo.DefaultProduceTopic(topic), kgo.RecordPartitioner(kgo.ManualPartitioner()))
+	require.NoError(t, err)
+	t.Cleanup(producer.Close)
+
+	// produce sends the record to the first partition, so that records are consumed in the order they are produced.
+	produce := func(t *testing.T, file string, value []byte) {
+		t.Helper()
+		require.NoError(t, producer.ProduceSync(ctx, &kgo.Record{Key: []byte(file), Value: value}).FirstErr())
+	}
+
+	var records []*kgo.Record
+	require.NoError(t, fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || util.FileType(path) == util.FileTypeNotIndexed {
+			return err
+		}
+
+		contents, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+
+		records = append(records, &kgo.Record{Key: []byte(path), Value: contents, Partition: int32(len(records) % numPartitions)})
+		return nil
+	}))
+	require.NoError(t, producer.ProduceSync(ctx, records...).FirstErr())
+
+	store, err := kafka.NewStore(ctx, &kafka.Conf{
+		Topic:              topic,
+		ClientID:           "cerbos",
+		Brokers:            []string{brokerDSN},
+		InitialSyncTimeout: watchTimeout,
+	})
+	require.NoError(t, err)
+
+	t.Run("initial_sync", func(t *testing.T) {
+		want, err := index.Build(ctx, fsys)
+		require.NoError(t, err)
+
+		wantPolicyIDs, err := want.ListPolicyIDs(ctx)
+		require.NoError(t, err)
+
+		havePolicyIDs, err := store.ListPolicyIDs(ctx, storage.ListPolicyIDsParams{})
+		require.NoError(t, err)
+		require.ElementsMatch(t, wantPolicyIDs, havePolicyIDs)
+
+		wantSchemaIDs, err := want.ListSchemaIDs(ctx)
+		require.NoError(t, err)
+
+		haveSchemaIDs, err := store.ListSchemaIDs(ctx)
+		require.NoError(t, err)
+		require.ElementsMatch(t, wantSchemaIDs, haveSchemaIDs)
+	})
+
+	t.Run("events", func(t *testing.T) {
+		const policyFile = "resource_policies/policy_01.yaml"
+		const schemaFile = "_schemas/principal.json"
+
+		policyContents, err := fs.ReadFile(fsys, policyFile)
+		require.NoError(t, err)
+
+		f, err := fsys.Open(policyFile)
+		require.NoError(t, err)
+		p, err := policy.ReadPolicy(f)
+		require.NoError(t, f.Close())
+		require.NoError(t, err)
+		modID := namer.GenModuleID(p)
+
+		schemaContents, err := fs.ReadFile(fsys, schemaFile)
+		require.NoError(t, err)
+
+		sub := &eventCollector{events: make(chan storage.Event, 8)}
+		store.Subscribe(sub)
+		t.Cleanup(func() { store.Unsubscribe(sub) })
+
+		t.Run("delete_policy", func(t *testing.T) {
+			produce(t, policyFile, nil)
+			require.True(t, sub.waitFor(storage.NewPolicyEvent(storage.EventDeleteOrDisablePolicy, modID), watchTimeout))
+
+			cu, err := store.GetFirstMatch(ctx, []namer.ModuleID{modID})
+			require.NoError(t, err)
+			require.Nil(t, cu)
+		})
+
+		t.Run("add_policy", func(t *testing.T) {
+			produce(t, policyFile, policyContents)
+			require.True(t, sub.waitFor(storage.NewPolicyEvent(storage.EventAddOrUpdatePolicy, modID), watchTimeout))
+
+			cu, err := store.GetFirstMatch(ctx, []namer.ModuleID{modID})
+			require.NoError(t, err)
+			require.NotNil(t, cu)
+		})
+
+		t.Run("invalid_policy", func(t *testing.T) {
+			produce(t, policyFile, []byte("not a policy"))
+			produce(t, schemaFile, schemaContents)
+			require.True(t, sub.waitFor(storage.NewSchemaEvent(storage.EventAddOrUpdateSchema, "principal.json"), watchTimeout))
+
+			cu, err := store.GetFirstMatch(ctx, []namer.ModuleID{modID})
+			require.NoError(t, err)
+			require.NotNil(t, cu, "Invalid record should not replace the policy")
+		})
+
+		t.Run("delete_schema", func(t *testing.T) {
+			produce(t, schemaFile, nil)
+			require.True(t, sub.waitFor(storage.NewSchemaEvent(storage.EventDeleteSchema, "principal.json"), watchTimeout))
+		})
+	})
+}
+
+func newKafkaBroker(t *testing.T) string {
+	t.Helper()
+
+	hostPort, err := util.GetFreePort()
+	require.NoError(t, err, "Unable to get free port")
+
+	pool, err := dockertest.NewPool("")
+	require.NoError(t, err, "Failed to connect to Docker")
+
+	resource, err := pool.RunWithOptions(&dockertest.RunOptions{
+		Repository: redpandaImage,
+		Tag:        redpandaVersion,
+		Cmd: []string{
+			"redpanda",
+			"start",
+			"--mode", "dev-container",
+			// kafka admin client will retrieve the advertised address from the broker
+			// so we need it to use the same port that is exposed on the container
+			"--advertise-kafka-addr", fmt.Sprintf("localhost:%d", hostPort),
+		},
+		ExposedPorts: []string{
+			"9092/tcp",
+		},
+		PortBindings: map[docker.Port][]docker.PortBinding{
+			"9092/tcp": {{HostIP: "localhost", HostPort: strconv.Itoa(hostPort)}},
+		},
+	}, func(config *docker.HostConfig) {
+		config.AutoRemove = true
+	})
+	require.NoError(t, err, "Failed to start container")
+
+	t.Cleanup(func() {
+		_ = pool.Purge(resource)
+	})
+
+	brokerDSN := fmt.Sprintf("localhost:%d", hostPort)
+	client, err := kgo.NewClient(kgo.SeedBrokers(brokerDSN))
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	require.NoError(t, pool.Retry(func() error {
+		return client.Ping(context.Background())
+	}), "Failed to connect to Kafka")
+
+	// use several partitions to check that the initial sync waits for all of them
+	compact := "compact"
+	_, err = kadm.NewClient(client).CreateTopic(context.Background(), numPartitions, 1, map[string]*string{"cleanup.policy": &compact}, topic)
+	require.NoError(t, err, "Failed to create Kafka topic")
+
+	return brokerDSN
+}
+
+type eventCollector struct {
+	events chan storage.Event
+}
+
+func (ec *eventCollector) SubscriberID() string {
+	return "kafka_test"
+}
+
+func (ec *eventCollector) OnStorageEvent(events ...storage.Event) {
+	for _, evt := range events {
+		select {
+		case ec.events <- evt:
+		default:
+		}
+	}
+}
+
+// waitFor returns true if the event is received before the timeout. Other events received in the meantime are discarded.
+func (ec *eventCollector) waitFor(want storage.Event, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case evt := <-ec.events:
+			if evt.Kind == want.Kind && evt.PolicyID == want.PolicyID && evt.SchemaFile == want.SchemaFile {
+				return true
+			}
+		case <-timer.C:
+			return false
+		}
+	}
+}
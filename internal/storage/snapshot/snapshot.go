@@ -0,0 +1,300 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package snapshot writes the policies and schemas held by a store to an archive and restores them from it.
+//
+// Snapshots are gzipped tarballs laid out like a policy repository, so they can also be loaded by the disk and blob drivers.
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+	"time"
+
+	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const (
+	// ManifestFile is the name of the file describing the contents of a snapshot.
+	// It's hidden so that the storage drivers ignore it when the snapshot is loaded as a policy archive.
+	ManifestFile = ".cerbos-snapshot.json"
+
+	keyPrefix     = "cerbos-snapshot-"
+	keyTimeLayout = "20060102T150405Z"
+	loadBatchSize = 50
+)
+
+var (
+	ErrInvalidKey  = errors.New("snapshot key must have a .tar.gz or .tgz extension")
+	ErrNotFound    = errors.New("snapshot not found")
+	ErrNotSnapshot = errors.New("archive is not a store snapshot")
+)
+
+// Bucket is the location that snapshots are written to and restored from.
+type Bucket interface {
+	// NewWriter returns a writer for the object with the given key.
+	// The object is only created when the writer is closed, and is discarded if the context is cancelled before that.
+	NewWriter(ctx context.Context, key string) (io.WriteCloser, error)
+	// NewReader returns a reader for the object with the given key. It returns ErrNotFound if the object doesn't exist.
+	NewReader(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// Manifest describes the contents of a snapshot.
+type Manifest struct {
+	CreatedAt time.Time `json:"createdAt"`
+	// Version is the version of Cerbos that created the snapshot.
+	Version string `json:"version"`
+	// Driver is the storage driver of the store the snapshot was taken from.
+	Driver string `json:"driver"`
+	// Policies are the paths of the policy files in the snapshot.
+	Policies []string `json:"policies"`
+	// Schemas are the IDs of the schemas in the snapshot.
+	Schemas []string `json:"schemas"`
+}
+
+// DefaultKey returns the key used for a snapshot taken at the given time when the caller doesn't provide one.
+func DefaultKey(t time.Time) string {
+	return keyPrefix + t.UTC().Format(keyTimeLayout) + ".tar.gz"
+}
+
+// Save writes a snapshot of the store to the object with the given key.
+func Save(ctx context.Context, bucket Bucket, key string, store storage.SourceStore) (*Manifest, error) {
+	if !util.IsGzip(key) {
+		return nil, ErrInvalidKey
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w, err := bucket.NewWriter(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot %s: %w", key, err)
+	}
+
+	manifest, err := Write(ctx, store, w)
+	if err != nil {
+		// cancel before closing to discard the partially written object
+		cancel()
+		_ = w.Close()
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write snapshot %s: %w", key, err)
+	}
+
+	return manifest, nil
+}
+
+// Restore adds or updates the policies and schemas from the snapshot with the given key in the store.
+// Policies and schemas that exist in the store but not in the snapshot are left as they are.
+func Restore(ctx context.Context, bucket Bucket, key string, store storage.MutableStore) (*Manifest, error) {
+	if !util.IsGzip(key) {
+		return nil, ErrInvalidKey
+	}
+
+	r, err := bucket.NewReader(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return Read(ctx, r, store)
+}
+
+// Write writes a snapshot of the store to w.
+func Write(ctx context.Context, store storage.SourceStore, w io.Writer) (*Manifest, error) {
+	manifest := &Manifest{
+		CreatedAt: time.Now().UTC(),
+		Version:   util.Version,
+		Driver:    store.Driver(),
+	}
+
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+
+	policyIDs, err := store.ListPolicyIDs(ctx, storage.ListPolicyIDsParams{IncludeDisabled: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+
+	for i := 0; i < len(policyIDs); i += loadBatchSize {
+		end := i + loadBatchSize
+		if end > len(policyIDs) {
+			end = len(policyIDs)
+		}
+
+		policies, err := store.LoadPolicy(ctx, policyIDs[i:end]...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load policies: %w", err)
+		}
+
+		for _, p := range policies {
+			name := policyFileName(p)
+
+			var buf bytes.Buffer
+			if err := policy.WritePolicy(&buf, p.Policy); err != nil {
+				return nil, fmt.Errorf("failed to encode policy %s: %w", name, err)
+			}
+
+			if err := writeFile(tw, name, buf.Bytes()); err != nil {
+				return nil, err
+			}
+			manifest.Policies = append(manifest.Policies, name)
+		}
+	}
+
+	schemaIDs, err := store.ListSchemaIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schemas: %w", err)
+	}
+
+	for _, id := range schemaIDs {
+		def, err := loadSchema(ctx, store, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := writeFile(tw, path.Join(util.SchemasDirectory, id), def); err != nil {
+			return nil, err
+		}
+		manifest.Schemas = append(manifest.Schemas, id)
+	}
+
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	if err := writeFile(tw, ManifestFile, manifestBytes); err != nil {
+		return nil, err
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close tar writer: %w", err)
+	}
+
+	if err := gzw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close gzip writer: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// Read adds or updates the policies and schemas from the snapshot read from r in the store.
+// The schemas are added first so that the policies referencing them pass validation.
+func Read(ctx context.Context, r io.Reader, store storage.MutableStore) (*Manifest, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	fsys, err := util.OpenArchiveFS("snapshot.tar.gz", data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot: %w", err)
+	}
+
+	manifestBytes, err := fs.ReadFile(fsys, ManifestFile)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotSnapshot
+		}
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	manifest := new(Manifest)
+	if err := json.Unmarshal(manifestBytes, manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+
+	schemas := make([]*schemav1.Schema, len(manifest.Schemas))
+	for i, id := range manifest.Schemas {
+		def, err := fs.ReadFile(fsys, path.Join(util.SchemasDirectory, id))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read schema %s: %w", id, err)
+		}
+		schemas[i] = &schemav1.Schema{Id: id, Definition: def}
+	}
+
+	policies := make([]policy.Wrapper, len(manifest.Policies))
+	for i, name := range manifest.Policies {
+		p, err := policy.ReadPolicyFromFile(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read policy %s: %w", name, err)
+		}
+		policies[i] = policy.Wrap(p)
+	}
+
+	if len(schemas) > 0 {
+		if err := store.AddOrUpdateSchema(ctx, schemas...); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(policies) > 0 {
+		if err := store.AddOrUpdate(ctx, policies...); err != nil {
+			return nil, err
+		}
+	}
+
+	return manifest, nil
+}
+
+// policyFileName returns the path of the policy in the snapshot, which is its store identifier if that's a usable file name.
+func policyFileName(p *policy.Wrapper) string {
+	name := p.GetMetadata().GetStoreIdentifier()
+	if name == "" {
+		name = namer.PolicyKey(p.Policy)
+	}
+
+	if !util.IsSupportedFileType(name) {
+		return strings.ReplaceAll(name, "/", "_") + ".yaml"
+	}
+
+	return name
+}
+
+func loadSchema(ctx context.Context, store storage.Store, id string) ([]byte, error) {
+	r, err := store.LoadSchema(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load schema %s: %w", id, err)
+	}
+	defer r.Close()
+
+	def, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema %s: %w", id, err)
+	}
+
+	return def, nil
+}
+
+func writeFile(tw *tar.Writer, name string, data []byte) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     name,
+		Size:     int64(len(data)),
+		Mode:     0o644, //nolint:gomnd
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return fmt.Errorf("failed to write tar header for %s: %w", name, err)
+	}
+
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	return nil
+}
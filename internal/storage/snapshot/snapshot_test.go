@@ -0,0 +1,142 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/db/sqlite3"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/storage/snapshot"
+	"github.com/cerbos/cerbos/internal/test"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+func TestSnapshot(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	source, err := disk.NewStore(ctx, &disk.Conf{Directory: test.PathToDir(t, "store")})
+	require.NoError(t, err)
+
+	wantPolicies, err := source.ListPolicyIDs(ctx, storage.ListPolicyIDsParams{IncludeDisabled: true})
+	require.NoError(t, err)
+
+	wantSchemas, err := source.ListSchemaIDs(ctx)
+	require.NoError(t, err)
+
+	bucket := newMemBucket()
+	key := snapshot.DefaultKey(time.Now())
+
+	t.Run("save", func(t *testing.T) {
+		manifest, err := snapshot.Save(ctx, bucket, key, source)
+		require.NoError(t, err)
+		require.Len(t, manifest.Policies, len(wantPolicies))
+		require.ElementsMatch(t, wantSchemas, manifest.Schemas)
+		require.Equal(t, source.Driver(), manifest.Driver)
+
+		fsys, err := util.OpenArchiveFS(key, bucket.objects[key])
+		require.NoError(t, err)
+
+		_, err = fs.Stat(fsys, snapshot.ManifestFile)
+		require.NoError(t, err)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		target, err := sqlite3.NewStore(ctx, &sqlite3.Conf{DSN: "file::memory:?_fk=true"})
+		require.NoError(t, err)
+
+		manifest, err := snapshot.Restore(ctx, bucket, key, target)
+		require.NoError(t, err)
+		require.Len(t, manifest.Policies, len(wantPolicies))
+
+		havePolicies, err := target.ListPolicyIDs(ctx, storage.ListPolicyIDsParams{IncludeDisabled: true})
+		require.NoError(t, err)
+		require.Len(t, havePolicies, len(wantPolicies))
+
+		haveSchemas, err := target.ListSchemaIDs(ctx)
+		require.NoError(t, err)
+		require.ElementsMatch(t, wantSchemas, haveSchemas)
+	})
+
+	t.Run("invalid_key", func(t *testing.T) {
+		_, err := snapshot.Save(ctx, bucket, "snapshot.zip", source)
+		require.ErrorIs(t, err, snapshot.ErrInvalidKey)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		target, err := sqlite3.NewStore(ctx, &sqlite3.Conf{DSN: "file::memory:?_fk=true"})
+		require.NoError(t, err)
+
+		_, err = snapshot.Restore(ctx, bucket, "missing.tar.gz", target)
+		require.ErrorIs(t, err, snapshot.ErrNotFound)
+	})
+
+	t.Run("discard_on_failure", func(t *testing.T) {
+		failKey := "failed.tar.gz"
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := snapshot.Save(ctx, bucket, failKey, source)
+		require.Error(t, err)
+		require.NotContains(t, bucket.objects, failKey)
+	})
+}
+
+type memBucket struct {
+	objects map[string][]byte
+	mu      sync.Mutex
+}
+
+func newMemBucket() *memBucket {
+	return &memBucket{objects: make(map[string][]byte)}
+}
+
+func (b *memBucket) NewWriter(ctx context.Context, key string) (io.WriteCloser, error) {
+	return &memWriter{ctx: ctx, bucket: b, key: key}, nil
+}
+
+func (b *memBucket) NewReader(_ context.Context, key string) (io.ReadCloser, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	data, ok := b.objects[key]
+	if !ok {
+		return nil, snapshot.ErrNotFound
+	}
+
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+type memWriter struct {
+	ctx    context.Context
+	bucket *memBucket
+	key    string
+	buf    bytes.Buffer
+}
+
+func (w *memWriter) Write(p []byte) (int, error) {
+	return w.buf.Write(p)
+}
+
+func (w *memWriter) Close() error {
+	if err := w.ctx.Err(); err != nil {
+		return err
+	}
+
+	w.bucket.mu.Lock()
+	defer w.bucket.mu.Unlock()
+
+	w.bucket.objects[w.key] = w.buf.Bytes()
+	return nil
+}
@@ -5,10 +5,13 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
 	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
 	"github.com/cerbos/cerbos/internal/config"
@@ -21,6 +24,9 @@ var (
 	drivers   = map[string]Constructor{}
 )
 
+// ErrRevisionNotFound is returned when a store does not have the requested point in its history.
+var ErrRevisionNotFound = errors.New("revision not found")
+
 // InvalidPolicyError is a custom error to signal that a policy is invalid.
 type InvalidPolicyError struct {
 	Err     error
@@ -165,6 +171,130 @@ type Reloadable interface {
 	Reload(context.Context) error
 }
 
+// Syncer stores pull the changes made to their remote source on demand, in addition to polling for them.
+type Syncer interface {
+	// Sync pulls the changes made to the remote source since the last sync and notifies the subscribers about them.
+	Sync(context.Context) error
+}
+
+// Revisioned stores report the revision of the policies they currently serve.
+type Revisioned interface {
+	// Revision returns an identifier of the current contents of the store, such as a bundle identifier or a commit hash.
+	Revision() string
+}
+
+// Historical stores keep a history of their contents.
+type Historical interface {
+	// AsOf returns a read-only store containing the policies that were in the store at the given point in its history,
+	// along with the revision that was current at that point.
+	AsOf(context.Context, HistoryPoint) (SourceStore, string, error)
+}
+
+// HistoryPoint identifies a point in the history of a store either by revision or by time.
+// If Revision is set, Time is ignored.
+type HistoryPoint struct {
+	Time     time.Time
+	Revision string
+}
+
+// Versioned stores keep every version of each policy and can restore earlier versions.
+type Versioned interface {
+	// ListPolicyVersions returns the versions of the policy with the given key, oldest first.
+	ListPolicyVersions(ctx context.Context, policyKey string) ([]PolicyVersion, error)
+	// GetPolicyVersion returns the policy with the given key as it was at the given version.
+	GetPolicyVersion(ctx context.Context, policyKey string, version uint64) (*policyv1.Policy, error)
+	// RollbackPolicy restores the policy with the given key to the given version and returns the version created by the change.
+	RollbackPolicy(ctx context.Context, policyKey string, version uint64) (uint64, error)
+}
+
+// Restorable stores keep deleted policies for a retention period, during which they can be restored.
+type Restorable interface {
+	// DeletePolicy deletes the policies with the given keys and returns the number of policies that were deleted.
+	DeletePolicy(ctx context.Context, policyKey ...string) (uint32, error)
+	// RestorePolicy restores the policies with the given keys that were deleted within the retention period
+	// and returns the number of policies that were restored.
+	RestorePolicy(ctx context.Context, policyKey ...string) (uint32, error)
+}
+
+// Audited stores keep a log of the changes made to them through the Admin API and who made them.
+type Audited interface {
+	// ListStoreEvents returns the changes that match the given parameters, newest first.
+	ListStoreEvents(ctx context.Context, params ListStoreEventsParams) ([]StoreEvent, error)
+}
+
+type ListStoreEventsParams struct {
+	// Target restricts the events to the changes made to the policy or schema with the given ID.
+	Target string
+	// Limit is the maximum number of events to return.
+	Limit uint
+}
+
+// StoreEventKind identifies the operation recorded by a StoreEvent.
+type StoreEventKind int
+
+const (
+	StoreEventAddOrUpdatePolicy StoreEventKind = iota
+	StoreEventDisablePolicy
+	StoreEventEnablePolicy
+	StoreEventDeletePolicy
+	StoreEventRestorePolicy
+	StoreEventRollbackPolicy
+	StoreEventAddOrUpdateSchema
+	StoreEventDeleteSchema
+)
+
+// StoreEvent is a change made to a policy or schema in an Audited store.
+type StoreEvent struct {
+	Timestamp time.Time
+	Actor     Actor
+	// Target is the key of the policy or the ID of the schema that was changed.
+	Target string
+	ID     uint64
+	// Revision is the policy revision created by the change. It is zero for schema changes.
+	Revision uint64
+	Kind     StoreEventKind
+}
+
+// Actor identifies who made a change to a store.
+type Actor struct {
+	// User is the name of the admin user.
+	User string
+	// ClientCert is the subject of the verified TLS client certificate presented by the caller, if any.
+	ClientCert string
+}
+
+type actorCtxKeyType struct{}
+
+var actorCtxKey = actorCtxKeyType{}
+
+// NewContextWithActor returns a context that makes Audited stores attribute the changes made with it to the given actor.
+func NewContextWithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorCtxKey, actor)
+}
+
+// ActorFromContext returns the actor making the changes, or the zero value if the context does not have one.
+func ActorFromContext(ctx context.Context) Actor {
+	actor, _ := ctx.Value(actorCtxKey).(Actor)
+	return actor
+}
+
+// PolicyChange identifies the kind of change that produced a policy version.
+type PolicyChange int
+
+const (
+	PolicyCreated PolicyChange = iota
+	PolicyUpdated
+	PolicyDeleted
+)
+
+// PolicyVersion describes a version of a policy kept by a Versioned store.
+type PolicyVersion struct {
+	Timestamp time.Time
+	Version   uint64
+	Change    PolicyChange
+	Disabled  bool
+}
+
 // Instrumented stores expose repository stats.
 type Instrumented interface {
 	RepoStats(context.Context) RepoStats
@@ -178,6 +308,12 @@ type Subscribable interface {
 	Unsubscribe(Subscriber)
 }
 
+// Watchable is implemented by stores that only publish change notifications if they are configured to do so.
+type Watchable interface {
+	// WatchesForChanges returns true if the store notifies its subscribers when policies or schemas change.
+	WatchesForChanges() bool
+}
+
 // Subscriber is the interface implemented by storage subscribers.
 type Subscriber interface {
 	SubscriberID() string
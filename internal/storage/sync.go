@@ -0,0 +1,225 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+)
+
+const (
+	defaultBackoffMultiplier = 2
+	defaultBackoffJitter     = 0.5
+	defaultDegradedAfter     = 3
+)
+
+// SyncConf configures how a store that syncs with a remote source retries after failures.
+type SyncConf struct {
+	// InitialInterval is the time to wait before the first retry after a failure. Defaults to the poll interval for drivers that poll for changes and to 500ms for drivers that watch for changes.
+	InitialInterval time.Duration `yaml:"initialInterval" conf:",example=10s"`
+	// MaxInterval is the maximum time to wait between retries. Defaults to 5m for drivers that poll for changes and to 30s for drivers that watch for changes.
+	MaxInterval time.Duration `yaml:"maxInterval" conf:",example=5m"`
+	// Multiplier is the factor by which the interval grows after each consecutive failure. Defaults to 2.
+	Multiplier float64 `yaml:"multiplier" conf:",example=2"`
+	// Jitter is the fraction of the interval by which each retry is randomly brought forward or delayed, to avoid many instances retrying at the same time. Defaults to 0.5.
+	Jitter float64 `yaml:"jitter" conf:",example=0.5"`
+	// DegradedAfter is the number of consecutive failures after which the store is reported as degraded by the health endpoint. Defaults to 3.
+	DegradedAfter uint `yaml:"degradedAfter" conf:",example=3"`
+}
+
+func (c *SyncConf) Validate() (errs error) {
+	if c == nil {
+		return nil
+	}
+
+	if c.InitialInterval < 0 {
+		errs = multierr.Append(errs, errors.New("sync.initialInterval must not be negative"))
+	}
+
+	if c.MaxInterval < 0 {
+		errs = multierr.Append(errs, errors.New("sync.maxInterval must not be negative"))
+	}
+
+	if c.Multiplier != 0 && c.Multiplier < 1 {
+		errs = multierr.Append(errs, errors.New("sync.multiplier must be at least 1"))
+	}
+
+	if c.Jitter < 0 || c.Jitter > 1 {
+		errs = multierr.Append(errs, errors.New("sync.jitter must be between 0 and 1"))
+	}
+
+	return errs
+}
+
+// NewBackOff creates the backoff policy for retrying after failures, using the given intervals if they are not configured.
+// The backoff never gives up.
+func (c *SyncConf) NewBackOff(defaultInitialInterval, defaultMaxInterval time.Duration) backoff.BackOff {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = defaultInitialInterval
+	b.MaxInterval = defaultMaxInterval
+	b.Multiplier = defaultBackoffMultiplier
+	b.RandomizationFactor = defaultBackoffJitter
+	b.MaxElapsedTime = 0
+
+	if c != nil {
+		if c.InitialInterval > 0 {
+			b.InitialInterval = c.InitialInterval
+		}
+
+		if c.MaxInterval > 0 {
+			b.MaxInterval = c.MaxInterval
+		}
+
+		if c.Multiplier > 0 {
+			b.Multiplier = c.Multiplier
+		}
+
+		if c.Jitter > 0 {
+			b.RandomizationFactor = c.Jitter
+		}
+	}
+
+	if b.MaxInterval < b.InitialInterval {
+		b.MaxInterval = b.InitialInterval
+	}
+
+	b.Reset()
+	return b
+}
+
+func (c *SyncConf) degradedAfter() uint {
+	if c == nil || c.DegradedAfter == 0 {
+		return defaultDegradedAfter
+	}
+
+	return c.DegradedAfter
+}
+
+// Syncable stores keep a local copy of the policies in a remote source and report how up to date it is.
+type Syncable interface {
+	SyncStatus() *SyncStatus
+}
+
+// SyncStatus tracks how up to date a store is with its remote source. It is reported through metrics and the
+// health endpoint.
+type SyncStatus struct {
+	lastSync      time.Time
+	onChange      func(degraded bool)
+	failures      uint
+	degradedAfter uint
+	mu            sync.RWMutex
+	live          bool
+}
+
+// NewSyncStatus creates the sync status of the store using the given driver and registers its metrics.
+func NewSyncStatus(driver string, conf *SyncConf) *SyncStatus {
+	s := &SyncStatus{degradedAfter: conf.degradedAfter()}
+
+	metrics.RegisterStoreSyncGauges(driver,
+		func() int64 { return s.LastSync().Unix() },
+		func() float64 { return s.Lag().Seconds() },
+		func() int64 { return int64(s.ConsecutiveFailures()) },
+	)
+
+	return s
+}
+
+// Succeeded records a successful sync with the remote source.
+func (s *SyncStatus) Succeeded() {
+	s.update(func() {
+		s.lastSync = time.Now()
+		s.failures = 0
+	})
+}
+
+// Live records that the store is watching the remote source for changes, and is therefore up to date until Failed is called.
+func (s *SyncStatus) Live() {
+	s.update(func() {
+		s.lastSync = time.Now()
+		s.failures = 0
+		s.live = true
+	})
+}
+
+// Failed records a failure to sync with the remote source.
+func (s *SyncStatus) Failed() {
+	s.update(func() {
+		if s.live {
+			s.lastSync = time.Now()
+			s.live = false
+		}
+		s.failures++
+	})
+}
+
+func (s *SyncStatus) update(fn func()) {
+	s.mu.Lock()
+	wasDegraded := s.degraded()
+	fn()
+	isDegraded := s.degraded()
+	onChange := s.onChange
+	s.mu.Unlock()
+
+	if onChange != nil && wasDegraded != isDegraded {
+		onChange(isDegraded)
+	}
+}
+
+// OnChange registers a function to call whenever the store becomes degraded or recovers.
+func (s *SyncStatus) OnChange(fn func(degraded bool)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.onChange = fn
+}
+
+// LastSync returns the time at which the store was last known to be in sync with the remote source.
+func (s *SyncStatus) LastSync() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.live {
+		return time.Now()
+	}
+
+	return s.lastSync
+}
+
+// Lag returns the time elapsed since the store was last known to be in sync with the remote source.
+func (s *SyncStatus) Lag() time.Duration {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.live || s.lastSync.IsZero() {
+		return 0
+	}
+
+	return time.Since(s.lastSync)
+}
+
+// ConsecutiveFailures returns the number of failures to sync since the last success.
+func (s *SyncStatus) ConsecutiveFailures() uint {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.failures
+}
+
+// Degraded returns true if the store failed to sync too many times in a row and might be serving stale policies.
+func (s *SyncStatus) Degraded() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.degraded()
+}
+
+func (s *SyncStatus) degraded() bool {
+	return s.failures >= s.degradedAfter
+}
@@ -0,0 +1,82 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+func TestSyncStatus(t *testing.T) {
+	var changes []bool
+	status := storage.NewSyncStatus("test", &storage.SyncConf{DegradedAfter: 2})
+	status.OnChange(func(degraded bool) { changes = append(changes, degraded) })
+
+	status.Succeeded()
+	require.False(t, status.Degraded())
+	require.Zero(t, status.ConsecutiveFailures())
+	lastSync := status.LastSync()
+
+	status.Failed()
+	require.False(t, status.Degraded())
+	require.Equal(t, uint(1), status.ConsecutiveFailures())
+	require.Equal(t, lastSync, status.LastSync())
+	require.Positive(t, status.Lag())
+
+	status.Failed()
+	require.True(t, status.Degraded())
+	require.Equal(t, uint(2), status.ConsecutiveFailures())
+
+	status.Failed()
+	require.True(t, status.Degraded())
+
+	status.Live()
+	require.False(t, status.Degraded())
+	require.Zero(t, status.Lag())
+
+	status.Failed()
+	require.Greater(t, status.LastSync(), lastSync, "Last sync should be the time at which the watch failed")
+
+	require.Equal(t, []bool{true, false}, changes)
+}
+
+func TestSyncConf(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		var conf *storage.SyncConf
+		require.NoError(t, conf.Validate())
+
+		b, ok := conf.NewBackOff(time.Minute, 5*time.Minute).(*backoff.ExponentialBackOff)
+		require.True(t, ok)
+		require.Equal(t, time.Minute, b.InitialInterval)
+		require.Equal(t, 5*time.Minute, b.MaxInterval)
+		require.Zero(t, b.MaxElapsedTime)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		conf := &storage.SyncConf{InitialInterval: 10 * time.Second, MaxInterval: time.Second, Multiplier: 3, Jitter: 0.1}
+		require.NoError(t, conf.Validate())
+
+		b, ok := conf.NewBackOff(time.Minute, 5*time.Minute).(*backoff.ExponentialBackOff)
+		require.True(t, ok)
+		require.Equal(t, 10*time.Second, b.InitialInterval)
+		require.Equal(t, 10*time.Second, b.MaxInterval, "Max interval should not be less than the initial interval")
+		require.Equal(t, 3.0, b.Multiplier)
+		require.Equal(t, 0.1, b.RandomizationFactor)
+
+		for i := 0; i < 10; i++ {
+			require.LessOrEqual(t, b.NextBackOff(), 11*time.Second)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		require.Error(t, (&storage.SyncConf{InitialInterval: -time.Second}).Validate())
+		require.Error(t, (&storage.SyncConf{Multiplier: 0.5}).Validate())
+		require.Error(t, (&storage.SyncConf{Jitter: 1.5}).Validate())
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,113 +19,152 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
 	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
 	responsev1 "github.com/cerbos/cerbos/api/genpb/cerbos/response/v1"
 	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
 	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
 	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/policy"
 	"github.com/cerbos/cerbos/internal/storage"
 	"github.com/cerbos/cerbos/internal/storage/db"
+	"github.com/cerbos/cerbos/internal/storage/snapshot"
+	"github.com/cerbos/cerbos/internal/util"
 )
 
 var _ svcv1.CerbosAdminServiceServer = (*CerbosAdminService)(nil)
 
+const defaultStoreEventsLimit = 100
+
 var (
-	errAuthRequired = status.Error(codes.Unauthenticated, "authentication required")
+	errAuthRequired = NewError(codes.Unauthenticated, ErrCodeAuthenticationFailed, "authentication required")
+	errTenantScoped = NewError(codes.InvalidArgument, ErrCodeInvalidRequest, "the Admin API only manages the default store and can't be used for a tenant")
 	authSep         = []byte(":")
+
+	policyContentHashIgnoreFields = map[string]struct{}{"cerbos.policy.v1.Policy.metadata": {}}
 )
 
 // CerbosAdminService implements the Cerbos administration service.
 type CerbosAdminService struct {
-	store    storage.Store
-	auditLog audit.Log
+	store        storage.Store
+	policyLoader engine.PolicyLoader
+	cerbosSvc    *CerbosService
+	auditLog     audit.Log
+	snapshots    snapshot.Bucket
 	*svcv1.UnimplementedCerbosAdminServiceServer
-	adminUser       string
-	adminPasswdHash []byte
+	historyEngines *historyEngines
+	auth           AdminAuth
+}
+
+// AdminAuth defines how the Admin API requests are authenticated.
+type AdminAuth struct {
+	// OIDC verifies bearer tokens. Bearer tokens are rejected if it's nil.
+	OIDC *OIDCVerifier
+	// Username and PasswordHash are the credentials for basic authentication.
+	Username     string
+	PasswordHash []byte
+	// DisableBasicAuth rejects basic authentication so that only bearer tokens are accepted.
+	DisableBasicAuth bool
 }
 
-func NewCerbosAdminService(store storage.Store, auditLog audit.Log, adminUser string, adminPasswdHash []byte) *CerbosAdminService {
+func NewCerbosAdminService(store storage.Store, policyLoader engine.PolicyLoader, cerbosSvc *CerbosService, auditLog audit.Log, snapshots snapshot.Bucket, auth AdminAuth) *CerbosAdminService {
 	svc := &CerbosAdminService{
 		auditLog:                              auditLog,
-		adminUser:                             adminUser,
-		adminPasswdHash:                       adminPasswdHash,
+		snapshots:                             snapshots,
+		auth:                                  auth,
 		UnimplementedCerbosAdminServiceServer: &svcv1.UnimplementedCerbosAdminServiceServer{},
 		store:                                 store,
+		policyLoader:                          policyLoader,
+		cerbosSvc:                             cerbosSvc,
+		historyEngines:                        newHistoryEngines(),
 	}
 
 	return svc
 }
 
 func (cas *CerbosAdminService) AddOrUpdatePolicy(ctx context.Context, req *requestv1.AddOrUpdatePolicyRequest) (*responsev1.AddOrUpdatePolicyResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	ms, ok := cas.store.(storage.MutableStore)
 	if !ok {
-		return nil, status.Error(codes.Unimplemented, "Configured store is not mutable")
+		return nil, NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Configured store is not mutable")
 	}
 
 	policies := make([]policy.Wrapper, len(req.Policies))
+	policyKeys := make([]string, len(req.Policies))
 	for i, p := range req.Policies {
 		policies[i] = policy.Wrap(p)
+		policyKeys[i] = namer.PolicyKey(p)
 	}
 
+	defer cas.recordPolicyChanges(ctx, policyKeys...)()
+
 	log := ctxzap.Extract(ctx)
-	if err := ms.AddOrUpdate(ctx, policies...); err != nil {
+	if err := ms.AddOrUpdate(cas.withActor(ctx), policies...); err != nil {
 		log.Error("Failed to add/update policies", zap.Error(err))
 		invalidPolicyErr := new(storage.InvalidPolicyError)
 		if errors.As(err, invalidPolicyErr) {
-			return nil, status.Errorf(codes.InvalidArgument, "Invalid policy: %v", invalidPolicyErr.Message)
+			return nil, NewErrorf(codes.InvalidArgument, ErrCodeInvalidPolicy, "Invalid policy: %v", invalidPolicyErr.Message)
 		}
-		return nil, status.Error(codes.Internal, "Failed to add/update policies")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "Failed to add/update policies")
 	}
 
 	return &responsev1.AddOrUpdatePolicyResponse{Success: &emptypb.Empty{}}, nil
 }
 
 func (cas *CerbosAdminService) AddOrUpdateSchema(ctx context.Context, req *requestv1.AddOrUpdateSchemaRequest) (*responsev1.AddOrUpdateSchemaResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	ms, ok := cas.store.(storage.MutableStore)
 	if !ok {
-		return nil, status.Error(codes.Unimplemented, "Configured store is not mutable")
+		return nil, NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Configured store is not mutable")
 	}
 
-	if err := ms.AddOrUpdateSchema(ctx, req.Schemas...); err != nil {
+	cas.recordAdminUser(ctx)
+	if err := ms.AddOrUpdateSchema(cas.withActor(ctx), req.Schemas...); err != nil {
 		ctxzap.Extract(ctx).Error("Failed to add/update the schema(s)", zap.Error(err))
 		var ise storage.InvalidSchemaError
 		if ok := errors.As(err, &ise); ok {
-			return nil, status.Errorf(codes.InvalidArgument, "Invalid schema in request: %s", ise.Message)
+			return nil, NewErrorf(codes.InvalidArgument, ErrCodeSchemaValidationError, "Invalid schema in request: %s", ise.Message)
 		}
 
-		return nil, status.Errorf(codes.Internal, "Failed to add/update the schema(s)")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "Failed to add/update the schema(s)")
 	}
 
 	return &responsev1.AddOrUpdateSchemaResponse{}, nil
 }
 
 func (cas *CerbosAdminService) ListPolicies(ctx context.Context, req *requestv1.ListPoliciesRequest) (*responsev1.ListPoliciesResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	if cas.store == nil {
-		return nil, status.Error(codes.NotFound, "store is not configured")
+		return nil, NewError(codes.NotFound, ErrCodeStoreUnavailable, "store is not configured")
 	}
 
 	// We've historically supported ListPolicies on non-mutable stores, but later introduced filters are not scalable.
 	// Therefore, if any of the filters in question are passed and the store is not mutable, we reject the request.
 	if _, ok := cas.store.(storage.MutableStore); !ok && (req.NameRegexp != "" || req.ScopeRegexp != "" || req.VersionRegexp != "") {
-		return nil, status.Error(codes.Unimplemented, "Store does not support regexp filters")
+		return nil, NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Store does not support regexp filters")
 	}
 
 	filterParams := storage.ListPolicyIDsParams{
@@ -137,7 +177,7 @@ func (cas *CerbosAdminService) ListPolicies(ctx context.Context, req *requestv1.
 	policyIds, err := cas.store.ListPolicyIDs(context.Background(), filterParams)
 	if err != nil {
 		ctxzap.Extract(ctx).Error("Could not get policy ids", zap.Error(err))
-		return nil, status.Error(codes.Internal, "could not get policy ids")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "could not get policy ids")
 	}
 
 	sort.Strings(policyIds)
@@ -147,24 +187,25 @@ func (cas *CerbosAdminService) ListPolicies(ctx context.Context, req *requestv1.
 }
 
 func (cas *CerbosAdminService) GetPolicy(ctx context.Context, req *requestv1.GetPolicyRequest) (*responsev1.GetPolicyResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	if cas.store == nil {
-		return nil, status.Error(codes.NotFound, "store is not configured")
+		return nil, NewError(codes.NotFound, ErrCodeStoreUnavailable, "store is not configured")
 	}
 
 	ss, ok := cas.store.(storage.SourceStore)
 	if !ok {
-		return nil, status.Error(codes.Unimplemented, "Configured store does not contain policy sources")
+		return nil, NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Configured store does not contain policy sources")
 	}
 
 	log := ctxzap.Extract(ctx)
 	wrappers, err := ss.LoadPolicy(ctx, req.Id...)
 	if err != nil {
 		log.Error("Could not get policy", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "could not get policy")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "could not get policy")
 	}
 
 	policies := make([]*policyv1.Policy, len(wrappers))
@@ -178,26 +219,29 @@ func (cas *CerbosAdminService) GetPolicy(ctx context.Context, req *requestv1.Get
 }
 
 func (cas *CerbosAdminService) DisablePolicy(ctx context.Context, req *requestv1.DisablePolicyRequest) (*responsev1.DisablePolicyResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	if cas.store == nil {
-		return nil, status.Error(codes.NotFound, "store is not configured")
+		return nil, NewError(codes.NotFound, ErrCodeStoreUnavailable, "store is not configured")
 	}
 
 	ms, ok := cas.store.(storage.MutableStore)
 	if !ok {
-		return nil, status.Error(codes.Unimplemented, "Configured store is not mutable")
+		return nil, NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Configured store is not mutable")
 	}
 
-	disabledPolicies, err := ms.Disable(ctx, req.Id...)
+	defer cas.recordPolicyChanges(ctx, req.Id...)()
+
+	disabledPolicies, err := ms.Disable(cas.withActor(ctx), req.Id...)
 	if err != nil {
 		ctxzap.Extract(ctx).Error("Failed to disable policies", zap.Error(err))
 		if errors.As(err, &db.ErrBreaksScopeChain{}) {
-			return nil, status.Error(codes.InvalidArgument, err.Error())
+			return nil, NewError(codes.InvalidArgument, ErrCodeInvalidRequest, err.Error())
 		}
-		return nil, status.Error(codes.Internal, "Failed to disable policies")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "Failed to disable policies")
 	}
 
 	return &responsev1.DisablePolicyResponse{
@@ -206,23 +250,26 @@ func (cas *CerbosAdminService) DisablePolicy(ctx context.Context, req *requestv1
 }
 
 func (cas *CerbosAdminService) EnablePolicy(ctx context.Context, req *requestv1.EnablePolicyRequest) (*responsev1.EnablePolicyResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	if cas.store == nil {
-		return nil, status.Error(codes.NotFound, "store is not configured")
+		return nil, NewError(codes.NotFound, ErrCodeStoreUnavailable, "store is not configured")
 	}
 
 	ms, ok := cas.store.(storage.MutableStore)
 	if !ok {
-		return nil, status.Error(codes.Unimplemented, "Configured store is not mutable")
+		return nil, NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Configured store is not mutable")
 	}
 
-	enabledPolicies, err := ms.Enable(ctx, req.Id...)
+	defer cas.recordPolicyChanges(ctx, req.Id...)()
+
+	enabledPolicies, err := ms.Enable(cas.withActor(ctx), req.Id...)
 	if err != nil {
 		ctxzap.Extract(ctx).Error("Failed to enable policies", zap.Error(err))
-		return nil, status.Error(codes.Internal, "Failed to enable policies")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "Failed to enable policies")
 	}
 
 	return &responsev1.EnablePolicyResponse{
@@ -231,18 +278,19 @@ func (cas *CerbosAdminService) EnablePolicy(ctx context.Context, req *requestv1.
 }
 
 func (cas *CerbosAdminService) ListSchemas(ctx context.Context, _ *requestv1.ListSchemasRequest) (*responsev1.ListSchemasResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	if cas.store == nil {
-		return nil, status.Error(codes.NotFound, "store is not configured")
+		return nil, NewError(codes.NotFound, ErrCodeStoreUnavailable, "store is not configured")
 	}
 
 	schemaIds, err := cas.store.ListSchemaIDs(ctx)
 	if err != nil {
 		ctxzap.Extract(ctx).Error("Failed to list schema ids", zap.Error(err))
-		return nil, status.Error(codes.NotFound, "failed to list schema ids")
+		return nil, NewError(codes.NotFound, ErrCodeStoreUnavailable, "failed to list schema ids")
 	}
 
 	sort.Strings(schemaIds)
@@ -252,12 +300,13 @@ func (cas *CerbosAdminService) ListSchemas(ctx context.Context, _ *requestv1.Lis
 }
 
 func (cas *CerbosAdminService) GetSchema(ctx context.Context, req *requestv1.GetSchemaRequest) (*responsev1.GetSchemaResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	if cas.store == nil {
-		return nil, status.Error(codes.NotFound, "store is not configured")
+		return nil, NewError(codes.NotFound, ErrCodeStoreUnavailable, "store is not configured")
 	}
 
 	log := ctxzap.Extract(ctx)
@@ -267,13 +316,13 @@ func (cas *CerbosAdminService) GetSchema(ctx context.Context, req *requestv1.Get
 		sch, err := cas.store.LoadSchema(context.Background(), id)
 		if err != nil {
 			log.Error(fmt.Sprintf("Could not get the schema with id %s", id), zap.Error(err))
-			return nil, status.Errorf(codes.Internal, "could not get the schema with id %s", id)
+			return nil, NewErrorf(codes.Internal, ErrCodeInternal, "could not get the schema with id %s", id)
 		}
 
 		schBytes, err := io.ReadAll(sch)
 		if err != nil {
 			log.Error(fmt.Sprintf("Could not read the schema with id %s", id), zap.Error(err))
-			return nil, status.Errorf(codes.Internal, "could not read the schema with id %s", id)
+			return nil, NewErrorf(codes.Internal, ErrCodeInternal, "could not read the schema with id %s", id)
 		}
 
 		schemas = append(schemas, &schemav1.Schema{
@@ -288,19 +337,21 @@ func (cas *CerbosAdminService) GetSchema(ctx context.Context, req *requestv1.Get
 }
 
 func (cas *CerbosAdminService) DeleteSchema(ctx context.Context, req *requestv1.DeleteSchemaRequest) (*responsev1.DeleteSchemaResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	ms, ok := cas.store.(storage.MutableStore)
 	if !ok {
-		return nil, status.Error(codes.Unimplemented, "Configured store is not mutable")
+		return nil, NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Configured store is not mutable")
 	}
 
-	deletedSchemas, err := ms.DeleteSchema(ctx, req.Id...)
+	cas.recordAdminUser(ctx)
+	deletedSchemas, err := ms.DeleteSchema(cas.withActor(ctx), req.Id...)
 	if err != nil {
 		ctxzap.Extract(ctx).Error("Failed to delete the schema(s)", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "Failed to delete the schema(s)")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "Failed to delete the schema(s)")
 	}
 
 	return &responsev1.DeleteSchemaResponse{DeletedSchemas: deletedSchemas}, nil
@@ -308,13 +359,14 @@ func (cas *CerbosAdminService) DeleteSchema(ctx context.Context, req *requestv1.
 
 func (cas *CerbosAdminService) ReloadStore(ctx context.Context, req *requestv1.ReloadStoreRequest) (*responsev1.ReloadStoreResponse, error) {
 	log := ctxzap.Extract(ctx)
-	if err := cas.checkCredentials(ctx); err != nil {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	rs, ok := cas.store.(storage.Reloadable)
 	if !ok {
-		return nil, status.Error(codes.Unimplemented, "Configured store is not reloadable")
+		return nil, NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Configured store is not reloadable")
 	}
 
 	reload := func(ctx context.Context) error {
@@ -332,16 +384,573 @@ func (cas *CerbosAdminService) ReloadStore(ctx context.Context, req *requestv1.R
 	}
 
 	if err := reload(ctx); err != nil {
-		return nil, status.Error(codes.Internal, "failed to reload store")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "failed to reload store")
 	}
 
 	return &responsev1.ReloadStoreResponse{}, nil
 }
 
+func (cas *CerbosAdminService) ExportPolicySnapshot(ctx context.Context, _ *requestv1.ExportPolicySnapshotRequest) (*responsev1.ExportPolicySnapshotResponse, error) {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if cas.store == nil || cas.policyLoader == nil {
+		return nil, NewError(codes.NotFound, ErrCodeStoreUnavailable, "store is not configured")
+	}
+
+	metadata := &responsev1.ExportPolicySnapshotResponse_Metadata{
+		CerbosVersion: util.Version,
+		StoreDriver:   cas.store.Driver(),
+		CreatedAt:     timestamppb.Now(),
+	}
+
+	if r, ok := cas.store.(storage.Revisioned); ok {
+		metadata.StoreRevision = r.Revision()
+	}
+
+	entries, err := cas.snapshotEntries(ctx)
+	if err != nil {
+		ctxzap.Extract(ctx).Error("Failed to create policy snapshot", zap.Error(err))
+		return nil, NewError(codes.Internal, ErrCodeInternal, "failed to create policy snapshot")
+	}
+
+	return &responsev1.ExportPolicySnapshotResponse{Metadata: metadata, Entries: entries}, nil
+}
+
+func (cas *CerbosAdminService) CheckResourcesAsOf(ctx context.Context, req *requestv1.CheckResourcesAsOfRequest) (*responsev1.CheckResourcesAsOfResponse, error) {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if cas.store == nil || cas.cerbosSvc == nil {
+		return nil, NewError(codes.NotFound, ErrCodeStoreUnavailable, "store is not configured")
+	}
+
+	hs, ok := cas.store.(storage.Historical)
+	if !ok {
+		return nil, NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Configured store does not keep a history of policies")
+	}
+
+	if len(req.Check.GetPolicies()) > 0 {
+		return nil, NewError(codes.InvalidArgument, ErrCodeInvalidRequest, "Ad-hoc request policies cannot be used to check resources against the store history")
+	}
+
+	point := storage.HistoryPoint{Revision: req.GetRevision()}
+	if t := req.GetTime(); t != nil {
+		point.Time = t.AsTime()
+	}
+
+	eng, revision, err := cas.historyEngines.get(ctx, hs, point)
+	if err != nil {
+		ctxzap.Extract(ctx).Error("Failed to load policies from store history", zap.Error(err))
+		if errors.Is(err, storage.ErrRevisionNotFound) {
+			return nil, NewError(codes.NotFound, ErrCodeNotFound, "requested revision does not exist in the store history")
+		}
+		return nil, NewError(codes.Internal, ErrCodeInternal, "failed to load policies from store history")
+	}
+
+	check, err := cas.cerbosSvc.withEngine(eng).CheckResources(ctx, req.Check)
+	if err != nil {
+		return nil, err
+	}
+
+	return &responsev1.CheckResourcesAsOfResponse{StoreRevision: revision, Check: check}, nil
+}
+
+func (cas *CerbosAdminService) InspectEffectiveRules(ctx context.Context, req *requestv1.InspectEffectiveRulesRequest) (*responsev1.InspectEffectiveRulesResponse, error) {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if cas.policyLoader == nil {
+		return nil, NewError(codes.NotFound, ErrCodeStoreUnavailable, "store is not configured")
+	}
+
+	version := req.PolicyVersion
+	if version == "" {
+		version = namer.DefaultVersion
+	}
+
+	modIDs := namer.ScopedResourcePolicyModuleIDs(req.Resource, version, req.Scope, req.LenientScopeSearch)
+	rps, err := cas.policyLoader.GetFirstMatch(ctx, modIDs)
+	if err != nil {
+		ctxzap.Extract(ctx).Error("Failed to load resource policy", zap.Error(err))
+		return nil, NewError(codes.Internal, ErrCodeInternal, "failed to load resource policy")
+	}
+
+	rp := rps.GetResourcePolicy()
+	if rp == nil {
+		return nil, NewError(codes.NotFound, ErrCodeNotFound, fmt.Sprintf("no resource policy found for %s", namer.ResourcePolicyFQN(req.Resource, version, req.Scope)))
+	}
+
+	return &responsev1.InspectEffectiveRulesResponse{PolicyFqn: rp.Meta.Fqn, Scopes: effectiveRules(rp)}, nil
+}
+
+func (cas *CerbosAdminService) ListDeprecations(ctx context.Context, _ *requestv1.ListDeprecationsRequest) (*responsev1.ListDeprecationsResponse, error) {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if cas.store == nil {
+		return nil, NewError(codes.NotFound, ErrCodeStoreUnavailable, "store is not configured")
+	}
+
+	ss, ok := cas.store.(storage.SourceStore)
+	if !ok {
+		return nil, NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Configured store does not contain policy sources")
+	}
+
+	found, err := compile.FindStoreDeprecations(ctx, ss)
+	if err != nil {
+		ctxzap.Extract(ctx).Error("Failed to find deprecations", zap.Error(err))
+		return nil, NewError(codes.Internal, ErrCodeInternal, "failed to find deprecations")
+	}
+
+	deprecations := make([]*responsev1.ListDeprecationsResponse_Deprecation, len(found))
+	for i, d := range found {
+		deprecations[i] = &responsev1.ListDeprecationsResponse_Deprecation{
+			File:        d.File,
+			Location:    d.Location,
+			Feature:     d.Feature,
+			Replacement: d.Replacement,
+		}
+	}
+
+	return &responsev1.ListDeprecationsResponse{Deprecations: deprecations}, nil
+}
+
+func (cas *CerbosAdminService) ListPolicyVersions(ctx context.Context, req *requestv1.ListPolicyVersionsRequest) (*responsev1.ListPolicyVersionsResponse, error) {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vs, err := cas.versionedStore()
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := vs.ListPolicyVersions(ctx, req.Id)
+	if err != nil {
+		ctxzap.Extract(ctx).Error("Failed to list policy versions", zap.Error(err))
+		return nil, NewError(codes.Internal, ErrCodeInternal, "failed to list policy versions")
+	}
+
+	resp := &responsev1.ListPolicyVersionsResponse{Versions: make([]*responsev1.ListPolicyVersionsResponse_Version, len(versions))}
+	for i, v := range versions {
+		resp.Versions[i] = &responsev1.ListPolicyVersionsResponse_Version{
+			Version:   v.Version,
+			Timestamp: timestamppb.New(v.Timestamp),
+			Change:    policyVersionChange(v.Change),
+			Disabled:  v.Disabled,
+		}
+	}
+
+	return resp, nil
+}
+
+func (cas *CerbosAdminService) GetPolicyVersion(ctx context.Context, req *requestv1.GetPolicyVersionRequest) (*responsev1.GetPolicyVersionResponse, error) {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vs, err := cas.versionedStore()
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := vs.GetPolicyVersion(ctx, req.Id, req.Version)
+	if err != nil {
+		ctxzap.Extract(ctx).Error("Failed to get policy version", zap.Error(err))
+		if errors.Is(err, storage.ErrRevisionNotFound) {
+			return nil, NewErrorf(codes.NotFound, ErrCodeNotFound, "policy %s has no version %d", req.Id, req.Version)
+		}
+		return nil, NewError(codes.Internal, ErrCodeInternal, "failed to get policy version")
+	}
+
+	return &responsev1.GetPolicyVersionResponse{Policy: p}, nil
+}
+
+func (cas *CerbosAdminService) RollbackPolicy(ctx context.Context, req *requestv1.RollbackPolicyRequest) (*responsev1.RollbackPolicyResponse, error) {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vs, err := cas.versionedStore()
+	if err != nil {
+		return nil, err
+	}
+
+	defer cas.recordPolicyChanges(ctx, req.Id)()
+
+	version, err := vs.RollbackPolicy(cas.withActor(ctx), req.Id, req.Version)
+	if err != nil {
+		ctxzap.Extract(ctx).Error("Failed to roll back policy", zap.Error(err))
+		if errors.Is(err, storage.ErrRevisionNotFound) {
+			return nil, NewErrorf(codes.NotFound, ErrCodeNotFound, "policy %s has no version %d", req.Id, req.Version)
+		}
+		invalidPolicyErr := new(storage.InvalidPolicyError)
+		if errors.As(err, invalidPolicyErr) {
+			return nil, NewErrorf(codes.InvalidArgument, ErrCodeInvalidPolicy, "Invalid policy: %v", invalidPolicyErr.Message)
+		}
+		return nil, NewError(codes.Internal, ErrCodeInternal, "failed to roll back policy")
+	}
+
+	return &responsev1.RollbackPolicyResponse{Version: version}, nil
+}
+
+func (cas *CerbosAdminService) DeletePolicy(ctx context.Context, req *requestv1.DeletePolicyRequest) (*responsev1.DeletePolicyResponse, error) {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := cas.restorableStore()
+	if err != nil {
+		return nil, err
+	}
+
+	defer cas.recordPolicyChanges(ctx, req.Id...)()
+
+	deletedPolicies, err := rs.DeletePolicy(cas.withActor(ctx), req.Id...)
+	if err != nil {
+		ctxzap.Extract(ctx).Error("Failed to delete policies", zap.Error(err))
+		if errors.As(err, &db.ErrBreaksScopeChain{}) {
+			return nil, NewError(codes.InvalidArgument, ErrCodeInvalidRequest, err.Error())
+		}
+		return nil, NewError(codes.Internal, ErrCodeInternal, "Failed to delete policies")
+	}
+
+	return &responsev1.DeletePolicyResponse{
+		DeletedPolicies: deletedPolicies,
+	}, nil
+}
+
+func (cas *CerbosAdminService) RestorePolicy(ctx context.Context, req *requestv1.RestorePolicyRequest) (*responsev1.RestorePolicyResponse, error) {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := cas.restorableStore()
+	if err != nil {
+		return nil, err
+	}
+
+	defer cas.recordPolicyChanges(ctx, req.Id...)()
+
+	restoredPolicies, err := rs.RestorePolicy(cas.withActor(ctx), req.Id...)
+	if err != nil {
+		ctxzap.Extract(ctx).Error("Failed to restore policies", zap.Error(err))
+		invalidPolicyErr := new(storage.InvalidPolicyError)
+		if errors.As(err, invalidPolicyErr) {
+			return nil, NewErrorf(codes.InvalidArgument, ErrCodeInvalidPolicy, "Invalid policy: %v", invalidPolicyErr.Message)
+		}
+		return nil, NewError(codes.Internal, ErrCodeInternal, "Failed to restore policies")
+	}
+
+	return &responsev1.RestorePolicyResponse{
+		RestoredPolicies: restoredPolicies,
+	}, nil
+}
+
+func (cas *CerbosAdminService) ListStoreEvents(ctx context.Context, req *requestv1.ListStoreEventsRequest) (*responsev1.ListStoreEventsResponse, error) {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	as, err := cas.auditedStore()
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultStoreEventsLimit
+	}
+
+	events, err := as.ListStoreEvents(ctx, storage.ListStoreEventsParams{Target: req.Id, Limit: uint(limit)})
+	if err != nil {
+		ctxzap.Extract(ctx).Error("Failed to list store events", zap.Error(err))
+		return nil, NewError(codes.Internal, ErrCodeInternal, "failed to list store events")
+	}
+
+	resp := &responsev1.ListStoreEventsResponse{Events: make([]*responsev1.ListStoreEventsResponse_Event, len(events))}
+	for i, e := range events {
+		resp.Events[i] = &responsev1.ListStoreEventsResponse_Event{
+			Id:        e.ID,
+			Timestamp: timestamppb.New(e.Timestamp),
+			Kind:      storeEventKind(e.Kind),
+			Target:    e.Target,
+			Revision:  e.Revision,
+			Actor: &responsev1.ListStoreEventsResponse_Event_Actor{
+				User:       e.Actor.User,
+				ClientCert: e.Actor.ClientCert,
+			},
+		}
+	}
+
+	return resp, nil
+}
+
+func (cas *CerbosAdminService) SnapshotStore(ctx context.Context, req *requestv1.SnapshotStoreRequest) (*responsev1.SnapshotStoreResponse, error) {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if cas.snapshots == nil {
+		return nil, NewError(codes.Unimplemented, ErrCodeFeatureDisabled, "Snapshots bucket is not configured")
+	}
+
+	if cas.store == nil {
+		return nil, NewError(codes.NotFound, ErrCodeStoreUnavailable, "store is not configured")
+	}
+
+	ss, ok := cas.store.(storage.SourceStore)
+	if !ok {
+		return nil, NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Configured store does not support snapshots")
+	}
+
+	key := req.Key
+	if key == "" {
+		key = snapshot.DefaultKey(time.Now())
+	}
+
+	cas.recordAdminUser(ctx)
+	manifest, err := snapshot.Save(ctx, cas.snapshots, key, ss)
+	if err != nil {
+		if errors.Is(err, snapshot.ErrInvalidKey) {
+			return nil, NewError(codes.InvalidArgument, ErrCodeInvalidRequest, err.Error())
+		}
+		ctxzap.Extract(ctx).Error("Failed to write snapshot", zap.Error(err))
+		return nil, NewError(codes.Internal, ErrCodeInternal, "Failed to write snapshot")
+	}
+
+	return &responsev1.SnapshotStoreResponse{
+		Key:       key,
+		CreatedAt: timestamppb.New(manifest.CreatedAt),
+		Policies:  uint32(len(manifest.Policies)),
+		Schemas:   uint32(len(manifest.Schemas)),
+	}, nil
+}
+
+func (cas *CerbosAdminService) RestoreStore(ctx context.Context, req *requestv1.RestoreStoreRequest) (*responsev1.RestoreStoreResponse, error) {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if cas.snapshots == nil {
+		return nil, NewError(codes.Unimplemented, ErrCodeFeatureDisabled, "Snapshots bucket is not configured")
+	}
+
+	ms, ok := cas.store.(storage.MutableStore)
+	if !ok {
+		return nil, NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Configured store is not mutable")
+	}
+
+	cas.recordAdminUser(ctx)
+	manifest, err := snapshot.Restore(cas.withActor(ctx), cas.snapshots, req.Key, ms)
+	if err != nil {
+		ctxzap.Extract(ctx).Error("Failed to restore snapshot", zap.Error(err))
+
+		invalidPolicyErr := new(storage.InvalidPolicyError)
+		var invalidSchemaErr storage.InvalidSchemaError
+		switch {
+		case errors.Is(err, snapshot.ErrInvalidKey), errors.Is(err, snapshot.ErrNotSnapshot):
+			return nil, NewError(codes.InvalidArgument, ErrCodeInvalidRequest, err.Error())
+		case errors.Is(err, snapshot.ErrNotFound):
+			return nil, NewErrorf(codes.NotFound, ErrCodeNotFound, "Snapshot %s not found", req.Key)
+		case errors.As(err, invalidPolicyErr):
+			return nil, NewErrorf(codes.InvalidArgument, ErrCodeInvalidPolicy, "Invalid policy: %v", invalidPolicyErr.Message)
+		case errors.As(err, &invalidSchemaErr):
+			return nil, NewErrorf(codes.InvalidArgument, ErrCodeSchemaValidationError, "Invalid schema: %s", invalidSchemaErr.Message)
+		}
+
+		return nil, NewError(codes.Internal, ErrCodeInternal, "Failed to restore snapshot")
+	}
+
+	return &responsev1.RestoreStoreResponse{
+		CreatedAt: timestamppb.New(manifest.CreatedAt),
+		Policies:  uint32(len(manifest.Policies)),
+		Schemas:   uint32(len(manifest.Schemas)),
+	}, nil
+}
+
+func (cas *CerbosAdminService) versionedStore() (storage.Versioned, error) {
+	if cas.store == nil {
+		return nil, NewError(codes.NotFound, ErrCodeStoreUnavailable, "store is not configured")
+	}
+
+	vs, ok := cas.store.(storage.Versioned)
+	if !ok {
+		return nil, NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Configured store does not keep policy versions")
+	}
+
+	return vs, nil
+}
+
+func (cas *CerbosAdminService) restorableStore() (storage.Restorable, error) {
+	if cas.store == nil {
+		return nil, NewError(codes.NotFound, ErrCodeStoreUnavailable, "store is not configured")
+	}
+
+	rs, ok := cas.store.(storage.Restorable)
+	if !ok {
+		return nil, NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Configured store does not support deleting and restoring policies")
+	}
+
+	return rs, nil
+}
+
+func (cas *CerbosAdminService) auditedStore() (storage.Audited, error) {
+	if cas.store == nil {
+		return nil, NewError(codes.NotFound, ErrCodeStoreUnavailable, "store is not configured")
+	}
+
+	as, ok := cas.store.(storage.Audited)
+	if !ok {
+		return nil, NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Configured store does not record the changes made to it")
+	}
+
+	return as, nil
+}
+
+func storeEventKind(kind storage.StoreEventKind) responsev1.ListStoreEventsResponse_Event_Kind {
+	switch kind {
+	case storage.StoreEventAddOrUpdatePolicy:
+		return responsev1.ListStoreEventsResponse_Event_KIND_ADD_OR_UPDATE_POLICY
+	case storage.StoreEventDisablePolicy:
+		return responsev1.ListStoreEventsResponse_Event_KIND_DISABLE_POLICY
+	case storage.StoreEventEnablePolicy:
+		return responsev1.ListStoreEventsResponse_Event_KIND_ENABLE_POLICY
+	case storage.StoreEventDeletePolicy:
+		return responsev1.ListStoreEventsResponse_Event_KIND_DELETE_POLICY
+	case storage.StoreEventRestorePolicy:
+		return responsev1.ListStoreEventsResponse_Event_KIND_RESTORE_POLICY
+	case storage.StoreEventRollbackPolicy:
+		return responsev1.ListStoreEventsResponse_Event_KIND_ROLLBACK_POLICY
+	case storage.StoreEventAddOrUpdateSchema:
+		return responsev1.ListStoreEventsResponse_Event_KIND_ADD_OR_UPDATE_SCHEMA
+	case storage.StoreEventDeleteSchema:
+		return responsev1.ListStoreEventsResponse_Event_KIND_DELETE_SCHEMA
+	default:
+		return responsev1.ListStoreEventsResponse_Event_KIND_UNSPECIFIED
+	}
+}
+
+func policyVersionChange(change storage.PolicyChange) responsev1.ListPolicyVersionsResponse_Version_Change {
+	switch change {
+	case storage.PolicyCreated:
+		return responsev1.ListPolicyVersionsResponse_Version_CHANGE_CREATED
+	case storage.PolicyUpdated:
+		return responsev1.ListPolicyVersionsResponse_Version_CHANGE_UPDATED
+	case storage.PolicyDeleted:
+		return responsev1.ListPolicyVersionsResponse_Version_CHANGE_DELETED
+	default:
+		return responsev1.ListPolicyVersionsResponse_Version_CHANGE_UNSPECIFIED
+	}
+}
+
+// snapshotEntries compiles every enabled policy in the store using the same policy loader as the engine.
+func (cas *CerbosAdminService) snapshotEntries(ctx context.Context) ([]*responsev1.ExportPolicySnapshotResponse_Entry, error) {
+	policyIDs, err := cas.store.ListPolicyIDs(ctx, storage.ListPolicyIDsParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+
+	sort.Strings(policyIDs)
+
+	entries := make([]*responsev1.ExportPolicySnapshotResponse_Entry, 0, len(policyIDs))
+	addEntry := func(policyID string, modID namer.ModuleID, dependencies []string) error {
+		rps, err := cas.policyLoader.GetFirstMatch(ctx, []namer.ModuleID{modID})
+		if err != nil {
+			return fmt.Errorf("failed to get policy set for %s: %w", policyID, err)
+		}
+
+		if rps == nil {
+			return nil
+		}
+
+		policySet, err := anypb.New(rps)
+		if err != nil {
+			return fmt.Errorf("failed to marshal policy set for %s: %w", policyID, err)
+		}
+
+		entries = append(entries, &responsev1.ExportPolicySnapshotResponse_Entry{
+			PolicyId:     policyID,
+			Fqn:          rps.Fqn,
+			Dependencies: dependencies,
+			PolicySet:    policySet,
+		})
+		return nil
+	}
+
+	ss, ok := cas.store.(storage.SourceStore)
+	if !ok {
+		// binary stores list the policies by their fully-qualified names
+		for _, id := range policyIDs {
+			if err := addEntry(id, namer.GenModuleIDFromFQN(id), nil); err != nil {
+				return nil, err
+			}
+		}
+
+		return entries, nil
+	}
+
+	if len(policyIDs) == 0 {
+		return entries, nil
+	}
+
+	wrappers, err := ss.LoadPolicy(ctx, policyIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load policies: %w", err)
+	}
+
+	modIDs := make([]namer.ModuleID, len(wrappers))
+	for i, w := range wrappers {
+		modIDs[i] = w.ID
+	}
+
+	compilationUnits, err := ss.GetCompilationUnits(ctx, modIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get compilation units: %w", err)
+	}
+
+	for _, w := range wrappers {
+		var dependencies []string
+		if cu, ok := compilationUnits[w.ID]; ok {
+			for depID, dep := range cu.Definitions {
+				if depID != w.ID {
+					dependencies = append(dependencies, namer.FQN(dep))
+				}
+			}
+			sort.Strings(dependencies)
+		}
+
+		if err := addEntry(w.GetMetadata().GetStoreIdentifier(), w.ID, dependencies); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].PolicyId < entries[j].PolicyId })
+	return entries, nil
+}
+
 func (cas *CerbosAdminService) ListAuditLogEntries(req *requestv1.ListAuditLogEntriesRequest, stream svcv1.CerbosAdminService_ListAuditLogEntriesServer) error {
 	ctx := stream.Context()
 
-	if err := cas.checkCredentials(ctx); err != nil {
+	ctx, err := cas.checkCredentials(ctx)
+	if err != nil {
 		return err
 	}
 
@@ -362,7 +971,7 @@ func (cas *CerbosAdminService) ListAuditLogEntries(req *requestv1.ListAuditLogEn
 			}
 
 			ctxzap.Extract(ctx).Error("Error from log iterator", zap.Error(err))
-			return status.Error(codes.Internal, "Iterator failure")
+			return NewError(codes.Internal, ErrCodeInternal, "Iterator failure")
 		}
 
 		if err := stream.Send(rec); err != nil {
@@ -374,16 +983,16 @@ func (cas *CerbosAdminService) ListAuditLogEntries(req *requestv1.ListAuditLogEn
 
 func (cas *CerbosAdminService) getAuditLogStream(ctx context.Context, req *requestv1.ListAuditLogEntriesRequest) (auditLogStream, error) {
 	if !cas.auditLog.Enabled() {
-		return nil, status.Error(codes.Unimplemented, "Audit logs are not enabled")
+		return nil, NewError(codes.Unimplemented, ErrCodeFeatureDisabled, "Audit logs are not enabled")
 	}
 
 	if cas.auditLog.Backend() == "" {
-		return nil, status.Error(codes.Unimplemented, "No audit log backend is configured")
+		return nil, NewError(codes.Unimplemented, ErrCodeFeatureDisabled, "No audit log backend is configured")
 	}
 
 	queryableLog, ok := cas.auditLog.(audit.QueryableLog)
 	if !ok {
-		return nil, status.Error(codes.Unimplemented, "Audit log backend does not support querying")
+		return nil, NewError(codes.Unimplemented, ErrCodeFeatureDisabled, "Audit log backend does not support querying")
 	}
 
 	switch req.Kind {
@@ -410,10 +1019,10 @@ func (cas *CerbosAdminService) getAuditLogStream(ctx context.Context, req *reque
 			return mkDecisionLogStream(queryableLog.DecisionLogEntryByID(ctx, audit.ID(f.Lookup))), nil
 		}
 	default:
-		return nil, status.Error(codes.InvalidArgument, "Unknown log stream kind")
+		return nil, NewError(codes.InvalidArgument, ErrCodeInvalidRequest, "Unknown log stream kind")
 	}
 
-	return nil, status.Error(codes.InvalidArgument, "Unknown filter")
+	return nil, NewError(codes.InvalidArgument, ErrCodeInvalidRequest, "Unknown filter")
 }
 
 type auditLogStream func() (*responsev1.ListAuditLogEntriesResponse, error)
@@ -448,39 +1057,150 @@ func mkDecisionLogStream(it audit.DecisionLogIterator) auditLogStream {
 	}
 }
 
-func (cas *CerbosAdminService) checkCredentials(ctx context.Context) error {
+// withActor returns a context that attributes the changes made to the store to the admin user and, if the caller
+// presented a verified TLS client certificate, to the subject of that certificate.
+func (cas *CerbosAdminService) withActor(ctx context.Context) context.Context {
+	actor := storage.Actor{User: adminUser(ctx)}
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 && len(tlsInfo.State.VerifiedChains[0]) > 0 {
+			actor.ClientCert = tlsInfo.State.VerifiedChains[0][0].Subject.String()
+		}
+	}
+
+	return storage.NewContextWithActor(ctx, actor)
+}
+
+// recordAdminUser records the admin user that made the call in the access log entry.
+func (cas *CerbosAdminService) recordAdminUser(ctx context.Context) {
+	if change := audit.AdminChangeFromContext(ctx); change != nil {
+		change.User = adminUser(ctx)
+	}
+}
+
+// recordPolicyChanges captures the content hashes of the given policies before the store is modified.
+// The returned function must be called after the modification to record the changes in the access log entry.
+func (cas *CerbosAdminService) recordPolicyChanges(ctx context.Context, policyKeys ...string) func() {
+	change := audit.AdminChangeFromContext(ctx)
+	if change == nil {
+		return func() {}
+	}
+
+	before := cas.policyHashes(ctx, policyKeys)
+	return func() {
+		after := cas.policyHashes(ctx, policyKeys)
+
+		change.User = adminUser(ctx)
+		change.Policies = make([]*auditv1.AdminChange_PolicyChange, len(policyKeys))
+		for i, pk := range policyKeys {
+			change.Policies[i] = &auditv1.AdminChange_PolicyChange{
+				PolicyId:   pk,
+				BeforeHash: before[pk],
+				AfterHash:  after[pk],
+			}
+		}
+	}
+}
+
+// policyHashes returns the content hashes of the policies that exist in the store, keyed by the policy key.
+func (cas *CerbosAdminService) policyHashes(ctx context.Context, policyKeys []string) map[string]string {
+	hashes := make(map[string]string, len(policyKeys))
+
+	ss, ok := cas.store.(storage.SourceStore)
+	if !ok {
+		return hashes
+	}
+
+	policies, err := ss.LoadPolicy(ctx, policyKeys...)
+	if err != nil {
+		ctxzap.Extract(ctx).Warn("Failed to load policies to record the changes", zap.Error(err))
+		return hashes
+	}
+
+	for _, p := range policies {
+		hashes[namer.PolicyKey(p.Policy)] = policyContentHash(p.Policy)
+	}
+
+	return hashes
+}
+
+// policyContentHash returns the hash of the policy definition.
+// Unlike the policy hash used for compilation, it changes when the policy is enabled or disabled.
+func policyContentHash(p *policyv1.Policy) string {
+	return strconv.FormatUint(util.HashPB(p, policyContentHashIgnoreFields), 16)
+}
+
+// checkCredentials authenticates the request and returns a context that identifies the admin user who made it.
+// Requests that are bound to a tenant or name one with the tenant header are rejected because the Admin API only
+// manages the default store.
+func (cas *CerbosAdminService) checkCredentials(ctx context.Context) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return errAuthRequired
+		return nil, errAuthRequired
 	}
 
 	header, ok := md["authorization"]
 	if !ok || len(header) == 0 {
-		return errAuthRequired
+		return nil, errAuthRequired
+	}
+
+	user, err := cas.auth.Authenticate(ctx, header[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if isTenantScoped(ctx) {
+		return nil, errTenantScoped
 	}
 
-	if !strings.HasPrefix(header[0], "Basic") {
-		return status.Error(codes.Unauthenticated, "unsupported authentication method")
+	return context.WithValue(ctx, adminUserCtxKey{}, user), nil
+}
+
+// Authenticate checks the credentials in the value of an Authorization header and returns the name of the admin user.
+func (a AdminAuth) Authenticate(ctx context.Context, authorization string) (string, error) {
+	switch {
+	case strings.HasPrefix(authorization, "Basic") && !a.DisableBasicAuth:
+		if err := a.checkBasic(strings.TrimSpace(strings.TrimPrefix(authorization, "Basic"))); err != nil {
+			return "", err
+		}
+		return a.Username, nil
+	case strings.HasPrefix(authorization, "Bearer") && a.OIDC != nil:
+		user, err := a.OIDC.Verify(ctx, strings.TrimSpace(strings.TrimPrefix(authorization, "Bearer")))
+		if err != nil {
+			ctxzap.Extract(ctx).Debug("Bearer token rejected", zap.Error(err))
+			return "", NewError(codes.Unauthenticated, ErrCodeAuthenticationFailed, "invalid bearer token")
+		}
+		return user, nil
+	default:
+		return "", NewError(codes.Unauthenticated, ErrCodeAuthenticationFailed, "unsupported authentication method")
 	}
+}
 
-	encoded := strings.TrimSpace(strings.TrimPrefix(header[0], "Basic"))
+func (a AdminAuth) checkBasic(encoded string) error {
 	decoded, err := base64.StdEncoding.DecodeString(encoded)
 	if err != nil {
-		return status.Error(codes.Unauthenticated, "failed to decode credentials")
+		return NewError(codes.Unauthenticated, ErrCodeAuthenticationFailed, "failed to decode credentials")
 	}
 
 	parts := bytes.Split(bytes.TrimSpace(decoded), authSep)
 	if len(parts) != 2 { //nolint:gomnd
-		return status.Error(codes.Unauthenticated, "invalid credentials")
+		return NewError(codes.Unauthenticated, ErrCodeAuthenticationFailed, "invalid credentials")
 	}
 
-	if !bytes.Equal(parts[0], []byte(cas.adminUser)) {
-		return status.Error(codes.Unauthenticated, "incorrect credentials")
+	if !bytes.Equal(parts[0], []byte(a.Username)) {
+		return NewError(codes.Unauthenticated, ErrCodeAuthenticationFailed, "incorrect credentials")
 	}
 
-	if err := bcrypt.CompareHashAndPassword(cas.adminPasswdHash, parts[1]); err != nil {
-		return status.Error(codes.Unauthenticated, "incorrect credentials")
+	if err := bcrypt.CompareHashAndPassword(a.PasswordHash, parts[1]); err != nil {
+		return NewError(codes.Unauthenticated, ErrCodeAuthenticationFailed, "incorrect credentials")
 	}
 
 	return nil
 }
+
+type adminUserCtxKey struct{}
+
+// adminUser returns the name of the admin user who made the request.
+func adminUser(ctx context.Context) string {
+	user, _ := ctx.Value(adminUserCtxKey{}).(string)
+	return user
+}
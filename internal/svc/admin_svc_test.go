@@ -0,0 +1,248 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build tests
+// +build tests
+
+package svc
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	responsev1 "github.com/cerbos/cerbos/api/genpb/cerbos/response/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/db/sqlite3"
+	"github.com/cerbos/cerbos/internal/storage/snapshot"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+func TestAdminChanges(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	store, err := sqlite3.NewStore(ctx, &sqlite3.Conf{DSN: fmt.Sprintf("%s?_fk=true", filepath.Join(t.TempDir(), "cerbos.db"))})
+	require.NoError(t, err)
+
+	passwdHash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	auditLog := &adminChangeLog{}
+	interceptor, err := audit.NewUnaryInterceptor(auditLog, func(string) bool { return false })
+	require.NoError(t, err)
+
+	cas := NewCerbosAdminService(store, nil, nil, auditLog, nil, AdminAuth{Username: "cerbos", PasswordHash: passwdHash})
+	authCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("cerbos:secret"))))
+
+	call := func(t *testing.T, handler grpc.UnaryHandler) *auditv1.AdminChange {
+		t.Helper()
+
+		_, err := interceptor(authCtx, nil, &grpc.UnaryServerInfo{FullMethod: "/test"}, handler)
+		require.NoError(t, err)
+
+		return auditLog.entry.AdminChange
+	}
+
+	mkPolicy := func(role string) *policyv1.Policy {
+		return test.NewResourcePolicyBuilder("leave_request", "default").
+			WithRules(test.NewResourceRule("view").WithRoles(role).WithEffect(effectv1.Effect_EFFECT_ALLOW).Build()).
+			Build()
+	}
+
+	const policyKey = "resource.leave_request.vdefault"
+	var created, updated string
+
+	t.Run("add", func(t *testing.T) {
+		change := call(t, func(ctx context.Context, _ any) (any, error) {
+			return cas.AddOrUpdatePolicy(ctx, &requestv1.AddOrUpdatePolicyRequest{Policies: []*policyv1.Policy{mkPolicy("user")}})
+		})
+
+		require.Equal(t, "cerbos", change.User)
+		require.Len(t, change.Policies, 1)
+		require.Equal(t, policyKey, change.Policies[0].PolicyId)
+		require.Empty(t, change.Policies[0].BeforeHash)
+		require.NotEmpty(t, change.Policies[0].AfterHash)
+		created = change.Policies[0].AfterHash
+	})
+
+	t.Run("update", func(t *testing.T) {
+		change := call(t, func(ctx context.Context, _ any) (any, error) {
+			return cas.AddOrUpdatePolicy(ctx, &requestv1.AddOrUpdatePolicyRequest{Policies: []*policyv1.Policy{mkPolicy("admin")}})
+		})
+
+		require.Len(t, change.Policies, 1)
+		require.Equal(t, created, change.Policies[0].BeforeHash)
+		require.NotEqual(t, created, change.Policies[0].AfterHash)
+		updated = change.Policies[0].AfterHash
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		change := call(t, func(ctx context.Context, _ any) (any, error) {
+			return cas.DisablePolicy(ctx, &requestv1.DisablePolicyRequest{Id: []string{policyKey}})
+		})
+
+		require.Len(t, change.Policies, 1)
+		require.Equal(t, updated, change.Policies[0].BeforeHash)
+		require.NotEqual(t, updated, change.Policies[0].AfterHash)
+	})
+
+	t.Run("read_only", func(t *testing.T) {
+		change := call(t, func(ctx context.Context, _ any) (any, error) {
+			return cas.ListPolicies(ctx, &requestv1.ListPoliciesRequest{})
+		})
+
+		require.Nil(t, change)
+	})
+
+	t.Run("store_events", func(t *testing.T) {
+		resp, err := cas.ListStoreEvents(authCtx, &requestv1.ListStoreEventsRequest{Id: policyKey})
+		require.NoError(t, err)
+		require.Len(t, resp.Events, 3)
+		require.Equal(t, responsev1.ListStoreEventsResponse_Event_KIND_DISABLE_POLICY, resp.Events[0].Kind)
+		require.Equal(t, responsev1.ListStoreEventsResponse_Event_KIND_ADD_OR_UPDATE_POLICY, resp.Events[2].Kind)
+		for _, event := range resp.Events {
+			require.Equal(t, policyKey, event.Target)
+			require.Equal(t, "cerbos", event.Actor.User)
+			require.Empty(t, event.Actor.ClientCert)
+			require.NotZero(t, event.Revision)
+		}
+
+		resp, err = cas.ListStoreEvents(authCtx, &requestv1.ListStoreEventsRequest{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, resp.Events, 1)
+		require.Equal(t, strconv.FormatUint(resp.Events[0].Revision, 10), store.Revision())
+
+		_, err = cas.ListStoreEvents(ctx, &requestv1.ListStoreEventsRequest{})
+		require.Error(t, err)
+	})
+
+	t.Run("tenant_scoped", func(t *testing.T) {
+		md, _ := metadata.FromIncomingContext(authCtx)
+		for name, tenantCtx := range map[string]context.Context{
+			"header": metadata.NewIncomingContext(ctx, metadata.Join(md, metadata.Pairs(TenantHeader, "acme"))),
+			"bound":  ContextWithTenant(authCtx, "acme"),
+		} {
+			_, err := cas.ListPolicies(tenantCtx, &requestv1.ListPoliciesRequest{})
+			require.Equal(t, codes.InvalidArgument, status.Code(err), name)
+		}
+	})
+}
+
+func TestSnapshotAndRestoreStore(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	mkStore := func(t *testing.T) *sqlite3.Store {
+		t.Helper()
+		store, err := sqlite3.NewStore(ctx, &sqlite3.Conf{DSN: "file::memory:?_fk=true"})
+		require.NoError(t, err)
+		return store
+	}
+
+	passwdHash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	authCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("cerbos:secret"))))
+	bucket := snapshotBucket{}
+
+	source := mkStore(t)
+	sourceSvc := NewCerbosAdminService(source, nil, nil, audit.NewNopLog(), bucket, AdminAuth{Username: "cerbos", PasswordHash: passwdHash})
+	_, err = sourceSvc.AddOrUpdatePolicy(authCtx, &requestv1.AddOrUpdatePolicyRequest{Policies: []*policyv1.Policy{
+		test.NewResourcePolicyBuilder("leave_request", "default").
+			WithRules(test.NewResourceRule("view").WithRoles("user").WithEffect(effectv1.Effect_EFFECT_ALLOW).Build()).
+			Build(),
+	}})
+	require.NoError(t, err)
+
+	snapshotResp, err := sourceSvc.SnapshotStore(authCtx, &requestv1.SnapshotStoreRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, snapshotResp.Key)
+	require.Equal(t, uint32(1), snapshotResp.Policies)
+
+	target := mkStore(t)
+	targetSvc := NewCerbosAdminService(target, nil, nil, audit.NewNopLog(), bucket, AdminAuth{Username: "cerbos", PasswordHash: passwdHash})
+
+	restoreResp, err := targetSvc.RestoreStore(authCtx, &requestv1.RestoreStoreRequest{Key: snapshotResp.Key})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), restoreResp.Policies)
+
+	policyIDs, err := target.ListPolicyIDs(ctx, storage.ListPolicyIDsParams{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"resource.leave_request.vdefault"}, policyIDs)
+
+	_, err = targetSvc.RestoreStore(authCtx, &requestv1.RestoreStoreRequest{Key: "missing.tar.gz"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	noBucketSvc := NewCerbosAdminService(source, nil, nil, audit.NewNopLog(), nil, AdminAuth{Username: "cerbos", PasswordHash: passwdHash})
+	_, err = noBucketSvc.SnapshotStore(authCtx, &requestv1.SnapshotStoreRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+// snapshotBucket keeps snapshots in memory.
+type snapshotBucket map[string][]byte
+
+func (b snapshotBucket) NewWriter(_ context.Context, key string) (io.WriteCloser, error) {
+	return &snapshotWriter{bucket: b, key: key}, nil
+}
+
+func (b snapshotBucket) NewReader(_ context.Context, key string) (io.ReadCloser, error) {
+	data, ok := b[key]
+	if !ok {
+		return nil, snapshot.ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+type snapshotWriter struct {
+	bucket snapshotBucket
+	key    string
+	bytes.Buffer
+}
+
+func (w *snapshotWriter) Close() error {
+	w.bucket[w.key] = w.Bytes()
+	return nil
+}
+
+type adminChangeLog struct {
+	entry *auditv1.AccessLogEntry
+}
+
+func (*adminChangeLog) Backend() string {
+	return "test"
+}
+
+func (*adminChangeLog) Enabled() bool {
+	return true
+}
+
+func (*adminChangeLog) Close() error {
+	return nil
+}
+
+func (l *adminChangeLog) WriteAccessLogEntry(_ context.Context, entry audit.AccessLogEntryMaker) (err error) {
+	l.entry, err = entry()
+	return err
+}
+
+func (*adminChangeLog) WriteDecisionLogEntry(context.Context, audit.DecisionLogEntryMaker) error {
+	return nil
+}
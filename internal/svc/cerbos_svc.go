@@ -6,14 +6,18 @@ package svc
 import (
 	"context"
 	"errors"
+	"io"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
 	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
 	responsev1 "github.com/cerbos/cerbos/api/genpb/cerbos/response/v1"
 	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
@@ -31,49 +35,163 @@ type CerbosService struct {
 	eng     *engine.Engine
 	auxData *auxdata.AuxData
 	*svcv1.UnimplementedCerbosServiceServer
-	reqLimits RequestLimits
+	reqLimits             RequestLimits
+	watchDecisionsEnabled bool
+	// requestPolicies evaluates the ad-hoc policies sent with requests. It's nil if request policies are disabled.
+	requestPolicies *RequestPolicies
+	// resourceKinds lists the resource kinds defined in the store. It's nil if the service is not backed by a store.
+	resourceKinds *ResourceKinds
+	// revisions resolves the store revision that requests are evaluated against. It's nil if requests can't be pinned to a store revision.
+	revisions *StoreRevisions
+	// tenants routes the requests that identify a tenant to the service of the tenant. It's nil if tenancy is not configured.
+	tenants *Tenants
 }
 
 type RequestLimits struct {
-	MaxActionsPerResource  uint
-	MaxResourcesPerRequest uint
+	MaxActionsPerResource       uint
+	MaxResourcesPerRequest      uint
+	MaxPlanEntriesPerRequest    uint
+	MaxPoliciesPerRequest       uint
+	MaxPolicyBytesPerRequest    uint
+	MaxAttributeBytesPerRequest uint
+	MaxAuxDataBytesPerRequest   uint
 }
 
-func NewCerbosService(eng *engine.Engine, auxData *auxdata.AuxData, reqLimits RequestLimits) *CerbosService {
+func NewCerbosService(eng *engine.Engine, auxData *auxdata.AuxData, reqLimits RequestLimits, watchDecisionsEnabled bool, requestPolicies *RequestPolicies, resourceKinds *ResourceKinds, revisions *StoreRevisions, tenants *Tenants) *CerbosService {
 	return &CerbosService{
 		eng:                              eng,
 		auxData:                          auxData,
 		reqLimits:                        reqLimits,
+		watchDecisionsEnabled:            watchDecisionsEnabled,
+		requestPolicies:                  requestPolicies,
+		resourceKinds:                    resourceKinds,
+		revisions:                        revisions,
+		tenants:                          tenants,
 		UnimplementedCerbosServiceServer: &svcv1.UnimplementedCerbosServiceServer{},
 	}
 }
 
 func (cs *CerbosService) PlanResources(ctx context.Context, request *requestv1.PlanResourcesRequest) (*responsev1.PlanResourcesResponse, error) {
 	log := ctxzap.Extract(ctx)
+	if err := cs.checkPayloadSizeLimits(attrSize(request.Principal.GetAttr())+attrSize(request.Resource.GetAttr()), request.AuxData); err != nil {
+		log.Error("Request too large", zap.Error(err))
+		return nil, err
+	}
+
+	ctx, cs, err := cs.forRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	auxData, err := cs.auxData.Extract(ctx, request.AuxData)
 	if err != nil {
 		log.Error("Failed to extract auxData", zap.Error(err))
-		return nil, status.Error(codes.InvalidArgument, "failed to extract auxData")
+		return nil, NewError(codes.InvalidArgument, ErrCodeInvalidAuxData, "failed to extract auxData")
 	}
 
 	input := &enginev1.PlanResourcesInput{
-		RequestId:   request.RequestId,
-		Action:      request.Action,
-		Principal:   request.Principal,
-		Resource:    request.Resource,
-		AuxData:     auxData,
-		IncludeMeta: request.IncludeMeta,
+		RequestId:            request.RequestId,
+		Action:               request.Action,
+		Principal:            request.Principal,
+		Resource:             request.Resource,
+		AuxData:              auxData,
+		IncludeMeta:          request.IncludeMeta,
+		ExpandConditionals:   request.ExpandConditionals,
+		IncludeRuleResiduals: request.IncludeRuleResiduals,
+		SimplifyFilters:      request.SimplifyFilters,
 	}
 	output, err := cs.eng.PlanResources(logging.ToContext(ctx, log), input)
 	if err != nil {
 		log.Error("Resources query plan request failed", zap.Error(err))
 		if errors.Is(err, compile.PolicyCompilationErr{}) {
-			return nil, status.Errorf(codes.FailedPrecondition, "Resources query plan failed due to invalid policy")
+			return nil, NewError(codes.FailedPrecondition, ErrCodeInvalidPolicy, "Resources query plan failed due to invalid policy")
+		}
+		return nil, NewError(codes.Internal, ErrCodeInternal, "Resources query plan request failed")
+	}
+
+	return mkPlanResourcesResponse(output, input.IncludeMeta), nil
+}
+
+// PlanResourcesStream produces query plans for a batch of principal and action combinations against a single resource.
+func (cs *CerbosService) PlanResourcesStream(req *requestv1.PlanResourcesStreamRequest, stream svcv1.CerbosService_PlanResourcesStreamServer) error {
+	ctx := stream.Context()
+	log := ctxzap.Extract(ctx)
+
+	if err := cs.checkNumPlanEntriesLimit(len(req.Entries)); err != nil {
+		return err
+	}
+
+	attrBytes := attrSize(req.Resource.GetAttr())
+	for _, entry := range req.Entries {
+		attrBytes += attrSize(entry.Principal.GetAttr())
+	}
+
+	if err := cs.checkPayloadSizeLimits(attrBytes, req.AuxData); err != nil {
+		log.Error("Request too large", zap.Error(err))
+		return err
+	}
+
+	ctx, cs, err := cs.forRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	auxData, err := cs.auxData.Extract(ctx, req.AuxData)
+	if err != nil {
+		log.Error("Failed to extract auxData", zap.Error(err))
+		return NewError(codes.InvalidArgument, ErrCodeInvalidAuxData, "failed to extract auxData")
+	}
+
+	inputs := make([]*enginev1.PlanResourcesInput, len(req.Entries))
+	for i, entry := range req.Entries {
+		inputs[i] = &enginev1.PlanResourcesInput{
+			RequestId:            req.RequestId,
+			Action:               entry.Action,
+			Principal:            entry.Principal,
+			Resource:             req.Resource,
+			AuxData:              auxData,
+			IncludeMeta:          req.IncludeMeta,
+			ExpandConditionals:   req.ExpandConditionals,
+			IncludeRuleResiduals: req.IncludeRuleResiduals,
+			SimplifyFilters:      req.SimplifyFilters,
+		}
+	}
+
+	i := 0
+	err = cs.eng.PlanResourcesStream(logging.ToContext(ctx, log), inputs, func(output *enginev1.PlanResourcesOutput, planErr error) error {
+		response := &responsev1.PlanResourcesStreamResponse{EntryId: req.Entries[i].Id}
+		if planErr != nil {
+			log.Error("Resources query plan failed for stream entry", zap.Int("entry", i), zap.Error(planErr))
+			response.Error = planErrorMessage(planErr)
+		} else {
+			response.Plan = mkPlanResourcesResponse(output, req.IncludeMeta)
+		}
+
+		if err := stream.Send(response); err != nil {
+			log.Error("Error writing to stream", zap.Error(err))
+			return err
 		}
-		return nil, status.Errorf(codes.Internal, "Resources query plan request failed")
+
+		i++
+		return nil
+	})
+	if err != nil {
+		log.Error("Resources query plan stream failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func planErrorMessage(err error) string {
+	if errors.Is(err, compile.PolicyCompilationErr{}) {
+		return "Resources query plan failed due to invalid policy"
 	}
 
+	return "Resources query plan request failed"
+}
+
+func mkPlanResourcesResponse(output *enginev1.PlanResourcesOutput, includeMeta bool) *responsev1.PlanResourcesResponse {
 	response := &responsev1.PlanResourcesResponse{
 		RequestId:        output.RequestId,
 		Action:           output.Action,
@@ -81,16 +199,17 @@ func (cs *CerbosService) PlanResources(ctx context.Context, request *requestv1.P
 		PolicyVersion:    output.PolicyVersion,
 		Filter:           output.Filter,
 		ValidationErrors: output.ValidationErrors,
+		RuleResiduals:    output.RuleResiduals,
 	}
 
-	if input.IncludeMeta {
+	if includeMeta {
 		response.Meta = &responsev1.PlanResourcesResponse_Meta{
 			FilterDebug:  output.FilterDebug,
 			MatchedScope: output.Scope,
 		}
 	}
 
-	return response, nil
+	return response
 }
 
 // CheckResourceSet checks a batch of homogenous resources.
@@ -107,10 +226,25 @@ func (cs *CerbosService) CheckResourceSet(ctx context.Context, req *requestv1.Ch
 		return nil, err
 	}
 
+	attrBytes := attrSize(req.Principal.GetAttr())
+	for _, res := range req.Resource.Instances {
+		attrBytes += attrSize(res.GetAttr())
+	}
+
+	if err := cs.checkPayloadSizeLimits(attrBytes, req.AuxData); err != nil {
+		log.Error("Request too large", zap.Error(err))
+		return nil, err
+	}
+
+	ctx, cs, err := cs.forRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	auxData, err := cs.auxData.Extract(ctx, req.AuxData)
 	if err != nil {
 		log.Error("Failed to extract auxData", zap.Error(err))
-		return nil, status.Error(codes.InvalidArgument, "failed to extract auxData")
+		return nil, NewError(codes.InvalidArgument, ErrCodeInvalidAuxData, "failed to extract auxData")
 	}
 
 	inputs := make([]*enginev1.CheckInput, len(req.Resource.Instances))
@@ -138,10 +272,13 @@ func (cs *CerbosService) CheckResourceSet(ctx context.Context, req *requestv1.Ch
 	outputs, err := cs.eng.Check(logging.ToContext(ctx, log), inputs)
 	if err != nil {
 		log.Error("Policy check failed", zap.Error(err))
+		if costErr := new(engine.CostLimitExceededError); errors.As(err, &costErr) {
+			return nil, NewErrorf(codes.ResourceExhausted, ErrCodeCostLimitExceeded, "Check failed: %v", costErr)
+		}
 		if errors.Is(err, compile.PolicyCompilationErr{}) {
-			return nil, status.Errorf(codes.FailedPrecondition, "Check failed due to invalid policy")
+			return nil, NewError(codes.FailedPrecondition, ErrCodeInvalidPolicy, "Check failed due to invalid policy")
 		}
-		return nil, status.Errorf(codes.Internal, "Policy check failed")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "Policy check failed")
 	}
 
 	result := newCheckResourceSetResponseBuilder(req)
@@ -161,10 +298,25 @@ func (cs *CerbosService) CheckResourceBatch(ctx context.Context, req *requestv1.
 		return nil, err
 	}
 
+	attrBytes := attrSize(req.Principal.GetAttr())
+	for _, res := range req.Resources {
+		attrBytes += attrSize(res.Resource.GetAttr())
+	}
+
+	if err := cs.checkPayloadSizeLimits(attrBytes, req.AuxData); err != nil {
+		log.Error("Request too large", zap.Error(err))
+		return nil, err
+	}
+
+	ctx, cs, err := cs.forRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	auxData, err := cs.auxData.Extract(ctx, req.AuxData)
 	if err != nil {
 		log.Error("Failed to extract auxData", zap.Error(err))
-		return nil, status.Error(codes.InvalidArgument, "failed to extract auxData")
+		return nil, NewError(codes.InvalidArgument, ErrCodeInvalidAuxData, "failed to extract auxData")
 	}
 
 	inputs := make([]*enginev1.CheckInput, len(req.Resources))
@@ -186,10 +338,13 @@ func (cs *CerbosService) CheckResourceBatch(ctx context.Context, req *requestv1.
 	outputs, err := cs.eng.Check(logging.ToContext(ctx, log), inputs)
 	if err != nil {
 		log.Error("Policy check failed", zap.Error(err))
+		if costErr := new(engine.CostLimitExceededError); errors.As(err, &costErr) {
+			return nil, NewErrorf(codes.ResourceExhausted, ErrCodeCostLimitExceeded, "Check failed: %v", costErr)
+		}
 		if errors.Is(err, compile.PolicyCompilationErr{}) {
-			return nil, status.Errorf(codes.FailedPrecondition, "Check failed due to invalid policy")
+			return nil, NewError(codes.FailedPrecondition, ErrCodeInvalidPolicy, "Check failed due to invalid policy")
 		}
-		return nil, status.Errorf(codes.Internal, "Policy check failed")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "Policy check failed")
 	}
 
 	result := &responsev1.CheckResourceBatchResponse{
@@ -213,6 +368,38 @@ func (cs *CerbosService) CheckResourceBatch(ctx context.Context, req *requestv1.
 	return result, nil
 }
 
+// withEngine returns a copy of the service that evaluates requests using the given engine.
+// The copy evaluates every request using that engine, even if the request is pinned to a store revision or identifies a tenant.
+func (cs *CerbosService) withEngine(eng *engine.Engine) *CerbosService {
+	clone := *cs
+	clone.eng = eng
+	clone.revisions = nil
+	clone.tenants = nil
+	return &clone
+}
+
+// requestPoliciesEngine creates an engine that evaluates the ad-hoc policies from the request layered over the policy store.
+// The engine doesn't write to the audit log because its decisions don't reflect the policies in the store.
+func (cs *CerbosService) requestPoliciesEngine(ctx context.Context, policies []*policyv1.Policy) (*engine.Engine, error) {
+	log := ctxzap.Extract(ctx)
+	if cs.requestPolicies == nil {
+		return nil, NewError(codes.Unimplemented, ErrCodeFeatureDisabled, "Ad-hoc request policies are not enabled")
+	}
+
+	if err := cs.checkRequestPoliciesLimit(policies); err != nil {
+		log.Error("Request too large", zap.Error(err))
+		return nil, err
+	}
+
+	loader, err := cs.requestPolicies.loader(policies)
+	if err != nil {
+		log.Error("Invalid request policies", zap.Error(err))
+		return nil, NewErrorf(codes.InvalidArgument, ErrCodeInvalidPolicy, "Invalid request policies: %v", err)
+	}
+
+	return cs.eng.WithPolicyLoader(loader), nil
+}
+
 // CheckResources checks a batch of heterogenous resources.
 func (cs *CerbosService) CheckResources(ctx context.Context, req *requestv1.CheckResourcesRequest) (*responsev1.CheckResourcesResponse, error) {
 	log := ctxzap.Extract(ctx)
@@ -221,10 +408,20 @@ func (cs *CerbosService) CheckResources(ctx context.Context, req *requestv1.Chec
 		return nil, err
 	}
 
+	if err := cs.checkPayloadSizeLimits(checkResourcesAttrSize(req.Principal, req.Resources), req.AuxData); err != nil {
+		log.Error("Request too large", zap.Error(err))
+		return nil, err
+	}
+
+	ctx, cs, err := cs.forRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	auxData, err := cs.auxData.Extract(ctx, req.AuxData)
 	if err != nil {
 		log.Error("Failed to extract auxData", zap.Error(err))
-		return nil, status.Error(codes.InvalidArgument, "failed to extract auxData")
+		return nil, NewError(codes.InvalidArgument, ErrCodeInvalidAuxData, "failed to extract auxData")
 	}
 
 	inputs := make([]*enginev1.CheckInput, len(req.Resources))
@@ -243,13 +440,26 @@ func (cs *CerbosService) CheckResources(ctx context.Context, req *requestv1.Chec
 		}
 	}
 
-	outputs, err := cs.eng.Check(logging.ToContext(ctx, log), inputs)
+	eng := cs.eng
+	if len(req.Policies) > 0 {
+		if eng, err = cs.requestPoliciesEngine(ctx, req.Policies); err != nil {
+			return nil, err
+		}
+	}
+
+	outputs, err := eng.Check(logging.ToContext(ctx, log), inputs)
 	if err != nil {
 		log.Error("Policy check failed", zap.Error(err))
+		if costErr := new(engine.CostLimitExceededError); errors.As(err, &costErr) {
+			return nil, NewErrorf(codes.ResourceExhausted, ErrCodeCostLimitExceeded, "Check failed: %v", costErr)
+		}
+		if rpErr := new(requestPolicyCompilationErr); errors.As(err, rpErr) {
+			return nil, NewErrorf(codes.InvalidArgument, ErrCodeInvalidPolicy, "Check failed due to invalid request policy: %v", rpErr.underlying)
+		}
 		if errors.Is(err, compile.PolicyCompilationErr{}) {
-			return nil, status.Errorf(codes.FailedPrecondition, "Check failed due to invalid policy")
+			return nil, NewError(codes.FailedPrecondition, ErrCodeInvalidPolicy, "Check failed due to invalid policy")
 		}
-		return nil, status.Errorf(codes.Internal, "Policy check failed")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "Policy check failed")
 	}
 
 	result := &responsev1.CheckResourcesResponse{
@@ -258,57 +468,258 @@ func (cs *CerbosService) CheckResources(ctx context.Context, req *requestv1.Chec
 	}
 
 	for i, out := range outputs {
-		resource := inputs[i].Resource
-		entry := &responsev1.CheckResourcesResponse_ResultEntry{
-			Resource: &responsev1.CheckResourcesResponse_ResultEntry_Resource{
-				Id:            resource.Id,
-				Kind:          resource.Kind,
-				PolicyVersion: resource.PolicyVersion,
-				Scope:         resource.Scope,
-			},
-			ValidationErrors: out.ValidationErrors,
-			Actions:          make(map[string]effectv1.Effect, len(out.Actions)),
-		}
+		result.Results[i] = mkCheckResourcesResultEntry(inputs[i].Resource, out, req.IncludeMeta)
+	}
+
+	return result, nil
+}
+
+// CheckResourcesStream checks the requests received on the stream and sends a response for each of them in the order they were received.
+// A failed check is reported in the response of the request instead of terminating the stream.
+func (cs *CerbosService) CheckResourcesStream(stream svcv1.CerbosService_CheckResourcesStreamServer) error {
+	ctx := stream.Context()
+	log := ctxzap.Extract(ctx)
 
-		if req.IncludeMeta {
-			entry.Meta = &responsev1.CheckResourcesResponse_ResultEntry_Meta{
-				EffectiveDerivedRoles: out.EffectiveDerivedRoles,
-				Actions:               make(map[string]*responsev1.CheckResourcesResponse_ResultEntry_Meta_EffectMeta, len(out.Actions)),
+	// the store revision is resolved once for the whole stream
+	ctx, cs, err := cs.forRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
 			}
+
+			log.Error("Error reading from stream", zap.Error(err))
+			return err
 		}
 
-		if len(out.Outputs) > 0 {
-			entry.Outputs = out.Outputs
+		response := &responsev1.CheckResourcesStreamResponse{Id: req.Id}
+		result, err := cs.CheckResources(ctx, req.Request)
+		if err != nil {
+			response.Error = status.Convert(err).Message()
+		} else {
+			response.Result = result
 		}
 
-		for action, actionEffect := range out.Actions {
-			entry.Actions[action] = actionEffect.Effect
-			if req.IncludeMeta {
-				entry.Meta.Actions[action] = &responsev1.CheckResourcesResponse_ResultEntry_Meta_EffectMeta{
-					MatchedPolicy: actionEffect.Policy,
-					MatchedScope:  actionEffect.Scope,
-				}
-			}
+		if err := stream.Send(response); err != nil {
+			log.Error("Error writing to stream", zap.Error(err))
+			return err
 		}
+	}
+}
 
-		result.Results[i] = entry
+// WatchDecisions checks a batch of resources and streams the results that change when policies or schemas are updated.
+func (cs *CerbosService) WatchDecisions(req *requestv1.WatchDecisionsRequest, stream svcv1.CerbosService_WatchDecisionsServer) error {
+	ctx := stream.Context()
+	log := ctxzap.Extract(ctx)
+	if !cs.watchDecisionsEnabled {
+		return NewError(codes.Unimplemented, ErrCodeFeatureDisabled, "WatchDecisions API is not enabled")
 	}
 
-	return result, nil
+	if pinnedRevision(ctx) != "" {
+		return NewError(codes.InvalidArgument, ErrCodeInvalidRequest, "Watched decisions follow the policy updates, so they can't be pinned to a store revision")
+	}
+
+	ctx, cs, err := cs.forTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := cs.checkNumResourcesLimit(len(req.Resources)); err != nil {
+		log.Error("Request too large", zap.Error(err))
+		return err
+	}
+
+	if err := cs.checkPayloadSizeLimits(checkResourcesAttrSize(req.Principal, req.Resources), req.AuxData); err != nil {
+		log.Error("Request too large", zap.Error(err))
+		return err
+	}
+
+	auxData, err := cs.auxData.Extract(ctx, req.AuxData)
+	if err != nil {
+		log.Error("Failed to extract auxData", zap.Error(err))
+		return NewError(codes.InvalidArgument, ErrCodeInvalidAuxData, "failed to extract auxData")
+	}
+
+	inputs := make([]*enginev1.CheckInput, len(req.Resources))
+	for i, res := range req.Resources {
+		if err := cs.checkNumActionsLimit(len(res.Actions)); err != nil {
+			log.Error("Request too large", zap.Error(err))
+			return err
+		}
+
+		inputs[i] = &enginev1.CheckInput{
+			RequestId: req.RequestId,
+			Actions:   res.Actions,
+			Principal: req.Principal,
+			Resource:  res.Resource,
+			AuxData:   auxData,
+		}
+	}
+
+	var sendErr error
+	send := func(response *responsev1.WatchDecisionsResponse) error {
+		if sendErr = stream.Send(response); sendErr != nil {
+			log.Error("Error writing to stream", zap.Error(sendErr))
+		}
+		return sendErr
+	}
+
+	err = cs.eng.WatchDecisions(logging.ToContext(ctx, log), inputs, func(changed []int, outputs []*enginev1.CheckOutput) error {
+		response := &responsev1.WatchDecisionsResponse{
+			RequestId: req.RequestId,
+			Results:   make([]*responsev1.CheckResourcesResponse_ResultEntry, len(changed)),
+		}
+
+		for i, idx := range changed {
+			response.Results[i] = mkCheckResourcesResultEntry(inputs[idx].Resource, outputs[idx], req.IncludeMeta)
+		}
+
+		return send(response)
+	}, func(err error) error {
+		log.Error("Failed to re-evaluate watched decisions", zap.Error(err))
+		return send(&responsev1.WatchDecisionsResponse{RequestId: req.RequestId, Error: watchErrorMessage(err)})
+	})
+	if err != nil {
+		if sendErr != nil {
+			return sendErr
+		}
+
+		log.Error("Watch decisions request failed", zap.Error(err))
+		switch {
+		case errors.Is(err, engine.ErrWatchUnsupported):
+			return NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Watching decisions is not supported by the configured store")
+		case errors.Is(err, compile.PolicyCompilationErr{}):
+			return NewError(codes.FailedPrecondition, ErrCodeInvalidPolicy, watchErrorMessage(err))
+		default:
+			return NewError(codes.Internal, ErrCodeInternal, watchErrorMessage(err))
+		}
+	}
+
+	return nil
+}
+
+func watchErrorMessage(err error) string {
+	if errors.Is(err, compile.PolicyCompilationErr{}) {
+		return "Check failed due to invalid policy"
+	}
+
+	return "Policy check failed"
+}
+
+func mkCheckResourcesResultEntry(resource *enginev1.Resource, out *enginev1.CheckOutput, includeMeta bool) *responsev1.CheckResourcesResponse_ResultEntry {
+	entry := &responsev1.CheckResourcesResponse_ResultEntry{
+		Resource: &responsev1.CheckResourcesResponse_ResultEntry_Resource{
+			Id:            resource.Id,
+			Kind:          resource.Kind,
+			PolicyVersion: resource.PolicyVersion,
+			Scope:         resource.Scope,
+		},
+		ValidationErrors: out.ValidationErrors,
+		Actions:          make(map[string]effectv1.Effect, len(out.Actions)),
+	}
+
+	if includeMeta {
+		entry.Meta = &responsev1.CheckResourcesResponse_ResultEntry_Meta{
+			EffectiveDerivedRoles: out.EffectiveDerivedRoles,
+			Actions:               make(map[string]*responsev1.CheckResourcesResponse_ResultEntry_Meta_EffectMeta, len(out.Actions)),
+		}
+	}
+
+	if len(out.Outputs) > 0 {
+		entry.Outputs = out.Outputs
+	}
+
+	for action, actionEffect := range out.Actions {
+		entry.Actions[action] = actionEffect.Effect
+		if includeMeta {
+			entry.Meta.Actions[action] = &responsev1.CheckResourcesResponse_ResultEntry_Meta_EffectMeta{
+				MatchedPolicy: actionEffect.Policy,
+				MatchedScope:  actionEffect.Scope,
+			}
+		}
+	}
+
+	return entry
 }
 
 func (cs *CerbosService) checkNumResourcesLimit(n int) error {
 	if n > int(cs.reqLimits.MaxResourcesPerRequest) {
-		return status.Errorf(codes.InvalidArgument,
+		return NewErrorf(codes.InvalidArgument, ErrCodeRequestLimitExceeded,
 			"number of resources in batch (%d) exceeds configured limit (%d)", n, cs.reqLimits.MaxResourcesPerRequest)
 	}
 
 	return nil
 }
 
+func (cs *CerbosService) checkNumPlanEntriesLimit(n int) error {
+	if n > int(cs.reqLimits.MaxPlanEntriesPerRequest) {
+		return NewErrorf(codes.InvalidArgument, ErrCodeRequestLimitExceeded,
+			"number of entries (%d) exceeds configured limit (%d)", n, cs.reqLimits.MaxPlanEntriesPerRequest)
+	}
+
+	return nil
+}
+
+func (cs *CerbosService) checkRequestPoliciesLimit(policies []*policyv1.Policy) error {
+	if n := len(policies); n > int(cs.reqLimits.MaxPoliciesPerRequest) {
+		return NewErrorf(codes.InvalidArgument, ErrCodeRequestLimitExceeded,
+			"number of request policies (%d) exceeds configured limit (%d)", n, cs.reqLimits.MaxPoliciesPerRequest)
+	}
+
+	size := 0
+	for _, p := range policies {
+		size += p.SizeVT()
+	}
+
+	if size > int(cs.reqLimits.MaxPolicyBytesPerRequest) {
+		return NewErrorf(codes.InvalidArgument, ErrCodeRequestLimitExceeded,
+			"size of request policies (%d bytes) exceeds configured limit (%d bytes)", size, cs.reqLimits.MaxPolicyBytesPerRequest)
+	}
+
+	return nil
+}
+
+// checkPayloadSizeLimits checks the combined size of the attributes and the size of the auxData sent in a request.
+func (cs *CerbosService) checkPayloadSizeLimits(attrBytes int, auxData *requestv1.AuxData) error {
+	if attrBytes > int(cs.reqLimits.MaxAttributeBytesPerRequest) {
+		return NewErrorf(codes.InvalidArgument, ErrCodeRequestLimitExceeded,
+			"size of attributes (%d bytes) exceeds configured limit (%d bytes)", attrBytes, cs.reqLimits.MaxAttributeBytesPerRequest)
+	}
+
+	if size := auxData.SizeVT(); size > int(cs.reqLimits.MaxAuxDataBytesPerRequest) {
+		return NewErrorf(codes.InvalidArgument, ErrCodeRequestLimitExceeded,
+			"size of auxData (%d bytes) exceeds configured limit (%d bytes)", size, cs.reqLimits.MaxAuxDataBytesPerRequest)
+	}
+
+	return nil
+}
+
+// attrSize returns the encoded size of the attributes in bytes.
+func attrSize(attr map[string]*structpb.Value) int {
+	if len(attr) == 0 {
+		return 0
+	}
+
+	return proto.Size(&structpb.Struct{Fields: attr})
+}
+
+func checkResourcesAttrSize(principal *enginev1.Principal, resources []*requestv1.CheckResourcesRequest_ResourceEntry) int {
+	size := attrSize(principal.GetAttr())
+	for _, res := range resources {
+		size += attrSize(res.Resource.GetAttr())
+	}
+
+	return size
+}
+
 func (cs *CerbosService) checkNumActionsLimit(n int) error {
 	if n > int(cs.reqLimits.MaxActionsPerResource) {
-		return status.Errorf(codes.InvalidArgument,
+		return NewErrorf(codes.InvalidArgument, ErrCodeRequestLimitExceeded,
 			"number of actions (%d) exceeds configured limit (%d)", n, cs.reqLimits.MaxActionsPerResource)
 	}
 
@@ -322,3 +733,26 @@ func (CerbosService) ServerInfo(_ context.Context, _ *requestv1.ServerInfoReques
 		BuildDate: util.BuildDate,
 	}, nil
 }
+
+// ListResourceKinds lists the resource kinds, policy versions, scopes and actions defined by the policies in the store.
+func (cs *CerbosService) ListResourceKinds(ctx context.Context, _ *requestv1.ListResourceKindsRequest) (*responsev1.ListResourceKindsResponse, error) {
+	ctx, cs, err := cs.forTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if cs.resourceKinds == nil {
+		return nil, NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Listing resource kinds is not supported")
+	}
+
+	kinds, err := cs.resourceKinds.List(ctx)
+	if err != nil {
+		ctxzap.Extract(ctx).Error("Failed to list resource kinds", zap.Error(err))
+		if errors.Is(err, compile.PolicyCompilationErr{}) {
+			return nil, NewError(codes.FailedPrecondition, ErrCodeInvalidPolicy, "Failed to list resource kinds due to invalid policy")
+		}
+		return nil, NewError(codes.Internal, ErrCodeInternal, "Failed to list resource kinds")
+	}
+
+	return &responsev1.ListResourceKindsResponse{ResourceKinds: kinds}, nil
+}
@@ -0,0 +1,207 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build tests
+// +build tests
+
+package svc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	responsev1 "github.com/cerbos/cerbos/api/genpb/cerbos/response/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/auxdata"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+func TestCheckResourcesStream(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	store, err := disk.NewStore(ctx, &disk.Conf{Directory: test.PathToDir(t, "store")})
+	require.NoError(t, err)
+
+	schemaMgr := schema.NewFromConf(ctx, store, schema.NewConf(schema.EnforcementNone))
+	eng, err := engine.New(ctx, engine.Components{
+		PolicyLoader: compile.NewManagerFromDefaultConf(ctx, store, schemaMgr),
+		SchemaMgr:    schemaMgr,
+		AuditLog:     audit.NewNopLog(),
+	})
+	require.NoError(t, err)
+
+	reqLimits := RequestLimits{MaxActionsPerResource: 5, MaxResourcesPerRequest: 1}
+	cs := NewCerbosService(eng, auxdata.NewFromConf(ctx, &auxdata.Conf{}), reqLimits, false, nil, nil, nil, nil)
+
+	mkRequest := func(id string, resourceIDs ...string) *requestv1.CheckResourcesStreamRequest {
+		req := &requestv1.CheckResourcesRequest{
+			RequestId: id,
+			Principal: &enginev1.Principal{Id: "john", Roles: []string{"employee"}},
+		}
+
+		for _, resourceID := range resourceIDs {
+			req.Resources = append(req.Resources, &requestv1.CheckResourcesRequest_ResourceEntry{
+				Actions:  []string{"view:public", "approve"},
+				Resource: &enginev1.Resource{Kind: "leave_request", PolicyVersion: "20210210", Id: resourceID},
+			})
+		}
+
+		return &requestv1.CheckResourcesStreamRequest{Id: id, Request: req}
+	}
+
+	t.Run("responds_in_order", func(t *testing.T) {
+		stream := &checkResourcesStream{
+			ctx: ctx,
+			requests: []*requestv1.CheckResourcesStreamRequest{
+				mkRequest("first", "XX125"),
+				mkRequest("too_large", "XX125", "XX126"),
+				mkRequest("last", "XX127"),
+			},
+		}
+		require.NoError(t, cs.CheckResourcesStream(stream))
+		require.Len(t, stream.responses, 3)
+
+		require.Equal(t, "first", stream.responses[0].Id)
+		require.Empty(t, stream.responses[0].Error)
+		require.Equal(t, "XX125", stream.responses[0].Result.Results[0].Resource.Id)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, stream.responses[0].Result.Results[0].Actions["view:public"])
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, stream.responses[0].Result.Results[0].Actions["approve"])
+
+		// a failed check doesn't terminate the stream
+		require.Equal(t, "too_large", stream.responses[1].Id)
+		require.Nil(t, stream.responses[1].Result)
+		require.NotEmpty(t, stream.responses[1].Error)
+
+		require.Equal(t, "last", stream.responses[2].Id)
+		require.Empty(t, stream.responses[2].Error)
+		require.Equal(t, "XX127", stream.responses[2].Result.Results[0].Resource.Id)
+	})
+
+	t.Run("receive_error", func(t *testing.T) {
+		recvErr := errors.New("connection reset")
+		stream := &checkResourcesStream{ctx: ctx, requests: []*requestv1.CheckResourcesStreamRequest{mkRequest("first", "XX125")}, recvErr: recvErr}
+		require.ErrorIs(t, cs.CheckResourcesStream(stream), recvErr)
+		require.Len(t, stream.responses, 1)
+	})
+}
+
+type checkResourcesStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	recvErr   error
+	requests  []*requestv1.CheckResourcesStreamRequest
+	responses []*responsev1.CheckResourcesStreamResponse
+}
+
+func (s *checkResourcesStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *checkResourcesStream) Recv() (*requestv1.CheckResourcesStreamRequest, error) {
+	if len(s.requests) == 0 {
+		if s.recvErr != nil {
+			return nil, s.recvErr
+		}
+		return nil, io.EOF
+	}
+
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *checkResourcesStream) Send(resp *responsev1.CheckResourcesStreamResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func TestPayloadSizeLimits(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	store, err := disk.NewStore(ctx, &disk.Conf{Directory: test.PathToDir(t, "store")})
+	require.NoError(t, err)
+
+	schemaMgr := schema.NewFromConf(ctx, store, schema.NewConf(schema.EnforcementNone))
+	eng, err := engine.New(ctx, engine.Components{
+		PolicyLoader: compile.NewManagerFromDefaultConf(ctx, store, schemaMgr),
+		SchemaMgr:    schemaMgr,
+		AuditLog:     audit.NewNopLog(),
+	})
+	require.NoError(t, err)
+
+	reqLimits := RequestLimits{
+		MaxActionsPerResource:       5,
+		MaxResourcesPerRequest:      5,
+		MaxAttributeBytesPerRequest: 64,
+		MaxAuxDataBytesPerRequest:   64,
+	}
+	cs := NewCerbosService(eng, auxdata.NewFromConf(ctx, &auxdata.Conf{}), reqLimits, false, nil, nil, nil, nil)
+
+	mkRequest := func(attrValue string, auxData *requestv1.AuxData) *requestv1.CheckResourcesRequest {
+		return &requestv1.CheckResourcesRequest{
+			RequestId: "test",
+			Principal: &enginev1.Principal{Id: "john", Roles: []string{"employee"}},
+			Resources: []*requestv1.CheckResourcesRequest_ResourceEntry{
+				{
+					Actions: []string{"view:public"},
+					Resource: &enginev1.Resource{
+						Kind:          "leave_request",
+						PolicyVersion: "20210210",
+						Id:            "XX125",
+						Attr:          map[string]*structpb.Value{"note": structpb.NewStringValue(attrValue)},
+					},
+				},
+			},
+			AuxData: auxData,
+		}
+	}
+
+	requireLimitExceeded := func(t *testing.T, err error) {
+		t.Helper()
+
+		st := status.Convert(err)
+		require.Equal(t, codes.InvalidArgument, st.Code())
+		require.Equal(t, ErrCodeRequestLimitExceeded, ErrorCodeOf(st))
+	}
+
+	t.Run("within_limits", func(t *testing.T) {
+		_, err := cs.CheckResources(ctx, mkRequest("short", nil))
+		require.NoError(t, err)
+	})
+
+	t.Run("attributes_too_large", func(t *testing.T) {
+		_, err := cs.CheckResources(ctx, mkRequest(strings.Repeat("x", 100), nil))
+		requireLimitExceeded(t, err)
+
+		_, err = cs.PlanResources(ctx, &requestv1.PlanResourcesRequest{
+			RequestId: "test",
+			Action:    "view:public",
+			Principal: &enginev1.Principal{Id: "john", Roles: []string{"employee"}, Attr: map[string]*structpb.Value{"note": structpb.NewStringValue(strings.Repeat("x", 100))}},
+			Resource:  &enginev1.PlanResourcesInput_Resource{Kind: "leave_request", PolicyVersion: "20210210"},
+		})
+		requireLimitExceeded(t, err)
+	})
+
+	t.Run("aux_data_too_large", func(t *testing.T) {
+		_, err := cs.CheckResources(ctx, mkRequest("short", &requestv1.AuxData{Jwt: &requestv1.AuxData_JWT{Token: strings.Repeat("x", 100)}}))
+		requireLimitExceeded(t, err)
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package svc
+
+import (
+	"sort"
+
+	responsev1 "github.com/cerbos/cerbos/api/genpb/cerbos/response/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+// effectiveRules returns the rules that can produce a decision for requests made at each scope of the policy set.
+// The engine evaluates the policies from the most specific scope to the root and stops as soon as an action has an effect,
+// so the actions that a more specific scope always decides for the roles of a rule are dropped from the inherited rule.
+func effectiveRules(rps *runtimev1.RunnableResourcePolicySet) []*responsev1.InspectEffectiveRulesResponse_Scope {
+	scopes := make([]*responsev1.InspectEffectiveRulesResponse_Scope, len(rps.Policies))
+	for i, p := range rps.Policies {
+		scope := &responsev1.InspectEffectiveRulesResponse_Scope{Scope: p.Scope}
+		for j := i; j < len(rps.Policies); j++ {
+			for _, rule := range rps.Policies[j].Rules {
+				actions := undecidedActions(rule, rps.Policies[i:j])
+				if len(actions) == 0 {
+					continue
+				}
+
+				scope.Rules = append(scope.Rules, &responsev1.InspectEffectiveRulesResponse_Rule{
+					Name:         rule.Name,
+					Scope:        rps.Policies[j].Scope,
+					Actions:      actions,
+					Roles:        sortedKeys(rule.Roles),
+					DerivedRoles: sortedKeys(rule.DerivedRoles),
+					Effect:       rule.Effect,
+					Conditional:  rule.Condition != nil,
+				})
+			}
+		}
+		scopes[i] = scope
+	}
+
+	return scopes
+}
+
+// undecidedActions returns the actions of the rule that are not always decided by the given (more specific) policies.
+func undecidedActions(rule *runtimev1.RunnableResourcePolicySet_Policy_Rule, overrides []*runtimev1.RunnableResourcePolicySet_Policy) []string {
+	var actions []string
+	for _, action := range sortedKeys(rule.Actions) {
+		if !isDecided(action, rule, overrides) {
+			actions = append(actions, action)
+		}
+	}
+
+	return actions
+}
+
+// isDecided returns true if any of the policies has an unconditional rule that covers the action for all the roles of the given rule.
+func isDecided(action string, rule *runtimev1.RunnableResourcePolicySet_Policy_Rule, policies []*runtimev1.RunnableResourcePolicySet_Policy) bool {
+	for _, p := range policies {
+		for _, r := range p.Rules {
+			if r.Condition != nil || !coversRoles(r, rule) {
+				continue
+			}
+
+			for actionGlob := range r.Actions {
+				if actionGlob == action || util.MatchesGlob(actionGlob, action) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// coversRoles returns true if every principal that can activate rule can also activate r.
+func coversRoles(r, rule *runtimev1.RunnableResourcePolicySet_Policy_Rule) bool {
+	if _, ok := r.Roles[compile.AnyRoleVal]; ok {
+		return true
+	}
+
+	// derived roles are activated by conditions, so only a rule matching any role is guaranteed to cover them.
+	if len(rule.DerivedRoles) > 0 || len(rule.Roles) == 0 {
+		return false
+	}
+
+	for role := range rule.Roles {
+		if _, ok := r.Roles[role]; !ok || role == compile.AnyRoleVal {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	if len(m) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
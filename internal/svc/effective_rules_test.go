@@ -0,0 +1,123 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build tests
+// +build tests
+
+package svc
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	responsev1 "github.com/cerbos/cerbos/api/genpb/cerbos/response/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+)
+
+func TestEffectiveRules(t *testing.T) {
+	rule := func(name string, effect effectv1.Effect, conditional bool, roles, derivedRoles []string, actions ...string) *runtimev1.RunnableResourcePolicySet_Policy_Rule {
+		r := &runtimev1.RunnableResourcePolicySet_Policy_Rule{
+			Name:         name,
+			Actions:      set(actions),
+			Roles:        set(roles),
+			DerivedRoles: set(derivedRoles),
+			Effect:       effect,
+		}
+		if conditional {
+			r.Condition = &runtimev1.Condition{Op: &runtimev1.Condition_Expr{Expr: &runtimev1.Expr{Original: "true"}}}
+		}
+		return r
+	}
+
+	allow, deny := effectv1.Effect_EFFECT_ALLOW, effectv1.Effect_EFFECT_DENY
+	rps := &runtimev1.RunnableResourcePolicySet{
+		Meta: &runtimev1.RunnableResourcePolicySet_Metadata{Fqn: "cerbos.resource.leave_request.vdefault/acme.hr"},
+		Policies: []*runtimev1.RunnableResourcePolicySet_Policy{
+			{
+				Scope: "acme.hr",
+				Rules: []*runtimev1.RunnableResourcePolicySet_Policy_Rule{
+					rule("hr_deny_delete", deny, false, []string{"*"}, nil, "delete"),
+				},
+			},
+			{
+				Scope: "acme",
+				Rules: []*runtimev1.RunnableResourcePolicySet_Policy_Rule{
+					rule("acme_view", allow, false, []string{"user", "admin"}, nil, "view:*"),
+					rule("acme_approve", allow, true, []string{"manager"}, nil, "approve"),
+				},
+			},
+			{
+				Scope: "",
+				Rules: []*runtimev1.RunnableResourcePolicySet_Policy_Rule{
+					rule("root_user", allow, false, []string{"user"}, nil, "view:public", "delete", "create"),
+					rule("root_approve", deny, false, []string{"manager"}, nil, "approve"),
+					rule("root_owner", allow, false, nil, []string{"owner"}, "view:private"),
+				},
+			},
+		},
+	}
+
+	effRule := func(name, scope string, effect effectv1.Effect, conditional bool, roles, derivedRoles []string, actions ...string) *responsev1.InspectEffectiveRulesResponse_Rule {
+		return &responsev1.InspectEffectiveRulesResponse_Rule{
+			Name:         name,
+			Scope:        scope,
+			Actions:      actions,
+			Roles:        roles,
+			DerivedRoles: derivedRoles,
+			Effect:       effect,
+			Conditional:  conditional,
+		}
+	}
+
+	want := []*responsev1.InspectEffectiveRulesResponse_Scope{
+		{
+			Scope: "acme.hr",
+			Rules: []*responsev1.InspectEffectiveRulesResponse_Rule{
+				effRule("hr_deny_delete", "acme.hr", deny, false, []string{"*"}, nil, "delete"),
+				effRule("acme_view", "acme", allow, false, []string{"admin", "user"}, nil, "view:*"),
+				effRule("acme_approve", "acme", allow, true, []string{"manager"}, nil, "approve"),
+				effRule("root_user", "", allow, false, []string{"user"}, nil, "create"),
+				effRule("root_approve", "", deny, false, []string{"manager"}, nil, "approve"),
+				effRule("root_owner", "", allow, false, nil, []string{"owner"}, "view:private"),
+			},
+		},
+		{
+			Scope: "acme",
+			Rules: []*responsev1.InspectEffectiveRulesResponse_Rule{
+				effRule("acme_view", "acme", allow, false, []string{"admin", "user"}, nil, "view:*"),
+				effRule("acme_approve", "acme", allow, true, []string{"manager"}, nil, "approve"),
+				effRule("root_user", "", allow, false, []string{"user"}, nil, "create", "delete"),
+				effRule("root_approve", "", deny, false, []string{"manager"}, nil, "approve"),
+				effRule("root_owner", "", allow, false, nil, []string{"owner"}, "view:private"),
+			},
+		},
+		{
+			Scope: "",
+			Rules: []*responsev1.InspectEffectiveRulesResponse_Rule{
+				effRule("root_user", "", allow, false, []string{"user"}, nil, "create", "delete", "view:public"),
+				effRule("root_approve", "", deny, false, []string{"manager"}, nil, "approve"),
+				effRule("root_owner", "", allow, false, nil, []string{"owner"}, "view:private"),
+			},
+		},
+	}
+
+	have := effectiveRules(rps)
+	require.Empty(t, cmp.Diff(want, have, protocmp.Transform()))
+}
+
+func set(values []string) map[string]*emptypb.Empty {
+	if len(values) == 0 {
+		return nil
+	}
+
+	m := make(map[string]*emptypb.Empty, len(values))
+	for _, v := range values {
+		m[v] = &emptypb.Empty{}
+	}
+	return m
+}
@@ -0,0 +1,103 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package svc
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the domain of the google.rpc.ErrorInfo details attached to the errors returned by the Cerbos APIs.
+const ErrorDomain = "cerbos.dev"
+
+// ErrorCode is a stable, machine-readable identifier of the cause of an error returned by the Cerbos APIs.
+// It is sent as the reason of the google.rpc.ErrorInfo detail of the gRPC status and as the `code` member of HTTP problem responses.
+type ErrorCode string
+
+const (
+	ErrCodeAuthenticationFailed  ErrorCode = "authentication_failed"
+	ErrCodeCostLimitExceeded     ErrorCode = "cost_limit_exceeded"
+	ErrCodeFeatureDisabled       ErrorCode = "feature_disabled"
+	ErrCodeInternal              ErrorCode = "internal_error"
+	ErrCodeInvalidAuxData        ErrorCode = "invalid_aux_data"
+	ErrCodeInvalidPolicy         ErrorCode = "invalid_policy"
+	ErrCodeInvalidRequest        ErrorCode = "invalid_request"
+	ErrCodeNotFound              ErrorCode = "not_found"
+	ErrCodePermissionDenied      ErrorCode = "permission_denied"
+	ErrCodeRateLimited           ErrorCode = "rate_limited"
+	ErrCodeRequestLimitExceeded  ErrorCode = "request_limit_exceeded"
+	ErrCodeSchemaValidationError ErrorCode = "schema_validation_failed"
+	ErrCodeServerOverloaded      ErrorCode = "server_overloaded"
+	ErrCodeStoreUnavailable      ErrorCode = "store_unavailable"
+	ErrCodeUnsupportedOperation  ErrorCode = "unsupported_operation"
+)
+
+// NewError creates a gRPC status error with the given code and message and attaches the error code as an ErrorInfo detail.
+func NewError(c codes.Code, errCode ErrorCode, msg string) error {
+	st, err := status.New(c, msg).WithDetails(&errdetails.ErrorInfo{Reason: string(errCode), Domain: ErrorDomain})
+	if err != nil {
+		return status.Error(c, msg)
+	}
+
+	return st.Err()
+}
+
+// NewErrorf is like NewError but formats the message according to the format specifier.
+func NewErrorf(c codes.Code, errCode ErrorCode, format string, args ...any) error {
+	return NewError(c, errCode, fmt.Sprintf(format, args...))
+}
+
+// ErrorCodeOf returns the error code of the given status.
+// If the status doesn't have an ErrorInfo detail from the Cerbos domain, the error code is derived from the gRPC code.
+func ErrorCodeOf(st *status.Status) ErrorCode {
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Domain == ErrorDomain {
+			return ErrorCode(info.Reason)
+		}
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return ErrCodeInvalidRequest
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return ErrCodeAuthenticationFailed
+	case codes.NotFound:
+		return ErrCodeNotFound
+	case codes.Unimplemented:
+		return ErrCodeUnsupportedOperation
+	case codes.FailedPrecondition:
+		return ErrCodeInvalidPolicy
+	case codes.ResourceExhausted:
+		return ErrCodeRequestLimitExceeded
+	case codes.Unavailable:
+		return ErrCodeStoreUnavailable
+	default:
+		return ErrCodeInternal
+	}
+}
+
+// WithErrorInfo returns an error equivalent to err that has an ErrorInfo detail from the Cerbos domain.
+// Errors that are not gRPC status errors or already have an ErrorInfo detail are returned unchanged.
+func WithErrorInfo(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() == codes.OK || st.Code() == codes.Canceled || st.Code() == codes.DeadlineExceeded {
+		return err
+	}
+
+	for _, d := range st.Details() {
+		if _, ok := d.(*errdetails.ErrorInfo); ok {
+			return err
+		}
+	}
+
+	withInfo, detailsErr := st.WithDetails(&errdetails.ErrorInfo{Reason: string(ErrorCodeOf(st)), Domain: ErrorDomain})
+	if detailsErr != nil {
+		return err
+	}
+
+	return withInfo.Err()
+}
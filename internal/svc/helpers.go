@@ -35,6 +35,16 @@ func ExtractRequestFields(fullMethod string, req any) map[string]any {
 			metaTagKey: map[string]string{requestIDTagKey: crsReq.RequestId},
 		}
 
+	case "/cerbos.svc.v1.CerbosService/WatchDecisions":
+		wdReq, ok := req.(*requestv1.WatchDecisionsRequest)
+		if !ok || wdReq.RequestId == "" {
+			return nil
+		}
+
+		return map[string]any{
+			metaTagKey: map[string]string{requestIDTagKey: wdReq.RequestId},
+		}
+
 	case "/cerbos.svc.v1.CerbosService/CheckResourceSet":
 		crsReq, ok := req.(*requestv1.CheckResourceSetRequest)
 		if !ok || crsReq.RequestId == "" {
@@ -65,6 +75,16 @@ func ExtractRequestFields(fullMethod string, req any) map[string]any {
 			metaTagKey: map[string]string{requestIDTagKey: plReq.RequestId},
 		}
 
+	case "/cerbos.svc.v1.CerbosService/PlanResourcesStream":
+		plsReq, ok := req.(*requestv1.PlanResourcesStreamRequest)
+		if !ok || plsReq.RequestId == "" {
+			return nil
+		}
+
+		return map[string]any{
+			metaTagKey: map[string]string{requestIDTagKey: plsReq.RequestId},
+		}
+
 	case "/cerbos.svc.v1.CerbosPlaygroundService/PlaygroundValidate":
 		pgReq, ok := req.(*requestv1.PlaygroundValidateRequest)
 		if !ok || pgReq.PlaygroundId == "" {
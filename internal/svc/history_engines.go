@@ -0,0 +1,101 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package svc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bluele/gcache"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+const historyEngineCacheSize = 16
+
+// historyEngines caches the engines used to evaluate requests against the store history.
+// Revisions never change, so an engine can be reused by every request for the same revision.
+type historyEngines struct {
+	cache gcache.Cache
+	sf    singleflight.Group
+}
+
+type historyEngine struct {
+	eng        *engine.Engine
+	cancelFunc context.CancelFunc
+}
+
+func newHistoryEngines() *historyEngines {
+	return &historyEngines{
+		cache: gcache.New(historyEngineCacheSize).
+			LRU().
+			EvictedFunc(func(_, value any) {
+				// stop the background work of the managers backing the evicted engine
+				value.(*historyEngine).cancelFunc() //nolint:forcetypeassert
+			}).
+			Build(),
+	}
+}
+
+// get returns the engine for the given point of the store history and the store revision it resolves to.
+func (he *historyEngines) get(ctx context.Context, hs storage.Historical, point storage.HistoryPoint) (*engine.Engine, string, error) {
+	if point.Revision != "" {
+		if entry, err := he.cache.GetIFPresent(point.Revision); err == nil {
+			return entry.(*historyEngine).eng, point.Revision, nil //nolint:forcetypeassert
+		}
+	}
+
+	store, revision, err := hs.AsOf(ctx, point)
+	if err != nil {
+		return nil, "", err
+	}
+
+	entry, err, _ := he.sf.Do(revision, func() (any, error) {
+		if entry, err := he.cache.GetIFPresent(revision); err == nil {
+			return entry, nil
+		}
+
+		entry, err := newHistoryEngine(store)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create engine for revision %s: %w", revision, err)
+		}
+
+		_ = he.cache.Set(revision, entry)
+		return entry, nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return entry.(*historyEngine).eng, revision, nil //nolint:forcetypeassert
+}
+
+func newHistoryEngine(store storage.SourceStore) (*historyEngine, error) {
+	// the managers outlive the request that created them, so they are bound to the lifetime of the cache entry instead.
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
+	schemaMgr, err := schema.New(ctx, store)
+	if err != nil {
+		cancelFunc()
+		return nil, fmt.Errorf("failed to create schema manager: %w", err)
+	}
+
+	compileMgr, err := compile.NewManager(ctx, store, schemaMgr)
+	if err != nil {
+		cancelFunc()
+		return nil, fmt.Errorf("failed to create compile manager: %w", err)
+	}
+
+	eng, err := engine.NewEphemeral(compileMgr, schemaMgr)
+	if err != nil {
+		cancelFunc()
+		return nil, err
+	}
+
+	return &historyEngine{eng: eng, cancelFunc: cancelFunc}, nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build tests
+// +build tests
+
+package svc
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/db/sqlite3"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+func TestHistoryEngines(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	store, err := sqlite3.NewStore(ctx, &sqlite3.Conf{DSN: fmt.Sprintf("%s?_fk=true", filepath.Join(t.TempDir(), "cerbos.db"))})
+	require.NoError(t, err)
+
+	rp := test.NewResourcePolicyBuilder("leave_request", "default").
+		WithRules(test.NewResourceRule("view").WithRoles("user").WithEffect(effectv1.Effect_EFFECT_ALLOW).Build()).
+		Build()
+	p := policy.Wrap(rp)
+	require.NoError(t, store.AddOrUpdate(ctx, p))
+
+	he := newHistoryEngines()
+
+	eng, revision, err := he.get(ctx, store, storage.HistoryPoint{Time: time.Now().Add(1 * time.Minute)})
+	require.NoError(t, err)
+	require.NotNil(t, eng)
+
+	t.Run("same_revision", func(t *testing.T) {
+		have, haveRevision, err := he.get(ctx, store, storage.HistoryPoint{Revision: revision})
+		require.NoError(t, err)
+		require.Equal(t, revision, haveRevision)
+		require.Same(t, eng, have)
+	})
+
+	t.Run("same_time", func(t *testing.T) {
+		have, haveRevision, err := he.get(ctx, store, storage.HistoryPoint{Time: time.Now().Add(1 * time.Minute)})
+		require.NoError(t, err)
+		require.Equal(t, revision, haveRevision)
+		require.Same(t, eng, have)
+	})
+
+	t.Run("new_revision", func(t *testing.T) {
+		require.NoError(t, store.Delete(ctx, p.ID))
+
+		have, haveRevision, err := he.get(ctx, store, storage.HistoryPoint{Time: time.Now().Add(1 * time.Minute)})
+		require.NoError(t, err)
+		require.NotEqual(t, revision, haveRevision)
+		require.NotSame(t, eng, have)
+	})
+
+	t.Run("unknown_revision", func(t *testing.T) {
+		_, _, err := he.get(ctx, store, storage.HistoryPoint{Time: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)})
+		require.ErrorIs(t, err, storage.ErrRevisionNotFound)
+	})
+}
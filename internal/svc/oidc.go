@@ -0,0 +1,241 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package svc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/httprc"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"go.uber.org/zap"
+
+	"github.com/cerbos/cerbos/internal/observability/logging"
+)
+
+const (
+	oidcDiscoveryPath    = "/.well-known/openid-configuration"
+	oidcDiscoveryTimeout = 10 * time.Second
+	// oidcAcceptableSkew allows for small differences between the clocks of the identity provider and the server.
+	oidcAcceptableSkew = 1 * time.Minute
+)
+
+var errTokenRoleMissing = errors.New("token does not have any of the required roles")
+
+// OIDCSettings defines how the bearer tokens issued by an OpenID Connect provider are verified.
+type OIDCSettings struct {
+	// Issuer is the URL of the provider. It must match the iss claim of the tokens.
+	Issuer string
+	// JWKSURL is the URL of the keys used to sign the tokens. It's discovered from the issuer if it's empty.
+	JWKSURL string
+	// RoleClaim is the path to the claim that holds the roles of the user, with nested claims separated by dots.
+	RoleClaim string
+	// UserClaim is the claim that identifies the user in the audit logs and the store change history.
+	UserClaim string
+	// Audience is the list of audiences that are accepted. The aud claim of the tokens must contain one of them.
+	Audience []string
+	// Roles is the list of roles that are allowed to use the Admin API. Any valid token is accepted if it's empty.
+	Roles []string
+}
+
+// OIDCVerifier verifies bearer tokens issued by an OpenID Connect provider.
+type OIDCVerifier struct {
+	keys     *jwk.Cache
+	log      *zap.Logger
+	client   *http.Client
+	jwksURL  string
+	settings OIDCSettings
+	mu       sync.Mutex
+}
+
+func NewOIDCVerifier(ctx context.Context, settings OIDCSettings) *OIDCVerifier {
+	log := logging.FromContext(ctx).Named("oidc")
+	errSink := func(err error) {
+		log.Warn("Error refreshing keyset", zap.Error(err))
+	}
+
+	return &OIDCVerifier{
+		keys:     jwk.NewCache(ctx, jwk.WithErrSink(httprc.ErrSinkFunc(errSink))),
+		log:      log,
+		client:   &http.Client{Timeout: oidcDiscoveryTimeout},
+		settings: settings,
+	}
+}
+
+// Verify checks the signature, issuer, audience, expiry and roles of the token and returns the user it was issued to.
+func (v *OIDCVerifier) Verify(ctx context.Context, token string) (string, error) {
+	jwksURL, err := v.keySetURL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	keys, err := v.keys.Get(ctx, jwksURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve keyset: %w", err)
+	}
+
+	opts := []jwt.ParseOption{
+		// Some providers don't include the algorithm in their keys.
+		jwt.WithKeySet(keys, jws.WithInferAlgorithmFromKey(true)),
+		jwt.WithValidate(true),
+		jwt.WithIssuer(v.settings.Issuer),
+		jwt.WithAcceptableSkew(oidcAcceptableSkew),
+	}
+
+	if len(v.settings.Audience) > 0 {
+		opts = append(opts, jwt.WithValidator(audienceValidator(v.settings.Audience)))
+	}
+
+	parsed, err := jwt.ParseString(token, opts...)
+	if err != nil {
+		return "", fmt.Errorf("invalid token: %w", err)
+	}
+
+	user := parsed.Subject()
+	if v.settings.UserClaim != "" {
+		if u, ok := parsed.Get(v.settings.UserClaim); ok {
+			if s, ok := u.(string); ok && s != "" {
+				user = s
+			}
+		}
+	}
+
+	if len(v.settings.Roles) == 0 {
+		return user, nil
+	}
+
+	for _, role := range tokenRoles(parsed, v.settings.RoleClaim) {
+		for _, want := range v.settings.Roles {
+			if role == want {
+				return user, nil
+			}
+		}
+	}
+
+	return "", errTokenRoleMissing
+}
+
+// keySetURL returns the configured JWKS URL or discovers it from the issuer.
+// Discovery is retried on the next request if it fails, so that the server can start while the provider is unavailable.
+func (v *OIDCVerifier) keySetURL(ctx context.Context) (string, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.jwksURL != "" {
+		return v.jwksURL, nil
+	}
+
+	jwksURL := v.settings.JWKSURL
+	if jwksURL == "" {
+		discovered, err := v.discover(ctx)
+		if err != nil {
+			return "", err
+		}
+		jwksURL = discovered
+	}
+
+	if err := v.keys.Register(jwksURL); err != nil {
+		return "", fmt.Errorf("failed to register keyset: %w", err)
+	}
+
+	v.jwksURL = jwksURL
+	return jwksURL, nil
+}
+
+func (v *OIDCVerifier) discover(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(v.settings.Issuer, "/")+oidcDiscoveryPath, http.NoBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to create OIDC discovery request: %w", err)
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to discover OIDC configuration: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to discover OIDC configuration: unexpected status %s", resp.Status)
+	}
+
+	var config struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&config); err != nil {
+		return "", fmt.Errorf("failed to decode OIDC configuration: %w", err)
+	}
+
+	if config.Issuer != v.settings.Issuer {
+		return "", fmt.Errorf("OIDC configuration has issuer %q instead of %q", config.Issuer, v.settings.Issuer)
+	}
+
+	if config.JWKSURI == "" {
+		return "", errors.New("OIDC configuration does not include jwks_uri")
+	}
+
+	v.log.Info("Discovered OIDC keyset", zap.String("url", config.JWKSURI))
+	return config.JWKSURI, nil
+}
+
+func audienceValidator(audience []string) jwt.Validator {
+	return jwt.ValidatorFunc(func(_ context.Context, t jwt.Token) jwt.ValidationError {
+		for _, have := range t.Audience() {
+			for _, want := range audience {
+				if have == want {
+					return nil
+				}
+			}
+		}
+
+		return jwt.ErrInvalidAudience()
+	})
+}
+
+// tokenRoles returns the roles held by the claim at the given path.
+// The claim can be a list of strings or a string of space-separated roles like the scope claim.
+func tokenRoles(token jwt.Token, claimPath string) []string {
+	path := strings.Split(claimPath, ".")
+
+	value, ok := token.Get(path[0])
+	if !ok {
+		return nil
+	}
+
+	for _, key := range path[1:] {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		if value, ok = m[key]; !ok {
+			return nil
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return strings.Fields(v)
+	case []string:
+		return v
+	case []any:
+		roles := make([]string, 0, len(v))
+		for _, r := range v {
+			if s, ok := r.(string); ok {
+				roles = append(roles, s)
+			}
+		}
+		return roles
+	default:
+		return nil
+	}
+}
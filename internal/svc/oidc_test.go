@@ -0,0 +1,148 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build tests
+// +build tests
+
+package svc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOIDCVerifier(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	rawKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	privateKey, err := jwk.FromRaw(rawKey)
+	require.NoError(t, err)
+	require.NoError(t, privateKey.Set(jwk.KeyIDKey, "test"))
+
+	publicKey, err := privateKey.PublicKey()
+	require.NoError(t, err)
+
+	keySet := jwk.NewSet()
+	require.NoError(t, keySet.AddKey(publicKey))
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	mux.HandleFunc(oidcDiscoveryPath, func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": srv.URL, "jwks_uri": srv.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(keySet)
+	})
+
+	mkToken := func(t *testing.T, claims map[string]any) string {
+		t.Helper()
+
+		token := jwt.New()
+		require.NoError(t, token.Set(jwt.IssuerKey, srv.URL))
+		require.NoError(t, token.Set(jwt.AudienceKey, "cerbos"))
+		require.NoError(t, token.Set(jwt.SubjectKey, "alice"))
+		require.NoError(t, token.Set(jwt.ExpirationKey, time.Now().Add(time.Hour)))
+		require.NoError(t, token.Set("email", "alice@example.com"))
+		require.NoError(t, token.Set("realm_access", map[string]any{"roles": []string{"cerbos-admin"}}))
+		for k, v := range claims {
+			require.NoError(t, token.Set(k, v))
+		}
+
+		signed, err := jwt.Sign(token, jwt.WithKey(jwa.ES256, privateKey))
+		require.NoError(t, err)
+		return string(signed)
+	}
+
+	verifier := NewOIDCVerifier(ctx, OIDCSettings{
+		Issuer:    srv.URL,
+		Audience:  []string{"cerbos"},
+		RoleClaim: "realm_access.roles",
+		Roles:     []string{"cerbos-admin"},
+		UserClaim: "email",
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		user, err := verifier.Verify(ctx, mkToken(t, nil))
+		require.NoError(t, err)
+		require.Equal(t, "alice@example.com", user)
+	})
+
+	testCases := []struct {
+		claims map[string]any
+		name   string
+	}{
+		{name: "wrong_issuer", claims: map[string]any{jwt.IssuerKey: "https://example.com"}},
+		{name: "wrong_audience", claims: map[string]any{jwt.AudienceKey: "other"}},
+		{name: "expired", claims: map[string]any{jwt.ExpirationKey: time.Now().Add(-time.Hour)}},
+		{name: "missing_role", claims: map[string]any{"realm_access": map[string]any{"roles": []string{"viewer"}}}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := verifier.Verify(ctx, mkToken(t, tc.claims))
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("unsigned", func(t *testing.T) {
+		token := jwt.New()
+		require.NoError(t, token.Set(jwt.IssuerKey, srv.URL))
+		unsigned, err := jwt.NewSerializer().Serialize(token)
+		require.NoError(t, err)
+
+		_, err = verifier.Verify(ctx, string(unsigned))
+		require.Error(t, err)
+	})
+
+	t.Run("admin_auth", func(t *testing.T) {
+		passwdHash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+		require.NoError(t, err)
+
+		basic := "Basic " + base64.StdEncoding.EncodeToString([]byte("cerbos:secret"))
+		bearer := "Bearer " + mkToken(t, nil)
+
+		auth := AdminAuth{Username: "cerbos", PasswordHash: passwdHash, OIDC: verifier}
+		user, err := auth.Authenticate(ctx, basic)
+		require.NoError(t, err)
+		require.Equal(t, "cerbos", user)
+
+		user, err = auth.Authenticate(ctx, bearer)
+		require.NoError(t, err)
+		require.Equal(t, "alice@example.com", user)
+
+		_, err = auth.Authenticate(ctx, "Bearer "+mkToken(t, map[string]any{jwt.AudienceKey: "other"}))
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		auth.DisableBasicAuth = true
+		_, err = auth.Authenticate(ctx, basic)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		_, err = auth.Authenticate(ctx, bearer)
+		require.NoError(t, err)
+
+		_, err = AdminAuth{Username: "cerbos", PasswordHash: passwdHash}.Authenticate(ctx, bearer)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
@@ -15,7 +15,6 @@ import (
 	"github.com/spf13/afero"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
@@ -39,15 +38,17 @@ var _ svcv1.CerbosPlaygroundServiceServer = (*CerbosPlaygroundService)(nil)
 // CerbosPlaygroundService implements the playground API.
 type CerbosPlaygroundService struct {
 	*svcv1.UnimplementedCerbosPlaygroundServiceServer
-	auxData   *auxdata.AuxData
-	reqLimits RequestLimits
+	auxData       *auxdata.AuxData
+	reqLimits     RequestLimits
+	compileLimits compile.Limits
 }
 
-func NewCerbosPlaygroundService(reqLimits RequestLimits) *CerbosPlaygroundService {
+func NewCerbosPlaygroundService(reqLimits RequestLimits, compileLimits compile.Limits) *CerbosPlaygroundService {
 	return &CerbosPlaygroundService{
 		UnimplementedCerbosPlaygroundServiceServer: &svcv1.UnimplementedCerbosPlaygroundServiceServer{},
-		auxData:   auxdata.NewWithoutVerification(context.Background()),
-		reqLimits: reqLimits,
+		auxData:       auxdata.NewWithoutVerification(context.Background()),
+		reqLimits:     reqLimits,
+		compileLimits: compileLimits,
 	}
 }
 
@@ -57,7 +58,7 @@ func (cs *CerbosPlaygroundService) PlaygroundValidate(ctx context.Context, req *
 	procCtx, cancelFunc := context.WithTimeout(ctx, playgroundRequestTimeout)
 	defer cancelFunc()
 
-	_, fail, err := doCompile(procCtx, log, req.Files)
+	_, fail, err := doCompile(procCtx, log, req.Files, cs.compileLimits)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +86,7 @@ func (cs *CerbosPlaygroundService) PlaygroundTest(ctx context.Context, req *requ
 	procCtx, cancelFunc := context.WithTimeout(ctx, playgroundRequestTimeout)
 	defer cancelFunc()
 
-	comps, fail, err := doCompile(procCtx, log, req.Files)
+	comps, fail, err := doCompile(procCtx, log, req.Files, cs.compileLimits)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +103,7 @@ func (cs *CerbosPlaygroundService) PlaygroundTest(ctx context.Context, req *requ
 	eng, err := comps.mkEngine(procCtx)
 	if err != nil {
 		log.Error("Failed to create engine", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "failed to create engine")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "failed to create engine")
 	}
 
 	fsys, err := buildFS(log, req.Files)
@@ -113,7 +114,7 @@ func (cs *CerbosPlaygroundService) PlaygroundTest(ctx context.Context, req *requ
 	results, err := verify.Verify(procCtx, fsys, eng, verify.Config{Trace: true})
 	if err != nil {
 		log.Error("Failed to run tests", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "failed to run tests")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "failed to run tests")
 	}
 
 	return &responsev1.PlaygroundTestResponse{
@@ -132,7 +133,7 @@ func (cs *CerbosPlaygroundService) PlaygroundEvaluate(ctx context.Context, req *
 	procCtx, cancelFunc := context.WithTimeout(ctx, playgroundRequestTimeout)
 	defer cancelFunc()
 
-	comps, fail, err := doCompile(procCtx, log, req.Files)
+	comps, fail, err := doCompile(procCtx, log, req.Files, cs.compileLimits)
 	if err != nil {
 		return nil, err
 	}
@@ -149,13 +150,13 @@ func (cs *CerbosPlaygroundService) PlaygroundEvaluate(ctx context.Context, req *
 	auxData, err := cs.auxData.Extract(ctx, req.AuxData)
 	if err != nil {
 		log.Error("Failed to extract auxData", zap.Error(err))
-		return nil, status.Error(codes.InvalidArgument, "failed to extract auxData")
+		return nil, NewError(codes.InvalidArgument, ErrCodeInvalidAuxData, "failed to extract auxData")
 	}
 
 	eng, err := comps.mkEngine(procCtx)
 	if err != nil {
 		log.Error("Failed to create engine", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "failed to create engine")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "failed to create engine")
 	}
 
 	inputs := []*enginev1.CheckInput{
@@ -171,7 +172,7 @@ func (cs *CerbosPlaygroundService) PlaygroundEvaluate(ctx context.Context, req *
 	output, err := eng.Check(procCtx, inputs)
 	if err != nil {
 		log.Error("Engine check failed", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "policy check failed")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "policy check failed")
 	}
 
 	return processEngineOutput(ctx, req.PlaygroundId, output)
@@ -183,7 +184,7 @@ func (cs *CerbosPlaygroundService) PlaygroundProxy(ctx context.Context, req *req
 	procCtx, cancelFunc := context.WithTimeout(ctx, playgroundRequestTimeout)
 	defer cancelFunc()
 
-	comps, fail, err := doCompile(procCtx, log, req.Files)
+	comps, fail, err := doCompile(procCtx, log, req.Files, cs.compileLimits)
 	if err != nil {
 		return nil, err
 	}
@@ -200,10 +201,10 @@ func (cs *CerbosPlaygroundService) PlaygroundProxy(ctx context.Context, req *req
 	eng, err := comps.mkEngine(procCtx)
 	if err != nil {
 		log.Error("Failed to create engine", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to create engine")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "failed to create engine")
 	}
 
-	cerbosSvc := NewCerbosService(eng, cs.auxData, cs.reqLimits)
+	cerbosSvc := NewCerbosService(eng, cs.auxData, cs.reqLimits, false, nil, nil, nil, nil)
 	switch proxyReq := req.ProxyRequest.(type) {
 	case *requestv1.PlaygroundProxyRequest_CheckResourceSet:
 		resp, err := cerbosSvc.CheckResourceSet(ctx, proxyReq.CheckResourceSet)
@@ -256,11 +257,11 @@ func (cs *CerbosPlaygroundService) PlaygroundProxy(ctx context.Context, req *req
 
 	default:
 		log.Error(fmt.Sprintf("Unhandled playground proxy request type %T", proxyReq))
-		return nil, status.Error(codes.Unimplemented, "unknown request type")
+		return nil, NewError(codes.Unimplemented, ErrCodeInvalidRequest, "unknown request type")
 	}
 }
 
-func doCompile(ctx context.Context, log *zap.Logger, files []*requestv1.File) (*components, *responsev1.PlaygroundFailure, error) {
+func doCompile(ctx context.Context, log *zap.Logger, files []*requestv1.File, limits compile.Limits) (*components, *responsev1.PlaygroundFailure, error) {
 	idx, err := buildIndex(ctx, log, files)
 	if err != nil {
 		idxErr := new(index.BuildError)
@@ -270,13 +271,13 @@ func doCompile(ctx context.Context, log *zap.Logger, files []*requestv1.File) (*
 		}
 
 		log.Error("Failed to create index", zap.Error(err))
-		return nil, nil, status.Errorf(codes.Internal, "failed to create index")
+		return nil, nil, NewError(codes.Internal, ErrCodeInternal, "failed to create index")
 	}
 
 	store := disk.NewFromIndexWithConf(idx, &disk.Conf{})
 	schemaMgr := schema.NewFromConf(ctx, store, schema.NewConf(schema.EnforcementWarn))
 
-	if err := compile.BatchCompile(idx.GetAllCompilationUnits(ctx), schemaMgr); err != nil {
+	if err := compile.BatchCompile(idx.GetAllCompilationUnits(ctx), schemaMgr, compile.WithContext(ctx), compile.WithLimits(limits)); err != nil {
 		compErr := new(compile.ErrorList)
 		if errors.As(err, &compErr) {
 			pf := processCompileErrors(ctx, compErr)
@@ -284,7 +285,7 @@ func doCompile(ctx context.Context, log *zap.Logger, files []*requestv1.File) (*
 		}
 
 		log.Error("Failed to compile", zap.Error(err))
-		return nil, nil, status.Errorf(codes.Internal, "failed to compile")
+		return nil, nil, NewError(codes.Internal, ErrCodeInternal, "failed to compile")
 	}
 
 	return &components{idx: idx, store: store, schemaMgr: schemaMgr}, nil, nil
@@ -304,7 +305,7 @@ func buildFS(log *zap.Logger, files []*requestv1.File) (fs.FS, error) {
 	for _, file := range files {
 		if err := afero.WriteFile(fsys, file.FileName, file.Contents, 0o644); err != nil { //nolint:gomnd
 			log.Error("Failed to create in-mem file", zap.String("file", file.FileName), zap.Error(err))
-			return nil, status.Errorf(codes.Internal, "failed to create file %s", file.FileName)
+			return nil, NewErrorf(codes.Internal, ErrCodeInternal, "failed to create file %s", file.FileName)
 		}
 	}
 
@@ -370,7 +371,7 @@ func processCompileErrors(ctx context.Context, errs *compile.ErrorList) *respons
 
 func processEngineOutput(_ context.Context, playgroundID string, outputs []*enginev1.CheckOutput) (*responsev1.PlaygroundEvaluateResponse, error) {
 	if len(outputs) != 1 {
-		return nil, status.Errorf(codes.Internal, "Unexpected engine output")
+		return nil, NewError(codes.Internal, ErrCodeInternal, "Unexpected engine output")
 	}
 
 	results := make([]*responsev1.PlaygroundEvaluateResponse_EvalResult, 0, len(outputs[0].Actions))
@@ -0,0 +1,262 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package svc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+var (
+	_ storage.SourceStore = (*requestPolicyStore)(nil)
+	_ engine.PolicyLoader = (*requestPolicyLoader)(nil)
+)
+
+// RequestPolicies evaluates the ad-hoc policies sent with CheckResources requests.
+type RequestPolicies struct {
+	store        storage.SourceStore
+	policyLoader engine.PolicyLoader
+	schemaMgr    schema.Manager
+	limits       compile.Limits
+}
+
+// NewRequestPolicies creates a RequestPolicies that layers ad-hoc policies over the given store.
+// Policies that are not affected by the ad-hoc policies are loaded from the given policy loader, so that
+// only the policies from the request and the policies that depend on them are compiled for each request.
+func NewRequestPolicies(store storage.SourceStore, policyLoader engine.PolicyLoader, schemaMgr schema.Manager, limits compile.Limits) *RequestPolicies {
+	return &RequestPolicies{
+		store:        store,
+		policyLoader: policyLoader,
+		schemaMgr:    schemaMgr,
+		limits:       limits,
+	}
+}
+
+// loader creates a policy loader that layers the given policies over the store.
+func (rp *RequestPolicies) loader(policies []*policyv1.Policy) (*requestPolicyLoader, error) {
+	store, err := newRequestPolicyStore(rp.store, policies)
+	if err != nil {
+		return nil, err
+	}
+
+	return &requestPolicyLoader{RequestPolicies: rp, store: store}, nil
+}
+
+// requestPolicyCompilationErr is returned when a policy affected by the ad-hoc policies from the request fails to compile.
+type requestPolicyCompilationErr struct {
+	underlying error
+}
+
+func (e requestPolicyCompilationErr) Error() string {
+	return fmt.Sprintf("request policy compilation error: %v", e.underlying)
+}
+
+func (e requestPolicyCompilationErr) Unwrap() error {
+	return e.underlying
+}
+
+type requestPolicyLoader struct {
+	*RequestPolicies
+	store *requestPolicyStore
+}
+
+func (l *requestPolicyLoader) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID) (*runtimev1.RunnablePolicySet, error) {
+	for _, id := range candidates {
+		units, err := l.store.GetCompilationUnits(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		cu, ok := units[id]
+		if !ok || cu.MainPolicy() == nil || cu.MainPolicy().Disabled {
+			continue
+		}
+
+		// policies that don't include any of the request policies are served by the shared policy loader to make use of its cache
+		if !l.store.overlays(cu) {
+			return l.policyLoader.GetFirstMatch(ctx, []namer.ModuleID{id})
+		}
+
+		rps, err := compile.Compile(cu, l.schemaMgr, compile.WithContext(ctx), compile.WithLimits(l.limits))
+		if err != nil {
+			return nil, requestPolicyCompilationErr{underlying: err}
+		}
+
+		return rps, nil
+	}
+
+	return nil, nil
+}
+
+// requestPolicyStore layers the ad-hoc policies sent with a request over the policies of the underlying store.
+// Policies from the request replace the store policies with the same module IDs.
+// It only lives for the duration of a single request, so it never produces any storage events.
+type requestPolicyStore struct {
+	storage.SourceStore
+	overlay map[namer.ModuleID]*policyv1.Policy
+	// base caches the definitions loaded from the underlying store.
+	base map[namer.ModuleID]*policyv1.Policy
+	mu   sync.Mutex
+}
+
+func newRequestPolicyStore(store storage.SourceStore, policies []*policyv1.Policy) (*requestPolicyStore, error) {
+	overlay := make(map[namer.ModuleID]*policyv1.Policy, len(policies))
+	for i, p := range policies {
+		if err := policy.Validate(p); err != nil {
+			return nil, fmt.Errorf("policy #%d is invalid: %w", i+1, err)
+		}
+
+		wp := policy.Wrap(policy.WithMetadata(p, fmt.Sprintf("request.policies[%d]", i), nil, ""))
+		if _, exists := overlay[wp.ID]; exists {
+			return nil, fmt.Errorf("policy #%d is a duplicate of another policy in the request: %s", i+1, wp.FQN)
+		}
+		overlay[wp.ID] = wp.Policy
+	}
+
+	return &requestPolicyStore{
+		SourceStore: store,
+		overlay:     overlay,
+		base:        make(map[namer.ModuleID]*policyv1.Policy),
+	}, nil
+}
+
+func (s *requestPolicyStore) Subscribe(storage.Subscriber) {}
+
+func (s *requestPolicyStore) Unsubscribe(storage.Subscriber) {}
+
+func (s *requestPolicyStore) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID) (*policy.CompilationUnit, error) {
+	for _, id := range candidates {
+		units, err := s.GetCompilationUnits(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if cu, ok := units[id]; ok {
+			return cu, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *requestPolicyStore) GetCompilationUnits(ctx context.Context, ids ...namer.ModuleID) (map[namer.ModuleID]*policy.CompilationUnit, error) {
+	result := make(map[namer.ModuleID]*policy.CompilationUnit, len(ids))
+	for _, id := range ids {
+		p, err := s.lookup(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if p == nil {
+			continue
+		}
+
+		cu := &policy.CompilationUnit{ModID: id}
+		if err := s.addWithDeps(ctx, cu, id, p); err != nil {
+			return nil, err
+		}
+
+		for _, ancestor := range cu.Ancestors() {
+			ap, err := s.lookup(ctx, ancestor)
+			if err != nil {
+				return nil, err
+			}
+
+			// missing ancestors are reported by the compiler
+			if ap == nil {
+				continue
+			}
+
+			if err := s.addWithDeps(ctx, cu, ancestor, ap); err != nil {
+				return nil, err
+			}
+		}
+
+		result[id] = cu
+	}
+
+	return result, nil
+}
+
+func (s *requestPolicyStore) addWithDeps(ctx context.Context, cu *policy.CompilationUnit, id namer.ModuleID, p *policyv1.Policy) error {
+	if _, ok := cu.Definitions[id]; ok {
+		return nil
+	}
+
+	cu.AddDefinition(id, p)
+
+	for _, dep := range policy.Wrap(p).Dependencies() {
+		dp, err := s.lookup(ctx, dep)
+		if err != nil {
+			return err
+		}
+
+		// missing imports are reported by the compiler
+		if dp == nil {
+			continue
+		}
+
+		if err := s.addWithDeps(ctx, cu, dep, dp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// overlays returns true if the compilation unit contains any of the policies from the request.
+func (s *requestPolicyStore) overlays(cu *policy.CompilationUnit) bool {
+	for id := range cu.Definitions {
+		if _, ok := s.overlay[id]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lookup returns the policy with the given module ID from the request or from the underlying store, or nil if it doesn't exist.
+func (s *requestPolicyStore) lookup(ctx context.Context, id namer.ModuleID) (*policyv1.Policy, error) {
+	if p, ok := s.overlay[id]; ok {
+		return p, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if p, ok := s.base[id]; ok {
+		return p, nil
+	}
+
+	units, err := s.SourceStore.GetCompilationUnits(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s from the store: %w", id.String(), err)
+	}
+
+	// cache everything the store returned to avoid loading the same dependencies again
+	for _, cu := range units {
+		for defID, def := range cu.Definitions {
+			if _, ok := s.base[defID]; !ok {
+				s.base[defID] = def
+			}
+		}
+	}
+
+	p, ok := s.base[id]
+	if !ok {
+		s.base[id] = nil
+	}
+
+	return p, nil
+}
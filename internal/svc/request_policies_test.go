@@ -0,0 +1,259 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build tests
+// +build tests
+
+package svc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/auxdata"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+func TestRequestPolicyStore(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	store, err := disk.NewStore(ctx, &disk.Conf{Directory: test.PathToDir(t, "store")})
+	require.NoError(t, err)
+
+	t.Run("replaces_store_policy", func(t *testing.T) {
+		p := test.NewResourcePolicyBuilder("leave_request", "20210210").
+			WithDerivedRolesImports("alpha").
+			WithRules(test.NewResourceRule("approve").WithDerivedRoles("reader").Build()).
+			Build()
+
+		rps, err := newRequestPolicyStore(store, []*policyv1.Policy{p})
+		require.NoError(t, err)
+
+		modID := namer.ResourcePolicyModuleID("leave_request", "20210210", "")
+		units, err := rps.GetCompilationUnits(ctx, modID)
+		require.NoError(t, err)
+		require.Contains(t, units, modID)
+
+		cu := units[modID]
+		require.Same(t, p, cu.MainPolicy())
+		require.True(t, rps.overlays(cu))
+
+		// imports are resolved from the store
+		require.Contains(t, cu.Definitions, namer.DerivedRolesModuleID("alpha"))
+		require.NotContains(t, cu.Definitions, namer.DerivedRolesModuleID("beta"))
+	})
+
+	t.Run("resolves_ancestors_from_store", func(t *testing.T) {
+		p := test.NewResourcePolicyBuilder("leave_request", "default").
+			WithRules(test.NewResourceRule("approve").WithRoles("employee").Build()).
+			Build()
+		p.GetResourcePolicy().Scope = "acme.hr.uk.brighton"
+
+		rps, err := newRequestPolicyStore(store, []*policyv1.Policy{p})
+		require.NoError(t, err)
+
+		modID := namer.ResourcePolicyModuleID("leave_request", "default", "acme.hr.uk.brighton")
+		cu, err := rps.GetFirstMatch(ctx, []namer.ModuleID{modID})
+		require.NoError(t, err)
+		require.NotNil(t, cu)
+
+		for _, scope := range []string{"acme.hr.uk", "acme.hr", "acme", ""} {
+			require.Contains(t, cu.Definitions, namer.ResourcePolicyModuleID("leave_request", "default", scope), "Missing ancestor %q", scope)
+		}
+	})
+
+	t.Run("store_only_policy", func(t *testing.T) {
+		p := test.NewResourcePolicyBuilder("experiment", "default").
+			WithRules(test.NewResourceRule("run").WithRoles("employee").Build()).
+			Build()
+
+		rps, err := newRequestPolicyStore(store, []*policyv1.Policy{p})
+		require.NoError(t, err)
+
+		modID := namer.ResourcePolicyModuleID("leave_request", "20210210", "")
+		units, err := rps.GetCompilationUnits(ctx, modID)
+		require.NoError(t, err)
+		require.Contains(t, units, modID)
+		require.False(t, rps.overlays(units[modID]))
+	})
+
+	t.Run("missing_policy", func(t *testing.T) {
+		rps, err := newRequestPolicyStore(store, nil)
+		require.NoError(t, err)
+
+		cu, err := rps.GetFirstMatch(ctx, []namer.ModuleID{namer.ResourcePolicyModuleID("nonexistent", "default", "")})
+		require.NoError(t, err)
+		require.Nil(t, cu)
+	})
+
+	t.Run("duplicate_policies", func(t *testing.T) {
+		p := test.NewResourcePolicyBuilder("experiment", "default").
+			WithRules(test.NewResourceRule("run").WithRoles("employee").Build()).
+			Build()
+
+		_, err := newRequestPolicyStore(store, []*policyv1.Policy{p, p})
+		require.ErrorContains(t, err, "duplicate")
+	})
+
+	t.Run("invalid_policy", func(t *testing.T) {
+		p := test.NewResourcePolicyBuilder("experiment", "not valid").
+			WithRules(test.NewResourceRule("run").WithRoles("employee").Build()).
+			Build()
+
+		_, err := newRequestPolicyStore(store, []*policyv1.Policy{p})
+		require.ErrorContains(t, err, "policy #1 is invalid")
+	})
+}
+
+func TestRequestPolicies(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	store, err := disk.NewStore(ctx, &disk.Conf{Directory: test.PathToDir(t, "store")})
+	require.NoError(t, err)
+
+	schemaMgr := schema.NewFromConf(ctx, store, schema.NewConf(schema.EnforcementNone))
+	policyLoader := compile.NewManagerFromDefaultConf(ctx, store, schemaMgr)
+	eng, err := engine.New(ctx, engine.Components{
+		PolicyLoader: policyLoader,
+		SchemaMgr:    schemaMgr,
+		AuditLog:     audit.NewNopLog(),
+	})
+	require.NoError(t, err)
+
+	reqLimits := RequestLimits{
+		MaxActionsPerResource:    5,
+		MaxResourcesPerRequest:   5,
+		MaxPlanEntriesPerRequest: 5,
+		MaxPoliciesPerRequest:    2,
+		MaxPolicyBytesPerRequest: 1024,
+	}
+	auxData := auxdata.NewFromConf(ctx, &auxdata.Conf{})
+	requestPolicies := NewRequestPolicies(store, policyLoader, schemaMgr, compile.Limits{MaxRulesPerPolicy: 2})
+	cs := NewCerbosService(eng, auxData, reqLimits, false, requestPolicies, nil, nil, nil)
+
+	mkRequest := func(policies ...*policyv1.Policy) *requestv1.CheckResourcesRequest {
+		return &requestv1.CheckResourcesRequest{
+			RequestId: "test",
+			Principal: &enginev1.Principal{Id: "john", Roles: []string{"employee"}},
+			Resources: []*requestv1.CheckResourcesRequest_ResourceEntry{
+				{
+					Actions:  []string{"approve"},
+					Resource: &enginev1.Resource{Kind: "leave_request", PolicyVersion: "20210210", Id: "XX125"},
+				},
+				{
+					Actions:  []string{"run"},
+					Resource: &enginev1.Resource{Kind: "experiment", Id: "EX1"},
+				},
+			},
+			Policies: policies,
+		}
+	}
+
+	allowApprove := test.NewResourcePolicyBuilder("leave_request", "20210210").
+		WithRules(test.NewResourceRule("approve").WithRoles("employee").Build()).
+		Build()
+	allowRun := test.NewResourcePolicyBuilder("experiment", "default").
+		WithRules(test.NewResourceRule("run").WithRoles("employee").Build()).
+		Build()
+
+	requireStatus := func(t *testing.T, err error, wantCode codes.Code, wantErrCode ErrorCode) {
+		t.Helper()
+
+		st, ok := status.FromError(err)
+		require.True(t, ok, "Not a status error: %v", err)
+		require.Equal(t, wantCode, st.Code())
+		require.Equal(t, wantErrCode, ErrorCodeOf(st))
+	}
+
+	t.Run("without_policies", func(t *testing.T) {
+		resp, err := cs.CheckResources(ctx, mkRequest())
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, resp.Results[0].Actions["approve"])
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, resp.Results[1].Actions["run"])
+	})
+
+	t.Run("with_policies", func(t *testing.T) {
+		resp, err := cs.CheckResources(ctx, mkRequest(allowApprove, allowRun))
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, resp.Results[0].Actions["approve"])
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, resp.Results[1].Actions["run"])
+
+		// the policies are only used for the request that includes them
+		resp, err = cs.CheckResources(ctx, mkRequest())
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, resp.Results[0].Actions["approve"])
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		disabled := NewCerbosService(eng, auxData, reqLimits, false, nil, nil, nil, nil)
+		_, err := disabled.CheckResources(ctx, mkRequest(allowRun))
+		requireStatus(t, err, codes.Unimplemented, ErrCodeFeatureDisabled)
+	})
+
+	t.Run("invalid_policy", func(t *testing.T) {
+		p := test.NewResourcePolicyBuilder("experiment", "not valid").
+			WithRules(test.NewResourceRule("run").WithRoles("employee").Build()).
+			Build()
+
+		_, err := cs.CheckResources(ctx, mkRequest(p))
+		requireStatus(t, err, codes.InvalidArgument, ErrCodeInvalidPolicy)
+	})
+
+	t.Run("policy_does_not_compile", func(t *testing.T) {
+		p := test.NewResourcePolicyBuilder("experiment", "default").
+			WithRules(test.NewResourceRule("run").WithDerivedRoles("unknown_role").Build()).
+			Build()
+
+		_, err := cs.CheckResources(ctx, mkRequest(p))
+		requireStatus(t, err, codes.InvalidArgument, ErrCodeInvalidPolicy)
+	})
+
+	t.Run("policy_exceeds_compile_limits", func(t *testing.T) {
+		p := test.NewResourcePolicyBuilder("experiment", "default").
+			WithRules(
+				test.NewResourceRule("run").WithRoles("employee").Build(),
+				test.NewResourceRule("stop").WithRoles("employee").Build(),
+				test.NewResourceRule("pause").WithRoles("employee").Build(),
+			).
+			Build()
+
+		_, err := cs.CheckResources(ctx, mkRequest(p))
+		requireStatus(t, err, codes.InvalidArgument, ErrCodeInvalidPolicy)
+	})
+
+	t.Run("too_many_policies", func(t *testing.T) {
+		allowView := test.NewResourcePolicyBuilder("report", "default").
+			WithRules(test.NewResourceRule("view").WithRoles("employee").Build()).
+			Build()
+
+		_, err := cs.CheckResources(ctx, mkRequest(allowApprove, allowRun, allowView))
+		requireStatus(t, err, codes.InvalidArgument, ErrCodeRequestLimitExceeded)
+	})
+
+	t.Run("policies_too_large", func(t *testing.T) {
+		rules := make([]*policyv1.ResourceRule, 100)
+		for i := range rules {
+			rules[i] = test.NewResourceRule("run").WithRoles("employee").Build()
+		}
+
+		p := test.NewResourcePolicyBuilder("experiment", "default").WithRules(rules...).Build()
+		_, err := cs.CheckResources(ctx, mkRequest(p))
+		requireStatus(t, err, codes.InvalidArgument, ErrCodeRequestLimitExceeded)
+	})
+}
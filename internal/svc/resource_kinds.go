@@ -0,0 +1,161 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package svc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	responsev1 "github.com/cerbos/cerbos/api/genpb/cerbos/response/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const globChars = "*?[{"
+
+// ResourceKinds lists the resource kinds and actions defined by the compiled policies in a store.
+type ResourceKinds struct {
+	store        storage.Store
+	policyLoader engine.PolicyLoader
+}
+
+func NewResourceKinds(store storage.Store, policyLoader engine.PolicyLoader) *ResourceKinds {
+	return &ResourceKinds{store: store, policyLoader: policyLoader}
+}
+
+// List returns the resource kinds sorted by name.
+// Resource policies contribute their resource kind, version, scope and the actions of their rules.
+// Principal policies contribute the actions of their resource rules to the matching resource kinds.
+// Resource kinds that are only referenced by principal policies are included if they are not wildcard patterns.
+func (rk *ResourceKinds) List(ctx context.Context) ([]*responsev1.ListResourceKindsResponse_ResourceKind, error) {
+	modIDs, err := rk.moduleIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	kinds := make(map[string]*resourceKindEntry)
+	getKind := func(kind string) *resourceKindEntry {
+		e, ok := kinds[kind]
+		if !ok {
+			e = newResourceKindEntry()
+			kinds[kind] = e
+		}
+		return e
+	}
+
+	var principalRules []*runtimev1.RunnablePrincipalPolicySet_Policy
+	for _, modID := range modIDs {
+		rps, err := rk.policyLoader.GetFirstMatch(ctx, []namer.ModuleID{modID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get policy set for %s: %w", modID.String(), err)
+		}
+
+		switch set := rps.GetPolicySet().(type) {
+		case *runtimev1.RunnablePolicySet_ResourcePolicy:
+			rp := set.ResourcePolicy
+			e := getKind(rp.Meta.Resource)
+			e.versions[rp.Meta.Version] = struct{}{}
+			if len(rp.Policies) > 0 {
+				e.scopes[rp.Policies[0].Scope] = struct{}{}
+			}
+
+			for _, p := range rp.Policies {
+				for _, rule := range p.Rules {
+					for action := range rule.Actions {
+						e.actions[action] = struct{}{}
+					}
+				}
+			}
+
+		case *runtimev1.RunnablePolicySet_PrincipalPolicy:
+			for _, p := range set.PrincipalPolicy.Policies {
+				for kind := range p.ResourceRules {
+					if !strings.ContainsAny(kind, globChars) {
+						getKind(kind)
+					}
+				}
+				principalRules = append(principalRules, p)
+			}
+		}
+	}
+
+	for _, p := range principalRules {
+		for pattern, rules := range p.ResourceRules {
+			for kind, e := range kinds {
+				if !util.MatchesGlob(pattern, kind) {
+					continue
+				}
+
+				for _, rule := range rules.ActionRules {
+					e.actions[rule.Action] = struct{}{}
+				}
+			}
+		}
+	}
+
+	out := make([]*responsev1.ListResourceKindsResponse_ResourceKind, 0, len(kinds))
+	for kind, e := range kinds {
+		out = append(out, &responsev1.ListResourceKindsResponse_ResourceKind{
+			Kind:           kind,
+			PolicyVersions: sortedKeys(e.versions),
+			Scopes:         sortedKeys(e.scopes),
+			Actions:        sortedKeys(e.actions),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Kind < out[j].Kind })
+
+	return out, nil
+}
+
+// moduleIDs returns the module IDs of the enabled policies in the store.
+func (rk *ResourceKinds) moduleIDs(ctx context.Context) ([]namer.ModuleID, error) {
+	policyIDs, err := rk.store.ListPolicyIDs(ctx, storage.ListPolicyIDsParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+
+	ss, ok := rk.store.(storage.SourceStore)
+	if !ok {
+		// binary stores list the policies by their fully-qualified names
+		modIDs := make([]namer.ModuleID, len(policyIDs))
+		for i, id := range policyIDs {
+			modIDs[i] = namer.GenModuleIDFromFQN(id)
+		}
+		return modIDs, nil
+	}
+
+	if len(policyIDs) == 0 {
+		return nil, nil
+	}
+
+	wrappers, err := ss.LoadPolicy(ctx, policyIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load policies: %w", err)
+	}
+
+	modIDs := make([]namer.ModuleID, len(wrappers))
+	for i, w := range wrappers {
+		modIDs[i] = w.ID
+	}
+	return modIDs, nil
+}
+
+type resourceKindEntry struct {
+	versions map[string]struct{}
+	scopes   map[string]struct{}
+	actions  map[string]struct{}
+}
+
+func newResourceKindEntry() *resourceKindEntry {
+	return &resourceKindEntry{
+		versions: make(map[string]struct{}),
+		scopes:   make(map[string]struct{}),
+		actions:  make(map[string]struct{}),
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build tests
+// +build tests
+
+package svc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	responsev1 "github.com/cerbos/cerbos/api/genpb/cerbos/response/v1"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+)
+
+const (
+	leaveRequestPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: leave_request
+  version: default
+  rules:
+    - actions: ["view:*", "create"]
+      effect: EFFECT_ALLOW
+      roles: ["user"]
+`
+	leaveRequestAcmePolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: leave_request
+  version: default
+  scope: acme
+  rules:
+    - actions: ["approve"]
+      effect: EFFECT_ALLOW
+      roles: ["manager"]
+`
+	leaveRequestStagingPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: leave_request
+  version: staging
+  rules:
+    - actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["user"]
+`
+	donaldDuckPolicy = `---
+apiVersion: api.cerbos.dev/v1
+principalPolicy:
+  principal: donald_duck
+  version: default
+  rules:
+    - resource: leave_request
+      actions:
+        - action: "defer"
+          effect: EFFECT_ALLOW
+    - resource: "salary_*"
+      actions:
+        - action: "view"
+          effect: EFFECT_ALLOW
+    - resource: salary_record
+      actions:
+        - action: "edit"
+          effect: EFFECT_DENY
+`
+)
+
+func TestResourceKinds(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	dir := t.TempDir()
+	for name, contents := range map[string]string{
+		"leave_request.yaml":         leaveRequestPolicy,
+		"leave_request_acme.yaml":    leaveRequestAcmePolicy,
+		"leave_request_staging.yaml": leaveRequestStagingPolicy,
+		"donald_duck.yaml":           donaldDuckPolicy,
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o600))
+	}
+
+	store, err := disk.NewStore(ctx, &disk.Conf{Directory: dir})
+	require.NoError(t, err)
+
+	schemaMgr := schema.NewFromConf(ctx, store, schema.NewConf(schema.EnforcementNone))
+	policyLoader := compile.NewManagerFromDefaultConf(ctx, store, schemaMgr)
+
+	have, err := NewResourceKinds(store, policyLoader).List(ctx)
+	require.NoError(t, err)
+
+	want := []*responsev1.ListResourceKindsResponse_ResourceKind{
+		{
+			Kind:           "leave_request",
+			PolicyVersions: []string{"default", "staging"},
+			Scopes:         []string{"", "acme"},
+			Actions:        []string{"approve", "create", "defer", "view", "view:*"},
+		},
+		{
+			Kind:    "salary_record",
+			Actions: []string{"edit", "view"},
+		},
+	}
+	require.Empty(t, cmp.Diff(want, have, protocmp.Transform()))
+}
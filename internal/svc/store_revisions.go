@@ -0,0 +1,105 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package svc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+// StoreRevisionHeader is the request header that pins a request to a store revision
+// and the response header that reports the store revision the request was evaluated against.
+const StoreRevisionHeader = "cerbos-store-revision"
+
+// StoreRevisions resolves the store revision that each request is evaluated against.
+type StoreRevisions struct {
+	store   storage.Store
+	engines *historyEngines
+}
+
+func NewStoreRevisions(store storage.Store) *StoreRevisions {
+	return &StoreRevisions{store: store, engines: newHistoryEngines()}
+}
+
+// current returns the revision of the store contents currently served by the PDP or an empty string if the store doesn't report one.
+func (sr *StoreRevisions) current() string {
+	if r, ok := sr.store.(storage.Revisioned); ok {
+		return r.Revision()
+	}
+
+	return ""
+}
+
+// engine returns the engine that evaluates the policies of the given revision and the store revision it resolves to.
+func (sr *StoreRevisions) engine(ctx context.Context, revision string) (*engine.Engine, string, error) {
+	hs, ok := sr.store.(storage.Historical)
+	if !ok {
+		return nil, "", NewError(codes.Unimplemented, ErrCodeUnsupportedOperation, "Configured store does not keep a history of policies")
+	}
+
+	eng, resolved, err := sr.engines.get(ctx, hs, storage.HistoryPoint{Revision: revision})
+	if err != nil {
+		ctxzap.Extract(ctx).Error("Failed to load policies from store history", zap.String("revision", revision), zap.Error(err))
+		if errors.Is(err, storage.ErrRevisionNotFound) {
+			return nil, "", NewErrorf(codes.NotFound, ErrCodeNotFound, "store revision %q does not exist in the store history", revision)
+		}
+		return nil, "", NewError(codes.Internal, ErrCodeInternal, "failed to load policies from store history")
+	}
+
+	return eng, resolved, nil
+}
+
+// pinnedRevision returns the store revision requested by the client or an empty string if the request isn't pinned.
+func pinnedRevision(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(StoreRevisionHeader); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// forRequest returns the service that evaluates the request in ctx and the context to evaluate it with.
+// If the request identifies a tenant, the returned service evaluates the policies of the tenant.
+// If the client pinned the request to a store revision, the returned service evaluates the policies of that revision.
+// The evaluated store revision is sent to the client in the response header.
+func (cs *CerbosService) forRequest(ctx context.Context) (context.Context, *CerbosService, error) {
+	ctx, cs, err := cs.forTenant(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cs.revisions == nil {
+		return ctx, cs, nil
+	}
+
+	revision := pinnedRevision(ctx)
+	if revision == "" {
+		if current := cs.revisions.current(); current != "" {
+			_ = grpc.SetHeader(ctx, metadata.Pairs(StoreRevisionHeader, current))
+			// The audit log reports the revision of the default store unless told otherwise, which is wrong for tenants.
+			ctx = audit.NewContextWithStoreRevision(ctx, current)
+		}
+
+		return ctx, cs.withEngine(cs.eng), nil
+	}
+
+	eng, revision, err := cs.revisions.engine(ctx, revision)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(StoreRevisionHeader, revision))
+	return audit.NewContextWithStoreRevision(ctx, revision), cs.withEngine(cs.eng.WithPoliciesFrom(eng)), nil
+}
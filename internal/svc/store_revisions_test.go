@@ -0,0 +1,161 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build tests
+// +build tests
+
+package svc
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/auxdata"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/db/sqlite3"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+func TestStoreRevisions(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	sqliteStore, err := sqlite3.NewStore(ctx, &sqlite3.Conf{DSN: fmt.Sprintf("%s?_fk=true", filepath.Join(t.TempDir(), "cerbos.db"))})
+	require.NoError(t, err)
+	store := revisionedStore{Store: sqliteStore, revision: "current"}
+
+	mkPolicy := func(effect effectv1.Effect) policy.Wrapper {
+		return policy.Wrap(test.NewResourcePolicyBuilder("leave_request", "default").
+			WithRules(test.NewResourceRule("view").WithRoles("user").WithEffect(effect).Build()).
+			Build())
+	}
+
+	require.NoError(t, store.AddOrUpdate(ctx, mkPolicy(effectv1.Effect_EFFECT_ALLOW)))
+	_, pinned, err := store.AsOf(ctx, storage.HistoryPoint{Time: time.Now().Add(1 * time.Minute)})
+	require.NoError(t, err)
+	require.NoError(t, store.AddOrUpdate(ctx, mkPolicy(effectv1.Effect_EFFECT_DENY)))
+
+	schemaMgr := schema.NewFromConf(ctx, store, schema.NewConf(schema.EnforcementNone))
+	eng, err := engine.New(ctx, engine.Components{
+		PolicyLoader: compile.NewManagerFromDefaultConf(ctx, store, schemaMgr),
+		SchemaMgr:    schemaMgr,
+		AuditLog:     audit.NewNopLog(),
+	})
+	require.NoError(t, err)
+
+	reqLimits := RequestLimits{MaxActionsPerResource: 5, MaxResourcesPerRequest: 5}
+	auxData := auxdata.NewFromConf(ctx, &auxdata.Conf{})
+	cs := NewCerbosService(eng, auxData, reqLimits, false, nil, nil, NewStoreRevisions(store), nil)
+
+	req := &requestv1.CheckResourcesRequest{
+		RequestId: "test",
+		Principal: &enginev1.Principal{Id: "john", Roles: []string{"user"}},
+		Resources: []*requestv1.CheckResourcesRequest_ResourceEntry{
+			{
+				Actions:  []string{"view"},
+				Resource: &enginev1.Resource{Kind: "leave_request", Id: "XX125"},
+			},
+		},
+	}
+
+	check := func(t *testing.T, cs *CerbosService, revision string) (effectv1.Effect, string, error) {
+		t.Helper()
+
+		stream := &headerCapture{}
+		ctx := grpc.NewContextWithServerTransportStream(ctx, stream)
+		if revision != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(StoreRevisionHeader, revision))
+		}
+
+		have, err := cs.CheckResources(ctx, req)
+		if err != nil {
+			return effectv1.Effect_EFFECT_UNSPECIFIED, "", err
+		}
+
+		var served string
+		if values := stream.header.Get(StoreRevisionHeader); len(values) > 0 {
+			served = values[0]
+		}
+
+		return have.Results[0].Actions["view"], served, nil
+	}
+
+	t.Run("current_revision", func(t *testing.T) {
+		effect, served, err := check(t, cs, "")
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, effect)
+		require.Equal(t, "current", served)
+	})
+
+	t.Run("pinned_revision", func(t *testing.T) {
+		effect, served, err := check(t, cs, pinned)
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, effect)
+		require.Equal(t, pinned, served)
+	})
+
+	t.Run("unknown_revision", func(t *testing.T) {
+		_, _, err := check(t, cs, "unknown")
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("store_without_history", func(t *testing.T) {
+		noHistory := NewCerbosService(eng, auxData, reqLimits, false, nil, nil, NewStoreRevisions(struct{ storage.Store }{store}), nil)
+
+		effect, served, err := check(t, noHistory, "")
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, effect)
+		require.Empty(t, served)
+
+		_, _, err = check(t, noHistory, pinned)
+		require.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+type revisionedStore struct {
+	*sqlite3.Store
+	revision string
+}
+
+func (rs revisionedStore) Revision() string {
+	return rs.revision
+}
+
+// headerCapture records the response headers set by the service.
+type headerCapture struct {
+	header metadata.MD
+}
+
+func (hc *headerCapture) Method() string {
+	return "/cerbos.svc.v1.CerbosService/CheckResources"
+}
+
+func (hc *headerCapture) SetHeader(md metadata.MD) error {
+	hc.header = metadata.Join(hc.header, md)
+	return nil
+}
+
+func (hc *headerCapture) SendHeader(md metadata.MD) error {
+	return hc.SetHeader(md)
+}
+
+func (hc *headerCapture) SetTrailer(metadata.MD) error {
+	return nil
+}
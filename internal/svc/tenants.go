@@ -0,0 +1,108 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package svc
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+)
+
+// TenantHeader is the request header that identifies the tenant whose policies the request is evaluated against.
+// The header can't select a tenant by itself: the tenant is taken from the API key or the SPIFFE ID that the request
+// was authenticated with, and the header is only accepted if it matches that tenant.
+const TenantHeader = "cerbos-tenant"
+
+// Tenants routes requests to the service of the tenant they are bound to.
+type Tenants struct {
+	services map[string]*CerbosService
+	required bool
+}
+
+// NewTenants creates an empty set of tenants. If required is true, requests that are not bound to a tenant are rejected.
+func NewTenants(required bool) *Tenants {
+	return &Tenants{services: make(map[string]*CerbosService), required: required}
+}
+
+// Add registers the service that evaluates the requests of the given tenant.
+func (t *Tenants) Add(id string, cs *CerbosService) {
+	t.services[id] = cs
+}
+
+type tenantCtxKey struct{}
+
+// ContextWithTenant binds the request to a tenant. It must only be called after authenticating the request
+// with a credential that belongs to the tenant, such as an API key or a SPIFFE ID mapped to the tenant.
+func ContextWithTenant(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, tenantCtxKey{}, id)
+}
+
+// TenantFromContext returns the tenant that the request is bound to.
+func TenantFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(tenantCtxKey{}).(string)
+	return id, ok && id != ""
+}
+
+// requestedTenant returns the tenant named by the tenant header of the request or an empty string if there's no header.
+func requestedTenant(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(TenantHeader); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// forTenant returns the service that evaluates the requests of the tenant that the request in ctx is bound to
+// and the context to evaluate it with, which tags the metrics, logs and audit log entries of the request with the tenant.
+// Requests that are not bound to a tenant are evaluated by cs unless a tenant is required, and they are rejected if they
+// try to select a tenant with the tenant header.
+func (cs *CerbosService) forTenant(ctx context.Context) (context.Context, *CerbosService, error) {
+	if cs.tenants == nil {
+		return ctx, cs, nil
+	}
+
+	id, bound := TenantFromContext(ctx)
+	if requested := requestedTenant(ctx); requested != "" && requested != id {
+		return nil, nil, NewErrorf(codes.PermissionDenied, ErrCodePermissionDenied, "not allowed to access tenant %q: the tenant is selected by the API key or the SPIFFE ID of the request", requested)
+	}
+
+	if !bound {
+		if cs.tenants.required {
+			return nil, nil, NewError(codes.PermissionDenied, ErrCodePermissionDenied, "requests must be made with an API key or a SPIFFE ID that is bound to a tenant")
+		}
+
+		return ctx, cs, nil
+	}
+
+	tenantSvc, ok := cs.tenants.services[id]
+	if !ok {
+		return nil, nil, NewErrorf(codes.NotFound, ErrCodeNotFound, "tenant %q does not exist", id)
+	}
+
+	// the audit log records the tenant from the request metadata, which only names the tenant if the client sent the header
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Set(TenantHeader, id)
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	if tagged, err := tag.New(ctx, tag.Upsert(metrics.KeyTenant, id)); err == nil {
+		ctx = tagged
+	}
+	ctxzap.AddFields(ctx, zap.String("tenant", id))
+
+	return ctx, tenantSvc, nil
+}
+
+// isTenantScoped returns true if the request is bound to a tenant or names one with the tenant header.
+func isTenantScoped(ctx context.Context) bool {
+	_, bound := TenantFromContext(ctx)
+	return bound || requestedTenant(ctx) != ""
+}
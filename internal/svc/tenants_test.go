@@ -0,0 +1,136 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build tests
+// +build tests
+
+package svc
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/auxdata"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage/db/sqlite3"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+func TestTenants(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	mkEngine := func(t *testing.T, effect effectv1.Effect) *engine.Engine {
+		t.Helper()
+
+		store, err := sqlite3.NewStore(ctx, &sqlite3.Conf{DSN: fmt.Sprintf("%s?_fk=true", filepath.Join(t.TempDir(), "cerbos.db"))})
+		require.NoError(t, err)
+		require.NoError(t, store.AddOrUpdate(ctx, policy.Wrap(test.NewResourcePolicyBuilder("leave_request", "default").
+			WithRules(test.NewResourceRule("view").WithRoles("user").WithEffect(effect).Build()).
+			Build())))
+
+		schemaMgr := schema.NewFromConf(ctx, store, schema.NewConf(schema.EnforcementNone))
+		eng, err := engine.New(ctx, engine.Components{
+			PolicyLoader: compile.NewManagerFromDefaultConf(ctx, store, schemaMgr),
+			SchemaMgr:    schemaMgr,
+			AuditLog:     audit.NewNopLog(),
+		})
+		require.NoError(t, err)
+
+		return eng
+	}
+
+	reqLimits := RequestLimits{MaxActionsPerResource: 5, MaxResourcesPerRequest: 5}
+	auxData := auxdata.NewFromConf(ctx, &auxdata.Conf{})
+	mkService := func(t *testing.T, required bool) *CerbosService {
+		t.Helper()
+
+		tenants := NewTenants(required)
+		tenants.Add("acme", NewCerbosService(mkEngine(t, effectv1.Effect_EFFECT_ALLOW), auxData, reqLimits, false, nil, nil, nil, nil))
+		return NewCerbosService(mkEngine(t, effectv1.Effect_EFFECT_DENY), auxData, reqLimits, false, nil, nil, nil, tenants)
+	}
+
+	req := &requestv1.CheckResourcesRequest{
+		RequestId: "test",
+		Principal: &enginev1.Principal{Id: "john", Roles: []string{"user"}},
+		Resources: []*requestv1.CheckResourcesRequest_ResourceEntry{
+			{
+				Actions:  []string{"view"},
+				Resource: &enginev1.Resource{Kind: "leave_request", Id: "XX125"},
+			},
+		},
+	}
+
+	check := func(t *testing.T, cs *CerbosService, bound, header string) (effectv1.Effect, error) {
+		t.Helper()
+
+		ctx := ctx
+		if bound != "" {
+			ctx = ContextWithTenant(ctx, bound)
+		}
+		if header != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(TenantHeader, header))
+		}
+
+		have, err := cs.CheckResources(ctx, req)
+		if err != nil {
+			return effectv1.Effect_EFFECT_UNSPECIFIED, err
+		}
+
+		return have.Results[0].Actions["view"], nil
+	}
+
+	t.Run("optional", func(t *testing.T) {
+		cs := mkService(t, false)
+
+		effect, err := check(t, cs, "", "")
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, effect)
+
+		effect, err = check(t, cs, "acme", "")
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, effect)
+
+		effect, err = check(t, cs, "acme", "acme")
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, effect)
+
+		_, err = check(t, cs, "globex", "")
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("header_only", func(t *testing.T) {
+		cs := mkService(t, false)
+
+		_, err := check(t, cs, "", "acme")
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = check(t, cs, "globex", "acme")
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("required", func(t *testing.T) {
+		cs := mkService(t, true)
+
+		_, err := check(t, cs, "", "")
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		effect, err := check(t, cs, "acme", "")
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, effect)
+	})
+}
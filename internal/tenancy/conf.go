@@ -0,0 +1,86 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenancy
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/config"
+)
+
+const confKey = "tenancy"
+
+var (
+	errEmptyID      = errors.New("id must not be empty")
+	errEmptyStorage = errors.New("storage must not be empty")
+
+	idRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{0,62}$`)
+)
+
+// Conf is optional configuration for serving the policies of several tenants from a single Cerbos instance.
+type Conf struct {
+	// Tenants is the list of tenants. Requests made with an API key or a SPIFFE ID bound to a tenant are evaluated using the policies in the store of the tenant.
+	Tenants []*TenantConf `yaml:"tenants"`
+	// RequireTenant rejects the requests that are not bound to a tenant by their API key or SPIFFE ID. By default, they are evaluated using the policies in the store defined by the storage section.
+	RequireTenant bool `yaml:"requireTenant" conf:",example=false"`
+}
+
+// TenantConf defines a tenant and the store that holds its policies.
+type TenantConf struct {
+	// Storage is merged over the storage section to create the store of the tenant, so it only needs to contain the settings that differ, such as the directory or the prefix that holds the policies of the tenant.
+	Storage map[string]any `yaml:"storage" conf:"required,example={\"disk\": {\"directory\": \"/policies/acme\"}}"`
+	// ID identifies the tenant in requests, logs and metrics. It can contain letters, digits, dots, dashes and underscores.
+	ID string `yaml:"id" conf:"required,example=acme"`
+}
+
+func (c *Conf) Key() string {
+	return confKey
+}
+
+func (c *Conf) Validate() (outErr error) {
+	if c.RequireTenant && len(c.Tenants) == 0 {
+		outErr = multierr.Append(outErr, errors.New("requireTenant needs at least one tenant"))
+	}
+
+	ids := make(map[string]struct{}, len(c.Tenants))
+	for i, tenant := range c.Tenants {
+		if err := tenant.Validate(); err != nil {
+			outErr = multierr.Append(outErr, fmt.Errorf("invalid tenant #%d: %w", i, err))
+			continue
+		}
+
+		if _, ok := ids[tenant.ID]; ok {
+			outErr = multierr.Append(outErr, fmt.Errorf("tenant %q is defined more than once", tenant.ID))
+		}
+		ids[tenant.ID] = struct{}{}
+	}
+
+	return outErr
+}
+
+func (tc *TenantConf) Validate() (outErr error) {
+	switch {
+	case tc.ID == "":
+		outErr = multierr.Append(outErr, errEmptyID)
+	case !idRegexp.MatchString(tc.ID):
+		outErr = multierr.Append(outErr, fmt.Errorf("id %q must start with a letter or a digit and contain at most 63 letters, digits, dots, dashes and underscores", tc.ID))
+	}
+
+	if len(tc.Storage) == 0 {
+		outErr = multierr.Append(outErr, errEmptyStorage)
+	}
+
+	return outErr
+}
+
+func GetConf() (*Conf, error) {
+	conf := &Conf{}
+	err := config.GetSection(conf)
+
+	return conf, err
+}
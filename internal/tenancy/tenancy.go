@@ -0,0 +1,29 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tenancy lets a single Cerbos instance serve the policies of several tenants. Each tenant has its own store,
+// which is created from the storage section of the configuration with the storage settings of the tenant merged over it.
+package tenancy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+// NewStore creates the store of the tenant from the storage section of confW with the storage settings of the tenant merged over it.
+func NewStore(ctx context.Context, confW *config.Wrapper, tenant *TenantConf) (storage.Store, error) {
+	tenantConfW, err := confW.Merge(map[string]any{storage.ConfKey: tenant.Storage})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read storage configuration of tenant %q: %w", tenant.ID, err)
+	}
+
+	store, err := storage.NewFromConf(ctx, tenantConfW)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create store of tenant %q: %w", tenant.ID, err)
+	}
+
+	return store, nil
+}
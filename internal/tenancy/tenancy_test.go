@@ -0,0 +1,105 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenancy_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/tenancy"
+)
+
+func TestConf(t *testing.T) {
+	storageConf := map[string]any{"disk": map[string]any{"directory": "/policies/acme"}}
+
+	testCases := []struct {
+		name    string
+		conf    map[string]any
+		wantErr string
+	}{
+		{
+			name: "valid",
+			conf: map[string]any{
+				"requireTenant": true,
+				"tenants": []any{
+					map[string]any{"id": "acme", "storage": storageConf},
+					map[string]any{"id": "globex.eu-1", "storage": storageConf},
+				},
+			},
+		},
+		{
+			name:    "duplicate_id",
+			conf:    map[string]any{"tenants": []any{map[string]any{"id": "acme", "storage": storageConf}, map[string]any{"id": "acme", "storage": storageConf}}},
+			wantErr: `tenant "acme" is defined more than once`,
+		},
+		{
+			name:    "invalid_id",
+			conf:    map[string]any{"tenants": []any{map[string]any{"id": "acme/eu", "storage": storageConf}}},
+			wantErr: `id "acme/eu" must start with a letter or a digit`,
+		},
+		{
+			name:    "missing_storage",
+			conf:    map[string]any{"tenants": []any{map[string]any{"id": "acme"}}},
+			wantErr: "storage must not be empty",
+		},
+		{
+			name:    "require_tenant_without_tenants",
+			conf:    map[string]any{"requireTenant": true},
+			wantErr: "requireTenant needs at least one tenant",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			confW, err := config.WrapperFromMap(map[string]any{"tenancy": tc.conf})
+			require.NoError(t, err)
+
+			var conf tenancy.Conf
+			err = confW.GetSection(&conf)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, conf.Tenants, 2)
+		})
+	}
+}
+
+func TestNewStore(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "acme"), 0o700))
+
+	confW, err := config.WrapperFromMap(map[string]any{
+		"storage": map[string]any{
+			"driver": "disk",
+			"disk":   map[string]any{"directory": dir, "watchForChanges": false},
+		},
+	})
+	require.NoError(t, err)
+
+	store, err := tenancy.NewStore(ctx, confW, &tenancy.TenantConf{
+		ID:      "acme",
+		Storage: map[string]any{"disk": map[string]any{"directory": filepath.Join(dir, "acme")}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, disk.DriverName, store.Driver())
+
+	_, err = tenancy.NewStore(ctx, confW, &tenancy.TenantConf{
+		ID:      "globex",
+		Storage: map[string]any{"driver": "unknown"},
+	})
+	require.ErrorContains(t, err, `failed to create store of tenant "globex"`)
+}
@@ -7,6 +7,8 @@ package mocks
 
 import (
 	context "context"
+	fs "io/fs"
+
 	io "io"
 
 	index "github.com/cerbos/cerbos/internal/storage/index"
@@ -739,6 +741,61 @@ func (_c *Index_Reload_Call) RunAndReturn(run func(context.Context) ([]storage.E
 	return _c
 }
 
+// ReloadFrom provides a mock function with given fields: ctx, fsys
+func (_m *Index) ReloadFrom(ctx context.Context, fsys fs.FS) ([]storage.Event, error) {
+	ret := _m.Called(ctx, fsys)
+
+	var r0 []storage.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, fs.FS) ([]storage.Event, error)); ok {
+		return rf(ctx, fsys)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, fs.FS) []storage.Event); ok {
+		r0 = rf(ctx, fsys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]storage.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, fs.FS) error); ok {
+		r1 = rf(ctx, fsys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Index_ReloadFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReloadFrom'
+type Index_ReloadFrom_Call struct {
+	*mock.Call
+}
+
+// ReloadFrom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fsys fs.FS
+func (_e *Index_Expecter) ReloadFrom(ctx interface{}, fsys interface{}) *Index_ReloadFrom_Call {
+	return &Index_ReloadFrom_Call{Call: _e.mock.On("ReloadFrom", ctx, fsys)}
+}
+
+func (_c *Index_ReloadFrom_Call) Run(run func(ctx context.Context, fsys fs.FS)) *Index_ReloadFrom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(fs.FS))
+	})
+	return _c
+}
+
+func (_c *Index_ReloadFrom_Call) Return(_a0 []storage.Event, _a1 error) *Index_ReloadFrom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Index_ReloadFrom_Call) RunAndReturn(run func(context.Context, fs.FS) ([]storage.Event, error)) *Index_ReloadFrom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RepoStats provides a mock function with given fields: _a0
 func (_m *Index) RepoStats(_a0 context.Context) storage.RepoStats {
 	ret := _m.Called(_a0)
@@ -0,0 +1,29 @@
+;; Source of functions.wasm. The functions follow the ABI described in internal/conditions/wasm.
+(module
+  (memory (export "memory") 1)
+  (global $next (mut i32) (i32.const 1024))
+
+  ;; bump allocator that never frees memory because each call runs in a fresh instance
+  (func (export "alloc") (param $size i32) (result i32)
+    global.get $next
+    global.get $next
+    local.get $size
+    i32.add
+    global.set $next)
+
+  ;; returns the JSON array of arguments as the result
+  (func (export "echo") (param $ptr i32) (param $len i32) (result i64)
+    local.get $ptr
+    i64.extend_i32_u
+    i64.const 32
+    i64.shl
+    local.get $len
+    i64.extend_i32_u
+    i64.or)
+
+  ;; never returns
+  (func (export "spin") (param $ptr i32) (param $len i32) (result i64)
+    loop
+      br 0
+    end
+    unreachable))
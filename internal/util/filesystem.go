@@ -5,6 +5,7 @@ package util
 
 import (
 	"archive/zip"
+	"bytes"
 	"compress/gzip"
 	"errors"
 	"fmt"
@@ -129,6 +130,31 @@ func OpenDirectoryFS(path string) (fs.FS, error) {
 	return os.DirFS(path), nil
 }
 
+// OpenArchiveFS opens an archive held in memory, such as an archive that had to be decrypted before use.
+// The path is only used to determine the type of the archive.
+func OpenArchiveFS(path string, data []byte) (fs.FS, error) {
+	switch {
+	case IsZip(path):
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open zip file: %w", err)
+		}
+		return zr, nil
+	case IsTar(path):
+		return getFsFromTar(bytes.NewReader(data))
+	case IsGzip(path):
+		gzr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open gzip file: %w", err)
+		}
+		defer gzr.Close()
+
+		return getFsFromTar(gzr)
+	}
+
+	return nil, fmt.Errorf("%q is not an archive", path)
+}
+
 // LoadFromJSONOrYAML reads a JSON or YAML encoded protobuf from the given path.
 func LoadFromJSONOrYAML(fsys fs.FS, path string, dest proto.Message) error {
 	f, err := fsys.Open(path)
@@ -134,7 +134,7 @@ func (tf *testFixture) checkDupes(suite *policyv1.TestSuite) error {
 	return errs
 }
 
-func (tf *testFixture) runTestSuite(ctx context.Context, eng Checker, shouldRun func(string) bool, file string, suite *policyv1.TestSuite, trace bool) *policyv1.TestResults_Suite {
+func (tf *testFixture) runTestSuite(ctx context.Context, eng Checker, shouldRun func(string) bool, shouldRunSuite func(string, []*policyv1.Test) bool, file string, suite *policyv1.TestSuite, trace bool) *policyv1.TestResults_Suite {
 	suiteResult := &policyv1.TestResults_Suite{
 		File:        file,
 		Name:        suite.Name,
@@ -160,6 +160,11 @@ func (tf *testFixture) runTestSuite(ctx context.Context, eng Checker, shouldRun
 		return suiteResult
 	}
 
+	if shouldRunSuite != nil && !shouldRunSuite(file, tests) {
+		suiteResult.Summary.OverallResult = policyv1.TestResults_RESULT_SKIPPED
+		return suiteResult
+	}
+
 	for _, test := range tests {
 		if err := ctx.Err(); err != nil {
 			return suiteResult
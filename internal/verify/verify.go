@@ -20,8 +20,11 @@ import (
 )
 
 type Config struct {
-	Run   string
-	Trace bool
+	// ShouldRunSuite, if set, is called with the path of each test suite and the tests it contains.
+	// Suites for which it returns false are skipped.
+	ShouldRunSuite func(file string, tests []*policyv1.Test) bool
+	Run            string
+	Trace          bool
 }
 
 var ErrTestFixtureNotFound = errors.New("test fixture not found")
@@ -139,7 +142,7 @@ func Verify(ctx context.Context, fsys fs.FS, eng Checker, conf Config) (*policyv
 			}
 		}
 
-		return fixture.runTestSuite(ctx, eng, shouldRun, file, suite, conf.Trace)
+		return fixture.runTestSuite(ctx, eng, shouldRun, conf.ShouldRunSuite, file, suite, conf.Trace)
 	}
 
 	results := &policyv1.TestResults{
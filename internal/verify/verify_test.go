@@ -358,6 +358,34 @@ func Test_doVerify(t *testing.T) {
 		}
 		is.Equal(policyv1.TestResults_RESULT_PASSED, result.Summary.OverallResult)
 	})
+	t.Run("Suites rejected by the suite filter are skipped", func(t *testing.T) {
+		fsys := make(fstest.MapFS)
+		ts := genTable(t, false, false)
+		for _, dir := range []string{"a", "b"} {
+			d := filepath.Join(dir, util.TestDataDirectory)
+			fsys[d+"/principals.yaml"] = newMapFile(principals)
+			fsys[d+"/resources.yaml"] = newMapFile(resources)
+			fsys[dir+"/leave_request_test.yaml"] = newMapFile(ts)
+		}
+
+		conf := Config{
+			ShouldRunSuite: func(file string, tests []*policyv1.Test) bool {
+				require.NotEmpty(t, tests)
+				return file == "a/leave_request_test.yaml"
+			},
+		}
+
+		result, err := Verify(context.Background(), fsys, eng, conf)
+		is := require.New(t)
+		is.NoError(err)
+		is.Len(result.Suites, 2)
+		is.Equal("a/leave_request_test.yaml", result.Suites[0].File)
+		is.Equal(policyv1.TestResults_RESULT_PASSED, result.Suites[0].Summary.OverallResult)
+		is.Equal("b/leave_request_test.yaml", result.Suites[1].File)
+		is.Equal(policyv1.TestResults_RESULT_SKIPPED, result.Suites[1].Summary.OverallResult)
+		is.Empty(result.Suites[1].TestCases)
+		is.Equal(policyv1.TestResults_RESULT_PASSED, result.Summary.OverallResult)
+	})
 	t.Run("Simple test", func(t *testing.T) {
 		fsys := make(fstest.MapFS)
 		ts := genTable(t, false, false)
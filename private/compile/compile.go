@@ -54,6 +54,11 @@ func Files(ctx context.Context, fsys fs.FS) (<-chan Artefact, error) {
 		return nil, fmt.Errorf("failed to build index: %w", err)
 	}
 
+	compileConf, err := internalcompile.GetConf()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read compile configuration: %w", err)
+	}
+
 	outChan := make(chan Artefact, 1)
 
 	go func() {
@@ -70,7 +75,7 @@ func Files(ctx context.Context, fsys fs.FS) (<-chan Artefact, error) {
 			log.Debug("Compiling unit")
 
 			artefact := Artefact{SourceFile: srcFile}
-			artefact.PolicySet, artefact.Error = internalcompile.Compile(unit, schemaMgr)
+			artefact.PolicySet, artefact.Error = internalcompile.Compile(unit, schemaMgr, internalcompile.WithContext(ctx), internalcompile.WithLimits(compileConf.Limits))
 
 			if artefact.Error != nil {
 				log.Error("Compilation failed", zap.Error(artefact.Error))
@@ -0,0 +1,130 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package plandiff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"go.uber.org/zap"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	internalcompile "github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/engine/planner"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage/bundle"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/storage/index"
+	"github.com/cerbos/cerbos/private/compile"
+)
+
+type (
+	Change     = planner.FilterChange
+	ChangeKind = planner.FilterChangeKind
+)
+
+const (
+	ChangeAdded    = planner.FilterChangeAdded
+	ChangeRemoved  = planner.FilterChangeRemoved
+	ChangeModified = planner.FilterChangeModified
+)
+
+// Planner produces query plans for a policy revision.
+type Planner interface {
+	PlanResources(context.Context, *enginev1.PlanResourcesInput) (*enginev1.PlanResourcesOutput, error)
+}
+
+type Result struct {
+	Input   *enginev1.PlanResourcesInput
+	Base    *enginev1.PlanResourcesOutput
+	Head    *enginev1.PlanResourcesOutput
+	Changes []Change
+}
+
+// Changed returns true if the plans produced by the base and head revisions are different.
+func (r *Result) Changed() bool {
+	return len(r.Changes) > 0
+}
+
+// Diff computes the plans for each input using the base and head revisions and returns the differences between them.
+func Diff(ctx context.Context, base, head Planner, inputs []*enginev1.PlanResourcesInput) ([]*Result, error) {
+	results := make([]*Result, len(inputs))
+	for i, input := range inputs {
+		baseOut, err := base.PlanResources(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to produce plan for input #%d using the base revision: %w", i, err)
+		}
+
+		headOut, err := head.PlanResources(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to produce plan for input #%d using the head revision: %w", i, err)
+		}
+
+		results[i] = &Result{
+			Input:   input,
+			Base:    baseOut,
+			Head:    headOut,
+			Changes: planner.DiffFilters(baseOut.Filter, headOut.Filter),
+		}
+	}
+
+	return results, nil
+}
+
+// Files creates a planner using the policy files in the given file system.
+func Files(ctx context.Context, fsys fs.FS) (Planner, error) {
+	idx, err := index.Build(ctx, fsys, index.WithBuildFailureLogLevel(zap.DebugLevel))
+	if err != nil {
+		idxErrs := new(index.BuildError)
+		if errors.As(err, &idxErrs) {
+			return nil, &compile.Errors{
+				Errors: &runtimev1.Errors{
+					Kind: &runtimev1.Errors_IndexBuildErrors{IndexBuildErrors: idxErrs.IndexBuildErrors},
+				},
+			}
+		}
+
+		return nil, fmt.Errorf("failed to build index: %w", err)
+	}
+
+	store := disk.NewFromIndexWithConf(idx, &disk.Conf{})
+	schemaMgr := schema.NewFromConf(ctx, store, schema.NewConf(schema.EnforcementReject))
+	compiler := internalcompile.NewManagerFromDefaultConf(ctx, store, schemaMgr)
+	eng, err := engine.NewEphemeral(compiler, schemaMgr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create engine: %w", err)
+	}
+
+	return eng, nil
+}
+
+type BundleParams struct {
+	BundlePath string
+	SecretKey  string
+	WorkDir    string
+}
+
+// Bundle creates a planner using the given policy bundle.
+func Bundle(ctx context.Context, params BundleParams) (Planner, error) {
+	bundleSrc, err := bundle.NewLocalSource(bundle.LocalParams{
+		BundlePath: params.BundlePath,
+		SecretKey:  params.SecretKey,
+		TempDir:    params.WorkDir,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create local bundle source from %q: %w", params.BundlePath, err)
+	}
+
+	schemaMgr := schema.NewFromConf(ctx, bundleSrc, schema.NewConf(schema.EnforcementReject))
+	eng, err := engine.NewEphemeral(bundleSrc, schemaMgr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create engine: %w", err)
+	}
+
+	return eng, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package plandiff_test
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/test"
+	"github.com/cerbos/cerbos/private/plandiff"
+)
+
+func TestDiff(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	t.Cleanup(cancelFn)
+
+	baseFS := os.DirFS(test.PathToDir(t, "query_planner/policies"))
+	base, err := plandiff.Files(ctx, baseFS)
+	require.NoError(t, err)
+
+	// head revision without the report_with_map resource policy
+	headFS := make(fstest.MapFS)
+	require.NoError(t, fs.WalkDir(baseFS, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path == "resource_policy_report_with_map.yaml" {
+			return err
+		}
+
+		data, err := fs.ReadFile(baseFS, path)
+		if err != nil {
+			return err
+		}
+
+		headFS[path] = &fstest.MapFile{Data: data}
+		return nil
+	}))
+
+	head, err := plandiff.Files(ctx, headFS)
+	require.NoError(t, err)
+
+	principal := &enginev1.Principal{
+		Id:    "123",
+		Roles: []string{"ADMIN"},
+		Attr: map[string]*structpb.Value{
+			"workspaceRolesMap": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+				"1": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("ADMIN")}}),
+			}}),
+		},
+	}
+
+	inputs := []*enginev1.PlanResourcesInput{
+		{
+			Action:    "delete_vehemently",
+			Principal: principal,
+			Resource:  &enginev1.PlanResourcesInput_Resource{Kind: "report_with_map", PolicyVersion: "default"},
+		},
+		{
+			Action:    "view",
+			Principal: &enginev1.Principal{Id: "maggie", Roles: []string{"manager"}},
+			Resource:  &enginev1.PlanResourcesInput_Resource{Kind: "leave_request", PolicyVersion: "default"},
+		},
+	}
+
+	t.Run("same_revision", func(t *testing.T) {
+		results, err := plandiff.Diff(ctx, base, base, inputs)
+		require.NoError(t, err)
+		require.Len(t, results, len(inputs))
+
+		for _, r := range results {
+			require.False(t, r.Changed())
+		}
+	})
+
+	t.Run("different_revisions", func(t *testing.T) {
+		results, err := plandiff.Diff(ctx, base, head, inputs)
+		require.NoError(t, err)
+		require.Len(t, results, len(inputs))
+
+		require.True(t, results[0].Changed())
+		require.Len(t, results[0].Changes, 1)
+		require.Equal(t, plandiff.ChangeModified, results[0].Changes[0].Kind)
+		require.Equal(t, "(false)", results[0].Changes[0].Head)
+
+		require.False(t, results[1].Changed())
+	})
+}